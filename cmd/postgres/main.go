@@ -3,6 +3,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -13,6 +14,7 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/u104rak1/pocgo/internal/config"
 	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
 	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/seed"
 	"github.com/uptrace/bun"
 )
@@ -43,6 +45,8 @@ func main() {
 		dropTables(m)
 	case "migrate refresh":
 		updateSchemaAndGenerateMigrations(dsn, m)
+	case "reconcile ledger":
+		reconcileLedger()
 	default:
 		log.Fatalf("Unknown command: %s", os.Args[1])
 	}
@@ -57,6 +61,28 @@ func insertSeedData() {
 	seed.InsertSeedData(db)
 }
 
+func reconcileLedger() {
+	db, err := config.LoadDB()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mismatches, err := repository.NewLedgerRepository(db).ListBalanceMismatches(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to reconcile ledger: %v", err)
+	}
+	if len(mismatches) == 0 {
+		fmt.Println("All account balances match the ledger")
+		return
+	}
+
+	for _, m := range mismatches {
+		fmt.Printf("account_id=%s currency=%s stored_balance=%d ledger_balance=%d diff=%d\n",
+			m.AccountID, m.Currency, m.StoredBalance, m.LedgerBalance, m.StoredBalance-m.LedgerBalance)
+	}
+	log.Fatalf("Found %d account(s) whose balance differs from the ledger", len(mismatches))
+}
+
 func migrateUp(m *migrate.Migrate) {
 	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
 		log.Fatalf("Failed to migrate up: %v", err)
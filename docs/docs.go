@@ -15,6 +15,63 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "アクセストークンの検証に使用できる公開鍵を JWK Set 形式で返します。鍵のローテーション中は以前の鍵も含まれます。共通鍵で署名している場合は空の一覧を返します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication API"
+                ],
+                "summary": "JWKS の取得",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jwks.JWKSResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "現在のセッションを失効させます。このセッションで発行したアクセストークンとリフレッシュトークンは全て使用できなくなります。",
+                "tags": [
+                    "Authentication API"
+                ],
+                "summary": "ログアウト",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/me": {
             "get": {
                 "security": [
@@ -62,6 +119,41 @@ const docTemplate = `{
             }
         },
         "/api/v1/me/accounts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "ログインユーザーが所有する口座の一覧を取得します。解約済みの口座は含まれません。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account API"
+                ],
+                "summary": "口座一覧の取得",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/accounts.ListAccountsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            },
             "post": {
                 "security": [
                     {
@@ -130,75 +222,92 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/me/accounts/{account_id}/transactions": {
+        "/api/v1/me/accounts/{account_id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "指定された口座の取引履歴を取得します。",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "指定された口座の情報を取得します。",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Transaction API"
+                    "Account API"
                 ],
-                "summary": "取引一覧取得",
+                "summary": "口座の取得",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "操作する口座ID",
+                        "description": "口座ID",
                         "name": "account_id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/accounts.ReadAccountResponse"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "取引日の開始日（YYYYMMDD）",
-                        "name": "from",
-                        "in": "query"
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "取引日の終了日（YYYYMMDD）",
-                        "name": "to",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "取引種別（DEPOSIT, WITHDRAWAL, TRANSFER カンマ区切りで複数指定可 未指定の場合は全ての取引種別を取得）",
-                        "name": "operation_types",
-                        "in": "query"
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "ソート順（ASC, DESC）",
-                        "name": "sort",
-                        "in": "query"
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
                     {
-                        "type": "integer",
-                        "description": "ページサイズ（1~100）",
-                        "name": "limit",
-                        "in": "query"
-                    },
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された口座を解約します。残高が残っている口座は解約できません。",
+                "tags": [
+                    "Account API"
+                ],
+                "summary": "口座の解約",
+                "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ページ番号（1~）",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/transactions.ListTransactionsResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Validation Failed or Bad Request",
@@ -224,6 +333,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ProblemDetail"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -232,13 +347,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "指定された口座に対して取引を実行します。",
+                "description": "指定された口座の名前を変更します。",
                 "consumes": [
                     "application/json"
                 ],
@@ -246,13 +361,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transaction API"
+                    "Account API"
                 ],
-                "summary": "取引実行",
+                "summary": "口座名の変更",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "操作する口座ID",
+                        "description": "口座ID",
                         "name": "account_id",
                         "in": "path",
                         "required": true
@@ -263,7 +378,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/transactions.ExecuteTransactionRequestBody"
+                            "$ref": "#/definitions/accounts.UpdateAccountRequestBody"
                         }
                     }
                 ],
@@ -271,7 +386,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/transactions.ExecuteTransactionResponse"
+                            "$ref": "#/definitions/accounts.UpdateAccountResponse"
                         }
                     },
                     "400": {
@@ -298,6 +413,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ProblemDetail"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -307,35 +428,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/signin": {
-            "post": {
-                "description": "ユーザーのメールアドレスとパスワードを使用してユーザーを認証し、アクセストークンを発行します。",
-                "consumes": [
-                    "application/json"
+        "/api/v1/me/accounts/{account_id}/holds": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "指定された口座の仮押さえを新しい順に取得します。",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication API"
+                    "Hold API"
                 ],
-                "summary": "サインイン",
+                "summary": "仮押さえ一覧の取得",
                 "parameters": [
                     {
-                        "description": "Request Body",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/signin.SigninRequest"
-                        }
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "状態 (AUTHORIZED, CAPTURED, VOIDED, EXPIRED)",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/signin.SigninResponse"
+                            "$ref": "#/definitions/holds.ListHoldsResponse"
                         }
                     },
                     "400": {
@@ -350,6 +477,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ProblemDetail"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -357,11 +496,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/signup": {
+            },
             "post": {
-                "description": "新しいユーザーを作成し、アクセストークンを発行します。",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された口座の利用可能な金額から仮押さえを行います。仮押さえした金額は確定または取り消すまで出金や振込に利用できません。\n有効期限までに確定しなかった仮押さえは自動的に期限切れになり、仮押さえした金額は再び利用できるようになります。",
                 "consumes": [
                     "application/json"
                 ],
@@ -369,17 +511,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication API"
+                    "Hold API"
                 ],
-                "summary": "サインアップ",
+                "summary": "仮押さえの作成",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "Request Body",
-                        "name": "body",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/signup.SignupRequest"
+                            "$ref": "#/definitions/holds.AuthorizeHoldRequestBody"
                         }
                     }
                 ],
@@ -387,7 +536,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/signup.SignupResponse"
+                            "$ref": "#/definitions/holds.HoldResponse"
                         }
                     },
                     "400": {
@@ -396,74 +545,2762 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ValidationProblemDetail"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.ProblemDetail"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/holds/{hold_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された仮押さえを取得します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hold API"
+                ],
+                "summary": "仮押さえの取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "仮押さえID",
+                        "name": "hold_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/holds.HoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/holds/{hold_id}/capture": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された仮押さえを確定し、確定した金額の出金または振込を行います。\n一部の金額のみを確定した場合、残りの金額は再び利用できるようになります。\n確定時の出金または振込には口座の取引金額の上限が適用されます。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hold API"
+                ],
+                "summary": "仮押さえの確定",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "仮押さえID",
+                        "name": "hold_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/holds.CaptureHoldRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/holds.HoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/holds/{hold_id}/void": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された仮押さえを取り消し、仮押さえした金額を再び利用できるようにします。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Hold API"
+                ],
+                "summary": "仮押さえの取り消し",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "仮押さえID",
+                        "name": "hold_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/holds.HoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.ProblemDetail"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/limits": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
                     }
+                ],
+                "description": "出金と振込のそれぞれについて、口座に適用される取引金額の上限と既定の上限を取得します。\n口座に上限を設定している場合は、上限ごとに既定の上限と口座の上限の小さい方が適用されます。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Limit API"
+                ],
+                "summary": "取引金額の上限の取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/limits.ListAccountLimitsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/limits/{operation_type}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された取引種別について、口座の取引金額の上限を設定します。\n上限は 1回 \u003c= 1日 \u003c= 1ヶ月 の関係を満たし、既定の上限以下である必要があります。\n既に設定している場合は上書きします。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Limit API"
+                ],
+                "summary": "取引金額の上限の設定",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "取引種別 (WITHDRAWAL, TRANSFER)",
+                        "name": "operation_type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/limits.SetAccountLimitRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/limits.AccountLimitResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/password": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "現在のパスワードを確認した上で、指定された口座のパスワードを変更します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account API"
+                ],
+                "summary": "口座パスワードの変更",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/accounts.ChangeAccountPasswordRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/standing-orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された口座の自動振込を、解約済みや終了したものも含めて取得します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Order API"
+                ],
+                "summary": "自動振込一覧の取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/standingorders.ListStandingOrdersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された口座から他の口座へ、毎週または毎月決められた日に振込を行う自動振込を作成します。\n実行日に残高不足などで振込できなかった場合は、1日ごとに maxRetries 回まで再試行します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Order API"
+                ],
+                "summary": "自動振込の作成",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/standingorders.CreateStandingOrderRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/standingorders.StandingOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/standing-orders/{standing_order_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された自動振込と、直近の実行履歴を取得します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Order API"
+                ],
+                "summary": "自動振込の取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "自動振込ID",
+                        "name": "standing_order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/standingorders.ReadStandingOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された自動振込を解約します。実行履歴は引き続き参照できます。",
+                "tags": [
+                    "Standing Order API"
+                ],
+                "summary": "自動振込の解約",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "自動振込ID",
+                        "name": "standing_order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された自動振込の金額、終了条件、再試行の方針を変更、または一時停止・再開します。\n再開した場合、停止中に過ぎた実行日の振込は行わず、今日以降の実行日から再開します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Order API"
+                ],
+                "summary": "自動振込の変更",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "自動振込ID",
+                        "name": "standing_order_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/standingorders.UpdateStandingOrderRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/standingorders.StandingOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/statements": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された期間の取引明細を CSV、OFX、ISO 20022 camt.053 のいずれかの形式で出力します。\n期間内の全ての取引を件数の上限なく出力し、期首残高と期末残高を含みます。金額は口座の通貨の小数点以下の桁数で出力します。",
+                "produces": [
+                    "text/csv",
+                    "application/x-ofx",
+                    "application/xml",
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction API"
+                ],
+                "summary": "取引明細のエクスポート",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "開始日 (YYYYMMDD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "終了日 (YYYYMMDD、終了日の取引も含みます)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ofx",
+                            "camt053"
+                        ],
+                        "type": "string",
+                        "description": "出力形式",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "取引明細",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された口座の取引履歴を取得します。他の口座から受け取った振込も含みます。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction API"
+                ],
+                "summary": "取引一覧取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "操作する口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "取引日の開始日（YYYYMMDD）",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "取引日の終了日（YYYYMMDD）",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "取引種別（DEPOSIT, WITHDRAWAL, TRANSFER, TRANSFER_IN, TRANSFER_OUT, REVERSAL カンマ区切りで複数指定可 TRANSFERは送金と受取の両方 未指定の場合は全ての取引種別を取得）",
+                        "name": "operation_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "検索語（メモと参照情報を単語で検索 空白区切りで複数指定した場合は全てを含む取引を取得 受け取った振込はメモを検索しません）",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "口座の通貨での取引金額の下限",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "口座の通貨での取引金額の上限",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "分類（カンマ区切りで複数指定可 いずれかの分類が付いた取引を取得）",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ソート順（ASC, DESC）",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ページサイズ（1~100）",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ページ番号（1~）cursor と同時には指定できません",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ページングのカーソル（links.next, links.prev に含まれる値）",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactions.ListTransactionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された口座に対して取引を実行します。\n振込は受取口座IDの代わりに支払先IDを指定できます。支払先は事前に確認済みにし、支払先の利用者が取引の通貨の受取口座を設定している必要があります。\n出金と振込は口座の取引金額の上限を超える場合は実行できず、残りの金額を含むエラーを返します。\nIdempotency-Key ヘッダーを指定した場合、同じキーで再送されたリクエストは取引を再実行せずに最初のレスポンスを返します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction API"
+                ],
+                "summary": "取引実行",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "操作する口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "リクエストを一意に識別するキー (最大255文字)",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transactions.ExecuteTransactionRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactions.ExecuteTransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/transactions/{transaction_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された口座の取引を取得します。他の口座から受け取った振込も取得できます。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction API"
+                ],
+                "summary": "取引の取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "取引ID",
+                        "name": "transaction_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactions.ReadTransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/transactions/{transaction_id}/categories": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された口座として取引に分類を付けます。既に付けている分類は全て置き換えます。\n振込の場合、送金元と受取口座はそれぞれ別の分類を付けられます。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction API"
+                ],
+                "summary": "取引の分類の設定",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "取引ID",
+                        "name": "transaction_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transactions.CategorizeTransactionRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactions.ReadTransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/accounts/{account_id}/transactions/{transaction_id}/reversal": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "完了した取引を取り消し、取引と逆向きに残高を戻す取消の取引 (REVERSAL) を作成します。\n取り消せるのは取引を行った口座の入金と振込のみで、1つの取引は1回しか取り消せません。出金と取消の取引は取り消せません。\n振込の場合は受取口座の残高も戻します。受取口座が受け取った資金を既に使っている場合は取り消せません。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction API"
+                ],
+                "summary": "取引の取消",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "操作する口座ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "取り消す取引ID",
+                        "name": "transaction_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transactions.ReverseTransactionRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transactions.ReverseTransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/payees": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "登録している支払先の一覧を取得します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payee API"
+                ],
+                "summary": "支払先の一覧",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/payees.ListPayeesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "メールアドレスで利用者を探し、未確認の支払先として登録します。\nレスポンスの伏せた名前を確認して支払先を確認済みにすると、支払先IDで振込ができるようになります。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payee API"
+                ],
+                "summary": "支払先の登録",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payees.RegisterPayeeRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/payees.PayeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/payees/{payee_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された支払先を取得します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payee API"
+                ],
+                "summary": "支払先の取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "支払先ID",
+                        "name": "payee_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/payees.PayeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "指定された支払先を削除します。支払先IDで行った振込の履歴は影響を受けません。",
+                "tags": [
+                    "Payee API"
+                ],
+                "summary": "支払先の削除",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "支払先ID",
+                        "name": "payee_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/payees/{payee_id}/confirmation": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "伏せた名前を確認した支払先を確認済みにします。確認済みの支払先にのみ支払先IDで振込ができます。\n既に確認済みの場合は何もせずに支払先を返します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payee API"
+                ],
+                "summary": "支払先の確認",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "支払先ID",
+                        "name": "payee_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/payees.PayeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/receiving-accounts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "他の利用者から支払先IDで振込を受け取る際の、通貨ごとの既定の口座の一覧を取得します。",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receiving Account API"
+                ],
+                "summary": "受取口座の一覧",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/receivingaccounts.ListReceivingAccountsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/me/receiving-accounts/{currency}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "他の利用者から支払先IDで振込を受け取る際の、指定された通貨の既定の口座を設定します。\n口座の通貨は指定された通貨と一致する必要があります。既に設定している場合は置き換えます。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receiving Account API"
+                ],
+                "summary": "受取口座の設定",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "通貨",
+                        "name": "currency",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/receivingaccounts.SetReceivingAccountRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/receivingaccounts.ReceivingAccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/signin": {
+            "post": {
+                "description": "ユーザーのメールアドレスとパスワードを使用してユーザーを認証し、アクセストークンとリフレッシュトークンを発行します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication API"
+                ],
+                "summary": "サインイン",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/signin.SigninRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/signin.SigninResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/signup": {
+            "post": {
+                "description": "新しいユーザーを作成し、アクセストークンとリフレッシュトークンを発行します。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication API"
+                ],
+                "summary": "サインアップ",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/signup.SignupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/signup.SignupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/token/refresh": {
+            "post": {
+                "description": "リフレッシュトークンを使用してアクセストークンとリフレッシュトークンを再発行します。使用済みのリフレッシュトークンが再利用された場合は、同じセッションのトークンを全て失効させます。",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication API"
+                ],
+                "summary": "トークンの再発行",
+                "parameters": [
+                    {
+                        "description": "Request Body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/token.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/token.RefreshTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation Failed or Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationProblemDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "accounts.ChangeAccountPasswordRequestBody": {
+            "type": "object",
+            "properties": {
+                "currentPassword": {
+                    "description": "現在の 4 桁のパスワード",
+                    "type": "string",
+                    "example": "1234"
+                },
+                "newPassword": {
+                    "description": "新しい 4 桁のパスワード",
+                    "type": "string",
+                    "example": "5678"
+                }
+            }
+        },
+        "accounts.CreateAccountRequestBody": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "description": "通貨（通貨マスタに登録されている ISO 4217 通貨コード）",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "name": {
+                    "description": "3 ～ 20 文字のアカウント名",
+                    "type": "string",
+                    "example": "For work"
+                },
+                "password": {
+                    "description": "4 桁のパスワード",
+                    "type": "string",
+                    "example": "1234"
+                }
+            }
+        },
+        "accounts.CreateAccountResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "description": "口座残高",
+                    "type": "number",
+                    "example": 0
+                },
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "id": {
+                    "description": "口座ID",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C7LE"
+                },
+                "name": {
+                    "description": "口座名",
+                    "type": "string",
+                    "example": "For work"
+                },
+                "updatedAt": {
+                    "description": "口座の更新日時",
+                    "type": "string",
+                    "example": "2021-08-01T00:00:00Z"
+                }
+            }
+        },
+        "accounts.ListAccountsAccount": {
+            "type": "object",
+            "properties": {
+                "availableBalance": {
+                    "description": "利用可能な金額（口座残高から仮押さえ中の金額を引いた金額）",
+                    "type": "number",
+                    "example": 700
+                },
+                "balance": {
+                    "description": "口座残高",
+                    "type": "number",
+                    "example": 1000
+                },
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "heldBalance": {
+                    "description": "仮押さえ中の金額",
+                    "type": "number",
+                    "example": 300
+                },
+                "id": {
+                    "description": "口座ID",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C7LE"
+                },
+                "name": {
+                    "description": "口座名",
+                    "type": "string",
+                    "example": "For work"
+                },
+                "updatedAt": {
+                    "description": "口座の更新日時",
+                    "type": "string",
+                    "example": "2021-08-01T00:00:00Z"
+                }
+            }
+        },
+        "accounts.ListAccountsResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "description": "口座一覧",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/accounts.ListAccountsAccount"
+                    }
+                }
+            }
+        },
+        "accounts.ReadAccountResponse": {
+            "type": "object",
+            "properties": {
+                "availableBalance": {
+                    "description": "利用可能な金額（口座残高から仮押さえ中の金額を引いた金額）",
+                    "type": "number",
+                    "example": 700
+                },
+                "balance": {
+                    "description": "口座残高",
+                    "type": "number",
+                    "example": 1000
+                },
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "heldBalance": {
+                    "description": "仮押さえ中の金額",
+                    "type": "number",
+                    "example": 300
+                },
+                "id": {
+                    "description": "口座ID",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C7LE"
+                },
+                "name": {
+                    "description": "口座名",
+                    "type": "string",
+                    "example": "For work"
+                },
+                "updatedAt": {
+                    "description": "口座の更新日時",
+                    "type": "string",
+                    "example": "2021-08-01T00:00:00Z"
+                }
+            }
+        },
+        "accounts.UpdateAccountRequestBody": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "3 ～ 20 文字の新しいアカウント名",
+                    "type": "string",
+                    "example": "For private"
+                }
+            }
+        },
+        "accounts.UpdateAccountResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "description": "口座残高",
+                    "type": "number",
+                    "example": 1000
+                },
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "id": {
+                    "description": "口座ID",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C7LE"
+                },
+                "name": {
+                    "description": "口座名",
+                    "type": "string",
+                    "example": "For private"
+                },
+                "updatedAt": {
+                    "description": "口座の更新日時",
+                    "type": "string",
+                    "example": "2021-08-01T00:00:00Z"
+                }
+            }
+        },
+        "holds.AuthorizeHoldRequestBody": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "仮押さえする金額 (通貨の小数点以下の桁数まで指定可能)",
+                    "type": "number",
+                    "example": 1000
+                },
+                "currency": {
+                    "description": "通貨 (口座と同じ通貨)",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "expiresAt": {
+                    "description": "有効期限 (ISO8601、30日後まで指定可能、デフォルト 7日後)",
+                    "type": "string",
+                    "example": "2026-10-24T09:00:00Z"
+                },
+                "password": {
+                    "description": "口座パスワード",
+                    "type": "string",
+                    "example": "1234"
+                },
+                "receiverAccountId": {
+                    "description": "受取口座ID (指定した場合は確定時に振込、指定しない場合は確定時に出金)",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9D87"
+                }
+            }
+        },
+        "holds.CaptureHoldRequestBody": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "確定する金額 (仮押さえした金額以下、指定しない場合は全額)",
+                    "type": "number",
+                    "example": 800
+                },
+                "password": {
+                    "description": "口座パスワード",
+                    "type": "string",
+                    "example": "1234"
+                }
+            }
+        },
+        "holds.HoldResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "description": "口座ID",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C8FW"
+                },
+                "amount": {
+                    "description": "仮押さえした金額",
+                    "type": "number",
+                    "example": 1000
+                },
+                "capturedAmount": {
+                    "description": "確定した金額 (確定した場合)",
+                    "type": "number",
+                    "example": 800
+                },
+                "createdAt": {
+                    "description": "作成日時",
+                    "type": "string",
+                    "example": "2026-10-17T09:00:00Z"
+                },
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "expiresAt": {
+                    "description": "有効期限",
+                    "type": "string",
+                    "example": "2026-10-24T09:00:00Z"
+                },
+                "id": {
+                    "description": "仮押さえID",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9H34"
+                },
+                "receiverAccountId": {
+                    "description": "受取口座ID (確定時に振込を行う場合)",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9D87"
+                },
+                "status": {
+                    "description": "状態 (AUTHORIZED, CAPTURED, VOIDED, EXPIRED)",
+                    "type": "string",
+                    "example": "AUTHORIZED"
+                },
+                "transactionId": {
+                    "description": "確定時に行った取引ID (確定した場合)",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9E89"
+                },
+                "updatedAt": {
+                    "description": "更新日時",
+                    "type": "string",
+                    "example": "2026-10-17T09:00:00Z"
+                }
+            }
+        },
+        "holds.ListHoldsResponse": {
+            "type": "object",
+            "properties": {
+                "holds": {
+                    "description": "仮押さえ一覧",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/holds.HoldResponse"
+                    }
+                }
+            }
+        },
+        "jwks.JSONWebKeyResponse": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "description": "署名アルゴリズム (RS256 または EdDSA)",
+                    "type": "string",
+                    "example": "EdDSA"
+                },
+                "crv": {
+                    "description": "楕円曲線の名前",
+                    "type": "string",
+                    "example": "Ed25519"
+                },
+                "e": {
+                    "description": "RSA 鍵の公開指数",
+                    "type": "string"
+                },
+                "kid": {
+                    "description": "鍵のID。アクセストークンのヘッダーの kid と一致する鍵で検証します。",
+                    "type": "string",
+                    "example": "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k"
+                },
+                "kty": {
+                    "description": "鍵の種類 (RSA または OKP)",
+                    "type": "string",
+                    "example": "OKP"
+                },
+                "n": {
+                    "description": "RSA 鍵のモジュラス",
+                    "type": "string"
+                },
+                "use": {
+                    "description": "鍵の用途",
+                    "type": "string",
+                    "example": "sig"
+                },
+                "x": {
+                    "description": "Ed25519 の公開鍵",
+                    "type": "string",
+                    "example": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"
+                }
+            }
+        },
+        "jwks.JWKSResponse": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "description": "アクセストークンの検証に使用できる公開鍵の一覧",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jwks.JSONWebKeyResponse"
+                    }
+                }
+            }
+        },
+        "limits.AccountLimitResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "default": {
+                    "description": "既定の上限 (設定されていない場合は null)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/limits.LimitsResponse"
+                        }
+                    ]
+                },
+                "effective": {
+                    "description": "口座に適用される上限 (上限がない場合は null)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/limits.LimitsResponse"
+                        }
+                    ]
+                },
+                "operationType": {
+                    "description": "取引種別 (WITHDRAWAL, TRANSFER)",
+                    "type": "string",
+                    "example": "WITHDRAWAL"
+                }
+            }
+        },
+        "limits.LimitsResponse": {
+            "type": "object",
+            "properties": {
+                "daily": {
+                    "description": "直近24時間の合計の上限",
+                    "type": "number",
+                    "example": 1000000
+                },
+                "monthly": {
+                    "description": "当月の合計の上限",
+                    "type": "number",
+                    "example": 5000000
+                },
+                "perTransaction": {
+                    "description": "1回の取引の上限",
+                    "type": "number",
+                    "example": 500000
+                }
+            }
+        },
+        "limits.ListAccountLimitsResponse": {
+            "type": "object",
+            "properties": {
+                "limits": {
+                    "description": "取引種別ごとの上限",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/limits.AccountLimitResponse"
+                    }
+                }
+            }
+        },
+        "limits.SetAccountLimitRequestBody": {
+            "type": "object",
+            "properties": {
+                "daily": {
+                    "description": "直近24時間の合計の上限 (口座の通貨)",
+                    "type": "number",
+                    "example": 300000
+                },
+                "monthly": {
+                    "description": "当月の合計の上限 (口座の通貨)",
+                    "type": "number",
+                    "example": 1000000
+                },
+                "password": {
+                    "description": "口座パスワード",
+                    "type": "string",
+                    "example": "1234"
+                },
+                "perTransaction": {
+                    "description": "1回の取引の上限 (口座の通貨)",
+                    "type": "number",
+                    "example": 100000
+                }
+            }
+        },
+        "me.ReadMyProfileResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "ユーザーのメールアドレス",
+                    "type": "string",
+                    "example": "sato@example.com"
+                },
+                "id": {
+                    "description": "ユーザーのID",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C8FW"
+                },
+                "name": {
+                    "description": "ユーザーの名前",
+                    "type": "string",
+                    "example": "Sato Taro"
+                }
+            }
+        },
+        "payees.ListPayeesResponse": {
+            "type": "object",
+            "properties": {
+                "payees": {
+                    "description": "支払先の一覧 (登録日時の昇順)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/payees.PayeeResponse"
+                    }
+                }
+            }
+        },
+        "payees.PayeeResponse": {
+            "type": "object",
+            "properties": {
+                "confirmed": {
+                    "description": "確認済みかどうか (確認済みの支払先にのみ支払先IDで振込ができます)",
+                    "type": "boolean",
+                    "example": true
+                },
+                "confirmedAt": {
+                    "description": "確認日時 (確認済みの場合)",
+                    "type": "string",
+                    "example": "2026-10-17T09:05:00Z"
+                },
+                "createdAt": {
+                    "description": "登録日時",
+                    "type": "string",
+                    "example": "2026-10-17T09:00:00Z"
+                },
+                "id": {
+                    "description": "支払先ID",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9P12"
+                },
+                "maskedName": {
+                    "description": "支払先の利用者の名前 (各単語の先頭の1文字以外を伏せた名前)",
+                    "type": "string",
+                    "example": "S*** T***"
+                },
+                "nickname": {
+                    "description": "ニックネーム",
+                    "type": "string",
+                    "example": "Landlord"
+                }
+            }
+        },
+        "payees.RegisterPayeeRequestBody": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "支払先の利用者のメールアドレス",
+                    "type": "string",
+                    "example": "sato@example.com"
+                },
+                "nickname": {
+                    "description": "ニックネーム (最大30文字)",
+                    "type": "string",
+                    "example": "Landlord"
+                }
+            }
+        },
+        "receivingaccounts.ListReceivingAccountsResponse": {
+            "type": "object",
+            "properties": {
+                "receivingAccounts": {
+                    "description": "通貨ごとの受取口座 (通貨コードの昇順)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/receivingaccounts.ReceivingAccountResponse"
+                    }
+                }
+            }
+        },
+        "receivingaccounts.ReceivingAccountResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "description": "受取口座ID",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C8FW"
+                },
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "updatedAt": {
+                    "description": "更新日時",
+                    "type": "string",
+                    "example": "2026-10-17T09:00:00Z"
+                }
+            }
+        },
+        "receivingaccounts.SetReceivingAccountRequestBody": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "description": "受取口座ID (指定した通貨の自分の口座)",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C8FW"
+                }
+            }
+        },
+        "response.ProblemDetail": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "Error detail message"
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/path/to/resource"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Error title"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "https://example.com/probs/error-title"
+                }
+            }
+        },
+        "response.ValidationError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "field name"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "error message"
+                }
+            }
+        },
+        "response.ValidationProblemDetail": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "Error detail message"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ValidationError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/path/to/resource"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Error title"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "https://example.com/probs/error-title"
                 }
             }
-        }
-    },
-    "definitions": {
-        "accounts.CreateAccountRequestBody": {
+        },
+        "signin.SigninRequest": {
             "type": "object",
             "properties": {
-                "currency": {
-                    "description": "通貨（JPY または USD）",
-                    "type": "string",
-                    "example": "JPY"
-                },
-                "name": {
-                    "description": "3 ～ 20 文字のアカウント名",
+                "email": {
+                    "description": "ログイン用メールアドレス",
                     "type": "string",
-                    "example": "For work"
+                    "example": "sato@example.com"
                 },
                 "password": {
-                    "description": "4 桁のパスワード",
+                    "description": "ログイン用パスワード",
                     "type": "string",
-                    "example": "1234"
+                    "example": "password"
                 }
             }
         },
-        "accounts.CreateAccountResponse": {
+        "signin.SigninResponse": {
             "type": "object",
             "properties": {
-                "balance": {
-                    "description": "口座残高",
-                    "type": "number",
-                    "example": 0
-                },
-                "currency": {
-                    "description": "通貨",
+                "accessToken": {
+                    "description": "アクセストークン",
                     "type": "string",
-                    "example": "JPY"
+                    "example": "eyJhb..."
                 },
-                "id": {
-                    "description": "口座ID",
+                "refreshToken": {
+                    "description": "アクセストークンの再発行に使用するリフレッシュトークン",
                     "type": "string",
-                    "example": "01J9R7YPV1FH1V0PPKVSB5C7LE"
+                    "example": "Jq3vT0hS..."
+                }
+            }
+        },
+        "signup.SignupRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "ログイン用メールアドレス",
+                    "type": "string",
+                    "example": "sato@example.com"
                 },
                 "name": {
-                    "description": "口座名",
+                    "description": "3 ～ 20 文字のユーザー名",
                     "type": "string",
-                    "example": "For work"
+                    "example": "Sato Taro"
                 },
-                "updatedAt": {
-                    "description": "口座の更新日時",
+                "password": {
+                    "description": "8 ～ 20 文字のログイン用パスワード",
                     "type": "string",
-                    "example": "2021-08-01T00:00:00Z"
+                    "example": "password"
                 }
             }
         },
-        "me.ReadMyProfileResponse": {
+        "signup.SignupResponse": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "description": "アクセストークン",
+                    "type": "string",
+                    "example": "eyJhb..."
+                },
+                "refreshToken": {
+                    "description": "アクセストークンの再発行に使用するリフレッシュトークン",
+                    "type": "string",
+                    "example": "Jq3vT0hS..."
+                },
+                "user": {
+                    "description": "ユーザー",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/signup.SignupResponseBodyUser"
+                        }
+                    ]
+                }
+            }
+        },
+        "signup.SignupResponseBodyUser": {
             "type": "object",
             "properties": {
                 "email": {
@@ -472,132 +3309,377 @@ const docTemplate = `{
                     "example": "sato@example.com"
                 },
                 "id": {
-                    "description": "ユーザーのID",
+                    "description": "ユーザーID",
                     "type": "string",
                     "example": "01J9R7YPV1FH1V0PPKVSB5C8FW"
                 },
                 "name": {
-                    "description": "ユーザーの名前",
+                    "description": "ユーザー名",
                     "type": "string",
                     "example": "Sato Taro"
                 }
             }
         },
-        "response.ProblemDetail": {
+        "standingorders.CreateStandingOrderRequestBody": {
             "type": "object",
             "properties": {
-                "detail": {
+                "amount": {
+                    "description": "振込金額 (通貨の小数点以下の桁数まで指定可能)",
+                    "type": "number",
+                    "example": 1000
+                },
+                "currency": {
+                    "description": "通貨 (口座と同じ通貨)",
                     "type": "string",
-                    "example": "Error detail message"
+                    "example": "JPY"
                 },
-                "instance": {
+                "dayOfMonth": {
+                    "description": "実行日 (MONTHLYの場合必須。指定日が存在しない月は月末日に実行)",
+                    "type": "integer",
+                    "example": 25
+                },
+                "endDate": {
+                    "description": "終了日 (YYYYMMDD)",
                     "type": "string",
-                    "example": "/path/to/resource"
+                    "example": "20271031"
                 },
-                "status": {
+                "failurePolicy": {
+                    "description": "再試行の上限に達した場合の方針 (SKIP, SUSPEND、デフォルト SKIP)",
+                    "type": "string",
+                    "example": "SKIP"
+                },
+                "frequency": {
+                    "description": "実行の間隔 (WEEKLY, MONTHLY)",
+                    "type": "string",
+                    "example": "MONTHLY"
+                },
+                "maxExecutions": {
+                    "description": "実行回数の上限",
                     "type": "integer",
-                    "example": 400
+                    "example": 12
                 },
-                "title": {
+                "maxRetries": {
+                    "description": "1回の実行日あたりの再試行回数 (0 ～ 5、デフォルト 3)",
+                    "type": "integer",
+                    "example": 3
+                },
+                "password": {
+                    "description": "口座パスワード",
                     "type": "string",
-                    "example": "Error title"
+                    "example": "1234"
                 },
-                "type": {
+                "receiverAccountId": {
+                    "description": "受取口座ID",
                     "type": "string",
-                    "example": "https://example.com/probs/error-title"
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9D87"
+                },
+                "startDate": {
+                    "description": "開始日 (YYYYMMDD)",
+                    "type": "string",
+                    "example": "20261101"
                 }
             }
         },
-        "response.ValidationError": {
+        "standingorders.ExecutionResponse": {
             "type": "object",
             "properties": {
-                "field": {
+                "attempt": {
+                    "description": "実行日に対する何回目の試行か",
+                    "type": "integer",
+                    "example": 1
+                },
+                "executedAt": {
+                    "description": "実行日時",
                     "type": "string",
-                    "example": "field name"
+                    "example": "2026-11-25T00:01:00Z"
                 },
-                "message": {
+                "failureReason": {
+                    "description": "失敗した理由 (SUCCEEDED以外の場合)",
                     "type": "string",
-                    "example": "error message"
+                    "example": "insufficient balance"
+                },
+                "result": {
+                    "description": "結果 (SUCCEEDED, RETRY_SCHEDULED, SKIPPED, SUSPENDED)",
+                    "type": "string",
+                    "example": "SUCCEEDED"
+                },
+                "scheduledDate": {
+                    "description": "実行日",
+                    "type": "string",
+                    "example": "20261125"
+                },
+                "transactionId": {
+                    "description": "取引ID (SUCCEEDEDの場合)",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9E89"
                 }
             }
         },
-        "response.ValidationProblemDetail": {
+        "standingorders.ListStandingOrdersResponse": {
             "type": "object",
             "properties": {
-                "detail": {
+                "standingOrders": {
+                    "description": "自動振込一覧",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/standingorders.StandingOrderResponse"
+                    }
+                }
+            }
+        },
+        "standingorders.ReadStandingOrderResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "description": "口座ID",
                     "type": "string",
-                    "example": "Error detail message"
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C8FW"
                 },
-                "errors": {
+                "amount": {
+                    "description": "振込金額",
+                    "type": "number",
+                    "example": 1000
+                },
+                "createdAt": {
+                    "description": "作成日時",
+                    "type": "string",
+                    "example": "2026-10-17T09:00:00Z"
+                },
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "dayOfMonth": {
+                    "description": "実行日 (MONTHLYの場合)",
+                    "type": "integer",
+                    "example": 25
+                },
+                "endDate": {
+                    "description": "終了日",
+                    "type": "string",
+                    "example": "20271031"
+                },
+                "executionCount": {
+                    "description": "振込に成功した回数",
+                    "type": "integer",
+                    "example": 0
+                },
+                "failurePolicy": {
+                    "description": "再試行の上限に達した場合の方針 (SKIP, SUSPEND)",
+                    "type": "string",
+                    "example": "SKIP"
+                },
+                "frequency": {
+                    "description": "実行の間隔 (WEEKLY, MONTHLY)",
+                    "type": "string",
+                    "example": "MONTHLY"
+                },
+                "id": {
+                    "description": "自動振込ID",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9F12"
+                },
+                "maxExecutions": {
+                    "description": "実行回数の上限",
+                    "type": "integer",
+                    "example": 12
+                },
+                "maxRetries": {
+                    "description": "1回の実行日あたりの再試行回数",
+                    "type": "integer",
+                    "example": 3
+                },
+                "nextAttemptDate": {
+                    "description": "次に実行を試みる日付 (失敗した場合は実行日より後になります)",
+                    "type": "string",
+                    "example": "20261125"
+                },
+                "nextRunDate": {
+                    "description": "次に実行する回の実行日",
+                    "type": "string",
+                    "example": "20261125"
+                },
+                "receiverAccountId": {
+                    "description": "受取口座ID",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9D87"
+                },
+                "recentExecutions": {
+                    "description": "直近の実行履歴 (新しい順)",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.ValidationError"
+                        "$ref": "#/definitions/standingorders.ExecutionResponse"
                     }
                 },
-                "instance": {
+                "retryCount": {
+                    "description": "次に実行する回で失敗した回数",
+                    "type": "integer",
+                    "example": 0
+                },
+                "startDate": {
+                    "description": "開始日",
                     "type": "string",
-                    "example": "/path/to/resource"
+                    "example": "20261101"
+                },
+                "status": {
+                    "description": "状態 (ACTIVE, PAUSED, SUSPENDED, COMPLETED, CANCELLED)",
+                    "type": "string",
+                    "example": "ACTIVE"
+                },
+                "updatedAt": {
+                    "description": "更新日時",
+                    "type": "string",
+                    "example": "2026-10-17T09:00:00Z"
+                }
+            }
+        },
+        "standingorders.StandingOrderResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "description": "口座ID",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C8FW"
+                },
+                "amount": {
+                    "description": "振込金額",
+                    "type": "number",
+                    "example": 1000
+                },
+                "createdAt": {
+                    "description": "作成日時",
+                    "type": "string",
+                    "example": "2026-10-17T09:00:00Z"
+                },
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "dayOfMonth": {
+                    "description": "実行日 (MONTHLYの場合)",
+                    "type": "integer",
+                    "example": 25
+                },
+                "endDate": {
+                    "description": "終了日",
+                    "type": "string",
+                    "example": "20271031"
+                },
+                "executionCount": {
+                    "description": "振込に成功した回数",
+                    "type": "integer",
+                    "example": 0
+                },
+                "failurePolicy": {
+                    "description": "再試行の上限に達した場合の方針 (SKIP, SUSPEND)",
+                    "type": "string",
+                    "example": "SKIP"
+                },
+                "frequency": {
+                    "description": "実行の間隔 (WEEKLY, MONTHLY)",
+                    "type": "string",
+                    "example": "MONTHLY"
+                },
+                "id": {
+                    "description": "自動振込ID",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9F12"
+                },
+                "maxExecutions": {
+                    "description": "実行回数の上限",
+                    "type": "integer",
+                    "example": 12
+                },
+                "maxRetries": {
+                    "description": "1回の実行日あたりの再試行回数",
+                    "type": "integer",
+                    "example": 3
+                },
+                "nextAttemptDate": {
+                    "description": "次に実行を試みる日付 (失敗した場合は実行日より後になります)",
+                    "type": "string",
+                    "example": "20261125"
+                },
+                "nextRunDate": {
+                    "description": "次に実行する回の実行日",
+                    "type": "string",
+                    "example": "20261125"
+                },
+                "receiverAccountId": {
+                    "description": "受取口座ID",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9D87"
+                },
+                "retryCount": {
+                    "description": "次に実行する回で失敗した回数",
+                    "type": "integer",
+                    "example": 0
+                },
+                "startDate": {
+                    "description": "開始日",
+                    "type": "string",
+                    "example": "20261101"
                 },
                 "status": {
-                    "type": "integer",
-                    "example": 400
-                },
-                "title": {
+                    "description": "状態 (ACTIVE, PAUSED, SUSPENDED, COMPLETED, CANCELLED)",
                     "type": "string",
-                    "example": "Error title"
+                    "example": "ACTIVE"
                 },
-                "type": {
+                "updatedAt": {
+                    "description": "更新日時",
                     "type": "string",
-                    "example": "https://example.com/probs/error-title"
+                    "example": "2026-10-17T09:00:00Z"
                 }
             }
         },
-        "signin.SigninRequest": {
+        "standingorders.UpdateStandingOrderRequestBody": {
             "type": "object",
             "properties": {
-                "email": {
-                    "description": "ログイン用メールアドレス",
+                "amount": {
+                    "description": "振込金額 (自動振込の通貨で指定)",
+                    "type": "number",
+                    "example": 2000
+                },
+                "endDate": {
+                    "description": "終了日 (YYYYMMDD)",
                     "type": "string",
-                    "example": "sato@example.com"
+                    "example": "20271031"
                 },
-                "password": {
-                    "description": "ログイン用パスワード",
+                "failurePolicy": {
+                    "description": "再試行の上限に達した場合の方針 (SKIP, SUSPEND)",
                     "type": "string",
-                    "example": "password"
-                }
-            }
-        },
-        "signin.SigninResponse": {
-            "type": "object",
-            "properties": {
-                "accessToken": {
-                    "description": "アクセストークン",
+                    "example": "SUSPEND"
+                },
+                "maxExecutions": {
+                    "description": "実行回数の上限",
+                    "type": "integer",
+                    "example": 12
+                },
+                "maxRetries": {
+                    "description": "1回の実行日あたりの再試行回数 (0 ～ 5)",
+                    "type": "integer",
+                    "example": 3
+                },
+                "status": {
+                    "description": "状態 (ACTIVE で再開、PAUSED で一時停止)",
                     "type": "string",
-                    "example": "eyJhb..."
+                    "example": "PAUSED"
                 }
             }
         },
-        "signup.SignupRequest": {
+        "token.RefreshTokenRequest": {
             "type": "object",
             "properties": {
-                "email": {
-                    "description": "ログイン用メールアドレス",
-                    "type": "string",
-                    "example": "sato@example.com"
-                },
-                "name": {
-                    "description": "3 ～ 20 文字のユーザー名",
-                    "type": "string",
-                    "example": "Sato Taro"
-                },
-                "password": {
-                    "description": "8 ～ 20 文字のログイン用パスワード",
+                "refreshToken": {
+                    "description": "サインイン時または前回の再発行時に発行されたリフレッシュトークン",
                     "type": "string",
-                    "example": "password"
+                    "example": "Jq3vT0hS..."
                 }
             }
         },
-        "signup.SignupResponse": {
+        "token.RefreshTokenResponse": {
             "type": "object",
             "properties": {
                 "accessToken": {
@@ -605,33 +3687,26 @@ const docTemplate = `{
                     "type": "string",
                     "example": "eyJhb..."
                 },
-                "user": {
-                    "description": "ユーザー",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/signup.SignupResponseBodyUser"
-                        }
-                    ]
+                "refreshToken": {
+                    "description": "新しいリフレッシュトークン。リクエストで使用したリフレッシュトークンは使用済みになります。",
+                    "type": "string",
+                    "example": "Jq3vT0hS..."
                 }
             }
         },
-        "signup.SignupResponseBodyUser": {
+        "transactions.CategorizeTransactionRequestBody": {
             "type": "object",
             "properties": {
-                "email": {
-                    "description": "ユーザーのメールアドレス",
-                    "type": "string",
-                    "example": "sato@example.com"
-                },
-                "id": {
-                    "description": "ユーザーID",
-                    "type": "string",
-                    "example": "01J9R7YPV1FH1V0PPKVSB5C8FW"
-                },
-                "name": {
-                    "description": "ユーザー名",
-                    "type": "string",
-                    "example": "Sato Taro"
+                "categories": {
+                    "description": "分類 (最大10個、1つあたり最大30文字、カンマは使用不可 空の配列の場合は分類を全て外します)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "food",
+                        "travel"
+                    ]
                 }
             }
         },
@@ -639,15 +3714,20 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "amount": {
-                    "description": "取引金額",
+                    "description": "取引金額 (通貨の小数点以下の桁数まで指定可能)",
                     "type": "number",
                     "example": 1000
                 },
                 "currency": {
-                    "description": "通貨 （JPY, USD)",
+                    "description": "通貨 （通貨マスタに登録されている ISO 4217 通貨コード)",
                     "type": "string",
                     "example": "JPY"
                 },
+                "memo": {
+                    "description": "メモ (最大200文字、取引を行った口座のみ参照可能)",
+                    "type": "string",
+                    "example": "Lunch with team"
+                },
                 "operationType": {
                     "description": "取引種別 （DEPOSIT, WITHDRAWAL, TRANSFER)",
                     "type": "string",
@@ -658,10 +3738,20 @@ const docTemplate = `{
                     "type": "string",
                     "example": "1234"
                 },
+                "payeeId": {
+                    "description": "支払先ID (TRANSFERの場合、確認済みの支払先が取引の通貨で受け取る既定の口座に振り込みます)",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9P12"
+                },
                 "receiverAccountId": {
-                    "description": "受取口座ID (TRANSFERの場合必須)",
+                    "description": "受取口座ID (TRANSFERの場合、受取口座IDと支払先IDのいずれか一方が必須)",
                     "type": "string",
                     "example": "01J9R8AJ1Q2YDH1X9836GS9D87"
+                },
+                "reference": {
+                    "description": "参照情報 (最大140文字、TRANSFERの場合は受取口座からも参照可能)",
+                    "type": "string",
+                    "example": "INV-2024-0001"
                 }
             }
         },
@@ -683,11 +3773,21 @@ const docTemplate = `{
                     "type": "string",
                     "example": "JPY"
                 },
+                "exchangeRate": {
+                    "description": "為替レート (通貨が異なるTRANSFERの場合、送金通貨1単位あたりの受取通貨の価格)",
+                    "type": "number",
+                    "example": 0.006667
+                },
                 "id": {
                     "description": "取引ID",
                     "type": "string",
                     "example": "01J9R8AJ1Q2YDH1X9836GS9E89"
                 },
+                "memo": {
+                    "description": "メモ",
+                    "type": "string",
+                    "example": "Lunch with team"
+                },
                 "operationType": {
                     "description": "取引種別",
                     "type": "string",
@@ -698,6 +3798,21 @@ const docTemplate = `{
                     "type": "string",
                     "example": "01J9R8AJ1Q2YDH1X9836GS9D87"
                 },
+                "receiverAmount": {
+                    "description": "受取金額 (TRANSFERの場合、受取口座の通貨での入金額)",
+                    "type": "number",
+                    "example": 6.67
+                },
+                "receiverCurrency": {
+                    "description": "受取通貨 (TRANSFERの場合)",
+                    "type": "string",
+                    "example": "USD"
+                },
+                "reference": {
+                    "description": "参照情報",
+                    "type": "string",
+                    "example": "INV-2024-0001"
+                },
                 "transactionAt": {
                     "description": "取引日時",
                     "type": "string",
@@ -705,11 +3820,34 @@ const docTemplate = `{
                 }
             }
         },
+        "transactions.ListTransactionsLinks": {
+            "type": "object",
+            "properties": {
+                "next": {
+                    "description": "次のページのURL (次のページがない場合は null)",
+                    "type": "string",
+                    "example": "/api/v1/me/accounts/01J9R7YPV1FH1V0PPKVSB5C8FW/transactions?cursor=eyJhdCI6IjIwMjQtMDMtMjBUMTU6MDA6MDBaIiwiaWQiOiIwMUo5UjhBSjFRMllESDFYOTgzNkdTOUU4OSIsImRpciI6Im5leHQifQ\u0026limit=10"
+                },
+                "prev": {
+                    "description": "前のページのURL (前のページがない場合は null)",
+                    "type": "string",
+                    "example": "/api/v1/me/accounts/01J9R7YPV1FH1V0PPKVSB5C8FW/transactions?cursor=eyJhdCI6IjIwMjQtMDMtMjBUMTU6MDA6MDBaIiwiaWQiOiIwMUo5UjhBSjFRMllESDFYOTgzNkdTOUU4OSIsImRpciI6InByZXYifQ\u0026limit=10"
+                }
+            }
+        },
         "transactions.ListTransactionsResponse": {
             "type": "object",
             "properties": {
+                "links": {
+                    "description": "前後のページへのリンク",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/transactions.ListTransactionsLinks"
+                        }
+                    ]
+                },
                 "total": {
-                    "description": "取引件数",
+                    "description": "取引件数 (cursor を指定した場合は件数を数えない為、含まれません)",
                     "type": "integer",
                     "example": 1
                 },
@@ -735,16 +3873,143 @@ const docTemplate = `{
                     "type": "number",
                     "example": 1000
                 },
+                "balanceAfter": {
+                    "description": "取引後の口座残高 (残高の記録を始める前の取引の場合は null)",
+                    "type": "number",
+                    "example": 1000
+                },
+                "categories": {
+                    "description": "口座が取引に付けた分類",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "food",
+                        "travel"
+                    ]
+                },
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "direction": {
+                    "description": "口座から見た取引の向き (入金と受け取った振込は CREDIT、出金と送金した振込は DEBIT、REVERSALは取り消した取引と逆の向き)",
+                    "type": "string",
+                    "example": "CREDIT"
+                },
+                "exchangeRate": {
+                    "description": "為替レート (通貨が異なるTRANSFERの場合、送金通貨1単位あたりの受取通貨の価格)",
+                    "type": "number",
+                    "example": 0.006667
+                },
+                "id": {
+                    "description": "取引ID",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9E89"
+                },
+                "memo": {
+                    "description": "メモ (取引を行った口座の場合のみ)",
+                    "type": "string",
+                    "example": "Lunch with team"
+                },
+                "operationType": {
+                    "description": "取引種別",
+                    "type": "string",
+                    "example": "DEPOSIT"
+                },
+                "receiverAccountId": {
+                    "description": "受取口座ID",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9D87"
+                },
+                "receiverAmount": {
+                    "description": "受取金額 (TRANSFERの場合、受取口座の通貨での入金額)",
+                    "type": "number",
+                    "example": 6.67
+                },
+                "receiverCurrency": {
+                    "description": "受取通貨 (TRANSFERの場合)",
+                    "type": "string",
+                    "example": "USD"
+                },
+                "reference": {
+                    "description": "参照情報",
+                    "type": "string",
+                    "example": "INV-2024-0001"
+                },
+                "reversedTransactionId": {
+                    "description": "取り消した取引ID (REVERSALの場合)",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9E88"
+                },
+                "signedAmount": {
+                    "description": "口座の通貨での符号付きの増減額 (DEBIT の場合は負の値)",
+                    "type": "number",
+                    "example": 1000
+                },
+                "transactionAt": {
+                    "description": "取引日時",
+                    "type": "string",
+                    "example": "2024-03-20T15:00:00Z"
+                }
+            }
+        },
+        "transactions.ReadTransactionResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "description": "口座ID",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C8FW"
+                },
+                "amount": {
+                    "description": "取引金額",
+                    "type": "number",
+                    "example": 1000
+                },
+                "balanceAfter": {
+                    "description": "取引後の口座残高 (残高の記録を始める前の取引の場合は null)",
+                    "type": "number",
+                    "example": 1000
+                },
+                "categories": {
+                    "description": "口座が取引に付けた分類",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "food",
+                        "travel"
+                    ]
+                },
                 "currency": {
                     "description": "通貨",
                     "type": "string",
                     "example": "JPY"
                 },
+                "direction": {
+                    "description": "口座から見た取引の向き (入金と受け取った振込は CREDIT、出金と送金した振込は DEBIT、REVERSALは取り消した取引と逆の向き)",
+                    "type": "string",
+                    "example": "CREDIT"
+                },
+                "exchangeRate": {
+                    "description": "為替レート (通貨が異なるTRANSFERの場合、送金通貨1単位あたりの受取通貨の価格)",
+                    "type": "number",
+                    "example": 0.006667
+                },
                 "id": {
                     "description": "取引ID",
                     "type": "string",
                     "example": "01J9R8AJ1Q2YDH1X9836GS9E89"
                 },
+                "memo": {
+                    "description": "メモ (取引を行った口座の場合のみ)",
+                    "type": "string",
+                    "example": "Lunch with team"
+                },
                 "operationType": {
                     "description": "取引種別",
                     "type": "string",
@@ -755,6 +4020,121 @@ const docTemplate = `{
                     "type": "string",
                     "example": "01J9R8AJ1Q2YDH1X9836GS9D87"
                 },
+                "receiverAmount": {
+                    "description": "受取金額 (TRANSFERの場合、受取口座の通貨での入金額)",
+                    "type": "number",
+                    "example": 6.67
+                },
+                "receiverCurrency": {
+                    "description": "受取通貨 (TRANSFERの場合)",
+                    "type": "string",
+                    "example": "USD"
+                },
+                "reference": {
+                    "description": "参照情報",
+                    "type": "string",
+                    "example": "INV-2024-0001"
+                },
+                "reversedTransactionId": {
+                    "description": "取り消した取引ID (REVERSALの場合)",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9E88"
+                },
+                "signedAmount": {
+                    "description": "口座の通貨での符号付きの増減額 (DEBIT の場合は負の値)",
+                    "type": "number",
+                    "example": 1000
+                },
+                "transactionAt": {
+                    "description": "取引日時",
+                    "type": "string",
+                    "example": "2024-03-20T15:00:00Z"
+                }
+            }
+        },
+        "transactions.ReverseTransactionRequestBody": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "description": "口座パスワード",
+                    "type": "string",
+                    "example": "1234"
+                }
+            }
+        },
+        "transactions.ReverseTransactionResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "description": "口座ID",
+                    "type": "string",
+                    "example": "01J9R7YPV1FH1V0PPKVSB5C8FW"
+                },
+                "amount": {
+                    "description": "取引金額",
+                    "type": "number",
+                    "example": 1000
+                },
+                "balanceAfter": {
+                    "description": "取消後の口座残高",
+                    "type": "number",
+                    "example": 1000
+                },
+                "currency": {
+                    "description": "通貨",
+                    "type": "string",
+                    "example": "JPY"
+                },
+                "direction": {
+                    "description": "口座から見た取引の向き (取り消した取引と逆の向き)",
+                    "type": "string",
+                    "example": "CREDIT"
+                },
+                "exchangeRate": {
+                    "description": "為替レート (取り消した振込に適用した為替レート)",
+                    "type": "number",
+                    "example": 0.006667
+                },
+                "id": {
+                    "description": "取消の取引ID",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9E90"
+                },
+                "operationType": {
+                    "description": "取引種別 (REVERSAL)",
+                    "type": "string",
+                    "example": "REVERSAL"
+                },
+                "receiverAccountId": {
+                    "description": "受取口座ID (振込を取り消した場合、資金を戻した口座)",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9D87"
+                },
+                "receiverAmount": {
+                    "description": "受取金額 (振込を取り消した場合、受取口座から戻した金額)",
+                    "type": "number",
+                    "example": 6.67
+                },
+                "receiverCurrency": {
+                    "description": "受取通貨 (振込を取り消した場合)",
+                    "type": "string",
+                    "example": "USD"
+                },
+                "reference": {
+                    "description": "参照情報 (取り消した取引から引き継がれます)",
+                    "type": "string",
+                    "example": "INV-2024-0001"
+                },
+                "reversedTransactionId": {
+                    "description": "取り消した取引ID",
+                    "type": "string",
+                    "example": "01J9R8AJ1Q2YDH1X9836GS9E89"
+                },
+                "signedAmount": {
+                    "description": "口座の通貨での符号付きの増減額 (DEBIT の場合は負の値)",
+                    "type": "number",
+                    "example": 1000
+                },
                 "transactionAt": {
                     "description": "取引日時",
                     "type": "string",
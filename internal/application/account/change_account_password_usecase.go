@@ -0,0 +1,66 @@
+package account
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type IChangeAccountPasswordUsecase interface {
+	Run(ctx context.Context, cmd ChangeAccountPasswordCommand) error
+}
+
+type changeAccountPasswordUsecase struct {
+	accountRepo accountDomain.IAccountRepository
+	accountServ accountDomain.IAccountService
+	unitOfWork  unitofwork.IUnitOfWork
+}
+
+func NewChangeAccountPasswordUsecase(
+	accountRepository accountDomain.IAccountRepository,
+	accountService accountDomain.IAccountService,
+	unitOfWork unitofwork.IUnitOfWork,
+) IChangeAccountPasswordUsecase {
+	return &changeAccountPasswordUsecase{
+		accountRepo: accountRepository,
+		accountServ: accountService,
+		unitOfWork:  unitOfWork,
+	}
+}
+
+type ChangeAccountPasswordCommand struct {
+	UserID          string
+	AccountID       string
+	CurrentPassword string
+	NewPassword     string
+}
+
+// 現在のパスワードを確認した上で、口座のパスワードを変更します。
+func (u *changeAccountPasswordUsecase) Run(ctx context.Context, cmd ChangeAccountPasswordCommand) error {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return err
+	}
+
+	return u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, &cmd.CurrentPassword)
+		if err != nil {
+			return err
+		}
+
+		if err := account.ChangePassword(cmd.NewPassword); err != nil {
+			return err
+		}
+		account.ChangeUpdatedAt(timer.Now())
+
+		return u.accountRepo.Save(ctx, account)
+	})
+}
@@ -0,0 +1,131 @@
+package account_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	accountUC "github.com/u104rak1/pocgo/internal/application/account"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func TestChangeAccountPasswordUsecase(t *testing.T) {
+	type Mocks struct {
+		accountRepo *domainMock.MockIAccountRepository
+		accountServ *domainMock.MockIAccountService
+	}
+
+	var (
+		userID          = idVO.NewUserIDForTest("user")
+		accountID       = idVO.NewAccountIDForTest("account")
+		currentPassword = "1234"
+		newPassword     = "5678"
+		arg             = gomock.Any()
+	)
+
+	happyCmd := accountUC.ChangeAccountPasswordCommand{
+		UserID:          userID.String(),
+		AccountID:       accountID.String(),
+		CurrentPassword: currentPassword,
+		NewPassword:     newPassword,
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      accountUC.ChangeAccountPasswordCommand
+		prepare  func(mocks Mocks, account *accountDomain.Account)
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: パスワードの変更が成功する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &currentPassword).Return(account, nil)
+				mocks.accountRepo.EXPECT().Save(arg, account).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: ユーザーIDが不正な形式である",
+			cmd: accountUC.ChangeAccountPasswordCommand{
+				UserID:    "invalid",
+				AccountID: accountID.String(),
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座IDが不正な形式である",
+			cmd: accountUC.ChangeAccountPasswordCommand{
+				UserID:    userID.String(),
+				AccountID: "invalid",
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 現在のパスワードが一致しない",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &currentPassword).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 新しいパスワードが不正な為、変更に失敗する",
+			cmd: accountUC.ChangeAccountPasswordCommand{
+				UserID:          userID.String(),
+				AccountID:       accountID.String(),
+				CurrentPassword: currentPassword,
+				NewPassword:     "12345",
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &currentPassword).Return(account, nil)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座の保存に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &currentPassword).Return(account, nil)
+				mocks.accountRepo.EXPECT().Save(arg, account).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountRepo: domainMock.NewMockIAccountRepository(ctrl),
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+			}
+			mockUnitOfWork := &appMock.MockIUnitOfWork{}
+			account, err := accountDomain.New(userID, 0, "For work", currentPassword, moneyVO.JPY)
+			assert.NoError(t, err)
+
+			uc := accountUC.NewChangeAccountPasswordUsecase(mocks.accountRepo, mocks.accountServ, mockUnitOfWork)
+			ctx := context.Background()
+			tt.prepare(mocks, account)
+
+			err = uc.Run(ctx, tt.cmd)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.NoError(t, account.ComparePassword(newPassword))
+			}
+		})
+	}
+}
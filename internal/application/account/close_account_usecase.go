@@ -0,0 +1,62 @@
+package account
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type ICloseAccountUsecase interface {
+	Run(ctx context.Context, cmd CloseAccountCommand) error
+}
+
+type closeAccountUsecase struct {
+	accountRepo accountDomain.IAccountRepository
+	accountServ accountDomain.IAccountService
+	unitOfWork  unitofwork.IUnitOfWork
+}
+
+func NewCloseAccountUsecase(
+	accountRepository accountDomain.IAccountRepository,
+	accountService accountDomain.IAccountService,
+	unitOfWork unitofwork.IUnitOfWork,
+) ICloseAccountUsecase {
+	return &closeAccountUsecase{
+		accountRepo: accountRepository,
+		accountServ: accountService,
+		unitOfWork:  unitOfWork,
+	}
+}
+
+type CloseAccountCommand struct {
+	UserID    string
+	AccountID string
+}
+
+// 口座を解約（論理削除）します。残高が残っている口座は解約できません。
+func (u *closeAccountUsecase) Run(ctx context.Context, cmd CloseAccountCommand) error {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return err
+	}
+
+	return u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil)
+		if err != nil {
+			return err
+		}
+
+		if err := account.CheckClosable(); err != nil {
+			return err
+		}
+
+		return u.accountRepo.Delete(ctx, account)
+	})
+}
@@ -0,0 +1,123 @@
+package account_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	accountUC "github.com/u104rak1/pocgo/internal/application/account"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func TestCloseAccountUsecase(t *testing.T) {
+	type Mocks struct {
+		accountRepo *domainMock.MockIAccountRepository
+		accountServ *domainMock.MockIAccountService
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		arg       = gomock.Any()
+	)
+	emptyAccount, err := accountDomain.New(userID, 0, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	fundedAccount, err := accountDomain.New(userID, 1000, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+
+	happyCmd := accountUC.CloseAccountCommand{
+		UserID:    userID.String(),
+		AccountID: accountID.String(),
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      accountUC.CloseAccountCommand
+		prepare  func(mocks Mocks)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 口座の解約が成功する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(emptyAccount, nil)
+				mocks.accountRepo.EXPECT().Delete(arg, emptyAccount).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: ユーザーIDが不正な形式である",
+			cmd: accountUC.CloseAccountCommand{
+				UserID:    "invalid",
+				AccountID: accountID.String(),
+			},
+			prepare: func(mocks Mocks) {},
+			wantErr: idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: 口座IDが不正な形式である",
+			cmd: accountUC.CloseAccountCommand{
+				UserID:    userID.String(),
+				AccountID: "invalid",
+			},
+			prepare: func(mocks Mocks) {},
+			wantErr: idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: 口座の取得と認可に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrNotFound)
+			},
+			wantErr: accountDomain.ErrNotFound,
+		},
+		{
+			caseName: "Negative: 残高が残っている為、解約に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(fundedAccount, nil)
+			},
+			wantErr: accountDomain.ErrNonZeroBalance,
+		},
+		{
+			caseName: "Negative: 口座の削除に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(emptyAccount, nil)
+				mocks.accountRepo.EXPECT().Delete(arg, emptyAccount).Return(accountDomain.ErrConcurrentModification)
+			},
+			wantErr: accountDomain.ErrConcurrentModification,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountRepo: domainMock.NewMockIAccountRepository(ctrl),
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+			}
+			mockUnitOfWork := &appMock.MockIUnitOfWork{}
+
+			uc := accountUC.NewCloseAccountUsecase(mocks.accountRepo, mocks.accountServ, mockUnitOfWork)
+			ctx := context.Background()
+			tt.prepare(mocks)
+
+			err := uc.Run(ctx, tt.cmd)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
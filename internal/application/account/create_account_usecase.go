@@ -14,20 +14,17 @@ type ICreateAccountUsecase interface {
 }
 
 type createAccountUsecase struct {
-	accountRepo accountDomain.IAccountRepository
 	accountServ accountDomain.IAccountService
 	userServ    userDomain.IUserService
 	unitOfWork  unitofwork.IUnitOfWork
 }
 
 func NewCreateAccountUsecase(
-	accountRepository accountDomain.IAccountRepository,
 	accountService accountDomain.IAccountService,
 	userService userDomain.IUserService,
 	unitOfWork unitofwork.IUnitOfWork,
 ) ICreateAccountUsecase {
 	return &createAccountUsecase{
-		accountRepo: accountRepository,
 		accountServ: accountService,
 		userServ:    userService,
 		unitOfWork:  unitOfWork,
@@ -45,7 +42,7 @@ type CreateAccountDTO struct {
 	ID        string
 	UserID    string
 	Name      string
-	Balance   float64
+	Balance   string
 	Currency  string
 	UpdatedAt string
 }
@@ -56,7 +53,7 @@ func (u *createAccountUsecase) Run(ctx context.Context, cmd CreateAccountCommand
 		return nil, err
 	}
 
-	balance := 0.0
+	balance := int64(0)
 	account, err := accountDomain.New(
 		userID, balance, cmd.Name, cmd.Password, cmd.Currency,
 	)
@@ -73,11 +70,7 @@ func (u *createAccountUsecase) Run(ctx context.Context, cmd CreateAccountCommand
 			return err
 		}
 
-		if err = u.accountRepo.Save(ctx, account); err != nil {
-			return err
-		}
-
-		return nil
+		return u.accountServ.Open(ctx, account)
 	})
 	if err != nil {
 		return nil, err
@@ -87,7 +80,7 @@ func (u *createAccountUsecase) Run(ctx context.Context, cmd CreateAccountCommand
 		ID:        account.IDString(),
 		UserID:    account.UserIDString(),
 		Name:      account.Name(),
-		Balance:   account.Balance().Amount(),
+		Balance:   account.Balance().Decimal(),
 		Currency:  account.Balance().Currency(),
 		UpdatedAt: account.UpdatedAtString(),
 	}, nil
@@ -15,7 +15,6 @@ import (
 
 func TestCreateAccountUsecase(t *testing.T) {
 	type Mocks struct {
-		accountRepo *domainMock.MockIAccountRepository
 		accountServ *domainMock.MockIAccountService
 		userServ    *domainMock.MockIUserService
 	}
@@ -47,7 +46,7 @@ func TestCreateAccountUsecase(t *testing.T) {
 			prepare: func(mocks Mocks) {
 				mocks.userServ.EXPECT().EnsureUserExists(arg, arg).Return(nil)
 				mocks.accountServ.EXPECT().CheckLimit(arg, arg).Return(nil)
-				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.accountServ.EXPECT().Open(arg, arg).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -88,7 +87,7 @@ func TestCreateAccountUsecase(t *testing.T) {
 			prepare: func(mocks Mocks) {
 				mocks.userServ.EXPECT().EnsureUserExists(arg, arg).Return(nil)
 				mocks.accountServ.EXPECT().CheckLimit(arg, arg).Return(nil)
-				mocks.accountRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+				mocks.accountServ.EXPECT().Open(arg, arg).Return(assert.AnError)
 			},
 			wantErr: true,
 		},
@@ -101,14 +100,13 @@ func TestCreateAccountUsecase(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := Mocks{
-				accountRepo: domainMock.NewMockIAccountRepository(ctrl),
 				accountServ: domainMock.NewMockIAccountService(ctrl),
 				userServ:    domainMock.NewMockIUserService(ctrl),
 			}
 			mockUnitOfWork := &appMock.MockIUnitOfWork{}
 
 			uc := accountUC.NewCreateAccountUsecase(
-				mocks.accountRepo, mocks.accountServ, mocks.userServ, mockUnitOfWork,
+				mocks.accountServ, mocks.userServ, mockUnitOfWork,
 			)
 			ctx := context.Background()
 			tt.prepare(mocks)
@@ -124,7 +122,7 @@ func TestCreateAccountUsecase(t *testing.T) {
 				assert.NotEmpty(t, dto.ID)
 				assert.Equal(t, tt.cmd.UserID, dto.UserID)
 				assert.Equal(t, tt.cmd.Name, dto.Name)
-				assert.Equal(t, 0.0, dto.Balance)
+				assert.Equal(t, "0", dto.Balance)
 				assert.Equal(t, tt.cmd.Currency, dto.Currency)
 				assert.NotEmpty(t, dto.UpdatedAt)
 			}
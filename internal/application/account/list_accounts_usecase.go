@@ -0,0 +1,72 @@
+package account
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IListAccountsUsecase interface {
+	Run(ctx context.Context, cmd ListAccountsCommand) (*ListAccountsDTO, error)
+}
+
+type listAccountsUsecase struct {
+	accountRepo accountDomain.IAccountRepository
+}
+
+func NewListAccountsUsecase(accountRepository accountDomain.IAccountRepository) IListAccountsUsecase {
+	return &listAccountsUsecase{
+		accountRepo: accountRepository,
+	}
+}
+
+type ListAccountsCommand struct {
+	UserID string
+}
+
+type ListAccountsDTO struct {
+	Accounts []ListAccountDTO
+}
+
+type ListAccountDTO struct {
+	ID      string
+	UserID  string
+	Name    string
+	Balance string
+	// 残高から仮押さえ中の金額を引いた、出金や振込に利用できる金額です。
+	AvailableBalance string
+	HeldBalance      string
+	Currency         string
+	UpdatedAt        string
+}
+
+func (u *listAccountsUsecase) Run(ctx context.Context, cmd ListAccountsCommand) (*ListAccountsDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := u.accountRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountDTOs := make([]ListAccountDTO, len(accounts))
+	for i, account := range accounts {
+		accountDTOs[i] = ListAccountDTO{
+			ID:               account.IDString(),
+			UserID:           account.UserIDString(),
+			Name:             account.Name(),
+			Balance:          account.Balance().Decimal(),
+			AvailableBalance: account.AvailableBalance().Decimal(),
+			HeldBalance:      account.HeldBalance().Decimal(),
+			Currency:         account.Balance().Currency(),
+			UpdatedAt:        account.UpdatedAtString(),
+		}
+	}
+
+	return &ListAccountsDTO{
+		Accounts: accountDTOs,
+	}, nil
+}
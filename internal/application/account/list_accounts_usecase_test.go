@@ -0,0 +1,110 @@
+package account_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	accountUC "github.com/u104rak1/pocgo/internal/application/account"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func TestListAccountsUsecase(t *testing.T) {
+	type Mocks struct {
+		accountRepo *domainMock.MockIAccountRepository
+	}
+
+	var (
+		userID = idVO.NewUserIDForTest("user")
+		arg    = gomock.Any()
+	)
+	account, err := accountDomain.New(userID, 1000, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+
+	happyCmd := accountUC.ListAccountsCommand{
+		UserID: userID.String(),
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      accountUC.ListAccountsCommand
+		prepare  func(mocks Mocks)
+		wantLen  int
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 口座一覧の取得が成功する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.accountRepo.EXPECT().ListByUserID(arg, userID).Return([]*accountDomain.Account{account}, nil)
+			},
+			wantLen: 1,
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 口座が存在しない場合は空の一覧を返す",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.accountRepo.EXPECT().ListByUserID(arg, userID).Return([]*accountDomain.Account{}, nil)
+			},
+			wantLen: 0,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: ユーザーIDが不正な形式である",
+			cmd: accountUC.ListAccountsCommand{
+				UserID: "invalid",
+			},
+			prepare: func(mocks Mocks) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座一覧の取得に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.accountRepo.EXPECT().ListByUserID(arg, userID).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountRepo: domainMock.NewMockIAccountRepository(ctrl),
+			}
+
+			uc := accountUC.NewListAccountsUsecase(mocks.accountRepo)
+			ctx := context.Background()
+			tt.prepare(mocks)
+
+			dto, err := uc.Run(ctx, tt.cmd)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, dto.Accounts, tt.wantLen)
+				for _, a := range dto.Accounts {
+					assert.Equal(t, account.IDString(), a.ID)
+					assert.Equal(t, tt.cmd.UserID, a.UserID)
+					assert.Equal(t, account.Name(), a.Name)
+					assert.Equal(t, "1000", a.Balance)
+					assert.Equal(t, "1000", a.AvailableBalance)
+					assert.Equal(t, "0", a.HeldBalance)
+					assert.Equal(t, moneyVO.JPY, a.Currency)
+					assert.Equal(t, account.UpdatedAtString(), a.UpdatedAt)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package account
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IReadAccountUsecase interface {
+	Run(ctx context.Context, cmd ReadAccountCommand) (*ReadAccountDTO, error)
+}
+
+type readAccountUsecase struct {
+	accountServ accountDomain.IAccountService
+}
+
+func NewReadAccountUsecase(accountService accountDomain.IAccountService) IReadAccountUsecase {
+	return &readAccountUsecase{
+		accountServ: accountService,
+	}
+}
+
+type ReadAccountCommand struct {
+	UserID    string
+	AccountID string
+}
+
+type ReadAccountDTO struct {
+	ID      string
+	UserID  string
+	Name    string
+	Balance string
+	// 残高から仮押さえ中の金額を引いた、出金や振込に利用できる金額です。
+	AvailableBalance string
+	HeldBalance      string
+	Currency         string
+	UpdatedAt        string
+}
+
+func (u *readAccountUsecase) Run(ctx context.Context, cmd ReadAccountCommand) (*ReadAccountDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadAccountDTO{
+		ID:               account.IDString(),
+		UserID:           account.UserIDString(),
+		Name:             account.Name(),
+		Balance:          account.Balance().Decimal(),
+		AvailableBalance: account.AvailableBalance().Decimal(),
+		HeldBalance:      account.HeldBalance().Decimal(),
+		Currency:         account.Balance().Currency(),
+		UpdatedAt:        account.UpdatedAtString(),
+	}, nil
+}
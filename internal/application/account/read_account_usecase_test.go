@@ -0,0 +1,107 @@
+package account_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	accountUC "github.com/u104rak1/pocgo/internal/application/account"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func TestReadAccountUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ *domainMock.MockIAccountService
+	}
+
+	var (
+		userID = idVO.NewUserIDForTest("user")
+		arg    = gomock.Any()
+	)
+	account, err := accountDomain.New(userID, 1000, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+
+	happyCmd := accountUC.ReadAccountCommand{
+		UserID:    userID.String(),
+		AccountID: account.IDString(),
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      accountUC.ReadAccountCommand
+		prepare  func(mocks Mocks)
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 口座の取得が成功する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, account.ID(), &userID, nil).Return(account, nil)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: ユーザーIDが不正な形式である",
+			cmd: accountUC.ReadAccountCommand{
+				UserID:    "invalid",
+				AccountID: account.IDString(),
+			},
+			prepare: func(mocks Mocks) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座IDが不正な形式である",
+			cmd: accountUC.ReadAccountCommand{
+				UserID:    userID.String(),
+				AccountID: "invalid",
+			},
+			prepare: func(mocks Mocks) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座の取得と認可に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, account.ID(), &userID, nil).Return(nil, accountDomain.ErrNotFound)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+			}
+
+			uc := accountUC.NewReadAccountUsecase(mocks.accountServ)
+			ctx := context.Background()
+			tt.prepare(mocks)
+
+			dto, err := uc.Run(ctx, tt.cmd)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, account.IDString(), dto.ID)
+				assert.Equal(t, tt.cmd.UserID, dto.UserID)
+				assert.Equal(t, account.Name(), dto.Name)
+				assert.Equal(t, "1000", dto.Balance)
+				assert.Equal(t, "1000", dto.AvailableBalance)
+				assert.Equal(t, "0", dto.HeldBalance)
+				assert.Equal(t, moneyVO.JPY, dto.Currency)
+				assert.Equal(t, account.UpdatedAtString(), dto.UpdatedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package account
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type IUpdateAccountUsecase interface {
+	Run(ctx context.Context, cmd UpdateAccountCommand) (*UpdateAccountDTO, error)
+}
+
+type updateAccountUsecase struct {
+	accountRepo accountDomain.IAccountRepository
+	accountServ accountDomain.IAccountService
+	unitOfWork  unitofwork.IUnitOfWork
+}
+
+func NewUpdateAccountUsecase(
+	accountRepository accountDomain.IAccountRepository,
+	accountService accountDomain.IAccountService,
+	unitOfWork unitofwork.IUnitOfWork,
+) IUpdateAccountUsecase {
+	return &updateAccountUsecase{
+		accountRepo: accountRepository,
+		accountServ: accountService,
+		unitOfWork:  unitOfWork,
+	}
+}
+
+type UpdateAccountCommand struct {
+	UserID    string
+	AccountID string
+	Name      string
+}
+
+type UpdateAccountDTO struct {
+	ID        string
+	UserID    string
+	Name      string
+	Balance   string
+	Currency  string
+	UpdatedAt string
+}
+
+func (u *updateAccountUsecase) Run(ctx context.Context, cmd UpdateAccountCommand) (*UpdateAccountDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var account *accountDomain.Account
+	err = u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		account, err = u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil)
+		if err != nil {
+			return err
+		}
+
+		if err := account.ChangeName(cmd.Name); err != nil {
+			return err
+		}
+		account.ChangeUpdatedAt(timer.Now())
+
+		return u.accountRepo.Save(ctx, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateAccountDTO{
+		ID:        account.IDString(),
+		UserID:    account.UserIDString(),
+		Name:      account.Name(),
+		Balance:   account.Balance().Decimal(),
+		Currency:  account.Balance().Currency(),
+		UpdatedAt: account.UpdatedAtString(),
+	}, nil
+}
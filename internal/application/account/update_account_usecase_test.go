@@ -0,0 +1,136 @@
+package account_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	accountUC "github.com/u104rak1/pocgo/internal/application/account"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func TestUpdateAccountUsecase(t *testing.T) {
+	type Mocks struct {
+		accountRepo *domainMock.MockIAccountRepository
+		accountServ *domainMock.MockIAccountService
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		newName   = "For private"
+		arg       = gomock.Any()
+	)
+
+	happyCmd := accountUC.UpdateAccountCommand{
+		UserID:    userID.String(),
+		AccountID: accountID.String(),
+		Name:      newName,
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      accountUC.UpdateAccountCommand
+		prepare  func(mocks Mocks, account *accountDomain.Account)
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 口座名の変更が成功する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(account, nil)
+				mocks.accountRepo.EXPECT().Save(arg, account).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: ユーザーIDが不正な形式である",
+			cmd: accountUC.UpdateAccountCommand{
+				UserID:    "invalid",
+				AccountID: accountID.String(),
+				Name:      newName,
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座IDが不正な形式である",
+			cmd: accountUC.UpdateAccountCommand{
+				UserID:    userID.String(),
+				AccountID: "invalid",
+				Name:      newName,
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座の取得と認可に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座名が不正な為、変更に失敗する",
+			cmd: accountUC.UpdateAccountCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+				Name:      "ab",
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(account, nil)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座の保存に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(account, nil)
+				mocks.accountRepo.EXPECT().Save(arg, account).Return(accountDomain.ErrConcurrentModification)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountRepo: domainMock.NewMockIAccountRepository(ctrl),
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+			}
+			mockUnitOfWork := &appMock.MockIUnitOfWork{}
+			account, err := accountDomain.New(userID, 1000, "For work", "1234", moneyVO.JPY)
+			assert.NoError(t, err)
+
+			uc := accountUC.NewUpdateAccountUsecase(mocks.accountRepo, mocks.accountServ, mockUnitOfWork)
+			ctx := context.Background()
+			tt.prepare(mocks, account)
+
+			dto, err := uc.Run(ctx, tt.cmd)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, account.IDString(), dto.ID)
+				assert.Equal(t, tt.cmd.UserID, dto.UserID)
+				assert.Equal(t, newName, dto.Name)
+				assert.Equal(t, "1000", dto.Balance)
+				assert.Equal(t, moneyVO.JPY, dto.Currency)
+				assert.NotEmpty(t, dto.UpdatedAt)
+			}
+		})
+	}
+}
@@ -1,6 +1,28 @@
 package authentication
 
+// AccessTokenClaims はアクセストークンから取得できる情報です。
+type AccessTokenClaims struct {
+	UserID    string
+	SessionID string
+}
+
+// JSONWebKey はアクセストークンの検証に使用できる公開鍵です。(RFC 7517)
+// RSA 鍵の場合は N と E、Ed25519 鍵の場合は Crv と X が設定されます。
+type JSONWebKey struct {
+	Kty string
+	Kid string
+	Use string
+	Alg string
+	N   string
+	E   string
+	Crv string
+	X   string
+}
+
 type IJWTService interface {
-	GenerateAccessToken(userID string) (string, error)
-	GetUserIDFromAccessToken(accessToken string) (string, error)
+	// アクセストークンを生成します。sessionID にはリフレッシュトークンのファミリーを表すセッションIDを指定します。
+	GenerateAccessToken(userID, sessionID string) (string, error)
+	ParseAccessToken(accessToken string) (*AccessTokenClaims, error)
+	// 検証に使用できる公開鍵の一覧を返します。共通鍵で署名している場合は空になります。
+	PublicKeys() []JSONWebKey
 }
@@ -0,0 +1,31 @@
+package authentication
+
+import (
+	"context"
+)
+
+type IListPublicKeysUsecase interface {
+	Run(ctx context.Context) (*ListPublicKeysDTO, error)
+}
+
+type listPublicKeysUsecase struct {
+	jwtServ IJWTService
+}
+
+func NewListPublicKeysUsecase(jwtService IJWTService) IListPublicKeysUsecase {
+	return &listPublicKeysUsecase{
+		jwtServ: jwtService,
+	}
+}
+
+type ListPublicKeysDTO struct {
+	Keys []JSONWebKey
+}
+
+// アクセストークンの検証に使用できる公開鍵の一覧を返します。
+// 鍵のローテーション中は、新しい署名鍵に加えて以前の鍵も含まれます。
+func (u *listPublicKeysUsecase) Run(ctx context.Context) (*ListPublicKeysDTO, error) {
+	return &ListPublicKeysDTO{
+		Keys: u.jwtServ.PublicKeys(),
+	}, nil
+}
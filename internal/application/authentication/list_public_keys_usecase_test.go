@@ -0,0 +1,63 @@
+package authentication_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+)
+
+func TestListPublicKeysUsecase(t *testing.T) {
+	type Mocks struct {
+		jwtServ *appMock.MockIJWTService
+	}
+
+	keys := []authApp.JSONWebKey{
+		{Kty: "OKP", Kid: "kid1", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "x"},
+		{Kty: "RSA", Kid: "kid2", Use: "sig", Alg: "RS256", N: "n", E: "AQAB"},
+	}
+
+	tests := []struct {
+		caseName string
+		prepare  func(mocks Mocks)
+		expected *authApp.ListPublicKeysDTO
+	}{
+		{
+			caseName: "Positive: 公開鍵の一覧を取得できる",
+			prepare: func(mocks Mocks) {
+				mocks.jwtServ.EXPECT().PublicKeys().Return(keys)
+			},
+			expected: &authApp.ListPublicKeysDTO{Keys: keys},
+		},
+		{
+			caseName: "Positive: 共通鍵で署名している場合は空の一覧を返す",
+			prepare: func(mocks Mocks) {
+				mocks.jwtServ.EXPECT().PublicKeys().Return([]authApp.JSONWebKey{})
+			},
+			expected: &authApp.ListPublicKeysDTO{Keys: []authApp.JSONWebKey{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				jwtServ: appMock.NewMockIJWTService(ctrl),
+			}
+			uc := authApp.NewListPublicKeysUsecase(mocks.jwtServ)
+			ctx := context.Background()
+			tt.prepare(mocks)
+
+			dto, err := uc.Run(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, dto)
+		})
+	}
+}
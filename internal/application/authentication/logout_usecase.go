@@ -0,0 +1,35 @@
+package authentication
+
+import (
+	"context"
+
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type ILogoutUsecase interface {
+	Run(ctx context.Context, cmd LogoutCommand) error
+}
+
+type logoutUsecase struct {
+	sessionServ sessionDomain.ISessionService
+}
+
+func NewLogoutUsecase(sessionService sessionDomain.ISessionService) ILogoutUsecase {
+	return &logoutUsecase{
+		sessionServ: sessionService,
+	}
+}
+
+type LogoutCommand struct {
+	SessionID string
+}
+
+// セッションを失効させ、そのセッションで発行したアクセストークンとリフレッシュトークンを全て無効にします。
+func (u *logoutUsecase) Run(ctx context.Context, cmd LogoutCommand) error {
+	sessionID, err := idVO.SessionIDFromString(cmd.SessionID)
+	if err != nil {
+		return err
+	}
+	return u.sessionServ.Revoke(ctx, sessionID)
+}
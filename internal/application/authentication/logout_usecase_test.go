@@ -0,0 +1,81 @@
+package authentication_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestLogoutUsecase(t *testing.T) {
+	type Mocks struct {
+		sessionServ *domainMock.MockISessionService
+	}
+
+	var (
+		sessionID = idVO.NewSessionIDForTest("session")
+		arg       = gomock.Any()
+	)
+
+	happyCmd := authApp.LogoutCommand{
+		SessionID: sessionID.String(),
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      authApp.LogoutCommand
+		prepare  func(mocks Mocks)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: ログアウトが成功する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.sessionServ.EXPECT().Revoke(arg, sessionID).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: セッションIDが不正な場合はエラーが返る",
+			cmd:      authApp.LogoutCommand{SessionID: "invalid"},
+			prepare:  func(mocks Mocks) {},
+			wantErr:  idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: セッションの失効に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.sessionServ.EXPECT().Revoke(arg, arg).Return(sessionDomain.ErrNotFound)
+			},
+			wantErr: sessionDomain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				sessionServ: domainMock.NewMockISessionService(ctrl),
+			}
+			uc := authApp.NewLogoutUsecase(mocks.sessionServ)
+			ctx := context.Background()
+			tt.prepare(mocks)
+
+			err := uc.Run(ctx, tt.cmd)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+)
+
+type IRefreshTokenUsecase interface {
+	Run(ctx context.Context, cmd RefreshTokenCommand) (*RefreshTokenDTO, error)
+}
+
+type refreshTokenUsecase struct {
+	sessionServ sessionDomain.ISessionService
+	jwtServ     IJWTService
+	unitOfWork  unitofwork.IUnitOfWork
+}
+
+func NewRefreshTokenUsecase(
+	sessionService sessionDomain.ISessionService,
+	jwtService IJWTService,
+	unitOfWork unitofwork.IUnitOfWork,
+) IRefreshTokenUsecase {
+	return &refreshTokenUsecase{
+		sessionServ: sessionService,
+		jwtServ:     jwtService,
+		unitOfWork:  unitOfWork,
+	}
+}
+
+type RefreshTokenCommand struct {
+	RefreshToken string
+}
+
+type RefreshTokenDTO struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// 再利用の確認、使用済みにする更新、新しいリフレッシュトークンの発行を1つのトランザクションで行います。
+// アクセストークンを生成できなかった場合は、クライアントが旧トークンを使い続けられるようにロールバックします。
+// 再利用を検知した場合はセッションの失効を残す為、トランザクションをコミットしてから ErrRefreshTokenReused を返します。
+func (u *refreshTokenUsecase) Run(ctx context.Context, cmd RefreshTokenCommand) (*RefreshTokenDTO, error) {
+	var dto *RefreshTokenDTO
+	var reuseErr error
+	if err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		session, refreshToken, err := u.sessionServ.Rotate(ctx, cmd.RefreshToken)
+		if errors.Is(err, sessionDomain.ErrRefreshTokenReused) {
+			reuseErr = err
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		accessToken, err := u.jwtServ.GenerateAccessToken(session.UserIDString(), session.IDString())
+		if err != nil {
+			return err
+		}
+		dto = &RefreshTokenDTO{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if reuseErr != nil {
+		return nil, reuseErr
+	}
+	return dto, nil
+}
@@ -0,0 +1,142 @@
+package authentication_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestRefreshTokenUsecase(t *testing.T) {
+	type Mocks struct {
+		sessionServ *domainMock.MockISessionService
+		jwtServ     *appMock.MockIJWTService
+	}
+
+	var (
+		accessToken  = "token"
+		refreshToken = "newRefreshToken"
+		arg          = gomock.Any()
+		inTx         = inTxMatcher{}
+	)
+
+	session, err := sessionDomain.New(idVO.NewUserIDForTest("user"))
+	assert.NoError(t, err)
+
+	happyCmd := authApp.RefreshTokenCommand{
+		RefreshToken: "refreshToken",
+	}
+
+	tests := []struct {
+		caseName      string
+		cmd           authApp.RefreshTokenCommand
+		prepare       func(mocks Mocks)
+		wantCommitted bool
+		wantErr       error
+	}{
+		{
+			caseName: "Positive: トークンの再発行が成功する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.sessionServ.EXPECT().Rotate(inTx, arg).Return(session, refreshToken, nil)
+				mocks.jwtServ.EXPECT().GenerateAccessToken(session.UserIDString(), session.IDString()).Return(accessToken, nil)
+			},
+			wantCommitted: true,
+			wantErr:       nil,
+		},
+		{
+			caseName: "Negative: リフレッシュトークンの再利用を検知した場合は、セッションの失効をコミットしてエラーを返す",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.sessionServ.EXPECT().Rotate(inTx, arg).Return(nil, "", sessionDomain.ErrRefreshTokenReused)
+			},
+			wantCommitted: true,
+			wantErr:       sessionDomain.ErrRefreshTokenReused,
+		},
+		{
+			caseName: "Negative: リフレッシュトークンのローテーションに失敗した場合はロールバックする",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.sessionServ.EXPECT().Rotate(inTx, arg).Return(nil, "", sessionDomain.ErrRefreshTokenExpired)
+			},
+			wantCommitted: false,
+			wantErr:       sessionDomain.ErrRefreshTokenExpired,
+		},
+		{
+			caseName: "Negative: アクセストークンの生成に失敗した場合はロールバックする",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.sessionServ.EXPECT().Rotate(inTx, arg).Return(session, refreshToken, nil)
+				mocks.jwtServ.EXPECT().GenerateAccessToken(arg, arg).Return("", assert.AnError)
+			},
+			wantCommitted: false,
+			wantErr:       assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				sessionServ: domainMock.NewMockISessionService(ctrl),
+				jwtServ:     appMock.NewMockIJWTService(ctrl),
+			}
+			uow := &txRecorder{}
+			uc := authApp.NewRefreshTokenUsecase(mocks.sessionServ, mocks.jwtServ, uow)
+			ctx := context.Background()
+			tt.prepare(mocks)
+
+			dto, err := uc.Run(ctx, tt.cmd)
+
+			assert.Equal(t, tt.wantCommitted, uow.committed)
+			assert.Equal(t, !tt.wantCommitted, uow.rolledBack)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, accessToken, dto.AccessToken)
+				assert.Equal(t, refreshToken, dto.RefreshToken)
+			}
+		})
+	}
+}
+
+type inTxKey struct{}
+
+// 障害を注入したテストで、すべての書き込みが1つのトランザクションで行われ、
+// 途中で失敗した場合にロールバックされることを確認する為の UnitOfWork です。
+type txRecorder struct {
+	committed  bool
+	rolledBack bool
+}
+
+func (r *txRecorder) RunInTx(ctx context.Context, f func(ctx context.Context) error) error {
+	if err := f(context.WithValue(ctx, inTxKey{}, true)); err != nil {
+		r.rolledBack = true
+		return err
+	}
+	r.committed = true
+	return nil
+}
+
+// トランザクション内のコンテキストで呼び出されたことを確認する為の Matcher です。
+type inTxMatcher struct{}
+
+func (inTxMatcher) Matches(x interface{}) bool {
+	ctx, ok := x.(context.Context)
+	return ok && ctx.Value(inTxKey{}) != nil
+}
+
+func (inTxMatcher) String() string {
+	return "is a context inside the transaction"
+}
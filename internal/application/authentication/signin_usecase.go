@@ -4,6 +4,7 @@ import (
 	"context"
 
 	authDomain "github.com/u104rak1/pocgo/internal/domain/authentication"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
 )
 
 type ISigninUsecase interface {
@@ -11,17 +12,20 @@ type ISigninUsecase interface {
 }
 
 type signinUsecase struct {
-	authServ authDomain.IAuthenticationService
-	jwtServ  IJWTService
+	authServ    authDomain.IAuthenticationService
+	sessionServ sessionDomain.ISessionService
+	jwtServ     IJWTService
 }
 
 func NewSigninUsecase(
 	authenticationService authDomain.IAuthenticationService,
+	sessionService sessionDomain.ISessionService,
 	jwtService IJWTService,
 ) ISigninUsecase {
 	return &signinUsecase{
-		authServ: authenticationService,
-		jwtServ:  jwtService,
+		authServ:    authenticationService,
+		sessionServ: sessionService,
+		jwtServ:     jwtService,
 	}
 }
 
@@ -31,7 +35,8 @@ type SigninCommand struct {
 }
 
 type SigninDTO struct {
-	AccessToken string
+	AccessToken  string
+	RefreshToken string
 }
 
 func (u *signinUsecase) Run(ctx context.Context, cmd SigninCommand) (*SigninDTO, error) {
@@ -40,12 +45,18 @@ func (u *signinUsecase) Run(ctx context.Context, cmd SigninCommand) (*SigninDTO,
 		return nil, err
 	}
 
-	token, err := u.jwtServ.GenerateAccessToken(userID.String())
+	session, refreshToken, err := u.sessionServ.Start(ctx, *userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := u.jwtServ.GenerateAccessToken(userID.String(), session.IDString())
 	if err != nil {
 		return nil, err
 	}
 
 	return &SigninDTO{
-		AccessToken: token,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
 	}, nil
 }
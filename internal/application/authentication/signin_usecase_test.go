@@ -9,23 +9,29 @@ import (
 	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
 	appMock "github.com/u104rak1/pocgo/internal/application/mock"
 	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
 func TestSigninUsecase(t *testing.T) {
 	type Mocks struct {
-		authServ *domainMock.MockIAuthenticationService
-		jwtServ  *appMock.MockIJWTService
+		authServ    *domainMock.MockIAuthenticationService
+		sessionServ *domainMock.MockISessionService
+		jwtServ     *appMock.MockIJWTService
 	}
 
 	var (
-		userID      = idVO.NewUserIDForTest("user")
-		email       = "sato@example.com"
-		password    = "password"
-		accessToken = "token"
-		arg         = gomock.Any()
+		userID       = idVO.NewUserIDForTest("user")
+		email        = "sato@example.com"
+		password     = "password"
+		accessToken  = "token"
+		refreshToken = "refreshToken"
+		arg          = gomock.Any()
 	)
 
+	session, err := sessionDomain.New(userID)
+	assert.NoError(t, err)
+
 	happyCmd := authApp.SigninCommand{
 		Email:    email,
 		Password: password,
@@ -42,7 +48,8 @@ func TestSigninUsecase(t *testing.T) {
 			cmd:      happyCmd,
 			prepare: func(mocks Mocks) {
 				mocks.authServ.EXPECT().Authenticate(arg, arg, arg).Return(&userID, nil)
-				mocks.jwtServ.EXPECT().GenerateAccessToken(arg).Return(accessToken, nil)
+				mocks.sessionServ.EXPECT().Start(arg, arg).Return(session, refreshToken, nil)
+				mocks.jwtServ.EXPECT().GenerateAccessToken(arg, arg).Return(accessToken, nil)
 			},
 			wantErr: false,
 		},
@@ -54,12 +61,22 @@ func TestSigninUsecase(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			caseName: "Negative: セッションの開始に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.authServ.EXPECT().Authenticate(arg, arg, arg).Return(&userID, nil)
+				mocks.sessionServ.EXPECT().Start(arg, arg).Return(nil, "", assert.AnError)
+			},
+			wantErr: true,
+		},
 		{
 			caseName: "Negative: アクセストークンの生成に失敗する",
 			cmd:      happyCmd,
 			prepare: func(mocks Mocks) {
 				mocks.authServ.EXPECT().Authenticate(arg, arg, arg).Return(&userID, nil)
-				mocks.jwtServ.EXPECT().GenerateAccessToken(arg).Return("", assert.AnError)
+				mocks.sessionServ.EXPECT().Start(arg, arg).Return(session, refreshToken, nil)
+				mocks.jwtServ.EXPECT().GenerateAccessToken(arg, arg).Return("", assert.AnError)
 			},
 			wantErr: true,
 		},
@@ -72,10 +89,11 @@ func TestSigninUsecase(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := Mocks{
-				authServ: domainMock.NewMockIAuthenticationService(ctrl),
-				jwtServ:  appMock.NewMockIJWTService(ctrl),
+				authServ:    domainMock.NewMockIAuthenticationService(ctrl),
+				sessionServ: domainMock.NewMockISessionService(ctrl),
+				jwtServ:     appMock.NewMockIJWTService(ctrl),
 			}
-			uc := authApp.NewSigninUsecase(mocks.authServ, mocks.jwtServ)
+			uc := authApp.NewSigninUsecase(mocks.authServ, mocks.sessionServ, mocks.jwtServ)
 			ctx := context.Background()
 			tt.prepare(mocks)
 
@@ -87,6 +105,7 @@ func TestSigninUsecase(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, accessToken, dto.AccessToken)
+				assert.Equal(t, refreshToken, dto.RefreshToken)
 			}
 		})
 	}
@@ -3,7 +3,9 @@ package authentication
 import (
 	"context"
 
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
 	authDomain "github.com/u104rak1/pocgo/internal/domain/authentication"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
 	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
@@ -13,26 +15,29 @@ type ISignupUsecase interface {
 }
 
 type signupUsecase struct {
-	userRepo userDomain.IUserRepository
-	userServ userDomain.IUserService
-	authRepo authDomain.IAuthenticationRepository
-	authServ authDomain.IAuthenticationService
-	jwtServ  IJWTService
+	userServ    userDomain.IUserService
+	authRepo    authDomain.IAuthenticationRepository
+	authServ    authDomain.IAuthenticationService
+	sessionServ sessionDomain.ISessionService
+	jwtServ     IJWTService
+	unitOfWork  unitofwork.IUnitOfWork
 }
 
 func NewSignupUsecase(
-	userRepository userDomain.IUserRepository,
 	authRepository authDomain.IAuthenticationRepository,
 	userService userDomain.IUserService,
 	authService authDomain.IAuthenticationService,
+	sessionService sessionDomain.ISessionService,
 	jwtService IJWTService,
+	unitOfWork unitofwork.IUnitOfWork,
 ) ISignupUsecase {
 	return &signupUsecase{
-		userRepo: userRepository,
-		authRepo: authRepository,
-		userServ: userService,
-		authServ: authService,
-		jwtServ:  jwtService,
+		authRepo:    authRepository,
+		userServ:    userService,
+		authServ:    authService,
+		sessionServ: sessionService,
+		jwtServ:     jwtService,
+		unitOfWork:  unitOfWork,
 	}
 }
 
@@ -43,8 +48,9 @@ type SignupCommand struct {
 }
 
 type SignupDTO struct {
-	User        SignupUserDTO
-	AccessToken string
+	User         SignupUserDTO
+	AccessToken  string
+	RefreshToken string
 }
 
 type SignupUserDTO struct {
@@ -53,17 +59,34 @@ type SignupUserDTO struct {
 	Email string
 }
 
+// ユーザー、認証情報、セッションと UserSignedUp イベントを1つのトランザクションで作成します。
+// 途中で失敗した場合は何も残らない為、同じメールアドレスで再びサインアップできます。
 func (u *signupUsecase) Run(ctx context.Context, cmd SignupCommand) (*SignupDTO, error) {
-	userID, err := u.createUser(ctx, cmd)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := u.createAuthentication(ctx, userID, cmd); err != nil {
-		return nil, err
-	}
-
-	accessToken, err := u.jwtServ.GenerateAccessToken(userID.String())
+	var (
+		userID       *idVO.UserID
+		accessToken  string
+		refreshToken string
+	)
+	err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		userID, err = u.createUser(ctx, cmd)
+		if err != nil {
+			return err
+		}
+
+		if err := u.createAuthentication(ctx, userID, cmd); err != nil {
+			return err
+		}
+
+		var session *sessionDomain.Session
+		session, refreshToken, err = u.sessionServ.Start(ctx, *userID)
+		if err != nil {
+			return err
+		}
+
+		accessToken, err = u.jwtServ.GenerateAccessToken(userID.String(), session.IDString())
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -74,10 +97,12 @@ func (u *signupUsecase) Run(ctx context.Context, cmd SignupCommand) (*SignupDTO,
 			Name:  cmd.Name,
 			Email: cmd.Email,
 		},
-		AccessToken: accessToken,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
 	}, nil
 }
 
+// 事前の確認をすり抜けた同時のサインアップは、保存時にメールアドレスの一意制約で ErrEmailAlreadyExists になります。
 func (u *signupUsecase) createUser(ctx context.Context, cmd SignupCommand) (*idVO.UserID, error) {
 	if err := u.userServ.VerifyEmailUniqueness(ctx, cmd.Email); err != nil {
 		return nil, err
@@ -88,7 +113,7 @@ func (u *signupUsecase) createUser(ctx context.Context, cmd SignupCommand) (*idV
 		return nil, err
 	}
 
-	if err = u.userRepo.Save(ctx, user); err != nil {
+	if err = u.userServ.Register(ctx, user); err != nil {
 		return nil, err
 	}
 
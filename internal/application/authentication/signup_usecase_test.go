@@ -9,15 +9,18 @@ import (
 	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
 	appMock "github.com/u104rak1/pocgo/internal/application/mock"
 	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
 func TestSignupUsecase(t *testing.T) {
 	type Mocks struct {
-		userRepo *domainMock.MockIUserRepository
-		userServ *domainMock.MockIUserService
-		authRepo *domainMock.MockIAuthenticationRepository
-		authServ *domainMock.MockIAuthenticationService
-		jwtServ  *appMock.MockIJWTService
+		userServ    *domainMock.MockIUserService
+		authRepo    *domainMock.MockIAuthenticationRepository
+		authServ    *domainMock.MockIAuthenticationService
+		sessionServ *domainMock.MockISessionService
+		jwtServ     *appMock.MockIJWTService
 	}
 
 	var (
@@ -25,9 +28,14 @@ func TestSignupUsecase(t *testing.T) {
 		userEmail    = "sato@example.com"
 		userPassword = "password"
 		accessToken  = "token"
+		refreshToken = "refreshToken"
 		arg          = gomock.Any()
+		inTx         = inTxMatcher{}
 	)
 
+	session, err := sessionDomain.New(idVO.NewUserIDForTest("user"))
+	assert.NoError(t, err)
+
 	happyCmd := authApp.SignupCommand{
 		Name:     userName,
 		Email:    userEmail,
@@ -38,77 +46,100 @@ func TestSignupUsecase(t *testing.T) {
 		caseName string
 		cmd      authApp.SignupCommand
 		prepare  func(mocks Mocks)
-		wantErr  bool
+		wantErr  error
 	}{
 		{
 			caseName: "Positive: サインアップが成功する",
 			cmd:      happyCmd,
 			prepare: func(mocks Mocks) {
-				mocks.userServ.EXPECT().VerifyEmailUniqueness(arg, arg).Return(nil)
-				mocks.userRepo.EXPECT().Save(arg, arg).Return(nil)
-				mocks.authServ.EXPECT().VerifyUniqueness(arg, arg).Return(nil)
-				mocks.authRepo.EXPECT().Save(arg, arg).Return(nil)
-				mocks.jwtServ.EXPECT().GenerateAccessToken(arg).Return(accessToken, nil)
+				mocks.userServ.EXPECT().VerifyEmailUniqueness(inTx, arg).Return(nil)
+				mocks.userServ.EXPECT().Register(inTx, arg).Return(nil)
+				mocks.authServ.EXPECT().VerifyUniqueness(inTx, arg).Return(nil)
+				mocks.authRepo.EXPECT().Save(inTx, arg).Return(nil)
+				mocks.sessionServ.EXPECT().Start(inTx, arg).Return(session, refreshToken, nil)
+				mocks.jwtServ.EXPECT().GenerateAccessToken(arg, arg).Return(accessToken, nil)
 			},
-			wantErr: false,
+			wantErr: nil,
 		},
 		{
 			caseName: "Negative: メールアドレスの一意性検証に失敗する",
 			cmd:      happyCmd,
 			prepare: func(mocks Mocks) {
-				mocks.userServ.EXPECT().VerifyEmailUniqueness(arg, arg).Return(assert.AnError)
+				mocks.userServ.EXPECT().VerifyEmailUniqueness(inTx, arg).Return(assert.AnError)
 			},
-			wantErr: true,
+			wantErr: assert.AnError,
 		},
 		{
 			caseName: "Negative: ユーザー作成に失敗する",
 			cmd:      authApp.SignupCommand{},
 			prepare: func(mocks Mocks) {
-				mocks.userServ.EXPECT().VerifyEmailUniqueness(arg, arg).Return(nil)
+				mocks.userServ.EXPECT().VerifyEmailUniqueness(inTx, arg).Return(nil)
 			},
-			wantErr: true,
+			wantErr: userDomain.ErrInvalidName,
 		},
 		{
 			caseName: "Negative: ユーザー保存に失敗する",
 			cmd:      happyCmd,
 			prepare: func(mocks Mocks) {
-				mocks.userServ.EXPECT().VerifyEmailUniqueness(arg, arg).Return(nil)
-				mocks.userRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+				mocks.userServ.EXPECT().VerifyEmailUniqueness(inTx, arg).Return(nil)
+				mocks.userServ.EXPECT().Register(inTx, arg).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			caseName: "Negative: 同時のサインアップでメールアドレスの一意制約に違反した場合、ErrEmailAlreadyExists を返す",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.userServ.EXPECT().VerifyEmailUniqueness(inTx, arg).Return(nil)
+				mocks.userServ.EXPECT().Register(inTx, arg).Return(userDomain.ErrEmailAlreadyExists)
 			},
-			wantErr: true,
+			wantErr: userDomain.ErrEmailAlreadyExists,
 		},
 		{
 			caseName: "Negative: 認証の一意性検証に失敗する",
 			cmd:      happyCmd,
 			prepare: func(mocks Mocks) {
-				mocks.userServ.EXPECT().VerifyEmailUniqueness(arg, arg).Return(nil)
-				mocks.userRepo.EXPECT().Save(arg, arg).Return(nil)
-				mocks.authServ.EXPECT().VerifyUniqueness(arg, arg).Return(assert.AnError)
+				mocks.userServ.EXPECT().VerifyEmailUniqueness(inTx, arg).Return(nil)
+				mocks.userServ.EXPECT().Register(inTx, arg).Return(nil)
+				mocks.authServ.EXPECT().VerifyUniqueness(inTx, arg).Return(assert.AnError)
 			},
-			wantErr: true,
+			wantErr: assert.AnError,
 		},
 		{
 			caseName: "Negative: 認証保存に失敗する",
 			cmd:      happyCmd,
 			prepare: func(mocks Mocks) {
-				mocks.userServ.EXPECT().VerifyEmailUniqueness(arg, arg).Return(nil)
-				mocks.userRepo.EXPECT().Save(arg, arg).Return(nil)
-				mocks.authServ.EXPECT().VerifyUniqueness(arg, arg).Return(nil)
-				mocks.authRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+				mocks.userServ.EXPECT().VerifyEmailUniqueness(inTx, arg).Return(nil)
+				mocks.userServ.EXPECT().Register(inTx, arg).Return(nil)
+				mocks.authServ.EXPECT().VerifyUniqueness(inTx, arg).Return(nil)
+				mocks.authRepo.EXPECT().Save(inTx, arg).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			caseName: "Negative: セッションの開始に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks) {
+				mocks.userServ.EXPECT().VerifyEmailUniqueness(inTx, arg).Return(nil)
+				mocks.userServ.EXPECT().Register(inTx, arg).Return(nil)
+				mocks.authServ.EXPECT().VerifyUniqueness(inTx, arg).Return(nil)
+				mocks.authRepo.EXPECT().Save(inTx, arg).Return(nil)
+				mocks.sessionServ.EXPECT().Start(inTx, arg).Return(nil, "", assert.AnError)
 			},
-			wantErr: true,
+			wantErr: assert.AnError,
 		},
 		{
 			caseName: "Negative: アクセストークン生成に失敗する",
 			cmd:      happyCmd,
 			prepare: func(mocks Mocks) {
-				mocks.userServ.EXPECT().VerifyEmailUniqueness(arg, arg).Return(nil)
-				mocks.userRepo.EXPECT().Save(arg, arg).Return(nil)
-				mocks.authServ.EXPECT().VerifyUniqueness(arg, arg).Return(nil)
-				mocks.authRepo.EXPECT().Save(arg, arg).Return(nil)
-				mocks.jwtServ.EXPECT().GenerateAccessToken(arg).Return("", assert.AnError)
+				mocks.userServ.EXPECT().VerifyEmailUniqueness(inTx, arg).Return(nil)
+				mocks.userServ.EXPECT().Register(inTx, arg).Return(nil)
+				mocks.authServ.EXPECT().VerifyUniqueness(inTx, arg).Return(nil)
+				mocks.authRepo.EXPECT().Save(inTx, arg).Return(nil)
+				mocks.sessionServ.EXPECT().Start(inTx, arg).Return(session, refreshToken, nil)
+				mocks.jwtServ.EXPECT().GenerateAccessToken(arg, arg).Return("", assert.AnError)
 			},
-			wantErr: true,
+			wantErr: assert.AnError,
 		},
 	}
 
@@ -119,22 +150,26 @@ func TestSignupUsecase(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := Mocks{
-				userRepo: domainMock.NewMockIUserRepository(ctrl),
-				userServ: domainMock.NewMockIUserService(ctrl),
-				authRepo: domainMock.NewMockIAuthenticationRepository(ctrl),
-				authServ: domainMock.NewMockIAuthenticationService(ctrl),
-				jwtServ:  appMock.NewMockIJWTService(ctrl),
+				userServ:    domainMock.NewMockIUserService(ctrl),
+				authRepo:    domainMock.NewMockIAuthenticationRepository(ctrl),
+				authServ:    domainMock.NewMockIAuthenticationService(ctrl),
+				sessionServ: domainMock.NewMockISessionService(ctrl),
+				jwtServ:     appMock.NewMockIJWTService(ctrl),
 			}
 
-			uc := authApp.NewSignupUsecase(mocks.userRepo, mocks.authRepo, mocks.userServ, mocks.authServ, mocks.jwtServ)
+			uow := &txRecorder{}
+			uc := authApp.NewSignupUsecase(mocks.authRepo, mocks.userServ, mocks.authServ, mocks.sessionServ, mocks.jwtServ, uow)
 			ctx := context.Background()
 			tt.prepare(mocks)
 
 			dto, err := uc.Run(ctx, tt.cmd)
 
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
 				assert.Nil(t, dto)
+				// 途中で失敗した場合は、それまでの保存を含めてロールバックされる
+				assert.True(t, uow.rolledBack)
+				assert.False(t, uow.committed)
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, dto)
@@ -142,6 +177,8 @@ func TestSignupUsecase(t *testing.T) {
 				assert.Equal(t, tt.cmd.Name, dto.User.Name)
 				assert.Equal(t, tt.cmd.Email, dto.User.Email)
 				assert.Equal(t, accessToken, dto.AccessToken)
+				assert.Equal(t, refreshToken, dto.RefreshToken)
+				assert.True(t, uow.committed)
 			}
 		})
 	}
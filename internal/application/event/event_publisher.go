@@ -0,0 +1,12 @@
+package event
+
+import (
+	"context"
+
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+)
+
+type IEventPublisher interface {
+	// イベントを外部に発行します。同じイベントを複数回発行する場合がある為、購読する側はイベントの ID で重複を除きます。
+	Publish(ctx context.Context, event *eventDomain.Event) error
+}
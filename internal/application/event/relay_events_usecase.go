@@ -0,0 +1,102 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+)
+
+type IRelayEventsUsecase interface {
+	Run(ctx context.Context) (*RelayEventsDTO, error)
+}
+
+type relayEventsUsecase struct {
+	eventRepo eventDomain.IEventRepository
+	publisher IEventPublisher
+	now       func() time.Time
+}
+
+// now には現在時刻を返す関数を指定します。通常は timer.Now を指定し、テストでは時刻を固定した関数を指定します。
+func NewRelayEventsUsecase(
+	eventRepository eventDomain.IEventRepository,
+	publisher IEventPublisher,
+	now func() time.Time,
+) IRelayEventsUsecase {
+	return &relayEventsUsecase{
+		eventRepo: eventRepository,
+		publisher: publisher,
+		now:       now,
+	}
+}
+
+type RelayEventsDTO struct {
+	// 発行した件数です。
+	Published int
+	// 発行できなかった件数です。失敗したイベントと同時に取得した、同じ集約の後続のイベントも含みます。
+	Failed int
+}
+
+// アウトボックスに記録された未発行のイベントを、記録した順に最大 eventDomain.RelayBatchSize 件発行します。
+// 発行に失敗した場合は、同じ集約の後続のイベントを発行せずに次回に持ち越し、集約ごとの順序を保ちます。
+// 失敗した集約はそれ以降の取得から除外して取得し直す為、発行できないイベントの後ろに同じ集約のイベントが溜まっていても、
+// 他の集約のイベントの発行は止まりません。
+// 発行した後に発行日時の記録に失敗した場合も次回に再び発行する為、イベントは少なくとも1回発行されます。
+// 失敗した場合のエラーはまとめて返しますが、その場合も発行した件数を返します。
+func (u *relayEventsUsecase) Run(ctx context.Context) (*RelayEventsDTO, error) {
+	dto := &RelayEventsDTO{}
+	var errs []error
+	blocked := make(map[eventDomain.Aggregate]bool)
+	var excluded []eventDomain.Aggregate
+	for dto.Published < eventDomain.RelayBatchSize {
+		events, err := u.eventRepo.ListUnpublished(ctx, eventDomain.RelayBatchSize-dto.Published, excluded)
+		if err != nil {
+			if dto.Published+dto.Failed == 0 {
+				return nil, err
+			}
+			errs = append(errs, err)
+			break
+		}
+
+		// 新たに失敗した集約がなければ、取得したイベントは全て発行済みで、取得し直しても発行できるイベントは増えない
+		newlyBlocked := false
+		for _, event := range events {
+			if err := ctx.Err(); err != nil {
+				errs = append(errs, err)
+				return dto, errors.Join(errs...)
+			}
+
+			aggregate := event.Aggregate()
+			if blocked[aggregate] {
+				dto.Failed++
+				continue
+			}
+
+			if err := u.relay(ctx, event); err != nil {
+				blocked[aggregate] = true
+				excluded = append(excluded, aggregate)
+				newlyBlocked = true
+				dto.Failed++
+				errs = append(errs, fmt.Errorf("event %s: %w", event.IDString(), err))
+				continue
+			}
+			dto.Published++
+		}
+		if !newlyBlocked {
+			break
+		}
+	}
+
+	return dto, errors.Join(errs...)
+}
+
+// イベントを発行し、発行日時を記録します。
+func (u *relayEventsUsecase) relay(ctx context.Context, event *eventDomain.Event) error {
+	if err := u.publisher.Publish(ctx, event); err != nil {
+		return err
+	}
+	event.MarkPublished(u.now())
+	return u.eventRepo.Save(ctx, event)
+}
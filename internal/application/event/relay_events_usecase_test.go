@@ -0,0 +1,184 @@
+package event_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	eventUC "github.com/u104rak1/pocgo/internal/application/event"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestRelayEventsUsecase(t *testing.T) {
+	type Mocks struct {
+		eventRepo *domainMock.MockIEventRepository
+		publisher *appMock.MockIEventPublisher
+	}
+
+	var (
+		occurredAt = timer.GetFixedDate()
+		now        = occurredAt.Add(time.Minute)
+		clock      = func() time.Time { return now }
+		arg        = gomock.Any()
+	)
+
+	// 口座 A に2件、口座 B に1件のイベントを記録した順に作成します。
+	newEvents := func(t *testing.T) []*eventDomain.Event {
+		var events []*eventDomain.Event
+		for _, accountID := range []idVO.AccountID{
+			idVO.NewAccountIDForTest("accountA"), idVO.NewAccountIDForTest("accountA"), idVO.NewAccountIDForTest("accountB"),
+		} {
+			event, err := eventDomain.New(eventDomain.MoneyDeposited{
+				TransactionID: idVO.NewTransactionID().String(),
+				AccountID:     accountID.String(),
+				Amount:        "1000",
+				Currency:      "JPY",
+				BalanceAfter:  "1000",
+			}, occurredAt)
+			assert.NoError(t, err)
+			events = append(events, event)
+		}
+		return events
+	}
+
+	tests := []struct {
+		caseName      string
+		prepare       func(mocks Mocks, events []*eventDomain.Event)
+		wantPublished int
+		wantFailed    int
+		wantErr       error
+	}{
+		{
+			caseName: "Positive: 未発行のイベントを記録した順に発行できる",
+			prepare: func(mocks Mocks, events []*eventDomain.Event) {
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize, gomock.Nil()).Return(events, nil)
+				var calls []*gomock.Call
+				for _, event := range events {
+					calls = append(calls,
+						mocks.publisher.EXPECT().Publish(arg, event).Return(nil),
+						mocks.eventRepo.EXPECT().Save(arg, event).DoAndReturn(
+							func(_ context.Context, event *eventDomain.Event) error {
+								assert.Equal(t, &now, event.PublishedAt())
+								return nil
+							}),
+					)
+				}
+				gomock.InOrder(calls...)
+			},
+			wantPublished: 3,
+			wantFailed:    0,
+			wantErr:       nil,
+		},
+		{
+			caseName: "Positive: 未発行のイベントがない場合は何もしない",
+			prepare: func(mocks Mocks, events []*eventDomain.Event) {
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize, gomock.Nil()).Return(nil, nil)
+			},
+			wantPublished: 0,
+			wantFailed:    0,
+			wantErr:       nil,
+		},
+		{
+			caseName: "Negative: 発行に失敗した場合は同じ集約の後続のイベントを発行せず、他の集約のイベントは発行する",
+			prepare: func(mocks Mocks, events []*eventDomain.Event) {
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize, gomock.Nil()).Return(events, nil)
+				mocks.publisher.EXPECT().Publish(arg, events[0]).Return(assert.AnError)
+				mocks.publisher.EXPECT().Publish(arg, events[2]).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, events[2]).Return(nil)
+				// 失敗した集約を除外して取得し直す
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize-1, []eventDomain.Aggregate{events[0].Aggregate()}).Return(nil, nil)
+			},
+			wantPublished: 1,
+			wantFailed:    2,
+			wantErr:       assert.AnError,
+		},
+		{
+			caseName: "Negative: 発行日時の記録に失敗した場合は同じ集約の後続のイベントを発行しない",
+			prepare: func(mocks Mocks, events []*eventDomain.Event) {
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize, gomock.Nil()).Return(events, nil)
+				mocks.publisher.EXPECT().Publish(arg, events[0]).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, events[0]).Return(assert.AnError)
+				mocks.publisher.EXPECT().Publish(arg, events[2]).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, events[2]).Return(nil)
+				// 失敗した集約を除外して取得し直す
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize-1, []eventDomain.Aggregate{events[0].Aggregate()}).Return(nil, nil)
+			},
+			wantPublished: 1,
+			wantFailed:    2,
+			wantErr:       assert.AnError,
+		},
+		{
+			caseName: "Negative: 発行できないイベントの後ろに同じ集約のイベントが溜まっていても、他の集約のイベントを発行する",
+			prepare: func(mocks Mocks, events []*eventDomain.Event) {
+				// 1回目に取得できる件数を全て口座 A のイベントが占めている
+				blockedEvents := make([]*eventDomain.Event, 0, eventDomain.RelayBatchSize)
+				blockedEvents = append(blockedEvents, events[0])
+				for len(blockedEvents) < eventDomain.RelayBatchSize {
+					blockedEvents = append(blockedEvents, events[1])
+				}
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize, gomock.Nil()).Return(blockedEvents, nil)
+				mocks.publisher.EXPECT().Publish(arg, events[0]).Return(assert.AnError)
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize, []eventDomain.Aggregate{events[0].Aggregate()}).Return(events[2:], nil)
+				mocks.publisher.EXPECT().Publish(arg, events[2]).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, events[2]).Return(nil)
+			},
+			wantPublished: 1,
+			wantFailed:    eventDomain.RelayBatchSize,
+			wantErr:       assert.AnError,
+		},
+		{
+			caseName: "Negative: 失敗した集約を除外して取得し直す際に失敗した場合は、発行した件数とエラーを返す",
+			prepare: func(mocks Mocks, events []*eventDomain.Event) {
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize, gomock.Nil()).Return(events, nil)
+				mocks.publisher.EXPECT().Publish(arg, events[0]).Return(assert.AnError)
+				mocks.publisher.EXPECT().Publish(arg, events[2]).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, events[2]).Return(nil)
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize-1, arg).Return(nil, assert.AnError)
+			},
+			wantPublished: 1,
+			wantFailed:    2,
+			wantErr:       assert.AnError,
+		},
+		{
+			caseName: "Negative: 未発行のイベントの取得に失敗する",
+			prepare: func(mocks Mocks, events []*eventDomain.Event) {
+				mocks.eventRepo.EXPECT().ListUnpublished(arg, eventDomain.RelayBatchSize, gomock.Nil()).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				eventRepo: domainMock.NewMockIEventRepository(ctrl),
+				publisher: appMock.NewMockIEventPublisher(ctrl),
+			}
+			events := newEvents(t)
+			uc := eventUC.NewRelayEventsUsecase(mocks.eventRepo, mocks.publisher, clock)
+			tt.prepare(mocks, events)
+
+			result, err := uc.Run(context.Background())
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if result != nil {
+				assert.Equal(t, tt.wantPublished, result.Published)
+				assert.Equal(t, tt.wantFailed, result.Failed)
+			}
+		})
+	}
+}
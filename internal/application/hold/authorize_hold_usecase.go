@@ -0,0 +1,105 @@
+package hold
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type IAuthorizeHoldUsecase interface {
+	Run(ctx context.Context, cmd AuthorizeHoldCommand) (*HoldDTO, error)
+}
+
+type authorizeHoldUsecase struct {
+	accountServ accountDomain.IAccountService
+	holdServ    holdDomain.IHoldService
+	unitOfWork  unitofwork.IUnitOfWork
+}
+
+func NewAuthorizeHoldUsecase(
+	accountService accountDomain.IAccountService,
+	holdService holdDomain.IHoldService,
+	unitOfWork unitofwork.IUnitOfWork,
+) IAuthorizeHoldUsecase {
+	return &authorizeHoldUsecase{
+		accountServ: accountService,
+		holdServ:    holdService,
+		unitOfWork:  unitOfWork,
+	}
+}
+
+type AuthorizeHoldCommand struct {
+	UserID    string
+	AccountID string
+	Password  string
+	// 指定した場合は確定時にこの口座へ振り込み、指定しない場合は出金します。
+	ReceiverAccountID *string
+	Amount            string
+	Currency          string
+	// 指定しない場合は現在時刻から holdDomain.DefaultExpiry 後になります。
+	ExpiresAt *time.Time
+}
+
+// 口座の利用できる金額から仮押さえを作成します。口座の暗証番号が必要です。
+func (u *authorizeHoldUsecase) Run(ctx context.Context, cmd AuthorizeHoldCommand) (*HoldDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var receiverAccountID *idVO.AccountID
+	if cmd.ReceiverAccountID != nil {
+		id, err := idVO.AccountIDFromString(*cmd.ReceiverAccountID)
+		if err != nil {
+			return nil, err
+		}
+		receiverAccountID = &id
+	}
+
+	amount, err := moneyVO.NewFromDecimal(cmd.Amount, cmd.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := timer.Now().Add(holdDomain.DefaultExpiry)
+	if cmd.ExpiresAt != nil {
+		expiresAt = *cmd.ExpiresAt
+	}
+
+	var hold *holdDomain.Hold
+	if err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, &cmd.Password)
+		if err != nil {
+			return err
+		}
+
+		if receiverAccountID != nil {
+			if _, err := u.accountServ.GetAndAuthorize(ctx, *receiverAccountID, nil, nil); err != nil {
+				if errors.Is(err, accountDomain.ErrNotFound) {
+					return accountDomain.ErrReceiverNotFound
+				}
+				return err
+			}
+		}
+
+		hold, err = u.holdServ.Authorize(ctx, account, receiverAccountID, amount.Amount(), amount.Currency(), expiresAt)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	dto := newHoldDTO(hold)
+	return &dto, nil
+}
@@ -0,0 +1,139 @@
+package hold_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	holdUC "github.com/u104rak1/pocgo/internal/application/hold"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestAuthorizeHoldUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ *domainMock.MockIAccountService
+		holdServ    *domainMock.MockIHoldService
+	}
+
+	var (
+		userID     = idVO.NewUserIDForTest("user")
+		accountID  = idVO.NewAccountIDForTest("account")
+		receiverID = idVO.NewAccountIDForTest("receiver")
+		password   = "1234"
+		expiresAt  = timer.Now().Add(holdDomain.DefaultExpiry)
+		arg        = gomock.Any()
+	)
+
+	happyCmd := holdUC.AuthorizeHoldCommand{
+		UserID:    userID.String(),
+		AccountID: accountID.String(),
+		Password:  password,
+		Amount:    "1000",
+		Currency:  moneyVO.JPY,
+	}
+	receiverIDString := receiverID.String()
+	transferCmd := happyCmd
+	transferCmd.ReceiverAccountID = &receiverIDString
+	transferCmd.ExpiresAt = &expiresAt
+
+	tests := []struct {
+		caseName string
+		cmd      holdUC.AuthorizeHoldCommand
+		prepare  func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 出金の仮押さえを作成できる",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.holdServ.EXPECT().Authorize(arg, account, nil, int64(1000), moneyVO.JPY, arg).Return(hold, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Positive: 振込の仮押さえを有効期限を指定して作成できる",
+			cmd:      transferCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, receiverID, nil, nil).Return(nil, nil)
+				mocks.holdServ.EXPECT().Authorize(arg, account, &receiverID, int64(1000), moneyVO.JPY, expiresAt).Return(hold, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 金額が不正である",
+			cmd: holdUC.AuthorizeHoldCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+				Password:  password,
+				Amount:    "-1",
+				Currency:  moneyVO.JPY,
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {},
+			wantErr: moneyVO.ErrNegativeAmount,
+		},
+		{
+			caseName: "Negative: 口座の暗証番号が一致しない",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			wantErr: accountDomain.ErrUnmatchedPassword,
+		},
+		{
+			caseName: "Negative: 振込先の口座が存在しない",
+			cmd:      transferCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, receiverID, nil, nil).Return(nil, accountDomain.ErrNotFound)
+			},
+			wantErr: accountDomain.ErrReceiverNotFound,
+		},
+		{
+			caseName: "Negative: 利用できる金額が不足している",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.holdServ.EXPECT().Authorize(arg, account, nil, int64(1000), moneyVO.JPY, arg).Return(nil, moneyVO.ErrInsufficientBalance)
+			},
+			wantErr: moneyVO.ErrInsufficientBalance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+				holdServ:    domainMock.NewMockIHoldService(ctrl),
+			}
+			account, err := accountDomain.New(userID, 0, "For work", password, moneyVO.JPY)
+			assert.NoError(t, err)
+			hold := newHold(t, accountID)
+			uc := holdUC.NewAuthorizeHoldUsecase(mocks.accountServ, mocks.holdServ, &appMock.MockIUnitOfWork{})
+			tt.prepare(mocks, account, hold)
+
+			result, err := uc.Run(context.Background(), tt.cmd)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, hold.IDString(), result.ID)
+				assert.Equal(t, holdDomain.StatusAuthorized, result.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package hold
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+type ICaptureHoldUsecase interface {
+	Run(ctx context.Context, cmd CaptureHoldCommand) (*HoldDTO, error)
+}
+
+type captureHoldUsecase struct {
+	accountServ accountDomain.IAccountService
+	holdServ    holdDomain.IHoldService
+	unitOfWork  unitofwork.IUnitOfWork
+}
+
+func NewCaptureHoldUsecase(
+	accountService accountDomain.IAccountService,
+	holdService holdDomain.IHoldService,
+	unitOfWork unitofwork.IUnitOfWork,
+) ICaptureHoldUsecase {
+	return &captureHoldUsecase{
+		accountServ: accountService,
+		holdServ:    holdService,
+		unitOfWork:  unitOfWork,
+	}
+}
+
+type CaptureHoldCommand struct {
+	UserID    string
+	AccountID string
+	HoldID    string
+	Password  string
+	// 指定しない場合は仮押さえした金額の全額を確定します。通貨は仮押さえと同じです。
+	Amount *string
+}
+
+// 仮押さえを確定し、確定した金額の出金または振込を行います。確定しなかった残りの金額は再び利用できるようになります。
+func (u *captureHoldUsecase) Run(ctx context.Context, cmd CaptureHoldCommand) (*HoldDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	holdID, err := idVO.HoldIDFromString(cmd.HoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	var hold *holdDomain.Hold
+	if err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, &cmd.Password)
+		if err != nil {
+			return err
+		}
+
+		hold, err = u.holdServ.GetByAccount(ctx, accountID, holdID)
+		if err != nil {
+			return err
+		}
+
+		var amount *int64
+		if cmd.Amount != nil {
+			money, err := moneyVO.NewFromDecimal(*cmd.Amount, hold.Amount().Currency())
+			if err != nil {
+				return err
+			}
+			value := money.Amount()
+			amount = &value
+		}
+
+		_, err = u.holdServ.Capture(ctx, account, hold, amount)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	dto := newHoldDTO(hold)
+	return &dto, nil
+}
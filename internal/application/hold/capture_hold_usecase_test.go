@@ -0,0 +1,131 @@
+package hold_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	holdUC "github.com/u104rak1/pocgo/internal/application/hold"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+)
+
+func TestCaptureHoldUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ *domainMock.MockIAccountService
+		holdServ    *domainMock.MockIHoldService
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		password  = "1234"
+		arg       = gomock.Any()
+	)
+
+	partial := int64(600)
+
+	tests := []struct {
+		caseName string
+		amount   *string
+		prepare  func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 仮押さえの全額を確定できる",
+			amount:   nil,
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.holdServ.EXPECT().GetByAccount(arg, accountID, hold.ID()).Return(hold, nil)
+				mocks.holdServ.EXPECT().Capture(arg, account, hold, nil).Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Positive: 仮押さえの一部の金額を確定できる",
+			amount:   strutil.StrPointer("600"),
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.holdServ.EXPECT().GetByAccount(arg, accountID, hold.ID()).Return(hold, nil)
+				mocks.holdServ.EXPECT().Capture(arg, account, hold, &partial).Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 口座の暗証番号が一致しない",
+			amount:   nil,
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			wantErr: accountDomain.ErrUnmatchedPassword,
+		},
+		{
+			caseName: "Negative: 仮押さえが存在しない",
+			amount:   nil,
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.holdServ.EXPECT().GetByAccount(arg, accountID, hold.ID()).Return(nil, holdDomain.ErrNotFound)
+			},
+			wantErr: holdDomain.ErrNotFound,
+		},
+		{
+			caseName: "Negative: 確定する金額が仮押さえの通貨の精度を超えている",
+			amount:   strutil.StrPointer("600.5"),
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.holdServ.EXPECT().GetByAccount(arg, accountID, hold.ID()).Return(hold, nil)
+			},
+			wantErr: moneyVO.ErrInvalidPrecision,
+		},
+		{
+			caseName: "Negative: 確定する金額が仮押さえした金額を超えている",
+			amount:   strutil.StrPointer("1001"),
+			prepare: func(mocks Mocks, account *accountDomain.Account, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.holdServ.EXPECT().GetByAccount(arg, accountID, hold.ID()).Return(hold, nil)
+				mocks.holdServ.EXPECT().Capture(arg, account, hold, arg).Return(nil, holdDomain.ErrCaptureExceedsHold)
+			},
+			wantErr: holdDomain.ErrCaptureExceedsHold,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+				holdServ:    domainMock.NewMockIHoldService(ctrl),
+			}
+			account, err := accountDomain.New(userID, 1000, "For work", password, moneyVO.JPY)
+			assert.NoError(t, err)
+			hold := newHold(t, accountID)
+			uc := holdUC.NewCaptureHoldUsecase(mocks.accountServ, mocks.holdServ, &appMock.MockIUnitOfWork{})
+			tt.prepare(mocks, account, hold)
+
+			result, err := uc.Run(context.Background(), holdUC.CaptureHoldCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+				HoldID:    hold.IDString(),
+				Password:  password,
+				Amount:    tt.amount,
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, hold.IDString(), result.ID)
+			}
+		})
+	}
+}
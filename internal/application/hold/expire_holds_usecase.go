@@ -0,0 +1,103 @@
+package hold
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IExpireHoldsUsecase interface {
+	Run(ctx context.Context) (*ExpireHoldsDTO, error)
+}
+
+type expireHoldsUsecase struct {
+	holdServ   holdDomain.IHoldService
+	holdRepo   holdDomain.IHoldRepository
+	unitOfWork unitofwork.IUnitOfWork
+	now        func() time.Time
+}
+
+// now には現在時刻を返す関数を指定します。通常は timer.Now を指定し、テストでは時刻を進められる関数を指定します。
+func NewExpireHoldsUsecase(
+	holdService holdDomain.IHoldService,
+	holdRepository holdDomain.IHoldRepository,
+	unitOfWork unitofwork.IUnitOfWork,
+	now func() time.Time,
+) IExpireHoldsUsecase {
+	return &expireHoldsUsecase{
+		holdServ:   holdService,
+		holdRepo:   holdRepository,
+		unitOfWork: unitOfWork,
+		now:        now,
+	}
+}
+
+type ExpireHoldsDTO struct {
+	// 期限切れにした件数です。
+	Expired int
+}
+
+// 他の処理が先に確定や取り消しを行い、期限切れにすべきでなくなったことを表します。
+var errNotExpired = errors.New("hold is no longer expired")
+
+// 有効期限を過ぎた仮押さえを最大 holdDomain.ExpireBatchSize 件期限切れにします。
+// 仮押さえごとに別のトランザクションで処理し、失敗した場合も次の仮押さえに進みます。
+// 失敗した場合のエラーはまとめて返しますが、その場合も期限切れにした件数を返します。
+func (u *expireHoldsUsecase) Run(ctx context.Context) (*ExpireHoldsDTO, error) {
+	now := u.now()
+	holds, err := u.holdRepo.ListExpired(ctx, now, holdDomain.ExpireBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &ExpireHoldsDTO{}
+	var errs []error
+	for _, hold := range holds {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		err := u.expire(ctx, hold.ID(), now)
+		if err == nil {
+			dto.Expired++
+			continue
+		}
+		if isTransient(err) {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("hold %s: %w", hold.IDString(), err))
+	}
+
+	return dto, errors.Join(errs...)
+}
+
+// 仮押さえを読み直し、まだ期限切れにすべき場合のみ期限切れにします。
+func (u *expireHoldsUsecase) expire(ctx context.Context, id idVO.HoldID, now time.Time) error {
+	return u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		hold, err := u.holdRepo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if hold == nil || !hold.IsExpired(now) {
+			return errNotExpired
+		}
+		return u.holdServ.Expire(ctx, hold, now)
+	})
+}
+
+// 次回の実行に持ち越すエラーかを返します。
+// 他の処理と更新が競合した場合や中断された場合は、次回に読み直して実行すれば成功する可能性があります。
+func isTransient(err error) bool {
+	return errors.Is(err, errNotExpired) ||
+		errors.Is(err, holdDomain.ErrConcurrentModification) ||
+		errors.Is(err, accountDomain.ErrConcurrentModification) ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,136 @@
+package hold_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	holdUC "github.com/u104rak1/pocgo/internal/application/hold"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestExpireHoldsUsecase(t *testing.T) {
+	type Mocks struct {
+		holdServ *domainMock.MockIHoldService
+		holdRepo *domainMock.MockIHoldRepository
+	}
+
+	var (
+		accountID = idVO.NewAccountIDForTest("account")
+		createdAt = timer.GetFixedDate()
+		now       = createdAt.Add(holdDomain.DefaultExpiry)
+		clock     = func() time.Time { return now }
+		arg       = gomock.Any()
+	)
+
+	// 2021-01-01 に作成され、now に有効期限を迎える仮押さえを作成します。
+	newExpiredHold := func(t *testing.T) *holdDomain.Hold {
+		hold, err := holdDomain.New(accountID, nil, 1000, moneyVO.JPY, now, createdAt)
+		assert.NoError(t, err)
+		return hold
+	}
+
+	// 読み直した仮押さえとして、保存されている仮押さえのコピーを返します。
+	reload := func(hold *holdDomain.Hold) *holdDomain.Hold {
+		copied := *hold
+		return &copied
+	}
+
+	tests := []struct {
+		caseName    string
+		prepare     func(mocks Mocks, holds []*holdDomain.Hold)
+		wantExpired int
+		wantErr     error
+	}{
+		{
+			caseName: "Positive: 有効期限を過ぎた仮押さえを期限切れにできる",
+			prepare: func(mocks Mocks, holds []*holdDomain.Hold) {
+				mocks.holdRepo.EXPECT().ListExpired(arg, now, holdDomain.ExpireBatchSize).Return(holds, nil)
+				for _, hold := range holds {
+					mocks.holdRepo.EXPECT().FindByID(arg, hold.ID()).Return(reload(hold), nil)
+					mocks.holdServ.EXPECT().Expire(arg, arg, now).Return(nil)
+				}
+			},
+			wantExpired: 2,
+			wantErr:     nil,
+		},
+		{
+			caseName: "Positive: 読み直した仮押さえが既に取り消されている場合は何もしない",
+			prepare: func(mocks Mocks, holds []*holdDomain.Hold) {
+				mocks.holdRepo.EXPECT().ListExpired(arg, now, holdDomain.ExpireBatchSize).Return(holds, nil)
+				voided := reload(holds[0])
+				assert.NoError(t, voided.Void(now))
+				mocks.holdRepo.EXPECT().FindByID(arg, holds[0].ID()).Return(voided, nil)
+				mocks.holdRepo.EXPECT().FindByID(arg, holds[1].ID()).Return(nil, nil)
+			},
+			wantExpired: 0,
+			wantErr:     nil,
+		},
+		{
+			caseName: "Positive: 更新が競合した仮押さえは次回に持ち越す",
+			prepare: func(mocks Mocks, holds []*holdDomain.Hold) {
+				mocks.holdRepo.EXPECT().ListExpired(arg, now, holdDomain.ExpireBatchSize).Return(holds, nil)
+				mocks.holdRepo.EXPECT().FindByID(arg, holds[0].ID()).Return(reload(holds[0]), nil)
+				mocks.holdServ.EXPECT().Expire(arg, arg, now).Return(accountDomain.ErrConcurrentModification)
+				mocks.holdRepo.EXPECT().FindByID(arg, holds[1].ID()).Return(reload(holds[1]), nil)
+				mocks.holdServ.EXPECT().Expire(arg, arg, now).Return(nil)
+			},
+			wantExpired: 1,
+			wantErr:     nil,
+		},
+		{
+			caseName: "Negative: 失敗した仮押さえがあっても次の仮押さえを処理する",
+			prepare: func(mocks Mocks, holds []*holdDomain.Hold) {
+				mocks.holdRepo.EXPECT().ListExpired(arg, now, holdDomain.ExpireBatchSize).Return(holds, nil)
+				mocks.holdRepo.EXPECT().FindByID(arg, holds[0].ID()).Return(reload(holds[0]), nil)
+				mocks.holdServ.EXPECT().Expire(arg, arg, now).Return(assert.AnError)
+				mocks.holdRepo.EXPECT().FindByID(arg, holds[1].ID()).Return(reload(holds[1]), nil)
+				mocks.holdServ.EXPECT().Expire(arg, arg, now).Return(nil)
+			},
+			wantExpired: 1,
+			wantErr:     assert.AnError,
+		},
+		{
+			caseName: "Negative: 仮押さえの取得に失敗する",
+			prepare: func(mocks Mocks, holds []*holdDomain.Hold) {
+				mocks.holdRepo.EXPECT().ListExpired(arg, now, holdDomain.ExpireBatchSize).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				holdServ: domainMock.NewMockIHoldService(ctrl),
+				holdRepo: domainMock.NewMockIHoldRepository(ctrl),
+			}
+			holds := []*holdDomain.Hold{newExpiredHold(t), newExpiredHold(t)}
+			uc := holdUC.NewExpireHoldsUsecase(mocks.holdServ, mocks.holdRepo, &appMock.MockIUnitOfWork{}, clock)
+			tt.prepare(mocks, holds)
+
+			result, err := uc.Run(context.Background())
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if result != nil {
+				assert.Equal(t, tt.wantExpired, result.Expired)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package hold
+
+import (
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// 仮押さえの各ユースケースが返す仮押さえです。日時は ISO8601 形式です。
+type HoldDTO struct {
+	ID                string
+	AccountID         string
+	ReceiverAccountID *string
+	Amount            string
+	Currency          string
+	Status            string
+	ExpiresAt         string
+	CapturedAmount    *string
+	TransactionID     *string
+	CreatedAt         string
+	UpdatedAt         string
+}
+
+func newHoldDTO(hold *holdDomain.Hold) HoldDTO {
+	return HoldDTO{
+		ID:                hold.IDString(),
+		AccountID:         hold.AccountIDString(),
+		ReceiverAccountID: hold.ReceiverAccountIDString(),
+		Amount:            hold.Amount().Decimal(),
+		Currency:          hold.Amount().Currency(),
+		Status:            hold.Status(),
+		ExpiresAt:         hold.ExpiresAtString(),
+		CapturedAmount:    hold.CapturedAmountDecimal(),
+		TransactionID:     hold.TransactionIDString(),
+		CreatedAt:         timer.FormatToISO8601(hold.CreatedAt()),
+		UpdatedAt:         hold.UpdatedAtString(),
+	}
+}
@@ -0,0 +1,70 @@
+package hold
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IListHoldsUsecase interface {
+	Run(ctx context.Context, cmd ListHoldsCommand) (*ListHoldsDTO, error)
+}
+
+type listHoldsUsecase struct {
+	accountServ accountDomain.IAccountService
+	holdRepo    holdDomain.IHoldRepository
+}
+
+func NewListHoldsUsecase(
+	accountService accountDomain.IAccountService,
+	holdRepository holdDomain.IHoldRepository,
+) IListHoldsUsecase {
+	return &listHoldsUsecase{
+		accountServ: accountService,
+		holdRepo:    holdRepository,
+	}
+}
+
+type ListHoldsCommand struct {
+	UserID    string
+	AccountID string
+	// 指定した場合はその状態の仮押さえのみを返します。
+	Status *string
+}
+
+type ListHoldsDTO struct {
+	Holds []HoldDTO
+}
+
+// 口座の仮押さえを新しい順に返します。
+func (u *listHoldsUsecase) Run(ctx context.Context, cmd ListHoldsCommand) (*ListHoldsDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil); err != nil {
+		return nil, err
+	}
+
+	holds, err := u.holdRepo.ListByAccountID(ctx, accountID, cmd.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	holdDTOs := make([]HoldDTO, len(holds))
+	for i, hold := range holds {
+		holdDTOs[i] = newHoldDTO(hold)
+	}
+
+	return &ListHoldsDTO{
+		Holds: holdDTOs,
+	}, nil
+}
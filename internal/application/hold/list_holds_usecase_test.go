@@ -0,0 +1,133 @@
+package hold_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	holdUC "github.com/u104rak1/pocgo/internal/application/hold"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// 現在時刻から 1000 円を出金する仮押さえを作成します。
+func newHold(t *testing.T, accountID idVO.AccountID) *holdDomain.Hold {
+	t.Helper()
+	now := timer.Now()
+	hold, err := holdDomain.New(accountID, nil, 1000, moneyVO.JPY, now.Add(holdDomain.DefaultExpiry), now)
+	assert.NoError(t, err)
+	return hold
+}
+
+func TestListHoldsUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ *domainMock.MockIAccountService
+		holdRepo    *domainMock.MockIHoldRepository
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		status    = holdDomain.StatusAuthorized
+		arg       = gomock.Any()
+	)
+
+	happyCmd := holdUC.ListHoldsCommand{
+		UserID:    userID.String(),
+		AccountID: accountID.String(),
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      holdUC.ListHoldsCommand
+		prepare  func(mocks Mocks, hold *holdDomain.Hold)
+		wantLen  int
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 口座の仮押さえの一覧を取得できる",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.holdRepo.EXPECT().ListByAccountID(arg, accountID, nil).Return([]*holdDomain.Hold{hold}, nil)
+			},
+			wantLen: 1,
+			wantErr: nil,
+		},
+		{
+			caseName: "Positive: 状態で絞り込める",
+			cmd: holdUC.ListHoldsCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+				Status:    strutil.StrPointer(status),
+			},
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.holdRepo.EXPECT().ListByAccountID(arg, accountID, &status).Return([]*holdDomain.Hold{hold}, nil)
+			},
+			wantLen: 1,
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 口座 ID が不正である",
+			cmd: holdUC.ListHoldsCommand{
+				UserID:    userID.String(),
+				AccountID: "invalid",
+			},
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {},
+			wantErr: idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: 他のユーザーの口座である",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: accountDomain.ErrUnauthorized,
+		},
+		{
+			caseName: "Negative: 仮押さえの取得に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.holdRepo.EXPECT().ListByAccountID(arg, accountID, nil).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+				holdRepo:    domainMock.NewMockIHoldRepository(ctrl),
+			}
+			hold := newHold(t, accountID)
+			uc := holdUC.NewListHoldsUsecase(mocks.accountServ, mocks.holdRepo)
+			tt.prepare(mocks, hold)
+
+			result, err := uc.Run(context.Background(), tt.cmd)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.Holds, tt.wantLen)
+				assert.Equal(t, hold.IDString(), result.Holds[0].ID)
+				assert.Equal(t, "1000", result.Holds[0].Amount)
+				assert.Equal(t, holdDomain.StatusAuthorized, result.Holds[0].Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package hold
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IReadHoldUsecase interface {
+	Run(ctx context.Context, cmd ReadHoldCommand) (*HoldDTO, error)
+}
+
+type readHoldUsecase struct {
+	accountServ accountDomain.IAccountService
+	holdServ    holdDomain.IHoldService
+}
+
+func NewReadHoldUsecase(
+	accountService accountDomain.IAccountService,
+	holdService holdDomain.IHoldService,
+) IReadHoldUsecase {
+	return &readHoldUsecase{
+		accountServ: accountService,
+		holdServ:    holdService,
+	}
+}
+
+type ReadHoldCommand struct {
+	UserID    string
+	AccountID string
+	HoldID    string
+}
+
+func (u *readHoldUsecase) Run(ctx context.Context, cmd ReadHoldCommand) (*HoldDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	holdID, err := idVO.HoldIDFromString(cmd.HoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil); err != nil {
+		return nil, err
+	}
+
+	hold, err := u.holdServ.GetByAccount(ctx, accountID, holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := newHoldDTO(hold)
+	return &dto, nil
+}
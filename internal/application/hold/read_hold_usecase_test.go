@@ -0,0 +1,101 @@
+package hold_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	holdUC "github.com/u104rak1/pocgo/internal/application/hold"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestReadHoldUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ *domainMock.MockIAccountService
+		holdServ    *domainMock.MockIHoldService
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		arg       = gomock.Any()
+	)
+
+	tests := []struct {
+		caseName string
+		holdID   func(hold *holdDomain.Hold) string
+		prepare  func(mocks Mocks, hold *holdDomain.Hold)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 仮押さえを取得できる",
+			holdID:   func(hold *holdDomain.Hold) string { return hold.IDString() },
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.holdServ.EXPECT().GetByAccount(arg, accountID, hold.ID()).Return(hold, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 仮押さえ ID が不正である",
+			holdID:   func(hold *holdDomain.Hold) string { return "invalid" },
+			prepare:  func(mocks Mocks, hold *holdDomain.Hold) {},
+			wantErr:  idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: 他のユーザーの口座である",
+			holdID:   func(hold *holdDomain.Hold) string { return hold.IDString() },
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: accountDomain.ErrUnauthorized,
+		},
+		{
+			caseName: "Negative: 仮押さえが存在しない",
+			holdID:   func(hold *holdDomain.Hold) string { return hold.IDString() },
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.holdServ.EXPECT().GetByAccount(arg, accountID, hold.ID()).Return(nil, holdDomain.ErrNotFound)
+			},
+			wantErr: holdDomain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+				holdServ:    domainMock.NewMockIHoldService(ctrl),
+			}
+			hold := newHold(t, accountID)
+			uc := holdUC.NewReadHoldUsecase(mocks.accountServ, mocks.holdServ)
+			tt.prepare(mocks, hold)
+
+			result, err := uc.Run(context.Background(), holdUC.ReadHoldCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+				HoldID:    tt.holdID(hold),
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, hold.IDString(), result.ID)
+				assert.Equal(t, accountID.String(), result.AccountID)
+				assert.Nil(t, result.ReceiverAccountID)
+				assert.Equal(t, "1000", result.Amount)
+				assert.Equal(t, hold.ExpiresAtString(), result.ExpiresAt)
+			}
+		})
+	}
+}
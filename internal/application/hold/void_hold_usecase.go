@@ -0,0 +1,76 @@
+package hold
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IVoidHoldUsecase interface {
+	Run(ctx context.Context, cmd VoidHoldCommand) (*HoldDTO, error)
+}
+
+type voidHoldUsecase struct {
+	accountServ accountDomain.IAccountService
+	holdServ    holdDomain.IHoldService
+	unitOfWork  unitofwork.IUnitOfWork
+}
+
+func NewVoidHoldUsecase(
+	accountService accountDomain.IAccountService,
+	holdService holdDomain.IHoldService,
+	unitOfWork unitofwork.IUnitOfWork,
+) IVoidHoldUsecase {
+	return &voidHoldUsecase{
+		accountServ: accountService,
+		holdServ:    holdService,
+		unitOfWork:  unitOfWork,
+	}
+}
+
+type VoidHoldCommand struct {
+	UserID    string
+	AccountID string
+	HoldID    string
+}
+
+// 仮押さえを取り消し、仮押さえした金額を再び利用できるようにします。
+func (u *voidHoldUsecase) Run(ctx context.Context, cmd VoidHoldCommand) (*HoldDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	holdID, err := idVO.HoldIDFromString(cmd.HoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	var hold *holdDomain.Hold
+	if err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil)
+		if err != nil {
+			return err
+		}
+
+		hold, err = u.holdServ.GetByAccount(ctx, accountID, holdID)
+		if err != nil {
+			return err
+		}
+
+		return u.holdServ.Void(ctx, account, hold)
+	}); err != nil {
+		return nil, err
+	}
+
+	dto := newHoldDTO(hold)
+	return &dto, nil
+}
@@ -0,0 +1,98 @@
+package hold_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	holdUC "github.com/u104rak1/pocgo/internal/application/hold"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestVoidHoldUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ *domainMock.MockIAccountService
+		holdServ    *domainMock.MockIHoldService
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		arg       = gomock.Any()
+	)
+
+	tests := []struct {
+		caseName string
+		prepare  func(mocks Mocks, hold *holdDomain.Hold)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 仮押さえを取り消せる",
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.holdServ.EXPECT().GetByAccount(arg, accountID, hold.ID()).Return(hold, nil)
+				mocks.holdServ.EXPECT().Void(arg, nil, hold).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 他のユーザーの口座である",
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: accountDomain.ErrUnauthorized,
+		},
+		{
+			caseName: "Negative: 仮押さえが存在しない",
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.holdServ.EXPECT().GetByAccount(arg, accountID, hold.ID()).Return(nil, holdDomain.ErrNotFound)
+			},
+			wantErr: holdDomain.ErrNotFound,
+		},
+		{
+			caseName: "Negative: 既に確定されている",
+			prepare: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.holdServ.EXPECT().GetByAccount(arg, accountID, hold.ID()).Return(hold, nil)
+				mocks.holdServ.EXPECT().Void(arg, nil, hold).Return(holdDomain.ErrNotAuthorized)
+			},
+			wantErr: holdDomain.ErrNotAuthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+				holdServ:    domainMock.NewMockIHoldService(ctrl),
+			}
+			hold := newHold(t, accountID)
+			uc := holdUC.NewVoidHoldUsecase(mocks.accountServ, mocks.holdServ, &appMock.MockIUnitOfWork{})
+			tt.prepare(mocks, hold)
+
+			result, err := uc.Run(context.Background(), holdUC.VoidHoldCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+				HoldID:    hold.IDString(),
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, hold.IDString(), result.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const KeyMaxLength = 255
+
+var (
+	ErrKeyAlreadyExists  = errors.New("idempotency key already exists")
+	ErrKeyReused         = errors.New("idempotency key has already been used for a different request")
+	ErrRequestInProgress = errors.New("a request with the same idempotency key is in progress")
+)
+
+// IdempotencyKey はユーザーごとの Idempotency-Key と、最初のリクエストに対するレスポンスを保持します。
+// Response が nil の場合は、最初のリクエストが処理中であることを表します。
+type IdempotencyKey struct {
+	UserID      string
+	Key         string
+	Fingerprint string
+	Response    []byte
+	CreatedAt   time.Time
+}
+
+type IIdempotencyKeyRepository interface {
+	// キーを登録します。同じユーザー・キーが既に存在する場合は ErrKeyAlreadyExists を返します。
+	Create(ctx context.Context, key *IdempotencyKey) error
+	FindByUserIDAndKey(ctx context.Context, userID, key string) (*IdempotencyKey, error)
+	SaveResponse(ctx context.Context, userID, key string, response []byte) error
+	// レスポンスが保存されていない（処理中の）キーを削除します。
+	// ロールバックを持たないストアで、処理が失敗したキーを再利用できるようにする為に使用します。
+	DeletePending(ctx context.Context, userID, key string) error
+}
+
+// リクエストの内容から、同一リクエストかどうかを判定する為のフィンガープリント（SHA-256）を生成します。
+func Fingerprint(request interface{}) (string, error) {
+	b, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
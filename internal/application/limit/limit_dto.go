@@ -0,0 +1,33 @@
+package limit
+
+import (
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+)
+
+// 上限の金額です。金額は10進数表記の文字列です。
+type LimitsDTO struct {
+	PerTransaction string
+	Daily          string
+	Monthly        string
+}
+
+// 取引種別ごとの口座の上限です。
+type AccountLimitDTO struct {
+	OperationType string
+	Currency      string
+	// 口座に適用される上限です。上限がない場合は nil です。
+	Effective *LimitsDTO
+	// 既定の上限です。設定されていない場合は nil です。
+	Default *LimitsDTO
+}
+
+func newLimitsDTO(limits *limitDomain.Limits) *LimitsDTO {
+	if limits == nil {
+		return nil
+	}
+	return &LimitsDTO{
+		PerTransaction: limits.PerTransaction().Decimal(),
+		Daily:          limits.Daily().Decimal(),
+		Monthly:        limits.Monthly().Decimal(),
+	}
+}
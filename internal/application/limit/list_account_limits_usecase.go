@@ -0,0 +1,88 @@
+package limit
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IListAccountLimitsUsecase interface {
+	Run(ctx context.Context, cmd ListAccountLimitsCommand) (*ListAccountLimitsDTO, error)
+}
+
+type listAccountLimitsUsecase struct {
+	accountServ accountDomain.IAccountService
+	limitServ   limitDomain.ILimitService
+}
+
+func NewListAccountLimitsUsecase(
+	accountService accountDomain.IAccountService,
+	limitService limitDomain.ILimitService,
+) IListAccountLimitsUsecase {
+	return &listAccountLimitsUsecase{
+		accountServ: accountService,
+		limitServ:   limitService,
+	}
+}
+
+type ListAccountLimitsCommand struct {
+	UserID    string
+	AccountID string
+}
+
+type ListAccountLimitsDTO struct {
+	Limits []AccountLimitDTO
+}
+
+// 上限を設定できる取引種別ごとに、口座に適用される上限と既定の上限を取得します。
+func (u *listAccountLimitsUsecase) Run(ctx context.Context, cmd ListAccountLimitsCommand) (*ListAccountLimitsDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := make([]AccountLimitDTO, 0, len(limitDomain.OperationTypes))
+	for _, operationType := range limitDomain.OperationTypes {
+		dto, err := getAccountLimit(ctx, u.limitServ, account, operationType)
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, *dto)
+	}
+	return &ListAccountLimitsDTO{Limits: limits}, nil
+}
+
+func getAccountLimit(
+	ctx context.Context,
+	limitServ limitDomain.ILimitService,
+	account *accountDomain.Account,
+	operationType string,
+) (*AccountLimitDTO, error) {
+	currency := account.Balance().Currency()
+	effective, err := limitServ.GetEffective(ctx, account.ID(), operationType, currency)
+	if err != nil {
+		return nil, err
+	}
+	defaultLimits, err := limitServ.GetDefault(ctx, operationType, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountLimitDTO{
+		OperationType: operationType,
+		Currency:      currency,
+		Effective:     newLimitsDTO(effective),
+		Default:       newLimitsDTO(defaultLimits),
+	}, nil
+}
@@ -0,0 +1,119 @@
+package limit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	limitUC "github.com/u104rak1/pocgo/internal/application/limit"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func newAccount(t *testing.T) *accountDomain.Account {
+	t.Helper()
+	account, err := accountDomain.New(idVO.NewUserIDForTest("user"), 0, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	return account
+}
+
+func newLimits(t *testing.T, operationType string, perTransaction, daily, monthly int64) *limitDomain.Limits {
+	t.Helper()
+	limits, err := limitDomain.NewLimits(operationType, perTransaction, daily, monthly, moneyVO.JPY)
+	assert.NoError(t, err)
+	return limits
+}
+
+func TestListAccountLimitsUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ *domainMock.MockIAccountService
+		limitServ   *domainMock.MockILimitService
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		arg       = gomock.Any()
+	)
+
+	tests := []struct {
+		caseName string
+		prepare  func(mocks Mocks, account *accountDomain.Account)
+		want     *limitUC.ListAccountLimitsDTO
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 取引種別ごとに口座に適用される上限と既定の上限を取得できる",
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(account, nil)
+				withdrawalDefault := newLimits(t, limitDomain.OperationWithdrawal, 1000, 3000, 10000)
+				mocks.limitServ.EXPECT().GetEffective(arg, account.ID(), limitDomain.OperationWithdrawal, moneyVO.JPY).
+					Return(newLimits(t, limitDomain.OperationWithdrawal, 500, 3000, 10000), nil)
+				mocks.limitServ.EXPECT().GetDefault(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(withdrawalDefault, nil)
+				mocks.limitServ.EXPECT().GetEffective(arg, account.ID(), limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
+				mocks.limitServ.EXPECT().GetDefault(arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
+			},
+			want: &limitUC.ListAccountLimitsDTO{
+				Limits: []limitUC.AccountLimitDTO{
+					{
+						OperationType: limitDomain.OperationWithdrawal,
+						Currency:      moneyVO.JPY,
+						Effective:     &limitUC.LimitsDTO{PerTransaction: "500", Daily: "3000", Monthly: "10000"},
+						Default:       &limitUC.LimitsDTO{PerTransaction: "1000", Daily: "3000", Monthly: "10000"},
+					},
+					{
+						OperationType: limitDomain.OperationTransfer,
+						Currency:      moneyVO.JPY,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 他のユーザーの口座である",
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: accountDomain.ErrUnauthorized,
+		},
+		{
+			caseName: "Negative: 上限の取得に失敗する",
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(account, nil)
+				mocks.limitServ.EXPECT().GetEffective(arg, account.ID(), limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+				limitServ:   domainMock.NewMockILimitService(ctrl),
+			}
+			tt.prepare(mocks, newAccount(t))
+			uc := limitUC.NewListAccountLimitsUsecase(mocks.accountServ, mocks.limitServ)
+
+			dto, err := uc.Run(context.Background(), limitUC.ListAccountLimitsCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, dto)
+		})
+	}
+}
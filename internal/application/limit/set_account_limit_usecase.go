@@ -0,0 +1,95 @@
+package limit
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+type ISetAccountLimitUsecase interface {
+	Run(ctx context.Context, cmd SetAccountLimitCommand) (*AccountLimitDTO, error)
+}
+
+type setAccountLimitUsecase struct {
+	accountServ accountDomain.IAccountService
+	limitServ   limitDomain.ILimitService
+	unitOfWork  unitofwork.IUnitOfWork
+}
+
+func NewSetAccountLimitUsecase(
+	accountService accountDomain.IAccountService,
+	limitService limitDomain.ILimitService,
+	unitOfWork unitofwork.IUnitOfWork,
+) ISetAccountLimitUsecase {
+	return &setAccountLimitUsecase{
+		accountServ: accountService,
+		limitServ:   limitService,
+		unitOfWork:  unitOfWork,
+	}
+}
+
+// 金額は "10.99" のような10進数表記で、口座の通貨で解釈します。
+type SetAccountLimitCommand struct {
+	UserID         string
+	AccountID      string
+	OperationType  string
+	Password       string
+	PerTransaction string
+	Daily          string
+	Monthly        string
+}
+
+// 口座の上限を設定し、設定後に口座に適用される上限を返します。
+func (u *setAccountLimitUsecase) Run(ctx context.Context, cmd SetAccountLimitCommand) (*AccountLimitDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var dto *AccountLimitDTO
+	if err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, &cmd.Password)
+		if err != nil {
+			return err
+		}
+
+		limits, err := newLimits(cmd, account.Balance().Currency())
+		if err != nil {
+			return err
+		}
+		if _, err := u.limitServ.Set(ctx, account, *limits); err != nil {
+			return err
+		}
+
+		dto, err = getAccountLimit(ctx, u.limitServ, account, cmd.OperationType)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return dto, nil
+}
+
+func newLimits(cmd SetAccountLimitCommand, currency string) (*limitDomain.Limits, error) {
+	perTransaction, err := moneyVO.NewFromDecimal(cmd.PerTransaction, currency)
+	if err != nil {
+		return nil, err
+	}
+	daily, err := moneyVO.NewFromDecimal(cmd.Daily, currency)
+	if err != nil {
+		return nil, err
+	}
+	monthly, err := moneyVO.NewFromDecimal(cmd.Monthly, currency)
+	if err != nil {
+		return nil, err
+	}
+	return limitDomain.NewLimits(cmd.OperationType, perTransaction.Amount(), daily.Amount(), monthly.Amount(), currency)
+}
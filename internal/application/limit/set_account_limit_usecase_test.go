@@ -0,0 +1,129 @@
+package limit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	limitUC "github.com/u104rak1/pocgo/internal/application/limit"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func TestSetAccountLimitUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ *domainMock.MockIAccountService
+		limitServ   *domainMock.MockILimitService
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		password  = "1234"
+		arg       = gomock.Any()
+	)
+
+	happyCmd := limitUC.SetAccountLimitCommand{
+		UserID:         userID.String(),
+		AccountID:      accountID.String(),
+		OperationType:  limitDomain.OperationWithdrawal,
+		Password:       password,
+		PerTransaction: "500",
+		Daily:          "3000",
+		Monthly:        "5000",
+	}
+	decimalCmd := happyCmd
+	decimalCmd.PerTransaction = "500.5"
+	inconsistentCmd := happyCmd
+	inconsistentCmd.Daily = "100"
+
+	tests := []struct {
+		caseName string
+		cmd      limitUC.SetAccountLimitCommand
+		prepare  func(mocks Mocks, account *accountDomain.Account)
+		want     *limitUC.AccountLimitDTO
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 口座の上限を設定し、適用される上限が返る",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				limits := newLimits(t, limitDomain.OperationWithdrawal, 500, 3000, 5000)
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.limitServ.EXPECT().Set(arg, account, *limits).Return(nil, nil)
+				mocks.limitServ.EXPECT().GetEffective(arg, account.ID(), limitDomain.OperationWithdrawal, moneyVO.JPY).Return(limits, nil)
+				mocks.limitServ.EXPECT().GetDefault(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).
+					Return(newLimits(t, limitDomain.OperationWithdrawal, 1000, 3000, 10000), nil)
+			},
+			want: &limitUC.AccountLimitDTO{
+				OperationType: limitDomain.OperationWithdrawal,
+				Currency:      moneyVO.JPY,
+				Effective:     &limitUC.LimitsDTO{PerTransaction: "500", Daily: "3000", Monthly: "5000"},
+				Default:       &limitUC.LimitsDTO{PerTransaction: "1000", Daily: "3000", Monthly: "10000"},
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 口座のパスワードが一致しない",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			wantErr: accountDomain.ErrUnmatchedPassword,
+		},
+		{
+			caseName: "Negative: 金額が口座の通貨の小数点以下の桁数を超える",
+			cmd:      decimalCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+			},
+			wantErr: moneyVO.ErrInvalidPrecision,
+		},
+		{
+			caseName: "Negative: 1回の上限が1日の上限を超える",
+			cmd:      inconsistentCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+			},
+			wantErr: limitDomain.ErrInconsistentLimits,
+		},
+		{
+			caseName: "Negative: 既定の上限を超える",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.limitServ.EXPECT().Set(arg, account, arg).Return(nil, limitDomain.ErrLooserThanDefault)
+			},
+			wantErr: limitDomain.ErrLooserThanDefault,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+				limitServ:   domainMock.NewMockILimitService(ctrl),
+			}
+			tt.prepare(mocks, newAccount(t))
+			uc := limitUC.NewSetAccountLimitUsecase(mocks.accountServ, mocks.limitServ, &appMock.MockIUnitOfWork{})
+
+			dto, err := uc.Run(context.Background(), tt.cmd)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, dto)
+		})
+	}
+}
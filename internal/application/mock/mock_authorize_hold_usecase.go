@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/hold/authorize_hold_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	hold "github.com/u104rak1/pocgo/internal/application/hold"
+)
+
+// MockIAuthorizeHoldUsecase is a mock of IAuthorizeHoldUsecase interface.
+type MockIAuthorizeHoldUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuthorizeHoldUsecaseMockRecorder
+}
+
+// MockIAuthorizeHoldUsecaseMockRecorder is the mock recorder for MockIAuthorizeHoldUsecase.
+type MockIAuthorizeHoldUsecaseMockRecorder struct {
+	mock *MockIAuthorizeHoldUsecase
+}
+
+// NewMockIAuthorizeHoldUsecase creates a new mock instance.
+func NewMockIAuthorizeHoldUsecase(ctrl *gomock.Controller) *MockIAuthorizeHoldUsecase {
+	mock := &MockIAuthorizeHoldUsecase{ctrl: ctrl}
+	mock.recorder = &MockIAuthorizeHoldUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAuthorizeHoldUsecase) EXPECT() *MockIAuthorizeHoldUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIAuthorizeHoldUsecase) Run(ctx context.Context, cmd hold.AuthorizeHoldCommand) (*hold.HoldDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*hold.HoldDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIAuthorizeHoldUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIAuthorizeHoldUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/standing_order/cancel_standing_order_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	standingorder "github.com/u104rak1/pocgo/internal/application/standing_order"
+)
+
+// MockICancelStandingOrderUsecase is a mock of ICancelStandingOrderUsecase interface.
+type MockICancelStandingOrderUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICancelStandingOrderUsecaseMockRecorder
+}
+
+// MockICancelStandingOrderUsecaseMockRecorder is the mock recorder for MockICancelStandingOrderUsecase.
+type MockICancelStandingOrderUsecaseMockRecorder struct {
+	mock *MockICancelStandingOrderUsecase
+}
+
+// NewMockICancelStandingOrderUsecase creates a new mock instance.
+func NewMockICancelStandingOrderUsecase(ctrl *gomock.Controller) *MockICancelStandingOrderUsecase {
+	mock := &MockICancelStandingOrderUsecase{ctrl: ctrl}
+	mock.recorder = &MockICancelStandingOrderUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICancelStandingOrderUsecase) EXPECT() *MockICancelStandingOrderUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockICancelStandingOrderUsecase) Run(ctx context.Context, cmd standingorder.CancelStandingOrderCommand) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockICancelStandingOrderUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockICancelStandingOrderUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/hold/capture_hold_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	hold "github.com/u104rak1/pocgo/internal/application/hold"
+)
+
+// MockICaptureHoldUsecase is a mock of ICaptureHoldUsecase interface.
+type MockICaptureHoldUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICaptureHoldUsecaseMockRecorder
+}
+
+// MockICaptureHoldUsecaseMockRecorder is the mock recorder for MockICaptureHoldUsecase.
+type MockICaptureHoldUsecaseMockRecorder struct {
+	mock *MockICaptureHoldUsecase
+}
+
+// NewMockICaptureHoldUsecase creates a new mock instance.
+func NewMockICaptureHoldUsecase(ctrl *gomock.Controller) *MockICaptureHoldUsecase {
+	mock := &MockICaptureHoldUsecase{ctrl: ctrl}
+	mock.recorder = &MockICaptureHoldUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICaptureHoldUsecase) EXPECT() *MockICaptureHoldUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockICaptureHoldUsecase) Run(ctx context.Context, cmd hold.CaptureHoldCommand) (*hold.HoldDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*hold.HoldDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockICaptureHoldUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockICaptureHoldUsecase)(nil).Run), ctx, cmd)
+}
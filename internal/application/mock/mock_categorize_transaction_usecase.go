@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/transaction/categorize_transaction_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	transaction "github.com/u104rak1/pocgo/internal/application/transaction"
+)
+
+// MockICategorizeTransactionUsecase is a mock of ICategorizeTransactionUsecase interface.
+type MockICategorizeTransactionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICategorizeTransactionUsecaseMockRecorder
+}
+
+// MockICategorizeTransactionUsecaseMockRecorder is the mock recorder for MockICategorizeTransactionUsecase.
+type MockICategorizeTransactionUsecaseMockRecorder struct {
+	mock *MockICategorizeTransactionUsecase
+}
+
+// NewMockICategorizeTransactionUsecase creates a new mock instance.
+func NewMockICategorizeTransactionUsecase(ctrl *gomock.Controller) *MockICategorizeTransactionUsecase {
+	mock := &MockICategorizeTransactionUsecase{ctrl: ctrl}
+	mock.recorder = &MockICategorizeTransactionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICategorizeTransactionUsecase) EXPECT() *MockICategorizeTransactionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockICategorizeTransactionUsecase) Run(ctx context.Context, cmd transaction.CategorizeTransactionCommand) (*transaction.ReadTransactionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*transaction.ReadTransactionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockICategorizeTransactionUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockICategorizeTransactionUsecase)(nil).Run), ctx, cmd)
+}
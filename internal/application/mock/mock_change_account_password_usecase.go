@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/account/change_account_password_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	account "github.com/u104rak1/pocgo/internal/application/account"
+)
+
+// MockIChangeAccountPasswordUsecase is a mock of IChangeAccountPasswordUsecase interface.
+type MockIChangeAccountPasswordUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIChangeAccountPasswordUsecaseMockRecorder
+}
+
+// MockIChangeAccountPasswordUsecaseMockRecorder is the mock recorder for MockIChangeAccountPasswordUsecase.
+type MockIChangeAccountPasswordUsecaseMockRecorder struct {
+	mock *MockIChangeAccountPasswordUsecase
+}
+
+// NewMockIChangeAccountPasswordUsecase creates a new mock instance.
+func NewMockIChangeAccountPasswordUsecase(ctrl *gomock.Controller) *MockIChangeAccountPasswordUsecase {
+	mock := &MockIChangeAccountPasswordUsecase{ctrl: ctrl}
+	mock.recorder = &MockIChangeAccountPasswordUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIChangeAccountPasswordUsecase) EXPECT() *MockIChangeAccountPasswordUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIChangeAccountPasswordUsecase) Run(ctx context.Context, cmd account.ChangeAccountPasswordCommand) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIChangeAccountPasswordUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIChangeAccountPasswordUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/account/close_account_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	account "github.com/u104rak1/pocgo/internal/application/account"
+)
+
+// MockICloseAccountUsecase is a mock of ICloseAccountUsecase interface.
+type MockICloseAccountUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICloseAccountUsecaseMockRecorder
+}
+
+// MockICloseAccountUsecaseMockRecorder is the mock recorder for MockICloseAccountUsecase.
+type MockICloseAccountUsecaseMockRecorder struct {
+	mock *MockICloseAccountUsecase
+}
+
+// NewMockICloseAccountUsecase creates a new mock instance.
+func NewMockICloseAccountUsecase(ctrl *gomock.Controller) *MockICloseAccountUsecase {
+	mock := &MockICloseAccountUsecase{ctrl: ctrl}
+	mock.recorder = &MockICloseAccountUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICloseAccountUsecase) EXPECT() *MockICloseAccountUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockICloseAccountUsecase) Run(ctx context.Context, cmd account.CloseAccountCommand) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockICloseAccountUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockICloseAccountUsecase)(nil).Run), ctx, cmd)
+}
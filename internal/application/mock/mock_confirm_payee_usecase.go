@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/payee/confirm_payee_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	payee "github.com/u104rak1/pocgo/internal/application/payee"
+)
+
+// MockIConfirmPayeeUsecase is a mock of IConfirmPayeeUsecase interface.
+type MockIConfirmPayeeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIConfirmPayeeUsecaseMockRecorder
+}
+
+// MockIConfirmPayeeUsecaseMockRecorder is the mock recorder for MockIConfirmPayeeUsecase.
+type MockIConfirmPayeeUsecaseMockRecorder struct {
+	mock *MockIConfirmPayeeUsecase
+}
+
+// NewMockIConfirmPayeeUsecase creates a new mock instance.
+func NewMockIConfirmPayeeUsecase(ctrl *gomock.Controller) *MockIConfirmPayeeUsecase {
+	mock := &MockIConfirmPayeeUsecase{ctrl: ctrl}
+	mock.recorder = &MockIConfirmPayeeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIConfirmPayeeUsecase) EXPECT() *MockIConfirmPayeeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIConfirmPayeeUsecase) Run(ctx context.Context, cmd payee.ConfirmPayeeCommand) (*payee.PayeeDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*payee.PayeeDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIConfirmPayeeUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIConfirmPayeeUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/standing_order/create_standing_order_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	standingorder "github.com/u104rak1/pocgo/internal/application/standing_order"
+)
+
+// MockICreateStandingOrderUsecase is a mock of ICreateStandingOrderUsecase interface.
+type MockICreateStandingOrderUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICreateStandingOrderUsecaseMockRecorder
+}
+
+// MockICreateStandingOrderUsecaseMockRecorder is the mock recorder for MockICreateStandingOrderUsecase.
+type MockICreateStandingOrderUsecaseMockRecorder struct {
+	mock *MockICreateStandingOrderUsecase
+}
+
+// NewMockICreateStandingOrderUsecase creates a new mock instance.
+func NewMockICreateStandingOrderUsecase(ctrl *gomock.Controller) *MockICreateStandingOrderUsecase {
+	mock := &MockICreateStandingOrderUsecase{ctrl: ctrl}
+	mock.recorder = &MockICreateStandingOrderUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICreateStandingOrderUsecase) EXPECT() *MockICreateStandingOrderUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockICreateStandingOrderUsecase) Run(ctx context.Context, cmd standingorder.CreateStandingOrderCommand) (*standingorder.StandingOrderDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*standingorder.StandingOrderDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockICreateStandingOrderUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockICreateStandingOrderUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/payee/delete_payee_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	payee "github.com/u104rak1/pocgo/internal/application/payee"
+)
+
+// MockIDeletePayeeUsecase is a mock of IDeletePayeeUsecase interface.
+type MockIDeletePayeeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDeletePayeeUsecaseMockRecorder
+}
+
+// MockIDeletePayeeUsecaseMockRecorder is the mock recorder for MockIDeletePayeeUsecase.
+type MockIDeletePayeeUsecaseMockRecorder struct {
+	mock *MockIDeletePayeeUsecase
+}
+
+// NewMockIDeletePayeeUsecase creates a new mock instance.
+func NewMockIDeletePayeeUsecase(ctrl *gomock.Controller) *MockIDeletePayeeUsecase {
+	mock := &MockIDeletePayeeUsecase{ctrl: ctrl}
+	mock.recorder = &MockIDeletePayeeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDeletePayeeUsecase) EXPECT() *MockIDeletePayeeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIDeletePayeeUsecase) Run(ctx context.Context, cmd payee.DeletePayeeCommand) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIDeletePayeeUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIDeletePayeeUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/event/event_publisher.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	event "github.com/u104rak1/pocgo/internal/domain/event"
+)
+
+// MockIEventPublisher is a mock of IEventPublisher interface.
+type MockIEventPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEventPublisherMockRecorder
+}
+
+// MockIEventPublisherMockRecorder is the mock recorder for MockIEventPublisher.
+type MockIEventPublisherMockRecorder struct {
+	mock *MockIEventPublisher
+}
+
+// NewMockIEventPublisher creates a new mock instance.
+func NewMockIEventPublisher(ctrl *gomock.Controller) *MockIEventPublisher {
+	mock := &MockIEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockIEventPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEventPublisher) EXPECT() *MockIEventPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockIEventPublisher) Publish(ctx context.Context, event *event.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockIEventPublisherMockRecorder) Publish(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockIEventPublisher)(nil).Publish), ctx, event)
+}
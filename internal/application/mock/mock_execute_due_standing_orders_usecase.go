@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/standing_order/execute_due_standing_orders_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	standingorder "github.com/u104rak1/pocgo/internal/application/standing_order"
+)
+
+// MockIExecuteDueStandingOrdersUsecase is a mock of IExecuteDueStandingOrdersUsecase interface.
+type MockIExecuteDueStandingOrdersUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExecuteDueStandingOrdersUsecaseMockRecorder
+}
+
+// MockIExecuteDueStandingOrdersUsecaseMockRecorder is the mock recorder for MockIExecuteDueStandingOrdersUsecase.
+type MockIExecuteDueStandingOrdersUsecaseMockRecorder struct {
+	mock *MockIExecuteDueStandingOrdersUsecase
+}
+
+// NewMockIExecuteDueStandingOrdersUsecase creates a new mock instance.
+func NewMockIExecuteDueStandingOrdersUsecase(ctrl *gomock.Controller) *MockIExecuteDueStandingOrdersUsecase {
+	mock := &MockIExecuteDueStandingOrdersUsecase{ctrl: ctrl}
+	mock.recorder = &MockIExecuteDueStandingOrdersUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExecuteDueStandingOrdersUsecase) EXPECT() *MockIExecuteDueStandingOrdersUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIExecuteDueStandingOrdersUsecase) Run(ctx context.Context) (*standingorder.ExecuteDueStandingOrdersDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(*standingorder.ExecuteDueStandingOrdersDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIExecuteDueStandingOrdersUsecaseMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIExecuteDueStandingOrdersUsecase)(nil).Run), ctx)
+}
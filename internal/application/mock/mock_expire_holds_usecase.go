@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/hold/expire_holds_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	hold "github.com/u104rak1/pocgo/internal/application/hold"
+)
+
+// MockIExpireHoldsUsecase is a mock of IExpireHoldsUsecase interface.
+type MockIExpireHoldsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExpireHoldsUsecaseMockRecorder
+}
+
+// MockIExpireHoldsUsecaseMockRecorder is the mock recorder for MockIExpireHoldsUsecase.
+type MockIExpireHoldsUsecaseMockRecorder struct {
+	mock *MockIExpireHoldsUsecase
+}
+
+// NewMockIExpireHoldsUsecase creates a new mock instance.
+func NewMockIExpireHoldsUsecase(ctrl *gomock.Controller) *MockIExpireHoldsUsecase {
+	mock := &MockIExpireHoldsUsecase{ctrl: ctrl}
+	mock.recorder = &MockIExpireHoldsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExpireHoldsUsecase) EXPECT() *MockIExpireHoldsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIExpireHoldsUsecase) Run(ctx context.Context) (*hold.ExpireHoldsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(*hold.ExpireHoldsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIExpireHoldsUsecaseMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIExpireHoldsUsecase)(nil).Run), ctx)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/transaction/export_statement_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	transaction "github.com/u104rak1/pocgo/internal/application/transaction"
+)
+
+// MockIExportStatementUsecase is a mock of IExportStatementUsecase interface.
+type MockIExportStatementUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExportStatementUsecaseMockRecorder
+}
+
+// MockIExportStatementUsecaseMockRecorder is the mock recorder for MockIExportStatementUsecase.
+type MockIExportStatementUsecaseMockRecorder struct {
+	mock *MockIExportStatementUsecase
+}
+
+// NewMockIExportStatementUsecase creates a new mock instance.
+func NewMockIExportStatementUsecase(ctrl *gomock.Controller) *MockIExportStatementUsecase {
+	mock := &MockIExportStatementUsecase{ctrl: ctrl}
+	mock.recorder = &MockIExportStatementUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExportStatementUsecase) EXPECT() *MockIExportStatementUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIExportStatementUsecase) Run(ctx context.Context, cmd transaction.ExportStatementCommand, writer transaction.IStatementWriter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd, writer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIExportStatementUsecaseMockRecorder) Run(ctx, cmd, writer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIExportStatementUsecase)(nil).Run), ctx, cmd, writer)
+}
+
+// MockIStatementWriter is a mock of IStatementWriter interface.
+type MockIStatementWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStatementWriterMockRecorder
+}
+
+// MockIStatementWriterMockRecorder is the mock recorder for MockIStatementWriter.
+type MockIStatementWriterMockRecorder struct {
+	mock *MockIStatementWriter
+}
+
+// NewMockIStatementWriter creates a new mock instance.
+func NewMockIStatementWriter(ctrl *gomock.Controller) *MockIStatementWriter {
+	mock := &MockIStatementWriter{ctrl: ctrl}
+	mock.recorder = &MockIStatementWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStatementWriter) EXPECT() *MockIStatementWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteFooter mocks base method.
+func (m *MockIStatementWriter) WriteFooter() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteFooter")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteFooter indicates an expected call of WriteFooter.
+func (mr *MockIStatementWriterMockRecorder) WriteFooter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteFooter", reflect.TypeOf((*MockIStatementWriter)(nil).WriteFooter))
+}
+
+// WriteHeader mocks base method.
+func (m *MockIStatementWriter) WriteHeader(header transaction.StatementHeaderDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteHeader", header)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteHeader indicates an expected call of WriteHeader.
+func (mr *MockIStatementWriterMockRecorder) WriteHeader(header interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteHeader", reflect.TypeOf((*MockIStatementWriter)(nil).WriteHeader), header)
+}
+
+// WriteTransaction mocks base method.
+func (m *MockIStatementWriter) WriteTransaction(transaction transaction.ListTransactionDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteTransaction", transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteTransaction indicates an expected call of WriteTransaction.
+func (mr *MockIStatementWriterMockRecorder) WriteTransaction(transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTransaction", reflect.TypeOf((*MockIStatementWriter)(nil).WriteTransaction), transaction)
+}
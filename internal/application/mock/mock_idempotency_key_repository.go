@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/idempotency/idempotency_key_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	idempotency "github.com/u104rak1/pocgo/internal/application/idempotency"
+)
+
+// MockIIdempotencyKeyRepository is a mock of IIdempotencyKeyRepository interface.
+type MockIIdempotencyKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIIdempotencyKeyRepositoryMockRecorder
+}
+
+// MockIIdempotencyKeyRepositoryMockRecorder is the mock recorder for MockIIdempotencyKeyRepository.
+type MockIIdempotencyKeyRepositoryMockRecorder struct {
+	mock *MockIIdempotencyKeyRepository
+}
+
+// NewMockIIdempotencyKeyRepository creates a new mock instance.
+func NewMockIIdempotencyKeyRepository(ctrl *gomock.Controller) *MockIIdempotencyKeyRepository {
+	mock := &MockIIdempotencyKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockIIdempotencyKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIIdempotencyKeyRepository) EXPECT() *MockIIdempotencyKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIIdempotencyKeyRepository) Create(ctx context.Context, key *idempotency.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIIdempotencyKeyRepositoryMockRecorder) Create(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIIdempotencyKeyRepository)(nil).Create), ctx, key)
+}
+
+// DeletePending mocks base method.
+func (m *MockIIdempotencyKeyRepository) DeletePending(ctx context.Context, userID, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePending", ctx, userID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePending indicates an expected call of DeletePending.
+func (mr *MockIIdempotencyKeyRepositoryMockRecorder) DeletePending(ctx, userID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePending", reflect.TypeOf((*MockIIdempotencyKeyRepository)(nil).DeletePending), ctx, userID, key)
+}
+
+// FindByUserIDAndKey mocks base method.
+func (m *MockIIdempotencyKeyRepository) FindByUserIDAndKey(ctx context.Context, userID, key string) (*idempotency.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDAndKey", ctx, userID, key)
+	ret0, _ := ret[0].(*idempotency.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDAndKey indicates an expected call of FindByUserIDAndKey.
+func (mr *MockIIdempotencyKeyRepositoryMockRecorder) FindByUserIDAndKey(ctx, userID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDAndKey", reflect.TypeOf((*MockIIdempotencyKeyRepository)(nil).FindByUserIDAndKey), ctx, userID, key)
+}
+
+// SaveResponse mocks base method.
+func (m *MockIIdempotencyKeyRepository) SaveResponse(ctx context.Context, userID, key string, response []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveResponse", ctx, userID, key, response)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveResponse indicates an expected call of SaveResponse.
+func (mr *MockIIdempotencyKeyRepositoryMockRecorder) SaveResponse(ctx, userID, key, response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveResponse", reflect.TypeOf((*MockIIdempotencyKeyRepository)(nil).SaveResponse), ctx, userID, key, response)
+}
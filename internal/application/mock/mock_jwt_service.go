@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	authentication "github.com/u104rak1/pocgo/internal/application/authentication"
 )
 
 // MockIJWTService is a mock of IJWTService interface.
@@ -34,31 +35,45 @@ func (m *MockIJWTService) EXPECT() *MockIJWTServiceMockRecorder {
 }
 
 // GenerateAccessToken mocks base method.
-func (m *MockIJWTService) GenerateAccessToken(userID string) (string, error) {
+func (m *MockIJWTService) GenerateAccessToken(userID, sessionID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAccessToken", userID)
+	ret := m.ctrl.Call(m, "GenerateAccessToken", userID, sessionID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateAccessToken indicates an expected call of GenerateAccessToken.
-func (mr *MockIJWTServiceMockRecorder) GenerateAccessToken(userID interface{}) *gomock.Call {
+func (mr *MockIJWTServiceMockRecorder) GenerateAccessToken(userID, sessionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockIJWTService)(nil).GenerateAccessToken), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockIJWTService)(nil).GenerateAccessToken), userID, sessionID)
 }
 
-// GetUserIDFromAccessToken mocks base method.
-func (m *MockIJWTService) GetUserIDFromAccessToken(accessToken string) (string, error) {
+// ParseAccessToken mocks base method.
+func (m *MockIJWTService) ParseAccessToken(accessToken string) (*authentication.AccessTokenClaims, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserIDFromAccessToken", accessToken)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ParseAccessToken", accessToken)
+	ret0, _ := ret[0].(*authentication.AccessTokenClaims)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserIDFromAccessToken indicates an expected call of GetUserIDFromAccessToken.
-func (mr *MockIJWTServiceMockRecorder) GetUserIDFromAccessToken(accessToken interface{}) *gomock.Call {
+// ParseAccessToken indicates an expected call of ParseAccessToken.
+func (mr *MockIJWTServiceMockRecorder) ParseAccessToken(accessToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseAccessToken", reflect.TypeOf((*MockIJWTService)(nil).ParseAccessToken), accessToken)
+}
+
+// PublicKeys mocks base method.
+func (m *MockIJWTService) PublicKeys() []authentication.JSONWebKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys")
+	ret0, _ := ret[0].([]authentication.JSONWebKey)
+	return ret0
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockIJWTServiceMockRecorder) PublicKeys() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDFromAccessToken", reflect.TypeOf((*MockIJWTService)(nil).GetUserIDFromAccessToken), accessToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockIJWTService)(nil).PublicKeys))
 }
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/limit/list_account_limits_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	limit "github.com/u104rak1/pocgo/internal/application/limit"
+)
+
+// MockIListAccountLimitsUsecase is a mock of IListAccountLimitsUsecase interface.
+type MockIListAccountLimitsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIListAccountLimitsUsecaseMockRecorder
+}
+
+// MockIListAccountLimitsUsecaseMockRecorder is the mock recorder for MockIListAccountLimitsUsecase.
+type MockIListAccountLimitsUsecaseMockRecorder struct {
+	mock *MockIListAccountLimitsUsecase
+}
+
+// NewMockIListAccountLimitsUsecase creates a new mock instance.
+func NewMockIListAccountLimitsUsecase(ctrl *gomock.Controller) *MockIListAccountLimitsUsecase {
+	mock := &MockIListAccountLimitsUsecase{ctrl: ctrl}
+	mock.recorder = &MockIListAccountLimitsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIListAccountLimitsUsecase) EXPECT() *MockIListAccountLimitsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIListAccountLimitsUsecase) Run(ctx context.Context, cmd limit.ListAccountLimitsCommand) (*limit.ListAccountLimitsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*limit.ListAccountLimitsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIListAccountLimitsUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIListAccountLimitsUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/account/list_accounts_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	account "github.com/u104rak1/pocgo/internal/application/account"
+)
+
+// MockIListAccountsUsecase is a mock of IListAccountsUsecase interface.
+type MockIListAccountsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIListAccountsUsecaseMockRecorder
+}
+
+// MockIListAccountsUsecaseMockRecorder is the mock recorder for MockIListAccountsUsecase.
+type MockIListAccountsUsecaseMockRecorder struct {
+	mock *MockIListAccountsUsecase
+}
+
+// NewMockIListAccountsUsecase creates a new mock instance.
+func NewMockIListAccountsUsecase(ctrl *gomock.Controller) *MockIListAccountsUsecase {
+	mock := &MockIListAccountsUsecase{ctrl: ctrl}
+	mock.recorder = &MockIListAccountsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIListAccountsUsecase) EXPECT() *MockIListAccountsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIListAccountsUsecase) Run(ctx context.Context, cmd account.ListAccountsCommand) (*account.ListAccountsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*account.ListAccountsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIListAccountsUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIListAccountsUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/hold/list_holds_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	hold "github.com/u104rak1/pocgo/internal/application/hold"
+)
+
+// MockIListHoldsUsecase is a mock of IListHoldsUsecase interface.
+type MockIListHoldsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIListHoldsUsecaseMockRecorder
+}
+
+// MockIListHoldsUsecaseMockRecorder is the mock recorder for MockIListHoldsUsecase.
+type MockIListHoldsUsecaseMockRecorder struct {
+	mock *MockIListHoldsUsecase
+}
+
+// NewMockIListHoldsUsecase creates a new mock instance.
+func NewMockIListHoldsUsecase(ctrl *gomock.Controller) *MockIListHoldsUsecase {
+	mock := &MockIListHoldsUsecase{ctrl: ctrl}
+	mock.recorder = &MockIListHoldsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIListHoldsUsecase) EXPECT() *MockIListHoldsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIListHoldsUsecase) Run(ctx context.Context, cmd hold.ListHoldsCommand) (*hold.ListHoldsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*hold.ListHoldsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIListHoldsUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIListHoldsUsecase)(nil).Run), ctx, cmd)
+}
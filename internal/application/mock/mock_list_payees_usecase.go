@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/payee/list_payees_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	payee "github.com/u104rak1/pocgo/internal/application/payee"
+)
+
+// MockIListPayeesUsecase is a mock of IListPayeesUsecase interface.
+type MockIListPayeesUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIListPayeesUsecaseMockRecorder
+}
+
+// MockIListPayeesUsecaseMockRecorder is the mock recorder for MockIListPayeesUsecase.
+type MockIListPayeesUsecaseMockRecorder struct {
+	mock *MockIListPayeesUsecase
+}
+
+// NewMockIListPayeesUsecase creates a new mock instance.
+func NewMockIListPayeesUsecase(ctrl *gomock.Controller) *MockIListPayeesUsecase {
+	mock := &MockIListPayeesUsecase{ctrl: ctrl}
+	mock.recorder = &MockIListPayeesUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIListPayeesUsecase) EXPECT() *MockIListPayeesUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIListPayeesUsecase) Run(ctx context.Context, cmd payee.ListPayeesCommand) (*payee.ListPayeesDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*payee.ListPayeesDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIListPayeesUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIListPayeesUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/authentication/list_public_keys_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	authentication "github.com/u104rak1/pocgo/internal/application/authentication"
+)
+
+// MockIListPublicKeysUsecase is a mock of IListPublicKeysUsecase interface.
+type MockIListPublicKeysUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIListPublicKeysUsecaseMockRecorder
+}
+
+// MockIListPublicKeysUsecaseMockRecorder is the mock recorder for MockIListPublicKeysUsecase.
+type MockIListPublicKeysUsecaseMockRecorder struct {
+	mock *MockIListPublicKeysUsecase
+}
+
+// NewMockIListPublicKeysUsecase creates a new mock instance.
+func NewMockIListPublicKeysUsecase(ctrl *gomock.Controller) *MockIListPublicKeysUsecase {
+	mock := &MockIListPublicKeysUsecase{ctrl: ctrl}
+	mock.recorder = &MockIListPublicKeysUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIListPublicKeysUsecase) EXPECT() *MockIListPublicKeysUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIListPublicKeysUsecase) Run(ctx context.Context) (*authentication.ListPublicKeysDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(*authentication.ListPublicKeysDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIListPublicKeysUsecaseMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIListPublicKeysUsecase)(nil).Run), ctx)
+}
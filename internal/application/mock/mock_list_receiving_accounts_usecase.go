@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/payee/list_receiving_accounts_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	payee "github.com/u104rak1/pocgo/internal/application/payee"
+)
+
+// MockIListReceivingAccountsUsecase is a mock of IListReceivingAccountsUsecase interface.
+type MockIListReceivingAccountsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIListReceivingAccountsUsecaseMockRecorder
+}
+
+// MockIListReceivingAccountsUsecaseMockRecorder is the mock recorder for MockIListReceivingAccountsUsecase.
+type MockIListReceivingAccountsUsecaseMockRecorder struct {
+	mock *MockIListReceivingAccountsUsecase
+}
+
+// NewMockIListReceivingAccountsUsecase creates a new mock instance.
+func NewMockIListReceivingAccountsUsecase(ctrl *gomock.Controller) *MockIListReceivingAccountsUsecase {
+	mock := &MockIListReceivingAccountsUsecase{ctrl: ctrl}
+	mock.recorder = &MockIListReceivingAccountsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIListReceivingAccountsUsecase) EXPECT() *MockIListReceivingAccountsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIListReceivingAccountsUsecase) Run(ctx context.Context, cmd payee.ListReceivingAccountsCommand) (*payee.ListReceivingAccountsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*payee.ListReceivingAccountsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIListReceivingAccountsUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIListReceivingAccountsUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/standing_order/list_standing_orders_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	standingorder "github.com/u104rak1/pocgo/internal/application/standing_order"
+)
+
+// MockIListStandingOrdersUsecase is a mock of IListStandingOrdersUsecase interface.
+type MockIListStandingOrdersUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIListStandingOrdersUsecaseMockRecorder
+}
+
+// MockIListStandingOrdersUsecaseMockRecorder is the mock recorder for MockIListStandingOrdersUsecase.
+type MockIListStandingOrdersUsecaseMockRecorder struct {
+	mock *MockIListStandingOrdersUsecase
+}
+
+// NewMockIListStandingOrdersUsecase creates a new mock instance.
+func NewMockIListStandingOrdersUsecase(ctrl *gomock.Controller) *MockIListStandingOrdersUsecase {
+	mock := &MockIListStandingOrdersUsecase{ctrl: ctrl}
+	mock.recorder = &MockIListStandingOrdersUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIListStandingOrdersUsecase) EXPECT() *MockIListStandingOrdersUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIListStandingOrdersUsecase) Run(ctx context.Context, cmd standingorder.ListStandingOrdersCommand) (*standingorder.ListStandingOrdersDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*standingorder.ListStandingOrdersDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIListStandingOrdersUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIListStandingOrdersUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/authentication/logout_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	authentication "github.com/u104rak1/pocgo/internal/application/authentication"
+)
+
+// MockILogoutUsecase is a mock of ILogoutUsecase interface.
+type MockILogoutUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockILogoutUsecaseMockRecorder
+}
+
+// MockILogoutUsecaseMockRecorder is the mock recorder for MockILogoutUsecase.
+type MockILogoutUsecaseMockRecorder struct {
+	mock *MockILogoutUsecase
+}
+
+// NewMockILogoutUsecase creates a new mock instance.
+func NewMockILogoutUsecase(ctrl *gomock.Controller) *MockILogoutUsecase {
+	mock := &MockILogoutUsecase{ctrl: ctrl}
+	mock.recorder = &MockILogoutUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILogoutUsecase) EXPECT() *MockILogoutUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockILogoutUsecase) Run(ctx context.Context, cmd authentication.LogoutCommand) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockILogoutUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockILogoutUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/account/read_account_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	account "github.com/u104rak1/pocgo/internal/application/account"
+)
+
+// MockIReadAccountUsecase is a mock of IReadAccountUsecase interface.
+type MockIReadAccountUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReadAccountUsecaseMockRecorder
+}
+
+// MockIReadAccountUsecaseMockRecorder is the mock recorder for MockIReadAccountUsecase.
+type MockIReadAccountUsecaseMockRecorder struct {
+	mock *MockIReadAccountUsecase
+}
+
+// NewMockIReadAccountUsecase creates a new mock instance.
+func NewMockIReadAccountUsecase(ctrl *gomock.Controller) *MockIReadAccountUsecase {
+	mock := &MockIReadAccountUsecase{ctrl: ctrl}
+	mock.recorder = &MockIReadAccountUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReadAccountUsecase) EXPECT() *MockIReadAccountUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIReadAccountUsecase) Run(ctx context.Context, cmd account.ReadAccountCommand) (*account.ReadAccountDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*account.ReadAccountDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIReadAccountUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIReadAccountUsecase)(nil).Run), ctx, cmd)
+}
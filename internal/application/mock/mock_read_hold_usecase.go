@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/hold/read_hold_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	hold "github.com/u104rak1/pocgo/internal/application/hold"
+)
+
+// MockIReadHoldUsecase is a mock of IReadHoldUsecase interface.
+type MockIReadHoldUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReadHoldUsecaseMockRecorder
+}
+
+// MockIReadHoldUsecaseMockRecorder is the mock recorder for MockIReadHoldUsecase.
+type MockIReadHoldUsecaseMockRecorder struct {
+	mock *MockIReadHoldUsecase
+}
+
+// NewMockIReadHoldUsecase creates a new mock instance.
+func NewMockIReadHoldUsecase(ctrl *gomock.Controller) *MockIReadHoldUsecase {
+	mock := &MockIReadHoldUsecase{ctrl: ctrl}
+	mock.recorder = &MockIReadHoldUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReadHoldUsecase) EXPECT() *MockIReadHoldUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIReadHoldUsecase) Run(ctx context.Context, cmd hold.ReadHoldCommand) (*hold.HoldDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*hold.HoldDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIReadHoldUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIReadHoldUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/payee/read_payee_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	payee "github.com/u104rak1/pocgo/internal/application/payee"
+)
+
+// MockIReadPayeeUsecase is a mock of IReadPayeeUsecase interface.
+type MockIReadPayeeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReadPayeeUsecaseMockRecorder
+}
+
+// MockIReadPayeeUsecaseMockRecorder is the mock recorder for MockIReadPayeeUsecase.
+type MockIReadPayeeUsecaseMockRecorder struct {
+	mock *MockIReadPayeeUsecase
+}
+
+// NewMockIReadPayeeUsecase creates a new mock instance.
+func NewMockIReadPayeeUsecase(ctrl *gomock.Controller) *MockIReadPayeeUsecase {
+	mock := &MockIReadPayeeUsecase{ctrl: ctrl}
+	mock.recorder = &MockIReadPayeeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReadPayeeUsecase) EXPECT() *MockIReadPayeeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIReadPayeeUsecase) Run(ctx context.Context, cmd payee.ReadPayeeCommand) (*payee.PayeeDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*payee.PayeeDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIReadPayeeUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIReadPayeeUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/standing_order/read_standing_order_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	standingorder "github.com/u104rak1/pocgo/internal/application/standing_order"
+)
+
+// MockIReadStandingOrderUsecase is a mock of IReadStandingOrderUsecase interface.
+type MockIReadStandingOrderUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReadStandingOrderUsecaseMockRecorder
+}
+
+// MockIReadStandingOrderUsecaseMockRecorder is the mock recorder for MockIReadStandingOrderUsecase.
+type MockIReadStandingOrderUsecaseMockRecorder struct {
+	mock *MockIReadStandingOrderUsecase
+}
+
+// NewMockIReadStandingOrderUsecase creates a new mock instance.
+func NewMockIReadStandingOrderUsecase(ctrl *gomock.Controller) *MockIReadStandingOrderUsecase {
+	mock := &MockIReadStandingOrderUsecase{ctrl: ctrl}
+	mock.recorder = &MockIReadStandingOrderUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReadStandingOrderUsecase) EXPECT() *MockIReadStandingOrderUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIReadStandingOrderUsecase) Run(ctx context.Context, cmd standingorder.ReadStandingOrderCommand) (*standingorder.ReadStandingOrderDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*standingorder.ReadStandingOrderDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIReadStandingOrderUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIReadStandingOrderUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/transaction/read_transaction_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	transaction "github.com/u104rak1/pocgo/internal/application/transaction"
+)
+
+// MockIReadTransactionUsecase is a mock of IReadTransactionUsecase interface.
+type MockIReadTransactionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReadTransactionUsecaseMockRecorder
+}
+
+// MockIReadTransactionUsecaseMockRecorder is the mock recorder for MockIReadTransactionUsecase.
+type MockIReadTransactionUsecaseMockRecorder struct {
+	mock *MockIReadTransactionUsecase
+}
+
+// NewMockIReadTransactionUsecase creates a new mock instance.
+func NewMockIReadTransactionUsecase(ctrl *gomock.Controller) *MockIReadTransactionUsecase {
+	mock := &MockIReadTransactionUsecase{ctrl: ctrl}
+	mock.recorder = &MockIReadTransactionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReadTransactionUsecase) EXPECT() *MockIReadTransactionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIReadTransactionUsecase) Run(ctx context.Context, cmd transaction.ReadTransactionCommand) (*transaction.ReadTransactionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*transaction.ReadTransactionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIReadTransactionUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIReadTransactionUsecase)(nil).Run), ctx, cmd)
+}
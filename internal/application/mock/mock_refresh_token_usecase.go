@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/authentication/refresh_token_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	authentication "github.com/u104rak1/pocgo/internal/application/authentication"
+)
+
+// MockIRefreshTokenUsecase is a mock of IRefreshTokenUsecase interface.
+type MockIRefreshTokenUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRefreshTokenUsecaseMockRecorder
+}
+
+// MockIRefreshTokenUsecaseMockRecorder is the mock recorder for MockIRefreshTokenUsecase.
+type MockIRefreshTokenUsecaseMockRecorder struct {
+	mock *MockIRefreshTokenUsecase
+}
+
+// NewMockIRefreshTokenUsecase creates a new mock instance.
+func NewMockIRefreshTokenUsecase(ctrl *gomock.Controller) *MockIRefreshTokenUsecase {
+	mock := &MockIRefreshTokenUsecase{ctrl: ctrl}
+	mock.recorder = &MockIRefreshTokenUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRefreshTokenUsecase) EXPECT() *MockIRefreshTokenUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIRefreshTokenUsecase) Run(ctx context.Context, cmd authentication.RefreshTokenCommand) (*authentication.RefreshTokenDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*authentication.RefreshTokenDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIRefreshTokenUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIRefreshTokenUsecase)(nil).Run), ctx, cmd)
+}
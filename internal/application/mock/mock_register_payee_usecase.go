@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/payee/register_payee_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	payee "github.com/u104rak1/pocgo/internal/application/payee"
+)
+
+// MockIRegisterPayeeUsecase is a mock of IRegisterPayeeUsecase interface.
+type MockIRegisterPayeeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRegisterPayeeUsecaseMockRecorder
+}
+
+// MockIRegisterPayeeUsecaseMockRecorder is the mock recorder for MockIRegisterPayeeUsecase.
+type MockIRegisterPayeeUsecaseMockRecorder struct {
+	mock *MockIRegisterPayeeUsecase
+}
+
+// NewMockIRegisterPayeeUsecase creates a new mock instance.
+func NewMockIRegisterPayeeUsecase(ctrl *gomock.Controller) *MockIRegisterPayeeUsecase {
+	mock := &MockIRegisterPayeeUsecase{ctrl: ctrl}
+	mock.recorder = &MockIRegisterPayeeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRegisterPayeeUsecase) EXPECT() *MockIRegisterPayeeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIRegisterPayeeUsecase) Run(ctx context.Context, cmd payee.RegisterPayeeCommand) (*payee.PayeeDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*payee.PayeeDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIRegisterPayeeUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIRegisterPayeeUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/event/relay_events_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	event "github.com/u104rak1/pocgo/internal/application/event"
+)
+
+// MockIRelayEventsUsecase is a mock of IRelayEventsUsecase interface.
+type MockIRelayEventsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRelayEventsUsecaseMockRecorder
+}
+
+// MockIRelayEventsUsecaseMockRecorder is the mock recorder for MockIRelayEventsUsecase.
+type MockIRelayEventsUsecaseMockRecorder struct {
+	mock *MockIRelayEventsUsecase
+}
+
+// NewMockIRelayEventsUsecase creates a new mock instance.
+func NewMockIRelayEventsUsecase(ctrl *gomock.Controller) *MockIRelayEventsUsecase {
+	mock := &MockIRelayEventsUsecase{ctrl: ctrl}
+	mock.recorder = &MockIRelayEventsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRelayEventsUsecase) EXPECT() *MockIRelayEventsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIRelayEventsUsecase) Run(ctx context.Context) (*event.RelayEventsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(*event.RelayEventsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIRelayEventsUsecaseMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIRelayEventsUsecase)(nil).Run), ctx)
+}
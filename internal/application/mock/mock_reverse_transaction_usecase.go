@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/transaction/reverse_transaction_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	transaction "github.com/u104rak1/pocgo/internal/application/transaction"
+)
+
+// MockIReverseTransactionUsecase is a mock of IReverseTransactionUsecase interface.
+type MockIReverseTransactionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReverseTransactionUsecaseMockRecorder
+}
+
+// MockIReverseTransactionUsecaseMockRecorder is the mock recorder for MockIReverseTransactionUsecase.
+type MockIReverseTransactionUsecaseMockRecorder struct {
+	mock *MockIReverseTransactionUsecase
+}
+
+// NewMockIReverseTransactionUsecase creates a new mock instance.
+func NewMockIReverseTransactionUsecase(ctrl *gomock.Controller) *MockIReverseTransactionUsecase {
+	mock := &MockIReverseTransactionUsecase{ctrl: ctrl}
+	mock.recorder = &MockIReverseTransactionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReverseTransactionUsecase) EXPECT() *MockIReverseTransactionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIReverseTransactionUsecase) Run(ctx context.Context, cmd transaction.ReverseTransactionCommand) (*transaction.ReverseTransactionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*transaction.ReverseTransactionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIReverseTransactionUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIReverseTransactionUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/limit/set_account_limit_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	limit "github.com/u104rak1/pocgo/internal/application/limit"
+)
+
+// MockISetAccountLimitUsecase is a mock of ISetAccountLimitUsecase interface.
+type MockISetAccountLimitUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockISetAccountLimitUsecaseMockRecorder
+}
+
+// MockISetAccountLimitUsecaseMockRecorder is the mock recorder for MockISetAccountLimitUsecase.
+type MockISetAccountLimitUsecaseMockRecorder struct {
+	mock *MockISetAccountLimitUsecase
+}
+
+// NewMockISetAccountLimitUsecase creates a new mock instance.
+func NewMockISetAccountLimitUsecase(ctrl *gomock.Controller) *MockISetAccountLimitUsecase {
+	mock := &MockISetAccountLimitUsecase{ctrl: ctrl}
+	mock.recorder = &MockISetAccountLimitUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISetAccountLimitUsecase) EXPECT() *MockISetAccountLimitUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockISetAccountLimitUsecase) Run(ctx context.Context, cmd limit.SetAccountLimitCommand) (*limit.AccountLimitDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*limit.AccountLimitDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockISetAccountLimitUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockISetAccountLimitUsecase)(nil).Run), ctx, cmd)
+}
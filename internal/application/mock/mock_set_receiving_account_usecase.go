@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/payee/set_receiving_account_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	payee "github.com/u104rak1/pocgo/internal/application/payee"
+)
+
+// MockISetReceivingAccountUsecase is a mock of ISetReceivingAccountUsecase interface.
+type MockISetReceivingAccountUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockISetReceivingAccountUsecaseMockRecorder
+}
+
+// MockISetReceivingAccountUsecaseMockRecorder is the mock recorder for MockISetReceivingAccountUsecase.
+type MockISetReceivingAccountUsecaseMockRecorder struct {
+	mock *MockISetReceivingAccountUsecase
+}
+
+// NewMockISetReceivingAccountUsecase creates a new mock instance.
+func NewMockISetReceivingAccountUsecase(ctrl *gomock.Controller) *MockISetReceivingAccountUsecase {
+	mock := &MockISetReceivingAccountUsecase{ctrl: ctrl}
+	mock.recorder = &MockISetReceivingAccountUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISetReceivingAccountUsecase) EXPECT() *MockISetReceivingAccountUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockISetReceivingAccountUsecase) Run(ctx context.Context, cmd payee.SetReceivingAccountCommand) (*payee.ReceivingAccountDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*payee.ReceivingAccountDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockISetReceivingAccountUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockISetReceivingAccountUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/account/update_account_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	account "github.com/u104rak1/pocgo/internal/application/account"
+)
+
+// MockIUpdateAccountUsecase is a mock of IUpdateAccountUsecase interface.
+type MockIUpdateAccountUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUpdateAccountUsecaseMockRecorder
+}
+
+// MockIUpdateAccountUsecaseMockRecorder is the mock recorder for MockIUpdateAccountUsecase.
+type MockIUpdateAccountUsecaseMockRecorder struct {
+	mock *MockIUpdateAccountUsecase
+}
+
+// NewMockIUpdateAccountUsecase creates a new mock instance.
+func NewMockIUpdateAccountUsecase(ctrl *gomock.Controller) *MockIUpdateAccountUsecase {
+	mock := &MockIUpdateAccountUsecase{ctrl: ctrl}
+	mock.recorder = &MockIUpdateAccountUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUpdateAccountUsecase) EXPECT() *MockIUpdateAccountUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIUpdateAccountUsecase) Run(ctx context.Context, cmd account.UpdateAccountCommand) (*account.UpdateAccountDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*account.UpdateAccountDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIUpdateAccountUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIUpdateAccountUsecase)(nil).Run), ctx, cmd)
+}
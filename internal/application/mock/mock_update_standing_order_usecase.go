@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/standing_order/update_standing_order_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	standingorder "github.com/u104rak1/pocgo/internal/application/standing_order"
+)
+
+// MockIUpdateStandingOrderUsecase is a mock of IUpdateStandingOrderUsecase interface.
+type MockIUpdateStandingOrderUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUpdateStandingOrderUsecaseMockRecorder
+}
+
+// MockIUpdateStandingOrderUsecaseMockRecorder is the mock recorder for MockIUpdateStandingOrderUsecase.
+type MockIUpdateStandingOrderUsecaseMockRecorder struct {
+	mock *MockIUpdateStandingOrderUsecase
+}
+
+// NewMockIUpdateStandingOrderUsecase creates a new mock instance.
+func NewMockIUpdateStandingOrderUsecase(ctrl *gomock.Controller) *MockIUpdateStandingOrderUsecase {
+	mock := &MockIUpdateStandingOrderUsecase{ctrl: ctrl}
+	mock.recorder = &MockIUpdateStandingOrderUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUpdateStandingOrderUsecase) EXPECT() *MockIUpdateStandingOrderUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIUpdateStandingOrderUsecase) Run(ctx context.Context, cmd standingorder.UpdateStandingOrderCommand) (*standingorder.StandingOrderDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*standingorder.StandingOrderDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIUpdateStandingOrderUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIUpdateStandingOrderUsecase)(nil).Run), ctx, cmd)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/hold/void_hold_usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	hold "github.com/u104rak1/pocgo/internal/application/hold"
+)
+
+// MockIVoidHoldUsecase is a mock of IVoidHoldUsecase interface.
+type MockIVoidHoldUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIVoidHoldUsecaseMockRecorder
+}
+
+// MockIVoidHoldUsecaseMockRecorder is the mock recorder for MockIVoidHoldUsecase.
+type MockIVoidHoldUsecaseMockRecorder struct {
+	mock *MockIVoidHoldUsecase
+}
+
+// NewMockIVoidHoldUsecase creates a new mock instance.
+func NewMockIVoidHoldUsecase(ctrl *gomock.Controller) *MockIVoidHoldUsecase {
+	mock := &MockIVoidHoldUsecase{ctrl: ctrl}
+	mock.recorder = &MockIVoidHoldUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIVoidHoldUsecase) EXPECT() *MockIVoidHoldUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockIVoidHoldUsecase) Run(ctx context.Context, cmd hold.VoidHoldCommand) (*hold.HoldDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, cmd)
+	ret0, _ := ret[0].(*hold.HoldDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIVoidHoldUsecaseMockRecorder) Run(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIVoidHoldUsecase)(nil).Run), ctx, cmd)
+}
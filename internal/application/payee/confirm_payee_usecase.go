@@ -0,0 +1,65 @@
+package payee
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type IConfirmPayeeUsecase interface {
+	Run(ctx context.Context, cmd ConfirmPayeeCommand) (*PayeeDTO, error)
+}
+
+type confirmPayeeUsecase struct {
+	payeeServ  payeeDomain.IPayeeService
+	payeeRepo  payeeDomain.IPayeeRepository
+	unitOfWork unitofwork.IUnitOfWork
+}
+
+func NewConfirmPayeeUsecase(
+	payeeService payeeDomain.IPayeeService,
+	payeeRepository payeeDomain.IPayeeRepository,
+	unitOfWork unitofwork.IUnitOfWork,
+) IConfirmPayeeUsecase {
+	return &confirmPayeeUsecase{
+		payeeServ:  payeeService,
+		payeeRepo:  payeeRepository,
+		unitOfWork: unitOfWork,
+	}
+}
+
+type ConfirmPayeeCommand struct {
+	UserID  string
+	PayeeID string
+}
+
+// 利用者が伏せた名前を確認して支払先を確認済みにします。確認済みの支払先にのみ支払先IDで振込ができます。
+func (u *confirmPayeeUsecase) Run(ctx context.Context, cmd ConfirmPayeeCommand) (*PayeeDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	payeeID, err := idVO.PayeeIDFromString(cmd.PayeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var payee *payeeDomain.Payee
+	if err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		payee, err = u.payeeServ.GetByUser(ctx, userID, payeeID)
+		if err != nil {
+			return err
+		}
+		payee.Confirm(timer.Now())
+		return u.payeeRepo.Save(ctx, payee)
+	}); err != nil {
+		return nil, err
+	}
+
+	dto := newPayeeDTO(payee)
+	return &dto, nil
+}
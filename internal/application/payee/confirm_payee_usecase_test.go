@@ -0,0 +1,85 @@
+package payee_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	payeeUC "github.com/u104rak1/pocgo/internal/application/payee"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestConfirmPayeeUsecase(t *testing.T) {
+	type Mocks struct {
+		payeeServ *domainMock.MockIPayeeService
+		payeeRepo *domainMock.MockIPayeeRepository
+	}
+
+	var (
+		userID = idVO.NewUserIDForTest("user")
+		arg    = gomock.Any()
+	)
+
+	tests := []struct {
+		caseName string
+		prepare  func(mocks Mocks, payee *payeeDomain.Payee)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 支払先を確認済みにできる",
+			prepare: func(mocks Mocks, payee *payeeDomain.Payee) {
+				mocks.payeeServ.EXPECT().GetByUser(arg, userID, payee.ID()).Return(payee, nil)
+				mocks.payeeRepo.EXPECT().Save(arg, payee).Return(nil)
+			},
+		},
+		{
+			caseName: "Negative: 支払先が存在しない",
+			prepare: func(mocks Mocks, payee *payeeDomain.Payee) {
+				mocks.payeeServ.EXPECT().GetByUser(arg, userID, payee.ID()).Return(nil, payeeDomain.ErrNotFound)
+			},
+			wantErr: payeeDomain.ErrNotFound,
+		},
+		{
+			caseName: "Negative: 支払先の保存に失敗する",
+			prepare: func(mocks Mocks, payee *payeeDomain.Payee) {
+				mocks.payeeServ.EXPECT().GetByUser(arg, userID, payee.ID()).Return(payee, nil)
+				mocks.payeeRepo.EXPECT().Save(arg, payee).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				payeeServ: domainMock.NewMockIPayeeService(ctrl),
+				payeeRepo: domainMock.NewMockIPayeeRepository(ctrl),
+			}
+			payee := newPayee(t, userID)
+			uc := payeeUC.NewConfirmPayeeUsecase(mocks.payeeServ, mocks.payeeRepo, &appMock.MockIUnitOfWork{})
+			tt.prepare(mocks, payee)
+
+			dto, err := uc.Run(context.Background(), payeeUC.ConfirmPayeeCommand{
+				UserID:  userID.String(),
+				PayeeID: payee.IDString(),
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, dto.Confirmed)
+			assert.NotNil(t, dto.ConfirmedAt)
+		})
+	}
+}
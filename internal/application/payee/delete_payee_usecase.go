@@ -0,0 +1,57 @@
+package payee
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IDeletePayeeUsecase interface {
+	Run(ctx context.Context, cmd DeletePayeeCommand) error
+}
+
+type deletePayeeUsecase struct {
+	payeeServ  payeeDomain.IPayeeService
+	payeeRepo  payeeDomain.IPayeeRepository
+	unitOfWork unitofwork.IUnitOfWork
+}
+
+func NewDeletePayeeUsecase(
+	payeeService payeeDomain.IPayeeService,
+	payeeRepository payeeDomain.IPayeeRepository,
+	unitOfWork unitofwork.IUnitOfWork,
+) IDeletePayeeUsecase {
+	return &deletePayeeUsecase{
+		payeeServ:  payeeService,
+		payeeRepo:  payeeRepository,
+		unitOfWork: unitOfWork,
+	}
+}
+
+type DeletePayeeCommand struct {
+	UserID  string
+	PayeeID string
+}
+
+// 支払先を削除します。支払先IDで行った振込の履歴は受取口座IDで残る為、影響を受けません。
+func (u *deletePayeeUsecase) Run(ctx context.Context, cmd DeletePayeeCommand) error {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	payeeID, err := idVO.PayeeIDFromString(cmd.PayeeID)
+	if err != nil {
+		return err
+	}
+
+	return u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		payee, err := u.payeeServ.GetByUser(ctx, userID, payeeID)
+		if err != nil {
+			return err
+		}
+		return u.payeeRepo.Delete(ctx, payee)
+	})
+}
@@ -0,0 +1,51 @@
+package payee
+
+import (
+	"context"
+
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IListPayeesUsecase interface {
+	Run(ctx context.Context, cmd ListPayeesCommand) (*ListPayeesDTO, error)
+}
+
+type listPayeesUsecase struct {
+	payeeRepo payeeDomain.IPayeeRepository
+}
+
+func NewListPayeesUsecase(payeeRepository payeeDomain.IPayeeRepository) IListPayeesUsecase {
+	return &listPayeesUsecase{
+		payeeRepo: payeeRepository,
+	}
+}
+
+type ListPayeesCommand struct {
+	UserID string
+}
+
+type ListPayeesDTO struct {
+	Payees []PayeeDTO
+}
+
+func (u *listPayeesUsecase) Run(ctx context.Context, cmd ListPayeesCommand) (*ListPayeesDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	payees, err := u.payeeRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	payeeDTOs := make([]PayeeDTO, len(payees))
+	for i, payee := range payees {
+		payeeDTOs[i] = newPayeeDTO(payee)
+	}
+
+	return &ListPayeesDTO{
+		Payees: payeeDTOs,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package payee_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	payeeUC "github.com/u104rak1/pocgo/internal/application/payee"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestListPayeesUsecase(t *testing.T) {
+	var (
+		userID = idVO.NewUserIDForTest("user")
+		arg    = gomock.Any()
+	)
+	payee := newPayee(t, userID)
+
+	tests := []struct {
+		caseName string
+		prepare  func(mockPayeeRepo *domainMock.MockIPayeeRepository)
+		wantLen  int
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 支払先の一覧が返る",
+			prepare: func(mockPayeeRepo *domainMock.MockIPayeeRepository) {
+				mockPayeeRepo.EXPECT().ListByUserID(arg, userID).Return([]*payeeDomain.Payee{payee}, nil)
+			},
+			wantLen: 1,
+		},
+		{
+			caseName: "Positive: 支払先がない場合は空の一覧が返る",
+			prepare: func(mockPayeeRepo *domainMock.MockIPayeeRepository) {
+				mockPayeeRepo.EXPECT().ListByUserID(arg, userID).Return([]*payeeDomain.Payee{}, nil)
+			},
+			wantLen: 0,
+		},
+		{
+			caseName: "Negative: 支払先の取得に失敗する",
+			prepare: func(mockPayeeRepo *domainMock.MockIPayeeRepository) {
+				mockPayeeRepo.EXPECT().ListByUserID(arg, userID).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPayeeRepo := domainMock.NewMockIPayeeRepository(ctrl)
+			uc := payeeUC.NewListPayeesUsecase(mockPayeeRepo)
+			tt.prepare(mockPayeeRepo)
+
+			dto, err := uc.Run(context.Background(), payeeUC.ListPayeesCommand{UserID: userID.String()})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, dto.Payees, tt.wantLen)
+			assert.NotNil(t, dto.Payees)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package payee
+
+import (
+	"context"
+
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IListReceivingAccountsUsecase interface {
+	Run(ctx context.Context, cmd ListReceivingAccountsCommand) (*ListReceivingAccountsDTO, error)
+}
+
+type listReceivingAccountsUsecase struct {
+	payeeRepo payeeDomain.IPayeeRepository
+}
+
+func NewListReceivingAccountsUsecase(payeeRepository payeeDomain.IPayeeRepository) IListReceivingAccountsUsecase {
+	return &listReceivingAccountsUsecase{
+		payeeRepo: payeeRepository,
+	}
+}
+
+type ListReceivingAccountsCommand struct {
+	UserID string
+}
+
+type ListReceivingAccountsDTO struct {
+	ReceivingAccounts []ReceivingAccountDTO
+}
+
+func (u *listReceivingAccountsUsecase) Run(ctx context.Context, cmd ListReceivingAccountsCommand) (*ListReceivingAccountsDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	receivingAccounts, err := u.payeeRepo.ListReceivingAccounts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	receivingAccountDTOs := make([]ReceivingAccountDTO, len(receivingAccounts))
+	for i, receivingAccount := range receivingAccounts {
+		receivingAccountDTOs[i] = newReceivingAccountDTO(receivingAccount)
+	}
+
+	return &ListReceivingAccountsDTO{
+		ReceivingAccounts: receivingAccountDTOs,
+	}, nil
+}
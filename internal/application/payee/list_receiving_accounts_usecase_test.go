@@ -0,0 +1,73 @@
+package payee_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	payeeUC "github.com/u104rak1/pocgo/internal/application/payee"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestListReceivingAccountsUsecase(t *testing.T) {
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		arg       = gomock.Any()
+	)
+	receivingAccount, err := payeeDomain.ReconstructReceivingAccount(userID.String(), moneyVO.JPY, accountID.String(), timer.GetFixedDate())
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName string
+		prepare  func(mockPayeeRepo *domainMock.MockIPayeeRepository)
+		want     *payeeUC.ListReceivingAccountsDTO
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 通貨ごとの受取口座の一覧が返る",
+			prepare: func(mockPayeeRepo *domainMock.MockIPayeeRepository) {
+				mockPayeeRepo.EXPECT().ListReceivingAccounts(arg, userID).Return([]*payeeDomain.ReceivingAccount{receivingAccount}, nil)
+			},
+			want: &payeeUC.ListReceivingAccountsDTO{
+				ReceivingAccounts: []payeeUC.ReceivingAccountDTO{
+					{Currency: moneyVO.JPY, AccountID: accountID.String(), UpdatedAt: timer.GetFixedDateString()},
+				},
+			},
+		},
+		{
+			caseName: "Negative: 受取口座の取得に失敗する",
+			prepare: func(mockPayeeRepo *domainMock.MockIPayeeRepository) {
+				mockPayeeRepo.EXPECT().ListReceivingAccounts(arg, userID).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPayeeRepo := domainMock.NewMockIPayeeRepository(ctrl)
+			uc := payeeUC.NewListReceivingAccountsUsecase(mockPayeeRepo)
+			tt.prepare(mockPayeeRepo)
+
+			dto, err := uc.Run(context.Background(), payeeUC.ListReceivingAccountsCommand{UserID: userID.String()})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, dto)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package payee
+
+import (
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+)
+
+// 支払先の各ユースケースが返す支払先です。支払先の利用者の名前は伏せた状態で返し、利用者IDやメールアドレスは返しません。
+type PayeeDTO struct {
+	ID          string
+	MaskedName  string
+	Nickname    *string
+	Confirmed   bool
+	ConfirmedAt *string
+	CreatedAt   string
+}
+
+type ReceivingAccountDTO struct {
+	Currency  string
+	AccountID string
+	UpdatedAt string
+}
+
+func newPayeeDTO(payee *payeeDomain.Payee) PayeeDTO {
+	return PayeeDTO{
+		ID:          payee.IDString(),
+		MaskedName:  payee.MaskedName(),
+		Nickname:    payee.Nickname(),
+		Confirmed:   payee.IsConfirmed(),
+		ConfirmedAt: payee.ConfirmedAtString(),
+		CreatedAt:   payee.CreatedAtString(),
+	}
+}
+
+func newReceivingAccountDTO(receivingAccount *payeeDomain.ReceivingAccount) ReceivingAccountDTO {
+	return ReceivingAccountDTO{
+		Currency:  receivingAccount.Currency(),
+		AccountID: receivingAccount.AccountIDString(),
+		UpdatedAt: receivingAccount.UpdatedAtString(),
+	}
+}
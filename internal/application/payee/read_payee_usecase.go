@@ -0,0 +1,47 @@
+package payee
+
+import (
+	"context"
+
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IReadPayeeUsecase interface {
+	Run(ctx context.Context, cmd ReadPayeeCommand) (*PayeeDTO, error)
+}
+
+type readPayeeUsecase struct {
+	payeeServ payeeDomain.IPayeeService
+}
+
+func NewReadPayeeUsecase(payeeService payeeDomain.IPayeeService) IReadPayeeUsecase {
+	return &readPayeeUsecase{
+		payeeServ: payeeService,
+	}
+}
+
+type ReadPayeeCommand struct {
+	UserID  string
+	PayeeID string
+}
+
+func (u *readPayeeUsecase) Run(ctx context.Context, cmd ReadPayeeCommand) (*PayeeDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	payeeID, err := idVO.PayeeIDFromString(cmd.PayeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	payee, err := u.payeeServ.GetByUser(ctx, userID, payeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := newPayeeDTO(payee)
+	return &dto, nil
+}
@@ -0,0 +1,76 @@
+package payee_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	payeeUC "github.com/u104rak1/pocgo/internal/application/payee"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestReadPayeeUsecase(t *testing.T) {
+	var (
+		userID = idVO.NewUserIDForTest("user")
+		arg    = gomock.Any()
+	)
+	payee := newPayee(t, userID)
+
+	tests := []struct {
+		caseName string
+		payeeID  string
+		prepare  func(mockPayeeServ *domainMock.MockIPayeeService)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 支払先が返る",
+			payeeID:  payee.IDString(),
+			prepare: func(mockPayeeServ *domainMock.MockIPayeeService) {
+				mockPayeeServ.EXPECT().GetByUser(arg, userID, payee.ID()).Return(payee, nil)
+			},
+		},
+		{
+			caseName: "Negative: 支払先IDが不正である",
+			payeeID:  "invalid",
+			prepare:  func(mockPayeeServ *domainMock.MockIPayeeService) {},
+			wantErr:  idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: 支払先が存在しない",
+			payeeID:  payee.IDString(),
+			prepare: func(mockPayeeServ *domainMock.MockIPayeeService) {
+				mockPayeeServ.EXPECT().GetByUser(arg, userID, payee.ID()).Return(nil, payeeDomain.ErrNotFound)
+			},
+			wantErr: payeeDomain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPayeeServ := domainMock.NewMockIPayeeService(ctrl)
+			uc := payeeUC.NewReadPayeeUsecase(mockPayeeServ)
+			tt.prepare(mockPayeeServ)
+
+			dto, err := uc.Run(context.Background(), payeeUC.ReadPayeeCommand{
+				UserID:  userID.String(),
+				PayeeID: tt.payeeID,
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, payee.IDString(), dto.ID)
+			assert.Equal(t, "S*** T***", dto.MaskedName)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package payee
+
+import (
+	"context"
+
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IRegisterPayeeUsecase interface {
+	Run(ctx context.Context, cmd RegisterPayeeCommand) (*PayeeDTO, error)
+}
+
+type registerPayeeUsecase struct {
+	payeeServ payeeDomain.IPayeeService
+}
+
+func NewRegisterPayeeUsecase(payeeService payeeDomain.IPayeeService) IRegisterPayeeUsecase {
+	return &registerPayeeUsecase{
+		payeeServ: payeeService,
+	}
+}
+
+type RegisterPayeeCommand struct {
+	UserID string
+	// 支払先の利用者のメールアドレスです。
+	Email    string
+	Nickname *string
+}
+
+// メールアドレスで支払先を登録し、名前を伏せた支払先を返します。利用者が名前を確認するまで支払先への振込はできません。
+func (u *registerPayeeUsecase) Run(ctx context.Context, cmd RegisterPayeeCommand) (*PayeeDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	payee, err := u.payeeServ.Register(ctx, userID, cmd.Email, cmd.Nickname)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := newPayeeDTO(payee)
+	return &dto, nil
+}
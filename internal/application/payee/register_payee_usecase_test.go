@@ -0,0 +1,93 @@
+package payee_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	payeeUC "github.com/u104rak1/pocgo/internal/application/payee"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func newPayee(t *testing.T, userID idVO.UserID) *payeeDomain.Payee {
+	t.Helper()
+	payee, err := payeeDomain.New(userID, idVO.NewUserIDForTest("payee"), "Sato Taro", strutil.StrPointer("Landlord"), timer.GetFixedDate())
+	assert.NoError(t, err)
+	return payee
+}
+
+func TestRegisterPayeeUsecase(t *testing.T) {
+	var (
+		userID = idVO.NewUserIDForTest("user")
+		email  = "sato@example.com"
+		arg    = gomock.Any()
+	)
+	payee := newPayee(t, userID)
+
+	tests := []struct {
+		caseName string
+		userID   string
+		prepare  func(mockPayeeServ *domainMock.MockIPayeeService)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 名前を伏せた支払先が返る",
+			userID:   userID.String(),
+			prepare: func(mockPayeeServ *domainMock.MockIPayeeService) {
+				mockPayeeServ.EXPECT().Register(arg, userID, email, strutil.StrPointer("Landlord")).Return(payee, nil)
+			},
+		},
+		{
+			caseName: "Negative: ユーザーIDが不正である",
+			userID:   "invalid",
+			prepare:  func(mockPayeeServ *domainMock.MockIPayeeService) {},
+			wantErr:  idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: メールアドレスの利用者が存在しない",
+			userID:   userID.String(),
+			prepare: func(mockPayeeServ *domainMock.MockIPayeeService) {
+				mockPayeeServ.EXPECT().Register(arg, userID, email, arg).Return(nil, payeeDomain.ErrRecipientNotFound)
+			},
+			wantErr: payeeDomain.ErrRecipientNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPayeeServ := domainMock.NewMockIPayeeService(ctrl)
+			uc := payeeUC.NewRegisterPayeeUsecase(mockPayeeServ)
+			tt.prepare(mockPayeeServ)
+
+			dto, err := uc.Run(context.Background(), payeeUC.RegisterPayeeCommand{
+				UserID:   tt.userID,
+				Email:    email,
+				Nickname: strutil.StrPointer("Landlord"),
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &payeeUC.PayeeDTO{
+				ID:          payee.IDString(),
+				MaskedName:  "S*** T***",
+				Nickname:    strutil.StrPointer("Landlord"),
+				Confirmed:   false,
+				ConfirmedAt: nil,
+				CreatedAt:   timer.GetFixedDateString(),
+			}, dto)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package payee
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type ISetReceivingAccountUsecase interface {
+	Run(ctx context.Context, cmd SetReceivingAccountCommand) (*ReceivingAccountDTO, error)
+}
+
+type setReceivingAccountUsecase struct {
+	accountServ accountDomain.IAccountService
+	payeeServ   payeeDomain.IPayeeService
+}
+
+func NewSetReceivingAccountUsecase(
+	accountService accountDomain.IAccountService,
+	payeeService payeeDomain.IPayeeService,
+) ISetReceivingAccountUsecase {
+	return &setReceivingAccountUsecase{
+		accountServ: accountService,
+		payeeServ:   payeeService,
+	}
+}
+
+type SetReceivingAccountCommand struct {
+	UserID    string
+	Currency  string
+	AccountID string
+}
+
+// 他の利用者から支払先IDで振込を受け取る際の、通貨ごとの既定の口座を設定します。既に設定されている場合は置き換えます。
+func (u *setReceivingAccountUsecase) Run(ctx context.Context, cmd SetReceivingAccountCommand) (*ReceivingAccountDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	receivingAccount, err := u.payeeServ.SetReceivingAccount(ctx, account, cmd.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := newReceivingAccountDTO(receivingAccount)
+	return &dto, nil
+}
@@ -0,0 +1,93 @@
+package payee_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	payeeUC "github.com/u104rak1/pocgo/internal/application/payee"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestSetReceivingAccountUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ *domainMock.MockIAccountService
+		payeeServ   *domainMock.MockIPayeeService
+	}
+
+	var (
+		userID = idVO.NewUserIDForTest("user")
+		arg    = gomock.Any()
+	)
+	account, err := accountDomain.New(userID, 0, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	receivingAccount := payeeDomain.NewReceivingAccount(account, timer.GetFixedDate())
+
+	tests := []struct {
+		caseName string
+		prepare  func(mocks Mocks)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 既定の受取口座を設定できる",
+			prepare: func(mocks Mocks) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, account.ID(), &userID, nil).Return(account, nil)
+				mocks.payeeServ.EXPECT().SetReceivingAccount(arg, account, moneyVO.JPY).Return(receivingAccount, nil)
+			},
+		},
+		{
+			caseName: "Negative: 他のユーザーの口座である",
+			prepare: func(mocks Mocks) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, account.ID(), &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: accountDomain.ErrUnauthorized,
+		},
+		{
+			caseName: "Negative: 口座と通貨が異なる",
+			prepare: func(mocks Mocks) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, account.ID(), &userID, nil).Return(account, nil)
+				mocks.payeeServ.EXPECT().SetReceivingAccount(arg, account, moneyVO.JPY).Return(nil, payeeDomain.ErrDifferentCurrency)
+			},
+			wantErr: payeeDomain.ErrDifferentCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ: domainMock.NewMockIAccountService(ctrl),
+				payeeServ:   domainMock.NewMockIPayeeService(ctrl),
+			}
+			uc := payeeUC.NewSetReceivingAccountUsecase(mocks.accountServ, mocks.payeeServ)
+			tt.prepare(mocks)
+
+			dto, err := uc.Run(context.Background(), payeeUC.SetReceivingAccountCommand{
+				UserID:    userID.String(),
+				Currency:  moneyVO.JPY,
+				AccountID: account.IDString(),
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &payeeUC.ReceivingAccountDTO{
+				Currency:  moneyVO.JPY,
+				AccountID: account.IDString(),
+				UpdatedAt: timer.GetFixedDateString(),
+			}, dto)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package standingorder
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type ICancelStandingOrderUsecase interface {
+	Run(ctx context.Context, cmd CancelStandingOrderCommand) error
+}
+
+type cancelStandingOrderUsecase struct {
+	accountServ       accountDomain.IAccountService
+	standingOrderServ standingOrderDomain.IStandingOrderService
+	standingOrderRepo standingOrderDomain.IStandingOrderRepository
+	unitOfWork        unitofwork.IUnitOfWork
+}
+
+func NewCancelStandingOrderUsecase(
+	accountService accountDomain.IAccountService,
+	standingOrderService standingOrderDomain.IStandingOrderService,
+	standingOrderRepository standingOrderDomain.IStandingOrderRepository,
+	unitOfWork unitofwork.IUnitOfWork,
+) ICancelStandingOrderUsecase {
+	return &cancelStandingOrderUsecase{
+		accountServ:       accountService,
+		standingOrderServ: standingOrderService,
+		standingOrderRepo: standingOrderRepository,
+		unitOfWork:        unitOfWork,
+	}
+}
+
+type CancelStandingOrderCommand struct {
+	UserID          string
+	AccountID       string
+	StandingOrderID string
+}
+
+// 自動振込を解約します。実行履歴を残す為、削除せずに CANCELLED にします。
+func (u *cancelStandingOrderUsecase) Run(ctx context.Context, cmd CancelStandingOrderCommand) error {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return err
+	}
+
+	orderID, err := idVO.StandingOrderIDFromString(cmd.StandingOrderID)
+	if err != nil {
+		return err
+	}
+
+	return u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil); err != nil {
+			return err
+		}
+
+		order, err := u.standingOrderServ.GetByAccount(ctx, accountID, orderID)
+		if err != nil {
+			return err
+		}
+
+		if err := order.Cancel(timer.Now()); err != nil {
+			return err
+		}
+
+		return u.standingOrderRepo.Save(ctx, order)
+	})
+}
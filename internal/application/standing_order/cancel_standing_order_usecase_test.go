@@ -0,0 +1,111 @@
+package standingorder_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	standingOrderUC "github.com/u104rak1/pocgo/internal/application/standing_order"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestCancelStandingOrderUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ       *domainMock.MockIAccountService
+		standingOrderServ *domainMock.MockIStandingOrderService
+		standingOrderRepo *domainMock.MockIStandingOrderRepository
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		arg       = gomock.Any()
+	)
+
+	tests := []struct {
+		caseName string
+		prepare  func(mocks Mocks, order *standingOrderDomain.StandingOrder)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 自動振込を解約できる",
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.standingOrderServ.EXPECT().GetByAccount(arg, accountID, order.ID()).Return(order, nil)
+				mocks.standingOrderRepo.EXPECT().Save(arg, order).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 他のユーザーの口座である",
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: accountDomain.ErrUnauthorized,
+		},
+		{
+			caseName: "Negative: 自動振込が存在しない",
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.standingOrderServ.EXPECT().GetByAccount(arg, accountID, order.ID()).Return(nil, standingOrderDomain.ErrNotFound)
+			},
+			wantErr: standingOrderDomain.ErrNotFound,
+		},
+		{
+			caseName: "Negative: 既に解約されている",
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				assert.NoError(t, order.Cancel(timer.GetFixedDate()))
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.standingOrderServ.EXPECT().GetByAccount(arg, accountID, order.ID()).Return(order, nil)
+			},
+			wantErr: standingOrderDomain.ErrNotModifiable,
+		},
+		{
+			caseName: "Negative: 自動振込の保存に失敗する",
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.standingOrderServ.EXPECT().GetByAccount(arg, accountID, order.ID()).Return(order, nil)
+				mocks.standingOrderRepo.EXPECT().Save(arg, order).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ:       domainMock.NewMockIAccountService(ctrl),
+				standingOrderServ: domainMock.NewMockIStandingOrderService(ctrl),
+				standingOrderRepo: domainMock.NewMockIStandingOrderRepository(ctrl),
+			}
+			order := newStandingOrder(t, accountID, 0, standingOrderDomain.FailurePolicySkip)
+			uc := standingOrderUC.NewCancelStandingOrderUsecase(
+				mocks.accountServ, mocks.standingOrderServ, mocks.standingOrderRepo, &appMock.MockIUnitOfWork{},
+			)
+			tt.prepare(mocks, order)
+
+			err := uc.Run(context.Background(), standingOrderUC.CancelStandingOrderCommand{
+				UserID:          userID.String(),
+				AccountID:       accountID.String(),
+				StandingOrderID: order.IDString(),
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, standingOrderDomain.StatusCancelled, order.Status())
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package standingorder
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type ICreateStandingOrderUsecase interface {
+	Run(ctx context.Context, cmd CreateStandingOrderCommand) (*StandingOrderDTO, error)
+}
+
+type createStandingOrderUsecase struct {
+	accountServ       accountDomain.IAccountService
+	standingOrderRepo standingOrderDomain.IStandingOrderRepository
+}
+
+func NewCreateStandingOrderUsecase(
+	accountService accountDomain.IAccountService,
+	standingOrderRepository standingOrderDomain.IStandingOrderRepository,
+) ICreateStandingOrderUsecase {
+	return &createStandingOrderUsecase{
+		accountServ:       accountService,
+		standingOrderRepo: standingOrderRepository,
+	}
+}
+
+type CreateStandingOrderCommand struct {
+	UserID            string
+	AccountID         string
+	Password          string
+	ReceiverAccountID string
+	Amount            string
+	Currency          string
+	Frequency         string
+	DayOfMonth        *int
+	StartDate         time.Time
+	EndDate           *time.Time
+	MaxExecutions     *int
+	// 指定しない場合は standingOrderDomain.DefaultMaxRetries になります。
+	MaxRetries *int
+	// 指定しない場合は standingOrderDomain.DefaultFailurePolicy になります。
+	FailurePolicy *string
+}
+
+// 自動振込を作成します。口座の暗証番号が必要で、振込金額は口座と同じ通貨で指定します。
+func (u *createStandingOrderUsecase) Run(ctx context.Context, cmd CreateStandingOrderCommand) (*StandingOrderDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	receiverAccountID, err := idVO.AccountIDFromString(cmd.ReceiverAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := moneyVO.NewFromDecimal(cmd.Amount, cmd.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := standingOrderDomain.NewSchedule(cmd.Frequency, cmd.DayOfMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, &cmd.Password)
+	if err != nil {
+		return nil, err
+	}
+	if account.Balance().Currency() != amount.Currency() {
+		return nil, moneyVO.ErrDifferentCurrencyOperation
+	}
+
+	if _, err := u.accountServ.GetAndAuthorize(ctx, receiverAccountID, nil, nil); err != nil {
+		if errors.Is(err, accountDomain.ErrNotFound) {
+			return nil, accountDomain.ErrReceiverNotFound
+		}
+		return nil, err
+	}
+
+	maxRetries := standingOrderDomain.DefaultMaxRetries
+	if cmd.MaxRetries != nil {
+		maxRetries = *cmd.MaxRetries
+	}
+	failurePolicy := standingOrderDomain.DefaultFailurePolicy
+	if cmd.FailurePolicy != nil {
+		failurePolicy = *cmd.FailurePolicy
+	}
+
+	order, err := standingOrderDomain.New(
+		accountID, receiverAccountID, amount.Amount(), amount.Currency(), *schedule,
+		cmd.StartDate, cmd.EndDate, cmd.MaxExecutions, maxRetries, failurePolicy, timer.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.standingOrderRepo.Save(ctx, order); err != nil {
+		return nil, err
+	}
+
+	dto := newStandingOrderDTO(order)
+	return &dto, nil
+}
@@ -0,0 +1,201 @@
+package standingorder_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	standingOrderUC "github.com/u104rak1/pocgo/internal/application/standing_order"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/numutil"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestCreateStandingOrderUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ       *domainMock.MockIAccountService
+		standingOrderRepo *domainMock.MockIStandingOrderRepository
+	}
+
+	var (
+		userID     = idVO.NewUserIDForTest("user")
+		accountID  = idVO.NewAccountIDForTest("account")
+		receiverID = idVO.NewAccountIDForTest("receiver")
+		password   = "1234"
+		today      = timer.Now()
+		arg        = gomock.Any()
+	)
+
+	happyCmd := standingOrderUC.CreateStandingOrderCommand{
+		UserID:            userID.String(),
+		AccountID:         accountID.String(),
+		Password:          password,
+		ReceiverAccountID: receiverID.String(),
+		Amount:            "80000",
+		Currency:          moneyVO.JPY,
+		Frequency:         standingOrderDomain.FrequencyMonthly,
+		DayOfMonth:        numutil.IntPointer(31),
+		StartDate:         today,
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      func() standingOrderUC.CreateStandingOrderCommand
+		prepare  func(mocks Mocks, account, receiver *accountDomain.Account)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 自動振込の作成が成功し、再試行の方針は既定値になる",
+			cmd:      func() standingOrderUC.CreateStandingOrderCommand { return happyCmd },
+			prepare: func(mocks Mocks, account, receiver *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, receiverID, nil, nil).Return(receiver, nil)
+				mocks.standingOrderRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 振込先の口座IDが不正な形式である",
+			cmd: func() standingOrderUC.CreateStandingOrderCommand {
+				cmd := happyCmd
+				cmd.ReceiverAccountID = "invalid"
+				return cmd
+			},
+			prepare: func(mocks Mocks, account, receiver *accountDomain.Account) {},
+			wantErr: idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: 金額の精度が通貨に合わない",
+			cmd: func() standingOrderUC.CreateStandingOrderCommand {
+				cmd := happyCmd
+				cmd.Amount = "1000.5"
+				return cmd
+			},
+			prepare: func(mocks Mocks, account, receiver *accountDomain.Account) {},
+			wantErr: moneyVO.ErrInvalidPrecision,
+		},
+		{
+			caseName: "Negative: 実行日の指定が不正である",
+			cmd: func() standingOrderUC.CreateStandingOrderCommand {
+				cmd := happyCmd
+				cmd.DayOfMonth = numutil.IntPointer(32)
+				return cmd
+			},
+			prepare: func(mocks Mocks, account, receiver *accountDomain.Account) {},
+			wantErr: standingOrderDomain.ErrInvalidDayOfMonth,
+		},
+		{
+			caseName: "Negative: 暗証番号が一致しない",
+			cmd:      func() standingOrderUC.CreateStandingOrderCommand { return happyCmd },
+			prepare: func(mocks Mocks, account, receiver *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			wantErr: accountDomain.ErrUnmatchedPassword,
+		},
+		{
+			caseName: "Negative: 口座と異なる通貨を指定した",
+			cmd: func() standingOrderUC.CreateStandingOrderCommand {
+				cmd := happyCmd
+				cmd.Amount = "100.50"
+				cmd.Currency = moneyVO.USD
+				return cmd
+			},
+			prepare: func(mocks Mocks, account, receiver *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+			},
+			wantErr: moneyVO.ErrDifferentCurrencyOperation,
+		},
+		{
+			caseName: "Negative: 振込先の口座が存在しない",
+			cmd:      func() standingOrderUC.CreateStandingOrderCommand { return happyCmd },
+			prepare: func(mocks Mocks, account, receiver *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, receiverID, nil, nil).Return(nil, accountDomain.ErrNotFound)
+			},
+			wantErr: accountDomain.ErrReceiverNotFound,
+		},
+		{
+			caseName: "Negative: 開始日が過去である",
+			cmd: func() standingOrderUC.CreateStandingOrderCommand {
+				cmd := happyCmd
+				cmd.StartDate = today.AddDate(0, 0, -1)
+				return cmd
+			},
+			prepare: func(mocks Mocks, account, receiver *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, receiverID, nil, nil).Return(receiver, nil)
+			},
+			wantErr: standingOrderDomain.ErrStartDateInPast,
+		},
+		{
+			caseName: "Negative: 失敗時の方針が不正である",
+			cmd: func() standingOrderUC.CreateStandingOrderCommand {
+				cmd := happyCmd
+				cmd.FailurePolicy = strutil.StrPointer("IGNORE")
+				return cmd
+			},
+			prepare: func(mocks Mocks, account, receiver *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, receiverID, nil, nil).Return(receiver, nil)
+			},
+			wantErr: standingOrderDomain.ErrUnsupportedPolicy,
+		},
+		{
+			caseName: "Negative: 自動振込の保存に失敗する",
+			cmd:      func() standingOrderUC.CreateStandingOrderCommand { return happyCmd },
+			prepare: func(mocks Mocks, account, receiver *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, &password).Return(account, nil)
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, receiverID, nil, nil).Return(receiver, nil)
+				mocks.standingOrderRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ:       domainMock.NewMockIAccountService(ctrl),
+				standingOrderRepo: domainMock.NewMockIStandingOrderRepository(ctrl),
+			}
+			account, err := accountDomain.New(userID, 100000, "For work", password, moneyVO.JPY)
+			assert.NoError(t, err)
+			receiver, err := accountDomain.New(idVO.NewUserIDForTest("landlord"), 0, "Rent", password, moneyVO.JPY)
+			assert.NoError(t, err)
+
+			uc := standingOrderUC.NewCreateStandingOrderUsecase(mocks.accountServ, mocks.standingOrderRepo)
+			tt.prepare(mocks, account, receiver)
+
+			dto, err := uc.Run(context.Background(), tt.cmd())
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, dto.ID)
+				assert.Equal(t, accountID.String(), dto.AccountID)
+				assert.Equal(t, receiverID.String(), dto.ReceiverAccountID)
+				assert.Equal(t, "80000", dto.Amount)
+				assert.Equal(t, moneyVO.JPY, dto.Currency)
+				assert.Equal(t, 31, *dto.DayOfMonth)
+				assert.Equal(t, standingOrderDomain.DefaultMaxRetries, dto.MaxRetries)
+				assert.Equal(t, standingOrderDomain.DefaultFailurePolicy, dto.FailurePolicy)
+				assert.Equal(t, standingOrderDomain.StatusActive, dto.Status)
+				lastDay := time.Date(today.Year(), today.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+				assert.Equal(t, timer.FormatToYYYYMMDD(lastDay), dto.NextRunDate)
+			}
+		})
+	}
+}
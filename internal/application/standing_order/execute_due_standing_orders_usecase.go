@@ -0,0 +1,165 @@
+package standingorder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IExecuteDueStandingOrdersUsecase interface {
+	Run(ctx context.Context) (*ExecuteDueStandingOrdersDTO, error)
+}
+
+type executeDueStandingOrdersUsecase struct {
+	accountServ       accountDomain.IAccountService
+	transactionServ   transactionDomain.ITransactionService
+	standingOrderRepo standingOrderDomain.IStandingOrderRepository
+	unitOfWork        unitofwork.IUnitOfWork
+	now               func() time.Time
+}
+
+// now には現在時刻を返す関数を指定します。通常は timer.Now を指定し、テストでは時刻を進められる関数を指定します。
+func NewExecuteDueStandingOrdersUsecase(
+	accountService accountDomain.IAccountService,
+	transactionService transactionDomain.ITransactionService,
+	standingOrderRepository standingOrderDomain.IStandingOrderRepository,
+	unitOfWork unitofwork.IUnitOfWork,
+	now func() time.Time,
+) IExecuteDueStandingOrdersUsecase {
+	return &executeDueStandingOrdersUsecase{
+		accountServ:       accountService,
+		transactionServ:   transactionService,
+		standingOrderRepo: standingOrderRepository,
+		unitOfWork:        unitOfWork,
+		now:               now,
+	}
+}
+
+type ExecuteDueStandingOrdersDTO struct {
+	// 振込に成功した件数です。
+	Succeeded int
+	// 振込に失敗し、失敗を記録した件数です。
+	Failed int
+}
+
+// 他の処理が先に更新して実行すべきでなくなったことを表します。
+var errNotDue = errors.New("standing order is no longer due")
+
+// 実行すべき自動振込を最大 standingOrderDomain.ExecuteBatchSize 件実行します。
+// 自動振込ごとに別のトランザクションで振込を行い、振込に失敗した場合はその失敗を記録して次の自動振込に進みます。
+// 失敗を記録できなかった場合などのエラーはまとめて返しますが、その場合も実行した件数を返します。
+func (u *executeDueStandingOrdersUsecase) Run(ctx context.Context) (*ExecuteDueStandingOrdersDTO, error) {
+	now := u.now()
+	orders, err := u.standingOrderRepo.ListDue(ctx, now, standingOrderDomain.ExecuteBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &ExecuteDueStandingOrdersDTO{}
+	var errs []error
+	for _, order := range orders {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		err := u.execute(ctx, order.ID(), now)
+		if err == nil {
+			dto.Succeeded++
+			continue
+		}
+		if isTransient(err) {
+			continue
+		}
+
+		recorded, recordErr := u.recordFailure(ctx, order.ID(), err, now)
+		if recordErr != nil {
+			errs = append(errs, fmt.Errorf("standing order %s: %w", order.IDString(), errors.Join(err, recordErr)))
+			continue
+		}
+		if recorded {
+			dto.Failed++
+		}
+	}
+
+	return dto, errors.Join(errs...)
+}
+
+// 自動振込を読み直し、まだ実行すべき場合のみ振込を行って結果を記録します。
+func (u *executeDueStandingOrdersUsecase) execute(ctx context.Context, id idVO.StandingOrderID, now time.Time) error {
+	return u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		order, err := u.standingOrderRepo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order == nil || !order.IsDue(now) {
+			return errNotDue
+		}
+
+		sender, err := u.accountServ.GetAndAuthorize(ctx, order.AccountID(), nil, nil)
+		if err != nil {
+			return err
+		}
+		receiver, err := u.accountServ.GetAndAuthorize(ctx, order.ReceiverAccountID(), nil, nil)
+		if err != nil {
+			if errors.Is(err, accountDomain.ErrNotFound) {
+				return accountDomain.ErrReceiverNotFound
+			}
+			return err
+		}
+
+		transaction, err := u.transactionServ.Transfer(ctx, sender, receiver, order.Amount().Amount(), order.Amount().Currency(), transactionDomain.Description{})
+		if err != nil {
+			return err
+		}
+
+		execution := order.RecordSuccess(transaction.ID(), now)
+		if err := u.standingOrderRepo.Save(ctx, order); err != nil {
+			return err
+		}
+		return u.standingOrderRepo.SaveExecution(ctx, execution)
+	})
+}
+
+// 振込の失敗を記録します。振込とは別のトランザクションで記録する為、振込のロールバックの影響を受けません。
+// 他の処理が先に更新して実行すべきでなくなった場合は記録せずに false を返します。
+func (u *executeDueStandingOrdersUsecase) recordFailure(ctx context.Context, id idVO.StandingOrderID, cause error, now time.Time) (bool, error) {
+	recorded := false
+	err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		order, err := u.standingOrderRepo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order == nil || !order.IsDue(now) {
+			return nil
+		}
+
+		execution := order.RecordFailure(cause.Error(), now)
+		if err := u.standingOrderRepo.Save(ctx, order); err != nil {
+			return err
+		}
+		if err := u.standingOrderRepo.SaveExecution(ctx, execution); err != nil {
+			return err
+		}
+		recorded = true
+		return nil
+	})
+	return recorded, err
+}
+
+// 失敗として記録せずに次回の実行に持ち越すエラーかを返します。
+// 他の処理と更新が競合した場合や中断された場合は、次回に読み直して実行すれば成功する可能性があります。
+func isTransient(err error) bool {
+	return errors.Is(err, errNotDue) ||
+		errors.Is(err, standingOrderDomain.ErrConcurrentModification) ||
+		errors.Is(err, accountDomain.ErrConcurrentModification) ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,188 @@
+package standingorder_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	standingOrderUC "github.com/u104rak1/pocgo/internal/application/standing_order"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// 実行履歴の結果が一致するかを判定します。
+type executionResultMatcher struct {
+	result string
+}
+
+func (m executionResultMatcher) Matches(x interface{}) bool {
+	execution, ok := x.(*standingOrderDomain.Execution)
+	return ok && execution.Result() == m.result
+}
+
+func (m executionResultMatcher) String() string {
+	return fmt.Sprintf("execution with result %s", m.result)
+}
+
+func TestExecuteDueStandingOrdersUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ       *domainMock.MockIAccountService
+		transactionServ   *domainMock.MockITransactionService
+		standingOrderRepo *domainMock.MockIStandingOrderRepository
+	}
+
+	var (
+		accountID  = idVO.NewAccountIDForTest("account")
+		receiverID = idVO.NewAccountIDForTest("receiver")
+		now        = time.Date(2021, 1, 25, 9, 0, 0, 0, time.UTC)
+		clock      = func() time.Time { return now }
+		arg        = gomock.Any()
+	)
+
+	// 読み直した自動振込として、保存されている自動振込のコピーを返します。
+	reload := func(order *standingOrderDomain.StandingOrder) *standingOrderDomain.StandingOrder {
+		copied := *order
+		return &copied
+	}
+
+	expectTransfer := func(mocks Mocks, order *standingOrderDomain.StandingOrder, transferErr error) {
+		sender, _ := accountDomain.New(idVO.NewUserIDForTest("user"), 0, "For work", "1234", moneyVO.JPY)
+		receiver, _ := accountDomain.New(idVO.NewUserIDForTest("landlord"), 0, "Rent", "1234", moneyVO.JPY)
+		mocks.standingOrderRepo.EXPECT().FindByID(arg, order.ID()).Return(reload(order), nil)
+		mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, nil, nil).Return(sender, nil)
+		mocks.accountServ.EXPECT().GetAndAuthorize(arg, receiverID, nil, nil).Return(receiver, nil)
+		if transferErr != nil {
+			mocks.transactionServ.EXPECT().Transfer(arg, sender, receiver, int64(1000), moneyVO.JPY, transactionDomain.Description{}).Return(nil, transferErr)
+			return
+		}
+		amount, currency := int64(1000), moneyVO.JPY
+		tx, _ := transactionDomain.New(accountID, &receiverID, transactionDomain.Transfer, amount, currency, &amount, &currency, nil, now)
+		mocks.transactionServ.EXPECT().Transfer(arg, sender, receiver, amount, currency, transactionDomain.Description{}).Return(tx, nil)
+	}
+
+	tests := []struct {
+		caseName      string
+		prepare       func(mocks Mocks, orders []*standingOrderDomain.StandingOrder)
+		wantSucceeded int
+		wantFailed    int
+		wantErr       error
+	}{
+		{
+			caseName: "Positive: 実行すべき自動振込の振込が成功し、実行履歴が記録される",
+			prepare: func(mocks Mocks, orders []*standingOrderDomain.StandingOrder) {
+				mocks.standingOrderRepo.EXPECT().ListDue(arg, now, standingOrderDomain.ExecuteBatchSize).Return(orders, nil)
+				for _, order := range orders {
+					expectTransfer(mocks, order, nil)
+					mocks.standingOrderRepo.EXPECT().Save(arg, arg).Return(nil)
+					mocks.standingOrderRepo.EXPECT().SaveExecution(arg, executionResultMatcher{standingOrderDomain.ResultSucceeded}).Return(nil)
+				}
+			},
+			wantSucceeded: 2,
+			wantFailed:    0,
+			wantErr:       nil,
+		},
+		{
+			caseName: "Positive: 残高不足で失敗した場合は失敗が記録され、次の自動振込に進む",
+			prepare: func(mocks Mocks, orders []*standingOrderDomain.StandingOrder) {
+				mocks.standingOrderRepo.EXPECT().ListDue(arg, arg, arg).Return(orders, nil)
+				expectTransfer(mocks, orders[0], moneyVO.ErrInsufficientBalance)
+				mocks.standingOrderRepo.EXPECT().FindByID(arg, orders[0].ID()).Return(reload(orders[0]), nil)
+				mocks.standingOrderRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.standingOrderRepo.EXPECT().SaveExecution(arg, executionResultMatcher{standingOrderDomain.ResultRetryScheduled}).Return(nil)
+
+				expectTransfer(mocks, orders[1], nil)
+				mocks.standingOrderRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.standingOrderRepo.EXPECT().SaveExecution(arg, executionResultMatcher{standingOrderDomain.ResultSucceeded}).Return(nil)
+			},
+			wantSucceeded: 1,
+			wantFailed:    1,
+			wantErr:       nil,
+		},
+		{
+			caseName: "Positive: 読み直した時点で一時停止されている場合は実行せず、失敗も記録しない",
+			prepare: func(mocks Mocks, orders []*standingOrderDomain.StandingOrder) {
+				mocks.standingOrderRepo.EXPECT().ListDue(arg, arg, arg).Return(orders[:1], nil)
+				paused := reload(orders[0])
+				assert.NoError(t, paused.Pause(now))
+				mocks.standingOrderRepo.EXPECT().FindByID(arg, orders[0].ID()).Return(paused, nil)
+			},
+			wantSucceeded: 0,
+			wantFailed:    0,
+			wantErr:       nil,
+		},
+		{
+			caseName: "Positive: 口座の更新が競合した場合は失敗を記録せず、次回に持ち越す",
+			prepare: func(mocks Mocks, orders []*standingOrderDomain.StandingOrder) {
+				mocks.standingOrderRepo.EXPECT().ListDue(arg, arg, arg).Return(orders[:1], nil)
+				expectTransfer(mocks, orders[0], accountDomain.ErrConcurrentModification)
+			},
+			wantSucceeded: 0,
+			wantFailed:    0,
+			wantErr:       nil,
+		},
+		{
+			caseName: "Negative: 失敗の記録に失敗した場合はエラーが返るが、次の自動振込に進む",
+			prepare: func(mocks Mocks, orders []*standingOrderDomain.StandingOrder) {
+				mocks.standingOrderRepo.EXPECT().ListDue(arg, arg, arg).Return(orders, nil)
+				expectTransfer(mocks, orders[0], moneyVO.ErrInsufficientBalance)
+				mocks.standingOrderRepo.EXPECT().FindByID(arg, orders[0].ID()).Return(nil, assert.AnError)
+
+				expectTransfer(mocks, orders[1], nil)
+				mocks.standingOrderRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.standingOrderRepo.EXPECT().SaveExecution(arg, arg).Return(nil)
+			},
+			wantSucceeded: 1,
+			wantFailed:    0,
+			wantErr:       assert.AnError,
+		},
+		{
+			caseName: "Negative: 実行すべき自動振込の取得に失敗する",
+			prepare: func(mocks Mocks, orders []*standingOrderDomain.StandingOrder) {
+				mocks.standingOrderRepo.EXPECT().ListDue(arg, arg, arg).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ:       domainMock.NewMockIAccountService(ctrl),
+				transactionServ:   domainMock.NewMockITransactionService(ctrl),
+				standingOrderRepo: domainMock.NewMockIStandingOrderRepository(ctrl),
+			}
+			orders := []*standingOrderDomain.StandingOrder{
+				newStandingOrder(t, accountID, 1, standingOrderDomain.FailurePolicySkip),
+				newStandingOrder(t, accountID, 1, standingOrderDomain.FailurePolicySkip),
+			}
+			uc := standingOrderUC.NewExecuteDueStandingOrdersUsecase(
+				mocks.accountServ, mocks.transactionServ, mocks.standingOrderRepo, &appMock.MockIUnitOfWork{}, clock,
+			)
+			tt.prepare(mocks, orders)
+
+			dto, err := uc.Run(context.Background())
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if dto != nil {
+				assert.Equal(t, tt.wantSucceeded, dto.Succeeded)
+				assert.Equal(t, tt.wantFailed, dto.Failed)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package standingorder
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IListStandingOrdersUsecase interface {
+	Run(ctx context.Context, cmd ListStandingOrdersCommand) (*ListStandingOrdersDTO, error)
+}
+
+type listStandingOrdersUsecase struct {
+	accountServ       accountDomain.IAccountService
+	standingOrderRepo standingOrderDomain.IStandingOrderRepository
+}
+
+func NewListStandingOrdersUsecase(
+	accountService accountDomain.IAccountService,
+	standingOrderRepository standingOrderDomain.IStandingOrderRepository,
+) IListStandingOrdersUsecase {
+	return &listStandingOrdersUsecase{
+		accountServ:       accountService,
+		standingOrderRepo: standingOrderRepository,
+	}
+}
+
+type ListStandingOrdersCommand struct {
+	UserID    string
+	AccountID string
+}
+
+type ListStandingOrdersDTO struct {
+	StandingOrders []StandingOrderDTO
+}
+
+func (u *listStandingOrdersUsecase) Run(ctx context.Context, cmd ListStandingOrdersCommand) (*ListStandingOrdersDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil); err != nil {
+		return nil, err
+	}
+
+	orders, err := u.standingOrderRepo.ListByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	orderDTOs := make([]StandingOrderDTO, len(orders))
+	for i, order := range orders {
+		orderDTOs[i] = newStandingOrderDTO(order)
+	}
+
+	return &ListStandingOrdersDTO{
+		StandingOrders: orderDTOs,
+	}, nil
+}
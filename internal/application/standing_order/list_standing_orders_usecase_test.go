@@ -0,0 +1,126 @@
+package standingorder_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	standingOrderUC "github.com/u104rak1/pocgo/internal/application/standing_order"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/numutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// 2021-01-01 から毎月25日に 1000 円を振り込む自動振込を作成します。
+func newStandingOrder(t *testing.T, accountID idVO.AccountID, maxRetries int, policy string) *standingOrderDomain.StandingOrder {
+	t.Helper()
+	schedule, err := standingOrderDomain.NewSchedule(standingOrderDomain.FrequencyMonthly, numutil.IntPointer(25))
+	assert.NoError(t, err)
+	order, err := standingOrderDomain.New(
+		accountID, idVO.NewAccountIDForTest("receiver"), 1000, moneyVO.JPY, *schedule,
+		timer.GetFixedDate(), nil, nil, maxRetries, policy, timer.GetFixedDate(),
+	)
+	assert.NoError(t, err)
+	return order
+}
+
+func TestListStandingOrdersUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ       *domainMock.MockIAccountService
+		standingOrderRepo *domainMock.MockIStandingOrderRepository
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		arg       = gomock.Any()
+	)
+
+	happyCmd := standingOrderUC.ListStandingOrdersCommand{
+		UserID:    userID.String(),
+		AccountID: accountID.String(),
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      standingOrderUC.ListStandingOrdersCommand
+		prepare  func(mocks Mocks, order *standingOrderDomain.StandingOrder)
+		wantLen  int
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 口座の自動振込の一覧を取得できる",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.standingOrderRepo.EXPECT().ListByAccountID(arg, accountID).
+					Return([]*standingOrderDomain.StandingOrder{order}, nil)
+			},
+			wantLen: 1,
+			wantErr: nil,
+		},
+		{
+			caseName: "Positive: 自動振込がない場合は空の一覧を返す",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.standingOrderRepo.EXPECT().ListByAccountID(arg, accountID).
+					Return([]*standingOrderDomain.StandingOrder{}, nil)
+			},
+			wantLen: 0,
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 他のユーザーの口座である",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: accountDomain.ErrUnauthorized,
+		},
+		{
+			caseName: "Negative: 一覧の取得に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.standingOrderRepo.EXPECT().ListByAccountID(arg, accountID).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ:       domainMock.NewMockIAccountService(ctrl),
+				standingOrderRepo: domainMock.NewMockIStandingOrderRepository(ctrl),
+			}
+			order := newStandingOrder(t, accountID, 0, standingOrderDomain.FailurePolicySkip)
+			uc := standingOrderUC.NewListStandingOrdersUsecase(mocks.accountServ, mocks.standingOrderRepo)
+			tt.prepare(mocks, order)
+
+			dto, err := uc.Run(context.Background(), tt.cmd)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, dto.StandingOrders, tt.wantLen)
+				if tt.wantLen > 0 {
+					assert.Equal(t, order.IDString(), dto.StandingOrders[0].ID)
+					assert.Equal(t, "20210125", dto.StandingOrders[0].NextRunDate)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package standingorder
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IReadStandingOrderUsecase interface {
+	Run(ctx context.Context, cmd ReadStandingOrderCommand) (*ReadStandingOrderDTO, error)
+}
+
+type readStandingOrderUsecase struct {
+	accountServ       accountDomain.IAccountService
+	standingOrderServ standingOrderDomain.IStandingOrderService
+	standingOrderRepo standingOrderDomain.IStandingOrderRepository
+}
+
+func NewReadStandingOrderUsecase(
+	accountService accountDomain.IAccountService,
+	standingOrderService standingOrderDomain.IStandingOrderService,
+	standingOrderRepository standingOrderDomain.IStandingOrderRepository,
+) IReadStandingOrderUsecase {
+	return &readStandingOrderUsecase{
+		accountServ:       accountService,
+		standingOrderServ: standingOrderService,
+		standingOrderRepo: standingOrderRepository,
+	}
+}
+
+type ReadStandingOrderCommand struct {
+	UserID          string
+	AccountID       string
+	StandingOrderID string
+}
+
+type ReadStandingOrderDTO struct {
+	StandingOrderDTO
+	// 新しい順に最大 standingOrderDomain.RecentExecutionsLimit 件の実行履歴です。
+	RecentExecutions []ExecutionDTO
+}
+
+func (u *readStandingOrderUsecase) Run(ctx context.Context, cmd ReadStandingOrderCommand) (*ReadStandingOrderDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := idVO.StandingOrderIDFromString(cmd.StandingOrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil); err != nil {
+		return nil, err
+	}
+
+	order, err := u.standingOrderServ.GetByAccount(ctx, accountID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	executions, err := u.standingOrderRepo.ListExecutions(ctx, orderID, standingOrderDomain.RecentExecutionsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	executionDTOs := make([]ExecutionDTO, len(executions))
+	for i, execution := range executions {
+		executionDTOs[i] = newExecutionDTO(execution)
+	}
+
+	return &ReadStandingOrderDTO{
+		StandingOrderDTO: newStandingOrderDTO(order),
+		RecentExecutions: executionDTOs,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package standingorder_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	standingOrderUC "github.com/u104rak1/pocgo/internal/application/standing_order"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestReadStandingOrderUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ       *domainMock.MockIAccountService
+		standingOrderServ *domainMock.MockIStandingOrderService
+		standingOrderRepo *domainMock.MockIStandingOrderRepository
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		arg       = gomock.Any()
+	)
+
+	tests := []struct {
+		caseName string
+		prepare  func(mocks Mocks, order *standingOrderDomain.StandingOrder, execution *standingOrderDomain.Execution)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 自動振込と最近の実行履歴を取得できる",
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder, execution *standingOrderDomain.Execution) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.standingOrderServ.EXPECT().GetByAccount(arg, accountID, order.ID()).Return(order, nil)
+				mocks.standingOrderRepo.EXPECT().ListExecutions(arg, order.ID(), standingOrderDomain.RecentExecutionsLimit).
+					Return([]*standingOrderDomain.Execution{execution}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 他のユーザーの口座である",
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder, execution *standingOrderDomain.Execution) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: accountDomain.ErrUnauthorized,
+		},
+		{
+			caseName: "Negative: 自動振込が存在しない",
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder, execution *standingOrderDomain.Execution) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.standingOrderServ.EXPECT().GetByAccount(arg, accountID, order.ID()).Return(nil, standingOrderDomain.ErrNotFound)
+			},
+			wantErr: standingOrderDomain.ErrNotFound,
+		},
+		{
+			caseName: "Negative: 実行履歴の取得に失敗する",
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder, execution *standingOrderDomain.Execution) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.standingOrderServ.EXPECT().GetByAccount(arg, accountID, order.ID()).Return(order, nil)
+				mocks.standingOrderRepo.EXPECT().ListExecutions(arg, arg, arg).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ:       domainMock.NewMockIAccountService(ctrl),
+				standingOrderServ: domainMock.NewMockIStandingOrderService(ctrl),
+				standingOrderRepo: domainMock.NewMockIStandingOrderRepository(ctrl),
+			}
+			order := newStandingOrder(t, accountID, 1, standingOrderDomain.FailurePolicySkip)
+			execution := order.RecordFailure("insufficient balance", time.Date(2021, 1, 25, 9, 0, 0, 0, time.UTC))
+			uc := standingOrderUC.NewReadStandingOrderUsecase(mocks.accountServ, mocks.standingOrderServ, mocks.standingOrderRepo)
+			tt.prepare(mocks, order, execution)
+
+			dto, err := uc.Run(context.Background(), standingOrderUC.ReadStandingOrderCommand{
+				UserID:          userID.String(),
+				AccountID:       accountID.String(),
+				StandingOrderID: order.IDString(),
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, order.IDString(), dto.ID)
+				assert.Equal(t, 1, dto.RetryCount)
+				assert.Equal(t, "20210126", dto.NextAttemptDate)
+				assert.Equal(t, []standingOrderUC.ExecutionDTO{{
+					ScheduledDate: "20210125",
+					Attempt:       1,
+					Result:        standingOrderDomain.ResultRetryScheduled,
+					TransactionID: nil,
+					FailureReason: execution.FailureReason(),
+					ExecutedAt:    "2021-01-25T09:00:00Z",
+				}}, dto.RecentExecutions)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package standingorder
+
+import (
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// 自動振込の各ユースケースが返す自動振込です。日付は YYYYMMDD 形式、日時は ISO8601 形式です。
+type StandingOrderDTO struct {
+	ID                string
+	AccountID         string
+	ReceiverAccountID string
+	Amount            string
+	Currency          string
+	Frequency         string
+	DayOfMonth        *int
+	StartDate         string
+	EndDate           *string
+	MaxExecutions     *int
+	MaxRetries        int
+	FailurePolicy     string
+	Status            string
+	NextRunDate       string
+	NextAttemptDate   string
+	RetryCount        int
+	ExecutionCount    int
+	CreatedAt         string
+	UpdatedAt         string
+}
+
+type ExecutionDTO struct {
+	ScheduledDate string
+	Attempt       int
+	Result        string
+	TransactionID *string
+	FailureReason *string
+	ExecutedAt    string
+}
+
+func newStandingOrderDTO(order *standingOrderDomain.StandingOrder) StandingOrderDTO {
+	var endDate *string
+	if order.EndDate() != nil {
+		date := timer.FormatToYYYYMMDD(*order.EndDate())
+		endDate = &date
+	}
+	return StandingOrderDTO{
+		ID:                order.IDString(),
+		AccountID:         order.AccountIDString(),
+		ReceiverAccountID: order.ReceiverAccountIDString(),
+		Amount:            order.Amount().Decimal(),
+		Currency:          order.Amount().Currency(),
+		Frequency:         order.Schedule().Frequency(),
+		DayOfMonth:        order.Schedule().DayOfMonth(),
+		StartDate:         timer.FormatToYYYYMMDD(order.StartDate()),
+		EndDate:           endDate,
+		MaxExecutions:     order.MaxExecutions(),
+		MaxRetries:        order.MaxRetries(),
+		FailurePolicy:     order.FailurePolicy(),
+		Status:            order.Status(),
+		NextRunDate:       timer.FormatToYYYYMMDD(order.NextRunDate()),
+		NextAttemptDate:   timer.FormatToYYYYMMDD(order.NextAttemptDate()),
+		RetryCount:        order.RetryCount(),
+		ExecutionCount:    order.ExecutionCount(),
+		CreatedAt:         timer.FormatToISO8601(order.CreatedAt()),
+		UpdatedAt:         order.UpdatedAtString(),
+	}
+}
+
+func newExecutionDTO(execution *standingOrderDomain.Execution) ExecutionDTO {
+	return ExecutionDTO{
+		ScheduledDate: timer.FormatToYYYYMMDD(execution.ScheduledDate()),
+		Attempt:       execution.Attempt(),
+		Result:        execution.Result(),
+		TransactionID: execution.TransactionIDString(),
+		FailureReason: execution.FailureReason(),
+		ExecutedAt:    execution.ExecutedAtString(),
+	}
+}
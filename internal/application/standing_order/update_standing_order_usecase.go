@@ -0,0 +1,144 @@
+package standingorder
+
+import (
+	"context"
+	"time"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type IUpdateStandingOrderUsecase interface {
+	Run(ctx context.Context, cmd UpdateStandingOrderCommand) (*StandingOrderDTO, error)
+}
+
+type updateStandingOrderUsecase struct {
+	accountServ       accountDomain.IAccountService
+	standingOrderServ standingOrderDomain.IStandingOrderService
+	standingOrderRepo standingOrderDomain.IStandingOrderRepository
+	unitOfWork        unitofwork.IUnitOfWork
+}
+
+func NewUpdateStandingOrderUsecase(
+	accountService accountDomain.IAccountService,
+	standingOrderService standingOrderDomain.IStandingOrderService,
+	standingOrderRepository standingOrderDomain.IStandingOrderRepository,
+	unitOfWork unitofwork.IUnitOfWork,
+) IUpdateStandingOrderUsecase {
+	return &updateStandingOrderUsecase{
+		accountServ:       accountService,
+		standingOrderServ: standingOrderService,
+		standingOrderRepo: standingOrderRepository,
+		unitOfWork:        unitOfWork,
+	}
+}
+
+// 指定しなかった項目は変更しません。
+type UpdateStandingOrderCommand struct {
+	UserID          string
+	AccountID       string
+	StandingOrderID string
+	// 自動振込の通貨で指定します。
+	Amount        *string
+	EndDate       *time.Time
+	MaxExecutions *int
+	MaxRetries    *int
+	FailurePolicy *string
+	// ACTIVE で再開、PAUSED で一時停止します。
+	Status *string
+}
+
+func (u *updateStandingOrderUsecase) Run(ctx context.Context, cmd UpdateStandingOrderCommand) (*StandingOrderDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := idVO.StandingOrderIDFromString(cmd.StandingOrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var order *standingOrderDomain.StandingOrder
+	err = u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil); err != nil {
+			return err
+		}
+
+		order, err = u.standingOrderServ.GetByAccount(ctx, accountID, orderID)
+		if err != nil {
+			return err
+		}
+
+		if err := u.apply(order, cmd, timer.Now()); err != nil {
+			return err
+		}
+
+		return u.standingOrderRepo.Save(ctx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dto := newStandingOrderDTO(order)
+	return &dto, nil
+}
+
+func (u *updateStandingOrderUsecase) apply(order *standingOrderDomain.StandingOrder, cmd UpdateStandingOrderCommand, now time.Time) error {
+	if cmd.Amount != nil {
+		amount, err := moneyVO.NewFromDecimal(*cmd.Amount, order.Amount().Currency())
+		if err != nil {
+			return err
+		}
+		if err := order.ChangeAmount(amount.Amount(), now); err != nil {
+			return err
+		}
+	}
+
+	if cmd.EndDate != nil || cmd.MaxExecutions != nil {
+		endDate, maxExecutions := order.EndDate(), order.MaxExecutions()
+		if cmd.EndDate != nil {
+			endDate = cmd.EndDate
+		}
+		if cmd.MaxExecutions != nil {
+			maxExecutions = cmd.MaxExecutions
+		}
+		if err := order.ChangeEndCondition(endDate, maxExecutions, now); err != nil {
+			return err
+		}
+	}
+
+	if cmd.MaxRetries != nil || cmd.FailurePolicy != nil {
+		maxRetries, failurePolicy := order.MaxRetries(), order.FailurePolicy()
+		if cmd.MaxRetries != nil {
+			maxRetries = *cmd.MaxRetries
+		}
+		if cmd.FailurePolicy != nil {
+			failurePolicy = *cmd.FailurePolicy
+		}
+		if err := order.ChangeRetryPolicy(maxRetries, failurePolicy, now); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Status != nil {
+		switch *cmd.Status {
+		case standingOrderDomain.StatusActive:
+			return order.Resume(now)
+		case standingOrderDomain.StatusPaused:
+			return order.Pause(now)
+		default:
+			return standingOrderDomain.ErrUnsupportedStatus
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package standingorder_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	standingOrderUC "github.com/u104rak1/pocgo/internal/application/standing_order"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/numutil"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestUpdateStandingOrderUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ       *domainMock.MockIAccountService
+		standingOrderServ *domainMock.MockIStandingOrderService
+		standingOrderRepo *domainMock.MockIStandingOrderRepository
+	}
+
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		arg       = gomock.Any()
+		endDate   = time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC)
+	)
+
+	expectFound := func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+		mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+		mocks.standingOrderServ.EXPECT().GetByAccount(arg, accountID, order.ID()).Return(order, nil)
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      standingOrderUC.UpdateStandingOrderCommand
+		prepare  func(mocks Mocks, order *standingOrderDomain.StandingOrder)
+		check    func(t *testing.T, dto *standingOrderUC.StandingOrderDTO)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 指定した項目のみ変更できる",
+			cmd: standingOrderUC.UpdateStandingOrderCommand{
+				Amount:        strutil.StrPointer("85000"),
+				EndDate:       &endDate,
+				MaxRetries:    numutil.IntPointer(0),
+				FailurePolicy: strutil.StrPointer(standingOrderDomain.FailurePolicySuspend),
+			},
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				expectFound(mocks, order)
+				mocks.standingOrderRepo.EXPECT().Save(arg, order).Return(nil)
+			},
+			check: func(t *testing.T, dto *standingOrderUC.StandingOrderDTO) {
+				assert.Equal(t, "85000", dto.Amount)
+				assert.Equal(t, "20991231", *dto.EndDate)
+				assert.Nil(t, dto.MaxExecutions)
+				assert.Equal(t, 0, dto.MaxRetries)
+				assert.Equal(t, standingOrderDomain.FailurePolicySuspend, dto.FailurePolicy)
+				assert.Equal(t, standingOrderDomain.StatusActive, dto.Status)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Positive: 一時停止できる",
+			cmd: standingOrderUC.UpdateStandingOrderCommand{
+				Status: strutil.StrPointer(standingOrderDomain.StatusPaused),
+			},
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				expectFound(mocks, order)
+				mocks.standingOrderRepo.EXPECT().Save(arg, order).Return(nil)
+			},
+			check: func(t *testing.T, dto *standingOrderUC.StandingOrderDTO) {
+				assert.Equal(t, standingOrderDomain.StatusPaused, dto.Status)
+				assert.Equal(t, "1000", dto.Amount)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Positive: 再開すると過ぎた実行日を見送る",
+			cmd: standingOrderUC.UpdateStandingOrderCommand{
+				Status: strutil.StrPointer(standingOrderDomain.StatusActive),
+			},
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				assert.NoError(t, order.Pause(timer.GetFixedDate()))
+				expectFound(mocks, order)
+				mocks.standingOrderRepo.EXPECT().Save(arg, order).Return(nil)
+			},
+			check: func(t *testing.T, dto *standingOrderUC.StandingOrderDTO) {
+				assert.Equal(t, standingOrderDomain.StatusActive, dto.Status)
+				assert.GreaterOrEqual(t, dto.NextRunDate, timer.FormatToYYYYMMDD(timer.Now()))
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 自動振込の通貨の精度に合わない金額である",
+			cmd: standingOrderUC.UpdateStandingOrderCommand{
+				Amount: strutil.StrPointer("10.5"),
+			},
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				expectFound(mocks, order)
+			},
+			wantErr: moneyVO.ErrInvalidPrecision,
+		},
+		{
+			caseName: "Negative: 解約した自動振込は変更できない",
+			cmd: standingOrderUC.UpdateStandingOrderCommand{
+				MaxRetries: numutil.IntPointer(1),
+			},
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				assert.NoError(t, order.Cancel(timer.GetFixedDate()))
+				expectFound(mocks, order)
+			},
+			wantErr: standingOrderDomain.ErrNotModifiable,
+		},
+		{
+			caseName: "Negative: 変更できない状態を指定した",
+			cmd: standingOrderUC.UpdateStandingOrderCommand{
+				Status: strutil.StrPointer(standingOrderDomain.StatusCompleted),
+			},
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				expectFound(mocks, order)
+			},
+			wantErr: standingOrderDomain.ErrUnsupportedStatus,
+		},
+		{
+			caseName: "Negative: 他のユーザーの口座である",
+			cmd:      standingOrderUC.UpdateStandingOrderCommand{},
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: accountDomain.ErrUnauthorized,
+		},
+		{
+			caseName: "Negative: スケジューラーが先に更新していた",
+			cmd: standingOrderUC.UpdateStandingOrderCommand{
+				Amount: strutil.StrPointer("2000"),
+			},
+			prepare: func(mocks Mocks, order *standingOrderDomain.StandingOrder) {
+				expectFound(mocks, order)
+				mocks.standingOrderRepo.EXPECT().Save(arg, order).Return(standingOrderDomain.ErrConcurrentModification)
+			},
+			wantErr: standingOrderDomain.ErrConcurrentModification,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ:       domainMock.NewMockIAccountService(ctrl),
+				standingOrderServ: domainMock.NewMockIStandingOrderService(ctrl),
+				standingOrderRepo: domainMock.NewMockIStandingOrderRepository(ctrl),
+			}
+			order := newStandingOrder(t, accountID, 1, standingOrderDomain.FailurePolicySkip)
+			uc := standingOrderUC.NewUpdateStandingOrderUsecase(
+				mocks.accountServ, mocks.standingOrderServ, mocks.standingOrderRepo, &appMock.MockIUnitOfWork{},
+			)
+			tt.prepare(mocks, order)
+
+			cmd := tt.cmd
+			cmd.UserID = userID.String()
+			cmd.AccountID = accountID.String()
+			cmd.StandingOrderID = order.IDString()
+			dto, err := uc.Run(context.Background(), cmd)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, order.IDString(), dto.ID)
+				tt.check(t, dto)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package transaction
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type ICategorizeTransactionUsecase interface {
+	Run(ctx context.Context, cmd CategorizeTransactionCommand) (*ReadTransactionDTO, error)
+}
+
+type categorizeTransactionUsecase struct {
+	accountServ     accountDomain.IAccountService
+	transactionServ transactionDomain.ITransactionService
+	unitOfWork      unitofwork.IUnitOfWork
+}
+
+func NewCategorizeTransactionUsecase(
+	accountService accountDomain.IAccountService,
+	transactionService transactionDomain.ITransactionService,
+	unitOfWork unitofwork.IUnitOfWork,
+) ICategorizeTransactionUsecase {
+	return &categorizeTransactionUsecase{
+		accountServ:     accountService,
+		transactionServ: transactionService,
+		unitOfWork:      unitOfWork,
+	}
+}
+
+type CategorizeTransactionCommand struct {
+	UserID        string
+	AccountID     string
+	TransactionID string
+	// 口座が取引に付ける分類です。既に付けている分類は全て置き換え、空の場合は分類を全て外します。
+	Categories []string
+}
+
+// 口座の所有者が取引に分類を付けます。振込の場合、送金元と受取口座はそれぞれ別の分類を付けられます。
+func (u *categorizeTransactionUsecase) Run(ctx context.Context, cmd CategorizeTransactionCommand) (*ReadTransactionDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := idVO.TransactionIDFromString(cmd.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil); err != nil {
+		return nil, err
+	}
+
+	var transaction *transactionDomain.Transaction
+	if err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) error {
+		transaction, err = u.transactionServ.GetByAccount(ctx, accountID, transactionID)
+		if err != nil {
+			return err
+		}
+		return u.transactionServ.Categorize(ctx, accountID, transaction, cmd.Categories)
+	}); err != nil {
+		return nil, err
+	}
+
+	return newReadTransactionDTO(transaction, accountID), nil
+}
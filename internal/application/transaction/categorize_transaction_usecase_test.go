@@ -0,0 +1,139 @@
+package transaction_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	transactionUC "github.com/u104rak1/pocgo/internal/application/transaction"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestCategorizeTransactionUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ     *domainMock.MockIAccountService
+		transactionServ *domainMock.MockITransactionService
+	}
+
+	var (
+		userID        = idVO.NewUserIDForTest("user")
+		accountID     = idVO.NewAccountIDForTest("account")
+		transactionID = idVO.NewTransactionIDForTest("transaction")
+		categories    = []string{"travel", "food"}
+		arg           = gomock.Any()
+	)
+
+	happyCmd := transactionUC.CategorizeTransactionCommand{
+		UserID:        userID.String(),
+		AccountID:     accountID.String(),
+		TransactionID: transactionID.String(),
+		Categories:    categories,
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      transactionUC.CategorizeTransactionCommand
+		prepare  func(mocks Mocks, transaction *transactionDomain.Transaction)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 取引の分類の設定が成功する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, transaction *transactionDomain.Transaction) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.transactionServ.EXPECT().GetByAccount(arg, accountID, transactionID).Return(transaction, nil)
+				mocks.transactionServ.EXPECT().Categorize(arg, accountID, transaction, categories).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: ユーザーIDが不正な形式である",
+			cmd: transactionUC.CategorizeTransactionCommand{
+				UserID:        "invalid",
+				AccountID:     accountID.String(),
+				TransactionID: transactionID.String(),
+			},
+			prepare: func(mocks Mocks, transaction *transactionDomain.Transaction) {},
+			wantErr: idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: 取引IDが不正な形式である",
+			cmd: transactionUC.CategorizeTransactionCommand{
+				UserID:        userID.String(),
+				AccountID:     accountID.String(),
+				TransactionID: "invalid",
+			},
+			prepare: func(mocks Mocks, transaction *transactionDomain.Transaction) {},
+			wantErr: idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: 口座の取得と認可に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, transaction *transactionDomain.Transaction) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			wantErr: accountDomain.ErrUnauthorized,
+		},
+		{
+			caseName: "Negative: 取引が見つからない",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, transaction *transactionDomain.Transaction) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.transactionServ.EXPECT().GetByAccount(arg, accountID, transactionID).Return(nil, transactionDomain.ErrNotFound)
+			},
+			wantErr: transactionDomain.ErrNotFound,
+		},
+		{
+			caseName: "Negative: 分類の設定に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, transaction *transactionDomain.Transaction) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(nil, nil)
+				mocks.transactionServ.EXPECT().GetByAccount(arg, accountID, transactionID).Return(transaction, nil)
+				mocks.transactionServ.EXPECT().Categorize(arg, accountID, transaction, categories).Return(transactionDomain.ErrTooManyCategories)
+			},
+			wantErr: transactionDomain.ErrTooManyCategories,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ:     domainMock.NewMockIAccountService(ctrl),
+				transactionServ: domainMock.NewMockITransactionService(ctrl),
+			}
+			mockUnitOfWork := &appMock.MockIUnitOfWork{}
+			// 分類を付けた後の取引として、送金元の口座の分類を持つ取引を用意する
+			transaction, err := transactionDomain.Reconstruct(
+				transactionID.String(), accountID.String(), nil, transactionDomain.Withdrawal, 1000, moneyVO.JPY,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, map[string][]string{accountID.String(): categories}, timer.GetFixedDate(),
+			)
+			assert.NoError(t, err)
+
+			uc := transactionUC.NewCategorizeTransactionUsecase(mocks.accountServ, mocks.transactionServ, mockUnitOfWork)
+			tt.prepare(mocks, transaction)
+
+			dto, err := uc.Run(context.Background(), tt.cmd)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, transactionID.String(), dto.ID)
+				assert.Equal(t, transactionDomain.Debit, dto.Direction)
+				assert.Equal(t, []string{"food", "travel"}, dto.Categories)
+			}
+		})
+	}
+}
@@ -2,11 +2,17 @@ package transaction
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
+	"github.com/u104rak1/pocgo/internal/application/idempotency"
 	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
 )
 
 type IExecuteTransactionUsecase interface {
@@ -14,20 +20,26 @@ type IExecuteTransactionUsecase interface {
 }
 
 type executeTransactionUsecase struct {
-	accountServ     accountDomain.IAccountService
-	transactionServ transactionDomain.ITransactionService
-	unitOfWork      unitofwork.IUnitOfWorkWithResult[transactionDomain.Transaction]
+	accountServ        accountDomain.IAccountService
+	transactionServ    transactionDomain.ITransactionService
+	payeeServ          payeeDomain.IPayeeService
+	idempotencyKeyRepo idempotency.IIdempotencyKeyRepository
+	unitOfWork         unitofwork.IUnitOfWorkWithResult[transactionDomain.Transaction]
 }
 
 func NewExecuteTransactionUsecase(
 	accountService accountDomain.IAccountService,
 	transactionService transactionDomain.ITransactionService,
+	payeeService payeeDomain.IPayeeService,
+	idempotencyKeyRepository idempotency.IIdempotencyKeyRepository,
 	unitOfWork unitofwork.IUnitOfWorkWithResult[transactionDomain.Transaction],
 ) IExecuteTransactionUsecase {
 	return &executeTransactionUsecase{
-		accountServ:     accountService,
-		transactionServ: transactionService,
-		unitOfWork:      unitOfWork,
+		accountServ:        accountService,
+		transactionServ:    transactionService,
+		payeeServ:          payeeService,
+		idempotencyKeyRepo: idempotencyKeyRepository,
+		unitOfWork:         unitOfWork,
 	}
 }
 
@@ -36,9 +48,14 @@ type ExecuteTransactionCommand struct {
 	AccountID         string
 	Password          string
 	OperationType     string
-	Amount            float64
+	Amount            string
 	Currency          string
 	ReceiverAccountID *string
+	// 受取口座IDの代わりに指定する支払先IDです。支払先が振込の通貨で受け取る既定の口座に振り込みます。
+	PayeeID        *string
+	Memo           *string
+	Reference      *string
+	IdempotencyKey *string
 }
 
 type ExecuteTransactionDTO struct {
@@ -46,8 +63,13 @@ type ExecuteTransactionDTO struct {
 	AccountID         string
 	ReceiverAccountID *string
 	OperationType     string
-	Amount            float64
+	Amount            string
 	Currency          string
+	ReceiverAmount    *string
+	ReceiverCurrency  *string
+	ExchangeRate      *string
+	Memo              *string
+	Reference         *string
 	TransactionAt     string
 }
 
@@ -62,53 +84,190 @@ func (u *executeTransactionUsecase) Run(ctx context.Context, cmd ExecuteTransact
 		return nil, err
 	}
 
+	amount, err := moneyVO.NewFromDecimal(cmd.Amount, cmd.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := transactionDomain.NewDescription(cmd.Memo, cmd.Reference)
+	if err != nil {
+		return nil, err
+	}
+
 	account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, &cmd.Password)
 	if err != nil {
 		return nil, err
 	}
 
-	var transaction *transactionDomain.Transaction
-	switch cmd.OperationType {
-	case transactionDomain.Deposit:
-		transaction, err = u.unitOfWork.RunInTx(ctx, func(ctx context.Context) (*transactionDomain.Transaction, error) {
-			return u.transactionServ.Deposit(ctx, account, cmd.Amount, cmd.Currency)
+	if cmd.IdempotencyKey == nil {
+		transaction, err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) (*transactionDomain.Transaction, error) {
+			return u.execute(ctx, cmd, account, amount, description)
 		})
 		if err != nil {
 			return nil, err
 		}
-	case transactionDomain.Withdrawal:
-		transaction, err = u.unitOfWork.RunInTx(ctx, func(ctx context.Context) (*transactionDomain.Transaction, error) {
-			return u.transactionServ.Withdrawal(ctx, account, cmd.Amount, cmd.Currency)
-		})
+		return newExecuteTransactionDTO(transaction), nil
+	}
+
+	return u.runIdempotently(ctx, cmd, userID, account, amount, description)
+}
+
+// Idempotency-Key が指定された場合、同じキーで既に処理されたリクエストであれば保存済みのレスポンスを返します。
+// キーの登録は取引と同じトランザクション内で行う為、同じキーのリクエストが同時に来ても取引が二重に実行されることはありません。
+func (u *executeTransactionUsecase) runIdempotently(
+	ctx context.Context,
+	cmd ExecuteTransactionCommand,
+	userID idVO.UserID,
+	account *accountDomain.Account,
+	amount *moneyVO.Money,
+	description transactionDomain.Description,
+) (*ExecuteTransactionDTO, error) {
+	// パスワードはフィンガープリントに含めない
+	// 支払先ID、メモと参照情報は指定されていない場合に省略し、指定する前に登録されたキーのフィンガープリントと一致させる
+	fingerprint, err := idempotency.Fingerprint(struct {
+		AccountID         string
+		OperationType     string
+		Amount            int64
+		Currency          string
+		ReceiverAccountID *string
+		PayeeID           *string `json:",omitempty"`
+		Memo              *string `json:",omitempty"`
+		Reference         *string `json:",omitempty"`
+	}{cmd.AccountID, cmd.OperationType, amount.Amount(), amount.Currency(), cmd.ReceiverAccountID, cmd.PayeeID, description.Memo(), description.Reference()})
+	if err != nil {
+		return nil, err
+	}
+
+	dto, err := u.findStoredResponse(ctx, userID.String(), *cmd.IdempotencyKey, fingerprint)
+	if err != nil || dto != nil {
+		return dto, err
+	}
+
+	transaction, err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) (*transactionDomain.Transaction, error) {
+		if err := u.idempotencyKeyRepo.Create(ctx, &idempotency.IdempotencyKey{
+			UserID:      userID.String(),
+			Key:         *cmd.IdempotencyKey,
+			Fingerprint: fingerprint,
+			CreatedAt:   timer.Now(),
+		}); err != nil {
+			return nil, err
+		}
+
+		transaction, err := u.execute(ctx, cmd, account, amount, description)
 		if err != nil {
 			return nil, err
 		}
-	case transactionDomain.Transfer:
-		transaction, err = u.unitOfWork.RunInTx(ctx, func(ctx context.Context) (*transactionDomain.Transaction, error) {
-			receiverAccountID, err := idVO.AccountIDFromString(*cmd.ReceiverAccountID)
+
+		response, err := json.Marshal(newExecuteTransactionDTO(transaction))
+		if err != nil {
+			return nil, err
+		}
+		if err := u.idempotencyKeyRepo.SaveResponse(ctx, userID.String(), *cmd.IdempotencyKey, response); err != nil {
+			return nil, err
+		}
+		return transaction, nil
+	})
+	if err != nil {
+		if errors.Is(err, idempotency.ErrKeyAlreadyExists) {
+			// 同じキーのリクエストが先に処理された
+			dto, err := u.findStoredResponse(ctx, userID.String(), *cmd.IdempotencyKey, fingerprint)
 			if err != nil {
 				return nil, err
 			}
-			receiverAccount, err := u.accountServ.GetAndAuthorize(ctx, receiverAccountID, nil, nil)
-			if err != nil {
-				return nil, err
+			if dto == nil {
+				return nil, idempotency.ErrRequestInProgress
 			}
-			return u.transactionServ.Transfer(ctx, account, receiverAccount, cmd.Amount, cmd.Currency)
-		})
+			return dto, nil
+		}
+		if deleteErr := u.idempotencyKeyRepo.DeletePending(ctx, userID.String(), *cmd.IdempotencyKey); deleteErr != nil {
+			return nil, errors.Join(err, deleteErr)
+		}
+		return nil, err
+	}
+
+	return newExecuteTransactionDTO(transaction), nil
+}
+
+// 保存済みのレスポンスを返します。キーが未使用の場合は nil を返します。
+func (u *executeTransactionUsecase) findStoredResponse(ctx context.Context, userID, key, fingerprint string) (*ExecuteTransactionDTO, error) {
+	stored, err := u.idempotencyKeyRepo.FindByUserIDAndKey(ctx, userID, key)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, nil
+	}
+	if stored.Fingerprint != fingerprint {
+		return nil, idempotency.ErrKeyReused
+	}
+	if stored.Response == nil {
+		return nil, idempotency.ErrRequestInProgress
+	}
+
+	var dto ExecuteTransactionDTO
+	if err := json.Unmarshal(stored.Response, &dto); err != nil {
+		return nil, err
+	}
+	return &dto, nil
+}
+
+func (u *executeTransactionUsecase) execute(
+	ctx context.Context,
+	cmd ExecuteTransactionCommand,
+	account *accountDomain.Account,
+	amount *moneyVO.Money,
+	description transactionDomain.Description,
+) (*transactionDomain.Transaction, error) {
+	switch cmd.OperationType {
+	case transactionDomain.Deposit:
+		return u.transactionServ.Deposit(ctx, account, amount.Amount(), amount.Currency(), description)
+	case transactionDomain.Withdrawal:
+		return u.transactionServ.Withdrawal(ctx, account, amount.Amount(), amount.Currency(), description)
+	case transactionDomain.Transfer:
+		receiverAccountID, err := u.receiverAccountID(ctx, cmd, account.UserID(), amount.Currency())
 		if err != nil {
 			return nil, err
 		}
+		receiverAccount, err := u.accountServ.GetAndAuthorize(ctx, receiverAccountID, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		return u.transactionServ.Transfer(ctx, account, receiverAccount, amount.Amount(), amount.Currency(), description)
 	default:
 		return nil, transactionDomain.ErrUnsupportedType
 	}
+}
+
+// 支払先IDが指定された場合は、支払先が振込の通貨で受け取る既定の口座のIDを返します。
+func (u *executeTransactionUsecase) receiverAccountID(
+	ctx context.Context,
+	cmd ExecuteTransactionCommand,
+	userID idVO.UserID,
+	currency string,
+) (idVO.AccountID, error) {
+	if cmd.PayeeID == nil {
+		return idVO.AccountIDFromString(*cmd.ReceiverAccountID)
+	}
+	payeeID, err := idVO.PayeeIDFromString(*cmd.PayeeID)
+	if err != nil {
+		return idVO.AccountID{}, err
+	}
+	return u.payeeServ.ResolveReceiverAccountID(ctx, userID, payeeID, currency)
+}
 
+func newExecuteTransactionDTO(transaction *transactionDomain.Transaction) *ExecuteTransactionDTO {
 	return &ExecuteTransactionDTO{
 		ID:                transaction.IDString(),
 		AccountID:         transaction.AccountIDString(),
 		ReceiverAccountID: transaction.ReceiverAccountIDString(),
 		OperationType:     transaction.OperationType(),
-		Amount:            transaction.TransferAmount().Amount(),
+		Amount:            transaction.TransferAmount().Decimal(),
 		Currency:          transaction.TransferAmount().Currency(),
+		ReceiverAmount:    transaction.ReceiverAmountDecimal(),
+		ReceiverCurrency:  transaction.ReceiverCurrency(),
+		ExchangeRate:      transaction.ExchangeRateString(),
+		Memo:              transaction.Memo(),
+		Reference:         transaction.Reference(),
 		TransactionAt:     transaction.TransactionAtString(),
-	}, nil
+	}
 }
@@ -2,25 +2,31 @@ package transaction_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/u104rak1/pocgo/internal/application/idempotency"
 	appMock "github.com/u104rak1/pocgo/internal/application/mock"
 	transactionUC "github.com/u104rak1/pocgo/internal/application/transaction"
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
 	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
 	passwordUtil "github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/strutil"
 	"github.com/u104rak1/pocgo/pkg/timer"
 )
 
 func TestExecuteTransactionUsecase(t *testing.T) {
 	type Mocks struct {
-		accountServ     *domainMock.MockIAccountService
-		transactionServ *domainMock.MockITransactionService
+		accountServ        *domainMock.MockIAccountService
+		transactionServ    *domainMock.MockITransactionService
+		payeeServ          *domainMock.MockIPayeeService
+		idempotencyKeyRepo *appMock.MockIIdempotencyKeyRepository
 	}
 
 	var (
@@ -30,7 +36,8 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 		accountName         = "test"
 		receiverAccountName = "receiver"
 		password            = "1234"
-		amount              = 1000.0
+		amount              = int64(1000)
+		decimalAmount       = "1000"
 		currency            = moneyVO.JPY
 		time                = timer.GetFixedDate()
 		arg                 = gomock.Any()
@@ -43,7 +50,7 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 		AccountID:     accountID.String(),
 		Password:      password,
 		OperationType: transactionDomain.Deposit,
-		Amount:        amount,
+		Amount:        decimalAmount,
 		Currency:      currency,
 	}
 
@@ -52,21 +59,45 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 		AccountID:     accountID.String(),
 		Password:      password,
 		OperationType: transactionDomain.Withdrawal,
-		Amount:        amount,
+		Amount:        decimalAmount,
 		Currency:      currency,
 	}
 
+	memo, reference := "Lunch with team", "INV-2024-0001"
+	describedWithdrawalCmd := happyWithdrawalCmd
+	describedWithdrawalCmd.Memo = &memo
+	describedWithdrawalCmd.Reference = &reference
+
 	receiverIDStr := receiverID.String()
 	happyTransferCmd := transactionUC.ExecuteTransactionCommand{
 		UserID:            userID.String(),
 		AccountID:         accountID.String(),
 		Password:          password,
 		OperationType:     transactionDomain.Transfer,
-		Amount:            amount,
+		Amount:            decimalAmount,
 		Currency:          currency,
 		ReceiverAccountID: &receiverIDStr,
 	}
 
+	payeeID := idVO.NewPayeeIDForTest("payee")
+	payeeIDStr := payeeID.String()
+	payeeTransferCmd := happyTransferCmd
+	payeeTransferCmd.ReceiverAccountID = nil
+	payeeTransferCmd.PayeeID = &payeeIDStr
+
+	idempotencyKey := "idempotency-key"
+	idempotentDepositCmd := happyDepositCmd
+	idempotentDepositCmd.IdempotencyKey = &idempotencyKey
+	fingerprint, err := idempotency.Fingerprint(struct {
+		AccountID         string
+		OperationType     string
+		Amount            int64
+		Currency          string
+		ReceiverAccountID *string
+	}{accountID.String(), transactionDomain.Deposit, amount, currency, nil})
+	assert.NoError(t, err)
+	storedResponse := []byte(`{"ID":"stored","AccountID":"` + accountID.String() + `","OperationType":"DEPOSIT","Amount":"1000","Currency":"JPY","TransactionAt":"2024-01-01T00:00:00Z"}`)
+
 	tests := []struct {
 		caseName string
 		cmd      transactionUC.ExecuteTransactionCommand
@@ -79,9 +110,9 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 			prepare: func(mocks Mocks, account *accountDomain.Account) {
 				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
 
-				tx, err := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, currency, time)
+				tx, err := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, currency, nil, nil, nil, time)
 				assert.NoError(t, err)
-				mocks.transactionServ.EXPECT().Deposit(arg, arg, arg, arg).Return(tx, nil)
+				mocks.transactionServ.EXPECT().Deposit(arg, arg, arg, arg, arg).Return(tx, nil)
 			},
 			wantErr: false,
 		},
@@ -91,9 +122,9 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 			prepare: func(mocks Mocks, account *accountDomain.Account) {
 				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
 
-				tx, err := transactionDomain.New(account.ID(), nil, transactionDomain.Withdrawal, amount, currency, time)
+				tx, err := transactionDomain.New(account.ID(), nil, transactionDomain.Withdrawal, amount, currency, nil, nil, nil, time)
 				assert.NoError(t, err)
-				mocks.transactionServ.EXPECT().Withdrawal(arg, arg, arg, arg).Return(tx, nil)
+				mocks.transactionServ.EXPECT().Withdrawal(arg, arg, arg, arg, arg).Return(tx, nil)
 			},
 			wantErr: false,
 		},
@@ -104,18 +135,130 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
 
 				receiverAccount, err := accountDomain.Reconstruct(
-					receiverID.String(), userID.String(), receiverAccountName, passwordHash, currency, 0.0, time,
+					receiverID.String(), userID.String(), receiverAccountName, passwordHash, currency, 0, 0, time, 1,
 				)
 				assert.NoError(t, err)
 				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, nil, nil).Return(receiverAccount, nil)
 
 				receiverAccountID := receiverAccount.ID()
-				tx, err := transactionDomain.New(account.ID(), &receiverAccountID, transactionDomain.Transfer, amount, currency, time)
+				tx, err := transactionDomain.New(account.ID(), &receiverAccountID, transactionDomain.Transfer, amount, currency, &amount, &currency, nil, time)
 				assert.NoError(t, err)
-				mocks.transactionServ.EXPECT().Transfer(arg, arg, arg, arg, arg).Return(tx, nil)
+				mocks.transactionServ.EXPECT().Transfer(arg, arg, arg, arg, arg, arg).Return(tx, nil)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 支払先IDを指定した送金取引は支払先の既定の受取口座に送金する",
+			cmd:      payeeTransferCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+				mocks.payeeServ.EXPECT().ResolveReceiverAccountID(arg, userID, payeeID, currency).Return(receiverID, nil)
+
+				receiverAccount, err := accountDomain.Reconstruct(
+					receiverID.String(), idVO.NewUserIDForTest("payee").String(), receiverAccountName, passwordHash, currency, 0, 0, time, 1,
+				)
+				assert.NoError(t, err)
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, receiverID, nil, nil).Return(receiverAccount, nil)
+
+				tx, err := transactionDomain.New(account.ID(), &receiverID, transactionDomain.Transfer, amount, currency, &amount, &currency, nil, time)
+				assert.NoError(t, err)
+				mocks.transactionServ.EXPECT().Transfer(arg, account, receiverAccount, arg, arg, arg).Return(tx, nil)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: メモと参照情報を付けた出金取引が成功する",
+			cmd:      describedWithdrawalCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+
+				description, err := transactionDomain.NewDescription(&memo, &reference)
+				assert.NoError(t, err)
+				mocks.transactionServ.EXPECT().Withdrawal(arg, arg, arg, arg, description).
+					DoAndReturn(func(ctx context.Context, account *accountDomain.Account, amount int64, currency string, description transactionDomain.Description) (*transactionDomain.Transaction, error) {
+						return transactionDomain.Reconstruct(
+							idVO.NewTransactionIDForTest("described").String(), account.IDString(), nil, transactionDomain.Withdrawal, amount, currency,
+							nil, nil, nil, nil, nil, nil, nil, description.Memo(), description.Reference(), nil, time,
+						)
+					})
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: Idempotency-Key が未使用の場合は、取引を実行してレスポンスを保存する",
+			cmd:      idempotentDepositCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+				mocks.idempotencyKeyRepo.EXPECT().FindByUserIDAndKey(arg, userID.String(), idempotencyKey).Return(nil, nil)
+				mocks.idempotencyKeyRepo.EXPECT().Create(arg, arg).Return(nil)
+
+				tx, err := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, currency, nil, nil, nil, time)
+				assert.NoError(t, err)
+				mocks.transactionServ.EXPECT().Deposit(arg, arg, arg, arg, arg).Return(tx, nil)
+				mocks.idempotencyKeyRepo.EXPECT().SaveResponse(arg, userID.String(), idempotencyKey, arg).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: Idempotency-Key が使用済みの場合は、取引を実行せずに保存済みのレスポンスを返す",
+			cmd:      idempotentDepositCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+				mocks.idempotencyKeyRepo.EXPECT().FindByUserIDAndKey(arg, userID.String(), idempotencyKey).Return(&idempotency.IdempotencyKey{
+					UserID: userID.String(), Key: idempotencyKey, Fingerprint: fingerprint, Response: storedResponse,
+				}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 同じ Idempotency-Key のリクエストが先に完了した場合は、保存済みのレスポンスを返す",
+			cmd:      idempotentDepositCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+				gomock.InOrder(
+					mocks.idempotencyKeyRepo.EXPECT().FindByUserIDAndKey(arg, userID.String(), idempotencyKey).Return(nil, nil),
+					mocks.idempotencyKeyRepo.EXPECT().Create(arg, arg).Return(idempotency.ErrKeyAlreadyExists),
+					mocks.idempotencyKeyRepo.EXPECT().FindByUserIDAndKey(arg, userID.String(), idempotencyKey).Return(&idempotency.IdempotencyKey{
+						UserID: userID.String(), Key: idempotencyKey, Fingerprint: fingerprint, Response: storedResponse,
+					}, nil),
+				)
 			},
 			wantErr: false,
 		},
+		{
+			caseName: "Negative: Idempotency-Key が異なるリクエストで使用済みである",
+			cmd:      idempotentDepositCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+				mocks.idempotencyKeyRepo.EXPECT().FindByUserIDAndKey(arg, userID.String(), idempotencyKey).Return(&idempotency.IdempotencyKey{
+					UserID: userID.String(), Key: idempotencyKey, Fingerprint: "other", Response: storedResponse,
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 同じ Idempotency-Key のリクエストが処理中である",
+			cmd:      idempotentDepositCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+				mocks.idempotencyKeyRepo.EXPECT().FindByUserIDAndKey(arg, userID.String(), idempotencyKey).Return(&idempotency.IdempotencyKey{
+					UserID: userID.String(), Key: idempotencyKey, Fingerprint: fingerprint,
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: Idempotency-Key を指定した取引に失敗した場合は、処理中のキーを削除する",
+			cmd:      idempotentDepositCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+				mocks.idempotencyKeyRepo.EXPECT().FindByUserIDAndKey(arg, userID.String(), idempotencyKey).Return(nil, nil)
+				mocks.idempotencyKeyRepo.EXPECT().Create(arg, arg).Return(nil)
+				mocks.transactionServ.EXPECT().Deposit(arg, arg, arg, arg, arg).Return(nil, assert.AnError)
+				mocks.idempotencyKeyRepo.EXPECT().DeletePending(arg, userID.String(), idempotencyKey).Return(nil)
+			},
+			wantErr: true,
+		},
 		{
 			caseName: "Negative: ユーザーIDが不正な形式である",
 			cmd: transactionUC.ExecuteTransactionCommand{
@@ -146,7 +289,7 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 			cmd:      happyDepositCmd,
 			prepare: func(mocks Mocks, account *accountDomain.Account) {
 				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
-				mocks.transactionServ.EXPECT().Deposit(arg, arg, arg, arg).Return(nil, assert.AnError)
+				mocks.transactionServ.EXPECT().Deposit(arg, arg, arg, arg, arg).Return(nil, assert.AnError)
 			},
 			wantErr: true,
 		},
@@ -155,7 +298,7 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 			cmd:      happyWithdrawalCmd,
 			prepare: func(mocks Mocks, account *accountDomain.Account) {
 				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
-				mocks.transactionServ.EXPECT().Withdrawal(arg, arg, arg, arg).Return(nil, assert.AnError)
+				mocks.transactionServ.EXPECT().Withdrawal(arg, arg, arg, arg, arg).Return(nil, assert.AnError)
 			},
 			wantErr: true,
 		},
@@ -175,12 +318,48 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
 
 				receiverAccount, err := accountDomain.Reconstruct(
-					receiverID.String(), userID.String(), receiverAccountName, passwordHash, currency, 0.0, time,
+					receiverID.String(), userID.String(), receiverAccountName, passwordHash, currency, 0, 0, time, 1,
 				)
 				assert.NoError(t, err)
 				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, nil, nil).Return(receiverAccount, nil)
 
-				mocks.transactionServ.EXPECT().Transfer(arg, arg, arg, arg, arg).Return(nil, assert.AnError)
+				mocks.transactionServ.EXPECT().Transfer(arg, arg, arg, arg, arg, arg).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 金額が通貨の精度を満たしていない",
+			cmd: transactionUC.ExecuteTransactionCommand{
+				UserID:        userID.String(),
+				AccountID:     accountID.String(),
+				Password:      password,
+				OperationType: transactionDomain.Deposit,
+				Amount:        "1000.5",
+				Currency:      currency,
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: メモが長すぎる",
+			cmd: transactionUC.ExecuteTransactionCommand{
+				UserID:        userID.String(),
+				AccountID:     accountID.String(),
+				Password:      password,
+				OperationType: transactionDomain.Deposit,
+				Amount:        decimalAmount,
+				Currency:      currency,
+				Memo:          strutil.StrPointer(strings.Repeat("a", transactionDomain.MemoMaxLength+1)),
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 支払先が未確認である",
+			cmd:      payeeTransferCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+				mocks.payeeServ.EXPECT().ResolveReceiverAccountID(arg, userID, payeeID, currency).Return(idVO.AccountID{}, payeeDomain.ErrNotConfirmed)
 			},
 			wantErr: true,
 		},
@@ -191,7 +370,7 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 				AccountID:     accountID.String(),
 				Password:      password,
 				OperationType: "UNSUPPORTED",
-				Amount:        amount,
+				Amount:        decimalAmount,
 				Currency:      currency,
 			},
 			prepare: func(mocks Mocks, account *accountDomain.Account) {
@@ -208,17 +387,19 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := Mocks{
-				accountServ:     domainMock.NewMockIAccountService(ctrl),
-				transactionServ: domainMock.NewMockITransactionService(ctrl),
+				accountServ:        domainMock.NewMockIAccountService(ctrl),
+				transactionServ:    domainMock.NewMockITransactionService(ctrl),
+				payeeServ:          domainMock.NewMockIPayeeService(ctrl),
+				idempotencyKeyRepo: appMock.NewMockIIdempotencyKeyRepository(ctrl),
 			}
 			mockUnitOfWork := &appMock.MockIUnitOfWorkWithResult[transactionDomain.Transaction]{}
 
 			uc := transactionUC.NewExecuteTransactionUsecase(
-				mocks.accountServ, mocks.transactionServ, mockUnitOfWork,
+				mocks.accountServ, mocks.transactionServ, mocks.payeeServ, mocks.idempotencyKeyRepo, mockUnitOfWork,
 			)
 			ctx := context.Background()
 			acc, err := accountDomain.Reconstruct(
-				accountID.String(), userID.String(), accountName, passwordHash, currency, 0.0, time,
+				accountID.String(), userID.String(), accountName, passwordHash, currency, 0, 0, time, 1,
 			)
 			assert.NoError(t, err)
 			tt.prepare(mocks, acc)
@@ -233,10 +414,16 @@ func TestExecuteTransactionUsecase(t *testing.T) {
 				assert.NotNil(t, dto)
 				assert.NotEmpty(t, dto.ID)
 				assert.Equal(t, tt.cmd.AccountID, dto.AccountID)
-				assert.Equal(t, tt.cmd.ReceiverAccountID, dto.ReceiverAccountID)
+				if tt.cmd.PayeeID != nil {
+					assert.Equal(t, &receiverIDStr, dto.ReceiverAccountID)
+				} else {
+					assert.Equal(t, tt.cmd.ReceiverAccountID, dto.ReceiverAccountID)
+				}
 				assert.Equal(t, tt.cmd.OperationType, dto.OperationType)
 				assert.Equal(t, tt.cmd.Amount, dto.Amount)
 				assert.Equal(t, tt.cmd.Currency, dto.Currency)
+				assert.Equal(t, tt.cmd.Memo, dto.Memo)
+				assert.Equal(t, tt.cmd.Reference, dto.Reference)
 				assert.NotEmpty(t, dto.TransactionAt)
 			}
 		})
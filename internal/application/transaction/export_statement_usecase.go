@@ -0,0 +1,167 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// 取引明細の出力形式です。
+const (
+	StatementFormatCSV     = "csv"
+	StatementFormatOFX     = "ofx"
+	StatementFormatCamt053 = "camt053"
+)
+
+type IExportStatementUsecase interface {
+	Run(ctx context.Context, cmd ExportStatementCommand, writer IStatementWriter) error
+}
+
+// 取引明細を出力形式（CSV、OFX など）ごとに書き出します。
+// WriteHeader、取引の件数分の WriteTransaction、WriteFooter の順に呼び出されます。
+type IStatementWriter interface {
+	WriteHeader(header StatementHeaderDTO) error
+	WriteTransaction(transaction ListTransactionDTO) error
+	WriteFooter() error
+}
+
+type exportStatementUsecase struct {
+	accountServ        accountDomain.IAccountService
+	ledgerRepo         ledgerDomain.ILedgerRepository
+	listTransactionsUC IListTransactionsUsecase
+}
+
+// 取引の取得は IListTransactionsUsecase にカーソルを渡してページ単位で行う為、件数の上限なく全ての取引を書き出します。
+func NewExportStatementUsecase(
+	accountService accountDomain.IAccountService,
+	ledgerRepository ledgerDomain.ILedgerRepository,
+	listTransactionsUsecase IListTransactionsUsecase,
+) IExportStatementUsecase {
+	return &exportStatementUsecase{
+		accountServ:        accountService,
+		ledgerRepo:         ledgerRepository,
+		listTransactionsUC: listTransactionsUsecase,
+	}
+}
+
+type ExportStatementCommand struct {
+	UserID    string
+	AccountID string
+	// 期間の開始日と終了日です（いずれも UTC の 0 時）。終了日の取引も含みます。
+	From time.Time
+	To   time.Time
+}
+
+type StatementHeaderDTO struct {
+	AccountID   string
+	AccountName string
+	Currency    string
+	// 明細の対象期間です。PeriodEnd は期間に含みません。
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	OpeningBalance string
+	ClosingBalance string
+	GeneratedAt    time.Time
+}
+
+func (u *exportStatementUsecase) Run(ctx context.Context, cmd ExportStatementCommand, writer IStatementWriter) error {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return err
+	}
+
+	account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil)
+	if err != nil {
+		return err
+	}
+
+	periodStart := cmd.From
+	periodEnd := cmd.To.AddDate(0, 0, 1)
+	currency := account.Balance().Currency()
+
+	// 期間の終了日時が未来の場合は、期末残高の算出後に記帳された取引が明細に含まれないよう、
+	// 期末残高と書き出す取引を同じ基準日時（生成日時）で区切ります。
+	generatedAt := timer.Now()
+	closingAt := periodEnd
+	if generatedAt.Before(closingAt) {
+		closingAt = generatedAt
+	}
+
+	openingBalance, err := u.balanceBefore(ctx, accountID, currency, periodStart)
+	if err != nil {
+		return err
+	}
+	closingBalance, err := u.balanceBefore(ctx, accountID, currency, closingAt)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.WriteHeader(StatementHeaderDTO{
+		AccountID:      account.IDString(),
+		AccountName:    account.Name(),
+		Currency:       currency,
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodEnd,
+		OpeningBalance: openingBalance,
+		ClosingBalance: closingBalance,
+		GeneratedAt:    generatedAt,
+	}); err != nil {
+		return err
+	}
+
+	// 期末残高と同じく、基準日時より前の取引を書き出します。
+	sort := transactionDomain.SortAsc
+	limit := transactionDomain.ListTransactionsLimit
+	var cursor *string
+	for {
+		dto, err := u.listTransactionsUC.Run(ctx, ListTransactionsCommand{
+			UserID:    cmd.UserID,
+			AccountID: cmd.AccountID,
+			From:      &periodStart,
+			Before:    &closingAt,
+			Sort:      &sort,
+			Limit:     &limit,
+			Cursor:    cursor,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, t := range dto.Transactions {
+			if err := writer.WriteTransaction(t); err != nil {
+				return err
+			}
+		}
+
+		if dto.NextCursor == nil {
+			break
+		}
+		cursor = dto.NextCursor
+	}
+
+	return writer.WriteFooter()
+}
+
+// 指定日時より前の記帳から残高を求め、通貨の小数点以下の桁数に合わせた10進数表記で返します。
+func (u *exportStatementUsecase) balanceBefore(ctx context.Context, accountID idVO.AccountID, currency string, before time.Time) (string, error) {
+	amount, err := u.ledgerRepo.BalanceByAccountIDBefore(ctx, accountID, currency, before)
+	if err != nil {
+		return "", err
+	}
+	balance, err := moneyVO.New(amount, currency)
+	if err != nil {
+		return "", err
+	}
+	return balance.Decimal(), nil
+}
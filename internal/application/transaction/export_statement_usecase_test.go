@@ -0,0 +1,238 @@
+package transaction_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	transactionUC "github.com/u104rak1/pocgo/internal/application/transaction"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	passwordUtil "github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestExportStatementUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ        *domainMock.MockIAccountService
+		ledgerRepo         *domainMock.MockILedgerRepository
+		listTransactionsUC *appMock.MockIListTransactionsUsecase
+		writer             *appMock.MockIStatementWriter
+	}
+
+	var (
+		userID      = idVO.NewUserIDForTest("user")
+		accountID   = idVO.NewAccountIDForTest("account")
+		accountName = "test"
+		password    = "1234"
+		currency    = moneyVO.JPY
+		from        = timer.GetFixedDate()
+		to          = from.AddDate(0, 0, 30)
+		periodEnd   = to.AddDate(0, 0, 1)
+		arg         = gomock.Any()
+	)
+	passwordHash, err := passwordUtil.Encode(password)
+	assert.NoError(t, err)
+
+	happyCmd := transactionUC.ExportStatementCommand{
+		UserID:    userID.String(),
+		AccountID: accountID.String(),
+		From:      from,
+		To:        to,
+	}
+	tx1 := transactionUC.ListTransactionDTO{ID: "tx1"}
+	tx2 := transactionUC.ListTransactionDTO{ID: "tx2"}
+
+	tests := []struct {
+		caseName string
+		cmd      transactionUC.ExportStatementCommand
+		prepare  func(mocks Mocks, account *accountDomain.Account)
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: カーソルで全ページを取得して取引明細を書き出せる",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(account, nil)
+				mocks.ledgerRepo.EXPECT().BalanceByAccountIDBefore(arg, accountID, currency, from).Return(int64(1000), nil)
+				mocks.ledgerRepo.EXPECT().BalanceByAccountIDBefore(arg, accountID, currency, periodEnd).Return(int64(1500), nil)
+
+				gomock.InOrder(
+					mocks.writer.EXPECT().WriteHeader(gomock.AssignableToTypeOf(transactionUC.StatementHeaderDTO{})).
+						DoAndReturn(func(header transactionUC.StatementHeaderDTO) error {
+							assert.Equal(t, accountID.String(), header.AccountID)
+							assert.Equal(t, accountName, header.AccountName)
+							assert.Equal(t, currency, header.Currency)
+							assert.Equal(t, from, header.PeriodStart)
+							assert.Equal(t, periodEnd, header.PeriodEnd)
+							assert.Equal(t, "1000", header.OpeningBalance)
+							assert.Equal(t, "1500", header.ClosingBalance)
+							return nil
+						}),
+					mocks.listTransactionsUC.EXPECT().Run(arg, arg).
+						DoAndReturn(func(_ context.Context, cmd transactionUC.ListTransactionsCommand) (*transactionUC.ListTransactionsDTO, error) {
+							assert.Nil(t, cmd.Cursor)
+							assert.Nil(t, cmd.Page)
+							assert.Equal(t, transactionDomain.SortAsc, *cmd.Sort)
+							assert.Equal(t, transactionDomain.ListTransactionsLimit, *cmd.Limit)
+							assert.Equal(t, from, *cmd.From)
+							assert.Nil(t, cmd.To)
+							assert.Equal(t, periodEnd, *cmd.Before)
+							return &transactionUC.ListTransactionsDTO{
+								Transactions: []transactionUC.ListTransactionDTO{tx1},
+								NextCursor:   strutil.StrPointer("next"),
+							}, nil
+						}),
+					mocks.writer.EXPECT().WriteTransaction(tx1).Return(nil),
+					mocks.listTransactionsUC.EXPECT().Run(arg, arg).
+						DoAndReturn(func(_ context.Context, cmd transactionUC.ListTransactionsCommand) (*transactionUC.ListTransactionsDTO, error) {
+							assert.Equal(t, "next", *cmd.Cursor)
+							return &transactionUC.ListTransactionsDTO{
+								Transactions: []transactionUC.ListTransactionDTO{tx2},
+							}, nil
+						}),
+					mocks.writer.EXPECT().WriteTransaction(tx2).Return(nil),
+					mocks.writer.EXPECT().WriteFooter().Return(nil),
+				)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 期間の終了日時が未来の場合、期末残高と書き出す取引を生成日時で区切る",
+			cmd: transactionUC.ExportStatementCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+				From:      from,
+				To:        timer.Now().AddDate(0, 0, 1),
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				var closingAt time.Time
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, accountID, &userID, nil).Return(account, nil)
+				mocks.ledgerRepo.EXPECT().BalanceByAccountIDBefore(arg, accountID, currency, from).Return(int64(1000), nil)
+				mocks.ledgerRepo.EXPECT().BalanceByAccountIDBefore(arg, accountID, currency, arg).
+					DoAndReturn(func(_ context.Context, _ idVO.AccountID, _ string, before time.Time) (int64, error) {
+						closingAt = before
+						return int64(1500), nil
+					})
+
+				gomock.InOrder(
+					mocks.writer.EXPECT().WriteHeader(gomock.AssignableToTypeOf(transactionUC.StatementHeaderDTO{})).
+						DoAndReturn(func(header transactionUC.StatementHeaderDTO) error {
+							assert.Equal(t, header.GeneratedAt, closingAt)
+							assert.True(t, closingAt.Before(header.PeriodEnd))
+							return nil
+						}),
+					mocks.listTransactionsUC.EXPECT().Run(arg, arg).
+						DoAndReturn(func(_ context.Context, cmd transactionUC.ListTransactionsCommand) (*transactionUC.ListTransactionsDTO, error) {
+							assert.Equal(t, closingAt, *cmd.Before)
+							return &transactionUC.ListTransactionsDTO{
+								Transactions: []transactionUC.ListTransactionDTO{tx1},
+							}, nil
+						}),
+					mocks.writer.EXPECT().WriteTransaction(tx1).Return(nil),
+					mocks.writer.EXPECT().WriteFooter().Return(nil),
+				)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: ユーザーIDが不正な形式である",
+			cmd: transactionUC.ExportStatementCommand{
+				UserID: "invalid",
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座IDが不正な形式である",
+			cmd: transactionUC.ExportStatementCommand{
+				UserID:    userID.String(),
+				AccountID: "invalid",
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座認証に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 期首残高の算出に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(account, nil)
+				mocks.ledgerRepo.EXPECT().BalanceByAccountIDBefore(arg, arg, arg, arg).Return(int64(0), assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 取引履歴の取得に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(account, nil)
+				mocks.ledgerRepo.EXPECT().BalanceByAccountIDBefore(arg, arg, arg, arg).Return(int64(0), nil).Times(2)
+				mocks.writer.EXPECT().WriteHeader(arg).Return(nil)
+				mocks.listTransactionsUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 取引の書き出しに失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(account, nil)
+				mocks.ledgerRepo.EXPECT().BalanceByAccountIDBefore(arg, arg, arg, arg).Return(int64(0), nil).Times(2)
+				mocks.writer.EXPECT().WriteHeader(arg).Return(nil)
+				mocks.listTransactionsUC.EXPECT().Run(arg, arg).Return(&transactionUC.ListTransactionsDTO{
+					Transactions: []transactionUC.ListTransactionDTO{tx1},
+				}, nil)
+				mocks.writer.EXPECT().WriteTransaction(tx1).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ:        domainMock.NewMockIAccountService(ctrl),
+				ledgerRepo:         domainMock.NewMockILedgerRepository(ctrl),
+				listTransactionsUC: appMock.NewMockIListTransactionsUsecase(ctrl),
+				writer:             appMock.NewMockIStatementWriter(ctrl),
+			}
+
+			uc := transactionUC.NewExportStatementUsecase(
+				mocks.accountServ, mocks.ledgerRepo, mocks.listTransactionsUC,
+			)
+			ctx := context.Background()
+			acc, err := accountDomain.Reconstruct(
+				accountID.String(), userID.String(), accountName, passwordHash, currency, 1500, 0, from, 1,
+			)
+			assert.NoError(t, err)
+			tt.prepare(mocks, acc)
+
+			err = uc.Run(ctx, tt.cmd, mocks.writer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
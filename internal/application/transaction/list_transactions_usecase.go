@@ -7,6 +7,7 @@ import (
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
 )
 
 type IListTransactionsUsecase interface {
@@ -29,29 +30,50 @@ func NewListTransactionsUsecase(
 }
 
 type ListTransactionsCommand struct {
-	UserID         string
-	AccountID      string
-	From           *time.Time
-	To             *time.Time
+	UserID    string
+	AccountID string
+	From      *time.Time
+	To        *time.Time
+	// 指定日時より前の取引に限ります。To と異なり指定日時を含みません。
+	Before         *time.Time
 	OperationTypes []string
-	Sort           *string
-	Limit          *int
-	Page           *int
+	Query          *string
+	// 金額の範囲は口座の通貨の10進数表記で指定します。
+	MinAmount  *string
+	MaxAmount  *string
+	Categories []string
+	Sort       *string
+	Limit      *int
+	Page       *int
+	Cursor     *string
 }
 
 type ListTransactionsDTO struct {
-	Total        int
+	// カーソルを指定した場合は nil です。
+	Total        *int
 	Transactions []ListTransactionDTO
+	NextCursor   *string
+	PrevCursor   *string
 }
 
 type ListTransactionDTO struct {
-	ID                string
-	AccountID         string
-	ReceiverAccountID *string
-	OperationType     string
-	Amount            float64
-	Currency          string
-	TransactionAt     string
+	ID                    string
+	AccountID             string
+	ReceiverAccountID     *string
+	OperationType         string
+	ReversedTransactionID *string
+	Amount                string
+	Currency              string
+	ReceiverAmount        *string
+	ReceiverCurrency      *string
+	ExchangeRate          *string
+	Direction             string
+	SignedAmount          string
+	BalanceAfter          *string
+	Memo                  *string
+	Reference             *string
+	Categories            []string
+	TransactionAt         string
 }
 
 func (u *listTransactionsUsecase) Run(ctx context.Context, cmd ListTransactionsCommand) (*ListTransactionsDTO, error) {
@@ -65,39 +87,98 @@ func (u *listTransactionsUsecase) Run(ctx context.Context, cmd ListTransactionsC
 		return nil, err
 	}
 
-	_, err = u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil)
+	account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	transactions, total, err := u.transactionServ.ListWithTotal(ctx, transactionDomain.ListTransactionsParams{
+	minAmount, err := amountInAccountCurrency(cmd.MinAmount, account)
+	if err != nil {
+		return nil, err
+	}
+	maxAmount, err := amountInAccountCurrency(cmd.MaxAmount, account)
+	if err != nil {
+		return nil, err
+	}
+	if minAmount != nil && maxAmount != nil && *minAmount > *maxAmount {
+		return nil, transactionDomain.ErrInvalidAmountRange
+	}
+
+	var cursor *transactionDomain.Cursor
+	if cmd.Cursor != nil {
+		cursor, err = transactionDomain.DecodeCursor(*cmd.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := u.transactionServ.List(ctx, transactionDomain.ListTransactionsParams{
 		AccountID:      accountID,
 		From:           cmd.From,
 		To:             cmd.To,
+		Before:         cmd.Before,
 		OperationTypes: cmd.OperationTypes,
+		Query:          cmd.Query,
+		MinAmount:      minAmount,
+		MaxAmount:      maxAmount,
+		Categories:     cmd.Categories,
 		Sort:           cmd.Sort,
 		Limit:          cmd.Limit,
 		Page:           cmd.Page,
+		Cursor:         cursor,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	transactionDTOs := make([]ListTransactionDTO, len(transactions))
-	for i, t := range transactions {
+	transactionDTOs := make([]ListTransactionDTO, len(result.Transactions))
+	for i, t := range result.Transactions {
 		transactionDTOs[i] = ListTransactionDTO{
-			ID:                t.IDString(),
-			AccountID:         t.AccountIDString(),
-			ReceiverAccountID: t.ReceiverAccountIDString(),
-			OperationType:     t.OperationType(),
-			Amount:            t.TransferAmount().Amount(),
-			Currency:          t.TransferAmount().Currency(),
-			TransactionAt:     t.TransactionAtString(),
+			ID:                    t.IDString(),
+			AccountID:             t.AccountIDString(),
+			ReceiverAccountID:     t.ReceiverAccountIDString(),
+			OperationType:         t.OperationType(),
+			ReversedTransactionID: t.ReversedTransactionIDString(),
+			Amount:                t.TransferAmount().Decimal(),
+			Currency:              t.TransferAmount().Currency(),
+			ReceiverAmount:        t.ReceiverAmountDecimal(),
+			ReceiverCurrency:      t.ReceiverCurrency(),
+			ExchangeRate:          t.ExchangeRateString(),
+			Direction:             t.DirectionFor(accountID),
+			SignedAmount:          t.SignedAmountDecimalFor(accountID),
+			BalanceAfter:          t.BalanceAfterDecimalFor(accountID),
+			Memo:                  t.MemoFor(accountID),
+			Reference:             t.Reference(),
+			Categories:            t.CategoriesFor(accountID),
+			TransactionAt:         t.TransactionAtString(),
 		}
 	}
 
 	return &ListTransactionsDTO{
-		Total:        total,
+		Total:        result.Total,
 		Transactions: transactionDTOs,
+		NextCursor:   encodeCursor(result.NextCursor),
+		PrevCursor:   encodeCursor(result.PrevCursor),
 	}, nil
 }
+
+func encodeCursor(cursor *transactionDomain.Cursor) *string {
+	if cursor == nil {
+		return nil
+	}
+	encoded := cursor.Encode()
+	return &encoded
+}
+
+// 10進数表記の金額を口座の通貨の最小単位に変換します。指定されていない場合は nil を返します。
+func amountInAccountCurrency(amount *string, account *accountDomain.Account) (*int64, error) {
+	if amount == nil {
+		return nil, nil
+	}
+	money, err := moneyVO.NewFromDecimal(*amount, account.Balance().Currency())
+	if err != nil {
+		return nil, err
+	}
+	value := money.Amount()
+	return &value, nil
+}
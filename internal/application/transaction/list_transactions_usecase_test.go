@@ -13,6 +13,7 @@ import (
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
 	passwordUtil "github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/strutil"
 	"github.com/u104rak1/pocgo/pkg/timer"
 )
 
@@ -27,7 +28,7 @@ func TestListTransactionsUsecase(t *testing.T) {
 		accountID   = idVO.NewAccountIDForTest("account")
 		accountName = "test"
 		password    = "1234"
-		amount      = 1000.0
+		amount      = int64(1000)
 		currency    = moneyVO.JPY
 		time        = timer.GetFixedDate()
 		arg         = gomock.Any()
@@ -61,16 +62,79 @@ func TestListTransactionsUsecase(t *testing.T) {
 			prepare: func(mocks Mocks, account *accountDomain.Account) {
 				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(account, nil)
 
-				tx1, err := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, currency, time)
+				tx1, err := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, currency, nil, nil, nil, time)
 				assert.NoError(t, err)
 
 				transactions := []*transactionDomain.Transaction{tx1}
 				total := len(transactions)
+				next := transactionDomain.NewCursor(tx1, false)
 
-				mocks.transactionServ.EXPECT().ListWithTotal(arg, arg).Return(transactions, total, nil)
+				mocks.transactionServ.EXPECT().List(arg, arg).Return(&transactionDomain.ListTransactionsResult{
+					Transactions: transactions,
+					Total:        &total,
+					NextCursor:   &next,
+				}, nil)
 			},
 			wantErr: false,
 		},
+		{
+			caseName: "Positive: 検索条件の金額は口座の通貨の最小単位に変換される",
+			cmd: transactionUC.ListTransactionsCommand{
+				UserID:     userID.String(),
+				AccountID:  accountID.String(),
+				Query:      strutil.StrPointer("lunch"),
+				MinAmount:  strutil.StrPointer("1000"),
+				MaxAmount:  strutil.StrPointer("5000"),
+				Categories: []string{"food"},
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(account, nil)
+
+				tx1, err := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, currency, nil, nil, nil, time)
+				assert.NoError(t, err)
+				total := 1
+				next := transactionDomain.NewCursor(tx1, false)
+
+				mocks.transactionServ.EXPECT().List(arg, arg).DoAndReturn(
+					func(_ context.Context, params transactionDomain.ListTransactionsParams) (*transactionDomain.ListTransactionsResult, error) {
+						assert.Equal(t, strutil.StrPointer("lunch"), params.Query)
+						assert.Equal(t, int64(1000), *params.MinAmount)
+						assert.Equal(t, int64(5000), *params.MaxAmount)
+						assert.Equal(t, []string{"food"}, params.Categories)
+						return &transactionDomain.ListTransactionsResult{
+							Transactions: []*transactionDomain.Transaction{tx1},
+							Total:        &total,
+							NextCursor:   &next,
+						}, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: 金額の下限が上限より大きい",
+			cmd: transactionUC.ListTransactionsCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+				MinAmount: strutil.StrPointer("5000"),
+				MaxAmount: strutil.StrPointer("1000"),
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(account, nil)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 金額の小数点以下の桁数が口座の通貨と合わない",
+			cmd: transactionUC.ListTransactionsCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+				MinAmount: strutil.StrPointer("10.5"),
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(account, nil)
+			},
+			wantErr: true,
+		},
 		{
 			caseName: "Negative: ユーザーIDが不正な形式である",
 			cmd: transactionUC.ListTransactionsCommand{
@@ -88,6 +152,18 @@ func TestListTransactionsUsecase(t *testing.T) {
 			prepare: func(mocks Mocks, account *accountDomain.Account) {},
 			wantErr: true,
 		},
+		{
+			caseName: "Negative: カーソルが不正な形式である",
+			cmd: transactionUC.ListTransactionsCommand{
+				UserID:    userID.String(),
+				AccountID: accountID.String(),
+				Cursor:    strutil.StrPointer("invalid"),
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(account, nil)
+			},
+			wantErr: true,
+		},
 		{
 			caseName: "Negative: 口座認証に失敗する",
 			cmd:      happyCmd,
@@ -101,7 +177,7 @@ func TestListTransactionsUsecase(t *testing.T) {
 			cmd:      happyCmd,
 			prepare: func(mocks Mocks, account *accountDomain.Account) {
 				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(account, nil)
-				mocks.transactionServ.EXPECT().ListWithTotal(arg, arg).Return(nil, 0, assert.AnError)
+				mocks.transactionServ.EXPECT().List(arg, arg).Return(nil, assert.AnError)
 			},
 			wantErr: true,
 		},
@@ -123,7 +199,7 @@ func TestListTransactionsUsecase(t *testing.T) {
 			)
 			ctx := context.Background()
 			acc, err := accountDomain.Reconstruct(
-				accountID.String(), userID.String(), accountName, passwordHash, currency, 0.0, time,
+				accountID.String(), userID.String(), accountName, passwordHash, currency, 0, 0, time, 1,
 			)
 			assert.NoError(t, err)
 			tt.prepare(mocks, acc)
@@ -138,12 +214,16 @@ func TestListTransactionsUsecase(t *testing.T) {
 				assert.NotNil(t, dto)
 				assert.NotEmpty(t, dto.Total)
 				assert.NotEmpty(t, dto.Transactions)
+				assert.NotNil(t, dto.NextCursor)
+				assert.Nil(t, dto.PrevCursor)
 				for _, tx := range dto.Transactions {
 					assert.NotEmpty(t, tx.ID)
 					assert.NotEmpty(t, tx.AccountID)
 					assert.NotEmpty(t, tx.OperationType)
 					assert.NotEmpty(t, tx.Amount)
 					assert.NotEmpty(t, tx.Currency)
+					assert.NotEmpty(t, tx.Direction)
+					assert.NotEmpty(t, tx.SignedAmount)
 					assert.NotEmpty(t, tx.TransactionAt)
 				}
 			}
@@ -0,0 +1,106 @@
+package transaction
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IReadTransactionUsecase interface {
+	Run(ctx context.Context, cmd ReadTransactionCommand) (*ReadTransactionDTO, error)
+}
+
+type readTransactionUsecase struct {
+	accountServ     accountDomain.IAccountService
+	transactionServ transactionDomain.ITransactionService
+}
+
+func NewReadTransactionUsecase(
+	accountService accountDomain.IAccountService,
+	transactionService transactionDomain.ITransactionService,
+) IReadTransactionUsecase {
+	return &readTransactionUsecase{
+		accountServ:     accountService,
+		transactionServ: transactionService,
+	}
+}
+
+type ReadTransactionCommand struct {
+	UserID        string
+	AccountID     string
+	TransactionID string
+}
+
+type ReadTransactionDTO struct {
+	ID                    string
+	AccountID             string
+	ReceiverAccountID     *string
+	OperationType         string
+	ReversedTransactionID *string
+	Amount                string
+	Currency              string
+	ReceiverAmount        *string
+	ReceiverCurrency      *string
+	ExchangeRate          *string
+	Direction             string
+	SignedAmount          string
+	BalanceAfter          *string
+	Memo                  *string
+	Reference             *string
+	Categories            []string
+	TransactionAt         string
+}
+
+func (u *readTransactionUsecase) Run(ctx context.Context, cmd ReadTransactionCommand) (*ReadTransactionDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := idVO.TransactionIDFromString(cmd.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.accountServ.GetAndAuthorize(ctx, accountID, &userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := u.transactionServ.GetByAccount(ctx, accountID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newReadTransactionDTO(t, accountID), nil
+}
+
+// 指定された口座から見た取引の DTO を作成します。
+func newReadTransactionDTO(t *transactionDomain.Transaction, accountID idVO.AccountID) *ReadTransactionDTO {
+	return &ReadTransactionDTO{
+		ID:                    t.IDString(),
+		AccountID:             t.AccountIDString(),
+		ReceiverAccountID:     t.ReceiverAccountIDString(),
+		OperationType:         t.OperationType(),
+		ReversedTransactionID: t.ReversedTransactionIDString(),
+		Amount:                t.TransferAmount().Decimal(),
+		Currency:              t.TransferAmount().Currency(),
+		ReceiverAmount:        t.ReceiverAmountDecimal(),
+		ReceiverCurrency:      t.ReceiverCurrency(),
+		ExchangeRate:          t.ExchangeRateString(),
+		Direction:             t.DirectionFor(accountID),
+		SignedAmount:          t.SignedAmountDecimalFor(accountID),
+		BalanceAfter:          t.BalanceAfterDecimalFor(accountID),
+		Memo:                  t.MemoFor(accountID),
+		Reference:             t.Reference(),
+		Categories:            t.CategoriesFor(accountID),
+		TransactionAt:         t.TransactionAtString(),
+	}
+}
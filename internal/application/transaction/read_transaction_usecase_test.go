@@ -0,0 +1,157 @@
+package transaction_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	transactionUC "github.com/u104rak1/pocgo/internal/application/transaction"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	passwordUtil "github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestReadTransactionUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ     *domainMock.MockIAccountService
+		transactionServ *domainMock.MockITransactionService
+	}
+
+	var (
+		userID        = idVO.NewUserIDForTest("user")
+		accountID     = idVO.NewAccountIDForTest("account")
+		transactionID = idVO.NewTransactionIDForTest("transaction")
+		accountName   = "test"
+		password      = "1234"
+		amount        = int64(1000)
+		balanceAfter  = int64(3000)
+		currency      = moneyVO.JPY
+		time          = timer.GetFixedDate()
+		arg           = gomock.Any()
+	)
+	passwordHash, err := passwordUtil.Encode(password)
+	assert.NoError(t, err)
+
+	happyCmd := transactionUC.ReadTransactionCommand{
+		UserID:        userID.String(),
+		AccountID:     accountID.String(),
+		TransactionID: transactionID.String(),
+	}
+
+	tests := []struct {
+		caseName string
+		cmd      transactionUC.ReadTransactionCommand
+		prepare  func(mocks Mocks, account *accountDomain.Account)
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 取引の取得が成功する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, account.ID(), &userID, nil).Return(account, nil)
+
+				tx, err := transactionDomain.Reconstruct(
+					transactionID.String(), accountID.String(), nil, transactionDomain.Withdrawal, amount, currency,
+					nil, nil, nil, nil, nil, &balanceAfter, nil, nil, nil, nil, time,
+				)
+				assert.NoError(t, err)
+				mocks.transactionServ.EXPECT().GetByAccount(arg, account.ID(), transactionID).Return(tx, nil)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: ユーザーIDが不正な形式である",
+			cmd: transactionUC.ReadTransactionCommand{
+				UserID: "invalid",
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座IDが不正な形式である",
+			cmd: transactionUC.ReadTransactionCommand{
+				UserID:    userID.String(),
+				AccountID: "invalid",
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 取引IDが不正な形式である",
+			cmd: transactionUC.ReadTransactionCommand{
+				UserID:        userID.String(),
+				AccountID:     accountID.String(),
+				TransactionID: "invalid",
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 口座認証に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 取引の取得に失敗する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, nil).Return(account, nil)
+				mocks.transactionServ.EXPECT().GetByAccount(arg, arg, arg).Return(nil, transactionDomain.ErrNotFound)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ:     domainMock.NewMockIAccountService(ctrl),
+				transactionServ: domainMock.NewMockITransactionService(ctrl),
+			}
+
+			uc := transactionUC.NewReadTransactionUsecase(
+				mocks.accountServ, mocks.transactionServ,
+			)
+			ctx := context.Background()
+			acc, err := accountDomain.Reconstruct(
+				accountID.String(), userID.String(), accountName, passwordHash, currency, 0, 0, time, 1,
+			)
+			assert.NoError(t, err)
+			tt.prepare(mocks, acc)
+
+			dto, err := uc.Run(ctx, tt.cmd)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &transactionUC.ReadTransactionDTO{
+					ID:            transactionID.String(),
+					AccountID:     accountID.String(),
+					OperationType: transactionDomain.Withdrawal,
+					Amount:        "1000",
+					Currency:      currency,
+					Direction:     transactionDomain.Debit,
+					SignedAmount:  "-1000",
+					BalanceAfter:  strutil.StrPointer("3000"),
+					Categories:    []string{},
+					TransactionAt: timer.GetFixedDateString(),
+				}, dto)
+			}
+		})
+	}
+}
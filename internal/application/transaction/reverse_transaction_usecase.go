@@ -0,0 +1,109 @@
+package transaction
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IReverseTransactionUsecase interface {
+	Run(ctx context.Context, cmd ReverseTransactionCommand) (*ReverseTransactionDTO, error)
+}
+
+type reverseTransactionUsecase struct {
+	accountServ     accountDomain.IAccountService
+	transactionServ transactionDomain.ITransactionService
+	unitOfWork      unitofwork.IUnitOfWorkWithResult[transactionDomain.Transaction]
+}
+
+func NewReverseTransactionUsecase(
+	accountService accountDomain.IAccountService,
+	transactionService transactionDomain.ITransactionService,
+	unitOfWork unitofwork.IUnitOfWorkWithResult[transactionDomain.Transaction],
+) IReverseTransactionUsecase {
+	return &reverseTransactionUsecase{
+		accountServ:     accountService,
+		transactionServ: transactionService,
+		unitOfWork:      unitOfWork,
+	}
+}
+
+type ReverseTransactionCommand struct {
+	UserID        string
+	AccountID     string
+	TransactionID string
+	Password      string
+}
+
+type ReverseTransactionDTO struct {
+	ID                    string
+	AccountID             string
+	ReceiverAccountID     *string
+	OperationType         string
+	ReversedTransactionID string
+	Amount                string
+	Currency              string
+	ReceiverAmount        *string
+	ReceiverCurrency      *string
+	ExchangeRate          *string
+	Direction             string
+	SignedAmount          string
+	BalanceAfter          *string
+	Reference             *string
+	TransactionAt         string
+}
+
+func (u *reverseTransactionUsecase) Run(ctx context.Context, cmd ReverseTransactionCommand) (*ReverseTransactionDTO, error) {
+	userID, err := idVO.UserIDFromString(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := idVO.AccountIDFromString(cmd.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := idVO.TransactionIDFromString(cmd.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := u.accountServ.GetAndAuthorize(ctx, accountID, &userID, &cmd.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	// 取消の有無の確認と両方の口座の残高の更新を同じトランザクションで行う
+	reversal, err := u.unitOfWork.RunInTx(ctx, func(ctx context.Context) (*transactionDomain.Transaction, error) {
+		original, err := u.transactionServ.GetByAccount(ctx, accountID, transactionID)
+		if err != nil {
+			return nil, err
+		}
+		return u.transactionServ.Reverse(ctx, account, original)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReverseTransactionDTO{
+		ID:                    reversal.IDString(),
+		AccountID:             reversal.AccountIDString(),
+		ReceiverAccountID:     reversal.ReceiverAccountIDString(),
+		OperationType:         reversal.OperationType(),
+		ReversedTransactionID: *reversal.ReversedTransactionIDString(),
+		Amount:                reversal.TransferAmount().Decimal(),
+		Currency:              reversal.TransferAmount().Currency(),
+		ReceiverAmount:        reversal.ReceiverAmountDecimal(),
+		ReceiverCurrency:      reversal.ReceiverCurrency(),
+		ExchangeRate:          reversal.ExchangeRateString(),
+		Direction:             reversal.DirectionFor(accountID),
+		SignedAmount:          reversal.SignedAmountDecimalFor(accountID),
+		BalanceAfter:          reversal.BalanceAfterDecimalFor(accountID),
+		Reference:             reversal.Reference(),
+		TransactionAt:         reversal.TransactionAtString(),
+	}, nil
+}
@@ -0,0 +1,156 @@
+package transaction_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	transactionUC "github.com/u104rak1/pocgo/internal/application/transaction"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	domainMock "github.com/u104rak1/pocgo/internal/domain/mock"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	passwordUtil "github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestReverseTransactionUsecase(t *testing.T) {
+	type Mocks struct {
+		accountServ     *domainMock.MockIAccountService
+		transactionServ *domainMock.MockITransactionService
+	}
+
+	var (
+		userID        = idVO.NewUserIDForTest("user")
+		accountID     = idVO.NewAccountIDForTest("account")
+		transactionID = idVO.NewTransactionIDForTest("transaction")
+		reversalID    = idVO.NewTransactionIDForTest("reversal")
+		accountName   = "test"
+		password      = "1234"
+		amount        = int64(1000)
+		balanceAfter  = int64(2000)
+		currency      = moneyVO.JPY
+		time          = timer.GetFixedDate()
+		arg           = gomock.Any()
+	)
+	passwordHash, err := passwordUtil.Encode(password)
+	assert.NoError(t, err)
+
+	happyCmd := transactionUC.ReverseTransactionCommand{
+		UserID:        userID.String(),
+		AccountID:     accountID.String(),
+		TransactionID: transactionID.String(),
+		Password:      password,
+	}
+
+	original, err := transactionDomain.New(accountID, nil, transactionDomain.Deposit, amount, currency, nil, nil, nil, time)
+	assert.NoError(t, err)
+	reversal, err := transactionDomain.Reconstruct(
+		reversalID.String(), accountID.String(), nil, transactionDomain.Reversal, amount, currency,
+		nil, nil, nil, strutil.StrPointer(transactionID.String()), strutil.StrPointer(transactionDomain.Deposit),
+		&balanceAfter, nil, nil, nil, nil, time,
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName string
+		cmd      transactionUC.ReverseTransactionCommand
+		prepare  func(mocks Mocks, account *accountDomain.Account)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 入金の取消が成功する",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, account.ID(), &userID, &password).Return(account, nil)
+				mocks.transactionServ.EXPECT().GetByAccount(arg, accountID, transactionID).Return(original, nil)
+				mocks.transactionServ.EXPECT().Reverse(arg, account, original).Return(reversal, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 取引IDが不正な形式の場合はエラーが返る",
+			cmd: transactionUC.ReverseTransactionCommand{
+				UserID:        userID.String(),
+				AccountID:     accountID.String(),
+				TransactionID: "invalid",
+				Password:      password,
+			},
+			prepare: func(mocks Mocks, account *accountDomain.Account) {},
+			wantErr: idVO.ErrInvalidULID,
+		},
+		{
+			caseName: "Negative: パスワードが一致しない場合はエラーが返る",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			wantErr: accountDomain.ErrUnmatchedPassword,
+		},
+		{
+			caseName: "Negative: 取引が見つからない場合はエラーが返る",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+				mocks.transactionServ.EXPECT().GetByAccount(arg, arg, arg).Return(nil, transactionDomain.ErrNotFound)
+			},
+			wantErr: transactionDomain.ErrNotFound,
+		},
+		{
+			caseName: "Negative: 既に取り消された取引の場合はエラーが返る",
+			cmd:      happyCmd,
+			prepare: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountServ.EXPECT().GetAndAuthorize(arg, arg, arg, arg).Return(account, nil)
+				mocks.transactionServ.EXPECT().GetByAccount(arg, arg, arg).Return(original, nil)
+				mocks.transactionServ.EXPECT().Reverse(arg, arg, arg).Return(nil, transactionDomain.ErrAlreadyReversed)
+			},
+			wantErr: transactionDomain.ErrAlreadyReversed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountServ:     domainMock.NewMockIAccountService(ctrl),
+				transactionServ: domainMock.NewMockITransactionService(ctrl),
+			}
+			mockUnitOfWork := &appMock.MockIUnitOfWorkWithResult[transactionDomain.Transaction]{}
+
+			uc := transactionUC.NewReverseTransactionUsecase(mocks.accountServ, mocks.transactionServ, mockUnitOfWork)
+			acc, err := accountDomain.Reconstruct(
+				accountID.String(), userID.String(), accountName, passwordHash, currency, balanceAfter, 0, time, 1,
+			)
+			assert.NoError(t, err)
+			tt.prepare(mocks, acc)
+
+			dto, err := uc.Run(context.Background(), tt.cmd)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, dto)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &transactionUC.ReverseTransactionDTO{
+					ID:                    reversalID.String(),
+					AccountID:             accountID.String(),
+					OperationType:         transactionDomain.Reversal,
+					ReversedTransactionID: transactionID.String(),
+					Amount:                "1000",
+					Currency:              currency,
+					Direction:             transactionDomain.Debit,
+					SignedAmount:          "-1000",
+					BalanceAfter:          strutil.StrPointer("2000"),
+					TransactionAt:         timer.GetFixedDateString(),
+				}, dto)
+			}
+		})
+	}
+}
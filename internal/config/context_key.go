@@ -15,3 +15,11 @@ type ctxTransactionKey struct{}
 func CtxTransactionKey() interface{} {
 	return ctxTransactionKey{}
 }
+
+type ctxSessionIDKey struct{}
+
+func CtxSessionIDKey() interface{} {
+	return ctxSessionIDKey{}
+}
+
+var ErrSessionIDMissing = errors.New("session id is missing")
@@ -1,12 +1,37 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 )
 
+const (
+	// 開発環境を表す APP_ENV の値
+	AppEnvDevelopment = "development"
+	// JWT_SECRET_KEY の初期値。開発環境以外では使用できません。
+	DefaultJWTSecretKey = "jwt_secret_key"
+	// DB_DRIVER の値
+	DBDriverPostgres = "postgres"
+	DBDriverSQLite   = "sqlite"
+	// EVENT_PUBLISHER の値
+	EventPublisherLog  = "log"
+	EventPublisherFile = "file"
+	EventPublisherHTTP = "http"
+)
+
+var (
+	ErrDefaultJWTSecretKey          = errors.New("JWT_SECRET_KEY must not be the default value outside development, set a secret key or JWT_SIGNING_KEY_FILE")
+	ErrUnsupportedEventPublisher    = errors.New("EVENT_PUBLISHER must be one of log, file or http")
+	ErrEventPublisherTargetRequired = errors.New("EVENT_PUBLISHER_FILE or EVENT_PUBLISHER_URL must be set for the file or http publisher")
+)
+
 type Env struct {
+	// 実行環境 (development, staging, production など)。
+	// 未指定の場合は production として扱い、明示的に development を指定した場合のみ開発用の初期値を使用できます。
+	APP_ENV           string `env:"APP_ENV" envDefault:"production"`
 	APP_PORT          string `env:"APP_PORT" envDefault:"8080"`
 	USE_INMEMORY      bool   `env:"USE_INMEMORY" envDefault:"false"`
 	POSTGRES_HOST     string `env:"POSTGRES_HOST" envDefault:"postgres"`
@@ -16,6 +41,38 @@ type Env struct {
 	POSTGRES_PORT     string `env:"POSTGRES_PORT" envDefault:"5432"`
 	POSTGRES_SSLMODE  string `env:"POSTGRES_SSLMODE" envDefault:"disable"`
 	JWT_SECRET_KEY    string `env:"JWT_SECRET_KEY" envDefault:"jwt_secret_key"`
+	// アクセストークンの署名に使用する PEM 形式の秘密鍵 (RSA または Ed25519) のパス。
+	// 指定した場合は JWT_SECRET_KEY の代わりにこの鍵で署名します。
+	JWT_SIGNING_KEY_FILE string `env:"JWT_SIGNING_KEY_FILE" envDefault:""`
+	// 鍵のローテーション中に検証にのみ使用する PEM ファイルのパス。カンマ区切りで複数指定できます。
+	JWT_VERIFICATION_KEY_FILES []string `env:"JWT_VERIFICATION_KEY_FILES" envSeparator:","`
+	// 為替レートの JSON ファイルのパス。未指定の場合は固定レートを使用します。
+	EXCHANGE_RATE_FILE string `env:"EXCHANGE_RATE_FILE" envDefault:""`
+	// 取引金額の既定の上限の JSON ファイルのパス。未指定の場合は組み込みの上限を使用します。
+	TRANSACTION_LIMIT_FILE string `env:"TRANSACTION_LIMIT_FILE" envDefault:""`
+	// 実行日を迎えた自動振込を実行する間隔。0 を指定した場合は自動振込を実行しません。
+	STANDING_ORDER_INTERVAL time.Duration `env:"STANDING_ORDER_INTERVAL" envDefault:"1m"`
+	// 有効期限を過ぎた仮押さえを期限切れにする間隔。0 を指定した場合は期限切れにしません。
+	HOLD_EXPIRY_INTERVAL time.Duration `env:"HOLD_EXPIRY_INTERVAL" envDefault:"1m"`
+	// USE_INMEMORY の場合にデータを永続化するディレクトリ。未指定の場合は再起動でデータが失われます。
+	INMEMORY_DATA_DIR string `env:"INMEMORY_DATA_DIR" envDefault:""`
+	// 先行書き込みログを fsync する契機 (always: コミットごと, periodic: INMEMORY_FSYNC_INTERVAL ごと, never: OS に任せる)
+	INMEMORY_FSYNC          string        `env:"INMEMORY_FSYNC" envDefault:"always"`
+	INMEMORY_FSYNC_INTERVAL time.Duration `env:"INMEMORY_FSYNC_INTERVAL" envDefault:"1s"`
+	// スナップショットを作成し、先行書き込みログを空にする間隔。0 を指定した場合は停止時のみ作成します。
+	INMEMORY_SNAPSHOT_INTERVAL time.Duration `env:"INMEMORY_SNAPSHOT_INTERVAL" envDefault:"5m"`
+	// 接続するデータベース (postgres, sqlite)。USE_INMEMORY の場合は使用しません。
+	DB_DRIVER string `env:"DB_DRIVER" envDefault:"postgres"`
+	// DB_DRIVER が sqlite の場合のデータベースファイルのパス。:memory: を指定した場合は接続を閉じるとデータが失われます。
+	SQLITE_PATH string `env:"SQLITE_PATH" envDefault:":memory:"`
+	// アウトボックスに記録したイベントを発行する間隔。0 を指定した場合は発行しません。
+	EVENT_RELAY_INTERVAL time.Duration `env:"EVENT_RELAY_INTERVAL" envDefault:"5s"`
+	// イベントの発行先 (log: ログに出力, file: EVENT_PUBLISHER_FILE に追記, http: EVENT_PUBLISHER_URL に POST)
+	EVENT_PUBLISHER string `env:"EVENT_PUBLISHER" envDefault:"log"`
+	// EVENT_PUBLISHER が file の場合に、イベントを JSON Lines 形式で追記するファイルのパス。
+	EVENT_PUBLISHER_FILE string `env:"EVENT_PUBLISHER_FILE" envDefault:""`
+	// EVENT_PUBLISHER が http の場合に、イベントを POST する URL。
+	EVENT_PUBLISHER_URL string `env:"EVENT_PUBLISHER_URL" envDefault:""`
 }
 
 func NewEnv() *Env {
@@ -25,3 +82,30 @@ func NewEnv() *Env {
 	}
 	return &e
 }
+
+func (e *Env) IsDevelopment() bool {
+	return e.APP_ENV == AppEnvDevelopment
+}
+
+// 起動時に設定値を検証します。
+// 共通鍵で署名する場合、開発環境以外では JWT_SECRET_KEY の初期値を使用できません。
+// イベントをファイルや HTTP で発行する場合は、発行先を指定する必要があります。
+func (e *Env) Validate() error {
+	if e.JWT_SIGNING_KEY_FILE == "" && !e.IsDevelopment() && e.JWT_SECRET_KEY == DefaultJWTSecretKey {
+		return ErrDefaultJWTSecretKey
+	}
+	switch e.EVENT_PUBLISHER {
+	case EventPublisherLog:
+	case EventPublisherFile:
+		if e.EVENT_PUBLISHER_FILE == "" {
+			return ErrEventPublisherTargetRequired
+		}
+	case EventPublisherHTTP:
+		if e.EVENT_PUBLISHER_URL == "" {
+			return ErrEventPublisherTargetRequired
+		}
+	default:
+		return ErrUnsupportedEventPublisher
+	}
+	return nil
+}
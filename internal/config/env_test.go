@@ -0,0 +1,88 @@
+package config_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/u104rak1/pocgo/internal/config"
+)
+
+func TestEnvValidate(t *testing.T) {
+	tests := []struct {
+		caseName string
+		env      config.Env
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 開発環境では初期値の JWT_SECRET_KEY を使用できる",
+			env:      config.Env{APP_ENV: config.AppEnvDevelopment, JWT_SECRET_KEY: config.DefaultJWTSecretKey, EVENT_PUBLISHER: config.EventPublisherLog},
+			wantErr:  nil,
+		},
+		{
+			caseName: "Positive: 開発環境以外でも JWT_SECRET_KEY を変更していれば起動できる",
+			env:      config.Env{APP_ENV: "production", JWT_SECRET_KEY: "9f1c4e0b7d", EVENT_PUBLISHER: config.EventPublisherLog},
+			wantErr:  nil,
+		},
+		{
+			caseName: "Positive: 開発環境以外でも署名鍵を指定していれば起動できる",
+			env:      config.Env{APP_ENV: "production", JWT_SECRET_KEY: config.DefaultJWTSecretKey, JWT_SIGNING_KEY_FILE: "/etc/pocgo/signing.pem", EVENT_PUBLISHER: config.EventPublisherLog},
+			wantErr:  nil,
+		},
+		{
+			caseName: "Negative: 開発環境以外で初期値の JWT_SECRET_KEY を使用している",
+			env:      config.Env{APP_ENV: "production", JWT_SECRET_KEY: config.DefaultJWTSecretKey},
+			wantErr:  config.ErrDefaultJWTSecretKey,
+		},
+		{
+			caseName: "Positive: 発行先を指定していればイベントをファイルに発行できる",
+			env:      config.Env{APP_ENV: config.AppEnvDevelopment, EVENT_PUBLISHER: config.EventPublisherFile, EVENT_PUBLISHER_FILE: "/var/lib/pocgo/events.jsonl"},
+			wantErr:  nil,
+		},
+		{
+			caseName: "Positive: 発行先を指定していればイベントを HTTP で発行できる",
+			env:      config.Env{APP_ENV: config.AppEnvDevelopment, EVENT_PUBLISHER: config.EventPublisherHTTP, EVENT_PUBLISHER_URL: "https://events.example.com"},
+			wantErr:  nil,
+		},
+		{
+			caseName: "Negative: イベントをファイルに発行する場合にファイルを指定していない",
+			env:      config.Env{APP_ENV: config.AppEnvDevelopment, EVENT_PUBLISHER: config.EventPublisherFile},
+			wantErr:  config.ErrEventPublisherTargetRequired,
+		},
+		{
+			caseName: "Negative: イベントを HTTP で発行する場合に URL を指定していない",
+			env:      config.Env{APP_ENV: config.AppEnvDevelopment, EVENT_PUBLISHER: config.EventPublisherHTTP},
+			wantErr:  config.ErrEventPublisherTargetRequired,
+		},
+		{
+			caseName: "Negative: 対応していない発行先を指定している",
+			env:      config.Env{APP_ENV: config.AppEnvDevelopment, EVENT_PUBLISHER: "kafka"},
+			wantErr:  config.ErrUnsupportedEventPublisher,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.env.Validate()
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNewEnvValidateWithoutAppEnv(t *testing.T) {
+	// APP_ENV を未指定にし、テスト終了時に元の値へ戻す
+	t.Setenv("APP_ENV", "")
+	os.Unsetenv("APP_ENV")
+	t.Setenv("JWT_SECRET_KEY", config.DefaultJWTSecretKey)
+	t.Setenv("JWT_SIGNING_KEY_FILE", "")
+	t.Setenv("EVENT_PUBLISHER", config.EventPublisherLog)
+
+	env := config.NewEnv()
+
+	assert.False(t, env.IsDevelopment())
+	assert.ErrorIs(t, env.Validate(), config.ErrDefaultJWTSecretKey)
+}
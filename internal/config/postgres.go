@@ -2,6 +2,7 @@ package config
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 
@@ -11,8 +12,22 @@ import (
 	"github.com/uptrace/bun/extra/bundebug"
 )
 
+var ErrUnknownDBDriver = errors.New("unknown DB_DRIVER, use postgres or sqlite")
+
+// DB_DRIVER に応じて PostgreSQL または SQLite に接続します。
 func LoadDB() (*bun.DB, error) {
-	db, err := newDBConnection()
+	env := NewEnv()
+
+	var db *bun.DB
+	var err error
+	switch env.DB_DRIVER {
+	case DBDriverPostgres:
+		db, err = newDBConnection()
+	case DBDriverSQLite:
+		db, err = newSQLiteConnection(env.SQLITE_PATH)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownDBDriver, env.DB_DRIVER)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("could not connect to database: %w", err)
 	}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/golang-migrate/migrate/v4"
+	migrateSQLite "github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/u104rak1/pocgo/internal/infrastructure/sqlite/migrations"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"github.com/uptrace/bun/extra/bundebug"
+	_ "modernc.org/sqlite"
+)
+
+// SQLite のデータベースを開き、未適用のマイグレーションを適用します。
+// SQLite は同時に1つの接続しか書き込めない為、接続を1つに制限してトランザクションを直列化します。
+// 接続を使い回す為、:memory: を指定した場合も接続を閉じるまではデータを保持します。
+func OpenSQLite(path string) (*bun.DB, error) {
+	dsn := fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", path)
+	sqldb, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	sqldb.SetMaxOpenConns(1)
+	sqldb.SetMaxIdleConns(1)
+	sqldb.SetConnMaxLifetime(0)
+	sqldb.SetConnMaxIdleTime(0)
+
+	if err := migrateSQLiteUp(sqldb); err != nil {
+		_ = sqldb.Close()
+		return nil, fmt.Errorf("failed to migrate sqlite: %w", err)
+	}
+
+	db := bun.NewDB(sqldb, sqlitedialect.New())
+
+	db.AddQueryHook(bundebug.NewQueryHook(
+		bundebug.WithVerbose(true),
+	))
+
+	return db, nil
+}
+
+// マイグレーションのドライバーは Close で接続を閉じる為、migrate.Migrate は閉じずに破棄します。
+func migrateSQLiteUp(sqldb *sql.DB) error {
+	source, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return err
+	}
+	driver, err := migrateSQLite.WithInstance(sqldb, &migrateSQLite.Config{})
+	if err != nil {
+		return err
+	}
+	m, err := migrate.NewWithInstance("iofs", source, "sqlite", driver)
+	if err != nil {
+		return err
+	}
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+func newSQLiteConnection(path string) (*bun.DB, error) {
+	db, err := OpenSQLite(path)
+	if err != nil {
+		log.Fatalf("Failed to open sqlite database: %v", err)
+		return nil, err
+	}
+
+	log.Println("Successfully opened sqlite database")
+	return db, nil
+}
@@ -1,6 +1,7 @@
 package account
 
 import (
+	"errors"
 	"time"
 
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
@@ -15,11 +16,15 @@ type Account struct {
 	name         string
 	passwordHash string
 	balance      moneyVO.Money
-	updatedAt    time.Time
+	// 仮押さえ (オーソリ) 中の金額の合計です。残高のうち、この金額を除いた分を利用できます。
+	held      moneyVO.Money
+	updatedAt time.Time
+	// 楽観的排他制御の為のバージョンです。未保存の口座は 0 です。
+	version int64
 }
 
-// 口座エンティティを作成します。新規で作成するのでパスワードの検証とハッシュ化を行います。
-func New(userID idVO.UserID, amount float64, name, password, currency string) (*Account, error) {
+// 口座エンティティを作成します。新規で作成するのでパスワードの検証とハッシュ化を行います。金額は通貨の最小単位（JPYは円、USDはセント）で指定します。
+func New(userID idVO.UserID, amount int64, name, password, currency string) (*Account, error) {
 	id := idVO.NewAccountID()
 
 	if err := validPassword(password); err != nil {
@@ -32,11 +37,12 @@ func New(userID idVO.UserID, amount float64, name, password, currency string) (*
 
 	updatedAt := timer.Now()
 
-	return newAccount(id, name, passwordHash, currency, userID, amount, updatedAt)
+	return newAccount(id, name, passwordHash, currency, userID, amount, 0, updatedAt, 0)
 }
 
 // データベースから口座を再構築します。パスワードは既にエンコードされているため、検証は行われません。
-func Reconstruct(id, userID, name, passwordHash, currency string, amount float64, updatedAt time.Time) (*Account, error) {
+// heldAmount には仮押さえ中の金額の合計を通貨の最小単位で指定します。
+func Reconstruct(id, userID, name, passwordHash, currency string, amount, heldAmount int64, updatedAt time.Time, version int64) (*Account, error) {
 	aID, err := idVO.AccountIDFromString(id)
 	if err != nil {
 		return nil, err
@@ -45,10 +51,10 @@ func Reconstruct(id, userID, name, passwordHash, currency string, amount float64
 	if err != nil {
 		return nil, err
 	}
-	return newAccount(aID, name, passwordHash, currency, uID, amount, updatedAt)
+	return newAccount(aID, name, passwordHash, currency, uID, amount, heldAmount, updatedAt, version)
 }
 
-func newAccount(id idVO.AccountID, name, passwordHash, currency string, userID idVO.UserID, amount float64, updatedAt time.Time) (*Account, error) {
+func newAccount(id idVO.AccountID, name, passwordHash, currency string, userID idVO.UserID, amount, heldAmount int64, updatedAt time.Time, version int64) (*Account, error) {
 	if err := validName(name); err != nil {
 		return nil, err
 	}
@@ -57,6 +63,13 @@ func newAccount(id idVO.AccountID, name, passwordHash, currency string, userID i
 	if err != nil {
 		return nil, err
 	}
+	held, err := moneyVO.New(heldAmount, currency)
+	if err != nil {
+		return nil, err
+	}
+	if err := validHeld(*balance, *held); err != nil {
+		return nil, err
+	}
 
 	return &Account{
 		id:           id,
@@ -64,7 +77,9 @@ func newAccount(id idVO.AccountID, name, passwordHash, currency string, userID i
 		name:         name,
 		passwordHash: passwordHash,
 		balance:      *balance,
+		held:         *held,
 		updatedAt:    updatedAt,
+		version:      version,
 	}, nil
 }
 
@@ -92,10 +107,23 @@ func (a *Account) PasswordHash() string {
 	return a.passwordHash
 }
 
+// 仮押さえ中の金額を含む残高です。
 func (a *Account) Balance() moneyVO.Money {
 	return a.balance
 }
 
+// 仮押さえ中の金額の合計です。
+func (a *Account) HeldBalance() moneyVO.Money {
+	return a.held
+}
+
+// 残高から仮押さえ中の金額を除いた、引き出しや振込に利用できる金額です。
+func (a *Account) AvailableBalance() moneyVO.Money {
+	// 仮押さえ中の金額は常に残高以下に保たれている為、エラーにはならない
+	available, _ := a.balance.Sub(a.held)
+	return *available
+}
+
 func (a *Account) UpdatedAt() time.Time {
 	return a.updatedAt
 }
@@ -104,6 +132,15 @@ func (a *Account) UpdatedAtString() string {
 	return timer.FormatToISO8601(a.updatedAt)
 }
 
+func (a *Account) Version() int64 {
+	return a.version
+}
+
+// 保存に成功した後、リポジトリから呼び出されます。同じエンティティを続けて保存できるようにバージョンを進めます。
+func (a *Account) IncrementVersion() {
+	a.version++
+}
+
 func (a *Account) ChangeName(new string) error {
 	if err := validName(new); err != nil {
 		return err
@@ -131,12 +168,16 @@ func (a *Account) ComparePassword(password string) error {
 	return nil
 }
 
-func (a *Account) Withdrawal(amount float64, currency string) error {
+// 利用できる金額から引き出します。仮押さえ中の金額は引き出せません。
+func (a *Account) Withdrawal(amount int64, currency string) error {
 	money, err := moneyVO.New(amount, currency)
 	if err != nil {
 		return err
 	}
 
+	if _, err := a.AvailableBalance().Sub(*money); err != nil {
+		return err
+	}
 	newBalance, err := a.balance.Sub(*money)
 	if err != nil {
 		return err
@@ -146,7 +187,7 @@ func (a *Account) Withdrawal(amount float64, currency string) error {
 	return nil
 }
 
-func (a *Account) Deposit(amount float64, currency string) error {
+func (a *Account) Deposit(amount int64, currency string) error {
 	depositMoney, err := moneyVO.New(amount, currency)
 	if err != nil {
 		return err
@@ -161,6 +202,52 @@ func (a *Account) Deposit(amount float64, currency string) error {
 	return nil
 }
 
+// 利用できる金額から指定した金額を仮押さえします。仮押さえした金額は残高に含まれたまま、引き出しや振込には利用できなくなります。
+func (a *Account) PlaceHold(amount int64, currency string) error {
+	money, err := moneyVO.New(amount, currency)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.AvailableBalance().Sub(*money); err != nil {
+		return err
+	}
+	newHeld, err := a.held.Add(*money)
+	if err != nil {
+		return err
+	}
+
+	a.held = *newHeld
+	return nil
+}
+
+// 仮押さえを解除し、指定した金額を再び利用できるようにします。
+func (a *Account) ReleaseHold(amount int64, currency string) error {
+	money, err := moneyVO.New(amount, currency)
+	if err != nil {
+		return err
+	}
+
+	newHeld, err := a.held.Sub(*money)
+	if err != nil {
+		if errors.Is(err, moneyVO.ErrInsufficientBalance) {
+			return ErrReleaseExceedsHeld
+		}
+		return err
+	}
+
+	a.held = *newHeld
+	return nil
+}
+
+// 口座を解約できるかをチェックします。残高が残っている口座は解約できません。
+func (a *Account) CheckClosable() error {
+	if a.balance.Amount() != 0 {
+		return ErrNonZeroBalance
+	}
+	return nil
+}
+
 func (a *Account) ChangeUpdatedAt(now time.Time) {
 	a.updatedAt = now
 }
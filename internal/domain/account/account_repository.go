@@ -9,5 +9,8 @@ import (
 type IAccountRepository interface {
 	Save(ctx context.Context, account *Account) error
 	FindByID(ctx context.Context, id idVO.AccountID) (*Account, error)
+	ListByUserID(ctx context.Context, userID idVO.UserID) ([]*Account, error)
 	CountByUserID(ctx context.Context, userID idVO.UserID) (int, error)
+	// 口座を論理削除します。読み込んだ時点から口座が更新されている場合は ErrConcurrentModification を返します。
+	Delete(ctx context.Context, account *Account) error
 }
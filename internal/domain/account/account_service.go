@@ -3,6 +3,8 @@ package account
 import (
 	"context"
 
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
@@ -11,16 +13,28 @@ type IAccountService interface {
 	CheckLimit(ctx context.Context, userID idVO.UserID) error
 
 	// ユーザーの口座を取得する。ユーザーIDとパスワードの確認はオプションであり、必要ない場合はnilを渡す。
+	// パスワードの照合に連続して失敗した口座は一定期間ロックされ、lockout.ErrLocked を返す。
 	GetAndAuthorize(ctx context.Context, accountID idVO.AccountID, userID *idVO.UserID, password *string) (*Account, error)
+
+	// 開設した口座を保存し、AccountOpened イベントを記録します。
+	Open(ctx context.Context, account *Account) error
 }
 
 type accountService struct {
 	accountRepo IAccountRepository
+	lockoutServ lockoutDomain.ILockoutService
+	eventRepo   eventDomain.IEventRepository
 }
 
-func NewService(accountRepository IAccountRepository) IAccountService {
+func NewService(
+	accountRepository IAccountRepository,
+	lockoutService lockoutDomain.ILockoutService,
+	eventRepository eventDomain.IEventRepository,
+) IAccountService {
 	return &accountService{
 		accountRepo: accountRepository,
+		lockoutServ: lockoutService,
+		eventRepo:   eventRepository,
 	}
 }
 
@@ -48,10 +62,41 @@ func (s *accountService) GetAndAuthorize(ctx context.Context, accountID idVO.Acc
 		return nil, ErrUnauthorized
 	}
 	if password != nil {
-		if err := account.ComparePassword(*password); err != nil {
+		if err := s.comparePassword(ctx, account, *password); err != nil {
 			return nil, err
 		}
 	}
 
 	return account, nil
 }
+
+func (s *accountService) Open(ctx context.Context, account *Account) error {
+	if err := s.accountRepo.Save(ctx, account); err != nil {
+		return err
+	}
+
+	event, err := eventDomain.New(eventDomain.AccountOpened{
+		AccountID: account.IDString(),
+		UserID:    account.UserIDString(),
+		Name:      account.Name(),
+		Currency:  account.Balance().Currency(),
+	}, account.UpdatedAt())
+	if err != nil {
+		return err
+	}
+	return s.eventRepo.Save(ctx, event)
+}
+
+func (s *accountService) comparePassword(ctx context.Context, account *Account, password string) error {
+	accountID := account.IDString()
+	if err := s.lockoutServ.Check(ctx, lockoutDomain.SubjectAccount, accountID); err != nil {
+		return err
+	}
+	if err := account.ComparePassword(password); err != nil {
+		if recordErr := s.lockoutServ.RecordFailure(ctx, lockoutDomain.SubjectAccount, accountID); recordErr != nil {
+			return recordErr
+		}
+		return err
+	}
+	return s.lockoutServ.RecordSuccess(ctx, lockoutDomain.SubjectAccount, accountID)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
 	"github.com/u104rak1/pocgo/internal/domain/mock"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
@@ -58,7 +60,7 @@ func TestCheckLimit(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockAccountRepo := mock.NewMockIAccountRepository(ctrl)
-			service := accountDomain.NewService(mockAccountRepo)
+			service := accountDomain.NewService(mockAccountRepo, mock.NewMockILockoutService(ctrl), mock.NewMockIEventRepository(ctrl))
 			ctx := context.Background()
 			tt.setup(mockAccountRepo)
 
@@ -79,7 +81,7 @@ func TestGetAndAuthorize(t *testing.T) {
 		userID    = idVO.NewUserIDForTest("user")
 		name      = "account-name"
 		password  = "1234"
-		amount    = 100.0
+		amount    = int64(100)
 		currency  = moneyVO.JPY
 		arg       = gomock.Any()
 	)
@@ -89,7 +91,7 @@ func TestGetAndAuthorize(t *testing.T) {
 		accountID idVO.AccountID
 		userID    *idVO.UserID
 		password  *string
-		setup     func(mockAccountRepo *mock.MockIAccountRepository, account *accountDomain.Account)
+		setup     func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account)
 		errMsg    string
 	}{
 		{
@@ -97,8 +99,10 @@ func TestGetAndAuthorize(t *testing.T) {
 			accountID: accountID,
 			userID:    &userID,
 			password:  &password,
-			setup: func(mockAccountRepo *mock.MockIAccountRepository, account *accountDomain.Account) {
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account) {
 				mockAccountRepo.EXPECT().FindByID(arg, arg).Return(account, nil)
+				mockLockoutServ.EXPECT().Check(arg, lockoutDomain.SubjectAccount, account.IDString()).Return(nil)
+				mockLockoutServ.EXPECT().RecordSuccess(arg, lockoutDomain.SubjectAccount, account.IDString()).Return(nil)
 			},
 			errMsg: "",
 		},
@@ -107,8 +111,10 @@ func TestGetAndAuthorize(t *testing.T) {
 			accountID: accountID,
 			userID:    nil,
 			password:  &password,
-			setup: func(mockAccountRepo *mock.MockIAccountRepository, account *accountDomain.Account) {
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account) {
 				mockAccountRepo.EXPECT().FindByID(arg, arg).Return(account, nil)
+				mockLockoutServ.EXPECT().Check(arg, lockoutDomain.SubjectAccount, account.IDString()).Return(nil)
+				mockLockoutServ.EXPECT().RecordSuccess(arg, lockoutDomain.SubjectAccount, account.IDString()).Return(nil)
 			},
 			errMsg: "",
 		},
@@ -117,7 +123,7 @@ func TestGetAndAuthorize(t *testing.T) {
 			accountID: accountID,
 			userID:    &userID,
 			password:  nil,
-			setup: func(mockAccountRepo *mock.MockIAccountRepository, account *accountDomain.Account) {
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account) {
 				mockAccountRepo.EXPECT().FindByID(arg, arg).Return(account, nil)
 			},
 			errMsg: "",
@@ -127,7 +133,7 @@ func TestGetAndAuthorize(t *testing.T) {
 			accountID: accountID,
 			userID:    &userID,
 			password:  nil,
-			setup: func(mockAccountRepo *mock.MockIAccountRepository, account *accountDomain.Account) {
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account) {
 				mockAccountRepo.EXPECT().FindByID(arg, arg).Return(nil, assert.AnError)
 			},
 			errMsg: assert.AnError.Error(),
@@ -137,7 +143,7 @@ func TestGetAndAuthorize(t *testing.T) {
 			accountID: accountID,
 			userID:    nil,
 			password:  nil,
-			setup: func(mockAccountRepo *mock.MockIAccountRepository, account *accountDomain.Account) {
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account) {
 				mockAccountRepo.EXPECT().FindByID(arg, arg).Return(nil, nil)
 			},
 			errMsg: "account not found",
@@ -150,7 +156,7 @@ func TestGetAndAuthorize(t *testing.T) {
 				return &id
 			}(),
 			password: nil,
-			setup: func(mockAccountRepo *mock.MockIAccountRepository, account *accountDomain.Account) {
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account) {
 				mockAccountRepo.EXPECT().FindByID(arg, arg).Return(account, nil)
 			},
 			errMsg: "unauthorized access to account",
@@ -160,11 +166,48 @@ func TestGetAndAuthorize(t *testing.T) {
 			accountID: accountID,
 			userID:    nil,
 			password:  strutil.StrPointer("5678"),
-			setup: func(mockAccountRepo *mock.MockIAccountRepository, account *accountDomain.Account) {
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account) {
 				mockAccountRepo.EXPECT().FindByID(arg, arg).Return(account, nil)
+				mockLockoutServ.EXPECT().Check(arg, arg, arg).Return(nil)
+				mockLockoutServ.EXPECT().RecordFailure(arg, lockoutDomain.SubjectAccount, account.IDString()).Return(nil)
 			},
 			errMsg: "passwords do not match",
 		},
+		{
+			caseName:  "Negative: 口座がロックされている場合はパスワードを照合せずにエラーが返る",
+			accountID: accountID,
+			userID:    &userID,
+			password:  &password,
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account) {
+				mockAccountRepo.EXPECT().FindByID(arg, arg).Return(account, nil)
+				mockLockoutServ.EXPECT().Check(arg, arg, arg).Return(lockoutDomain.ErrLocked)
+			},
+			errMsg: lockoutDomain.ErrLocked.Error(),
+		},
+		{
+			caseName:  "Negative: 失敗の記録に失敗した場合はエラーが返る",
+			accountID: accountID,
+			userID:    &userID,
+			password:  strutil.StrPointer("5678"),
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account) {
+				mockAccountRepo.EXPECT().FindByID(arg, arg).Return(account, nil)
+				mockLockoutServ.EXPECT().Check(arg, arg, arg).Return(nil)
+				mockLockoutServ.EXPECT().RecordFailure(arg, arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName:  "Negative: 成功の記録に失敗した場合はエラーが返る",
+			accountID: accountID,
+			userID:    &userID,
+			password:  &password,
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockLockoutServ *mock.MockILockoutService, account *accountDomain.Account) {
+				mockAccountRepo.EXPECT().FindByID(arg, arg).Return(account, nil)
+				mockLockoutServ.EXPECT().Check(arg, arg, arg).Return(nil)
+				mockLockoutServ.EXPECT().RecordSuccess(arg, arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -174,11 +217,12 @@ func TestGetAndAuthorize(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockAccountRepo := mock.NewMockIAccountRepository(ctrl)
-			service := accountDomain.NewService(mockAccountRepo)
+			mockLockoutServ := mock.NewMockILockoutService(ctrl)
+			service := accountDomain.NewService(mockAccountRepo, mockLockoutServ, mock.NewMockIEventRepository(ctrl))
 			ctx := context.Background()
 			account, err := accountDomain.New(userID, amount, name, password, currency)
 			assert.NoError(t, err)
-			tt.setup(mockAccountRepo, account)
+			tt.setup(mockAccountRepo, mockLockoutServ, account)
 
 			a, err := service.GetAndAuthorize(ctx, tt.accountID, tt.userID, tt.password)
 			if tt.errMsg != "" {
@@ -191,3 +235,68 @@ func TestGetAndAuthorize(t *testing.T) {
 		})
 	}
 }
+
+func TestOpen(t *testing.T) {
+	var arg = gomock.Any()
+
+	account, err := accountDomain.New(idVO.NewUserIDForTest("user"), 0, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName string
+		setup    func(mockAccountRepo *mock.MockIAccountRepository, mockEventRepo *mock.MockIEventRepository)
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 口座を保存し、AccountOpened イベントを記録する",
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockEventRepo *mock.MockIEventRepository) {
+				mockAccountRepo.EXPECT().Save(arg, account).Return(nil)
+				mockEventRepo.EXPECT().Save(arg, arg).DoAndReturn(func(_ context.Context, event *eventDomain.Event) error {
+					assert.Equal(t, eventDomain.TypeAccountOpened, event.Type())
+					assert.Equal(t, eventDomain.AggregateAccount, event.AggregateType())
+					assert.Equal(t, account.IDString(), event.AggregateID())
+					assert.Equal(t, account.UpdatedAt(), event.OccurredAt())
+					return nil
+				})
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: 口座の保存に失敗した場合はイベントを記録しない",
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockEventRepo *mock.MockIEventRepository) {
+				mockAccountRepo.EXPECT().Save(arg, account).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: イベントの記録に失敗した場合はエラーが返る",
+			setup: func(mockAccountRepo *mock.MockIAccountRepository, mockEventRepo *mock.MockIEventRepository) {
+				mockAccountRepo.EXPECT().Save(arg, account).Return(nil)
+				mockEventRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAccountRepo := mock.NewMockIAccountRepository(ctrl)
+			mockEventRepo := mock.NewMockIEventRepository(ctrl)
+			service := accountDomain.NewService(mockAccountRepo, mock.NewMockILockoutService(ctrl), mockEventRepo)
+			ctx := context.Background()
+			tt.setup(mockAccountRepo, mockEventRepo)
+
+			err := service.Open(ctx, account)
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package account
 import (
 	"errors"
 	"fmt"
+
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
 )
 
 const (
@@ -13,13 +15,17 @@ const (
 )
 
 var (
-	ErrInvalidName           = fmt.Errorf("account name must be between %d and %d characters", NameMinLength, NameMaxLength)
-	ErrPasswordInvalidLength = fmt.Errorf("account password must be %d characters", PasswordLength)
-	ErrNotFound              = errors.New("account not found")
-	ErrReceiverNotFound      = errors.New("receiver account not found")
-	ErrUnmatchedPassword     = errors.New("passwords do not match")
-	ErrLimitReached          = fmt.Errorf("account limit reached, maximum %d accounts", MaxAccountLimit)
-	ErrUnauthorized          = errors.New("unauthorized access to account")
+	ErrInvalidName            = fmt.Errorf("account name must be between %d and %d characters", NameMinLength, NameMaxLength)
+	ErrPasswordInvalidLength  = fmt.Errorf("account password must be %d characters", PasswordLength)
+	ErrNotFound               = errors.New("account not found")
+	ErrReceiverNotFound       = errors.New("receiver account not found")
+	ErrUnmatchedPassword      = errors.New("passwords do not match")
+	ErrLimitReached           = fmt.Errorf("account limit reached, maximum %d accounts", MaxAccountLimit)
+	ErrUnauthorized           = errors.New("unauthorized access to account")
+	ErrConcurrentModification = errors.New("account has been modified by another request, please retry")
+	ErrNonZeroBalance         = errors.New("account with a non-zero balance cannot be closed")
+	ErrHeldExceedsBalance     = errors.New("held amount must not exceed the balance")
+	ErrReleaseExceedsHeld     = errors.New("released amount exceeds the held amount")
 )
 
 func validName(name string) error {
@@ -35,3 +41,10 @@ func validPassword(password string) error {
 	}
 	return nil
 }
+
+func validHeld(balance, held moneyVO.Money) error {
+	if held.Amount() > balance.Amount() {
+		return ErrHeldExceedsBalance
+	}
+	return nil
+}
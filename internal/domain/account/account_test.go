@@ -18,7 +18,7 @@ func TestNew(t *testing.T) {
 		userID   = idVO.NewUserIDForTest("user")
 		name     = "For work"
 		password = "1234"
-		amount   = 1000.0
+		amount   = int64(1000)
 		currency = moneyVO.JPY
 		now      = timer.GetFixedDate()
 	)
@@ -28,7 +28,7 @@ func TestNew(t *testing.T) {
 		userID    idVO.UserID
 		name      string
 		password  string
-		amount    float64
+		amount    int64
 		currency  string
 		updatedAt time.Time
 		errMsg    string
@@ -156,13 +156,13 @@ func TestReconstruct(t *testing.T) {
 		userID    = idVO.NewUserIDForTest("user").String()
 		name      = "For work"
 		password  = "1234"
-		amount    = 1000.0
+		amount    = int64(1000)
 		currency  = moneyVO.JPY
 		now       = timer.GetFixedDate()
 	)
 	t.Run("Positive: 口座を再構築できる", func(t *testing.T) {
 		encodedPassword, _ := passwordUtil.Encode(password)
-		acc, err := accountDomain.Reconstruct(accountID, userID, name, encodedPassword, currency, amount, now)
+		acc, err := accountDomain.Reconstruct(accountID, userID, name, encodedPassword, currency, amount, 300, now, 3)
 
 		assert.NoError(t, err)
 		assert.Equal(t, accountID, acc.IDString())
@@ -171,8 +171,30 @@ func TestReconstruct(t *testing.T) {
 		assert.Equal(t, encodedPassword, acc.PasswordHash())
 		assert.Equal(t, amount, acc.Balance().Amount())
 		assert.Equal(t, currency, acc.Balance().Currency())
+		assert.Equal(t, int64(300), acc.HeldBalance().Amount())
+		assert.Equal(t, int64(700), acc.AvailableBalance().Amount())
 		assert.Equal(t, now, acc.UpdatedAt())
 		assert.Equal(t, timer.GetFixedDateString(), acc.UpdatedAtString())
+		assert.Equal(t, int64(3), acc.Version())
+	})
+
+	t.Run("Negative: 仮押さえ中の金額が残高を超える場合はエラーが返る", func(t *testing.T) {
+		encodedPassword, _ := passwordUtil.Encode(password)
+		acc, err := accountDomain.Reconstruct(accountID, userID, name, encodedPassword, currency, amount, amount+1, now, 3)
+
+		assert.Equal(t, accountDomain.ErrHeldExceedsBalance, err)
+		assert.Nil(t, acc)
+	})
+}
+
+func TestIncrementVersion(t *testing.T) {
+	t.Run("Positive: 新規作成した口座のバージョンは0で、保存ごとに1ずつ進む", func(t *testing.T) {
+		acc, err := accountDomain.New(idVO.NewUserIDForTest("user"), 1000, "For work", "1234", moneyVO.JPY)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), acc.Version())
+
+		acc.IncrementVersion()
+		assert.Equal(t, int64(1), acc.Version())
 	})
 }
 
@@ -181,7 +203,7 @@ func TestChangeName(t *testing.T) {
 		userID   = idVO.NewUserIDForTest("user")
 		name     = "For work"
 		password = "1234"
-		amount   = 1000.0
+		amount   = int64(1000)
 		currency = moneyVO.JPY
 	)
 
@@ -225,7 +247,7 @@ func TestChangePassword(t *testing.T) {
 		userID   = idVO.NewUserIDForTest("user")
 		name     = "For work"
 		password = "1234"
-		amount   = 1000.0
+		amount   = int64(1000)
 		currency = moneyVO.JPY
 	)
 
@@ -270,7 +292,7 @@ func TestComparePassword(t *testing.T) {
 		userID   = idVO.NewUserIDForTest("user")
 		name     = "For work"
 		password = "1234"
-		amount   = 1000.0
+		amount   = int64(1000)
 		currency = moneyVO.JPY
 	)
 
@@ -312,13 +334,14 @@ func TestWithdrawal(t *testing.T) {
 		userID   = idVO.NewUserIDForTest("user")
 		name     = "For work"
 		password = "1234"
-		amount   = 1000.0
+		amount   = int64(1000)
 		currency = moneyVO.JPY
 	)
 
 	tests := []struct {
 		caseName string
-		amount   float64
+		held     int64
+		amount   int64
 		currency string
 		errMsg   string
 	}{
@@ -328,10 +351,24 @@ func TestWithdrawal(t *testing.T) {
 			currency: moneyVO.JPY,
 			errMsg:   "",
 		},
+		{
+			caseName: "Positive: 仮押さえ中の金額を除いた利用できる金額まで引き出しができる",
+			held:     700,
+			amount:   300,
+			currency: moneyVO.JPY,
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 残高が十分でも、利用できる金額を超える場合はエラーが返る",
+			held:     701,
+			amount:   300,
+			currency: moneyVO.JPY,
+			errMsg:   moneyVO.ErrInsufficientBalance.Error(),
+		},
 		{
 			caseName: "Negative: money値オブジェクトの作成に失敗した場合、エラーが返る",
 			amount:   300,
-			currency: "EUR",
+			currency: "XXX",
 			errMsg:   moneyVO.ErrUnsupportedCurrency.Error(),
 		},
 		{
@@ -345,14 +382,19 @@ func TestWithdrawal(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.caseName, func(t *testing.T) {
 			acc, _ := accountDomain.New(userID, amount, name, password, currency)
+			if tt.held > 0 {
+				assert.NoError(t, acc.PlaceHold(tt.held, currency))
+			}
 			err := acc.Withdrawal(tt.amount, tt.currency)
 
 			if tt.errMsg != "" {
 				assert.Error(t, err)
 				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Equal(t, amount, acc.Balance().Amount())
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, amount-tt.amount, acc.Balance().Amount())
+				assert.Equal(t, tt.held, acc.HeldBalance().Amount())
 			}
 		})
 	}
@@ -363,13 +405,13 @@ func TestDeposit(t *testing.T) {
 		userID   = idVO.NewUserIDForTest("user")
 		name     = "For work"
 		password = "1234"
-		amount   = 1000.0
+		amount   = int64(1000)
 		currency = moneyVO.JPY
 	)
 
 	tests := []struct {
 		caseName string
-		amount   float64
+		amount   int64
 		currency string
 		errMsg   string
 	}{
@@ -382,7 +424,7 @@ func TestDeposit(t *testing.T) {
 		{
 			caseName: "Negative: money値オブジェクトの作成に失敗した場合、エラーが返る",
 			amount:   300,
-			currency: "EUR",
+			currency: "XXX",
 			errMsg:   moneyVO.ErrUnsupportedCurrency.Error(),
 		},
 		{
@@ -409,12 +451,161 @@ func TestDeposit(t *testing.T) {
 	}
 }
 
+func TestPlaceHold(t *testing.T) {
+	var (
+		userID   = idVO.NewUserIDForTest("user")
+		name     = "For work"
+		password = "1234"
+		amount   = int64(1000)
+		currency = moneyVO.JPY
+	)
+
+	tests := []struct {
+		caseName string
+		held     int64
+		amount   int64
+		currency string
+		wantHeld int64
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 利用できる金額の範囲で仮押さえができる",
+			held:     400,
+			amount:   600,
+			currency: moneyVO.JPY,
+			wantHeld: 1000,
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 利用できる金額を超える場合はエラーが返る",
+			held:     400,
+			amount:   601,
+			currency: moneyVO.JPY,
+			wantHeld: 400,
+			errMsg:   moneyVO.ErrInsufficientBalance.Error(),
+		},
+		{
+			caseName: "Negative: 通貨が一致しない場合はエラーが返る",
+			amount:   100,
+			currency: moneyVO.USD,
+			wantHeld: 0,
+			errMsg:   moneyVO.ErrDifferentCurrencyOperation.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			acc, _ := accountDomain.New(userID, amount, name, password, currency)
+			if tt.held > 0 {
+				assert.NoError(t, acc.PlaceHold(tt.held, currency))
+			}
+			err := acc.PlaceHold(tt.amount, tt.currency)
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, amount, acc.Balance().Amount())
+			assert.Equal(t, tt.wantHeld, acc.HeldBalance().Amount())
+			assert.Equal(t, amount-tt.wantHeld, acc.AvailableBalance().Amount())
+		})
+	}
+}
+
+func TestReleaseHold(t *testing.T) {
+	var (
+		userID   = idVO.NewUserIDForTest("user")
+		name     = "For work"
+		password = "1234"
+		amount   = int64(1000)
+		currency = moneyVO.JPY
+	)
+
+	tests := []struct {
+		caseName string
+		amount   int64
+		wantHeld int64
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 仮押さえ中の金額の範囲で解除できる",
+			amount:   300,
+			wantHeld: 200,
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 仮押さえ中の金額を超える場合はエラーが返る",
+			amount:   501,
+			wantHeld: 500,
+			errMsg:   accountDomain.ErrReleaseExceedsHeld.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			acc, _ := accountDomain.New(userID, amount, name, password, currency)
+			assert.NoError(t, acc.PlaceHold(500, currency))
+			err := acc.ReleaseHold(tt.amount, currency)
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantHeld, acc.HeldBalance().Amount())
+		})
+	}
+}
+
+func TestCheckClosable(t *testing.T) {
+	var (
+		userID   = idVO.NewUserIDForTest("user")
+		name     = "For work"
+		password = "1234"
+		currency = moneyVO.JPY
+	)
+
+	tests := []struct {
+		caseName string
+		amount   int64
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 残高が0の場合は解約できる",
+			amount:   0,
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 残高が残っている場合はエラーが返る",
+			amount:   1,
+			errMsg:   accountDomain.ErrNonZeroBalance.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			acc, _ := accountDomain.New(userID, tt.amount, name, password, currency)
+			err := acc.CheckClosable()
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestChangeUpdatedAt(t *testing.T) {
 	var (
 		userID   = idVO.NewUserIDForTest("user")
 		name     = "For work"
 		password = "1234"
-		amount   = 1000.0
+		amount   = int64(1000)
 		currency = moneyVO.JPY
 	)
 
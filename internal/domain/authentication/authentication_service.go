@@ -3,24 +3,32 @@ package authentication
 import (
 	"context"
 
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
 	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
 type IAuthenticationService interface {
 	VerifyUniqueness(ctx context.Context, userID idVO.UserID) error
+	// メールアドレスとパスワードを照合します。連続して失敗したユーザーは一定期間ロックされ、lockout.ErrLocked を返します。
 	Authenticate(ctx context.Context, email, password string) (*idVO.UserID, error)
 }
 
 type authenticationService struct {
-	authRepo IAuthenticationRepository
-	userRepo userDomain.IUserRepository
+	authRepo    IAuthenticationRepository
+	userRepo    userDomain.IUserRepository
+	lockoutServ lockoutDomain.ILockoutService
 }
 
-func NewService(authenticationRepository IAuthenticationRepository, userRepository userDomain.IUserRepository) IAuthenticationService {
+func NewService(
+	authenticationRepository IAuthenticationRepository,
+	userRepository userDomain.IUserRepository,
+	lockoutService lockoutDomain.ILockoutService,
+) IAuthenticationService {
 	return &authenticationService{
-		authRepo: authenticationRepository,
-		userRepo: userRepository,
+		authRepo:    authenticationRepository,
+		userRepo:    userRepository,
+		lockoutServ: lockoutService,
 	}
 }
 
@@ -44,7 +52,12 @@ func (s *authenticationService) Authenticate(ctx context.Context, email, passwor
 		return nil, ErrAuthenticationFailed
 	}
 
-	auth, err := s.authRepo.FindByUserID(ctx, user.ID())
+	userID := user.ID()
+	if err := s.lockoutServ.Check(ctx, lockoutDomain.SubjectUser, userID.String()); err != nil {
+		return nil, err
+	}
+
+	auth, err := s.authRepo.FindByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -53,9 +66,14 @@ func (s *authenticationService) Authenticate(ctx context.Context, email, passwor
 	}
 
 	if err := auth.ComparePassword(password); err != nil {
+		if err := s.lockoutServ.RecordFailure(ctx, lockoutDomain.SubjectUser, userID.String()); err != nil {
+			return nil, err
+		}
 		return nil, ErrAuthenticationFailed
 	}
+	if err := s.lockoutServ.RecordSuccess(ctx, lockoutDomain.SubjectUser, userID.String()); err != nil {
+		return nil, err
+	}
 
-	userID := user.ID()
 	return &userID, nil
 }
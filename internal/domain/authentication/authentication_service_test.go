@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	authDomain "github.com/u104rak1/pocgo/internal/domain/authentication"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
 	"github.com/u104rak1/pocgo/internal/domain/mock"
 	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
@@ -55,7 +56,7 @@ func TestVerifyUniqueness(t *testing.T) {
 
 			mockAuthRepo := mock.NewMockIAuthenticationRepository(ctrl)
 			mockUserRepo := mock.NewMockIUserRepository(ctrl)
-			service := authDomain.NewService(mockAuthRepo, mockUserRepo)
+			service := authDomain.NewService(mockAuthRepo, mockUserRepo, mock.NewMockILockoutService(ctrl))
 			ctx := context.Background()
 			tt.setup(mockAuthRepo)
 
@@ -73,8 +74,9 @@ func TestVerifyUniqueness(t *testing.T) {
 
 func TestAuthenticate(t *testing.T) {
 	type Mocks struct {
-		authRepo *mock.MockIAuthenticationRepository
-		userRepo *mock.MockIUserRepository
+		authRepo    *mock.MockIAuthenticationRepository
+		userRepo    *mock.MockIUserRepository
+		lockoutServ *mock.MockILockoutService
 	}
 
 	var (
@@ -99,7 +101,9 @@ func TestAuthenticate(t *testing.T) {
 			password: password,
 			setup: func(mocks Mocks, user *userDomain.User, auth *authDomain.Authentication) {
 				mocks.userRepo.EXPECT().FindByEmail(arg, arg).Return(user, nil)
+				mocks.lockoutServ.EXPECT().Check(arg, lockoutDomain.SubjectUser, userID.String()).Return(nil)
 				mocks.authRepo.EXPECT().FindByUserID(arg, arg).Return(auth, nil)
+				mocks.lockoutServ.EXPECT().RecordSuccess(arg, lockoutDomain.SubjectUser, userID.String()).Return(nil)
 			},
 			wantUserID: userID,
 			errMsg:     "",
@@ -128,6 +132,7 @@ func TestAuthenticate(t *testing.T) {
 			password: password,
 			setup: func(mocks Mocks, user *userDomain.User, auth *authDomain.Authentication) {
 				mocks.userRepo.EXPECT().FindByEmail(arg, arg).Return(user, nil)
+				mocks.lockoutServ.EXPECT().Check(arg, arg, arg).Return(nil)
 				mocks.authRepo.EXPECT().FindByUserID(arg, arg).Return(nil, nil)
 			},
 			errMsg: "email or password is incorrect",
@@ -138,6 +143,7 @@ func TestAuthenticate(t *testing.T) {
 			password: password,
 			setup: func(mocks Mocks, user *userDomain.User, auth *authDomain.Authentication) {
 				mocks.userRepo.EXPECT().FindByEmail(arg, arg).Return(user, nil)
+				mocks.lockoutServ.EXPECT().Check(arg, arg, arg).Return(nil)
 				mocks.authRepo.EXPECT().FindByUserID(arg, arg).Return(nil, assert.AnError)
 			},
 			errMsg: assert.AnError.Error(),
@@ -148,10 +154,46 @@ func TestAuthenticate(t *testing.T) {
 			password: "wrongPassword",
 			setup: func(mocks Mocks, user *userDomain.User, auth *authDomain.Authentication) {
 				mocks.userRepo.EXPECT().FindByEmail(arg, arg).Return(user, nil)
+				mocks.lockoutServ.EXPECT().Check(arg, arg, arg).Return(nil)
 				mocks.authRepo.EXPECT().FindByUserID(arg, arg).Return(auth, nil)
+				mocks.lockoutServ.EXPECT().RecordFailure(arg, lockoutDomain.SubjectUser, userID.String()).Return(nil)
 			},
 			errMsg: "email or password is incorrect",
 		},
+		{
+			caseName: "Negative: ユーザーがロックされている場合はパスワードを照合せずにエラーが返る",
+			email:    email,
+			password: password,
+			setup: func(mocks Mocks, user *userDomain.User, auth *authDomain.Authentication) {
+				mocks.userRepo.EXPECT().FindByEmail(arg, arg).Return(user, nil)
+				mocks.lockoutServ.EXPECT().Check(arg, arg, arg).Return(lockoutDomain.ErrLocked)
+			},
+			errMsg: lockoutDomain.ErrLocked.Error(),
+		},
+		{
+			caseName: "Negative: 失敗の記録に失敗した場合はエラーが返る",
+			email:    email,
+			password: "wrongPassword",
+			setup: func(mocks Mocks, user *userDomain.User, auth *authDomain.Authentication) {
+				mocks.userRepo.EXPECT().FindByEmail(arg, arg).Return(user, nil)
+				mocks.lockoutServ.EXPECT().Check(arg, arg, arg).Return(nil)
+				mocks.authRepo.EXPECT().FindByUserID(arg, arg).Return(auth, nil)
+				mocks.lockoutServ.EXPECT().RecordFailure(arg, arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: 成功の記録に失敗した場合はエラーが返る",
+			email:    email,
+			password: password,
+			setup: func(mocks Mocks, user *userDomain.User, auth *authDomain.Authentication) {
+				mocks.userRepo.EXPECT().FindByEmail(arg, arg).Return(user, nil)
+				mocks.lockoutServ.EXPECT().Check(arg, arg, arg).Return(nil)
+				mocks.authRepo.EXPECT().FindByUserID(arg, arg).Return(auth, nil)
+				mocks.lockoutServ.EXPECT().RecordSuccess(arg, arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -161,10 +203,11 @@ func TestAuthenticate(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := Mocks{
-				authRepo: mock.NewMockIAuthenticationRepository(ctrl),
-				userRepo: mock.NewMockIUserRepository(ctrl),
+				authRepo:    mock.NewMockIAuthenticationRepository(ctrl),
+				userRepo:    mock.NewMockIUserRepository(ctrl),
+				lockoutServ: mock.NewMockILockoutService(ctrl),
 			}
-			service := authDomain.NewService(mocks.authRepo, mocks.userRepo)
+			service := authDomain.NewService(mocks.authRepo, mocks.userRepo, mocks.lockoutServ)
 			ctx := context.Background()
 			user, err := userDomain.Reconstruct(userID.String(), name, email)
 			assert.NoError(t, err)
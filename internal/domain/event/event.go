@@ -0,0 +1,122 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// Event はドメインで起きた出来事です。状態の変更と同じトランザクションでアウトボックスに記録し、
+// 中継処理が外部に発行した後に発行日時を記録します。発行は少なくとも1回行う為、購読する側は ID で重複を除きます。
+type Event struct {
+	id            idVO.EventID
+	eventType     string
+	aggregateType string
+	aggregateID   string
+	// Payload を JSON にしたものです。
+	payload     json.RawMessage
+	occurredAt  time.Time
+	publishedAt *time.Time
+}
+
+// イベントを作成します。作成時点では未発行です。
+func New(payload Payload, occurredAt time.Time) (*Event, error) {
+	aggregateType, aggregateID := payload.aggregate()
+	if err := validAggregate(aggregateType, aggregateID); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{
+		id:            idVO.NewEventID(),
+		eventType:     payload.eventType(),
+		aggregateType: aggregateType,
+		aggregateID:   aggregateID,
+		payload:       data,
+		occurredAt:    occurredAt,
+	}, nil
+}
+
+// データベースからイベントを再構築します。
+func Reconstruct(
+	id, eventType, aggregateType, aggregateID string,
+	payload json.RawMessage,
+	occurredAt time.Time,
+	publishedAt *time.Time,
+) (*Event, error) {
+	eID, err := idVO.EventIDFromString(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validType(eventType); err != nil {
+		return nil, err
+	}
+	if err := validAggregate(aggregateType, aggregateID); err != nil {
+		return nil, err
+	}
+	return &Event{
+		id:            eID,
+		eventType:     eventType,
+		aggregateType: aggregateType,
+		aggregateID:   aggregateID,
+		payload:       payload,
+		occurredAt:    occurredAt,
+		publishedAt:   publishedAt,
+	}, nil
+}
+
+func (e *Event) ID() idVO.EventID {
+	return e.id
+}
+
+func (e *Event) IDString() string {
+	return e.id.String()
+}
+
+func (e *Event) Type() string {
+	return e.eventType
+}
+
+func (e *Event) AggregateType() string {
+	return e.aggregateType
+}
+
+func (e *Event) AggregateID() string {
+	return e.aggregateID
+}
+
+func (e *Event) Aggregate() Aggregate {
+	return Aggregate{Type: e.aggregateType, ID: e.aggregateID}
+}
+
+func (e *Event) Payload() json.RawMessage {
+	return e.payload
+}
+
+func (e *Event) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+func (e *Event) OccurredAtString() string {
+	return timer.FormatToISO8601(e.occurredAt)
+}
+
+func (e *Event) PublishedAt() *time.Time {
+	return e.publishedAt
+}
+
+func (e *Event) IsPublished() bool {
+	return e.publishedAt != nil
+}
+
+// 外部に発行したことを記録します。既に発行済みの場合は最初に発行した日時のままです。
+func (e *Event) MarkPublished(now time.Time) {
+	if e.publishedAt != nil {
+		return
+	}
+	e.publishedAt = &now
+}
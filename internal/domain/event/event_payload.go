@@ -0,0 +1,129 @@
+package event
+
+// Payload はイベントの内容です。イベントの種類と、どの集約で起きたかを返します。
+// 購読する側がドメインのパッケージに依存せずに読めるよう、値は全て文字列で保持し、金額は通貨の小数点以下の桁数に合わせた10進数の文字列です。
+type Payload interface {
+	eventType() string
+	aggregate() (aggregateType, aggregateID string)
+}
+
+type UserSignedUp struct {
+	UserID string `json:"userId"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+}
+
+func (p UserSignedUp) eventType() string {
+	return TypeUserSignedUp
+}
+
+func (p UserSignedUp) aggregate() (string, string) {
+	return AggregateUser, p.UserID
+}
+
+type AccountOpened struct {
+	AccountID string `json:"accountId"`
+	UserID    string `json:"userId"`
+	Name      string `json:"name"`
+	Currency  string `json:"currency"`
+}
+
+func (p AccountOpened) eventType() string {
+	return TypeAccountOpened
+}
+
+func (p AccountOpened) aggregate() (string, string) {
+	return AggregateAccount, p.AccountID
+}
+
+type MoneyDeposited struct {
+	TransactionID string `json:"transactionId"`
+	AccountID     string `json:"accountId"`
+	Amount        string `json:"amount"`
+	Currency      string `json:"currency"`
+	BalanceAfter  string `json:"balanceAfter"`
+}
+
+func (p MoneyDeposited) eventType() string {
+	return TypeMoneyDeposited
+}
+
+func (p MoneyDeposited) aggregate() (string, string) {
+	return AggregateAccount, p.AccountID
+}
+
+type MoneyWithdrawn struct {
+	TransactionID string `json:"transactionId"`
+	AccountID     string `json:"accountId"`
+	Amount        string `json:"amount"`
+	Currency      string `json:"currency"`
+	BalanceAfter  string `json:"balanceAfter"`
+}
+
+func (p MoneyWithdrawn) eventType() string {
+	return TypeMoneyWithdrawn
+}
+
+func (p MoneyWithdrawn) aggregate() (string, string) {
+	return AggregateAccount, p.AccountID
+}
+
+// 振込は送金元の口座のイベントとして記録します。受取口座には同じ取引の MoneyReceived を記録する為、
+// 購読する側は口座ごとのイベントを記録した順に受け取れます。
+type MoneyTransferred struct {
+	TransactionID     string `json:"transactionId"`
+	SenderAccountID   string `json:"senderAccountId"`
+	ReceiverAccountID string `json:"receiverAccountId"`
+	Amount            string `json:"amount"`
+	Currency          string `json:"currency"`
+	ReceiverAmount    string `json:"receiverAmount"`
+	ReceiverCurrency  string `json:"receiverCurrency"`
+	// 受取口座の通貨が異なる場合のみ設定します。
+	ExchangeRate *string `json:"exchangeRate,omitempty"`
+}
+
+func (p MoneyTransferred) eventType() string {
+	return TypeMoneyTransferred
+}
+
+func (p MoneyTransferred) aggregate() (string, string) {
+	return AggregateAccount, p.SenderAccountID
+}
+
+// 振込の受取口座のイベントです。金額は受取口座の通貨に換算した入金額です。
+type MoneyReceived struct {
+	TransactionID   string `json:"transactionId"`
+	AccountID       string `json:"accountId"`
+	SenderAccountID string `json:"senderAccountId"`
+	Amount          string `json:"amount"`
+	Currency        string `json:"currency"`
+	BalanceAfter    string `json:"balanceAfter"`
+}
+
+func (p MoneyReceived) eventType() string {
+	return TypeMoneyReceived
+}
+
+func (p MoneyReceived) aggregate() (string, string) {
+	return AggregateAccount, p.AccountID
+}
+
+// 取消は残高が変わった口座ごとに記録します。振込の取消では、送金元の口座と受取口座のそれぞれのイベントを記録します。
+type MoneyReversed struct {
+	TransactionID         string `json:"transactionId"`
+	ReversedTransactionID string `json:"reversedTransactionId"`
+	AccountID             string `json:"accountId"`
+	// 取消によって残高が増えた場合は CREDIT、減った場合は DEBIT です。
+	Direction    string `json:"direction"`
+	Amount       string `json:"amount"`
+	Currency     string `json:"currency"`
+	BalanceAfter string `json:"balanceAfter"`
+}
+
+func (p MoneyReversed) eventType() string {
+	return TypeMoneyReversed
+}
+
+func (p MoneyReversed) aggregate() (string, string) {
+	return AggregateAccount, p.AccountID
+}
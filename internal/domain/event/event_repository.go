@@ -0,0 +1,11 @@
+package event
+
+import "context"
+
+// IEventRepository はイベントを状態の変更と同じトランザクションで記録するアウトボックスです。
+type IEventRepository interface {
+	// 新しいイベントは追加し、発行済みにしたイベントは発行日時を更新します。
+	Save(ctx context.Context, event *Event) error
+	// 未発行のイベントを記録した順に最大 limit 件取得します。excluded に指定した集約のイベントは取得しません。
+	ListUnpublished(ctx context.Context, limit int, excluded []Aggregate) ([]*Event, error)
+}
@@ -0,0 +1,56 @@
+package event
+
+import "errors"
+
+// 集約の種類です。同じ集約のイベントは記録した順に発行します。
+const (
+	AggregateUser    = "USER"
+	AggregateAccount = "ACCOUNT"
+)
+
+// Aggregate はイベントが起きた集約を表します。
+type Aggregate struct {
+	Type string
+	ID   string
+}
+
+// イベントの種類です。
+const (
+	TypeUserSignedUp     = "UserSignedUp"
+	TypeAccountOpened    = "AccountOpened"
+	TypeMoneyDeposited   = "MoneyDeposited"
+	TypeMoneyWithdrawn   = "MoneyWithdrawn"
+	TypeMoneyTransferred = "MoneyTransferred"
+	TypeMoneyReceived    = "MoneyReceived"
+	TypeMoneyReversed    = "MoneyReversed"
+)
+
+// 1回の中継で発行するイベントの最大件数です。
+const RelayBatchSize = 100
+
+var (
+	ErrInvalidType          = errors.New("invalid event type")
+	ErrInvalidAggregateType = errors.New("invalid event aggregate type")
+	ErrEmptyAggregateID     = errors.New("event aggregate id must not be empty")
+)
+
+func validType(eventType string) error {
+	switch eventType {
+	case TypeUserSignedUp, TypeAccountOpened, TypeMoneyDeposited, TypeMoneyWithdrawn, TypeMoneyTransferred, TypeMoneyReceived, TypeMoneyReversed:
+		return nil
+	default:
+		return ErrInvalidType
+	}
+}
+
+func validAggregate(aggregateType, aggregateID string) error {
+	switch aggregateType {
+	case AggregateUser, AggregateAccount:
+	default:
+		return ErrInvalidAggregateType
+	}
+	if aggregateID == "" {
+		return ErrEmptyAggregateID
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package event_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestNew(t *testing.T) {
+	var (
+		userID    = idVO.NewUserIDForTest("user").String()
+		accountID = idVO.NewAccountIDForTest("account").String()
+	)
+
+	tests := []struct {
+		caseName          string
+		payload           eventDomain.Payload
+		wantType          string
+		wantAggregateType string
+		wantAggregateID   string
+		wantPayload       string
+		wantErr           error
+	}{
+		{
+			caseName:          "Positive: サインアップしたユーザーのイベントを作成できる",
+			payload:           eventDomain.UserSignedUp{UserID: userID, Name: "Sato Taro", Email: "sato@example.com"},
+			wantType:          eventDomain.TypeUserSignedUp,
+			wantAggregateType: eventDomain.AggregateUser,
+			wantAggregateID:   userID,
+			wantPayload:       `{"userId":"` + userID + `","name":"Sato Taro","email":"sato@example.com"}`,
+		},
+		{
+			caseName: "Positive: 振込のイベントは送金元の口座のイベントとして作成する",
+			payload: eventDomain.MoneyTransferred{
+				TransactionID: "transaction", SenderAccountID: accountID, ReceiverAccountID: "receiver",
+				Amount: "100", Currency: "JPY", ReceiverAmount: "0.67", ReceiverCurrency: "USD",
+			},
+			wantType:          eventDomain.TypeMoneyTransferred,
+			wantAggregateType: eventDomain.AggregateAccount,
+			wantAggregateID:   accountID,
+			wantPayload: `{"transactionId":"transaction","senderAccountId":"` + accountID + `","receiverAccountId":"receiver",` +
+				`"amount":"100","currency":"JPY","receiverAmount":"0.67","receiverCurrency":"USD"}`,
+		},
+		{
+			caseName: "Positive: 振込の受取のイベントは受取口座のイベントとして作成する",
+			payload: eventDomain.MoneyReceived{
+				TransactionID: "transaction", AccountID: accountID, SenderAccountID: "sender",
+				Amount: "0.67", Currency: "USD", BalanceAfter: "10.67",
+			},
+			wantType:          eventDomain.TypeMoneyReceived,
+			wantAggregateType: eventDomain.AggregateAccount,
+			wantAggregateID:   accountID,
+			wantPayload: `{"transactionId":"transaction","accountId":"` + accountID + `","senderAccountId":"sender",` +
+				`"amount":"0.67","currency":"USD","balanceAfter":"10.67"}`,
+		},
+		{
+			caseName: "Negative: 集約のIDが空の場合はエラーが返る",
+			payload:  eventDomain.AccountOpened{UserID: userID, Name: "For work", Currency: "JPY"},
+			wantErr:  eventDomain.ErrEmptyAggregateID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			event, err := eventDomain.New(tt.payload, timer.GetFixedDate())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, event)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, event.ID().IsValid())
+			assert.Equal(t, tt.wantType, event.Type())
+			assert.Equal(t, tt.wantAggregateType, event.AggregateType())
+			assert.Equal(t, tt.wantAggregateID, event.AggregateID())
+			assert.JSONEq(t, tt.wantPayload, string(event.Payload()))
+			assert.Equal(t, timer.GetFixedDate(), event.OccurredAt())
+			assert.False(t, event.IsPublished())
+		})
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	var (
+		id          = idVO.NewEventIDForTest("event").String()
+		aggregateID = idVO.NewAccountIDForTest("account").String()
+		payload     = json.RawMessage(`{"accountId":"` + aggregateID + `"}`)
+	)
+
+	tests := []struct {
+		caseName      string
+		id            string
+		eventType     string
+		aggregateType string
+		wantErr       error
+	}{
+		{
+			caseName:      "Positive: イベントを再構築できる",
+			id:            id,
+			eventType:     eventDomain.TypeAccountOpened,
+			aggregateType: eventDomain.AggregateAccount,
+		},
+		{
+			caseName:      "Negative: 不正なIDの場合はエラーが返る",
+			id:            "invalid",
+			eventType:     eventDomain.TypeAccountOpened,
+			aggregateType: eventDomain.AggregateAccount,
+			wantErr:       idVO.ErrInvalidULID,
+		},
+		{
+			caseName:      "Negative: 不明なイベントの種類の場合はエラーが返る",
+			id:            id,
+			eventType:     "AccountFrozen",
+			aggregateType: eventDomain.AggregateAccount,
+			wantErr:       eventDomain.ErrInvalidType,
+		},
+		{
+			caseName:      "Negative: 不明な集約の種類の場合はエラーが返る",
+			id:            id,
+			eventType:     eventDomain.TypeAccountOpened,
+			aggregateType: "PAYEE",
+			wantErr:       eventDomain.ErrInvalidAggregateType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			publishedAt := timer.GetFixedDate()
+			event, err := eventDomain.Reconstruct(tt.id, tt.eventType, tt.aggregateType, aggregateID, payload, timer.GetFixedDate(), &publishedAt)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, event)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.id, event.IDString())
+			assert.Equal(t, payload, event.Payload())
+			assert.True(t, event.IsPublished())
+		})
+	}
+}
+
+func TestMarkPublished(t *testing.T) {
+	event, err := eventDomain.New(eventDomain.UserSignedUp{UserID: idVO.NewUserIDForTest("user").String()}, timer.GetFixedDate())
+	assert.NoError(t, err)
+
+	first := timer.GetFixedDate()
+	event.MarkPublished(first)
+	event.MarkPublished(first.Add(1))
+
+	assert.True(t, event.IsPublished())
+	assert.Equal(t, first, *event.PublishedAt())
+}
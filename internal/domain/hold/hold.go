@@ -0,0 +1,275 @@
+package hold
+
+import (
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// Hold は口座の残高のうち、後で確定する支払いの為に金額を仮押さえ (オーソリ) したものを表します。
+// 確定すると仮押さえを解除して、指定した金額の出金または受取口座への振込を行います。
+// 確定せずに取り消した場合や有効期限を過ぎた場合は、仮押さえを解除するだけです。
+type Hold struct {
+	id        idVO.HoldID
+	accountID idVO.AccountID
+	// 指定した場合、確定時に受取口座へ振込を行います。指定しない場合は出金を行います。
+	receiverAccountID *idVO.AccountID
+	amount            moneyVO.Money
+	status            string
+	expiresAt         time.Time
+	// 確定した金額と、確定時に行った取引です。確定するまでは nil です。
+	capturedAmount *moneyVO.Money
+	transactionID  *idVO.TransactionID
+	createdAt      time.Time
+	updatedAt      time.Time
+	// 楽観的排他制御の為のバージョンです。未保存の仮押さえは 0 です。
+	version int64
+}
+
+// 仮押さえを作成します。金額は通貨の最小単位で指定し、有効期限は now から MaxExpiry までの間で指定します。
+func New(
+	accountID idVO.AccountID, receiverAccountID *idVO.AccountID,
+	amount int64, currency string,
+	expiresAt time.Time, now time.Time,
+) (*Hold, error) {
+	if receiverAccountID != nil && *receiverAccountID == accountID {
+		return nil, ErrSameAccount
+	}
+	if err := validExpiry(expiresAt, now); err != nil {
+		return nil, err
+	}
+	return newHold(
+		idVO.NewHoldID(), accountID, receiverAccountID, amount, currency,
+		StatusAuthorized, expiresAt, nil, nil, now, now, 0,
+	)
+}
+
+// データベースから仮押さえを再構築します。
+func Reconstruct(
+	id, accountID string, receiverAccountID *string,
+	amount int64, currency string,
+	status string, expiresAt time.Time,
+	capturedAmount *int64, transactionID *string,
+	createdAt, updatedAt time.Time, version int64,
+) (*Hold, error) {
+	hID, err := idVO.HoldIDFromString(id)
+	if err != nil {
+		return nil, err
+	}
+	aID, err := idVO.AccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+	var rID *idVO.AccountID
+	if receiverAccountID != nil {
+		id, err := idVO.AccountIDFromString(*receiverAccountID)
+		if err != nil {
+			return nil, err
+		}
+		rID = &id
+	}
+	var tID *idVO.TransactionID
+	if transactionID != nil {
+		id, err := idVO.TransactionIDFromString(*transactionID)
+		if err != nil {
+			return nil, err
+		}
+		tID = &id
+	}
+	if err := validStatus(status); err != nil {
+		return nil, err
+	}
+	return newHold(hID, aID, rID, amount, currency, status, expiresAt, capturedAmount, tID, createdAt, updatedAt, version)
+}
+
+func newHold(
+	id idVO.HoldID,
+	accountID idVO.AccountID, receiverAccountID *idVO.AccountID,
+	amount int64, currency string,
+	status string, expiresAt time.Time,
+	capturedAmount *int64, transactionID *idVO.TransactionID,
+	createdAt, updatedAt time.Time, version int64,
+) (*Hold, error) {
+	if err := validAmount(amount); err != nil {
+		return nil, err
+	}
+	money, err := moneyVO.New(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	var captured *moneyVO.Money
+	if capturedAmount != nil {
+		captured, err = moneyVO.New(*capturedAmount, currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Hold{
+		id:                id,
+		accountID:         accountID,
+		receiverAccountID: receiverAccountID,
+		amount:            *money,
+		status:            status,
+		expiresAt:         expiresAt,
+		capturedAmount:    captured,
+		transactionID:     transactionID,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+		version:           version,
+	}, nil
+}
+
+func (h *Hold) ID() idVO.HoldID {
+	return h.id
+}
+
+func (h *Hold) IDString() string {
+	return h.id.String()
+}
+
+func (h *Hold) AccountID() idVO.AccountID {
+	return h.accountID
+}
+
+func (h *Hold) AccountIDString() string {
+	return h.accountID.String()
+}
+
+func (h *Hold) ReceiverAccountID() *idVO.AccountID {
+	return h.receiverAccountID
+}
+
+func (h *Hold) ReceiverAccountIDString() *string {
+	if h.receiverAccountID == nil {
+		return nil
+	}
+	id := h.receiverAccountID.String()
+	return &id
+}
+
+// 仮押さえした金額です。
+func (h *Hold) Amount() moneyVO.Money {
+	return h.amount
+}
+
+func (h *Hold) Status() string {
+	return h.status
+}
+
+func (h *Hold) ExpiresAt() time.Time {
+	return h.expiresAt
+}
+
+func (h *Hold) ExpiresAtString() string {
+	return timer.FormatToISO8601(h.expiresAt)
+}
+
+func (h *Hold) CapturedAmount() *moneyVO.Money {
+	return h.capturedAmount
+}
+
+// 確定した金額を "10.99" のような10進数表記で返します。確定していない場合は nil です。
+func (h *Hold) CapturedAmountDecimal() *string {
+	if h.capturedAmount == nil {
+		return nil
+	}
+	decimal := h.capturedAmount.Decimal()
+	return &decimal
+}
+
+func (h *Hold) TransactionID() *idVO.TransactionID {
+	return h.transactionID
+}
+
+func (h *Hold) TransactionIDString() *string {
+	if h.transactionID == nil {
+		return nil
+	}
+	id := h.transactionID.String()
+	return &id
+}
+
+func (h *Hold) CreatedAt() time.Time {
+	return h.createdAt
+}
+
+func (h *Hold) UpdatedAt() time.Time {
+	return h.updatedAt
+}
+
+func (h *Hold) UpdatedAtString() string {
+	return timer.FormatToISO8601(h.updatedAt)
+}
+
+func (h *Hold) Version() int64 {
+	return h.version
+}
+
+// 保存に成功した後、リポジトリから呼び出されます。同じエンティティを続けて保存できるようにバージョンを進めます。
+func (h *Hold) IncrementVersion() {
+	h.version++
+}
+
+// 指定した時刻に有効期限を過ぎている AUTHORIZED の仮押さえかを返します。
+func (h *Hold) IsExpired(now time.Time) bool {
+	return h.status == StatusAuthorized && !now.Before(h.expiresAt)
+}
+
+// 指定した金額で確定できるかをチェックします。仮押さえした金額以下であれば、一部の金額だけを確定できます。
+func (h *Hold) CheckCapturable(amount int64, now time.Time) error {
+	if h.status != StatusAuthorized {
+		return ErrNotAuthorized
+	}
+	if h.IsExpired(now) {
+		return ErrExpired
+	}
+	if err := validAmount(amount); err != nil {
+		return err
+	}
+	if amount > h.amount.Amount() {
+		return ErrCaptureExceedsHold
+	}
+	return nil
+}
+
+// 確定した金額と、確定時に行った取引を記録します。確定しなかった残りの金額は仮押さえを解除します。
+func (h *Hold) Capture(amount int64, transactionID idVO.TransactionID, now time.Time) error {
+	if err := h.CheckCapturable(amount, now); err != nil {
+		return err
+	}
+	captured, err := moneyVO.New(amount, h.amount.Currency())
+	if err != nil {
+		return err
+	}
+	h.capturedAmount = captured
+	h.transactionID = &transactionID
+	h.status = StatusCaptured
+	h.updatedAt = now
+	return nil
+}
+
+// 仮押さえを取り消します。有効期限を過ぎていても、まだ解除されていなければ取り消せます。
+func (h *Hold) Void(now time.Time) error {
+	if h.status != StatusAuthorized {
+		return ErrNotAuthorized
+	}
+	h.status = StatusVoided
+	h.updatedAt = now
+	return nil
+}
+
+// 有効期限を過ぎた仮押さえを解除します。
+func (h *Hold) Expire(now time.Time) error {
+	if h.status != StatusAuthorized {
+		return ErrNotAuthorized
+	}
+	if !h.IsExpired(now) {
+		return ErrNotExpired
+	}
+	h.status = StatusExpired
+	h.updatedAt = now
+	return nil
+}
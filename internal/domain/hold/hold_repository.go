@@ -0,0 +1,19 @@
+package hold
+
+import (
+	"context"
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IHoldRepository interface {
+	// 読み込んだ時点のバージョンと一致する場合のみ保存します。一致しない場合は ErrConcurrentModification を返します。
+	Save(ctx context.Context, hold *Hold) error
+	// 存在しない場合は nil を返します。
+	FindByID(ctx context.Context, id idVO.HoldID) (*Hold, error)
+	// 口座の仮押さえを新しい順に取得します。status を指定した場合はその状態の仮押さえのみ取得します。
+	ListByAccountID(ctx context.Context, accountID idVO.AccountID, status *string) ([]*Hold, error)
+	// now の時点で有効期限を過ぎている AUTHORIZED の仮押さえを、有効期限の古い順に最大 limit 件取得します。
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*Hold, error)
+}
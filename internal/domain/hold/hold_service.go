@@ -0,0 +1,173 @@
+package hold
+
+import (
+	"context"
+	"time"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type IHoldService interface {
+	// 指定された口座の仮押さえを取得します。存在しない場合や他の口座の仮押さえの場合は ErrNotFound を返します。
+	GetByAccount(ctx context.Context, accountID idVO.AccountID, id idVO.HoldID) (*Hold, error)
+	// 口座の利用できる金額から仮押さえを作成します。確定時と同じく、出金または振込の上限を超える金額は仮押さえできません。
+	Authorize(ctx context.Context, account *accountDomain.Account, receiverAccountID *idVO.AccountID, amount int64, currency string, expiresAt time.Time) (*Hold, error)
+	// 仮押さえを確定し、指定した金額の出金または振込を行います。amount を指定しない場合は仮押さえした金額の全額を確定します。
+	Capture(ctx context.Context, account *accountDomain.Account, hold *Hold, amount *int64) (*transactionDomain.Transaction, error)
+	// 仮押さえを取り消し、仮押さえした金額を再び利用できるようにします。
+	Void(ctx context.Context, account *accountDomain.Account, hold *Hold) error
+	// 有効期限を過ぎた仮押さえを解除します。
+	Expire(ctx context.Context, hold *Hold, now time.Time) error
+}
+
+type holdService struct {
+	accountRepo     accountDomain.IAccountRepository
+	holdRepo        IHoldRepository
+	transactionServ transactionDomain.ITransactionService
+}
+
+func NewService(
+	accountRepository accountDomain.IAccountRepository,
+	holdRepository IHoldRepository,
+	transactionService transactionDomain.ITransactionService,
+) IHoldService {
+	return &holdService{
+		accountRepo:     accountRepository,
+		holdRepo:        holdRepository,
+		transactionServ: transactionService,
+	}
+}
+
+func (s *holdService) GetByAccount(ctx context.Context, accountID idVO.AccountID, id idVO.HoldID) (*Hold, error) {
+	hold, err := s.holdRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if hold == nil || hold.AccountID() != accountID {
+		return nil, ErrNotFound
+	}
+	return hold, nil
+}
+
+func (s *holdService) Authorize(
+	ctx context.Context,
+	account *accountDomain.Account,
+	receiverAccountID *idVO.AccountID,
+	amount int64,
+	currency string,
+	expiresAt time.Time,
+) (*Hold, error) {
+	now := timer.Now()
+	hold, err := New(account.ID(), receiverAccountID, amount, currency, expiresAt, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := account.PlaceHold(amount, currency); err != nil {
+		return nil, err
+	}
+	// 上限を超えて確定できない仮押さえを作らないよう、作成時にも上限を検証します。
+	// 他の仮押さえの金額は利用済みに含めない為、確定時にも改めて上限を検証します。
+	operationType := transactionDomain.Withdrawal
+	if receiverAccountID != nil {
+		operationType = transactionDomain.Transfer
+	}
+	if err := s.transactionServ.CheckLimit(ctx, account, operationType, amount); err != nil {
+		return nil, err
+	}
+	account.ChangeUpdatedAt(now)
+	if err := s.accountRepo.Save(ctx, account); err != nil {
+		return nil, err
+	}
+
+	if err := s.holdRepo.Save(ctx, hold); err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+func (s *holdService) Capture(
+	ctx context.Context,
+	account *accountDomain.Account,
+	hold *Hold,
+	amount *int64,
+) (*transactionDomain.Transaction, error) {
+	captureAmount := hold.Amount().Amount()
+	if amount != nil {
+		captureAmount = *amount
+	}
+	now := timer.Now()
+	if err := hold.CheckCapturable(captureAmount, now); err != nil {
+		return nil, err
+	}
+
+	// 仮押さえの全額を解除してから、確定した金額の出金または振込を行う
+	if err := account.ReleaseHold(hold.Amount().Amount(), hold.Amount().Currency()); err != nil {
+		return nil, err
+	}
+
+	var (
+		transaction *transactionDomain.Transaction
+		err         error
+	)
+	if hold.ReceiverAccountID() == nil {
+		transaction, err = s.transactionServ.Withdrawal(ctx, account, captureAmount, hold.Amount().Currency(), transactionDomain.Description{})
+	} else {
+		receiver, findErr := s.accountRepo.FindByID(ctx, *hold.ReceiverAccountID())
+		if findErr != nil {
+			return nil, findErr
+		}
+		if receiver == nil {
+			return nil, accountDomain.ErrReceiverNotFound
+		}
+		transaction, err = s.transactionServ.Transfer(ctx, account, receiver, captureAmount, hold.Amount().Currency(), transactionDomain.Description{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := hold.Capture(captureAmount, transaction.ID(), now); err != nil {
+		return nil, err
+	}
+	if err := s.holdRepo.Save(ctx, hold); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+func (s *holdService) Void(ctx context.Context, account *accountDomain.Account, hold *Hold) error {
+	now := timer.Now()
+	if err := hold.Void(now); err != nil {
+		return err
+	}
+	return s.release(ctx, account, hold, now)
+}
+
+func (s *holdService) Expire(ctx context.Context, hold *Hold, now time.Time) error {
+	if err := hold.Expire(now); err != nil {
+		return err
+	}
+	account, err := s.accountRepo.FindByID(ctx, hold.AccountID())
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return accountDomain.ErrNotFound
+	}
+	return s.release(ctx, account, hold, now)
+}
+
+// 仮押さえした金額を口座で再び利用できるようにし、口座と仮押さえを保存します。
+func (s *holdService) release(ctx context.Context, account *accountDomain.Account, hold *Hold, now time.Time) error {
+	if err := account.ReleaseHold(hold.Amount().Amount(), hold.Amount().Currency()); err != nil {
+		return err
+	}
+	account.ChangeUpdatedAt(now)
+	if err := s.accountRepo.Save(ctx, account); err != nil {
+		return err
+	}
+	return s.holdRepo.Save(ctx, hold)
+}
@@ -0,0 +1,365 @@
+package hold_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	"github.com/u104rak1/pocgo/internal/domain/mock"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type Mocks struct {
+	accountRepo     *mock.MockIAccountRepository
+	holdRepo        *mock.MockIHoldRepository
+	transactionServ *mock.MockITransactionService
+}
+
+func newService(t *testing.T) (holdDomain.IHoldService, Mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mocks := Mocks{
+		accountRepo:     mock.NewMockIAccountRepository(ctrl),
+		holdRepo:        mock.NewMockIHoldRepository(ctrl),
+		transactionServ: mock.NewMockITransactionService(ctrl),
+	}
+	return holdDomain.NewService(mocks.accountRepo, mocks.holdRepo, mocks.transactionServ), mocks
+}
+
+// 残高 1000 円のうち、hold の金額を仮押さえした口座を作成します。
+func newAccountWithHold(t *testing.T, hold *holdDomain.Hold) *accountDomain.Account {
+	t.Helper()
+	account, err := accountDomain.New(idVO.NewUserIDForTest("user"), 1000, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	if hold != nil {
+		assert.NoError(t, account.PlaceHold(hold.Amount().Amount(), hold.Amount().Currency()))
+	}
+	return account
+}
+
+// サービスは現在時刻で有効期限を判定する為、現在時刻から1時間後に有効期限を迎える 1000 円の仮押さえを作成します。
+func newActiveHold(t *testing.T, receiverID *idVO.AccountID) *holdDomain.Hold {
+	t.Helper()
+	now := timer.Now()
+	hold, err := holdDomain.New(accountID, receiverID, 1000, moneyVO.JPY, now.Add(time.Hour), now)
+	assert.NoError(t, err)
+	return hold
+}
+
+func TestGetByAccount(t *testing.T) {
+	var (
+		arg            = gomock.Any()
+		otherAccountID = idVO.NewAccountIDForTest("other")
+	)
+
+	tests := []struct {
+		caseName  string
+		accountID idVO.AccountID
+		setup     func(mocks Mocks, hold *holdDomain.Hold)
+		wantErr   error
+	}{
+		{
+			caseName:  "Positive: 口座の仮押さえを取得できる",
+			accountID: accountID,
+			setup: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.holdRepo.EXPECT().FindByID(arg, hold.ID()).Return(hold, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName:  "Negative: 仮押さえが存在しない場合は ErrNotFound が返る",
+			accountID: accountID,
+			setup: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.holdRepo.EXPECT().FindByID(arg, arg).Return(nil, nil)
+			},
+			wantErr: holdDomain.ErrNotFound,
+		},
+		{
+			caseName:  "Negative: 他の口座の仮押さえの場合は ErrNotFound が返る",
+			accountID: otherAccountID,
+			setup: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.holdRepo.EXPECT().FindByID(arg, arg).Return(hold, nil)
+			},
+			wantErr: holdDomain.ErrNotFound,
+		},
+		{
+			caseName:  "Negative: 取得に失敗した場合はエラーが返る",
+			accountID: accountID,
+			setup: func(mocks Mocks, hold *holdDomain.Hold) {
+				mocks.holdRepo.EXPECT().FindByID(arg, arg).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			service, mocks := newService(t)
+			hold := newHold(t, nil)
+			tt.setup(mocks, hold)
+
+			got, err := service.GetByAccount(context.Background(), tt.accountID, hold.ID())
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, hold, got)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	arg := gomock.Any()
+
+	tests := []struct {
+		caseName string
+		amount   int64
+		setup    func(mocks Mocks)
+		wantHeld int64
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 利用できる金額から仮押さえを作成できる",
+			amount:   1000,
+			setup: func(mocks Mocks) {
+				mocks.transactionServ.EXPECT().CheckLimit(arg, arg, transactionDomain.Transfer, int64(1000)).Return(nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.holdRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+			wantHeld: 1000,
+			wantErr:  nil,
+		},
+		{
+			caseName: "Negative: 利用できる金額を超える場合はエラーが返る",
+			amount:   1001,
+			setup:    func(mocks Mocks) {},
+			wantHeld: 0,
+			wantErr:  moneyVO.ErrInsufficientBalance,
+		},
+		{
+			caseName: "Negative: 振込の上限を超える場合はエラーが返る",
+			amount:   1000,
+			setup: func(mocks Mocks) {
+				mocks.transactionServ.EXPECT().CheckLimit(arg, arg, transactionDomain.Transfer, int64(1000)).Return(limitDomain.ErrLimitExceeded)
+			},
+			wantHeld: 1000,
+			wantErr:  limitDomain.ErrLimitExceeded,
+		},
+		{
+			caseName: "Negative: 口座の保存に失敗した場合はエラーが返る",
+			amount:   1000,
+			setup: func(mocks Mocks) {
+				mocks.transactionServ.EXPECT().CheckLimit(arg, arg, arg, arg).Return(nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(accountDomain.ErrConcurrentModification)
+			},
+			wantHeld: 1000,
+			wantErr:  accountDomain.ErrConcurrentModification,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			service, mocks := newService(t)
+			account := newAccountWithHold(t, nil)
+			tt.setup(mocks)
+
+			hold, err := service.Authorize(context.Background(), account, &receiverAccountID, tt.amount, moneyVO.JPY, timer.Now().Add(holdDomain.DefaultExpiry))
+
+			assert.Equal(t, tt.wantHeld, account.HeldBalance().Amount())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, hold)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, account.ID(), hold.AccountID())
+			assert.Equal(t, &receiverAccountID, hold.ReceiverAccountID())
+			assert.Equal(t, holdDomain.StatusAuthorized, hold.Status())
+		})
+	}
+}
+
+func TestCapture(t *testing.T) {
+	var (
+		partial = int64(600)
+		arg     = gomock.Any()
+	)
+
+	// 出金または振込の取引を返します。残高は口座に反映しません。
+	newTransaction := func(operationType string, amount int64) *transactionDomain.Transaction {
+		var receiverID *idVO.AccountID
+		var receiverAmount *int64
+		var receiverCurrency *string
+		if operationType == transactionDomain.Transfer {
+			currency := moneyVO.JPY
+			receiverID, receiverAmount, receiverCurrency = &receiverAccountID, &amount, &currency
+		}
+		tx, _ := transactionDomain.New(accountID, receiverID, operationType, amount, moneyVO.JPY, receiverAmount, receiverCurrency, nil, timer.Now())
+		return tx
+	}
+
+	tests := []struct {
+		caseName   string
+		receiverID *idVO.AccountID
+		amount     *int64
+		setup      func(mocks Mocks, account *accountDomain.Account)
+		wantStatus string
+		wantErr    error
+	}{
+		{
+			caseName: "Positive: 全額を確定すると、仮押さえを解除して出金する",
+			amount:   nil,
+			setup: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.transactionServ.EXPECT().Withdrawal(arg, account, int64(1000), moneyVO.JPY, transactionDomain.Description{}).
+					DoAndReturn(func(_ context.Context, account *accountDomain.Account, amount int64, currency string, _ transactionDomain.Description) (*transactionDomain.Transaction, error) {
+						// 出金の時点で仮押さえは解除されている
+						assert.Equal(t, int64(0), account.HeldBalance().Amount())
+						return newTransaction(transactionDomain.Withdrawal, amount), nil
+					})
+				mocks.holdRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+			wantStatus: holdDomain.StatusCaptured,
+			wantErr:    nil,
+		},
+		{
+			caseName:   "Positive: 一部の金額を確定すると、その金額だけ受取口座に振り込む",
+			receiverID: &receiverAccountID,
+			amount:     &partial,
+			setup: func(mocks Mocks, account *accountDomain.Account) {
+				receiver, _ := accountDomain.New(idVO.NewUserIDForTest("merchant"), 0, "Shop", "1234", moneyVO.JPY)
+				mocks.accountRepo.EXPECT().FindByID(arg, receiverAccountID).Return(receiver, nil)
+				mocks.transactionServ.EXPECT().Transfer(arg, account, receiver, partial, moneyVO.JPY, transactionDomain.Description{}).
+					Return(newTransaction(transactionDomain.Transfer, partial), nil)
+				mocks.holdRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+			wantStatus: holdDomain.StatusCaptured,
+			wantErr:    nil,
+		},
+		{
+			caseName:   "Negative: 受取口座が見つからない場合はエラーが返る",
+			receiverID: &receiverAccountID,
+			setup: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.accountRepo.EXPECT().FindByID(arg, receiverAccountID).Return(nil, nil)
+			},
+			wantStatus: holdDomain.StatusAuthorized,
+			wantErr:    accountDomain.ErrReceiverNotFound,
+		},
+		{
+			caseName:   "Negative: 仮押さえした金額を超える場合は出金せずにエラーが返る",
+			amount:     func() *int64 { v := int64(1001); return &v }(),
+			setup:      func(mocks Mocks, account *accountDomain.Account) {},
+			wantStatus: holdDomain.StatusAuthorized,
+			wantErr:    holdDomain.ErrCaptureExceedsHold,
+		},
+		{
+			caseName: "Negative: 出金に失敗した場合はエラーが返る",
+			setup: func(mocks Mocks, account *accountDomain.Account) {
+				mocks.transactionServ.EXPECT().Withdrawal(arg, arg, arg, arg, arg).Return(nil, assert.AnError)
+			},
+			wantStatus: holdDomain.StatusAuthorized,
+			wantErr:    assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			service, mocks := newService(t)
+			hold := newActiveHold(t, tt.receiverID)
+			account := newAccountWithHold(t, hold)
+			tt.setup(mocks, account)
+
+			transaction, err := service.Capture(context.Background(), account, hold, tt.amount)
+
+			assert.Equal(t, tt.wantStatus, hold.Status())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, transaction)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, transaction.ID(), *hold.TransactionID())
+			assert.Equal(t, transaction.TransferAmount(), *hold.CapturedAmount())
+		})
+	}
+}
+
+func TestVoid(t *testing.T) {
+	arg := gomock.Any()
+
+	t.Run("Positive: 仮押さえを取り消すと、仮押さえした金額が利用できるようになる", func(t *testing.T) {
+		service, mocks := newService(t)
+		hold := newActiveHold(t, nil)
+		account := newAccountWithHold(t, hold)
+		mocks.accountRepo.EXPECT().Save(arg, account).Return(nil)
+		mocks.holdRepo.EXPECT().Save(arg, hold).Return(nil)
+
+		err := service.Void(context.Background(), account, hold)
+
+		assert.NoError(t, err)
+		assert.Equal(t, holdDomain.StatusVoided, hold.Status())
+		assert.Equal(t, int64(1000), account.AvailableBalance().Amount())
+	})
+
+	t.Run("Negative: 取り消した仮押さえは再度取り消せない", func(t *testing.T) {
+		service, _ := newService(t)
+		hold := newActiveHold(t, nil)
+		assert.NoError(t, hold.Void(timer.Now()))
+		account := newAccountWithHold(t, nil)
+
+		err := service.Void(context.Background(), account, hold)
+
+		assert.ErrorIs(t, err, holdDomain.ErrNotAuthorized)
+	})
+}
+
+func TestExpire(t *testing.T) {
+	arg := gomock.Any()
+
+	t.Run("Positive: 有効期限を過ぎた仮押さえを解除し、口座の仮押さえも解除する", func(t *testing.T) {
+		service, mocks := newService(t)
+		hold := newActiveHold(t, nil)
+		account := newAccountWithHold(t, hold)
+		mocks.accountRepo.EXPECT().FindByID(arg, hold.AccountID()).Return(account, nil)
+		mocks.accountRepo.EXPECT().Save(arg, account).Return(nil)
+		mocks.holdRepo.EXPECT().Save(arg, hold).Return(nil)
+
+		err := service.Expire(context.Background(), hold, hold.ExpiresAt())
+
+		assert.NoError(t, err)
+		assert.Equal(t, holdDomain.StatusExpired, hold.Status())
+		assert.Equal(t, int64(0), account.HeldBalance().Amount())
+	})
+
+	t.Run("Negative: 有効期限の前は解除できない", func(t *testing.T) {
+		service, _ := newService(t)
+		hold := newActiveHold(t, nil)
+
+		err := service.Expire(context.Background(), hold, timer.Now())
+
+		assert.ErrorIs(t, err, holdDomain.ErrNotExpired)
+	})
+
+	t.Run("Negative: 口座が見つからない場合はエラーが返る", func(t *testing.T) {
+		service, mocks := newService(t)
+		hold := newActiveHold(t, nil)
+		mocks.accountRepo.EXPECT().FindByID(arg, arg).Return(nil, nil)
+
+		err := service.Expire(context.Background(), hold, hold.ExpiresAt())
+
+		assert.ErrorIs(t, err, accountDomain.ErrNotFound)
+	})
+}
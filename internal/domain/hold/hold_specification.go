@@ -0,0 +1,63 @@
+package hold
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 仮押さえの状態
+const (
+	// 金額を仮押さえしている状態です。確定または取消するまで、仮押さえした金額は利用できません。
+	StatusAuthorized = "AUTHORIZED"
+	// 確定して出金または振込を行った状態です。
+	StatusCaptured = "CAPTURED"
+	// 利用者が取り消した状態です。
+	StatusVoided = "VOIDED"
+	// 有効期限までに確定されず、自動で解除された状態です。
+	StatusExpired = "EXPIRED"
+)
+
+const (
+	// 作成時に有効期限を指定しない場合の有効期間です。
+	DefaultExpiry = 7 * 24 * time.Hour
+	// 有効期間の上限です。
+	MaxExpiry = 30 * 24 * time.Hour
+	// スケジューラーが1回の実行で解除する仮押さえの件数です。
+	ExpireBatchSize = 100
+)
+
+var (
+	ErrNotFound               = errors.New("hold not found")
+	ErrInvalidAmount          = errors.New("amount must be greater than 0")
+	ErrSameAccount            = errors.New("receiver account must be different from the account")
+	ErrInvalidExpiry          = fmt.Errorf("expiry must be in the future and within %d days", int(MaxExpiry.Hours()/24))
+	ErrUnsupportedStatus      = errors.New("unsupported hold status")
+	ErrNotAuthorized          = errors.New("hold has already been captured, voided or expired")
+	ErrExpired                = errors.New("hold has expired")
+	ErrNotExpired             = errors.New("hold has not expired yet")
+	ErrCaptureExceedsHold     = errors.New("captured amount must not exceed the held amount")
+	ErrConcurrentModification = errors.New("hold has been modified by another request, please retry")
+)
+
+func validAmount(amount int64) error {
+	if amount <= 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+func validExpiry(expiresAt, now time.Time) error {
+	if !expiresAt.After(now) || expiresAt.After(now.Add(MaxExpiry)) {
+		return ErrInvalidExpiry
+	}
+	return nil
+}
+
+func validStatus(status string) error {
+	switch status {
+	case StatusAuthorized, StatusCaptured, StatusVoided, StatusExpired:
+		return nil
+	}
+	return ErrUnsupportedStatus
+}
@@ -0,0 +1,317 @@
+package hold_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+var (
+	accountID         = idVO.NewAccountIDForTest("account")
+	receiverAccountID = idVO.NewAccountIDForTest("receiver")
+)
+
+// 1日後に有効期限を迎える 1000 円の仮押さえを作成します。
+func newHold(t *testing.T, receiverID *idVO.AccountID) *holdDomain.Hold {
+	t.Helper()
+	now := timer.GetFixedDate()
+	hold, err := holdDomain.New(accountID, receiverID, 1000, moneyVO.JPY, now.Add(24*time.Hour), now)
+	assert.NoError(t, err)
+	return hold
+}
+
+func TestNew(t *testing.T) {
+	now := timer.GetFixedDate()
+
+	tests := []struct {
+		caseName   string
+		receiverID *idVO.AccountID
+		amount     int64
+		currency   string
+		expiresAt  time.Time
+		wantErr    error
+	}{
+		{
+			caseName:   "Positive: 出金の仮押さえを作成できる",
+			receiverID: nil,
+			amount:     1000,
+			currency:   moneyVO.JPY,
+			expiresAt:  now.Add(holdDomain.DefaultExpiry),
+			wantErr:    nil,
+		},
+		{
+			caseName:   "Positive: 有効期間の上限の有効期限で振込の仮押さえを作成できる",
+			receiverID: &receiverAccountID,
+			amount:     1000,
+			currency:   moneyVO.JPY,
+			expiresAt:  now.Add(holdDomain.MaxExpiry),
+			wantErr:    nil,
+		},
+		{
+			caseName:   "Negative: 受取口座が口座と同じ場合はエラーが返る",
+			receiverID: &accountID,
+			amount:     1000,
+			currency:   moneyVO.JPY,
+			expiresAt:  now.Add(holdDomain.DefaultExpiry),
+			wantErr:    holdDomain.ErrSameAccount,
+		},
+		{
+			caseName:  "Negative: 金額が0の場合はエラーが返る",
+			amount:    0,
+			currency:  moneyVO.JPY,
+			expiresAt: now.Add(holdDomain.DefaultExpiry),
+			wantErr:   holdDomain.ErrInvalidAmount,
+		},
+		{
+			caseName:  "Negative: 有効期限が現在時刻の場合はエラーが返る",
+			amount:    1000,
+			currency:  moneyVO.JPY,
+			expiresAt: now,
+			wantErr:   holdDomain.ErrInvalidExpiry,
+		},
+		{
+			caseName:  "Negative: 有効期限が有効期間の上限を超える場合はエラーが返る",
+			amount:    1000,
+			currency:  moneyVO.JPY,
+			expiresAt: now.Add(holdDomain.MaxExpiry + time.Second),
+			wantErr:   holdDomain.ErrInvalidExpiry,
+		},
+		{
+			caseName:  "Negative: 未対応の通貨の場合はエラーが返る",
+			amount:    1000,
+			currency:  "XXX",
+			expiresAt: now.Add(holdDomain.DefaultExpiry),
+			wantErr:   moneyVO.ErrUnsupportedCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			hold, err := holdDomain.New(accountID, tt.receiverID, tt.amount, tt.currency, tt.expiresAt, now)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, hold)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotEmpty(t, hold.IDString())
+			assert.Equal(t, accountID, hold.AccountID())
+			assert.Equal(t, tt.receiverID, hold.ReceiverAccountID())
+			assert.Equal(t, tt.amount, hold.Amount().Amount())
+			assert.Equal(t, holdDomain.StatusAuthorized, hold.Status())
+			assert.Equal(t, tt.expiresAt, hold.ExpiresAt())
+			assert.Nil(t, hold.CapturedAmount())
+			assert.Nil(t, hold.TransactionID())
+			assert.Equal(t, now, hold.CreatedAt())
+			assert.Equal(t, int64(0), hold.Version())
+		})
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	var (
+		holdID        = idVO.NewHoldIDForTest("hold").String()
+		transactionID = idVO.NewTransactionIDForTest("transaction").String()
+		now           = timer.GetFixedDate()
+		captured      = int64(600)
+	)
+
+	t.Run("Positive: 確定した仮押さえを再構築できる", func(t *testing.T) {
+		hold, err := holdDomain.Reconstruct(
+			holdID, accountID.String(), strutil.StrPointer(receiverAccountID.String()), 1000, moneyVO.JPY,
+			holdDomain.StatusCaptured, now, &captured, &transactionID, now, now, 2,
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, holdID, hold.IDString())
+		assert.Equal(t, accountID.String(), hold.AccountIDString())
+		assert.Equal(t, receiverAccountID.String(), *hold.ReceiverAccountIDString())
+		assert.Equal(t, "1000", hold.Amount().Decimal())
+		assert.Equal(t, holdDomain.StatusCaptured, hold.Status())
+		assert.Equal(t, "600", *hold.CapturedAmountDecimal())
+		assert.Equal(t, transactionID, *hold.TransactionIDString())
+		assert.Equal(t, timer.GetFixedDateString(), hold.ExpiresAtString())
+		assert.Equal(t, timer.GetFixedDateString(), hold.UpdatedAtString())
+		assert.Equal(t, int64(2), hold.Version())
+	})
+
+	t.Run("Negative: 未対応の状態の場合はエラーが返る", func(t *testing.T) {
+		hold, err := holdDomain.Reconstruct(
+			holdID, accountID.String(), nil, 1000, moneyVO.JPY, "UNKNOWN", now, nil, nil, now, now, 1,
+		)
+
+		assert.ErrorIs(t, err, holdDomain.ErrUnsupportedStatus)
+		assert.Nil(t, hold)
+	})
+
+	t.Run("Negative: 取引IDが不正な場合はエラーが返る", func(t *testing.T) {
+		invalid := "invalid"
+		hold, err := holdDomain.Reconstruct(
+			holdID, accountID.String(), nil, 1000, moneyVO.JPY, holdDomain.StatusCaptured, now, &captured, &invalid, now, now, 1,
+		)
+
+		assert.ErrorIs(t, err, idVO.ErrInvalidULID)
+		assert.Nil(t, hold)
+	})
+}
+
+func TestHold_Capture(t *testing.T) {
+	var (
+		transactionID = idVO.NewTransactionIDForTest("transaction")
+		now           = timer.GetFixedDate()
+	)
+
+	tests := []struct {
+		caseName string
+		prepare  func(hold *holdDomain.Hold)
+		amount   int64
+		at       time.Time
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 仮押さえした金額の全額を確定できる",
+			prepare:  func(hold *holdDomain.Hold) {},
+			amount:   1000,
+			at:       now,
+			wantErr:  nil,
+		},
+		{
+			caseName: "Positive: 仮押さえした金額の一部を確定できる",
+			prepare:  func(hold *holdDomain.Hold) {},
+			amount:   1,
+			at:       now,
+			wantErr:  nil,
+		},
+		{
+			caseName: "Negative: 仮押さえした金額を超える場合はエラーが返る",
+			prepare:  func(hold *holdDomain.Hold) {},
+			amount:   1001,
+			at:       now,
+			wantErr:  holdDomain.ErrCaptureExceedsHold,
+		},
+		{
+			caseName: "Negative: 金額が0の場合はエラーが返る",
+			prepare:  func(hold *holdDomain.Hold) {},
+			amount:   0,
+			at:       now,
+			wantErr:  holdDomain.ErrInvalidAmount,
+		},
+		{
+			caseName: "Negative: 有効期限を過ぎている場合はエラーが返る",
+			prepare:  func(hold *holdDomain.Hold) {},
+			amount:   1000,
+			at:       now.Add(24 * time.Hour),
+			wantErr:  holdDomain.ErrExpired,
+		},
+		{
+			caseName: "Negative: 取り消した仮押さえの場合はエラーが返る",
+			prepare: func(hold *holdDomain.Hold) {
+				assert.NoError(t, hold.Void(now))
+			},
+			amount:  1000,
+			at:      now,
+			wantErr: holdDomain.ErrNotAuthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			hold := newHold(t, nil)
+			tt.prepare(hold)
+			status := hold.Status()
+
+			err := hold.Capture(tt.amount, transactionID, tt.at)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, status, hold.Status())
+				assert.Nil(t, hold.CapturedAmount())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, holdDomain.StatusCaptured, hold.Status())
+			assert.Equal(t, tt.amount, hold.CapturedAmount().Amount())
+			assert.Equal(t, transactionID, *hold.TransactionID())
+			assert.Equal(t, tt.at, hold.UpdatedAt())
+		})
+	}
+}
+
+func TestHold_Void(t *testing.T) {
+	now := timer.GetFixedDate()
+
+	t.Run("Positive: 有効期限を過ぎていても、解除されていない仮押さえは取り消せる", func(t *testing.T) {
+		hold := newHold(t, nil)
+		err := hold.Void(now.Add(48 * time.Hour))
+
+		assert.NoError(t, err)
+		assert.Equal(t, holdDomain.StatusVoided, hold.Status())
+	})
+
+	t.Run("Negative: 確定した仮押さえは取り消せない", func(t *testing.T) {
+		hold := newHold(t, nil)
+		assert.NoError(t, hold.Capture(1000, idVO.NewTransactionIDForTest("transaction"), now))
+		err := hold.Void(now)
+
+		assert.ErrorIs(t, err, holdDomain.ErrNotAuthorized)
+		assert.Equal(t, holdDomain.StatusCaptured, hold.Status())
+	})
+}
+
+func TestHold_Expire(t *testing.T) {
+	now := timer.GetFixedDate()
+
+	tests := []struct {
+		caseName string
+		prepare  func(hold *holdDomain.Hold)
+		at       time.Time
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 有効期限ちょうどの時刻に解除できる",
+			prepare:  func(hold *holdDomain.Hold) {},
+			at:       now.Add(24 * time.Hour),
+			wantErr:  nil,
+		},
+		{
+			caseName: "Negative: 有効期限の前は解除できない",
+			prepare:  func(hold *holdDomain.Hold) {},
+			at:       now.Add(24*time.Hour - time.Second),
+			wantErr:  holdDomain.ErrNotExpired,
+		},
+		{
+			caseName: "Negative: 取り消した仮押さえは解除できない",
+			prepare: func(hold *holdDomain.Hold) {
+				assert.NoError(t, hold.Void(now))
+			},
+			at:      now.Add(24 * time.Hour),
+			wantErr: holdDomain.ErrNotAuthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			hold := newHold(t, nil)
+			tt.prepare(hold)
+			assert.Equal(t, tt.wantErr == nil, hold.IsExpired(tt.at))
+
+			err := hold.Expire(tt.at)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, holdDomain.StatusExpired, hold.Status())
+			assert.False(t, hold.IsExpired(tt.at))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package ledger
+
+import (
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// Entry は1つの取引に対する仕訳を表します。通貨ごとに借方と貸方の合計が一致している必要があります。
+type Entry struct {
+	transactionID idVO.TransactionID
+	postings      []*Posting
+	postedAt      time.Time
+}
+
+func NewEntry(transactionID idVO.TransactionID, postings []*Posting, postedAt time.Time) (*Entry, error) {
+	if len(postings) < 2 {
+		return nil, ErrEmptyEntry
+	}
+
+	balances := make(map[string]int64)
+	for _, p := range postings {
+		if p.side == Debit {
+			balances[p.amount.Currency()] += p.amount.Amount()
+		} else {
+			balances[p.amount.Currency()] -= p.amount.Amount()
+		}
+	}
+	for _, b := range balances {
+		if b != 0 {
+			return nil, ErrUnbalancedEntry
+		}
+	}
+
+	return &Entry{
+		transactionID: transactionID,
+		postings:      postings,
+		postedAt:      postedAt,
+	}, nil
+}
+
+// 入金の仕訳を作成します。現金勘定を借方、顧客口座を貸方に記帳します。
+func NewDepositEntry(transactionID idVO.TransactionID, accountID idVO.AccountID, amount moneyVO.Money, postedAt time.Time) (*Entry, error) {
+	return newEntry(transactionID, postedAt,
+		func() (*Posting, error) {
+			return NewSystemPosting(SystemCash, Debit, amount.Amount(), amount.Currency())
+		},
+		func() (*Posting, error) {
+			return NewAccountPosting(accountID, Credit, amount.Amount(), amount.Currency())
+		},
+	)
+}
+
+// 出金の仕訳を作成します。顧客口座を借方、現金勘定を貸方に記帳します。
+func NewWithdrawalEntry(transactionID idVO.TransactionID, accountID idVO.AccountID, amount moneyVO.Money, postedAt time.Time) (*Entry, error) {
+	return newEntry(transactionID, postedAt,
+		func() (*Posting, error) {
+			return NewAccountPosting(accountID, Debit, amount.Amount(), amount.Currency())
+		},
+		func() (*Posting, error) {
+			return NewSystemPosting(SystemCash, Credit, amount.Amount(), amount.Currency())
+		},
+	)
+}
+
+// 振込の仕訳を作成します。送金元口座を借方、受取口座を貸方に記帳します。
+// 通貨が異なる場合は、通貨ごとに貸借が一致するように為替勘定を経由して記帳します。
+func NewTransferEntry(
+	transactionID idVO.TransactionID,
+	senderAccountID, receiverAccountID idVO.AccountID,
+	sentAmount, receivedAmount moneyVO.Money,
+	postedAt time.Time,
+) (*Entry, error) {
+	debitSender := func() (*Posting, error) {
+		return NewAccountPosting(senderAccountID, Debit, sentAmount.Amount(), sentAmount.Currency())
+	}
+	creditReceiver := func() (*Posting, error) {
+		return NewAccountPosting(receiverAccountID, Credit, receivedAmount.Amount(), receivedAmount.Currency())
+	}
+	if sentAmount.Currency() == receivedAmount.Currency() {
+		return newEntry(transactionID, postedAt, debitSender, creditReceiver)
+	}
+
+	return newEntry(transactionID, postedAt,
+		debitSender,
+		func() (*Posting, error) {
+			return NewSystemPosting(SystemFXClearing, Credit, sentAmount.Amount(), sentAmount.Currency())
+		},
+		func() (*Posting, error) {
+			return NewSystemPosting(SystemFXClearing, Debit, receivedAmount.Amount(), receivedAmount.Currency())
+		},
+		creditReceiver,
+	)
+}
+
+func newEntry(transactionID idVO.TransactionID, postedAt time.Time, builders ...func() (*Posting, error)) (*Entry, error) {
+	postings := make([]*Posting, 0, len(builders))
+	for _, build := range builders {
+		p, err := build()
+		if err != nil {
+			return nil, err
+		}
+		postings = append(postings, p)
+	}
+	return NewEntry(transactionID, postings, postedAt)
+}
+
+func (e *Entry) TransactionID() idVO.TransactionID {
+	return e.transactionID
+}
+
+func (e *Entry) TransactionIDString() string {
+	return e.transactionID.String()
+}
+
+func (e *Entry) Postings() []*Posting {
+	return e.postings
+}
+
+func (e *Entry) PostedAt() time.Time {
+	return e.postedAt
+}
@@ -0,0 +1,160 @@
+package ledger_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestNewEntry(t *testing.T) {
+	var (
+		transactionID = idVO.NewTransactionIDForTest("transaction")
+		accountID     = idVO.NewAccountIDForTest("account")
+		postedAt      = timer.GetFixedDate()
+	)
+	posting := func(side string, amount int64, currency string) *ledgerDomain.Posting {
+		p, err := ledgerDomain.NewAccountPosting(accountID, side, amount, currency)
+		assert.NoError(t, err)
+		return p
+	}
+
+	tests := []struct {
+		caseName string
+		postings []*ledgerDomain.Posting
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 借方と貸方の合計が一致する場合は仕訳を作成できる",
+			postings: []*ledgerDomain.Posting{
+				posting(ledgerDomain.Debit, 1000, moneyVO.JPY),
+				posting(ledgerDomain.Credit, 1000, moneyVO.JPY),
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Positive: 通貨ごとに借方と貸方の合計が一致する場合は仕訳を作成できる",
+			postings: []*ledgerDomain.Posting{
+				posting(ledgerDomain.Debit, 1000, moneyVO.JPY),
+				posting(ledgerDomain.Credit, 1000, moneyVO.JPY),
+				posting(ledgerDomain.Debit, 667, moneyVO.USD),
+				posting(ledgerDomain.Credit, 667, moneyVO.USD),
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: 記帳が1行しかない場合はエラーが返る",
+			postings: []*ledgerDomain.Posting{
+				posting(ledgerDomain.Debit, 1000, moneyVO.JPY),
+			},
+			errMsg: ledgerDomain.ErrEmptyEntry.Error(),
+		},
+		{
+			caseName: "Negative: 借方と貸方の合計が一致しない場合はエラーが返る",
+			postings: []*ledgerDomain.Posting{
+				posting(ledgerDomain.Debit, 1000, moneyVO.JPY),
+				posting(ledgerDomain.Credit, 999, moneyVO.JPY),
+			},
+			errMsg: ledgerDomain.ErrUnbalancedEntry.Error(),
+		},
+		{
+			caseName: "Negative: 通貨をまたいで貸借を一致させている場合はエラーが返る",
+			postings: []*ledgerDomain.Posting{
+				posting(ledgerDomain.Debit, 1000, moneyVO.JPY),
+				posting(ledgerDomain.Credit, 1000, moneyVO.USD),
+			},
+			errMsg: ledgerDomain.ErrUnbalancedEntry.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			entry, err := ledgerDomain.NewEntry(transactionID, tt.postings, postedAt)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, entry)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, transactionID, entry.TransactionID())
+				assert.Equal(t, tt.postings, entry.Postings())
+				assert.Equal(t, postedAt, entry.PostedAt())
+			}
+		})
+	}
+}
+
+func TestNewTransactionEntries(t *testing.T) {
+	var (
+		transactionID     = idVO.NewTransactionIDForTest("transaction")
+		accountID         = idVO.NewAccountIDForTest("account")
+		receiverAccountID = idVO.NewAccountIDForTest("receiver")
+		postedAt          = timer.GetFixedDate()
+	)
+	jpy, err := moneyVO.New(1000, moneyVO.JPY)
+	assert.NoError(t, err)
+	usd, err := moneyVO.New(667, moneyVO.USD)
+	assert.NoError(t, err)
+
+	// 口座ごとの残高の増減と、システム勘定への記帳を比較しやすい形に変換する
+	type line struct {
+		account string
+		side    string
+		amount  int64
+		curr    string
+	}
+	lines := func(entry *ledgerDomain.Entry) []line {
+		var result []line
+		for _, p := range entry.Postings() {
+			account := ""
+			if p.AccountID() != nil {
+				account = p.AccountID().String()
+			} else {
+				account = *p.SystemAccount()
+			}
+			result = append(result, line{account, p.Side(), p.Amount().Amount(), p.Amount().Currency()})
+		}
+		return result
+	}
+
+	t.Run("Positive: 入金は現金勘定を借方、口座を貸方に記帳する", func(t *testing.T) {
+		entry, err := ledgerDomain.NewDepositEntry(transactionID, accountID, *jpy, postedAt)
+		assert.NoError(t, err)
+		assert.Equal(t, []line{
+			{ledgerDomain.SystemCash, ledgerDomain.Debit, 1000, moneyVO.JPY},
+			{accountID.String(), ledgerDomain.Credit, 1000, moneyVO.JPY},
+		}, lines(entry))
+	})
+
+	t.Run("Positive: 出金は口座を借方、現金勘定を貸方に記帳する", func(t *testing.T) {
+		entry, err := ledgerDomain.NewWithdrawalEntry(transactionID, accountID, *jpy, postedAt)
+		assert.NoError(t, err)
+		assert.Equal(t, []line{
+			{accountID.String(), ledgerDomain.Debit, 1000, moneyVO.JPY},
+			{ledgerDomain.SystemCash, ledgerDomain.Credit, 1000, moneyVO.JPY},
+		}, lines(entry))
+	})
+
+	t.Run("Positive: 同じ通貨の振込は送金元を借方、受取口座を貸方に記帳する", func(t *testing.T) {
+		entry, err := ledgerDomain.NewTransferEntry(transactionID, accountID, receiverAccountID, *jpy, *jpy, postedAt)
+		assert.NoError(t, err)
+		assert.Equal(t, []line{
+			{accountID.String(), ledgerDomain.Debit, 1000, moneyVO.JPY},
+			{receiverAccountID.String(), ledgerDomain.Credit, 1000, moneyVO.JPY},
+		}, lines(entry))
+	})
+
+	t.Run("Positive: 異なる通貨の振込は為替勘定を経由して記帳する", func(t *testing.T) {
+		entry, err := ledgerDomain.NewTransferEntry(transactionID, accountID, receiverAccountID, *jpy, *usd, postedAt)
+		assert.NoError(t, err)
+		assert.Equal(t, []line{
+			{accountID.String(), ledgerDomain.Debit, 1000, moneyVO.JPY},
+			{ledgerDomain.SystemFXClearing, ledgerDomain.Credit, 1000, moneyVO.JPY},
+			{ledgerDomain.SystemFXClearing, ledgerDomain.Debit, 667, moneyVO.USD},
+			{receiverAccountID.String(), ledgerDomain.Credit, 667, moneyVO.USD},
+		}, lines(entry))
+	})
+}
@@ -0,0 +1,29 @@
+package ledger
+
+import (
+	"context"
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type ILedgerRepository interface {
+	Save(ctx context.Context, entry *Entry) error
+
+	// 顧客口座の記帳から残高（通貨の最小単位）を算出します。記帳が無い場合は 0 を返します。
+	BalanceByAccountID(ctx context.Context, accountID idVO.AccountID, currency string) (int64, error)
+
+	// 指定日時より前に記帳された分のみから残高（通貨の最小単位）を算出します。取引明細の期首・期末残高に使用します。
+	BalanceByAccountIDBefore(ctx context.Context, accountID idVO.AccountID, currency string, before time.Time) (int64, error)
+
+	// 保存されている残高と記帳から算出した残高が一致しない口座を返します。
+	ListBalanceMismatches(ctx context.Context) ([]*BalanceMismatch, error)
+}
+
+// BalanceMismatch は保存されている口座残高と、記帳から算出した残高の差異を表します。
+type BalanceMismatch struct {
+	AccountID     string
+	Currency      string
+	StoredBalance int64
+	LedgerBalance int64
+}
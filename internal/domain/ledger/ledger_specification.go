@@ -0,0 +1,40 @@
+package ledger
+
+import (
+	"errors"
+)
+
+// 仕訳の貸借区分
+const (
+	Debit  = "DEBIT"
+	Credit = "CREDIT"
+)
+
+// システム勘定
+// 顧客口座への入金・出金は現金勘定、異なる通貨間の振込は通貨ごとに為替勘定を相手勘定として記帳します。
+const (
+	SystemCash       = "SYSTEM_CASH"
+	SystemFXClearing = "SYSTEM_FX_CLEARING"
+)
+
+var (
+	ErrInvalidSide          = errors.New("posting side must be DEBIT or CREDIT")
+	ErrInvalidSystemAccount = errors.New("unsupported system account")
+	ErrInvalidAmount        = errors.New("posting amount must be greater than 0")
+	ErrEmptyEntry           = errors.New("journal entry must have at least two postings")
+	ErrUnbalancedEntry      = errors.New("journal entry is not balanced: total debits must equal total credits in each currency")
+)
+
+func validSide(side string) error {
+	if side != Debit && side != Credit {
+		return ErrInvalidSide
+	}
+	return nil
+}
+
+func validSystemAccount(systemAccount string) error {
+	if systemAccount != SystemCash && systemAccount != SystemFXClearing {
+		return ErrInvalidSystemAccount
+	}
+	return nil
+}
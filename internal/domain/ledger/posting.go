@@ -0,0 +1,78 @@
+package ledger
+
+import (
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// Posting は仕訳の1行を表します。顧客口座またはシステム勘定のどちらか一方に対して記帳します。
+type Posting struct {
+	accountID     *idVO.AccountID
+	systemAccount *string
+	side          string
+	amount        moneyVO.Money
+}
+
+// 顧客口座に対する記帳を作成します。金額は通貨の最小単位で指定します。
+func NewAccountPosting(accountID idVO.AccountID, side string, amount int64, currency string) (*Posting, error) {
+	return newPosting(&accountID, nil, side, amount, currency)
+}
+
+// システム勘定に対する記帳を作成します。金額は通貨の最小単位で指定します。
+func NewSystemPosting(systemAccount, side string, amount int64, currency string) (*Posting, error) {
+	if err := validSystemAccount(systemAccount); err != nil {
+		return nil, err
+	}
+	return newPosting(nil, &systemAccount, side, amount, currency)
+}
+
+func newPosting(accountID *idVO.AccountID, systemAccount *string, side string, amount int64, currency string) (*Posting, error) {
+	if err := validSide(side); err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	money, err := moneyVO.New(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &Posting{
+		accountID:     accountID,
+		systemAccount: systemAccount,
+		side:          side,
+		amount:        *money,
+	}, nil
+}
+
+func (p *Posting) AccountID() *idVO.AccountID {
+	return p.accountID
+}
+
+func (p *Posting) AccountIDString() *string {
+	if p.accountID == nil {
+		return nil
+	}
+	id := p.accountID.String()
+	return &id
+}
+
+func (p *Posting) SystemAccount() *string {
+	return p.systemAccount
+}
+
+func (p *Posting) Side() string {
+	return p.side
+}
+
+func (p *Posting) Amount() moneyVO.Money {
+	return p.amount
+}
+
+// 顧客口座の残高に対する増減額を返します。顧客口座は負債勘定の為、貸方（CREDIT）で増加し、借方（DEBIT）で減少します。
+func (p *Posting) SignedAmount() int64 {
+	if p.side == Credit {
+		return p.amount.Amount()
+	}
+	return -p.amount.Amount()
+}
@@ -0,0 +1,118 @@
+package ledger_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func TestNewAccountPosting(t *testing.T) {
+	accountID := idVO.NewAccountIDForTest("account")
+
+	tests := []struct {
+		caseName   string
+		side       string
+		amount     int64
+		currency   string
+		wantSigned int64
+		errMsg     string
+	}{
+		{
+			caseName:   "Positive: 貸方の記帳は残高を増加させる",
+			side:       ledgerDomain.Credit,
+			amount:     1000,
+			currency:   moneyVO.JPY,
+			wantSigned: 1000,
+			errMsg:     "",
+		},
+		{
+			caseName:   "Positive: 借方の記帳は残高を減少させる",
+			side:       ledgerDomain.Debit,
+			amount:     1000,
+			currency:   moneyVO.JPY,
+			wantSigned: -1000,
+			errMsg:     "",
+		},
+		{
+			caseName: "Negative: 貸借区分が不正な場合はエラーが返る",
+			side:     "INVALID",
+			amount:   1000,
+			currency: moneyVO.JPY,
+			errMsg:   ledgerDomain.ErrInvalidSide.Error(),
+		},
+		{
+			caseName: "Negative: 金額が0の場合はエラーが返る",
+			side:     ledgerDomain.Credit,
+			amount:   0,
+			currency: moneyVO.JPY,
+			errMsg:   ledgerDomain.ErrInvalidAmount.Error(),
+		},
+		{
+			caseName: "Negative: サポートされていない通貨の場合はエラーが返る",
+			side:     ledgerDomain.Credit,
+			amount:   1000,
+			currency: "XXX",
+			errMsg:   moneyVO.ErrUnsupportedCurrency.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			p, err := ledgerDomain.NewAccountPosting(accountID, tt.side, tt.amount, tt.currency)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, p)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, accountID, *p.AccountID())
+				assert.Nil(t, p.SystemAccount())
+				assert.Equal(t, tt.side, p.Side())
+				assert.Equal(t, tt.amount, p.Amount().Amount())
+				assert.Equal(t, tt.wantSigned, p.SignedAmount())
+			}
+		})
+	}
+}
+
+func TestNewSystemPosting(t *testing.T) {
+	tests := []struct {
+		caseName      string
+		systemAccount string
+		errMsg        string
+	}{
+		{
+			caseName:      "Positive: 現金勘定に記帳できる",
+			systemAccount: ledgerDomain.SystemCash,
+			errMsg:        "",
+		},
+		{
+			caseName:      "Positive: 為替勘定に記帳できる",
+			systemAccount: ledgerDomain.SystemFXClearing,
+			errMsg:        "",
+		},
+		{
+			caseName:      "Negative: サポートされていないシステム勘定の場合はエラーが返る",
+			systemAccount: "SYSTEM_UNKNOWN",
+			errMsg:        ledgerDomain.ErrInvalidSystemAccount.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			p, err := ledgerDomain.NewSystemPosting(tt.systemAccount, ledgerDomain.Debit, 1000, moneyVO.JPY)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, p)
+			} else {
+				assert.NoError(t, err)
+				assert.Nil(t, p.AccountID())
+				assert.Equal(t, tt.systemAccount, *p.SystemAccount())
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package limit
+
+import (
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// AccountLimit は利用者が自分の口座に設定した取引金額の上限を表します。
+// 口座と取引種別ごとに1つだけ設定でき、既定の上限より厳しい値のみ設定できます。
+type AccountLimit struct {
+	accountID idVO.AccountID
+	limits    Limits
+	updatedAt time.Time
+}
+
+func New(accountID idVO.AccountID, limits Limits, now time.Time) *AccountLimit {
+	return &AccountLimit{
+		accountID: accountID,
+		limits:    limits,
+		updatedAt: now,
+	}
+}
+
+// データベースから口座の上限を再構築します。
+func Reconstruct(
+	accountID, operationType string,
+	perTransaction, daily, monthly int64, currency string,
+	updatedAt time.Time,
+) (*AccountLimit, error) {
+	aID, err := idVO.AccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := NewLimits(operationType, perTransaction, daily, monthly, currency)
+	if err != nil {
+		return nil, err
+	}
+	return New(aID, *limits, updatedAt), nil
+}
+
+func (a *AccountLimit) AccountID() idVO.AccountID {
+	return a.accountID
+}
+
+func (a *AccountLimit) AccountIDString() string {
+	return a.accountID.String()
+}
+
+func (a *AccountLimit) OperationType() string {
+	return a.limits.OperationType()
+}
+
+func (a *AccountLimit) Limits() Limits {
+	return a.limits
+}
+
+func (a *AccountLimit) UpdatedAt() time.Time {
+	return a.updatedAt
+}
+
+func (a *AccountLimit) UpdatedAtString() string {
+	return timer.FormatToISO8601(a.updatedAt)
+}
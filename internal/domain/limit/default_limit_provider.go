@@ -0,0 +1,8 @@
+package limit
+
+import "context"
+
+type IDefaultLimitProvider interface {
+	// 取引種別と通貨の既定の上限を返します。既定の上限が設定されていない場合は nil を返します。
+	Default(ctx context.Context, operationType, currency string) (*Limits, error)
+}
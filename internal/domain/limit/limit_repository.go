@@ -0,0 +1,14 @@
+package limit
+
+import (
+	"context"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IAccountLimitRepository interface {
+	// 口座と取引種別ごとに保存します。既に設定されている場合は上書きします。
+	Save(ctx context.Context, accountLimit *AccountLimit) error
+	// 設定されていない場合は nil を返します。
+	Find(ctx context.Context, accountID idVO.AccountID, operationType string) (*AccountLimit, error)
+}
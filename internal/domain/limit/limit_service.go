@@ -0,0 +1,81 @@
+package limit
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type ILimitService interface {
+	// 口座に適用される上限を返します。既定の上限と口座の上限の両方がある場合は、上限ごとに小さい方を適用します。
+	// どちらも設定されていない場合は上限がない為 nil を返します。
+	GetEffective(ctx context.Context, accountID idVO.AccountID, operationType, currency string) (*Limits, error)
+	// 既定の上限を返します。設定されていない場合は nil を返します。
+	GetDefault(ctx context.Context, operationType, currency string) (*Limits, error)
+	// 口座の上限を設定します。既定の上限がある場合は、それを超える上限は設定できません。
+	Set(ctx context.Context, account *accountDomain.Account, limits Limits) (*AccountLimit, error)
+}
+
+type limitService struct {
+	accountLimitRepo     IAccountLimitRepository
+	defaultLimitProvider IDefaultLimitProvider
+}
+
+func NewService(
+	accountLimitRepository IAccountLimitRepository,
+	defaultLimitProvider IDefaultLimitProvider,
+) ILimitService {
+	return &limitService{
+		accountLimitRepo:     accountLimitRepository,
+		defaultLimitProvider: defaultLimitProvider,
+	}
+}
+
+func (s *limitService) GetEffective(ctx context.Context, accountID idVO.AccountID, operationType, currency string) (*Limits, error) {
+	defaultLimits, err := s.GetDefault(ctx, operationType, currency)
+	if err != nil {
+		return nil, err
+	}
+	accountLimit, err := s.accountLimitRepo.Find(ctx, accountID, operationType)
+	if err != nil {
+		return nil, err
+	}
+
+	if accountLimit == nil {
+		return defaultLimits, nil
+	}
+	limits := accountLimit.Limits()
+	if defaultLimits != nil {
+		// 口座の上限を設定した後に既定の上限が引き下げられた場合も、既定の上限を超えないようにする
+		limits = limits.Tighten(*defaultLimits)
+	}
+	return &limits, nil
+}
+
+func (s *limitService) GetDefault(ctx context.Context, operationType, currency string) (*Limits, error) {
+	if err := validOperationType(operationType); err != nil {
+		return nil, err
+	}
+	return s.defaultLimitProvider.Default(ctx, operationType, currency)
+}
+
+func (s *limitService) Set(ctx context.Context, account *accountDomain.Account, limits Limits) (*AccountLimit, error) {
+	if limits.Currency() != account.Balance().Currency() {
+		return nil, ErrDifferentCurrency
+	}
+	defaultLimits, err := s.GetDefault(ctx, limits.OperationType(), limits.Currency())
+	if err != nil {
+		return nil, err
+	}
+	if defaultLimits != nil && !limits.Within(*defaultLimits) {
+		return nil, ErrLooserThanDefault
+	}
+
+	accountLimit := New(account.ID(), limits, timer.Now())
+	if err := s.accountLimitRepo.Save(ctx, accountLimit); err != nil {
+		return nil, err
+	}
+	return accountLimit, nil
+}
@@ -0,0 +1,197 @@
+package limit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	"github.com/u104rak1/pocgo/internal/domain/mock"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type Mocks struct {
+	accountLimitRepo     *mock.MockIAccountLimitRepository
+	defaultLimitProvider *mock.MockIDefaultLimitProvider
+}
+
+func newService(t *testing.T) (limitDomain.ILimitService, Mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mocks := Mocks{
+		accountLimitRepo:     mock.NewMockIAccountLimitRepository(ctrl),
+		defaultLimitProvider: mock.NewMockIDefaultLimitProvider(ctrl),
+	}
+	return limitDomain.NewService(mocks.accountLimitRepo, mocks.defaultLimitProvider), mocks
+}
+
+func mustLimits(t *testing.T, perTransaction, daily, monthly int64) *limitDomain.Limits {
+	t.Helper()
+	limits, err := limitDomain.NewLimits(limitDomain.OperationWithdrawal, perTransaction, daily, monthly, moneyVO.JPY)
+	assert.NoError(t, err)
+	return limits
+}
+
+func TestGetEffective(t *testing.T) {
+	var (
+		arg       = gomock.Any()
+		accountID = idVO.NewAccountIDForTest("account")
+	)
+
+	tests := []struct {
+		caseName      string
+		operationType string
+		prepare       func(mocks Mocks)
+		want          *limitDomain.Limits
+		wantErr       error
+	}{
+		{
+			caseName:      "Positive: 口座の上限がない場合は既定の上限が返る",
+			operationType: limitDomain.OperationWithdrawal,
+			prepare: func(mocks Mocks) {
+				mocks.defaultLimitProvider.EXPECT().Default(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(mustLimits(t, 1000, 3000, 10000), nil)
+				mocks.accountLimitRepo.EXPECT().Find(arg, accountID, limitDomain.OperationWithdrawal).Return(nil, nil)
+			},
+			want: mustLimits(t, 1000, 3000, 10000),
+		},
+		{
+			caseName:      "Positive: 口座の上限がある場合は上限ごとに既定の上限と小さい方が返る",
+			operationType: limitDomain.OperationWithdrawal,
+			prepare: func(mocks Mocks) {
+				mocks.defaultLimitProvider.EXPECT().Default(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(mustLimits(t, 1000, 3000, 10000), nil)
+				accountLimit := limitDomain.New(accountID, *mustLimits(t, 500, 5000, 5000), timer.GetFixedDate())
+				mocks.accountLimitRepo.EXPECT().Find(arg, accountID, limitDomain.OperationWithdrawal).Return(accountLimit, nil)
+			},
+			want: mustLimits(t, 500, 3000, 5000),
+		},
+		{
+			caseName:      "Positive: 既定の上限がない場合は口座の上限が返る",
+			operationType: limitDomain.OperationWithdrawal,
+			prepare: func(mocks Mocks) {
+				mocks.defaultLimitProvider.EXPECT().Default(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, nil)
+				accountLimit := limitDomain.New(accountID, *mustLimits(t, 500, 5000, 5000), timer.GetFixedDate())
+				mocks.accountLimitRepo.EXPECT().Find(arg, accountID, limitDomain.OperationWithdrawal).Return(accountLimit, nil)
+			},
+			want: mustLimits(t, 500, 5000, 5000),
+		},
+		{
+			caseName:      "Positive: どちらの上限もない場合は nil が返る",
+			operationType: limitDomain.OperationWithdrawal,
+			prepare: func(mocks Mocks) {
+				mocks.defaultLimitProvider.EXPECT().Default(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, nil)
+				mocks.accountLimitRepo.EXPECT().Find(arg, accountID, limitDomain.OperationWithdrawal).Return(nil, nil)
+			},
+			want: nil,
+		},
+		{
+			caseName:      "Negative: 上限を設定できない取引種別の場合はエラーが返る",
+			operationType: "DEPOSIT",
+			prepare:       func(mocks Mocks) {},
+			wantErr:       limitDomain.ErrUnsupportedOperationType,
+		},
+		{
+			caseName:      "Negative: 口座の上限の取得に失敗した場合はエラーが返る",
+			operationType: limitDomain.OperationWithdrawal,
+			prepare: func(mocks Mocks) {
+				mocks.defaultLimitProvider.EXPECT().Default(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, nil)
+				mocks.accountLimitRepo.EXPECT().Find(arg, accountID, limitDomain.OperationWithdrawal).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			service, mocks := newService(t)
+			tt.prepare(mocks)
+
+			limits, err := service.GetEffective(context.Background(), accountID, tt.operationType, moneyVO.JPY)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, limits)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, limits)
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	arg := gomock.Any()
+
+	account, err := accountDomain.New(idVO.NewUserIDForTest("user"), 0, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	usdLimits, err := limitDomain.NewLimits(limitDomain.OperationWithdrawal, 100, 300, 1000, moneyVO.USD)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName string
+		limits   limitDomain.Limits
+		prepare  func(mocks Mocks)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 既定の上限以下の上限を設定できる",
+			limits:   *mustLimits(t, 500, 3000, 5000),
+			prepare: func(mocks Mocks) {
+				mocks.defaultLimitProvider.EXPECT().Default(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(mustLimits(t, 1000, 3000, 10000), nil)
+				mocks.accountLimitRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Positive: 既定の上限がない場合は任意の上限を設定できる",
+			limits:   *mustLimits(t, 50000, 300000, 1000000),
+			prepare: func(mocks Mocks) {
+				mocks.defaultLimitProvider.EXPECT().Default(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, nil)
+				mocks.accountLimitRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 既定の上限を超える上限は設定できない",
+			limits:   *mustLimits(t, 500, 5000, 5000),
+			prepare: func(mocks Mocks) {
+				mocks.defaultLimitProvider.EXPECT().Default(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(mustLimits(t, 1000, 3000, 10000), nil)
+			},
+			wantErr: limitDomain.ErrLooserThanDefault,
+		},
+		{
+			caseName: "Negative: 口座と異なる通貨の上限は設定できない",
+			limits:   *usdLimits,
+			prepare:  func(mocks Mocks) {},
+			wantErr:  limitDomain.ErrDifferentCurrency,
+		},
+		{
+			caseName: "Negative: 保存に失敗した場合はエラーが返る",
+			limits:   *mustLimits(t, 500, 3000, 5000),
+			prepare: func(mocks Mocks) {
+				mocks.defaultLimitProvider.EXPECT().Default(arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, nil)
+				mocks.accountLimitRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			service, mocks := newService(t)
+			tt.prepare(mocks)
+
+			accountLimit, err := service.Set(context.Background(), account, tt.limits)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, accountLimit)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, account.ID(), accountLimit.AccountID())
+			assert.Equal(t, tt.limits, accountLimit.Limits())
+		})
+	}
+}
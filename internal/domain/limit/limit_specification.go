@@ -0,0 +1,62 @@
+package limit
+
+import (
+	"errors"
+	"time"
+)
+
+// 上限を設定できる取引種別です。値は取引の種別と同じです。
+const (
+	OperationWithdrawal = "WITHDRAWAL"
+	OperationTransfer   = "TRANSFER"
+)
+
+// 上限を設定できる取引種別の一覧です。
+var OperationTypes = []string{OperationWithdrawal, OperationTransfer}
+
+// 上限の期間
+const (
+	// 1回の取引の金額の上限です。
+	WindowPerTransaction = "PER_TRANSACTION"
+	// 直近24時間の取引の合計金額の上限です。
+	WindowDaily = "DAILY"
+	// 当月 (UTC) の取引の合計金額の上限です。
+	WindowMonthly = "MONTHLY"
+)
+
+// 1日の上限の集計期間です。暦日ではなく、取引時点から遡った24時間で集計します。
+const DailyWindow = 24 * time.Hour
+
+var (
+	ErrLimitExceeded            = errors.New("transaction limit exceeded")
+	ErrInvalidLimit             = errors.New("limit must be greater than 0")
+	ErrInconsistentLimits       = errors.New("limits must satisfy per transaction <= daily <= monthly")
+	ErrLooserThanDefault        = errors.New("limit cannot exceed the default limit")
+	ErrUnsupportedOperationType = errors.New("unsupported operation type for limits")
+	ErrDifferentCurrency        = errors.New("limit currency must be the same as the account currency")
+)
+
+// 当月の集計の起点となる、now が属する月の初日 (UTC) を返します。
+func StartOfMonth(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func validOperationType(operationType string) error {
+	for _, t := range OperationTypes {
+		if t == operationType {
+			return nil
+		}
+	}
+	return ErrUnsupportedOperationType
+}
+
+func validLimits(perTransaction, daily, monthly int64) error {
+	if perTransaction <= 0 || daily <= 0 || monthly <= 0 {
+		return ErrInvalidLimit
+	}
+	if perTransaction > daily || daily > monthly {
+		return ErrInconsistentLimits
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package limit
+
+import (
+	"fmt"
+	"strings"
+
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// Limits は取引種別と通貨ごとの取引金額の上限を表します。
+// 1回の取引の上限、直近24時間の合計の上限、当月の合計の上限の3つを持ちます。
+type Limits struct {
+	operationType  string
+	perTransaction moneyVO.Money
+	daily          moneyVO.Money
+	monthly        moneyVO.Money
+}
+
+// 上限を作成します。金額は通貨の最小単位で指定し、1回 <= 1日 <= 1ヶ月 の関係を満たす必要があります。
+func NewLimits(operationType string, perTransaction, daily, monthly int64, currency string) (*Limits, error) {
+	if err := validOperationType(operationType); err != nil {
+		return nil, err
+	}
+	if err := validLimits(perTransaction, daily, monthly); err != nil {
+		return nil, err
+	}
+
+	perTransactionMoney, err := moneyVO.New(perTransaction, currency)
+	if err != nil {
+		return nil, err
+	}
+	dailyMoney, err := moneyVO.New(daily, currency)
+	if err != nil {
+		return nil, err
+	}
+	monthlyMoney, err := moneyVO.New(monthly, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Limits{
+		operationType:  operationType,
+		perTransaction: *perTransactionMoney,
+		daily:          *dailyMoney,
+		monthly:        *monthlyMoney,
+	}, nil
+}
+
+func (l Limits) OperationType() string {
+	return l.operationType
+}
+
+func (l Limits) Currency() string {
+	return l.perTransaction.Currency()
+}
+
+func (l Limits) PerTransaction() moneyVO.Money {
+	return l.perTransaction
+}
+
+func (l Limits) Daily() moneyVO.Money {
+	return l.daily
+}
+
+func (l Limits) Monthly() moneyVO.Money {
+	return l.monthly
+}
+
+// 全ての上限が other の上限以下の場合に true を返します。
+func (l Limits) Within(other Limits) bool {
+	return l.perTransaction.Amount() <= other.perTransaction.Amount() &&
+		l.daily.Amount() <= other.daily.Amount() &&
+		l.monthly.Amount() <= other.monthly.Amount()
+}
+
+// 上限ごとに other と比べて小さい方を取った上限を返します。通貨と取引種別は同じである必要があります。
+func (l Limits) Tighten(other Limits) Limits {
+	tightened := l
+	if other.perTransaction.Amount() < l.perTransaction.Amount() {
+		tightened.perTransaction = other.perTransaction
+	}
+	if other.daily.Amount() < l.daily.Amount() {
+		tightened.daily = other.daily
+	}
+	if other.monthly.Amount() < l.monthly.Amount() {
+		tightened.monthly = other.monthly
+	}
+	return tightened
+}
+
+// 直近24時間と当月の利用済みの金額に amount を加えても上限を超えないかを検証します。
+// 超える場合は、超えた上限とその時点で取引できる残りの金額を持つ *ExceededError を返します。
+func (l Limits) Check(amount, dailyUsed, monthlyUsed int64) error {
+	remaining := min(
+		l.perTransaction.Amount(),
+		max(l.daily.Amount()-dailyUsed, 0),
+		max(l.monthly.Amount()-monthlyUsed, 0),
+	)
+
+	var exceeded *moneyVO.Money
+	var window string
+	switch {
+	case amount > l.perTransaction.Amount():
+		exceeded, window = &l.perTransaction, WindowPerTransaction
+	case dailyUsed+amount > l.daily.Amount():
+		exceeded, window = &l.daily, WindowDaily
+	case monthlyUsed+amount > l.monthly.Amount():
+		exceeded, window = &l.monthly, WindowMonthly
+	default:
+		return nil
+	}
+
+	remainingMoney, err := moneyVO.New(remaining, l.Currency())
+	if err != nil {
+		return err
+	}
+	return &ExceededError{
+		OperationType: l.operationType,
+		Window:        window,
+		Limit:         *exceeded,
+		Remaining:     *remainingMoney,
+	}
+}
+
+// ExceededError は取引が上限を超えたことを表します。errors.Is で ErrLimitExceeded と一致します。
+type ExceededError struct {
+	OperationType string
+	// 超えた上限の期間 (WindowPerTransaction, WindowDaily, WindowMonthly) です。
+	Window string
+	Limit  moneyVO.Money
+	// 全ての上限を考慮して、現時点で取引できる残りの金額です。
+	Remaining moneyVO.Money
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("%s: %s limit of %s %s for %s, remaining allowance is %s %s",
+		ErrLimitExceeded.Error(),
+		strings.ToLower(strings.ReplaceAll(e.Window, "_", " ")),
+		e.Limit.Decimal(), e.Limit.Currency(),
+		e.OperationType,
+		e.Remaining.Decimal(), e.Remaining.Currency(),
+	)
+}
+
+func (e *ExceededError) Is(target error) bool {
+	return target == ErrLimitExceeded
+}
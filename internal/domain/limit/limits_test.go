@@ -0,0 +1,205 @@
+package limit_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// 1回 1000 円、1日 3000 円、1ヶ月 10000 円の出金の上限を作成します。
+func newLimits(t *testing.T) limitDomain.Limits {
+	t.Helper()
+	limits, err := limitDomain.NewLimits(limitDomain.OperationWithdrawal, 1000, 3000, 10000, moneyVO.JPY)
+	assert.NoError(t, err)
+	return *limits
+}
+
+func TestNewLimits(t *testing.T) {
+	tests := []struct {
+		caseName       string
+		operationType  string
+		perTransaction int64
+		daily          int64
+		monthly        int64
+		currency       string
+		wantErr        error
+	}{
+		{
+			caseName:       "Positive: 上限を作成できる",
+			operationType:  limitDomain.OperationTransfer,
+			perTransaction: 1000,
+			daily:          3000,
+			monthly:        10000,
+			currency:       moneyVO.JPY,
+			wantErr:        nil,
+		},
+		{
+			caseName:       "Positive: 全ての上限が同じ金額でも作成できる",
+			operationType:  limitDomain.OperationWithdrawal,
+			perTransaction: 1000,
+			daily:          1000,
+			monthly:        1000,
+			currency:       moneyVO.JPY,
+			wantErr:        nil,
+		},
+		{
+			caseName:       "Negative: 上限を設定できない取引種別の場合はエラーが返る",
+			operationType:  "DEPOSIT",
+			perTransaction: 1000,
+			daily:          3000,
+			monthly:        10000,
+			currency:       moneyVO.JPY,
+			wantErr:        limitDomain.ErrUnsupportedOperationType,
+		},
+		{
+			caseName:       "Negative: 上限が0の場合はエラーが返る",
+			operationType:  limitDomain.OperationWithdrawal,
+			perTransaction: 0,
+			daily:          3000,
+			monthly:        10000,
+			currency:       moneyVO.JPY,
+			wantErr:        limitDomain.ErrInvalidLimit,
+		},
+		{
+			caseName:       "Negative: 1回の上限が1日の上限を超える場合はエラーが返る",
+			operationType:  limitDomain.OperationWithdrawal,
+			perTransaction: 5000,
+			daily:          3000,
+			monthly:        10000,
+			currency:       moneyVO.JPY,
+			wantErr:        limitDomain.ErrInconsistentLimits,
+		},
+		{
+			caseName:       "Negative: 1日の上限が1ヶ月の上限を超える場合はエラーが返る",
+			operationType:  limitDomain.OperationWithdrawal,
+			perTransaction: 1000,
+			daily:          30000,
+			monthly:        10000,
+			currency:       moneyVO.JPY,
+			wantErr:        limitDomain.ErrInconsistentLimits,
+		},
+		{
+			caseName:       "Negative: 対応していない通貨の場合はエラーが返る",
+			operationType:  limitDomain.OperationWithdrawal,
+			perTransaction: 1000,
+			daily:          3000,
+			monthly:        10000,
+			currency:       "XXX",
+			wantErr:        moneyVO.ErrUnsupportedCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			limits, err := limitDomain.NewLimits(tt.operationType, tt.perTransaction, tt.daily, tt.monthly, tt.currency)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, limits)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.operationType, limits.OperationType())
+			assert.Equal(t, tt.currency, limits.Currency())
+			assert.Equal(t, tt.perTransaction, limits.PerTransaction().Amount())
+			assert.Equal(t, tt.daily, limits.Daily().Amount())
+			assert.Equal(t, tt.monthly, limits.Monthly().Amount())
+		})
+	}
+}
+
+func TestLimits_Check(t *testing.T) {
+	limits := newLimits(t)
+
+	tests := []struct {
+		caseName      string
+		amount        int64
+		dailyUsed     int64
+		monthlyUsed   int64
+		wantWindow    string
+		wantRemaining int64
+		wantMessage   string
+	}{
+		{
+			caseName:    "Positive: 全ての上限の範囲内の場合はエラーが返らない",
+			amount:      1000,
+			dailyUsed:   2000,
+			monthlyUsed: 9000,
+		},
+		{
+			caseName:      "Negative: 1回の上限を超える場合は残りの金額を含むエラーが返る",
+			amount:        1001,
+			wantWindow:    limitDomain.WindowPerTransaction,
+			wantRemaining: 1000,
+			wantMessage:   "transaction limit exceeded: per transaction limit of 1000 JPY for WITHDRAWAL, remaining allowance is 1000 JPY",
+		},
+		{
+			caseName:      "Negative: 1日の上限を超える場合は1日の残りの金額を含むエラーが返る",
+			amount:        1000,
+			dailyUsed:     2500,
+			monthlyUsed:   2500,
+			wantWindow:    limitDomain.WindowDaily,
+			wantRemaining: 500,
+			wantMessage:   "transaction limit exceeded: daily limit of 3000 JPY for WITHDRAWAL, remaining allowance is 500 JPY",
+		},
+		{
+			caseName:      "Negative: 1ヶ月の上限を超える場合は1ヶ月の残りの金額を含むエラーが返る",
+			amount:        1000,
+			dailyUsed:     0,
+			monthlyUsed:   9800,
+			wantWindow:    limitDomain.WindowMonthly,
+			wantRemaining: 200,
+			wantMessage:   "transaction limit exceeded: monthly limit of 10000 JPY for WITHDRAWAL, remaining allowance is 200 JPY",
+		},
+		{
+			caseName:      "Negative: 上限を使い切っている場合は残りの金額が0のエラーが返る",
+			amount:        1,
+			dailyUsed:     3500,
+			monthlyUsed:   3500,
+			wantWindow:    limitDomain.WindowDaily,
+			wantRemaining: 0,
+			wantMessage:   "transaction limit exceeded: daily limit of 3000 JPY for WITHDRAWAL, remaining allowance is 0 JPY",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			err := limits.Check(tt.amount, tt.dailyUsed, tt.monthlyUsed)
+			if tt.wantWindow == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, limitDomain.ErrLimitExceeded)
+			var exceeded *limitDomain.ExceededError
+			assert.True(t, errors.As(err, &exceeded))
+			assert.Equal(t, limitDomain.OperationWithdrawal, exceeded.OperationType)
+			assert.Equal(t, tt.wantWindow, exceeded.Window)
+			assert.Equal(t, tt.wantRemaining, exceeded.Remaining.Amount())
+			assert.Equal(t, tt.wantMessage, err.Error())
+		})
+	}
+}
+
+func TestLimits_TightenAndWithin(t *testing.T) {
+	limits := newLimits(t)
+	other, err := limitDomain.NewLimits(limitDomain.OperationWithdrawal, 500, 5000, 5000, moneyVO.JPY)
+	assert.NoError(t, err)
+
+	tightened := limits.Tighten(*other)
+	assert.Equal(t, int64(500), tightened.PerTransaction().Amount())
+	assert.Equal(t, int64(3000), tightened.Daily().Amount())
+	assert.Equal(t, int64(5000), tightened.Monthly().Amount())
+
+	assert.True(t, tightened.Within(limits))
+	assert.True(t, tightened.Within(*other))
+	assert.False(t, limits.Within(*other))
+	assert.False(t, other.Within(limits))
+}
+
+func TestStartOfMonth(t *testing.T) {
+	now := time.Date(2024, 3, 15, 10, 30, 0, 0, time.FixedZone("JST", 9*60*60))
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), limitDomain.StartOfMonth(now))
+}
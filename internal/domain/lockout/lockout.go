@@ -0,0 +1,86 @@
+package lockout
+
+import (
+	"time"
+)
+
+// Lockout はパスワードや暗証番号の連続失敗回数と、それによるロックの期限を表します。
+// 照合に成功した場合は削除され、失敗回数はリセットされます。
+type Lockout struct {
+	subject     Subject
+	subjectID   string
+	failedCount int
+	lockedUntil *time.Time
+	updatedAt   time.Time
+}
+
+func New(subject Subject, subjectID string, now time.Time) (*Lockout, error) {
+	return newLockout(subject, subjectID, 0, nil, now)
+}
+
+func Reconstruct(subject, subjectID string, failedCount int, lockedUntil *time.Time, updatedAt time.Time) (*Lockout, error) {
+	return newLockout(Subject(subject), subjectID, failedCount, lockedUntil, updatedAt)
+}
+
+func newLockout(subject Subject, subjectID string, failedCount int, lockedUntil *time.Time, updatedAt time.Time) (*Lockout, error) {
+	if err := validSubject(subject); err != nil {
+		return nil, err
+	}
+	return &Lockout{
+		subject:     subject,
+		subjectID:   subjectID,
+		failedCount: failedCount,
+		lockedUntil: lockedUntil,
+		updatedAt:   updatedAt,
+	}, nil
+}
+
+func (l *Lockout) Subject() Subject {
+	return l.subject
+}
+
+func (l *Lockout) SubjectString() string {
+	return string(l.subject)
+}
+
+func (l *Lockout) SubjectID() string {
+	return l.subjectID
+}
+
+func (l *Lockout) FailedCount() int {
+	return l.failedCount
+}
+
+func (l *Lockout) LockedUntil() *time.Time {
+	return l.lockedUntil
+}
+
+func (l *Lockout) UpdatedAt() time.Time {
+	return l.updatedAt
+}
+
+// 指定した時刻にロックされているかを返します。ロックの期限ちょうどに解除されます。
+func (l *Lockout) IsLocked(now time.Time) bool {
+	return l.lockedUntil != nil && now.Before(*l.lockedUntil)
+}
+
+// 失敗回数を 1 増やします。
+func (l *Lockout) IncrementFailedCount(now time.Time) {
+	l.failedCount++
+	l.updatedAt = now
+}
+
+// 失敗回数が上限に達している場合は、失敗回数に応じた期間ロックし true を返します。
+// 既により長いロックが設定されている場合は短縮しません。
+func (l *Lockout) LockIfExceeded(now time.Time) bool {
+	duration := lockoutDuration(l.failedCount)
+	if duration == 0 {
+		return false
+	}
+	until := now.Add(duration)
+	if l.lockedUntil == nil || until.After(*l.lockedUntil) {
+		l.lockedUntil = &until
+		l.updatedAt = now
+	}
+	return true
+}
@@ -0,0 +1,18 @@
+package lockout
+
+import (
+	"context"
+	"time"
+)
+
+// 失敗回数はリクエストのトランザクションがロールバックされても残す必要がある為、
+// 実装はユニットオブワークのトランザクションに参加せずに即時に書き込みます。
+type ILockoutRepository interface {
+	// ロックの状態を取得します。存在しない場合は nil を返します。
+	Find(ctx context.Context, subject Subject, subjectID string) (*Lockout, error)
+	// 失敗回数を原子的に 1 増やし、更新後の状態を返します。同時に照合に失敗した場合でも回数を取りこぼしません。
+	IncrementFailedCount(ctx context.Context, subject Subject, subjectID string, now time.Time) (*Lockout, error)
+	// ロックの期限を保存します。保存済みの期限より短い期限で上書きしません。
+	SaveLockedUntil(ctx context.Context, lockout *Lockout) error
+	Delete(ctx context.Context, subject Subject, subjectID string) error
+}
@@ -0,0 +1,55 @@
+package lockout
+
+import (
+	"context"
+	"time"
+)
+
+type ILockoutService interface {
+	// 対象がロックされている場合は ErrLocked を返します。パスワードを照合する前に呼び出してください。
+	Check(ctx context.Context, subject Subject, subjectID string) error
+	// 照合の失敗を記録し、失敗回数が上限に達した場合は対象をロックします。
+	RecordFailure(ctx context.Context, subject Subject, subjectID string) error
+	// 照合の成功を記録し、失敗回数をリセットします。
+	RecordSuccess(ctx context.Context, subject Subject, subjectID string) error
+}
+
+type lockoutService struct {
+	lockoutRepo ILockoutRepository
+	now         func() time.Time
+}
+
+// now には現在時刻を返す関数を指定します。通常は timer.Now を指定し、テストでは時刻を進められる関数を指定します。
+func NewService(lockoutRepository ILockoutRepository, now func() time.Time) ILockoutService {
+	return &lockoutService{
+		lockoutRepo: lockoutRepository,
+		now:         now,
+	}
+}
+
+func (s *lockoutService) Check(ctx context.Context, subject Subject, subjectID string) error {
+	lockout, err := s.lockoutRepo.Find(ctx, subject, subjectID)
+	if err != nil {
+		return err
+	}
+	if lockout != nil && lockout.IsLocked(s.now()) {
+		return ErrLocked
+	}
+	return nil
+}
+
+func (s *lockoutService) RecordFailure(ctx context.Context, subject Subject, subjectID string) error {
+	now := s.now()
+	lockout, err := s.lockoutRepo.IncrementFailedCount(ctx, subject, subjectID, now)
+	if err != nil {
+		return err
+	}
+	if !lockout.LockIfExceeded(now) {
+		return nil
+	}
+	return s.lockoutRepo.SaveLockedUntil(ctx, lockout)
+}
+
+func (s *lockoutService) RecordSuccess(ctx context.Context, subject Subject, subjectID string) error {
+	return s.lockoutRepo.Delete(ctx, subject, subjectID)
+}
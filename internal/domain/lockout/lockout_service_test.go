@@ -0,0 +1,190 @@
+package lockout_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	"github.com/u104rak1/pocgo/internal/domain/mock"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestCheck(t *testing.T) {
+	var (
+		arg         = gomock.Any()
+		now         = timer.GetFixedDate()
+		clock       = func() time.Time { return now }
+		lockedUntil = now.Add(time.Minute)
+	)
+
+	tests := []struct {
+		caseName string
+		setup    func(mockLockoutRepo *mock.MockILockoutRepository)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 失敗の記録がない場合はロックされていない",
+			setup: func(mockLockoutRepo *mock.MockILockoutRepository) {
+				mockLockoutRepo.EXPECT().Find(arg, lockoutDomain.SubjectUser, "user").Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Positive: ロックの期限を過ぎている場合はロックされていない",
+			setup: func(mockLockoutRepo *mock.MockILockoutRepository) {
+				expired := now.Add(-time.Second)
+				lockout, _ := lockoutDomain.Reconstruct("user", "user", lockoutDomain.MaxFailedAttempts, &expired, now)
+				mockLockoutRepo.EXPECT().Find(arg, arg, arg).Return(lockout, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: ロックの期限前の場合は ErrLocked が返る",
+			setup: func(mockLockoutRepo *mock.MockILockoutRepository) {
+				lockout, _ := lockoutDomain.Reconstruct("user", "user", lockoutDomain.MaxFailedAttempts, &lockedUntil, now)
+				mockLockoutRepo.EXPECT().Find(arg, arg, arg).Return(lockout, nil)
+			},
+			wantErr: lockoutDomain.ErrLocked,
+		},
+		{
+			caseName: "Negative: 取得に失敗した場合はエラーが返る",
+			setup: func(mockLockoutRepo *mock.MockILockoutRepository) {
+				mockLockoutRepo.EXPECT().Find(arg, arg, arg).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLockoutRepo := mock.NewMockILockoutRepository(ctrl)
+			service := lockoutDomain.NewService(mockLockoutRepo, clock)
+			tt.setup(mockLockoutRepo)
+
+			err := service.Check(context.Background(), lockoutDomain.SubjectUser, "user")
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRecordFailure(t *testing.T) {
+	var (
+		arg   = gomock.Any()
+		now   = timer.GetFixedDate()
+		clock = func() time.Time { return now }
+	)
+
+	lockoutWithCount := func(failedCount int) *lockoutDomain.Lockout {
+		lockout, _ := lockoutDomain.Reconstruct("account", "account", failedCount, nil, now)
+		return lockout
+	}
+
+	tests := []struct {
+		caseName string
+		setup    func(mockLockoutRepo *mock.MockILockoutRepository)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 失敗回数が上限未満の場合はロックしない",
+			setup: func(mockLockoutRepo *mock.MockILockoutRepository) {
+				mockLockoutRepo.EXPECT().IncrementFailedCount(arg, lockoutDomain.SubjectAccount, "account", now).
+					Return(lockoutWithCount(1), nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Positive: 失敗回数が上限に達した場合はロックの期限を保存する",
+			setup: func(mockLockoutRepo *mock.MockILockoutRepository) {
+				mockLockoutRepo.EXPECT().IncrementFailedCount(arg, arg, arg, arg).
+					Return(lockoutWithCount(lockoutDomain.MaxFailedAttempts), nil)
+				mockLockoutRepo.EXPECT().SaveLockedUntil(arg, arg).DoAndReturn(
+					func(_ context.Context, lockout *lockoutDomain.Lockout) error {
+						assert.Equal(t, now.Add(lockoutDomain.BaseLockoutDuration), *lockout.LockedUntil())
+						return nil
+					})
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 失敗回数の更新に失敗した場合はエラーが返る",
+			setup: func(mockLockoutRepo *mock.MockILockoutRepository) {
+				mockLockoutRepo.EXPECT().IncrementFailedCount(arg, arg, arg, arg).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			caseName: "Negative: ロックの期限の保存に失敗した場合はエラーが返る",
+			setup: func(mockLockoutRepo *mock.MockILockoutRepository) {
+				mockLockoutRepo.EXPECT().IncrementFailedCount(arg, arg, arg, arg).
+					Return(lockoutWithCount(lockoutDomain.MaxFailedAttempts), nil)
+				mockLockoutRepo.EXPECT().SaveLockedUntil(arg, arg).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLockoutRepo := mock.NewMockILockoutRepository(ctrl)
+			service := lockoutDomain.NewService(mockLockoutRepo, clock)
+			tt.setup(mockLockoutRepo)
+
+			err := service.RecordFailure(context.Background(), lockoutDomain.SubjectAccount, "account")
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRecordSuccess(t *testing.T) {
+	arg := gomock.Any()
+
+	tests := []struct {
+		caseName string
+		setup    func(mockLockoutRepo *mock.MockILockoutRepository)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 失敗回数をリセットできる",
+			setup: func(mockLockoutRepo *mock.MockILockoutRepository) {
+				mockLockoutRepo.EXPECT().Delete(arg, lockoutDomain.SubjectUser, "user").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 削除に失敗した場合はエラーが返る",
+			setup: func(mockLockoutRepo *mock.MockILockoutRepository) {
+				mockLockoutRepo.EXPECT().Delete(arg, arg, arg).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLockoutRepo := mock.NewMockILockoutRepository(ctrl)
+			service := lockoutDomain.NewService(mockLockoutRepo, timer.Now)
+			tt.setup(mockLockoutRepo)
+
+			err := service.RecordSuccess(context.Background(), lockoutDomain.SubjectUser, "user")
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
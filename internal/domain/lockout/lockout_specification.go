@@ -0,0 +1,54 @@
+package lockout
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// ロックされるまでに許容する連続失敗回数
+	MaxFailedAttempts = 5
+	// 最初のロック期間。以降はロック後に失敗する度に 2 倍になります。
+	BaseLockoutDuration = 1 * time.Minute
+	// ロック期間の上限
+	MaxLockoutDuration = 24 * time.Hour
+)
+
+// Subject はロックの対象の種類です。
+type Subject string
+
+const (
+	// サインイン時のパスワード照合。ユーザーごとに数えます。
+	SubjectUser Subject = "user"
+	// 口座の暗証番号照合。口座ごとに数えます。
+	SubjectAccount Subject = "account"
+)
+
+var (
+	ErrLocked         = errors.New("too many failed attempts, temporarily locked")
+	ErrInvalidSubject = errors.New("invalid lockout subject")
+)
+
+func validSubject(subject Subject) error {
+	switch subject {
+	case SubjectUser, SubjectAccount:
+		return nil
+	default:
+		return ErrInvalidSubject
+	}
+}
+
+// 連続失敗回数に応じたロック期間を返します。MaxFailedAttempts 回目で BaseLockoutDuration、以降は 1 回ごとに 2 倍になります。
+func lockoutDuration(failedCount int) time.Duration {
+	if failedCount < MaxFailedAttempts {
+		return 0
+	}
+	duration := BaseLockoutDuration
+	for i := MaxFailedAttempts; i < failedCount; i++ {
+		duration *= 2
+		if duration >= MaxLockoutDuration {
+			return MaxLockoutDuration
+		}
+	}
+	return duration
+}
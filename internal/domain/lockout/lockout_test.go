@@ -0,0 +1,128 @@
+package lockout_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestReconstruct(t *testing.T) {
+	now := timer.GetFixedDate()
+
+	t.Run("Positive: ロックの状態を復元できる", func(t *testing.T) {
+		lockedUntil := now.Add(time.Minute)
+		lockout, err := lockoutDomain.Reconstruct("account", "account", 5, &lockedUntil, now)
+		assert.NoError(t, err)
+		assert.Equal(t, lockoutDomain.SubjectAccount, lockout.Subject())
+		assert.Equal(t, "account", lockout.SubjectID())
+		assert.Equal(t, 5, lockout.FailedCount())
+		assert.Equal(t, &lockedUntil, lockout.LockedUntil())
+		assert.Equal(t, now, lockout.UpdatedAt())
+	})
+
+	t.Run("Negative: 対象の種類が不正な場合はエラーが返る", func(t *testing.T) {
+		lockout, err := lockoutDomain.Reconstruct("invalid", "account", 0, nil, now)
+		assert.ErrorIs(t, err, lockoutDomain.ErrInvalidSubject)
+		assert.Nil(t, lockout)
+	})
+}
+
+func TestLockIfExceeded(t *testing.T) {
+	now := timer.GetFixedDate()
+
+	tests := []struct {
+		caseName      string
+		failedCount   int
+		expectLocked  bool
+		expectedUntil time.Time
+	}{
+		{
+			caseName:     "Positive: 失敗回数が上限未満の場合はロックしない",
+			failedCount:  lockoutDomain.MaxFailedAttempts - 1,
+			expectLocked: false,
+		},
+		{
+			caseName:      "Positive: 失敗回数が上限に達した場合は BaseLockoutDuration ロックする",
+			failedCount:   lockoutDomain.MaxFailedAttempts,
+			expectLocked:  true,
+			expectedUntil: now.Add(lockoutDomain.BaseLockoutDuration),
+		},
+		{
+			caseName:      "Positive: ロック後に失敗する度にロック期間が 2 倍になる",
+			failedCount:   lockoutDomain.MaxFailedAttempts + 3,
+			expectLocked:  true,
+			expectedUntil: now.Add(8 * lockoutDomain.BaseLockoutDuration),
+		},
+		{
+			caseName:      "Positive: ロック期間は MaxLockoutDuration を超えない",
+			failedCount:   lockoutDomain.MaxFailedAttempts + 100,
+			expectLocked:  true,
+			expectedUntil: now.Add(lockoutDomain.MaxLockoutDuration),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			lockout, err := lockoutDomain.Reconstruct("user", "user", tt.failedCount, nil, now)
+			assert.NoError(t, err)
+
+			locked := lockout.LockIfExceeded(now)
+
+			assert.Equal(t, tt.expectLocked, locked)
+			if tt.expectLocked {
+				assert.Equal(t, tt.expectedUntil, *lockout.LockedUntil())
+			} else {
+				assert.Nil(t, lockout.LockedUntil())
+			}
+		})
+	}
+
+	t.Run("Positive: 既に設定されているより長いロックは短縮しない", func(t *testing.T) {
+		longer := now.Add(time.Hour)
+		lockout, err := lockoutDomain.Reconstruct("user", "user", lockoutDomain.MaxFailedAttempts, &longer, now)
+		assert.NoError(t, err)
+
+		assert.True(t, lockout.LockIfExceeded(now))
+		assert.Equal(t, longer, *lockout.LockedUntil())
+	})
+}
+
+func TestIsLocked(t *testing.T) {
+	now := timer.GetFixedDate()
+	lockedUntil := now.Add(time.Minute)
+	lockout, err := lockoutDomain.Reconstruct("user", "user", lockoutDomain.MaxFailedAttempts, &lockedUntil, now)
+	assert.NoError(t, err)
+
+	t.Run("Positive: ロックの期限前はロックされている", func(t *testing.T) {
+		assert.True(t, lockout.IsLocked(lockedUntil.Add(-time.Second)))
+	})
+
+	t.Run("Positive: ロックの期限ちょうどに解除される", func(t *testing.T) {
+		assert.False(t, lockout.IsLocked(lockedUntil))
+	})
+
+	t.Run("Positive: 一度もロックされていない場合はロックされていない", func(t *testing.T) {
+		unlocked, err := lockoutDomain.New(lockoutDomain.SubjectUser, "user", now)
+		assert.NoError(t, err)
+		assert.False(t, unlocked.IsLocked(now))
+	})
+}
+
+func TestIncrementFailedCount(t *testing.T) {
+	t.Run("Positive: 失敗回数が 1 増え、更新日時が変わる", func(t *testing.T) {
+		now := timer.GetFixedDate()
+		lockout, err := lockoutDomain.New(lockoutDomain.SubjectAccount, "account", now)
+		assert.NoError(t, err)
+
+		later := now.Add(time.Second)
+		lockout.IncrementFailedCount(later)
+
+		assert.Equal(t, 1, lockout.FailedCount())
+		assert.Equal(t, later, lockout.UpdatedAt())
+	})
+}
@@ -51,6 +51,20 @@ func (mr *MockIAccountRepositoryMockRecorder) CountByUserID(ctx, userID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserID", reflect.TypeOf((*MockIAccountRepository)(nil).CountByUserID), ctx, userID)
 }
 
+// Delete mocks base method.
+func (m *MockIAccountRepository) Delete(ctx context.Context, account *account.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIAccountRepositoryMockRecorder) Delete(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIAccountRepository)(nil).Delete), ctx, account)
+}
+
 // FindByID mocks base method.
 func (m *MockIAccountRepository) FindByID(ctx context.Context, id id.AccountID) (*account.Account, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +80,21 @@ func (mr *MockIAccountRepositoryMockRecorder) FindByID(ctx, id interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIAccountRepository)(nil).FindByID), ctx, id)
 }
 
+// ListByUserID mocks base method.
+func (m *MockIAccountRepository) ListByUserID(ctx context.Context, userID id.UserID) ([]*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockIAccountRepositoryMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockIAccountRepository)(nil).ListByUserID), ctx, userID)
+}
+
 // Save mocks base method.
 func (m *MockIAccountRepository) Save(ctx context.Context, account *account.Account) error {
 	m.ctrl.T.Helper()
@@ -64,3 +64,17 @@ func (mr *MockIAccountServiceMockRecorder) GetAndAuthorize(ctx, accountID, userI
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAndAuthorize", reflect.TypeOf((*MockIAccountService)(nil).GetAndAuthorize), ctx, accountID, userID, password)
 }
+
+// Open mocks base method.
+func (m *MockIAccountService) Open(ctx context.Context, account *account.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockIAccountServiceMockRecorder) Open(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockIAccountService)(nil).Open), ctx, account)
+}
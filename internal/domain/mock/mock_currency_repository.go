@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/value_object/money/currency_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	money "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// MockICurrencyRepository is a mock of ICurrencyRepository interface.
+type MockICurrencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICurrencyRepositoryMockRecorder
+}
+
+// MockICurrencyRepositoryMockRecorder is the mock recorder for MockICurrencyRepository.
+type MockICurrencyRepositoryMockRecorder struct {
+	mock *MockICurrencyRepository
+}
+
+// NewMockICurrencyRepository creates a new mock instance.
+func NewMockICurrencyRepository(ctrl *gomock.Controller) *MockICurrencyRepository {
+	mock := &MockICurrencyRepository{ctrl: ctrl}
+	mock.recorder = &MockICurrencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICurrencyRepository) EXPECT() *MockICurrencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockICurrencyRepository) FindAll(ctx context.Context) ([]*money.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*money.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockICurrencyRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockICurrencyRepository)(nil).FindAll), ctx)
+}
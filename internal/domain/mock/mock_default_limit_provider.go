@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/limit/default_limit_provider.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	limit "github.com/u104rak1/pocgo/internal/domain/limit"
+)
+
+// MockIDefaultLimitProvider is a mock of IDefaultLimitProvider interface.
+type MockIDefaultLimitProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDefaultLimitProviderMockRecorder
+}
+
+// MockIDefaultLimitProviderMockRecorder is the mock recorder for MockIDefaultLimitProvider.
+type MockIDefaultLimitProviderMockRecorder struct {
+	mock *MockIDefaultLimitProvider
+}
+
+// NewMockIDefaultLimitProvider creates a new mock instance.
+func NewMockIDefaultLimitProvider(ctrl *gomock.Controller) *MockIDefaultLimitProvider {
+	mock := &MockIDefaultLimitProvider{ctrl: ctrl}
+	mock.recorder = &MockIDefaultLimitProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDefaultLimitProvider) EXPECT() *MockIDefaultLimitProviderMockRecorder {
+	return m.recorder
+}
+
+// Default mocks base method.
+func (m *MockIDefaultLimitProvider) Default(ctx context.Context, operationType, currency string) (*limit.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Default", ctx, operationType, currency)
+	ret0, _ := ret[0].(*limit.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Default indicates an expected call of Default.
+func (mr *MockIDefaultLimitProviderMockRecorder) Default(ctx, operationType, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Default", reflect.TypeOf((*MockIDefaultLimitProvider)(nil).Default), ctx, operationType, currency)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/event/event_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	event "github.com/u104rak1/pocgo/internal/domain/event"
+)
+
+// MockIEventRepository is a mock of IEventRepository interface.
+type MockIEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEventRepositoryMockRecorder
+}
+
+// MockIEventRepositoryMockRecorder is the mock recorder for MockIEventRepository.
+type MockIEventRepositoryMockRecorder struct {
+	mock *MockIEventRepository
+}
+
+// NewMockIEventRepository creates a new mock instance.
+func NewMockIEventRepository(ctrl *gomock.Controller) *MockIEventRepository {
+	mock := &MockIEventRepository{ctrl: ctrl}
+	mock.recorder = &MockIEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEventRepository) EXPECT() *MockIEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListUnpublished mocks base method.
+func (m *MockIEventRepository) ListUnpublished(ctx context.Context, limit int, excluded []event.Aggregate) ([]*event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnpublished", ctx, limit, excluded)
+	ret0, _ := ret[0].([]*event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnpublished indicates an expected call of ListUnpublished.
+func (mr *MockIEventRepositoryMockRecorder) ListUnpublished(ctx, limit, excluded interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnpublished", reflect.TypeOf((*MockIEventRepository)(nil).ListUnpublished), ctx, limit, excluded)
+}
+
+// Save mocks base method.
+func (m *MockIEventRepository) Save(ctx context.Context, event *event.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIEventRepositoryMockRecorder) Save(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIEventRepository)(nil).Save), ctx, event)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/value_object/money/exchange_rate_provider.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	money "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// MockIExchangeRateProvider is a mock of IExchangeRateProvider interface.
+type MockIExchangeRateProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockIExchangeRateProviderMockRecorder
+}
+
+// MockIExchangeRateProviderMockRecorder is the mock recorder for MockIExchangeRateProvider.
+type MockIExchangeRateProviderMockRecorder struct {
+	mock *MockIExchangeRateProvider
+}
+
+// NewMockIExchangeRateProvider creates a new mock instance.
+func NewMockIExchangeRateProvider(ctrl *gomock.Controller) *MockIExchangeRateProvider {
+	mock := &MockIExchangeRateProvider{ctrl: ctrl}
+	mock.recorder = &MockIExchangeRateProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIExchangeRateProvider) EXPECT() *MockIExchangeRateProviderMockRecorder {
+	return m.recorder
+}
+
+// Quote mocks base method.
+func (m *MockIExchangeRateProvider) Quote(ctx context.Context, base, quote string) (*money.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", ctx, base, quote)
+	ret0, _ := ret[0].(*money.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockIExchangeRateProviderMockRecorder) Quote(ctx, base, quote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockIExchangeRateProvider)(nil).Quote), ctx, base, quote)
+}
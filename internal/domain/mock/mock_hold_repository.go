@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/hold/hold_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	hold "github.com/u104rak1/pocgo/internal/domain/hold"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// MockIHoldRepository is a mock of IHoldRepository interface.
+type MockIHoldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHoldRepositoryMockRecorder
+}
+
+// MockIHoldRepositoryMockRecorder is the mock recorder for MockIHoldRepository.
+type MockIHoldRepositoryMockRecorder struct {
+	mock *MockIHoldRepository
+}
+
+// NewMockIHoldRepository creates a new mock instance.
+func NewMockIHoldRepository(ctrl *gomock.Controller) *MockIHoldRepository {
+	mock := &MockIHoldRepository{ctrl: ctrl}
+	mock.recorder = &MockIHoldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHoldRepository) EXPECT() *MockIHoldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockIHoldRepository) FindByID(ctx context.Context, id id.HoldID) (*hold.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*hold.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockIHoldRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIHoldRepository)(nil).FindByID), ctx, id)
+}
+
+// ListByAccountID mocks base method.
+func (m *MockIHoldRepository) ListByAccountID(ctx context.Context, accountID id.AccountID, status *string) ([]*hold.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccountID", ctx, accountID, status)
+	ret0, _ := ret[0].([]*hold.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccountID indicates an expected call of ListByAccountID.
+func (mr *MockIHoldRepositoryMockRecorder) ListByAccountID(ctx, accountID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountID", reflect.TypeOf((*MockIHoldRepository)(nil).ListByAccountID), ctx, accountID, status)
+}
+
+// ListExpired mocks base method.
+func (m *MockIHoldRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*hold.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpired", ctx, now, limit)
+	ret0, _ := ret[0].([]*hold.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpired indicates an expected call of ListExpired.
+func (mr *MockIHoldRepositoryMockRecorder) ListExpired(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpired", reflect.TypeOf((*MockIHoldRepository)(nil).ListExpired), ctx, now, limit)
+}
+
+// Save mocks base method.
+func (m *MockIHoldRepository) Save(ctx context.Context, hold *hold.Hold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, hold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIHoldRepositoryMockRecorder) Save(ctx, hold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIHoldRepository)(nil).Save), ctx, hold)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/hold/hold_service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	account "github.com/u104rak1/pocgo/internal/domain/account"
+	hold "github.com/u104rak1/pocgo/internal/domain/hold"
+	transaction "github.com/u104rak1/pocgo/internal/domain/transaction"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// MockIHoldService is a mock of IHoldService interface.
+type MockIHoldService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHoldServiceMockRecorder
+}
+
+// MockIHoldServiceMockRecorder is the mock recorder for MockIHoldService.
+type MockIHoldServiceMockRecorder struct {
+	mock *MockIHoldService
+}
+
+// NewMockIHoldService creates a new mock instance.
+func NewMockIHoldService(ctrl *gomock.Controller) *MockIHoldService {
+	mock := &MockIHoldService{ctrl: ctrl}
+	mock.recorder = &MockIHoldServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHoldService) EXPECT() *MockIHoldServiceMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockIHoldService) Authorize(ctx context.Context, account *account.Account, receiverAccountID *id.AccountID, amount int64, currency string, expiresAt time.Time) (*hold.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, account, receiverAccountID, amount, currency, expiresAt)
+	ret0, _ := ret[0].(*hold.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockIHoldServiceMockRecorder) Authorize(ctx, account, receiverAccountID, amount, currency, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockIHoldService)(nil).Authorize), ctx, account, receiverAccountID, amount, currency, expiresAt)
+}
+
+// Capture mocks base method.
+func (m *MockIHoldService) Capture(ctx context.Context, account *account.Account, hold *hold.Hold, amount *int64) (*transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, account, hold, amount)
+	ret0, _ := ret[0].(*transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockIHoldServiceMockRecorder) Capture(ctx, account, hold, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockIHoldService)(nil).Capture), ctx, account, hold, amount)
+}
+
+// Expire mocks base method.
+func (m *MockIHoldService) Expire(ctx context.Context, hold *hold.Hold, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", ctx, hold, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockIHoldServiceMockRecorder) Expire(ctx, hold, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockIHoldService)(nil).Expire), ctx, hold, now)
+}
+
+// GetByAccount mocks base method.
+func (m *MockIHoldService) GetByAccount(ctx context.Context, accountID id.AccountID, id id.HoldID) (*hold.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccount", ctx, accountID, id)
+	ret0, _ := ret[0].(*hold.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccount indicates an expected call of GetByAccount.
+func (mr *MockIHoldServiceMockRecorder) GetByAccount(ctx, accountID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccount", reflect.TypeOf((*MockIHoldService)(nil).GetByAccount), ctx, accountID, id)
+}
+
+// Void mocks base method.
+func (m *MockIHoldService) Void(ctx context.Context, account *account.Account, hold *hold.Hold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", ctx, account, hold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockIHoldServiceMockRecorder) Void(ctx, account, hold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockIHoldService)(nil).Void), ctx, account, hold)
+}
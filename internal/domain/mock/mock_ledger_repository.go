@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ledger/ledger_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	ledger "github.com/u104rak1/pocgo/internal/domain/ledger"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// MockILedgerRepository is a mock of ILedgerRepository interface.
+type MockILedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockILedgerRepositoryMockRecorder
+}
+
+// MockILedgerRepositoryMockRecorder is the mock recorder for MockILedgerRepository.
+type MockILedgerRepositoryMockRecorder struct {
+	mock *MockILedgerRepository
+}
+
+// NewMockILedgerRepository creates a new mock instance.
+func NewMockILedgerRepository(ctrl *gomock.Controller) *MockILedgerRepository {
+	mock := &MockILedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockILedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILedgerRepository) EXPECT() *MockILedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BalanceByAccountID mocks base method.
+func (m *MockILedgerRepository) BalanceByAccountID(ctx context.Context, accountID id.AccountID, currency string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceByAccountID", ctx, accountID, currency)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceByAccountID indicates an expected call of BalanceByAccountID.
+func (mr *MockILedgerRepositoryMockRecorder) BalanceByAccountID(ctx, accountID, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceByAccountID", reflect.TypeOf((*MockILedgerRepository)(nil).BalanceByAccountID), ctx, accountID, currency)
+}
+
+// BalanceByAccountIDBefore mocks base method.
+func (m *MockILedgerRepository) BalanceByAccountIDBefore(ctx context.Context, accountID id.AccountID, currency string, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceByAccountIDBefore", ctx, accountID, currency, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceByAccountIDBefore indicates an expected call of BalanceByAccountIDBefore.
+func (mr *MockILedgerRepositoryMockRecorder) BalanceByAccountIDBefore(ctx, accountID, currency, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceByAccountIDBefore", reflect.TypeOf((*MockILedgerRepository)(nil).BalanceByAccountIDBefore), ctx, accountID, currency, before)
+}
+
+// ListBalanceMismatches mocks base method.
+func (m *MockILedgerRepository) ListBalanceMismatches(ctx context.Context) ([]*ledger.BalanceMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalanceMismatches", ctx)
+	ret0, _ := ret[0].([]*ledger.BalanceMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalanceMismatches indicates an expected call of ListBalanceMismatches.
+func (mr *MockILedgerRepositoryMockRecorder) ListBalanceMismatches(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalanceMismatches", reflect.TypeOf((*MockILedgerRepository)(nil).ListBalanceMismatches), ctx)
+}
+
+// Save mocks base method.
+func (m *MockILedgerRepository) Save(ctx context.Context, entry *ledger.Entry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockILedgerRepositoryMockRecorder) Save(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockILedgerRepository)(nil).Save), ctx, entry)
+}
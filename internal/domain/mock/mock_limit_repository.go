@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/limit/limit_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	limit "github.com/u104rak1/pocgo/internal/domain/limit"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// MockIAccountLimitRepository is a mock of IAccountLimitRepository interface.
+type MockIAccountLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAccountLimitRepositoryMockRecorder
+}
+
+// MockIAccountLimitRepositoryMockRecorder is the mock recorder for MockIAccountLimitRepository.
+type MockIAccountLimitRepositoryMockRecorder struct {
+	mock *MockIAccountLimitRepository
+}
+
+// NewMockIAccountLimitRepository creates a new mock instance.
+func NewMockIAccountLimitRepository(ctrl *gomock.Controller) *MockIAccountLimitRepository {
+	mock := &MockIAccountLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockIAccountLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAccountLimitRepository) EXPECT() *MockIAccountLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockIAccountLimitRepository) Find(ctx context.Context, accountID id.AccountID, operationType string) (*limit.AccountLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, accountID, operationType)
+	ret0, _ := ret[0].(*limit.AccountLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockIAccountLimitRepositoryMockRecorder) Find(ctx, accountID, operationType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockIAccountLimitRepository)(nil).Find), ctx, accountID, operationType)
+}
+
+// Save mocks base method.
+func (m *MockIAccountLimitRepository) Save(ctx context.Context, accountLimit *limit.AccountLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, accountLimit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIAccountLimitRepositoryMockRecorder) Save(ctx, accountLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIAccountLimitRepository)(nil).Save), ctx, accountLimit)
+}
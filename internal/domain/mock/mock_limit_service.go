@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/limit/limit_service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	account "github.com/u104rak1/pocgo/internal/domain/account"
+	limit "github.com/u104rak1/pocgo/internal/domain/limit"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// MockILimitService is a mock of ILimitService interface.
+type MockILimitService struct {
+	ctrl     *gomock.Controller
+	recorder *MockILimitServiceMockRecorder
+}
+
+// MockILimitServiceMockRecorder is the mock recorder for MockILimitService.
+type MockILimitServiceMockRecorder struct {
+	mock *MockILimitService
+}
+
+// NewMockILimitService creates a new mock instance.
+func NewMockILimitService(ctrl *gomock.Controller) *MockILimitService {
+	mock := &MockILimitService{ctrl: ctrl}
+	mock.recorder = &MockILimitServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILimitService) EXPECT() *MockILimitServiceMockRecorder {
+	return m.recorder
+}
+
+// GetDefault mocks base method.
+func (m *MockILimitService) GetDefault(ctx context.Context, operationType, currency string) (*limit.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefault", ctx, operationType, currency)
+	ret0, _ := ret[0].(*limit.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefault indicates an expected call of GetDefault.
+func (mr *MockILimitServiceMockRecorder) GetDefault(ctx, operationType, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefault", reflect.TypeOf((*MockILimitService)(nil).GetDefault), ctx, operationType, currency)
+}
+
+// GetEffective mocks base method.
+func (m *MockILimitService) GetEffective(ctx context.Context, accountID id.AccountID, operationType, currency string) (*limit.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffective", ctx, accountID, operationType, currency)
+	ret0, _ := ret[0].(*limit.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffective indicates an expected call of GetEffective.
+func (mr *MockILimitServiceMockRecorder) GetEffective(ctx, accountID, operationType, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffective", reflect.TypeOf((*MockILimitService)(nil).GetEffective), ctx, accountID, operationType, currency)
+}
+
+// Set mocks base method.
+func (m *MockILimitService) Set(ctx context.Context, account *account.Account, limits limit.Limits) (*limit.AccountLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, account, limits)
+	ret0, _ := ret[0].(*limit.AccountLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockILimitServiceMockRecorder) Set(ctx, account, limits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockILimitService)(nil).Set), ctx, account, limits)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/lockout/lockout_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	lockout "github.com/u104rak1/pocgo/internal/domain/lockout"
+)
+
+// MockILockoutRepository is a mock of ILockoutRepository interface.
+type MockILockoutRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockILockoutRepositoryMockRecorder
+}
+
+// MockILockoutRepositoryMockRecorder is the mock recorder for MockILockoutRepository.
+type MockILockoutRepositoryMockRecorder struct {
+	mock *MockILockoutRepository
+}
+
+// NewMockILockoutRepository creates a new mock instance.
+func NewMockILockoutRepository(ctrl *gomock.Controller) *MockILockoutRepository {
+	mock := &MockILockoutRepository{ctrl: ctrl}
+	mock.recorder = &MockILockoutRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILockoutRepository) EXPECT() *MockILockoutRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockILockoutRepository) Delete(ctx context.Context, subject lockout.Subject, subjectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, subject, subjectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockILockoutRepositoryMockRecorder) Delete(ctx, subject, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockILockoutRepository)(nil).Delete), ctx, subject, subjectID)
+}
+
+// Find mocks base method.
+func (m *MockILockoutRepository) Find(ctx context.Context, subject lockout.Subject, subjectID string) (*lockout.Lockout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, subject, subjectID)
+	ret0, _ := ret[0].(*lockout.Lockout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockILockoutRepositoryMockRecorder) Find(ctx, subject, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockILockoutRepository)(nil).Find), ctx, subject, subjectID)
+}
+
+// IncrementFailedCount mocks base method.
+func (m *MockILockoutRepository) IncrementFailedCount(ctx context.Context, subject lockout.Subject, subjectID string, now time.Time) (*lockout.Lockout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementFailedCount", ctx, subject, subjectID, now)
+	ret0, _ := ret[0].(*lockout.Lockout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementFailedCount indicates an expected call of IncrementFailedCount.
+func (mr *MockILockoutRepositoryMockRecorder) IncrementFailedCount(ctx, subject, subjectID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementFailedCount", reflect.TypeOf((*MockILockoutRepository)(nil).IncrementFailedCount), ctx, subject, subjectID, now)
+}
+
+// SaveLockedUntil mocks base method.
+func (m *MockILockoutRepository) SaveLockedUntil(ctx context.Context, lockout *lockout.Lockout) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLockedUntil", ctx, lockout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLockedUntil indicates an expected call of SaveLockedUntil.
+func (mr *MockILockoutRepositoryMockRecorder) SaveLockedUntil(ctx, lockout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLockedUntil", reflect.TypeOf((*MockILockoutRepository)(nil).SaveLockedUntil), ctx, lockout)
+}
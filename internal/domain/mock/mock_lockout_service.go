@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/lockout/lockout_service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	lockout "github.com/u104rak1/pocgo/internal/domain/lockout"
+)
+
+// MockILockoutService is a mock of ILockoutService interface.
+type MockILockoutService struct {
+	ctrl     *gomock.Controller
+	recorder *MockILockoutServiceMockRecorder
+}
+
+// MockILockoutServiceMockRecorder is the mock recorder for MockILockoutService.
+type MockILockoutServiceMockRecorder struct {
+	mock *MockILockoutService
+}
+
+// NewMockILockoutService creates a new mock instance.
+func NewMockILockoutService(ctrl *gomock.Controller) *MockILockoutService {
+	mock := &MockILockoutService{ctrl: ctrl}
+	mock.recorder = &MockILockoutServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILockoutService) EXPECT() *MockILockoutServiceMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockILockoutService) Check(ctx context.Context, subject lockout.Subject, subjectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, subject, subjectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockILockoutServiceMockRecorder) Check(ctx, subject, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockILockoutService)(nil).Check), ctx, subject, subjectID)
+}
+
+// RecordFailure mocks base method.
+func (m *MockILockoutService) RecordFailure(ctx context.Context, subject lockout.Subject, subjectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", ctx, subject, subjectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockILockoutServiceMockRecorder) RecordFailure(ctx, subject, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockILockoutService)(nil).RecordFailure), ctx, subject, subjectID)
+}
+
+// RecordSuccess mocks base method.
+func (m *MockILockoutService) RecordSuccess(ctx context.Context, subject lockout.Subject, subjectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSuccess", ctx, subject, subjectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSuccess indicates an expected call of RecordSuccess.
+func (mr *MockILockoutServiceMockRecorder) RecordSuccess(ctx, subject, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSuccess", reflect.TypeOf((*MockILockoutService)(nil).RecordSuccess), ctx, subject, subjectID)
+}
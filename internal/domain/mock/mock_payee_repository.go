@@ -0,0 +1,169 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/payee/payee_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	payee "github.com/u104rak1/pocgo/internal/domain/payee"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// MockIPayeeRepository is a mock of IPayeeRepository interface.
+type MockIPayeeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPayeeRepositoryMockRecorder
+}
+
+// MockIPayeeRepositoryMockRecorder is the mock recorder for MockIPayeeRepository.
+type MockIPayeeRepositoryMockRecorder struct {
+	mock *MockIPayeeRepository
+}
+
+// NewMockIPayeeRepository creates a new mock instance.
+func NewMockIPayeeRepository(ctrl *gomock.Controller) *MockIPayeeRepository {
+	mock := &MockIPayeeRepository{ctrl: ctrl}
+	mock.recorder = &MockIPayeeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPayeeRepository) EXPECT() *MockIPayeeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByUserID mocks base method.
+func (m *MockIPayeeRepository) CountByUserID(ctx context.Context, userID id.UserID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUserID", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUserID indicates an expected call of CountByUserID.
+func (mr *MockIPayeeRepositoryMockRecorder) CountByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserID", reflect.TypeOf((*MockIPayeeRepository)(nil).CountByUserID), ctx, userID)
+}
+
+// Delete mocks base method.
+func (m *MockIPayeeRepository) Delete(ctx context.Context, payee *payee.Payee) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, payee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIPayeeRepositoryMockRecorder) Delete(ctx, payee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIPayeeRepository)(nil).Delete), ctx, payee)
+}
+
+// ExistsByUserIDAndPayeeUserID mocks base method.
+func (m *MockIPayeeRepository) ExistsByUserIDAndPayeeUserID(ctx context.Context, userID, payeeUserID id.UserID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByUserIDAndPayeeUserID", ctx, userID, payeeUserID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByUserIDAndPayeeUserID indicates an expected call of ExistsByUserIDAndPayeeUserID.
+func (mr *MockIPayeeRepositoryMockRecorder) ExistsByUserIDAndPayeeUserID(ctx, userID, payeeUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByUserIDAndPayeeUserID", reflect.TypeOf((*MockIPayeeRepository)(nil).ExistsByUserIDAndPayeeUserID), ctx, userID, payeeUserID)
+}
+
+// FindByID mocks base method.
+func (m *MockIPayeeRepository) FindByID(ctx context.Context, id id.PayeeID) (*payee.Payee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*payee.Payee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockIPayeeRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIPayeeRepository)(nil).FindByID), ctx, id)
+}
+
+// FindReceivingAccount mocks base method.
+func (m *MockIPayeeRepository) FindReceivingAccount(ctx context.Context, userID id.UserID, currency string) (*payee.ReceivingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReceivingAccount", ctx, userID, currency)
+	ret0, _ := ret[0].(*payee.ReceivingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReceivingAccount indicates an expected call of FindReceivingAccount.
+func (mr *MockIPayeeRepositoryMockRecorder) FindReceivingAccount(ctx, userID, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReceivingAccount", reflect.TypeOf((*MockIPayeeRepository)(nil).FindReceivingAccount), ctx, userID, currency)
+}
+
+// ListByUserID mocks base method.
+func (m *MockIPayeeRepository) ListByUserID(ctx context.Context, userID id.UserID) ([]*payee.Payee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*payee.Payee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockIPayeeRepositoryMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockIPayeeRepository)(nil).ListByUserID), ctx, userID)
+}
+
+// ListReceivingAccounts mocks base method.
+func (m *MockIPayeeRepository) ListReceivingAccounts(ctx context.Context, userID id.UserID) ([]*payee.ReceivingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReceivingAccounts", ctx, userID)
+	ret0, _ := ret[0].([]*payee.ReceivingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReceivingAccounts indicates an expected call of ListReceivingAccounts.
+func (mr *MockIPayeeRepositoryMockRecorder) ListReceivingAccounts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReceivingAccounts", reflect.TypeOf((*MockIPayeeRepository)(nil).ListReceivingAccounts), ctx, userID)
+}
+
+// Save mocks base method.
+func (m *MockIPayeeRepository) Save(ctx context.Context, payee *payee.Payee) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, payee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIPayeeRepositoryMockRecorder) Save(ctx, payee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIPayeeRepository)(nil).Save), ctx, payee)
+}
+
+// SaveReceivingAccount mocks base method.
+func (m *MockIPayeeRepository) SaveReceivingAccount(ctx context.Context, receivingAccount *payee.ReceivingAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReceivingAccount", ctx, receivingAccount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveReceivingAccount indicates an expected call of SaveReceivingAccount.
+func (mr *MockIPayeeRepositoryMockRecorder) SaveReceivingAccount(ctx, receivingAccount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReceivingAccount", reflect.TypeOf((*MockIPayeeRepository)(nil).SaveReceivingAccount), ctx, receivingAccount)
+}
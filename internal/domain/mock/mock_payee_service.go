@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/payee/payee_service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	account "github.com/u104rak1/pocgo/internal/domain/account"
+	payee "github.com/u104rak1/pocgo/internal/domain/payee"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// MockIPayeeService is a mock of IPayeeService interface.
+type MockIPayeeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPayeeServiceMockRecorder
+}
+
+// MockIPayeeServiceMockRecorder is the mock recorder for MockIPayeeService.
+type MockIPayeeServiceMockRecorder struct {
+	mock *MockIPayeeService
+}
+
+// NewMockIPayeeService creates a new mock instance.
+func NewMockIPayeeService(ctrl *gomock.Controller) *MockIPayeeService {
+	mock := &MockIPayeeService{ctrl: ctrl}
+	mock.recorder = &MockIPayeeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPayeeService) EXPECT() *MockIPayeeServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByUser mocks base method.
+func (m *MockIPayeeService) GetByUser(ctx context.Context, userID id.UserID, id id.PayeeID) (*payee.Payee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUser", ctx, userID, id)
+	ret0, _ := ret[0].(*payee.Payee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUser indicates an expected call of GetByUser.
+func (mr *MockIPayeeServiceMockRecorder) GetByUser(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MockIPayeeService)(nil).GetByUser), ctx, userID, id)
+}
+
+// Register mocks base method.
+func (m *MockIPayeeService) Register(ctx context.Context, userID id.UserID, email string, nickname *string) (*payee.Payee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, userID, email, nickname)
+	ret0, _ := ret[0].(*payee.Payee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockIPayeeServiceMockRecorder) Register(ctx, userID, email, nickname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIPayeeService)(nil).Register), ctx, userID, email, nickname)
+}
+
+// ResolveReceiverAccountID mocks base method.
+func (m *MockIPayeeService) ResolveReceiverAccountID(ctx context.Context, userID id.UserID, payeeID id.PayeeID, currency string) (id.AccountID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReceiverAccountID", ctx, userID, payeeID, currency)
+	ret0, _ := ret[0].(id.AccountID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveReceiverAccountID indicates an expected call of ResolveReceiverAccountID.
+func (mr *MockIPayeeServiceMockRecorder) ResolveReceiverAccountID(ctx, userID, payeeID, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReceiverAccountID", reflect.TypeOf((*MockIPayeeService)(nil).ResolveReceiverAccountID), ctx, userID, payeeID, currency)
+}
+
+// SetReceivingAccount mocks base method.
+func (m *MockIPayeeService) SetReceivingAccount(ctx context.Context, account *account.Account, currency string) (*payee.ReceivingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReceivingAccount", ctx, account, currency)
+	ret0, _ := ret[0].(*payee.ReceivingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReceivingAccount indicates an expected call of SetReceivingAccount.
+func (mr *MockIPayeeServiceMockRecorder) SetReceivingAccount(ctx, account, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReceivingAccount", reflect.TypeOf((*MockIPayeeService)(nil).SetReceivingAccount), ctx, account, currency)
+}
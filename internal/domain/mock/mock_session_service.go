@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/session/session_service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	session "github.com/u104rak1/pocgo/internal/domain/session"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// MockISessionService is a mock of ISessionService interface.
+type MockISessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionServiceMockRecorder
+}
+
+// MockISessionServiceMockRecorder is the mock recorder for MockISessionService.
+type MockISessionServiceMockRecorder struct {
+	mock *MockISessionService
+}
+
+// NewMockISessionService creates a new mock instance.
+func NewMockISessionService(ctrl *gomock.Controller) *MockISessionService {
+	mock := &MockISessionService{ctrl: ctrl}
+	mock.recorder = &MockISessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionService) EXPECT() *MockISessionServiceMockRecorder {
+	return m.recorder
+}
+
+// EnsureActive mocks base method.
+func (m *MockISessionService) EnsureActive(ctx context.Context, id id.SessionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureActive", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureActive indicates an expected call of EnsureActive.
+func (mr *MockISessionServiceMockRecorder) EnsureActive(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureActive", reflect.TypeOf((*MockISessionService)(nil).EnsureActive), ctx, id)
+}
+
+// Revoke mocks base method.
+func (m *MockISessionService) Revoke(ctx context.Context, id id.SessionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockISessionServiceMockRecorder) Revoke(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockISessionService)(nil).Revoke), ctx, id)
+}
+
+// Rotate mocks base method.
+func (m *MockISessionService) Rotate(ctx context.Context, refreshToken string) (*session.Session, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, refreshToken)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockISessionServiceMockRecorder) Rotate(ctx, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockISessionService)(nil).Rotate), ctx, refreshToken)
+}
+
+// Start mocks base method.
+func (m *MockISessionService) Start(ctx context.Context, userID id.UserID) (*session.Session, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, userID)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockISessionServiceMockRecorder) Start(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockISessionService)(nil).Start), ctx, userID)
+}
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/standing_order/standing_order_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	standingorder "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// MockIStandingOrderRepository is a mock of IStandingOrderRepository interface.
+type MockIStandingOrderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStandingOrderRepositoryMockRecorder
+}
+
+// MockIStandingOrderRepositoryMockRecorder is the mock recorder for MockIStandingOrderRepository.
+type MockIStandingOrderRepositoryMockRecorder struct {
+	mock *MockIStandingOrderRepository
+}
+
+// NewMockIStandingOrderRepository creates a new mock instance.
+func NewMockIStandingOrderRepository(ctrl *gomock.Controller) *MockIStandingOrderRepository {
+	mock := &MockIStandingOrderRepository{ctrl: ctrl}
+	mock.recorder = &MockIStandingOrderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStandingOrderRepository) EXPECT() *MockIStandingOrderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockIStandingOrderRepository) FindByID(ctx context.Context, id id.StandingOrderID) (*standingorder.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*standingorder.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockIStandingOrderRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIStandingOrderRepository)(nil).FindByID), ctx, id)
+}
+
+// ListByAccountID mocks base method.
+func (m *MockIStandingOrderRepository) ListByAccountID(ctx context.Context, accountID id.AccountID) ([]*standingorder.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccountID", ctx, accountID)
+	ret0, _ := ret[0].([]*standingorder.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccountID indicates an expected call of ListByAccountID.
+func (mr *MockIStandingOrderRepositoryMockRecorder) ListByAccountID(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountID", reflect.TypeOf((*MockIStandingOrderRepository)(nil).ListByAccountID), ctx, accountID)
+}
+
+// ListDue mocks base method.
+func (m *MockIStandingOrderRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*standingorder.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDue", ctx, now, limit)
+	ret0, _ := ret[0].([]*standingorder.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDue indicates an expected call of ListDue.
+func (mr *MockIStandingOrderRepositoryMockRecorder) ListDue(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDue", reflect.TypeOf((*MockIStandingOrderRepository)(nil).ListDue), ctx, now, limit)
+}
+
+// ListExecutions mocks base method.
+func (m *MockIStandingOrderRepository) ListExecutions(ctx context.Context, id id.StandingOrderID, limit int) ([]*standingorder.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExecutions", ctx, id, limit)
+	ret0, _ := ret[0].([]*standingorder.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExecutions indicates an expected call of ListExecutions.
+func (mr *MockIStandingOrderRepositoryMockRecorder) ListExecutions(ctx, id, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExecutions", reflect.TypeOf((*MockIStandingOrderRepository)(nil).ListExecutions), ctx, id, limit)
+}
+
+// Save mocks base method.
+func (m *MockIStandingOrderRepository) Save(ctx context.Context, order *standingorder.StandingOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIStandingOrderRepositoryMockRecorder) Save(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIStandingOrderRepository)(nil).Save), ctx, order)
+}
+
+// SaveExecution mocks base method.
+func (m *MockIStandingOrderRepository) SaveExecution(ctx context.Context, execution *standingorder.Execution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveExecution", ctx, execution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveExecution indicates an expected call of SaveExecution.
+func (mr *MockIStandingOrderRepositoryMockRecorder) SaveExecution(ctx, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveExecution", reflect.TypeOf((*MockIStandingOrderRepository)(nil).SaveExecution), ctx, execution)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/standing_order/standing_order_service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	standingorder "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// MockIStandingOrderService is a mock of IStandingOrderService interface.
+type MockIStandingOrderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStandingOrderServiceMockRecorder
+}
+
+// MockIStandingOrderServiceMockRecorder is the mock recorder for MockIStandingOrderService.
+type MockIStandingOrderServiceMockRecorder struct {
+	mock *MockIStandingOrderService
+}
+
+// NewMockIStandingOrderService creates a new mock instance.
+func NewMockIStandingOrderService(ctrl *gomock.Controller) *MockIStandingOrderService {
+	mock := &MockIStandingOrderService{ctrl: ctrl}
+	mock.recorder = &MockIStandingOrderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStandingOrderService) EXPECT() *MockIStandingOrderServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByAccount mocks base method.
+func (m *MockIStandingOrderService) GetByAccount(ctx context.Context, accountID id.AccountID, id id.StandingOrderID) (*standingorder.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccount", ctx, accountID, id)
+	ret0, _ := ret[0].(*standingorder.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccount indicates an expected call of GetByAccount.
+func (mr *MockIStandingOrderServiceMockRecorder) GetByAccount(ctx, accountID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccount", reflect.TypeOf((*MockIStandingOrderService)(nil).GetByAccount), ctx, accountID, id)
+}
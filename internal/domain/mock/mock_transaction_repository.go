@@ -7,9 +7,11 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	transaction "github.com/u104rak1/pocgo/internal/domain/transaction"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
 // MockITransactionRepository is a mock of ITransactionRepository interface.
@@ -35,20 +37,64 @@ func (m *MockITransactionRepository) EXPECT() *MockITransactionRepositoryMockRec
 	return m.recorder
 }
 
-// ListWithTotalByAccountID mocks base method.
-func (m *MockITransactionRepository) ListWithTotalByAccountID(ctx context.Context, params transaction.ListTransactionsParams) ([]*transaction.Transaction, int, error) {
+// CountByAccountID mocks base method.
+func (m *MockITransactionRepository) CountByAccountID(ctx context.Context, params transaction.ListTransactionsParams) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListWithTotalByAccountID", ctx, params)
+	ret := m.ctrl.Call(m, "CountByAccountID", ctx, params)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByAccountID indicates an expected call of CountByAccountID.
+func (mr *MockITransactionRepositoryMockRecorder) CountByAccountID(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByAccountID", reflect.TypeOf((*MockITransactionRepository)(nil).CountByAccountID), ctx, params)
+}
+
+// FindByID mocks base method.
+func (m *MockITransactionRepository) FindByID(ctx context.Context, id id.TransactionID) (*transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockITransactionRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockITransactionRepository)(nil).FindByID), ctx, id)
+}
+
+// FindReversalOf mocks base method.
+func (m *MockITransactionRepository) FindReversalOf(ctx context.Context, id id.TransactionID) (*transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReversalOf", ctx, id)
+	ret0, _ := ret[0].(*transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReversalOf indicates an expected call of FindReversalOf.
+func (mr *MockITransactionRepositoryMockRecorder) FindReversalOf(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReversalOf", reflect.TypeOf((*MockITransactionRepository)(nil).FindReversalOf), ctx, id)
+}
+
+// ListByAccountID mocks base method.
+func (m *MockITransactionRepository) ListByAccountID(ctx context.Context, params transaction.ListTransactionsParams) ([]*transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccountID", ctx, params)
 	ret0, _ := ret[0].([]*transaction.Transaction)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ListWithTotalByAccountID indicates an expected call of ListWithTotalByAccountID.
-func (mr *MockITransactionRepositoryMockRecorder) ListWithTotalByAccountID(ctx, params interface{}) *gomock.Call {
+// ListByAccountID indicates an expected call of ListByAccountID.
+func (mr *MockITransactionRepositoryMockRecorder) ListByAccountID(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithTotalByAccountID", reflect.TypeOf((*MockITransactionRepository)(nil).ListWithTotalByAccountID), ctx, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountID", reflect.TypeOf((*MockITransactionRepository)(nil).ListByAccountID), ctx, params)
 }
 
 // Save mocks base method.
@@ -64,3 +110,32 @@ func (mr *MockITransactionRepositoryMockRecorder) Save(ctx, transaction interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockITransactionRepository)(nil).Save), ctx, transaction)
 }
+
+// SaveCategories mocks base method.
+func (m *MockITransactionRepository) SaveCategories(ctx context.Context, transaction *transaction.Transaction, accountID id.AccountID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCategories", ctx, transaction, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCategories indicates an expected call of SaveCategories.
+func (mr *MockITransactionRepositoryMockRecorder) SaveCategories(ctx, transaction, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCategories", reflect.TypeOf((*MockITransactionRepository)(nil).SaveCategories), ctx, transaction, accountID)
+}
+
+// SumAmount mocks base method.
+func (m *MockITransactionRepository) SumAmount(ctx context.Context, accountID id.AccountID, operationType string, from time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumAmount", ctx, accountID, operationType, from)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumAmount indicates an expected call of SumAmount.
+func (mr *MockITransactionRepositoryMockRecorder) SumAmount(ctx, accountID, operationType, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumAmount", reflect.TypeOf((*MockITransactionRepository)(nil).SumAmount), ctx, accountID, operationType, from)
+}
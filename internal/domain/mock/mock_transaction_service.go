@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	account "github.com/u104rak1/pocgo/internal/domain/account"
 	transaction "github.com/u104rak1/pocgo/internal/domain/transaction"
+	id "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
 // MockITransactionService is a mock of ITransactionService interface.
@@ -36,63 +37,120 @@ func (m *MockITransactionService) EXPECT() *MockITransactionServiceMockRecorder
 	return m.recorder
 }
 
+// Categorize mocks base method.
+func (m *MockITransactionService) Categorize(ctx context.Context, accountID id.AccountID, transaction *transaction.Transaction, categories []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Categorize", ctx, accountID, transaction, categories)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Categorize indicates an expected call of Categorize.
+func (mr *MockITransactionServiceMockRecorder) Categorize(ctx, accountID, transaction, categories interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Categorize", reflect.TypeOf((*MockITransactionService)(nil).Categorize), ctx, accountID, transaction, categories)
+}
+
+// CheckLimit mocks base method.
+func (m *MockITransactionService) CheckLimit(ctx context.Context, account *account.Account, operationType string, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckLimit", ctx, account, operationType, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckLimit indicates an expected call of CheckLimit.
+func (mr *MockITransactionServiceMockRecorder) CheckLimit(ctx, account, operationType, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLimit", reflect.TypeOf((*MockITransactionService)(nil).CheckLimit), ctx, account, operationType, amount)
+}
+
 // Deposit mocks base method.
-func (m *MockITransactionService) Deposit(ctx context.Context, account *account.Account, amount float64, currency string) (*transaction.Transaction, error) {
+func (m *MockITransactionService) Deposit(ctx context.Context, account *account.Account, amount int64, currency string, description transaction.Description) (*transaction.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Deposit", ctx, account, amount, currency)
+	ret := m.ctrl.Call(m, "Deposit", ctx, account, amount, currency, description)
 	ret0, _ := ret[0].(*transaction.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Deposit indicates an expected call of Deposit.
-func (mr *MockITransactionServiceMockRecorder) Deposit(ctx, account, amount, currency interface{}) *gomock.Call {
+func (mr *MockITransactionServiceMockRecorder) Deposit(ctx, account, amount, currency, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockITransactionService)(nil).Deposit), ctx, account, amount, currency, description)
+}
+
+// GetByAccount mocks base method.
+func (m *MockITransactionService) GetByAccount(ctx context.Context, accountID id.AccountID, transactionID id.TransactionID) (*transaction.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccount", ctx, accountID, transactionID)
+	ret0, _ := ret[0].(*transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccount indicates an expected call of GetByAccount.
+func (mr *MockITransactionServiceMockRecorder) GetByAccount(ctx, accountID, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccount", reflect.TypeOf((*MockITransactionService)(nil).GetByAccount), ctx, accountID, transactionID)
+}
+
+// List mocks base method.
+func (m *MockITransactionService) List(ctx context.Context, params transaction.ListTransactionsParams) (*transaction.ListTransactionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, params)
+	ret0, _ := ret[0].(*transaction.ListTransactionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockITransactionServiceMockRecorder) List(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockITransactionService)(nil).Deposit), ctx, account, amount, currency)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockITransactionService)(nil).List), ctx, params)
 }
 
-// ListWithTotal mocks base method.
-func (m *MockITransactionService) ListWithTotal(ctx context.Context, params transaction.ListTransactionsParams) ([]*transaction.Transaction, int, error) {
+// Reverse mocks base method.
+func (m *MockITransactionService) Reverse(ctx context.Context, account *account.Account, original *transaction.Transaction) (*transaction.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListWithTotal", ctx, params)
-	ret0, _ := ret[0].([]*transaction.Transaction)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "Reverse", ctx, account, original)
+	ret0, _ := ret[0].(*transaction.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ListWithTotal indicates an expected call of ListWithTotal.
-func (mr *MockITransactionServiceMockRecorder) ListWithTotal(ctx, params interface{}) *gomock.Call {
+// Reverse indicates an expected call of Reverse.
+func (mr *MockITransactionServiceMockRecorder) Reverse(ctx, account, original interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithTotal", reflect.TypeOf((*MockITransactionService)(nil).ListWithTotal), ctx, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reverse", reflect.TypeOf((*MockITransactionService)(nil).Reverse), ctx, account, original)
 }
 
 // Transfer mocks base method.
-func (m *MockITransactionService) Transfer(ctx context.Context, senderAccount, receiverAccount *account.Account, amount float64, currency string) (*transaction.Transaction, error) {
+func (m *MockITransactionService) Transfer(ctx context.Context, senderAccount, receiverAccount *account.Account, amount int64, currency string, description transaction.Description) (*transaction.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Transfer", ctx, senderAccount, receiverAccount, amount, currency)
+	ret := m.ctrl.Call(m, "Transfer", ctx, senderAccount, receiverAccount, amount, currency, description)
 	ret0, _ := ret[0].(*transaction.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Transfer indicates an expected call of Transfer.
-func (mr *MockITransactionServiceMockRecorder) Transfer(ctx, senderAccount, receiverAccount, amount, currency interface{}) *gomock.Call {
+func (mr *MockITransactionServiceMockRecorder) Transfer(ctx, senderAccount, receiverAccount, amount, currency, description interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockITransactionService)(nil).Transfer), ctx, senderAccount, receiverAccount, amount, currency)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockITransactionService)(nil).Transfer), ctx, senderAccount, receiverAccount, amount, currency, description)
 }
 
 // Withdrawal mocks base method.
-func (m *MockITransactionService) Withdrawal(ctx context.Context, account *account.Account, amount float64, currency string) (*transaction.Transaction, error) {
+func (m *MockITransactionService) Withdrawal(ctx context.Context, account *account.Account, amount int64, currency string, description transaction.Description) (*transaction.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Withdrawal", ctx, account, amount, currency)
+	ret := m.ctrl.Call(m, "Withdrawal", ctx, account, amount, currency, description)
 	ret0, _ := ret[0].(*transaction.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Withdrawal indicates an expected call of Withdrawal.
-func (mr *MockITransactionServiceMockRecorder) Withdrawal(ctx, account, amount, currency interface{}) *gomock.Call {
+func (mr *MockITransactionServiceMockRecorder) Withdrawal(ctx, account, amount, currency, description interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdrawal", reflect.TypeOf((*MockITransactionService)(nil).Withdrawal), ctx, account, amount, currency)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdrawal", reflect.TypeOf((*MockITransactionService)(nil).Withdrawal), ctx, account, amount, currency, description)
 }
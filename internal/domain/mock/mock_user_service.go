@@ -65,6 +65,20 @@ func (mr *MockIUserServiceMockRecorder) FindUser(ctx, id interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUser", reflect.TypeOf((*MockIUserService)(nil).FindUser), ctx, id)
 }
 
+// Register mocks base method.
+func (m *MockIUserService) Register(ctx context.Context, user *user.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockIUserServiceMockRecorder) Register(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIUserService)(nil).Register), ctx, user)
+}
+
 // VerifyEmailUniqueness mocks base method.
 func (m *MockIUserService) VerifyEmailUniqueness(ctx context.Context, email string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,170 @@
+package payee
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// Payee は利用者が振込先として登録した他の利用者です。
+// 支払先の利用者の名前は伏せた状態で保持し、利用者が名前を確認するまで支払先への振込はできません。
+type Payee struct {
+	id          idVO.PayeeID
+	userID      idVO.UserID
+	payeeUserID idVO.UserID
+	// 登録時点の支払先の利用者の名前を、各単語の先頭の1文字以外を伏せた状態で保持します。
+	maskedName string
+	nickname   *string
+	// 利用者が支払先の名前を確認した日時です。確認するまでは nil です。
+	confirmedAt *time.Time
+	createdAt   time.Time
+}
+
+// 支払先を作成します。作成時点では未確認です。
+func New(userID, payeeUserID idVO.UserID, payeeName string, nickname *string, now time.Time) (*Payee, error) {
+	if userID == payeeUserID {
+		return nil, ErrSelfPayee
+	}
+	n, err := newNickname(nickname)
+	if err != nil {
+		return nil, err
+	}
+	return &Payee{
+		id:          idVO.NewPayeeID(),
+		userID:      userID,
+		payeeUserID: payeeUserID,
+		maskedName:  MaskName(payeeName),
+		nickname:    n,
+		createdAt:   now,
+	}, nil
+}
+
+// データベースから支払先を再構築します。
+func Reconstruct(
+	id, userID, payeeUserID, maskedName string,
+	nickname *string,
+	confirmedAt *time.Time,
+	createdAt time.Time,
+) (*Payee, error) {
+	pID, err := idVO.PayeeIDFromString(id)
+	if err != nil {
+		return nil, err
+	}
+	uID, err := idVO.UserIDFromString(userID)
+	if err != nil {
+		return nil, err
+	}
+	puID, err := idVO.UserIDFromString(payeeUserID)
+	if err != nil {
+		return nil, err
+	}
+	return &Payee{
+		id:          pID,
+		userID:      uID,
+		payeeUserID: puID,
+		maskedName:  maskedName,
+		nickname:    nickname,
+		confirmedAt: confirmedAt,
+		createdAt:   createdAt,
+	}, nil
+}
+
+// 前後の空白を除き、空になった場合は指定されなかったものとして扱います。
+func newNickname(nickname *string) (*string, error) {
+	if nickname == nil {
+		return nil, nil
+	}
+	trimmed := strings.TrimSpace(*nickname)
+	if trimmed == "" {
+		return nil, nil
+	}
+	if utf8.RuneCountInString(trimmed) > NicknameMaxLength {
+		return nil, ErrInvalidNickname
+	}
+	return &trimmed, nil
+}
+
+// 空白で区切った各単語の先頭の1文字以外を MaskRune に置き換えます。例えば "Sato Taro" は "S*** T***" になります。
+func MaskName(name string) string {
+	words := strings.Fields(name)
+	for i, word := range words {
+		first, size := utf8.DecodeRuneInString(word)
+		words[i] = string(first) + strings.Repeat(string(MaskRune), utf8.RuneCountInString(word[size:]))
+	}
+	return strings.Join(words, " ")
+}
+
+func (p *Payee) ID() idVO.PayeeID {
+	return p.id
+}
+
+func (p *Payee) IDString() string {
+	return p.id.String()
+}
+
+func (p *Payee) UserID() idVO.UserID {
+	return p.userID
+}
+
+func (p *Payee) UserIDString() string {
+	return p.userID.String()
+}
+
+func (p *Payee) PayeeUserID() idVO.UserID {
+	return p.payeeUserID
+}
+
+func (p *Payee) PayeeUserIDString() string {
+	return p.payeeUserID.String()
+}
+
+func (p *Payee) MaskedName() string {
+	return p.maskedName
+}
+
+func (p *Payee) Nickname() *string {
+	return p.nickname
+}
+
+func (p *Payee) ConfirmedAt() *time.Time {
+	return p.confirmedAt
+}
+
+func (p *Payee) ConfirmedAtString() *string {
+	if p.confirmedAt == nil {
+		return nil
+	}
+	s := timer.FormatToISO8601(*p.confirmedAt)
+	return &s
+}
+
+func (p *Payee) IsConfirmed() bool {
+	return p.confirmedAt != nil
+}
+
+func (p *Payee) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Payee) CreatedAtString() string {
+	return timer.FormatToISO8601(p.createdAt)
+}
+
+// 利用者が伏せた名前を確認したことを記録します。既に確認済みの場合は最初に確認した日時のままです。
+func (p *Payee) Confirm(now time.Time) {
+	if p.confirmedAt != nil {
+		return
+	}
+	p.confirmedAt = &now
+}
+
+// 振込ができる状態かを検証します。
+func (p *Payee) CheckPayable() error {
+	if !p.IsConfirmed() {
+		return ErrNotConfirmed
+	}
+	return nil
+}
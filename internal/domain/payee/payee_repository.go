@@ -0,0 +1,25 @@
+package payee
+
+import (
+	"context"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IPayeeRepository interface {
+	Save(ctx context.Context, payee *Payee) error
+	// 存在しない場合は nil を返します。
+	FindByID(ctx context.Context, id idVO.PayeeID) (*Payee, error)
+	// 登録日時の古い順に取得します。
+	ListByUserID(ctx context.Context, userID idVO.UserID) ([]*Payee, error)
+	CountByUserID(ctx context.Context, userID idVO.UserID) (int, error)
+	ExistsByUserIDAndPayeeUserID(ctx context.Context, userID, payeeUserID idVO.UserID) (bool, error)
+	Delete(ctx context.Context, payee *Payee) error
+
+	// 利用者と通貨の組み合わせごとに1件のみ保持し、既に存在する場合は置き換えます。
+	SaveReceivingAccount(ctx context.Context, receivingAccount *ReceivingAccount) error
+	// 存在しない場合は nil を返します。
+	FindReceivingAccount(ctx context.Context, userID idVO.UserID, currency string) (*ReceivingAccount, error)
+	// 通貨コードの順に取得します。
+	ListReceivingAccounts(ctx context.Context, userID idVO.UserID) ([]*ReceivingAccount, error)
+}
@@ -0,0 +1,110 @@
+package payee
+
+import (
+	"context"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type IPayeeService interface {
+	// メールアドレスで支払先の利用者を探し、未確認の支払先として登録します。
+	Register(ctx context.Context, userID idVO.UserID, email string, nickname *string) (*Payee, error)
+	// 指定された利用者の支払先を取得します。存在しない場合や他の利用者の支払先の場合は ErrNotFound を返します。
+	GetByUser(ctx context.Context, userID idVO.UserID, id idVO.PayeeID) (*Payee, error)
+	// 支払先が振込の通貨で受け取る既定の口座のIDを返します。支払先が未確認の場合は ErrNotConfirmed を返します。
+	ResolveReceiverAccountID(ctx context.Context, userID idVO.UserID, payeeID idVO.PayeeID, currency string) (idVO.AccountID, error)
+	// 口座を口座の通貨の既定の受取口座にします。currency は口座の通貨と一致する必要があります。
+	SetReceivingAccount(ctx context.Context, account *accountDomain.Account, currency string) (*ReceivingAccount, error)
+}
+
+type payeeService struct {
+	payeeRepo IPayeeRepository
+	userRepo  userDomain.IUserRepository
+}
+
+func NewService(payeeRepository IPayeeRepository, userRepository userDomain.IUserRepository) IPayeeService {
+	return &payeeService{
+		payeeRepo: payeeRepository,
+		userRepo:  userRepository,
+	}
+}
+
+func (s *payeeService) Register(ctx context.Context, userID idVO.UserID, email string, nickname *string) (*Payee, error) {
+	payeeUser, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if payeeUser == nil {
+		return nil, ErrRecipientNotFound
+	}
+
+	payee, err := New(userID, payeeUser.ID(), payeeUser.Name(), nickname, timer.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.payeeRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= MaxPayeeLimit {
+		return nil, ErrLimitReached
+	}
+
+	exists, err := s.payeeRepo.ExistsByUserIDAndPayeeUserID(ctx, userID, payeeUser.ID())
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrAlreadyExists
+	}
+
+	if err := s.payeeRepo.Save(ctx, payee); err != nil {
+		return nil, err
+	}
+	return payee, nil
+}
+
+func (s *payeeService) GetByUser(ctx context.Context, userID idVO.UserID, id idVO.PayeeID) (*Payee, error) {
+	payee, err := s.payeeRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if payee == nil || payee.UserID() != userID {
+		return nil, ErrNotFound
+	}
+	return payee, nil
+}
+
+func (s *payeeService) ResolveReceiverAccountID(ctx context.Context, userID idVO.UserID, payeeID idVO.PayeeID, currency string) (idVO.AccountID, error) {
+	payee, err := s.GetByUser(ctx, userID, payeeID)
+	if err != nil {
+		return idVO.AccountID{}, err
+	}
+	if err := payee.CheckPayable(); err != nil {
+		return idVO.AccountID{}, err
+	}
+
+	receivingAccount, err := s.payeeRepo.FindReceivingAccount(ctx, payee.PayeeUserID(), currency)
+	if err != nil {
+		return idVO.AccountID{}, err
+	}
+	if receivingAccount == nil {
+		return idVO.AccountID{}, ErrNoReceivingAccount
+	}
+	return receivingAccount.AccountID(), nil
+}
+
+func (s *payeeService) SetReceivingAccount(ctx context.Context, account *accountDomain.Account, currency string) (*ReceivingAccount, error) {
+	if account.Balance().Currency() != currency {
+		return nil, ErrDifferentCurrency
+	}
+	receivingAccount := NewReceivingAccount(account, timer.Now())
+	if err := s.payeeRepo.SaveReceivingAccount(ctx, receivingAccount); err != nil {
+		return nil, err
+	}
+	return receivingAccount, nil
+}
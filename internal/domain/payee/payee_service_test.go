@@ -0,0 +1,309 @@
+package payee_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	"github.com/u104rak1/pocgo/internal/domain/mock"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type Mocks struct {
+	payeeRepo *mock.MockIPayeeRepository
+	userRepo  *mock.MockIUserRepository
+}
+
+func newService(t *testing.T) (payeeDomain.IPayeeService, Mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mocks := Mocks{
+		payeeRepo: mock.NewMockIPayeeRepository(ctrl),
+		userRepo:  mock.NewMockIUserRepository(ctrl),
+	}
+	return payeeDomain.NewService(mocks.payeeRepo, mocks.userRepo), mocks
+}
+
+func newPayee(t *testing.T, confirmed bool) *payeeDomain.Payee {
+	t.Helper()
+	payee, err := payeeDomain.New(idVO.NewUserIDForTest("user"), idVO.NewUserIDForTest("payee"), "Sato Taro", nil, timer.GetFixedDate())
+	assert.NoError(t, err)
+	if confirmed {
+		payee.Confirm(timer.GetFixedDate())
+	}
+	return payee
+}
+
+func TestRegister(t *testing.T) {
+	var (
+		arg    = gomock.Any()
+		userID = idVO.NewUserIDForTest("user")
+		email  = "sato@example.com"
+	)
+	payeeUser, err := userDomain.Reconstruct(idVO.NewUserIDForTest("payee").String(), "Sato Taro", email)
+	assert.NoError(t, err)
+	self, err := userDomain.Reconstruct(userID.String(), "Yamada Hanako", email)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName string
+		prepare  func(mocks Mocks)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: メールアドレスで支払先を登録できる",
+			prepare: func(mocks Mocks) {
+				mocks.userRepo.EXPECT().FindByEmail(arg, email).Return(payeeUser, nil)
+				mocks.payeeRepo.EXPECT().CountByUserID(arg, userID).Return(0, nil)
+				mocks.payeeRepo.EXPECT().ExistsByUserIDAndPayeeUserID(arg, userID, payeeUser.ID()).Return(false, nil)
+				mocks.payeeRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+		},
+		{
+			caseName: "Negative: メールアドレスの利用者が存在しない場合は ErrRecipientNotFound が返る",
+			prepare: func(mocks Mocks) {
+				mocks.userRepo.EXPECT().FindByEmail(arg, email).Return(nil, nil)
+			},
+			wantErr: payeeDomain.ErrRecipientNotFound,
+		},
+		{
+			caseName: "Negative: 自分自身のメールアドレスの場合は ErrSelfPayee が返る",
+			prepare: func(mocks Mocks) {
+				mocks.userRepo.EXPECT().FindByEmail(arg, email).Return(self, nil)
+			},
+			wantErr: payeeDomain.ErrSelfPayee,
+		},
+		{
+			caseName: "Negative: 登録できる上限に達している場合は ErrLimitReached が返る",
+			prepare: func(mocks Mocks) {
+				mocks.userRepo.EXPECT().FindByEmail(arg, email).Return(payeeUser, nil)
+				mocks.payeeRepo.EXPECT().CountByUserID(arg, userID).Return(payeeDomain.MaxPayeeLimit, nil)
+			},
+			wantErr: payeeDomain.ErrLimitReached,
+		},
+		{
+			caseName: "Negative: 既に登録済みの場合は ErrAlreadyExists が返る",
+			prepare: func(mocks Mocks) {
+				mocks.userRepo.EXPECT().FindByEmail(arg, email).Return(payeeUser, nil)
+				mocks.payeeRepo.EXPECT().CountByUserID(arg, userID).Return(1, nil)
+				mocks.payeeRepo.EXPECT().ExistsByUserIDAndPayeeUserID(arg, userID, payeeUser.ID()).Return(true, nil)
+			},
+			wantErr: payeeDomain.ErrAlreadyExists,
+		},
+		{
+			caseName: "Negative: 保存に失敗した場合はエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.userRepo.EXPECT().FindByEmail(arg, email).Return(payeeUser, nil)
+				mocks.payeeRepo.EXPECT().CountByUserID(arg, userID).Return(0, nil)
+				mocks.payeeRepo.EXPECT().ExistsByUserIDAndPayeeUserID(arg, userID, payeeUser.ID()).Return(false, nil)
+				mocks.payeeRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			service, mocks := newService(t)
+			tt.prepare(mocks)
+
+			payee, err := service.Register(context.Background(), userID, email, nil)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, payee)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, payeeUser.ID(), payee.PayeeUserID())
+			assert.Equal(t, "S*** T***", payee.MaskedName())
+			assert.False(t, payee.IsConfirmed())
+		})
+	}
+}
+
+func TestGetByUser(t *testing.T) {
+	arg := gomock.Any()
+	payee := newPayee(t, false)
+
+	tests := []struct {
+		caseName string
+		userID   idVO.UserID
+		prepare  func(mocks Mocks)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 利用者の支払先を取得できる",
+			userID:   payee.UserID(),
+			prepare: func(mocks Mocks) {
+				mocks.payeeRepo.EXPECT().FindByID(arg, payee.ID()).Return(payee, nil)
+			},
+		},
+		{
+			caseName: "Negative: 支払先が存在しない場合は ErrNotFound が返る",
+			userID:   payee.UserID(),
+			prepare: func(mocks Mocks) {
+				mocks.payeeRepo.EXPECT().FindByID(arg, payee.ID()).Return(nil, nil)
+			},
+			wantErr: payeeDomain.ErrNotFound,
+		},
+		{
+			caseName: "Negative: 他の利用者の支払先の場合は ErrNotFound が返る",
+			userID:   idVO.NewUserIDForTest("other"),
+			prepare: func(mocks Mocks) {
+				mocks.payeeRepo.EXPECT().FindByID(arg, payee.ID()).Return(payee, nil)
+			},
+			wantErr: payeeDomain.ErrNotFound,
+		},
+		{
+			caseName: "Negative: 取得に失敗した場合はエラーが返る",
+			userID:   payee.UserID(),
+			prepare: func(mocks Mocks) {
+				mocks.payeeRepo.EXPECT().FindByID(arg, payee.ID()).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			service, mocks := newService(t)
+			tt.prepare(mocks)
+
+			got, err := service.GetByUser(context.Background(), tt.userID, payee.ID())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, payee, got)
+		})
+	}
+}
+
+func TestResolveReceiverAccountID(t *testing.T) {
+	arg := gomock.Any()
+	confirmed := newPayee(t, true)
+	unconfirmed := newPayee(t, false)
+	receiverAccountID := idVO.NewAccountIDForTest("receiver")
+	receivingAccount, err := payeeDomain.ReconstructReceivingAccount(
+		confirmed.PayeeUserIDString(), moneyVO.JPY, receiverAccountID.String(), timer.GetFixedDate(),
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName string
+		payee    *payeeDomain.Payee
+		prepare  func(mocks Mocks)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 確認済みの支払先の通貨の既定の受取口座が返る",
+			payee:    confirmed,
+			prepare: func(mocks Mocks) {
+				mocks.payeeRepo.EXPECT().FindByID(arg, confirmed.ID()).Return(confirmed, nil)
+				mocks.payeeRepo.EXPECT().FindReceivingAccount(arg, confirmed.PayeeUserID(), moneyVO.JPY).Return(receivingAccount, nil)
+			},
+		},
+		{
+			caseName: "Negative: 未確認の支払先の場合は ErrNotConfirmed が返る",
+			payee:    unconfirmed,
+			prepare: func(mocks Mocks) {
+				mocks.payeeRepo.EXPECT().FindByID(arg, unconfirmed.ID()).Return(unconfirmed, nil)
+			},
+			wantErr: payeeDomain.ErrNotConfirmed,
+		},
+		{
+			caseName: "Negative: 通貨の受取口座がない場合は ErrNoReceivingAccount が返る",
+			payee:    confirmed,
+			prepare: func(mocks Mocks) {
+				mocks.payeeRepo.EXPECT().FindByID(arg, confirmed.ID()).Return(confirmed, nil)
+				mocks.payeeRepo.EXPECT().FindReceivingAccount(arg, confirmed.PayeeUserID(), moneyVO.JPY).Return(nil, nil)
+			},
+			wantErr: payeeDomain.ErrNoReceivingAccount,
+		},
+		{
+			caseName: "Negative: 受取口座の取得に失敗した場合はエラーが返る",
+			payee:    confirmed,
+			prepare: func(mocks Mocks) {
+				mocks.payeeRepo.EXPECT().FindByID(arg, confirmed.ID()).Return(confirmed, nil)
+				mocks.payeeRepo.EXPECT().FindReceivingAccount(arg, confirmed.PayeeUserID(), moneyVO.JPY).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			service, mocks := newService(t)
+			tt.prepare(mocks)
+
+			accountID, err := service.ResolveReceiverAccountID(context.Background(), tt.payee.UserID(), tt.payee.ID(), moneyVO.JPY)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, receiverAccountID, accountID)
+		})
+	}
+}
+
+func TestSetReceivingAccount(t *testing.T) {
+	arg := gomock.Any()
+	account, err := accountDomain.New(idVO.NewUserIDForTest("user"), 0, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName string
+		currency string
+		prepare  func(mocks Mocks)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 口座を既定の受取口座にできる",
+			currency: moneyVO.JPY,
+			prepare: func(mocks Mocks) {
+				mocks.payeeRepo.EXPECT().SaveReceivingAccount(arg, arg).Return(nil)
+			},
+		},
+		{
+			caseName: "Negative: 口座と異なる通貨の場合は ErrDifferentCurrency が返る",
+			currency: moneyVO.USD,
+			prepare:  func(mocks Mocks) {},
+			wantErr:  payeeDomain.ErrDifferentCurrency,
+		},
+		{
+			caseName: "Negative: 保存に失敗した場合はエラーが返る",
+			currency: moneyVO.JPY,
+			prepare: func(mocks Mocks) {
+				mocks.payeeRepo.EXPECT().SaveReceivingAccount(arg, arg).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			service, mocks := newService(t)
+			tt.prepare(mocks)
+
+			receivingAccount, err := service.SetReceivingAccount(context.Background(), account, tt.currency)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, receivingAccount)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, account.UserID(), receivingAccount.UserID())
+			assert.Equal(t, account.ID(), receivingAccount.AccountID())
+			assert.Equal(t, moneyVO.JPY, receivingAccount.Currency())
+		})
+	}
+}
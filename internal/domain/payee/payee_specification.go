@@ -0,0 +1,26 @@
+package payee
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	NicknameMaxLength = 30
+	// 1人の利用者が登録できる支払先の上限です。
+	MaxPayeeLimit = 100
+	// 支払先の名前を伏せる際に使用する文字です。
+	MaskRune = '*'
+)
+
+var (
+	ErrNotFound           = errors.New("payee not found")
+	ErrRecipientNotFound  = errors.New("no user is registered with the email")
+	ErrSelfPayee          = errors.New("you cannot register yourself as a payee")
+	ErrAlreadyExists      = errors.New("payee is already registered")
+	ErrLimitReached       = fmt.Errorf("payee limit reached, maximum %d payees", MaxPayeeLimit)
+	ErrInvalidNickname    = fmt.Errorf("payee nickname must be %d characters or less", NicknameMaxLength)
+	ErrNotConfirmed       = errors.New("payee must be confirmed before the first payment")
+	ErrNoReceivingAccount = errors.New("payee has no receiving account for the currency")
+	ErrDifferentCurrency  = errors.New("receiving account currency must match the specified currency")
+)
@@ -0,0 +1,102 @@
+package payee_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestNew(t *testing.T) {
+	var (
+		userID      = idVO.NewUserIDForTest("user")
+		payeeUserID = idVO.NewUserIDForTest("payee")
+	)
+
+	tests := []struct {
+		caseName     string
+		payeeUserID  idVO.UserID
+		nickname     *string
+		wantNickname *string
+		wantErr      error
+	}{
+		{
+			caseName:     "Positive: 未確認の支払先を作成できる",
+			payeeUserID:  payeeUserID,
+			nickname:     strutil.StrPointer(" Landlord "),
+			wantNickname: strutil.StrPointer("Landlord"),
+		},
+		{
+			caseName:     "Positive: 空白のみのニックネームは指定されなかったものとして扱う",
+			payeeUserID:  payeeUserID,
+			nickname:     strutil.StrPointer("  "),
+			wantNickname: nil,
+		},
+		{
+			caseName:    "Negative: 自分自身は支払先にできない",
+			payeeUserID: userID,
+			wantErr:     payeeDomain.ErrSelfPayee,
+		},
+		{
+			caseName:    "Negative: ニックネームが長すぎる場合はエラーが返る",
+			payeeUserID: payeeUserID,
+			nickname:    strutil.StrPointer("あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほま"),
+			wantErr:     payeeDomain.ErrInvalidNickname,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			payee, err := payeeDomain.New(userID, tt.payeeUserID, "Sato Taro", tt.nickname, timer.GetFixedDate())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, payee)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, userID, payee.UserID())
+			assert.Equal(t, tt.payeeUserID, payee.PayeeUserID())
+			assert.Equal(t, "S*** T***", payee.MaskedName())
+			assert.Equal(t, tt.wantNickname, payee.Nickname())
+			assert.False(t, payee.IsConfirmed())
+			assert.ErrorIs(t, payee.CheckPayable(), payeeDomain.ErrNotConfirmed)
+		})
+	}
+}
+
+func TestMaskName(t *testing.T) {
+	tests := []struct {
+		caseName string
+		name     string
+		want     string
+	}{
+		{caseName: "Positive: 各単語の先頭の1文字以外を伏せる", name: "Sato Taro", want: "S*** T***"},
+		{caseName: "Positive: 連続した空白は1つにまとめる", name: "  Sato   Taro ", want: "S*** T***"},
+		{caseName: "Positive: マルチバイト文字も1文字ずつ伏せる", name: "佐藤 太郎", want: "佐* 太*"},
+		{caseName: "Positive: 1文字の単語はそのまま", name: "A Bc", want: "A B*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			assert.Equal(t, tt.want, payeeDomain.MaskName(tt.name))
+		})
+	}
+}
+
+func TestPayee_Confirm(t *testing.T) {
+	payee, err := payeeDomain.New(idVO.NewUserIDForTest("user"), idVO.NewUserIDForTest("payee"), "Sato Taro", nil, timer.GetFixedDate())
+	assert.NoError(t, err)
+
+	confirmedAt := timer.GetFixedDate().AddDate(0, 0, 1)
+	payee.Confirm(confirmedAt)
+	assert.True(t, payee.IsConfirmed())
+	assert.NoError(t, payee.CheckPayable())
+	assert.Equal(t, confirmedAt, *payee.ConfirmedAt())
+
+	// 再度確認しても最初に確認した日時のまま
+	payee.Confirm(confirmedAt.AddDate(0, 0, 1))
+	assert.Equal(t, confirmedAt, *payee.ConfirmedAt())
+}
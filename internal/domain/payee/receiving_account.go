@@ -0,0 +1,74 @@
+package payee
+
+import (
+	"time"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// ReceivingAccount は利用者が支払先として振込を受け取る際に使用する、通貨ごとの既定の口座です。
+// 他の利用者が支払先IDを指定して振込を行うと、振込の通貨の既定の口座に入金されます。
+type ReceivingAccount struct {
+	userID    idVO.UserID
+	currency  string
+	accountID idVO.AccountID
+	updatedAt time.Time
+}
+
+// 口座を口座の通貨の既定の受取口座にします。
+func NewReceivingAccount(account *accountDomain.Account, now time.Time) *ReceivingAccount {
+	return &ReceivingAccount{
+		userID:    account.UserID(),
+		currency:  account.Balance().Currency(),
+		accountID: account.ID(),
+		updatedAt: now,
+	}
+}
+
+// データベースから受取口座を再構築します。
+func ReconstructReceivingAccount(userID, currency, accountID string, updatedAt time.Time) (*ReceivingAccount, error) {
+	uID, err := idVO.UserIDFromString(userID)
+	if err != nil {
+		return nil, err
+	}
+	aID, err := idVO.AccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &ReceivingAccount{
+		userID:    uID,
+		currency:  currency,
+		accountID: aID,
+		updatedAt: updatedAt,
+	}, nil
+}
+
+func (r *ReceivingAccount) UserID() idVO.UserID {
+	return r.userID
+}
+
+func (r *ReceivingAccount) UserIDString() string {
+	return r.userID.String()
+}
+
+func (r *ReceivingAccount) Currency() string {
+	return r.currency
+}
+
+func (r *ReceivingAccount) AccountID() idVO.AccountID {
+	return r.accountID
+}
+
+func (r *ReceivingAccount) AccountIDString() string {
+	return r.accountID.String()
+}
+
+func (r *ReceivingAccount) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+func (r *ReceivingAccount) UpdatedAtString() string {
+	return timer.FormatToISO8601(r.updatedAt)
+}
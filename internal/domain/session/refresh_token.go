@@ -0,0 +1,101 @@
+package session
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// RefreshToken はセッションに紐づくリフレッシュトークンを表します。
+// トークンの値そのものは保持せず、SHA-256 のハッシュ値のみを保持します。
+type RefreshToken struct {
+	tokenHash string
+	sessionID idVO.SessionID
+	expiresAt time.Time
+	usedAt    *time.Time
+	createdAt time.Time
+}
+
+// リフレッシュトークンを新規に発行します。クライアントに返却するトークンの値は戻り値でのみ取得できます。
+func NewRefreshToken(sessionID idVO.SessionID) (*RefreshToken, string, error) {
+	b := make([]byte, RefreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	now := timer.Now()
+	refreshToken, err := newRefreshToken(HashRefreshToken(token), sessionID, now.Add(RefreshTokenTTL), nil, now)
+	if err != nil {
+		return nil, "", err
+	}
+	return refreshToken, token, nil
+}
+
+func ReconstructRefreshToken(tokenHash, sessionID string, expiresAt time.Time, usedAt *time.Time, createdAt time.Time) (*RefreshToken, error) {
+	sID, err := idVO.SessionIDFromString(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return newRefreshToken(tokenHash, sID, expiresAt, usedAt, createdAt)
+}
+
+func newRefreshToken(tokenHash string, sessionID idVO.SessionID, expiresAt time.Time, usedAt *time.Time, createdAt time.Time) (*RefreshToken, error) {
+	return &RefreshToken{
+		tokenHash: tokenHash,
+		sessionID: sessionID,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+		createdAt: createdAt,
+	}, nil
+}
+
+// リフレッシュトークンの値から、保存・検索に使用するハッシュ値を生成します。
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *RefreshToken) TokenHash() string {
+	return r.tokenHash
+}
+
+func (r *RefreshToken) SessionID() idVO.SessionID {
+	return r.sessionID
+}
+
+func (r *RefreshToken) SessionIDString() string {
+	return r.sessionID.String()
+}
+
+func (r *RefreshToken) ExpiresAt() time.Time {
+	return r.expiresAt
+}
+
+func (r *RefreshToken) UsedAt() *time.Time {
+	return r.usedAt
+}
+
+func (r *RefreshToken) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// リフレッシュトークンを使用済みにします。
+// 有効期限切れの場合は ErrRefreshTokenExpired、既に使用済み（ローテーション済み）の場合は ErrRefreshTokenReused を返します。
+// 有効期限切れのトークンは再利用の検知の対象外とする為、使用済みかどうかより先に有効期限を確認します。
+func (r *RefreshToken) Use() error {
+	now := timer.Now()
+	if !now.Before(r.expiresAt) {
+		return ErrRefreshTokenExpired
+	}
+	if r.usedAt != nil {
+		return ErrRefreshTokenReused
+	}
+	r.usedAt = &now
+	return nil
+}
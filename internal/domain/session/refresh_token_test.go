@@ -0,0 +1,123 @@
+package session_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestNewRefreshToken(t *testing.T) {
+	t.Run("Positive: リフレッシュトークンを発行でき、ハッシュ値のみを保持する", func(t *testing.T) {
+		t.Parallel()
+		sessionID := idVO.NewSessionIDForTest("session")
+
+		refreshToken, token, err := sessionDomain.NewRefreshToken(sessionID)
+
+		assert.NoError(t, err)
+		assert.Len(t, token, 43)
+		assert.Len(t, refreshToken.TokenHash(), 64)
+		assert.NotEqual(t, token, refreshToken.TokenHash())
+		assert.Equal(t, sessionDomain.HashRefreshToken(token), refreshToken.TokenHash())
+		assert.Equal(t, sessionID, refreshToken.SessionID())
+		assert.Equal(t, 30*24*time.Hour, refreshToken.ExpiresAt().Sub(refreshToken.CreatedAt()))
+		assert.Nil(t, refreshToken.UsedAt())
+	})
+
+	t.Run("Positive: 発行する度に異なるリフレッシュトークンが生成される", func(t *testing.T) {
+		t.Parallel()
+		sessionID := idVO.NewSessionIDForTest("session")
+
+		_, token1, err := sessionDomain.NewRefreshToken(sessionID)
+		assert.NoError(t, err)
+		_, token2, err := sessionDomain.NewRefreshToken(sessionID)
+		assert.NoError(t, err)
+
+		assert.NotEqual(t, token1, token2)
+	})
+}
+
+func TestReconstructRefreshToken(t *testing.T) {
+	t.Run("Positive: リフレッシュトークンを再構築できる", func(t *testing.T) {
+		t.Parallel()
+		var (
+			tokenHash = sessionDomain.HashRefreshToken("token")
+			sessionID = idVO.NewSessionIDForTest("session")
+			createdAt = timer.GetFixedDate()
+			expiresAt = createdAt.Add(time.Hour)
+			usedAt    = createdAt.Add(time.Minute)
+		)
+
+		refreshToken, err := sessionDomain.ReconstructRefreshToken(tokenHash, sessionID.String(), expiresAt, &usedAt, createdAt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tokenHash, refreshToken.TokenHash())
+		assert.Equal(t, sessionID.String(), refreshToken.SessionIDString())
+		assert.Equal(t, expiresAt, refreshToken.ExpiresAt())
+		assert.Equal(t, &usedAt, refreshToken.UsedAt())
+		assert.Equal(t, createdAt, refreshToken.CreatedAt())
+	})
+}
+
+func TestUse(t *testing.T) {
+	var (
+		tokenHash = sessionDomain.HashRefreshToken("token")
+		sessionID = idVO.NewSessionIDForTest("session").String()
+		now       = timer.Now()
+		usedAt    = now.Add(-time.Minute)
+	)
+
+	tests := []struct {
+		caseName  string
+		expiresAt time.Time
+		usedAt    *time.Time
+		errMsg    string
+	}{
+		{
+			caseName:  "Positive: 未使用で有効期限内のリフレッシュトークンを使用済みにできる",
+			expiresAt: now.Add(time.Hour),
+			usedAt:    nil,
+			errMsg:    "",
+		},
+		{
+			caseName:  "Negative: 使用済みのリフレッシュトークンの場合はエラーが返る",
+			expiresAt: now.Add(time.Hour),
+			usedAt:    &usedAt,
+			errMsg:    "refresh token has already been used",
+		},
+		{
+			caseName:  "Negative: 有効期限切れのリフレッシュトークンの場合はエラーが返る",
+			expiresAt: now.Add(-time.Hour),
+			usedAt:    nil,
+			errMsg:    "refresh token has expired",
+		},
+		{
+			caseName:  "Negative: 使用済みかつ有効期限切れのリフレッシュトークンの場合は有効期限切れのエラーが返る",
+			expiresAt: now.Add(-time.Hour),
+			usedAt:    &usedAt,
+			errMsg:    "refresh token has expired",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			refreshToken, err := sessionDomain.ReconstructRefreshToken(tokenHash, sessionID, tt.expiresAt, tt.usedAt, now.Add(-2*time.Hour))
+			assert.NoError(t, err)
+
+			err = refreshToken.Use()
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Equal(t, tt.usedAt, refreshToken.UsedAt())
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, refreshToken.UsedAt())
+			}
+		})
+	}
+}
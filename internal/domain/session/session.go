@@ -0,0 +1,80 @@
+package session
+
+import (
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// Session はサインインから始まるリフレッシュトークンのファミリーを表します。
+// リフレッシュトークンはローテーションの度に同じセッションに紐づけて発行され、セッションを失効させるとファミリー全体が無効になります。
+type Session struct {
+	id        idVO.SessionID
+	userID    idVO.UserID
+	createdAt time.Time
+	revokedAt *time.Time
+}
+
+func New(userID idVO.UserID) (*Session, error) {
+	id := idVO.NewSessionID()
+	return newSession(id, userID, timer.Now(), nil)
+}
+
+func Reconstruct(id, userID string, createdAt time.Time, revokedAt *time.Time) (*Session, error) {
+	sessionID, err := idVO.SessionIDFromString(id)
+	if err != nil {
+		return nil, err
+	}
+	uID, err := idVO.UserIDFromString(userID)
+	if err != nil {
+		return nil, err
+	}
+	return newSession(sessionID, uID, createdAt, revokedAt)
+}
+
+func newSession(id idVO.SessionID, userID idVO.UserID, createdAt time.Time, revokedAt *time.Time) (*Session, error) {
+	return &Session{
+		id:        id,
+		userID:    userID,
+		createdAt: createdAt,
+		revokedAt: revokedAt,
+	}, nil
+}
+
+func (s *Session) ID() idVO.SessionID {
+	return s.id
+}
+
+func (s *Session) IDString() string {
+	return s.id.String()
+}
+
+func (s *Session) UserID() idVO.UserID {
+	return s.userID
+}
+
+func (s *Session) UserIDString() string {
+	return s.userID.String()
+}
+
+func (s *Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Session) RevokedAt() *time.Time {
+	return s.revokedAt
+}
+
+func (s *Session) IsRevoked() bool {
+	return s.revokedAt != nil
+}
+
+// セッションを失効させます。既に失効している場合は失効日時を変更しません。
+func (s *Session) Revoke() {
+	if s.IsRevoked() {
+		return
+	}
+	now := timer.Now()
+	s.revokedAt = &now
+}
@@ -0,0 +1,17 @@
+package session
+
+import (
+	"context"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type ISessionRepository interface {
+	Save(ctx context.Context, session *Session) error
+	FindByID(ctx context.Context, id idVO.SessionID) (*Session, error)
+	SaveRefreshToken(ctx context.Context, refreshToken *RefreshToken) error
+	FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// リフレッシュトークンを使用済みとして保存します。
+	// 他のリクエストによって既に使用済みにされていた場合は ErrRefreshTokenReused を返します。
+	MarkRefreshTokenUsed(ctx context.Context, refreshToken *RefreshToken) error
+}
@@ -0,0 +1,124 @@
+package session
+
+import (
+	"context"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type ISessionService interface {
+	// 新しいセッションを開始し、最初のリフレッシュトークンを発行します。戻り値の文字列はクライアントに返却するリフレッシュトークンです。
+	Start(ctx context.Context, userID idVO.UserID) (*Session, string, error)
+	// リフレッシュトークンをローテーションし、同じセッションに新しいリフレッシュトークンを発行します。
+	// 使用済みのリフレッシュトークンが再利用された場合は、漏洩とみなしてセッション（ファミリー全体）を失効させ ErrRefreshTokenReused を返します。
+	Rotate(ctx context.Context, refreshToken string) (*Session, string, error)
+	Revoke(ctx context.Context, id idVO.SessionID) error
+	// セッションが存在し、失効していないことを確認します。
+	EnsureActive(ctx context.Context, id idVO.SessionID) error
+}
+
+type sessionService struct {
+	sessionRepo ISessionRepository
+}
+
+func NewService(sessionRepository ISessionRepository) ISessionService {
+	return &sessionService{
+		sessionRepo: sessionRepository,
+	}
+}
+
+func (s *sessionService) Start(ctx context.Context, userID idVO.UserID) (*Session, string, error) {
+	session, err := New(userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.sessionRepo.Save(ctx, session); err != nil {
+		return nil, "", err
+	}
+
+	token, err := s.issueRefreshToken(ctx, session)
+	if err != nil {
+		return nil, "", err
+	}
+	return session, token, nil
+}
+
+func (s *sessionService) Rotate(ctx context.Context, refreshToken string) (*Session, string, error) {
+	current, err := s.sessionRepo.FindRefreshTokenByHash(ctx, HashRefreshToken(refreshToken))
+	if err != nil {
+		return nil, "", err
+	}
+	if current == nil {
+		return nil, "", ErrInvalidRefreshToken
+	}
+
+	session, err := s.sessionRepo.FindByID(ctx, current.SessionID())
+	if err != nil {
+		return nil, "", err
+	}
+	if session == nil {
+		return nil, "", ErrInvalidRefreshToken
+	}
+	if session.IsRevoked() {
+		return nil, "", ErrSessionRevoked
+	}
+
+	if err := current.Use(); err != nil {
+		return nil, "", s.revokeOnReuse(ctx, session, err)
+	}
+	if err := s.sessionRepo.MarkRefreshTokenUsed(ctx, current); err != nil {
+		return nil, "", s.revokeOnReuse(ctx, session, err)
+	}
+
+	token, err := s.issueRefreshToken(ctx, session)
+	if err != nil {
+		return nil, "", err
+	}
+	return session, token, nil
+}
+
+func (s *sessionService) Revoke(ctx context.Context, id idVO.SessionID) error {
+	session, err := s.sessionRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return ErrNotFound
+	}
+	session.Revoke()
+	return s.sessionRepo.Save(ctx, session)
+}
+
+func (s *sessionService) EnsureActive(ctx context.Context, id idVO.SessionID) error {
+	session, err := s.sessionRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if session == nil || session.IsRevoked() {
+		return ErrSessionRevoked
+	}
+	return nil
+}
+
+func (s *sessionService) issueRefreshToken(ctx context.Context, session *Session) (string, error) {
+	refreshToken, token, err := NewRefreshToken(session.ID())
+	if err != nil {
+		return "", err
+	}
+	if err := s.sessionRepo.SaveRefreshToken(ctx, refreshToken); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// リフレッシュトークンの再利用を検知した場合はセッションを失効させます。再利用以外のエラーはそのまま返します。
+func (s *sessionService) revokeOnReuse(ctx context.Context, session *Session, err error) error {
+	if err != ErrRefreshTokenReused {
+		return err
+	}
+	session.Revoke()
+	if saveErr := s.sessionRepo.Save(ctx, session); saveErr != nil {
+		return saveErr
+	}
+	return ErrRefreshTokenReused
+}
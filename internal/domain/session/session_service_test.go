@@ -0,0 +1,382 @@
+package session_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/u104rak1/pocgo/internal/domain/mock"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestStart(t *testing.T) {
+	arg := gomock.Any()
+
+	tests := []struct {
+		caseName string
+		setup    func(mockSessionRepo *mock.MockISessionRepository)
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: セッションを開始し、リフレッシュトークンを発行できる",
+			setup: func(mockSessionRepo *mock.MockISessionRepository) {
+				mockSessionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mockSessionRepo.EXPECT().SaveRefreshToken(arg, arg).Return(nil)
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: セッションの保存に失敗した場合はエラーが返る",
+			setup: func(mockSessionRepo *mock.MockISessionRepository) {
+				mockSessionRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: リフレッシュトークンの保存に失敗した場合はエラーが返る",
+			setup: func(mockSessionRepo *mock.MockISessionRepository) {
+				mockSessionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mockSessionRepo.EXPECT().SaveRefreshToken(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSessionRepo := mock.NewMockISessionRepository(ctrl)
+			service := sessionDomain.NewService(mockSessionRepo)
+			ctx := context.Background()
+			tt.setup(mockSessionRepo)
+
+			session, token, err := service.Start(ctx, idVO.NewUserIDForTest("user"))
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Nil(t, session)
+				assert.Empty(t, token)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, session)
+				assert.NotEmpty(t, token)
+			}
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	type Mocks struct {
+		sessionRepo *mock.MockISessionRepository
+	}
+
+	var (
+		arg       = gomock.Any()
+		token     = "token"
+		userID    = idVO.NewUserIDForTest("user").String()
+		sessionID = idVO.NewSessionIDForTest("session").String()
+		now       = timer.Now()
+	)
+
+	newSession := func(revokedAt *time.Time) *sessionDomain.Session {
+		session, err := sessionDomain.Reconstruct(sessionID, userID, now, revokedAt)
+		assert.NoError(t, err)
+		return session
+	}
+	newRefreshToken := func(expiresAt time.Time, usedAt *time.Time) *sessionDomain.RefreshToken {
+		refreshToken, err := sessionDomain.ReconstructRefreshToken(
+			sessionDomain.HashRefreshToken(token), sessionID, expiresAt, usedAt, now,
+		)
+		assert.NoError(t, err)
+		return refreshToken
+	}
+
+	tests := []struct {
+		caseName    string
+		prepare     func(mocks Mocks)
+		wantRevoked bool
+		errMsg      string
+	}{
+		{
+			caseName: "Positive: リフレッシュトークンをローテーションできる",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(newRefreshToken(now.Add(time.Hour), nil), nil)
+				mocks.sessionRepo.EXPECT().FindByID(arg, arg).Return(newSession(nil), nil)
+				mocks.sessionRepo.EXPECT().MarkRefreshTokenUsed(arg, arg).Return(nil)
+				mocks.sessionRepo.EXPECT().SaveRefreshToken(arg, arg).Return(nil)
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: リフレッシュトークンが存在しない場合はエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(nil, nil)
+			},
+			errMsg: "invalid refresh token",
+		},
+		{
+			caseName: "Negative: リフレッシュトークンの取得に失敗した場合はエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(nil, assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: セッションが存在しない場合はエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(newRefreshToken(now.Add(time.Hour), nil), nil)
+				mocks.sessionRepo.EXPECT().FindByID(arg, arg).Return(nil, nil)
+			},
+			errMsg: "invalid refresh token",
+		},
+		{
+			caseName: "Negative: セッションの取得に失敗した場合はエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(newRefreshToken(now.Add(time.Hour), nil), nil)
+				mocks.sessionRepo.EXPECT().FindByID(arg, arg).Return(nil, assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: セッションが失効している場合はエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(newRefreshToken(now.Add(time.Hour), nil), nil)
+				mocks.sessionRepo.EXPECT().FindByID(arg, arg).Return(newSession(&now), nil)
+			},
+			errMsg: "session has been revoked",
+		},
+		{
+			caseName: "Negative: リフレッシュトークンが有効期限切れの場合はエラーが返り、セッションは失効しない",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(newRefreshToken(now.Add(-time.Hour), nil), nil)
+				mocks.sessionRepo.EXPECT().FindByID(arg, arg).Return(newSession(nil), nil)
+			},
+			errMsg: "refresh token has expired",
+		},
+		{
+			caseName: "Negative: 使用済みのリフレッシュトークンが再利用された場合はセッションを失効させてエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(newRefreshToken(now.Add(time.Hour), &now), nil)
+				mocks.sessionRepo.EXPECT().FindByID(arg, arg).Return(newSession(nil), nil)
+				mocks.sessionRepo.EXPECT().Save(arg, arg).DoAndReturn(
+					func(_ context.Context, session *sessionDomain.Session) error {
+						assert.True(t, session.IsRevoked())
+						return nil
+					},
+				)
+			},
+			errMsg: "refresh token has already been used",
+		},
+		{
+			caseName: "Negative: 同時に使用済みにされていた場合はセッションを失効させてエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(newRefreshToken(now.Add(time.Hour), nil), nil)
+				mocks.sessionRepo.EXPECT().FindByID(arg, arg).Return(newSession(nil), nil)
+				mocks.sessionRepo.EXPECT().MarkRefreshTokenUsed(arg, arg).Return(sessionDomain.ErrRefreshTokenReused)
+				mocks.sessionRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+			errMsg: "refresh token has already been used",
+		},
+		{
+			caseName: "Negative: 再利用を検知した際のセッションの保存に失敗した場合はエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(newRefreshToken(now.Add(time.Hour), &now), nil)
+				mocks.sessionRepo.EXPECT().FindByID(arg, arg).Return(newSession(nil), nil)
+				mocks.sessionRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: リフレッシュトークンを使用済みにできなかった場合はエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(newRefreshToken(now.Add(time.Hour), nil), nil)
+				mocks.sessionRepo.EXPECT().FindByID(arg, arg).Return(newSession(nil), nil)
+				mocks.sessionRepo.EXPECT().MarkRefreshTokenUsed(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: 新しいリフレッシュトークンの保存に失敗した場合はエラーが返る",
+			prepare: func(mocks Mocks) {
+				mocks.sessionRepo.EXPECT().FindRefreshTokenByHash(arg, arg).Return(newRefreshToken(now.Add(time.Hour), nil), nil)
+				mocks.sessionRepo.EXPECT().FindByID(arg, arg).Return(newSession(nil), nil)
+				mocks.sessionRepo.EXPECT().MarkRefreshTokenUsed(arg, arg).Return(nil)
+				mocks.sessionRepo.EXPECT().SaveRefreshToken(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				sessionRepo: mock.NewMockISessionRepository(ctrl),
+			}
+			service := sessionDomain.NewService(mocks.sessionRepo)
+			ctx := context.Background()
+			tt.prepare(mocks)
+
+			session, newToken, err := service.Rotate(ctx, token)
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Nil(t, session)
+				assert.Empty(t, newToken)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, sessionID, session.IDString())
+				assert.NotEmpty(t, newToken)
+				assert.NotEqual(t, token, newToken)
+			}
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	var (
+		arg       = gomock.Any()
+		sessionID = idVO.NewSessionIDForTest("session")
+		userID    = idVO.NewUserIDForTest("user")
+	)
+
+	tests := []struct {
+		caseName string
+		setup    func(mockSessionRepo *mock.MockISessionRepository)
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: セッションを失効させることができる",
+			setup: func(mockSessionRepo *mock.MockISessionRepository) {
+				session, err := sessionDomain.Reconstruct(sessionID.String(), userID.String(), timer.Now(), nil)
+				assert.NoError(t, err)
+				mockSessionRepo.EXPECT().FindByID(arg, arg).Return(session, nil)
+				mockSessionRepo.EXPECT().Save(arg, arg).DoAndReturn(
+					func(_ context.Context, session *sessionDomain.Session) error {
+						assert.True(t, session.IsRevoked())
+						return nil
+					},
+				)
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: セッションが存在しない場合はエラーが返る",
+			setup: func(mockSessionRepo *mock.MockISessionRepository) {
+				mockSessionRepo.EXPECT().FindByID(arg, arg).Return(nil, nil)
+			},
+			errMsg: "session not found",
+		},
+		{
+			caseName: "Negative: セッションの取得に失敗した場合はエラーが返る",
+			setup: func(mockSessionRepo *mock.MockISessionRepository) {
+				mockSessionRepo.EXPECT().FindByID(arg, arg).Return(nil, assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSessionRepo := mock.NewMockISessionRepository(ctrl)
+			service := sessionDomain.NewService(mockSessionRepo)
+			ctx := context.Background()
+			tt.setup(mockSessionRepo)
+
+			err := service.Revoke(ctx, sessionID)
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEnsureActive(t *testing.T) {
+	var (
+		arg       = gomock.Any()
+		sessionID = idVO.NewSessionIDForTest("session")
+		userID    = idVO.NewUserIDForTest("user")
+		now       = timer.Now()
+	)
+
+	tests := []struct {
+		caseName string
+		setup    func(mockSessionRepo *mock.MockISessionRepository)
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 有効なセッションの場合はエラーが返らない",
+			setup: func(mockSessionRepo *mock.MockISessionRepository) {
+				session, err := sessionDomain.Reconstruct(sessionID.String(), userID.String(), now, nil)
+				assert.NoError(t, err)
+				mockSessionRepo.EXPECT().FindByID(arg, arg).Return(session, nil)
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: セッションが失効している場合はエラーが返る",
+			setup: func(mockSessionRepo *mock.MockISessionRepository) {
+				session, err := sessionDomain.Reconstruct(sessionID.String(), userID.String(), now, &now)
+				assert.NoError(t, err)
+				mockSessionRepo.EXPECT().FindByID(arg, arg).Return(session, nil)
+			},
+			errMsg: "session has been revoked",
+		},
+		{
+			caseName: "Negative: セッションが存在しない場合はエラーが返る",
+			setup: func(mockSessionRepo *mock.MockISessionRepository) {
+				mockSessionRepo.EXPECT().FindByID(arg, arg).Return(nil, nil)
+			},
+			errMsg: "session has been revoked",
+		},
+		{
+			caseName: "Negative: セッションの取得に失敗した場合はエラーが返る",
+			setup: func(mockSessionRepo *mock.MockISessionRepository) {
+				mockSessionRepo.EXPECT().FindByID(arg, arg).Return(nil, assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSessionRepo := mock.NewMockISessionRepository(ctrl)
+			service := sessionDomain.NewService(mockSessionRepo)
+			ctx := context.Background()
+			tt.setup(mockSessionRepo)
+
+			err := service.EnsureActive(ctx, sessionID)
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package session
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// リフレッシュトークンの有効期間
+	RefreshTokenTTL = 30 * 24 * time.Hour
+	// リフレッシュトークンの生成に使用する乱数のバイト数
+	RefreshTokenBytes = 32
+	// リフレッシュトークンの文字数（RefreshTokenBytes を base64url でパディングなしにエンコードした長さ）
+	RefreshTokenLength = 43
+)
+
+var (
+	ErrNotFound            = errors.New("session not found")
+	ErrSessionRevoked      = errors.New("session has been revoked")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenExpired = errors.New("refresh token has expired")
+	ErrRefreshTokenReused  = errors.New("refresh token has already been used")
+)
@@ -0,0 +1,74 @@
+package session_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("Positive: セッションを作成できる", func(t *testing.T) {
+		t.Parallel()
+		userID := idVO.NewUserIDForTest("user")
+
+		session, err := sessionDomain.New(userID)
+
+		assert.NoError(t, err)
+		assert.True(t, session.ID().IsValid())
+		assert.Equal(t, userID, session.UserID())
+		assert.False(t, session.CreatedAt().IsZero())
+		assert.Nil(t, session.RevokedAt())
+		assert.False(t, session.IsRevoked())
+	})
+}
+
+func TestReconstruct(t *testing.T) {
+	t.Run("Positive: セッションを再構築できる", func(t *testing.T) {
+		t.Parallel()
+		var (
+			sessionID = idVO.NewSessionIDForTest("session")
+			userID    = idVO.NewUserIDForTest("user")
+			createdAt = timer.GetFixedDate()
+			revokedAt = createdAt.Add(time.Hour)
+		)
+
+		session, err := sessionDomain.Reconstruct(sessionID.String(), userID.String(), createdAt, &revokedAt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, sessionID.String(), session.IDString())
+		assert.Equal(t, userID.String(), session.UserIDString())
+		assert.Equal(t, createdAt, session.CreatedAt())
+		assert.Equal(t, &revokedAt, session.RevokedAt())
+		assert.True(t, session.IsRevoked())
+	})
+}
+
+func TestRevoke(t *testing.T) {
+	t.Run("Positive: セッションを失効させることができる", func(t *testing.T) {
+		t.Parallel()
+		session, err := sessionDomain.New(idVO.NewUserIDForTest("user"))
+		assert.NoError(t, err)
+
+		session.Revoke()
+
+		assert.True(t, session.IsRevoked())
+		assert.NotNil(t, session.RevokedAt())
+	})
+
+	t.Run("Positive: 失効済みのセッションを失効させても失効日時は変わらない", func(t *testing.T) {
+		t.Parallel()
+		revokedAt := timer.GetFixedDate()
+		session, err := sessionDomain.Reconstruct(
+			idVO.NewSessionIDForTest("session").String(), idVO.NewUserIDForTest("user").String(), revokedAt, &revokedAt,
+		)
+		assert.NoError(t, err)
+
+		session.Revoke()
+
+		assert.Equal(t, revokedAt, *session.RevokedAt())
+	})
+}
@@ -0,0 +1,111 @@
+package standingorder
+
+import (
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// Execution は自動振込の1回の実行の試みと、その結果を表します。
+// 自動振込、実行日、試行回数の組み合わせで一意になる為、同じ試みが二重に記録されることはありません。
+type Execution struct {
+	standingOrderID idVO.StandingOrderID
+	scheduledDate   time.Time
+	attempt         int
+	result          string
+	// 振込に成功した場合の取引IDです。
+	transactionID *idVO.TransactionID
+	// 振込に失敗した場合の理由です。
+	failureReason *string
+	executedAt    time.Time
+}
+
+func newExecution(
+	standingOrderID idVO.StandingOrderID,
+	scheduledDate time.Time,
+	attempt int,
+	result string,
+	transactionID *idVO.TransactionID,
+	failureReason *string,
+	executedAt time.Time,
+) *Execution {
+	return &Execution{
+		standingOrderID: standingOrderID,
+		scheduledDate:   scheduledDate,
+		attempt:         attempt,
+		result:          result,
+		transactionID:   transactionID,
+		failureReason:   failureReason,
+		executedAt:      executedAt,
+	}
+}
+
+// データベースから実行履歴を再構築します。
+func ReconstructExecution(
+	standingOrderID string,
+	scheduledDate time.Time,
+	attempt int,
+	result string,
+	transactionID *string,
+	failureReason *string,
+	executedAt time.Time,
+) (*Execution, error) {
+	oID, err := idVO.StandingOrderIDFromString(standingOrderID)
+	if err != nil {
+		return nil, err
+	}
+	var tID *idVO.TransactionID
+	if transactionID != nil {
+		id, err := idVO.TransactionIDFromString(*transactionID)
+		if err != nil {
+			return nil, err
+		}
+		tID = &id
+	}
+	return newExecution(oID, truncateToDate(scheduledDate), attempt, result, tID, failureReason, executedAt), nil
+}
+
+func (e *Execution) StandingOrderID() idVO.StandingOrderID {
+	return e.standingOrderID
+}
+
+func (e *Execution) StandingOrderIDString() string {
+	return e.standingOrderID.String()
+}
+
+func (e *Execution) ScheduledDate() time.Time {
+	return e.scheduledDate
+}
+
+func (e *Execution) Attempt() int {
+	return e.attempt
+}
+
+func (e *Execution) Result() string {
+	return e.result
+}
+
+func (e *Execution) TransactionID() *idVO.TransactionID {
+	return e.transactionID
+}
+
+func (e *Execution) TransactionIDString() *string {
+	if e.transactionID == nil {
+		return nil
+	}
+	id := e.transactionID.String()
+	return &id
+}
+
+func (e *Execution) FailureReason() *string {
+	return e.failureReason
+}
+
+func (e *Execution) ExecutedAt() time.Time {
+	return e.executedAt
+}
+
+func (e *Execution) ExecutedAtString() string {
+	return timer.FormatToISO8601(e.executedAt)
+}
@@ -0,0 +1,64 @@
+package standingorder
+
+import (
+	"time"
+)
+
+// Schedule は自動振込を実行する日付の規則を表します。日付は全て UTC の 0 時で扱います。
+type Schedule struct {
+	frequency  string
+	dayOfMonth *int
+}
+
+// 毎月の場合は実行日 (1 ～ 31) を指定し、毎週の場合は nil を指定します。
+func NewSchedule(frequency string, dayOfMonth *int) (*Schedule, error) {
+	if err := validFrequency(frequency, dayOfMonth); err != nil {
+		return nil, err
+	}
+	return &Schedule{frequency: frequency, dayOfMonth: dayOfMonth}, nil
+}
+
+func (s Schedule) Frequency() string {
+	return s.frequency
+}
+
+func (s Schedule) DayOfMonth() *int {
+	return s.dayOfMonth
+}
+
+// 開始日以降で最初の実行日を返します。
+func (s Schedule) First(startDate time.Time) time.Time {
+	start := truncateToDate(startDate)
+	if s.frequency == FrequencyWeekly {
+		return start
+	}
+	date := s.monthlyDate(start.Year(), start.Month())
+	if date.Before(start) {
+		return s.monthlyDate(start.Year(), start.Month()+1)
+	}
+	return date
+}
+
+// 指定した実行日の次の実行日を返します。
+func (s Schedule) Next(date time.Time) time.Time {
+	current := truncateToDate(date)
+	if s.frequency == FrequencyWeekly {
+		return current.AddDate(0, 0, 7)
+	}
+	return s.monthlyDate(current.Year(), current.Month()+1)
+}
+
+// 指定した月の実行日を返します。実行日がその月に存在しない場合は月末日になります。
+func (s Schedule) monthlyDate(year int, month time.Month) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	day := *s.dayOfMonth
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func truncateToDate(t time.Time) time.Time {
+	u := t.UTC()
+	return time.Date(u.Year(), u.Month(), u.Day(), 0, 0, 0, 0, time.UTC)
+}
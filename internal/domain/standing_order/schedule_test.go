@@ -0,0 +1,205 @@
+package standingorder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	"github.com/u104rak1/pocgo/pkg/numutil"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNewSchedule(t *testing.T) {
+	tests := []struct {
+		caseName   string
+		frequency  string
+		dayOfMonth *int
+		wantErr    error
+	}{
+		{
+			caseName:   "Positive: 毎月1日の規則を作成できる",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(1),
+			wantErr:    nil,
+		},
+		{
+			caseName:   "Positive: 毎月31日の規則を作成できる",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(31),
+			wantErr:    nil,
+		},
+		{
+			caseName:   "Positive: 毎週の規則を作成できる",
+			frequency:  standingOrderDomain.FrequencyWeekly,
+			dayOfMonth: nil,
+			wantErr:    nil,
+		},
+		{
+			caseName:   "Negative: 毎月で実行日が0の場合はエラーが返る",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(0),
+			wantErr:    standingOrderDomain.ErrInvalidDayOfMonth,
+		},
+		{
+			caseName:   "Negative: 毎月で実行日が32の場合はエラーが返る",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(32),
+			wantErr:    standingOrderDomain.ErrInvalidDayOfMonth,
+		},
+		{
+			caseName:   "Negative: 毎月で実行日がない場合はエラーが返る",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: nil,
+			wantErr:    standingOrderDomain.ErrInvalidDayOfMonth,
+		},
+		{
+			caseName:   "Negative: 毎週で実行日を指定した場合はエラーが返る",
+			frequency:  standingOrderDomain.FrequencyWeekly,
+			dayOfMonth: numutil.IntPointer(1),
+			wantErr:    standingOrderDomain.ErrUnexpectedDayOfMonth,
+		},
+		{
+			caseName:   "Negative: サポートされていない間隔の場合はエラーが返る",
+			frequency:  "DAILY",
+			dayOfMonth: nil,
+			wantErr:    standingOrderDomain.ErrUnsupportedFrequency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			schedule, err := standingOrderDomain.NewSchedule(tt.frequency, tt.dayOfMonth)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.frequency, schedule.Frequency())
+				assert.Equal(t, tt.dayOfMonth, schedule.DayOfMonth())
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, schedule)
+			}
+		})
+	}
+}
+
+func TestScheduleFirst(t *testing.T) {
+	tests := []struct {
+		caseName   string
+		frequency  string
+		dayOfMonth *int
+		startDate  time.Time
+		want       time.Time
+	}{
+		{
+			caseName:   "Positive: 毎週の場合は開始日が最初の実行日になる",
+			frequency:  standingOrderDomain.FrequencyWeekly,
+			dayOfMonth: nil,
+			startDate:  date(2021, 1, 6),
+			want:       date(2021, 1, 6),
+		},
+		{
+			caseName:   "Positive: 毎月で開始日が実行日より前の場合は同じ月の実行日になる",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(25),
+			startDate:  date(2021, 1, 10),
+			want:       date(2021, 1, 25),
+		},
+		{
+			caseName:   "Positive: 毎月で開始日が実行日と同じ場合は開始日が最初の実行日になる",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(10),
+			startDate:  date(2021, 1, 10),
+			want:       date(2021, 1, 10),
+		},
+		{
+			caseName:   "Positive: 毎月で開始日が実行日より後の場合は翌月の実行日になる",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(5),
+			startDate:  date(2021, 1, 10),
+			want:       date(2021, 2, 5),
+		},
+		{
+			caseName:   "Positive: 毎月31日で開始月に31日がない場合は月末日になる",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(31),
+			startDate:  date(2021, 2, 1),
+			want:       date(2021, 2, 28),
+		},
+		{
+			caseName:   "Positive: 時刻は切り捨てられる",
+			frequency:  standingOrderDomain.FrequencyWeekly,
+			dayOfMonth: nil,
+			startDate:  time.Date(2021, 1, 6, 15, 30, 0, 0, time.UTC),
+			want:       date(2021, 1, 6),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			schedule, err := standingOrderDomain.NewSchedule(tt.frequency, tt.dayOfMonth)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.First(tt.startDate))
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	tests := []struct {
+		caseName   string
+		frequency  string
+		dayOfMonth *int
+		date       time.Time
+		want       time.Time
+	}{
+		{
+			caseName:   "Positive: 毎週の場合は7日後になる",
+			frequency:  standingOrderDomain.FrequencyWeekly,
+			dayOfMonth: nil,
+			date:       date(2021, 12, 29),
+			want:       date(2022, 1, 5),
+		},
+		{
+			caseName:   "Positive: 毎月の場合は翌月の実行日になる",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(25),
+			date:       date(2021, 12, 25),
+			want:       date(2022, 1, 25),
+		},
+		{
+			caseName:   "Positive: 毎月31日で翌月に31日がない場合は月末日になる",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(31),
+			date:       date(2021, 1, 31),
+			want:       date(2021, 2, 28),
+		},
+		{
+			caseName:   "Positive: 毎月31日で月末日に実行した翌月は31日に戻る",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(31),
+			date:       date(2021, 2, 28),
+			want:       date(2021, 3, 31),
+		},
+		{
+			caseName:   "Positive: 毎月29日でうるう年の2月は29日になる",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: numutil.IntPointer(29),
+			date:       date(2024, 1, 29),
+			want:       date(2024, 2, 29),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			schedule, err := standingOrderDomain.NewSchedule(tt.frequency, tt.dayOfMonth)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(tt.date))
+		})
+	}
+}
@@ -0,0 +1,406 @@
+package standingorder
+
+import (
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// StandingOrder は口座から他の口座へ、決められた日付に繰り返し振込を行う自動振込を表します。
+// 実行日に失敗した場合は1日ごとに最大 maxRetries 回まで再試行し、それでも失敗した場合は failurePolicy に従います。
+type StandingOrder struct {
+	id                idVO.StandingOrderID
+	accountID         idVO.AccountID
+	receiverAccountID idVO.AccountID
+	amount            moneyVO.Money
+	schedule          Schedule
+	startDate         time.Time
+	// 終了条件です。どちらも指定しない場合は解約するまで実行します。
+	endDate       *time.Time
+	maxExecutions *int
+	maxRetries    int
+	failurePolicy string
+	status        string
+	// 次に実行する回の実行日です。
+	nextRunDate time.Time
+	// 次に実行する回で失敗した回数です。
+	retryCount int
+	// 振込に成功した回数です。
+	executionCount int
+	createdAt      time.Time
+	updatedAt      time.Time
+	// 楽観的排他制御の為のバージョンです。未保存の自動振込は 0 です。
+	version int64
+}
+
+// 自動振込を作成します。金額は通貨の最小単位で指定し、開始日は now の日付以降である必要があります。
+func New(
+	accountID, receiverAccountID idVO.AccountID,
+	amount int64, currency string,
+	schedule Schedule,
+	startDate time.Time, endDate *time.Time, maxExecutions *int,
+	maxRetries int, failurePolicy string,
+	now time.Time,
+) (*StandingOrder, error) {
+	if accountID == receiverAccountID {
+		return nil, ErrSameAccount
+	}
+	start := truncateToDate(startDate)
+	if start.Before(truncateToDate(now)) {
+		return nil, ErrStartDateInPast
+	}
+
+	order, err := newStandingOrder(
+		idVO.NewStandingOrderID(), accountID, receiverAccountID, amount, currency, schedule,
+		start, endDate, maxExecutions, maxRetries, failurePolicy,
+		StatusActive, schedule.First(start), 0, 0, now, now, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if order.endDate != nil && order.nextRunDate.After(*order.endDate) {
+		return nil, ErrInvalidEndDate
+	}
+	return order, nil
+}
+
+// データベースから自動振込を再構築します。
+func Reconstruct(
+	id, accountID, receiverAccountID string,
+	amount int64, currency string,
+	frequency string, dayOfMonth *int,
+	startDate time.Time, endDate *time.Time, maxExecutions *int,
+	maxRetries int, failurePolicy, status string,
+	nextRunDate time.Time, retryCount, executionCount int,
+	createdAt, updatedAt time.Time, version int64,
+) (*StandingOrder, error) {
+	oID, err := idVO.StandingOrderIDFromString(id)
+	if err != nil {
+		return nil, err
+	}
+	aID, err := idVO.AccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+	rID, err := idVO.AccountIDFromString(receiverAccountID)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := NewSchedule(frequency, dayOfMonth)
+	if err != nil {
+		return nil, err
+	}
+	if err := validStatus(status); err != nil {
+		return nil, err
+	}
+	return newStandingOrder(
+		oID, aID, rID, amount, currency, *schedule,
+		startDate, endDate, maxExecutions, maxRetries, failurePolicy,
+		status, nextRunDate, retryCount, executionCount, createdAt, updatedAt, version,
+	)
+}
+
+func newStandingOrder(
+	id idVO.StandingOrderID,
+	accountID, receiverAccountID idVO.AccountID,
+	amount int64, currency string,
+	schedule Schedule,
+	startDate time.Time, endDate *time.Time, maxExecutions *int,
+	maxRetries int, failurePolicy, status string,
+	nextRunDate time.Time, retryCount, executionCount int,
+	createdAt, updatedAt time.Time, version int64,
+) (*StandingOrder, error) {
+	money, err := newAmount(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	if err := validMaxExecutions(maxExecutions); err != nil {
+		return nil, err
+	}
+	if err := validMaxRetries(maxRetries); err != nil {
+		return nil, err
+	}
+	if err := validFailurePolicy(failurePolicy); err != nil {
+		return nil, err
+	}
+
+	var end *time.Time
+	if endDate != nil {
+		date := truncateToDate(*endDate)
+		end = &date
+	}
+
+	return &StandingOrder{
+		id:                id,
+		accountID:         accountID,
+		receiverAccountID: receiverAccountID,
+		amount:            *money,
+		schedule:          schedule,
+		startDate:         truncateToDate(startDate),
+		endDate:           end,
+		maxExecutions:     maxExecutions,
+		maxRetries:        maxRetries,
+		failurePolicy:     failurePolicy,
+		status:            status,
+		nextRunDate:       truncateToDate(nextRunDate),
+		retryCount:        retryCount,
+		executionCount:    executionCount,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+		version:           version,
+	}, nil
+}
+
+func newAmount(amount int64, currency string) (*moneyVO.Money, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	return moneyVO.New(amount, currency)
+}
+
+func (o *StandingOrder) ID() idVO.StandingOrderID {
+	return o.id
+}
+
+func (o *StandingOrder) IDString() string {
+	return o.id.String()
+}
+
+func (o *StandingOrder) AccountID() idVO.AccountID {
+	return o.accountID
+}
+
+func (o *StandingOrder) AccountIDString() string {
+	return o.accountID.String()
+}
+
+func (o *StandingOrder) ReceiverAccountID() idVO.AccountID {
+	return o.receiverAccountID
+}
+
+func (o *StandingOrder) ReceiverAccountIDString() string {
+	return o.receiverAccountID.String()
+}
+
+func (o *StandingOrder) Amount() moneyVO.Money {
+	return o.amount
+}
+
+func (o *StandingOrder) Schedule() Schedule {
+	return o.schedule
+}
+
+func (o *StandingOrder) StartDate() time.Time {
+	return o.startDate
+}
+
+func (o *StandingOrder) EndDate() *time.Time {
+	return o.endDate
+}
+
+func (o *StandingOrder) MaxExecutions() *int {
+	return o.maxExecutions
+}
+
+func (o *StandingOrder) MaxRetries() int {
+	return o.maxRetries
+}
+
+func (o *StandingOrder) FailurePolicy() string {
+	return o.failurePolicy
+}
+
+func (o *StandingOrder) Status() string {
+	return o.status
+}
+
+func (o *StandingOrder) NextRunDate() time.Time {
+	return o.nextRunDate
+}
+
+func (o *StandingOrder) RetryCount() int {
+	return o.retryCount
+}
+
+func (o *StandingOrder) ExecutionCount() int {
+	return o.executionCount
+}
+
+func (o *StandingOrder) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+func (o *StandingOrder) UpdatedAt() time.Time {
+	return o.updatedAt
+}
+
+func (o *StandingOrder) UpdatedAtString() string {
+	return timer.FormatToISO8601(o.updatedAt)
+}
+
+func (o *StandingOrder) Version() int64 {
+	return o.version
+}
+
+// 保存に成功した後、リポジトリから呼び出されます。同じエンティティを続けて保存できるようにバージョンを進めます。
+func (o *StandingOrder) IncrementVersion() {
+	o.version++
+}
+
+// 次に実行を試みる日付です。失敗した場合は実行日から失敗した日数だけ後になります。
+func (o *StandingOrder) NextAttemptDate() time.Time {
+	return o.nextRunDate.AddDate(0, 0, o.retryCount)
+}
+
+// 指定した時刻に実行すべきかを返します。ACTIVE の自動振込のみ実行します。
+func (o *StandingOrder) IsDue(now time.Time) bool {
+	return o.status == StatusActive && !o.NextAttemptDate().After(truncateToDate(now))
+}
+
+// 振込の成功を記録して次の実行日に進め、実行履歴を返します。
+func (o *StandingOrder) RecordSuccess(transactionID idVO.TransactionID, now time.Time) *Execution {
+	execution := newExecution(o.id, o.nextRunDate, o.retryCount+1, ResultSucceeded, &transactionID, nil, now)
+	o.executionCount++
+	o.retryCount = 0
+	o.advance()
+	o.updatedAt = now
+	return execution
+}
+
+// 振込の失敗を記録し、実行履歴を返します。再試行の上限に達していない場合は翌日に再試行し、
+// 上限に達した場合は failurePolicy に従ってその回を見送るか、自動振込を停止します。
+func (o *StandingOrder) RecordFailure(reason string, now time.Time) *Execution {
+	attempt := o.retryCount + 1
+	scheduledDate := o.nextRunDate
+
+	var result string
+	switch {
+	case o.retryCount < o.maxRetries:
+		o.retryCount++
+		result = ResultRetryScheduled
+	case o.failurePolicy == FailurePolicySkip:
+		o.retryCount = 0
+		o.advance()
+		result = ResultSkipped
+	default:
+		o.status = StatusSuspended
+		result = ResultSuspended
+	}
+	o.updatedAt = now
+	return newExecution(o.id, scheduledDate, attempt, result, nil, &reason, now)
+}
+
+// 次の実行日に進めます。終了条件を満たした場合は COMPLETED になります。
+func (o *StandingOrder) advance() {
+	o.nextRunDate = o.schedule.Next(o.nextRunDate)
+	o.completeIfFinished()
+}
+
+func (o *StandingOrder) completeIfFinished() {
+	if o.maxExecutions != nil && o.executionCount >= *o.maxExecutions {
+		o.status = StatusCompleted
+	}
+	if o.endDate != nil && o.nextRunDate.After(*o.endDate) {
+		o.status = StatusCompleted
+	}
+}
+
+func (o *StandingOrder) checkModifiable() error {
+	if o.status == StatusCompleted || o.status == StatusCancelled {
+		return ErrNotModifiable
+	}
+	return nil
+}
+
+// 振込金額を変更します。通貨は変更できません。
+func (o *StandingOrder) ChangeAmount(amount int64, now time.Time) error {
+	if err := o.checkModifiable(); err != nil {
+		return err
+	}
+	money, err := newAmount(amount, o.amount.Currency())
+	if err != nil {
+		return err
+	}
+	o.amount = *money
+	o.updatedAt = now
+	return nil
+}
+
+// 終了条件を変更します。変更後の条件を既に満たしている場合は COMPLETED になります。
+func (o *StandingOrder) ChangeEndCondition(endDate *time.Time, maxExecutions *int, now time.Time) error {
+	if err := o.checkModifiable(); err != nil {
+		return err
+	}
+	if err := validMaxExecutions(maxExecutions); err != nil {
+		return err
+	}
+	var end *time.Time
+	if endDate != nil {
+		date := truncateToDate(*endDate)
+		if date.Before(truncateToDate(now)) {
+			return ErrInvalidEndDate
+		}
+		end = &date
+	}
+	o.endDate = end
+	o.maxExecutions = maxExecutions
+	o.completeIfFinished()
+	o.updatedAt = now
+	return nil
+}
+
+// 失敗時の再試行回数と、再試行の上限に達した場合の方針を変更します。
+func (o *StandingOrder) ChangeRetryPolicy(maxRetries int, failurePolicy string, now time.Time) error {
+	if err := o.checkModifiable(); err != nil {
+		return err
+	}
+	if err := validMaxRetries(maxRetries); err != nil {
+		return err
+	}
+	if err := validFailurePolicy(failurePolicy); err != nil {
+		return err
+	}
+	o.maxRetries = maxRetries
+	o.failurePolicy = failurePolicy
+	o.updatedAt = now
+	return nil
+}
+
+func (o *StandingOrder) Pause(now time.Time) error {
+	if err := o.checkModifiable(); err != nil {
+		return err
+	}
+	o.status = StatusPaused
+	o.updatedAt = now
+	return nil
+}
+
+// 一時停止または停止した自動振込を再開します。停止中に過ぎた実行日の振込は行わず、now 以降の実行日から再開します。
+func (o *StandingOrder) Resume(now time.Time) error {
+	if err := o.checkModifiable(); err != nil {
+		return err
+	}
+	if o.status == StatusActive {
+		return nil
+	}
+	today := truncateToDate(now)
+	for o.nextRunDate.Before(today) {
+		o.nextRunDate = o.schedule.Next(o.nextRunDate)
+	}
+	o.status = StatusActive
+	o.retryCount = 0
+	o.completeIfFinished()
+	o.updatedAt = now
+	return nil
+}
+
+func (o *StandingOrder) Cancel(now time.Time) error {
+	if err := o.checkModifiable(); err != nil {
+		return err
+	}
+	o.status = StatusCancelled
+	o.updatedAt = now
+	return nil
+}
@@ -0,0 +1,22 @@
+package standingorder
+
+import (
+	"context"
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IStandingOrderRepository interface {
+	// 読み込んだ時点のバージョンと一致する場合のみ保存します。一致しない場合は ErrConcurrentModification を返します。
+	Save(ctx context.Context, order *StandingOrder) error
+	// 存在しない場合は nil を返します。
+	FindByID(ctx context.Context, id idVO.StandingOrderID) (*StandingOrder, error)
+	ListByAccountID(ctx context.Context, accountID idVO.AccountID) ([]*StandingOrder, error)
+	// now の日付までに実行を試みるべき ACTIVE の自動振込を、実行を試みる日付の古い順に最大 limit 件取得します。
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*StandingOrder, error)
+
+	SaveExecution(ctx context.Context, execution *Execution) error
+	// 実行履歴を新しい順に最大 limit 件取得します。
+	ListExecutions(ctx context.Context, id idVO.StandingOrderID, limit int) ([]*Execution, error)
+}
@@ -0,0 +1,33 @@
+package standingorder
+
+import (
+	"context"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type IStandingOrderService interface {
+	// 指定された口座の自動振込を取得します。存在しない場合や他の口座の自動振込の場合は ErrNotFound を返します。
+	GetByAccount(ctx context.Context, accountID idVO.AccountID, id idVO.StandingOrderID) (*StandingOrder, error)
+}
+
+type standingOrderService struct {
+	standingOrderRepo IStandingOrderRepository
+}
+
+func NewService(standingOrderRepository IStandingOrderRepository) IStandingOrderService {
+	return &standingOrderService{
+		standingOrderRepo: standingOrderRepository,
+	}
+}
+
+func (s *standingOrderService) GetByAccount(ctx context.Context, accountID idVO.AccountID, id idVO.StandingOrderID) (*StandingOrder, error) {
+	order, err := s.standingOrderRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.AccountID() != accountID {
+		return nil, ErrNotFound
+	}
+	return order, nil
+}
@@ -0,0 +1,82 @@
+package standingorder_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/u104rak1/pocgo/internal/domain/mock"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestGetByAccount(t *testing.T) {
+	var (
+		arg            = gomock.Any()
+		otherAccountID = idVO.NewAccountIDForTest("other")
+	)
+
+	tests := []struct {
+		caseName  string
+		accountID idVO.AccountID
+		setup     func(mockStandingOrderRepo *mock.MockIStandingOrderRepository, order *standingOrderDomain.StandingOrder)
+		wantErr   error
+	}{
+		{
+			caseName:  "Positive: 口座の自動振込を取得できる",
+			accountID: accountID,
+			setup: func(mockStandingOrderRepo *mock.MockIStandingOrderRepository, order *standingOrderDomain.StandingOrder) {
+				mockStandingOrderRepo.EXPECT().FindByID(arg, order.ID()).Return(order, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName:  "Negative: 自動振込が存在しない場合は ErrNotFound が返る",
+			accountID: accountID,
+			setup: func(mockStandingOrderRepo *mock.MockIStandingOrderRepository, order *standingOrderDomain.StandingOrder) {
+				mockStandingOrderRepo.EXPECT().FindByID(arg, arg).Return(nil, nil)
+			},
+			wantErr: standingOrderDomain.ErrNotFound,
+		},
+		{
+			caseName:  "Negative: 他の口座の自動振込の場合は ErrNotFound が返る",
+			accountID: otherAccountID,
+			setup: func(mockStandingOrderRepo *mock.MockIStandingOrderRepository, order *standingOrderDomain.StandingOrder) {
+				mockStandingOrderRepo.EXPECT().FindByID(arg, arg).Return(order, nil)
+			},
+			wantErr: standingOrderDomain.ErrNotFound,
+		},
+		{
+			caseName:  "Negative: 取得に失敗した場合はエラーが返る",
+			accountID: accountID,
+			setup: func(mockStandingOrderRepo *mock.MockIStandingOrderRepository, order *standingOrderDomain.StandingOrder) {
+				mockStandingOrderRepo.EXPECT().FindByID(arg, arg).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			order := newMonthlyOrder(t, 0, standingOrderDomain.FailurePolicySkip, nil, nil)
+			mockStandingOrderRepo := mock.NewMockIStandingOrderRepository(ctrl)
+			service := standingOrderDomain.NewService(mockStandingOrderRepo)
+			tt.setup(mockStandingOrderRepo, order)
+
+			got, err := service.GetByAccount(context.Background(), tt.accountID, order.ID())
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, order, got)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			}
+		})
+	}
+}
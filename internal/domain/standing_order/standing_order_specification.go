@@ -0,0 +1,119 @@
+package standingorder
+
+import (
+	"errors"
+	"fmt"
+)
+
+// 実行の間隔
+const (
+	// 開始日と同じ曜日に毎週実行します。
+	FrequencyWeekly = "WEEKLY"
+	// 毎月指定日に実行します。指定日が存在しない月は月末日に実行します。
+	FrequencyMonthly = "MONTHLY"
+)
+
+// 自動振込の状態
+const (
+	StatusActive = "ACTIVE"
+	// 利用者が一時停止した状態です。
+	StatusPaused = "PAUSED"
+	// 再試行しても実行できず、失敗時の方針が SUSPEND の為に停止した状態です。
+	StatusSuspended = "SUSPENDED"
+	// 終了日または実行回数の上限に達した状態です。
+	StatusCompleted = "COMPLETED"
+	StatusCancelled = "CANCELLED"
+)
+
+// 再試行の上限に達した場合の方針
+const (
+	// その回の振込を見送り、次回の実行日から再開します。
+	FailurePolicySkip = "SKIP"
+	// 自動振込を停止します。再開するには利用者が ACTIVE に戻す必要があります。
+	FailurePolicySuspend = "SUSPEND"
+)
+
+// 実行結果
+const (
+	ResultSucceeded = "SUCCEEDED"
+	// 失敗したが、翌日に再試行します。
+	ResultRetryScheduled = "RETRY_SCHEDULED"
+	ResultSkipped        = "SKIPPED"
+	ResultSuspended      = "SUSPENDED"
+)
+
+const (
+	DayOfMonthMin = 1
+	DayOfMonthMax = 31
+	// 1回の実行日あたりの再試行回数の上限です。再試行は1日ごとに行います。
+	MaxRetriesLimit = 5
+	// 作成時に再試行回数と失敗時の方針を指定しない場合の値です。
+	DefaultMaxRetries    = 3
+	DefaultFailurePolicy = FailurePolicySkip
+	// スケジューラーが1回の実行で処理する自動振込の件数です。
+	ExecuteBatchSize = 100
+	// 自動振込の詳細で返す実行履歴の件数です。
+	RecentExecutionsLimit = 20
+)
+
+var (
+	ErrNotFound               = errors.New("standing order not found")
+	ErrUnsupportedFrequency   = errors.New("unsupported standing order frequency")
+	ErrInvalidDayOfMonth      = fmt.Errorf("day of month must be between %d and %d for a monthly standing order", DayOfMonthMin, DayOfMonthMax)
+	ErrUnexpectedDayOfMonth   = errors.New("day of month can only be specified for a monthly standing order")
+	ErrInvalidAmount          = errors.New("amount must be greater than 0")
+	ErrSameAccount            = errors.New("receiver account must be different from the sender account")
+	ErrStartDateInPast        = errors.New("start date must not be in the past")
+	ErrInvalidEndDate         = errors.New("end date must not be before the first execution date")
+	ErrInvalidMaxExecutions   = errors.New("max executions must be greater than 0")
+	ErrInvalidMaxRetries      = fmt.Errorf("max retries must be between 0 and %d", MaxRetriesLimit)
+	ErrUnsupportedPolicy      = errors.New("unsupported failure policy")
+	ErrUnsupportedStatus      = errors.New("unsupported standing order status")
+	ErrNotModifiable          = errors.New("completed or cancelled standing order cannot be modified")
+	ErrConcurrentModification = errors.New("standing order has been modified by another request, please retry")
+)
+
+func validFrequency(frequency string, dayOfMonth *int) error {
+	switch frequency {
+	case FrequencyWeekly:
+		if dayOfMonth != nil {
+			return ErrUnexpectedDayOfMonth
+		}
+	case FrequencyMonthly:
+		if dayOfMonth == nil || *dayOfMonth < DayOfMonthMin || *dayOfMonth > DayOfMonthMax {
+			return ErrInvalidDayOfMonth
+		}
+	default:
+		return ErrUnsupportedFrequency
+	}
+	return nil
+}
+
+func validMaxExecutions(maxExecutions *int) error {
+	if maxExecutions != nil && *maxExecutions <= 0 {
+		return ErrInvalidMaxExecutions
+	}
+	return nil
+}
+
+func validMaxRetries(maxRetries int) error {
+	if maxRetries < 0 || maxRetries > MaxRetriesLimit {
+		return ErrInvalidMaxRetries
+	}
+	return nil
+}
+
+func validFailurePolicy(policy string) error {
+	if policy != FailurePolicySkip && policy != FailurePolicySuspend {
+		return ErrUnsupportedPolicy
+	}
+	return nil
+}
+
+func validStatus(status string) error {
+	switch status {
+	case StatusActive, StatusPaused, StatusSuspended, StatusCompleted, StatusCancelled:
+		return nil
+	}
+	return ErrUnsupportedStatus
+}
@@ -0,0 +1,385 @@
+package standingorder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/numutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+var (
+	accountID         = idVO.NewAccountIDForTest("account")
+	receiverAccountID = idVO.NewAccountIDForTest("receiver")
+)
+
+// 2021-01-01 から毎月25日に 1000 円を振り込む自動振込を作成します。
+func newMonthlyOrder(t *testing.T, maxRetries int, policy string, endDate *time.Time, maxExecutions *int) *standingOrderDomain.StandingOrder {
+	t.Helper()
+	schedule, err := standingOrderDomain.NewSchedule(standingOrderDomain.FrequencyMonthly, numutil.IntPointer(25))
+	assert.NoError(t, err)
+	order, err := standingOrderDomain.New(
+		accountID, receiverAccountID, 1000, moneyVO.JPY, *schedule,
+		date(2021, 1, 1), endDate, maxExecutions, maxRetries, policy, timer.GetFixedDate(),
+	)
+	assert.NoError(t, err)
+	return order
+}
+
+func TestNew(t *testing.T) {
+	var (
+		now         = timer.GetFixedDate()
+		schedule, _ = standingOrderDomain.NewSchedule(standingOrderDomain.FrequencyMonthly, numutil.IntPointer(25))
+		endDate     = date(2021, 6, 30)
+		earlyEnd    = date(2021, 1, 24)
+		maxExec     = numutil.IntPointer(12)
+		zeroExec    = numutil.IntPointer(0)
+	)
+
+	tests := []struct {
+		caseName      string
+		receiverID    idVO.AccountID
+		amount        int64
+		currency      string
+		startDate     time.Time
+		endDate       *time.Time
+		maxExecutions *int
+		maxRetries    int
+		policy        string
+		wantErr       error
+	}{
+		{
+			caseName:      "Positive: 自動振込を作成できる",
+			receiverID:    receiverAccountID,
+			amount:        1000,
+			currency:      moneyVO.JPY,
+			startDate:     now,
+			endDate:       &endDate,
+			maxExecutions: maxExec,
+			maxRetries:    standingOrderDomain.MaxRetriesLimit,
+			policy:        standingOrderDomain.FailurePolicySkip,
+			wantErr:       nil,
+		},
+		{
+			caseName:   "Negative: 振込先が振込元と同じ場合はエラーが返る",
+			receiverID: accountID,
+			amount:     1000,
+			currency:   moneyVO.JPY,
+			startDate:  now,
+			policy:     standingOrderDomain.FailurePolicySkip,
+			wantErr:    standingOrderDomain.ErrSameAccount,
+		},
+		{
+			caseName:   "Negative: 開始日が過去の場合はエラーが返る",
+			receiverID: receiverAccountID,
+			amount:     1000,
+			currency:   moneyVO.JPY,
+			startDate:  now.AddDate(0, 0, -1),
+			policy:     standingOrderDomain.FailurePolicySkip,
+			wantErr:    standingOrderDomain.ErrStartDateInPast,
+		},
+		{
+			caseName:   "Negative: 金額が0の場合はエラーが返る",
+			receiverID: receiverAccountID,
+			amount:     0,
+			currency:   moneyVO.JPY,
+			startDate:  now,
+			policy:     standingOrderDomain.FailurePolicySkip,
+			wantErr:    standingOrderDomain.ErrInvalidAmount,
+		},
+		{
+			caseName:   "Negative: 終了日が最初の実行日より前の場合はエラーが返る",
+			receiverID: receiverAccountID,
+			amount:     1000,
+			currency:   moneyVO.JPY,
+			startDate:  now,
+			endDate:    &earlyEnd,
+			policy:     standingOrderDomain.FailurePolicySkip,
+			wantErr:    standingOrderDomain.ErrInvalidEndDate,
+		},
+		{
+			caseName:      "Negative: 実行回数の上限が0の場合はエラーが返る",
+			receiverID:    receiverAccountID,
+			amount:        1000,
+			currency:      moneyVO.JPY,
+			startDate:     now,
+			maxExecutions: zeroExec,
+			policy:        standingOrderDomain.FailurePolicySkip,
+			wantErr:       standingOrderDomain.ErrInvalidMaxExecutions,
+		},
+		{
+			caseName:   "Negative: 再試行回数が上限を超える場合はエラーが返る",
+			receiverID: receiverAccountID,
+			amount:     1000,
+			currency:   moneyVO.JPY,
+			startDate:  now,
+			maxRetries: standingOrderDomain.MaxRetriesLimit + 1,
+			policy:     standingOrderDomain.FailurePolicySkip,
+			wantErr:    standingOrderDomain.ErrInvalidMaxRetries,
+		},
+		{
+			caseName:   "Negative: サポートされていない方針の場合はエラーが返る",
+			receiverID: receiverAccountID,
+			amount:     1000,
+			currency:   moneyVO.JPY,
+			startDate:  now,
+			policy:     "IGNORE",
+			wantErr:    standingOrderDomain.ErrUnsupportedPolicy,
+		},
+		{
+			caseName:   "Negative: サポートされていない通貨の場合はエラーが返る",
+			receiverID: receiverAccountID,
+			amount:     1000,
+			currency:   "EUR",
+			startDate:  now,
+			policy:     standingOrderDomain.FailurePolicySkip,
+			wantErr:    moneyVO.ErrUnsupportedCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			order, err := standingOrderDomain.New(
+				accountID, tt.receiverID, tt.amount, tt.currency, *schedule,
+				tt.startDate, tt.endDate, tt.maxExecutions, tt.maxRetries, tt.policy, now,
+			)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, accountID, order.AccountID())
+				assert.Equal(t, tt.receiverID, order.ReceiverAccountID())
+				assert.Equal(t, tt.amount, order.Amount().Amount())
+				assert.Equal(t, standingOrderDomain.StatusActive, order.Status())
+				assert.Equal(t, date(2021, 1, 25), order.NextRunDate())
+				assert.Equal(t, 0, order.RetryCount())
+				assert.Equal(t, 0, order.ExecutionCount())
+				assert.Equal(t, int64(0), order.Version())
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, order)
+			}
+		})
+	}
+}
+
+func TestIsDue(t *testing.T) {
+	order := newMonthlyOrder(t, 0, standingOrderDomain.FailurePolicySkip, nil, nil)
+
+	assert.False(t, order.IsDue(time.Date(2021, 1, 24, 23, 59, 59, 0, time.UTC)))
+	assert.True(t, order.IsDue(date(2021, 1, 25)))
+	assert.True(t, order.IsDue(date(2021, 1, 26)))
+
+	assert.NoError(t, order.Pause(date(2021, 1, 20)))
+	assert.False(t, order.IsDue(date(2021, 1, 25)))
+}
+
+func TestRecordSuccess(t *testing.T) {
+	var (
+		transactionID = idVO.NewTransactionIDForTest("transaction")
+		executedAt    = time.Date(2021, 1, 25, 9, 0, 0, 0, time.UTC)
+	)
+
+	t.Run("Positive: 成功すると次の実行日に進み、実行履歴が返る", func(t *testing.T) {
+		order := newMonthlyOrder(t, 1, standingOrderDomain.FailurePolicySkip, nil, nil)
+
+		execution := order.RecordSuccess(transactionID, executedAt)
+
+		assert.Equal(t, order.ID(), execution.StandingOrderID())
+		assert.Equal(t, date(2021, 1, 25), execution.ScheduledDate())
+		assert.Equal(t, 1, execution.Attempt())
+		assert.Equal(t, standingOrderDomain.ResultSucceeded, execution.Result())
+		assert.Equal(t, &transactionID, execution.TransactionID())
+		assert.Nil(t, execution.FailureReason())
+		assert.Equal(t, executedAt, execution.ExecutedAt())
+
+		assert.Equal(t, date(2021, 2, 25), order.NextRunDate())
+		assert.Equal(t, 1, order.ExecutionCount())
+		assert.Equal(t, standingOrderDomain.StatusActive, order.Status())
+	})
+
+	t.Run("Positive: 実行回数の上限に達すると COMPLETED になる", func(t *testing.T) {
+		order := newMonthlyOrder(t, 0, standingOrderDomain.FailurePolicySkip, nil, numutil.IntPointer(2))
+
+		order.RecordSuccess(transactionID, executedAt)
+		assert.Equal(t, standingOrderDomain.StatusActive, order.Status())
+		order.RecordSuccess(transactionID, executedAt.AddDate(0, 1, 0))
+		assert.Equal(t, standingOrderDomain.StatusCompleted, order.Status())
+		assert.Equal(t, 2, order.ExecutionCount())
+	})
+
+	t.Run("Positive: 次の実行日が終了日を過ぎると COMPLETED になる", func(t *testing.T) {
+		endDate := date(2021, 2, 24)
+		order := newMonthlyOrder(t, 0, standingOrderDomain.FailurePolicySkip, &endDate, nil)
+
+		order.RecordSuccess(transactionID, executedAt)
+		assert.Equal(t, standingOrderDomain.StatusCompleted, order.Status())
+	})
+}
+
+func TestRecordFailure(t *testing.T) {
+	const reason = "insufficient balance"
+
+	t.Run("Positive: 再試行の上限までは翌日に再試行し、上限に達すると SKIP の場合は次の実行日に進む", func(t *testing.T) {
+		order := newMonthlyOrder(t, 2, standingOrderDomain.FailurePolicySkip, nil, nil)
+
+		wants := []struct {
+			attemptDate time.Time
+			result      string
+		}{
+			{date(2021, 1, 25), standingOrderDomain.ResultRetryScheduled},
+			{date(2021, 1, 26), standingOrderDomain.ResultRetryScheduled},
+			{date(2021, 1, 27), standingOrderDomain.ResultSkipped},
+		}
+		for i, want := range wants {
+			assert.Equal(t, want.attemptDate, order.NextAttemptDate())
+			assert.False(t, order.IsDue(want.attemptDate.AddDate(0, 0, -1)))
+			assert.True(t, order.IsDue(want.attemptDate))
+
+			execution := order.RecordFailure(reason, want.attemptDate)
+			assert.Equal(t, date(2021, 1, 25), execution.ScheduledDate())
+			assert.Equal(t, i+1, execution.Attempt())
+			assert.Equal(t, want.result, execution.Result())
+			assert.Equal(t, reason, *execution.FailureReason())
+			assert.Nil(t, execution.TransactionID())
+		}
+
+		assert.Equal(t, standingOrderDomain.StatusActive, order.Status())
+		assert.Equal(t, date(2021, 2, 25), order.NextRunDate())
+		assert.Equal(t, 0, order.RetryCount())
+		assert.Equal(t, 0, order.ExecutionCount())
+	})
+
+	t.Run("Positive: 再試行の上限に達すると SUSPEND の場合は停止する", func(t *testing.T) {
+		order := newMonthlyOrder(t, 1, standingOrderDomain.FailurePolicySuspend, nil, nil)
+
+		order.RecordFailure(reason, date(2021, 1, 25))
+		execution := order.RecordFailure(reason, date(2021, 1, 26))
+
+		assert.Equal(t, standingOrderDomain.ResultSuspended, execution.Result())
+		assert.Equal(t, standingOrderDomain.StatusSuspended, order.Status())
+		assert.False(t, order.IsDue(date(2021, 1, 27)))
+	})
+
+	t.Run("Positive: 再試行の後に成功すると試行回数が記録され、再試行回数が戻る", func(t *testing.T) {
+		order := newMonthlyOrder(t, 1, standingOrderDomain.FailurePolicySkip, nil, nil)
+
+		order.RecordFailure(reason, date(2021, 1, 25))
+		execution := order.RecordSuccess(idVO.NewTransactionIDForTest("transaction"), date(2021, 1, 26))
+
+		assert.Equal(t, 2, execution.Attempt())
+		assert.Equal(t, 0, order.RetryCount())
+		assert.Equal(t, date(2021, 2, 25), order.NextAttemptDate())
+	})
+}
+
+func TestResume(t *testing.T) {
+	t.Run("Positive: 停止中に過ぎた実行日は見送り、再開した日以降の実行日から再開する", func(t *testing.T) {
+		order := newMonthlyOrder(t, 0, standingOrderDomain.FailurePolicySuspend, nil, nil)
+		order.RecordFailure("insufficient balance", date(2021, 1, 25))
+		assert.Equal(t, standingOrderDomain.StatusSuspended, order.Status())
+
+		assert.NoError(t, order.Resume(date(2021, 3, 26)))
+
+		assert.Equal(t, standingOrderDomain.StatusActive, order.Status())
+		assert.Equal(t, date(2021, 4, 25), order.NextRunDate())
+		assert.Equal(t, 0, order.RetryCount())
+	})
+
+	t.Run("Positive: 再開した日が実行日の場合はその日に実行する", func(t *testing.T) {
+		order := newMonthlyOrder(t, 0, standingOrderDomain.FailurePolicySkip, nil, nil)
+		assert.NoError(t, order.Pause(date(2021, 1, 20)))
+
+		assert.NoError(t, order.Resume(date(2021, 2, 25)))
+
+		assert.Equal(t, date(2021, 2, 25), order.NextRunDate())
+		assert.True(t, order.IsDue(date(2021, 2, 25)))
+	})
+
+	t.Run("Positive: 再開した時点で終了日を過ぎている場合は COMPLETED になる", func(t *testing.T) {
+		endDate := date(2021, 2, 28)
+		order := newMonthlyOrder(t, 0, standingOrderDomain.FailurePolicySkip, &endDate, nil)
+		assert.NoError(t, order.Pause(date(2021, 1, 20)))
+
+		assert.NoError(t, order.Resume(date(2021, 3, 1)))
+
+		assert.Equal(t, standingOrderDomain.StatusCompleted, order.Status())
+	})
+}
+
+func TestModify(t *testing.T) {
+	now := date(2021, 1, 20)
+
+	t.Run("Positive: 金額、終了条件、再試行の方針を変更できる", func(t *testing.T) {
+		order := newMonthlyOrder(t, 0, standingOrderDomain.FailurePolicySkip, nil, nil)
+		endDate := date(2021, 12, 31)
+
+		assert.NoError(t, order.ChangeAmount(2000, now))
+		assert.NoError(t, order.ChangeEndCondition(&endDate, numutil.IntPointer(3), now))
+		assert.NoError(t, order.ChangeRetryPolicy(3, standingOrderDomain.FailurePolicySuspend, now))
+
+		assert.Equal(t, int64(2000), order.Amount().Amount())
+		assert.Equal(t, moneyVO.JPY, order.Amount().Currency())
+		assert.Equal(t, &endDate, order.EndDate())
+		assert.Equal(t, 3, *order.MaxExecutions())
+		assert.Equal(t, 3, order.MaxRetries())
+		assert.Equal(t, standingOrderDomain.FailurePolicySuspend, order.FailurePolicy())
+		assert.Equal(t, now, order.UpdatedAt())
+	})
+
+	t.Run("Negative: 不正な値の場合はエラーが返る", func(t *testing.T) {
+		order := newMonthlyOrder(t, 0, standingOrderDomain.FailurePolicySkip, nil, nil)
+		pastDate := date(2021, 1, 19)
+
+		assert.ErrorIs(t, order.ChangeAmount(0, now), standingOrderDomain.ErrInvalidAmount)
+		assert.ErrorIs(t, order.ChangeEndCondition(&pastDate, nil, now), standingOrderDomain.ErrInvalidEndDate)
+		assert.ErrorIs(t, order.ChangeRetryPolicy(-1, standingOrderDomain.FailurePolicySkip, now), standingOrderDomain.ErrInvalidMaxRetries)
+	})
+
+	t.Run("Negative: 解約した自動振込は変更できない", func(t *testing.T) {
+		order := newMonthlyOrder(t, 0, standingOrderDomain.FailurePolicySkip, nil, nil)
+		assert.NoError(t, order.Cancel(now))
+		assert.Equal(t, standingOrderDomain.StatusCancelled, order.Status())
+
+		assert.ErrorIs(t, order.ChangeAmount(2000, now), standingOrderDomain.ErrNotModifiable)
+		assert.ErrorIs(t, order.ChangeEndCondition(nil, nil, now), standingOrderDomain.ErrNotModifiable)
+		assert.ErrorIs(t, order.ChangeRetryPolicy(0, standingOrderDomain.FailurePolicySkip, now), standingOrderDomain.ErrNotModifiable)
+		assert.ErrorIs(t, order.Pause(now), standingOrderDomain.ErrNotModifiable)
+		assert.ErrorIs(t, order.Resume(now), standingOrderDomain.ErrNotModifiable)
+		assert.ErrorIs(t, order.Cancel(now), standingOrderDomain.ErrNotModifiable)
+	})
+}
+
+func TestReconstruct(t *testing.T) {
+	var (
+		id         = idVO.NewStandingOrderIDForTest("order")
+		now        = timer.GetFixedDate()
+		nextRun    = date(2021, 1, 25)
+		dayOfMonth = numutil.IntPointer(25)
+	)
+
+	order, err := standingOrderDomain.Reconstruct(
+		id.String(), accountID.String(), receiverAccountID.String(), 1000, moneyVO.JPY,
+		standingOrderDomain.FrequencyMonthly, dayOfMonth, now, nil, nil,
+		1, standingOrderDomain.FailurePolicySkip, standingOrderDomain.StatusPaused,
+		nextRun, 1, 3, now, now, 4,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, id, order.ID())
+	assert.Equal(t, standingOrderDomain.StatusPaused, order.Status())
+	assert.Equal(t, date(2021, 1, 26), order.NextAttemptDate())
+	assert.Equal(t, 3, order.ExecutionCount())
+	assert.Equal(t, int64(4), order.Version())
+
+	_, err = standingOrderDomain.Reconstruct(
+		id.String(), accountID.String(), receiverAccountID.String(), 1000, moneyVO.JPY,
+		standingOrderDomain.FrequencyMonthly, dayOfMonth, now, nil, nil,
+		1, standingOrderDomain.FailurePolicySkip, "UNKNOWN",
+		nextRun, 0, 0, now, now, 1,
+	)
+	assert.ErrorIs(t, err, standingOrderDomain.ErrUnsupportedStatus)
+}
@@ -0,0 +1,46 @@
+package transaction
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Description は取引を行う際に付ける説明です。ゼロ値は説明がないことを表します。
+type Description struct {
+	// 取引を行った口座のみが参照できるメモです。
+	memo *string
+	// 振込の場合は受取口座からも参照できる参照情報 (請求書番号など) です。
+	reference *string
+}
+
+// 取引の説明を作成します。前後の空白を除き、空になった場合は指定されなかったものとして扱います。
+func NewDescription(memo, reference *string) (Description, error) {
+	m := trimToNil(memo)
+	if m != nil && utf8.RuneCountInString(*m) > MemoMaxLength {
+		return Description{}, ErrInvalidMemo
+	}
+	r := trimToNil(reference)
+	if r != nil && utf8.RuneCountInString(*r) > ReferenceMaxLength {
+		return Description{}, ErrInvalidReference
+	}
+	return Description{memo: m, reference: r}, nil
+}
+
+func (d Description) Memo() *string {
+	return d.memo
+}
+
+func (d Description) Reference() *string {
+	return d.reference
+}
+
+func trimToNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*s)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
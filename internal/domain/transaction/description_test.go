@@ -0,0 +1,72 @@
+package transaction_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+)
+
+func TestNewDescription(t *testing.T) {
+	tests := []struct {
+		caseName      string
+		memo          *string
+		reference     *string
+		wantMemo      *string
+		wantReference *string
+		wantErr       error
+	}{
+		{
+			caseName:      "Positive: メモと参照情報を指定できる",
+			memo:          strutil.StrPointer("Lunch with team"),
+			reference:     strutil.StrPointer("INV-2024-0001"),
+			wantMemo:      strutil.StrPointer("Lunch with team"),
+			wantReference: strutil.StrPointer("INV-2024-0001"),
+		},
+		{
+			caseName:      "Positive: 前後の空白が取り除かれる",
+			memo:          strutil.StrPointer("  Lunch  "),
+			reference:     strutil.StrPointer("\tINV-2024-0001\n"),
+			wantMemo:      strutil.StrPointer("Lunch"),
+			wantReference: strutil.StrPointer("INV-2024-0001"),
+		},
+		{
+			caseName:  "Positive: 空白のみの場合は指定されなかったものとして扱われる",
+			memo:      strutil.StrPointer("   "),
+			reference: strutil.StrPointer(""),
+		},
+		{
+			caseName: "Positive: 指定しない場合は説明がない",
+		},
+		{
+			caseName: "Positive: メモは文字数で上限を検証する",
+			memo:     strutil.StrPointer(strings.Repeat("あ", transactionDomain.MemoMaxLength)),
+			wantMemo: strutil.StrPointer(strings.Repeat("あ", transactionDomain.MemoMaxLength)),
+		},
+		{
+			caseName: "Negative: メモが長すぎる場合は ErrInvalidMemo が返る",
+			memo:     strutil.StrPointer(strings.Repeat("あ", transactionDomain.MemoMaxLength+1)),
+			wantErr:  transactionDomain.ErrInvalidMemo,
+		},
+		{
+			caseName:  "Negative: 参照情報が長すぎる場合は ErrInvalidReference が返る",
+			reference: strutil.StrPointer(strings.Repeat("a", transactionDomain.ReferenceMaxLength+1)),
+			wantErr:   transactionDomain.ErrInvalidReference,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			description, err := transactionDomain.NewDescription(tt.memo, tt.reference)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantMemo, description.Memo())
+			assert.Equal(t, tt.wantReference, description.Reference())
+		})
+	}
+}
@@ -14,28 +14,80 @@ type Transaction struct {
 	receiverAccountID *idVO.AccountID
 	operationType     string
 	transferAmount    moneyVO.Money
-	transactionAt     time.Time
+	receiverAmount    *moneyVO.Money
+	exchangeRate      *moneyVO.ExchangeRate
+	// 取消の場合のみ、取り消した取引のIDと取引種別を持ちます。
+	reversedTransactionID *idVO.TransactionID
+	reversedOperationType *string
+	// 取引後の口座残高。残高の記録を始める前の取引では nil です。
+	balanceAfter         *moneyVO.Money
+	receiverBalanceAfter *moneyVO.Money
+	// 取引を行った口座のみが参照できるメモと、振込の受取口座からも参照できる参照情報です。
+	memo      *string
+	reference *string
+	// 取引に関係する口座ごとに、口座の所有者が後から付けた分類です。
+	categories    map[idVO.AccountID][]string
+	transactionAt time.Time
 }
 
 // 取引エンティティを作成します。transactionAtは口座の更新日と同じ値にしたいので、引数で受け取ります。
+// receiverAmount, receiverCurrency は振込で受取口座に入金された金額、exchangeRate は通貨が異なる振込で適用した為替レートです。
 func New(
 	accountID idVO.AccountID,
 	receiverAccountID *idVO.AccountID,
 	operationType string,
-	amount float64,
+	amount int64,
 	currency string,
+	receiverAmount *int64,
+	receiverCurrency *string,
+	exchangeRate *string,
 	transactionAt time.Time,
 ) (*Transaction, error) {
 	id := idVO.NewTransactionID()
-	return newTransaction(id, accountID, receiverAccountID, operationType, amount, currency, transactionAt)
+	return newTransaction(id, accountID, receiverAccountID, operationType, amount, currency, receiverAmount, receiverCurrency, exchangeRate, nil, nil, transactionAt)
 }
 
+// 取引を取り消す取引を作成します。口座、金額、為替レート、参照情報は取り消す取引と同じ値を持ち、残高は逆向きに増減します。
+func newReversal(original *Transaction, transactionAt time.Time) (*Transaction, error) {
+	var receiverAmount *int64
+	if original.receiverAmount != nil {
+		amount := original.receiverAmount.Amount()
+		receiverAmount = &amount
+	}
+	reversedTransactionID := original.id
+	reversedOperationType := original.operationType
+	reversal, err := newTransaction(
+		idVO.NewTransactionID(), original.accountID, original.receiverAccountID, Reversal,
+		original.transferAmount.Amount(), original.transferAmount.Currency(),
+		receiverAmount, original.ReceiverCurrency(), original.ExchangeRateString(),
+		&reversedTransactionID, &reversedOperationType, transactionAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	reversal.reference = original.reference
+	return reversal, nil
+}
+
+// reversedTransactionID, reversedOperationType は取消の場合に取り消した取引のIDと取引種別です。
+// balanceAfter, receiverBalanceAfter は取引後の口座残高と受取口座の残高です。記録されていない取引では nil を渡します。
+// categories は口座IDごとの分類です。
 func Reconstruct(
 	id, accountID string,
 	receiverAccountID *string,
 	operationType string,
-	amount float64,
+	amount int64,
 	currency string,
+	receiverAmount *int64,
+	receiverCurrency *string,
+	exchangeRate *string,
+	reversedTransactionID *string,
+	reversedOperationType *string,
+	balanceAfter *int64,
+	receiverBalanceAfter *int64,
+	memo *string,
+	reference *string,
+	categories map[string][]string,
 	transactionAt time.Time,
 ) (*Transaction, error) {
 	tID, err := idVO.TransactionIDFromString(id)
@@ -57,7 +109,51 @@ func Reconstruct(
 		raID = &tmpID
 	}
 
-	return newTransaction(tID, aID, raID, operationType, amount, currency, transactionAt)
+	var rtID *idVO.TransactionID
+	if reversedTransactionID != nil {
+		tmpID, err := idVO.TransactionIDFromString(*reversedTransactionID)
+		if err != nil {
+			return nil, err
+		}
+		rtID = &tmpID
+	}
+
+	transaction, err := newTransaction(
+		tID, aID, raID, operationType, amount, currency, receiverAmount, receiverCurrency, exchangeRate,
+		rtID, reversedOperationType, transactionAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if balanceAfter != nil {
+		transaction.balanceAfter, err = moneyVO.New(*balanceAfter, currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if receiverBalanceAfter != nil {
+		if transaction.receiverAmount == nil {
+			return nil, ErrInvalidReceiverBalance
+		}
+		transaction.receiverBalanceAfter, err = moneyVO.New(*receiverBalanceAfter, transaction.receiverAmount.Currency())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	transaction.memo = memo
+	transaction.reference = reference
+	for accountID, accountCategories := range categories {
+		aID, err := idVO.AccountIDFromString(accountID)
+		if err != nil {
+			return nil, err
+		}
+		if err := transaction.changeCategoriesFor(aID, accountCategories); err != nil {
+			return nil, err
+		}
+	}
+	return transaction, nil
 }
 
 func newTransaction(
@@ -65,29 +161,72 @@ func newTransaction(
 	accountID idVO.AccountID,
 	receiverAccountID *idVO.AccountID,
 	operationType string,
-	amount float64,
+	amount int64,
 	currency string,
+	receiverAmount *int64,
+	receiverCurrency *string,
+	exchangeRate *string,
+	reversedTransactionID *idVO.TransactionID,
+	reversedOperationType *string,
 	transactionAt time.Time,
 ) (*Transaction, error) {
 	if err := validOperationType(operationType); err != nil {
 		return nil, err
 	}
+	if err := validReversedTransaction(operationType, reversedTransactionID, reversedOperationType); err != nil {
+		return nil, err
+	}
 
 	transferAmount, err := moneyVO.New(amount, currency)
 	if err != nil {
 		return nil, err
 	}
 
+	if (receiverAmount == nil) != (receiverCurrency == nil) {
+		return nil, ErrInvalidReceiverAmount
+	}
+	var rAmount *moneyVO.Money
+	if receiverAmount != nil {
+		rAmount, err = moneyVO.New(*receiverAmount, *receiverCurrency)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rate, err := newExchangeRate(transferAmount, rAmount, exchangeRate)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Transaction{
-		id:                id,
-		accountID:         accountID,
-		receiverAccountID: receiverAccountID,
-		operationType:     operationType,
-		transferAmount:    *transferAmount,
-		transactionAt:     transactionAt,
+		id:                    id,
+		accountID:             accountID,
+		receiverAccountID:     receiverAccountID,
+		operationType:         operationType,
+		transferAmount:        *transferAmount,
+		receiverAmount:        rAmount,
+		exchangeRate:          rate,
+		reversedTransactionID: reversedTransactionID,
+		reversedOperationType: reversedOperationType,
+		transactionAt:         transactionAt,
 	}, nil
 }
 
+// 通貨が異なる振込の場合のみ為替レートを持ちます。
+func newExchangeRate(transferAmount *moneyVO.Money, receiverAmount *moneyVO.Money, exchangeRate *string) (*moneyVO.ExchangeRate, error) {
+	crossCurrency := receiverAmount != nil && receiverAmount.Currency() != transferAmount.Currency()
+	if !crossCurrency {
+		if exchangeRate != nil {
+			return nil, ErrUnexpectedExchangeRate
+		}
+		return nil, nil
+	}
+	if exchangeRate == nil {
+		return nil, ErrExchangeRateRequired
+	}
+	return moneyVO.NewExchangeRate(transferAmount.Currency(), receiverAmount.Currency(), *exchangeRate)
+}
+
 func (t *Transaction) ID() idVO.TransactionID {
 	return t.id
 }
@@ -124,6 +263,199 @@ func (t *Transaction) TransferAmount() moneyVO.Money {
 	return t.transferAmount
 }
 
+// 受取口座に入金された金額を返します。振込以外の場合は nil です。
+func (t *Transaction) ReceiverAmount() *moneyVO.Money {
+	return t.receiverAmount
+}
+
+// 受取口座に入金された金額を10進数表記で返します。振込以外の場合は nil です。
+func (t *Transaction) ReceiverAmountDecimal() *string {
+	if t.receiverAmount == nil {
+		return nil
+	}
+	amount := t.receiverAmount.Decimal()
+	return &amount
+}
+
+func (t *Transaction) ReceiverCurrency() *string {
+	if t.receiverAmount == nil {
+		return nil
+	}
+	currency := t.receiverAmount.Currency()
+	return &currency
+}
+
+// 適用した為替レートを返します。通貨が同じ場合は nil です。
+func (t *Transaction) ExchangeRate() *moneyVO.ExchangeRate {
+	return t.exchangeRate
+}
+
+func (t *Transaction) ExchangeRateString() *string {
+	if t.exchangeRate == nil {
+		return nil
+	}
+	rate := t.exchangeRate.Rate()
+	return &rate
+}
+
+// 取消の場合に取り消した取引のIDを返します。取消以外の場合は nil です。
+func (t *Transaction) ReversedTransactionID() *idVO.TransactionID {
+	return t.reversedTransactionID
+}
+
+func (t *Transaction) ReversedTransactionIDString() *string {
+	if t.reversedTransactionID == nil {
+		return nil
+	}
+	reversedTransactionID := t.reversedTransactionID.String()
+	return &reversedTransactionID
+}
+
+// 取消の場合に取り消した取引の取引種別を返します。取消以外の場合は nil です。
+func (t *Transaction) ReversedOperationType() *string {
+	return t.reversedOperationType
+}
+
+// 取引の説明を設定します。取引の作成時のみ設定でき、後から変更することはできません。
+func (t *Transaction) describe(description Description) {
+	t.memo = description.memo
+	t.reference = description.reference
+}
+
+// 取引を行った口座が付けたメモを返します。メモがない場合は nil です。
+func (t *Transaction) Memo() *string {
+	return t.memo
+}
+
+// 指定された口座から見たメモを返します。メモは取引を行った口座のみが参照でき、受け取った振込では nil です。
+func (t *Transaction) MemoFor(accountID idVO.AccountID) *string {
+	if t.accountID != accountID {
+		return nil
+	}
+	return t.memo
+}
+
+// 参照情報を返します。振込の場合は送金元と受取口座の両方が参照できます。参照情報がない場合は nil です。
+func (t *Transaction) Reference() *string {
+	return t.reference
+}
+
+// 指定された口座が付けた分類を返します。分類がない場合は空のスライスです。
+func (t *Transaction) CategoriesFor(accountID idVO.AccountID) []string {
+	categories := make([]string, len(t.categories[accountID]))
+	copy(categories, t.categories[accountID])
+	return categories
+}
+
+// 指定された口座が付けた分類を categories で置き換えます。空の場合は分類を全て外します。
+// 分類は口座ごとに独立しており、振込の送金元と受取口座はそれぞれ別の分類を付けられます。
+func (t *Transaction) changeCategoriesFor(accountID idVO.AccountID, categories []string) error {
+	normalized, err := normalizeCategories(categories)
+	if err != nil {
+		return err
+	}
+	if len(normalized) == 0 {
+		delete(t.categories, accountID)
+		return nil
+	}
+	if t.categories == nil {
+		t.categories = make(map[idVO.AccountID][]string)
+	}
+	t.categories[accountID] = normalized
+	return nil
+}
+
+// 取引後の口座残高を記録します。receiverBalance は振込の場合の受取口座の残高です。
+func (t *Transaction) recordBalancesAfter(balance moneyVO.Money, receiverBalance *moneyVO.Money) {
+	t.balanceAfter = &balance
+	t.receiverBalanceAfter = receiverBalance
+}
+
+// 取引後の口座残高を返します。記録されていない場合は nil です。
+func (t *Transaction) BalanceAfter() *moneyVO.Money {
+	return t.balanceAfter
+}
+
+// 振込後の受取口座の残高を返します。振込以外または記録されていない場合は nil です。
+func (t *Transaction) ReceiverBalanceAfter() *moneyVO.Money {
+	return t.receiverBalanceAfter
+}
+
+// 指定された口座が取引の送金元または受取口座かどうかを返します。
+func (t *Transaction) Involves(accountID idVO.AccountID) bool {
+	return t.accountID == accountID || (t.receiverAccountID != nil && *t.receiverAccountID == accountID)
+}
+
+// 指定された口座から見た取引後の残高を10進数表記で返します。記録されていない場合は nil です。
+func (t *Transaction) BalanceAfterDecimalFor(accountID idVO.AccountID) *string {
+	balance := t.balanceAfter
+	if t.isReceivedBy(accountID) {
+		balance = t.receiverBalanceAfter
+	}
+	if balance == nil {
+		return nil
+	}
+	decimal := balance.Decimal()
+	return &decimal
+}
+
+// 指定された口座が振込の受取口座かどうかを返します。振込の取消の場合も、取り消した振込の受取口座かどうかを返します。
+func (t *Transaction) isReceivedBy(accountID idVO.AccountID) bool {
+	return t.isTransfer() &&
+		t.accountID != accountID &&
+		t.receiverAccountID != nil && *t.receiverAccountID == accountID
+}
+
+func (t *Transaction) isTransfer() bool {
+	return t.operationType == Transfer ||
+		(t.operationType == Reversal && *t.reversedOperationType == Transfer)
+}
+
+// 指定された口座から見た取引種別を返します。振込の場合は TRANSFER_IN または TRANSFER_OUT です。
+func (t *Transaction) OperationTypeFor(accountID idVO.AccountID) string {
+	if t.operationType != Transfer {
+		return t.operationType
+	}
+	if t.isReceivedBy(accountID) {
+		return TransferIn
+	}
+	return TransferOut
+}
+
+// 指定された口座から見た取引の向きを返します。入金と受け取った振込は CREDIT、出金と送金した振込は DEBIT です。
+// 取消の場合は、取り消した取引と逆の向きを返します。
+func (t *Transaction) DirectionFor(accountID idVO.AccountID) string {
+	operationType := t.operationType
+	if t.operationType == Reversal {
+		operationType = *t.reversedOperationType
+	}
+	credit := operationType == Deposit || t.isReceivedBy(accountID)
+	if t.operationType == Reversal {
+		credit = !credit
+	}
+	if credit {
+		return Credit
+	}
+	return Debit
+}
+
+// 指定された口座で増減した金額を返します。受け取った振込の場合は受取口座の通貨での入金額です。
+func (t *Transaction) AmountFor(accountID idVO.AccountID) moneyVO.Money {
+	if t.isReceivedBy(accountID) && t.receiverAmount != nil {
+		return *t.receiverAmount
+	}
+	return t.transferAmount
+}
+
+// 指定された口座で増減した金額を符号付きの10進数表記で返します。DEBIT の場合は負の値です。
+func (t *Transaction) SignedAmountDecimalFor(accountID idVO.AccountID) string {
+	amount := t.AmountFor(accountID).Decimal()
+	if t.DirectionFor(accountID) == Debit {
+		return "-" + amount
+	}
+	return amount
+}
+
 func (t *Transaction) TransactionAt() time.Time {
 	return t.transactionAt
 }
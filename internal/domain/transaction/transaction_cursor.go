@@ -0,0 +1,99 @@
+package transaction
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+// Cursor は取引一覧のキーセットページネーションの位置を表します。
+// 取引日時と取引IDの組で一意に並び順が決まる為、ページングの途中で取引が追加されても結果がずれません。
+type Cursor struct {
+	TransactionAt time.Time
+	ID            idVO.TransactionID
+	// true の場合はカーソルより前のページ、false の場合は後のページを表します。
+	Backward bool
+}
+
+type cursorPayload struct {
+	TransactionAt string `json:"at"`
+	ID            string `json:"id"`
+	Direction     string `json:"dir"`
+}
+
+const (
+	cursorDirectionNext = "next"
+	cursorDirectionPrev = "prev"
+)
+
+// 取引の位置を指すカーソルを作成します。
+func NewCursor(transaction *Transaction, backward bool) Cursor {
+	return Cursor{
+		TransactionAt: transaction.TransactionAt(),
+		ID:            transaction.ID(),
+		Backward:      backward,
+	}
+}
+
+// クライアントに渡す不透明な文字列に変換します。
+func (c Cursor) Encode() string {
+	direction := cursorDirectionNext
+	if c.Backward {
+		direction = cursorDirectionPrev
+	}
+	b, _ := json.Marshal(cursorPayload{
+		TransactionAt: c.TransactionAt.UTC().Format(time.RFC3339Nano),
+		ID:            c.ID.String(),
+		Direction:     direction,
+	})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Encode で作成した文字列からカーソルを復元します。不正な文字列の場合は ErrInvalidCursor を返します。
+func DecodeCursor(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	transactionAt, err := time.Parse(time.RFC3339Nano, payload.TransactionAt)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := idVO.TransactionIDFromString(payload.ID)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if payload.Direction != cursorDirectionNext && payload.Direction != cursorDirectionPrev {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{
+		TransactionAt: transactionAt,
+		ID:            id,
+		Backward:      payload.Direction == cursorDirectionPrev,
+	}, nil
+}
+
+// 指定された並び順で、取引がカーソルより後ろ (Backward の場合は前) にあるかを返します。
+func (c Cursor) Includes(transaction *Transaction, sort string) bool {
+	cmp := transaction.TransactionAt().Compare(c.TransactionAt)
+	if cmp == 0 {
+		cmp = strings.Compare(transaction.IDString(), c.ID.String())
+	}
+	if sort == SortDesc {
+		cmp = -cmp
+	}
+	if c.Backward {
+		return cmp < 0
+	}
+	return cmp > 0
+}
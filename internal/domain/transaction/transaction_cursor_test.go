@@ -0,0 +1,92 @@
+package transaction_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestCursor_EncodeAndDecode(t *testing.T) {
+	accountID := idVO.NewAccountIDForTest("account")
+	transactionAt := timer.GetFixedDate().Add(123456 * time.Microsecond)
+	tx, err := transactionDomain.New(accountID, nil, transactionDomain.Deposit, 1000, moneyVO.JPY, nil, nil, nil, transactionAt)
+	assert.NoError(t, err)
+
+	for _, backward := range []bool{false, true} {
+		cursor := transactionDomain.NewCursor(tx, backward)
+		decoded, err := transactionDomain.DecodeCursor(cursor.Encode())
+		assert.NoError(t, err)
+		assert.True(t, transactionAt.Equal(decoded.TransactionAt))
+		assert.Equal(t, tx.ID(), decoded.ID)
+		assert.Equal(t, backward, decoded.Backward)
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+	transactionID := idVO.NewTransactionIDForTest("transaction").String()
+
+	tests := []struct {
+		caseName string
+		input    string
+	}{
+		{caseName: "Negative: base64ではない", input: "!!!"},
+		{caseName: "Negative: JSONではない", input: encode("cursor")},
+		{caseName: "Negative: 取引日時が不正", input: encode(`{"at":"invalid","id":"` + transactionID + `","dir":"next"}`)},
+		{caseName: "Negative: 取引IDが不正", input: encode(`{"at":"2021-01-01T00:00:00Z","id":"invalid","dir":"next"}`)},
+		{caseName: "Negative: 向きが不正", input: encode(`{"at":"2021-01-01T00:00:00Z","id":"` + transactionID + `","dir":"up"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			cursor, err := transactionDomain.DecodeCursor(tt.input)
+			assert.ErrorIs(t, err, transactionDomain.ErrInvalidCursor)
+			assert.Nil(t, cursor)
+		})
+	}
+}
+
+func TestCursor_Includes(t *testing.T) {
+	accountID := idVO.NewAccountIDForTest("account")
+	newTransaction := func(transactionAt time.Time) *transactionDomain.Transaction {
+		tx, err := transactionDomain.New(accountID, nil, transactionDomain.Deposit, 1000, moneyVO.JPY, nil, nil, nil, transactionAt)
+		assert.NoError(t, err)
+		return tx
+	}
+	base := timer.GetFixedDate()
+	older := newTransaction(base.Add(-time.Hour))
+	cursorTx := newTransaction(base)
+	newer := newTransaction(base.Add(time.Hour))
+
+	tests := []struct {
+		caseName    string
+		backward    bool
+		sort        string
+		transaction *transactionDomain.Transaction
+		want        bool
+	}{
+		{caseName: "Positive: 降順の次のページには古い取引が含まれる", sort: transactionDomain.SortDesc, transaction: older, want: true},
+		{caseName: "Positive: 降順の次のページには新しい取引が含まれない", sort: transactionDomain.SortDesc, transaction: newer, want: false},
+		{caseName: "Positive: 降順の前のページには新しい取引が含まれる", backward: true, sort: transactionDomain.SortDesc, transaction: newer, want: true},
+		{caseName: "Positive: 昇順の次のページには新しい取引が含まれる", sort: transactionDomain.SortAsc, transaction: newer, want: true},
+		{caseName: "Positive: 昇順の前のページには古い取引が含まれる", backward: true, sort: transactionDomain.SortAsc, transaction: older, want: true},
+		{caseName: "Positive: カーソルの取引自身は含まれない", sort: transactionDomain.SortDesc, transaction: cursorTx, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			cursor := transactionDomain.NewCursor(cursorTx, tt.backward)
+			assert.Equal(t, tt.want, cursor.Includes(tt.transaction, tt.sort))
+		})
+	}
+}
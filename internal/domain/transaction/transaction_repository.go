@@ -7,17 +7,42 @@ import (
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
+// Cursor を指定した場合は Page を無視し、カーソルの位置から Limit 件を取得します。
 type ListTransactionsParams struct {
-	AccountID      idVO.AccountID
-	From           *time.Time
-	To             *time.Time
+	AccountID idVO.AccountID
+	From      *time.Time
+	To        *time.Time
+	// 指定日時より前の取引に限ります。To と異なり指定日時を含みません。
+	Before         *time.Time
 	OperationTypes []string
-	Sort           *string
-	Limit          *int
-	Page           *int
+	// メモと参照情報を単語単位で検索するキーワードです。空白で区切った全ての単語を含む取引に一致します。
+	// メモは取引を行った口座から見た場合のみ検索の対象になります。
+	Query *string
+	// 口座の通貨の最小単位で、口座から見た金額 (AmountFor) の範囲を指定します。
+	MinAmount *int64
+	MaxAmount *int64
+	// 口座が付けた分類のいずれかを持つ取引に一致します。
+	Categories []string
+	Sort       *string
+	Limit      *int
+	Page       *int
+	Cursor     *Cursor
 }
 
 type ITransactionRepository interface {
 	Save(ctx context.Context, transaction *Transaction) error
-	ListWithTotalByAccountID(ctx context.Context, params ListTransactionsParams) (transactions []*Transaction, total int, err error)
+	// 取引が存在しない場合は nil を返します。
+	FindByID(ctx context.Context, id idVO.TransactionID) (*Transaction, error)
+	// 指定された取引を取り消した取引を返します。取り消されていない場合は nil を返します。
+	FindReversalOf(ctx context.Context, id idVO.TransactionID) (*Transaction, error)
+	// 口座が送金元の取引に加え、受取口座として受け取った振込も返します。
+	// OperationTypes には TRANSFER_IN, TRANSFER_OUT も指定できます。
+	// 取引は取引日時と取引IDの組で Sort の順に並びます。Backward のカーソルを指定した場合も同じ順で返します。
+	ListByAccountID(ctx context.Context, params ListTransactionsParams) ([]*Transaction, error)
+	// ListByAccountID と同じ条件に一致する取引の件数を返します。Sort, Limit, Page, Cursor は無視します。
+	CountByAccountID(ctx context.Context, params ListTransactionsParams) (int, error)
+	// 口座が送金元である operationType の取引のうち、from 以降の取引の金額の合計を返します。取り消された取引は含みません。
+	SumAmount(ctx context.Context, accountID idVO.AccountID, operationType string, from time.Time) (int64, error)
+	// 指定された口座が取引に付けた分類を、取引が持つ分類で置き換えます。
+	SaveCategories(ctx context.Context, transaction *Transaction, accountID idVO.AccountID) error
 }
@@ -2,37 +2,66 @@ package transaction
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
 	"github.com/u104rak1/pocgo/pkg/timer"
 )
 
 type ITransactionService interface {
-	Deposit(ctx context.Context, account *accountDomain.Account, amount float64, currency string) (*Transaction, error)
-	Withdrawal(ctx context.Context, account *accountDomain.Account, amount float64, currency string) (*Transaction, error)
-	Transfer(ctx context.Context, senderAccount *accountDomain.Account, receiverAccount *accountDomain.Account, amount float64, currency string) (*Transaction, error)
-	ListWithTotal(ctx context.Context, params ListTransactionsParams) (transactions []*Transaction, total int, err error)
+	Deposit(ctx context.Context, account *accountDomain.Account, amount int64, currency string, description Description) (*Transaction, error)
+	Withdrawal(ctx context.Context, account *accountDomain.Account, amount int64, currency string, description Description) (*Transaction, error)
+	Transfer(ctx context.Context, senderAccount *accountDomain.Account, receiverAccount *accountDomain.Account, amount int64, currency string, description Description) (*Transaction, error)
+	// 取引一覧と前後のページのカーソルを取得します。件数はカーソルを指定していない場合のみ取得します。
+	List(ctx context.Context, params ListTransactionsParams) (*ListTransactionsResult, error)
+	// 指定された口座が送金元または受取口座である取引を取得します。該当しない場合は ErrNotFound を返します。
+	GetByAccount(ctx context.Context, accountID idVO.AccountID, transactionID idVO.TransactionID) (*Transaction, error)
+	// 取引を取り消し、取引と逆向きに残高を戻す取引を作成します。取り消せるのは取引を行った口座のみです。
+	Reverse(ctx context.Context, account *accountDomain.Account, original *Transaction) (*Transaction, error)
+	// 指定された口座が取引に付けた分類を置き換えます。取引は GetByAccount で口座に関係することを確認したものを渡します。
+	Categorize(ctx context.Context, accountID idVO.AccountID, transaction *Transaction, categories []string) error
+	// 取引を行う前に、出金または振込の金額が口座の上限を超えないかを検証します。上限を超える場合は limit.ErrLimitExceeded を返します。
+	CheckLimit(ctx context.Context, account *accountDomain.Account, operationType string, amount int64) error
 }
 
 type transactionService struct {
-	accountRepo     accountDomain.IAccountRepository
-	transactionRepo ITransactionRepository
+	accountRepo          accountDomain.IAccountRepository
+	transactionRepo      ITransactionRepository
+	ledgerRepo           ledgerDomain.ILedgerRepository
+	exchangeRateProvider moneyVO.IExchangeRateProvider
+	limitServ            limitDomain.ILimitService
+	eventRepo            eventDomain.IEventRepository
 }
 
 func NewService(
 	accountRepository accountDomain.IAccountRepository,
-	transactionRepository ITransactionRepository) ITransactionService {
+	transactionRepository ITransactionRepository,
+	ledgerRepository ledgerDomain.ILedgerRepository,
+	exchangeRateProvider moneyVO.IExchangeRateProvider,
+	limitService limitDomain.ILimitService,
+	eventRepository eventDomain.IEventRepository) ITransactionService {
 	return &transactionService{
-		accountRepo:     accountRepository,
-		transactionRepo: transactionRepository,
+		accountRepo:          accountRepository,
+		transactionRepo:      transactionRepository,
+		ledgerRepo:           ledgerRepository,
+		exchangeRateProvider: exchangeRateProvider,
+		limitServ:            limitService,
+		eventRepo:            eventRepository,
 	}
 }
 
 func (s *transactionService) Deposit(
 	ctx context.Context,
 	account *accountDomain.Account,
-	amount float64,
+	amount int64,
 	currency string,
+	description Description,
 ) (*Transaction, error) {
 	if err := account.Deposit(amount, currency); err != nil {
 		return nil, err
@@ -43,38 +72,82 @@ func (s *transactionService) Deposit(
 		return nil, err
 	}
 
-	transaction, err := New(account.ID(), nil, Deposit, amount, currency, updatedAt)
+	transaction, err := New(account.ID(), nil, Deposit, amount, currency, nil, nil, nil, updatedAt)
 	if err != nil {
 		return nil, err
 	}
+	transaction.describe(description)
+	transaction.recordBalancesAfter(account.Balance(), nil)
 	if err := s.transactionRepo.Save(ctx, transaction); err != nil {
 		return nil, err
 	}
+
+	entry, err := ledgerDomain.NewDepositEntry(transaction.ID(), account.ID(), transaction.TransferAmount(), updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ledgerRepo.Save(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordEvent(ctx, eventDomain.MoneyDeposited{
+		TransactionID: transaction.IDString(),
+		AccountID:     account.IDString(),
+		Amount:        transaction.TransferAmount().Decimal(),
+		Currency:      transaction.TransferAmount().Currency(),
+		BalanceAfter:  account.Balance().Decimal(),
+	}, updatedAt); err != nil {
+		return nil, err
+	}
 	return transaction, nil
 }
 
 func (s *transactionService) Withdrawal(
 	ctx context.Context,
 	account *accountDomain.Account,
-	amount float64,
+	amount int64,
 	currency string,
+	description Description,
 ) (*Transaction, error) {
 	if err := account.Withdrawal(amount, currency); err != nil {
 		return nil, err
 	}
 	updatedAt := timer.Now()
+	if err := s.checkLimit(ctx, account, Withdrawal, amount, updatedAt); err != nil {
+		return nil, err
+	}
 	account.ChangeUpdatedAt(updatedAt)
 	if err := s.accountRepo.Save(ctx, account); err != nil {
 		return nil, err
 	}
 
-	transaction, err := New(account.ID(), nil, Withdrawal, amount, currency, updatedAt)
+	transaction, err := New(account.ID(), nil, Withdrawal, amount, currency, nil, nil, nil, updatedAt)
 	if err != nil {
 		return nil, err
 	}
+	transaction.describe(description)
+	transaction.recordBalancesAfter(account.Balance(), nil)
 	if err := s.transactionRepo.Save(ctx, transaction); err != nil {
 		return nil, err
 	}
+
+	entry, err := ledgerDomain.NewWithdrawalEntry(transaction.ID(), account.ID(), transaction.TransferAmount(), updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ledgerRepo.Save(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordEvent(ctx, eventDomain.MoneyWithdrawn{
+		TransactionID: transaction.IDString(),
+		AccountID:     account.IDString(),
+		Amount:        transaction.TransferAmount().Decimal(),
+		Currency:      transaction.TransferAmount().Currency(),
+		BalanceAfter:  account.Balance().Decimal(),
+	}, updatedAt); err != nil {
+		return nil, err
+	}
 	return transaction, nil
 }
 
@@ -82,17 +155,31 @@ func (s *transactionService) Transfer(
 	ctx context.Context,
 	senderAccount *accountDomain.Account,
 	receiverAccount *accountDomain.Account,
-	amount float64,
+	amount int64,
 	currency string,
+	description Description,
 ) (*Transaction, error) {
-	if err := receiverAccount.Deposit(amount, currency); err != nil {
+	if err := senderAccount.Withdrawal(amount, currency); err != nil {
 		return nil, err
 	}
-	if err := senderAccount.Withdrawal(amount, currency); err != nil {
+	updatedAt := timer.Now()
+	if err := s.checkLimit(ctx, senderAccount, Transfer, amount, updatedAt); err != nil {
+		return nil, err
+	}
+
+	// 受取口座の通貨が異なる場合は、為替レートで換算した金額を入金する
+	transferAmount, err := moneyVO.New(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	receiverAmount, exchangeRate, err := s.convert(ctx, *transferAmount, receiverAccount.Balance().Currency())
+	if err != nil {
+		return nil, err
+	}
+	if err := receiverAccount.Deposit(receiverAmount.Amount(), receiverAmount.Currency()); err != nil {
 		return nil, err
 	}
 
-	updatedAt := timer.Now()
 	senderAccount.ChangeUpdatedAt(updatedAt)
 	receiverAccount.ChangeUpdatedAt(updatedAt)
 
@@ -104,19 +191,124 @@ func (s *transactionService) Transfer(
 	}
 
 	receiverAccountID := receiverAccount.ID()
-	transaction, err := New(senderAccount.ID(), &receiverAccountID, Transfer, amount, currency, updatedAt)
+	receiverAmountValue, receiverCurrency := receiverAmount.Amount(), receiverAmount.Currency()
+	transaction, err := New(
+		senderAccount.ID(), &receiverAccountID, Transfer, amount, currency,
+		&receiverAmountValue, &receiverCurrency, exchangeRate, updatedAt,
+	)
 	if err != nil {
 		return nil, err
 	}
+	transaction.describe(description)
+	receiverBalance := receiverAccount.Balance()
+	transaction.recordBalancesAfter(senderAccount.Balance(), &receiverBalance)
 	if err := s.transactionRepo.Save(ctx, transaction); err != nil {
 		return nil, err
 	}
+
+	entry, err := ledgerDomain.NewTransferEntry(
+		transaction.ID(), senderAccount.ID(), receiverAccountID, *transferAmount, *receiverAmount, updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ledgerRepo.Save(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordEvent(ctx, eventDomain.MoneyTransferred{
+		TransactionID:     transaction.IDString(),
+		SenderAccountID:   senderAccount.IDString(),
+		ReceiverAccountID: receiverAccount.IDString(),
+		Amount:            transferAmount.Decimal(),
+		Currency:          transferAmount.Currency(),
+		ReceiverAmount:    receiverAmount.Decimal(),
+		ReceiverCurrency:  receiverAmount.Currency(),
+		ExchangeRate:      exchangeRate,
+	}, updatedAt); err != nil {
+		return nil, err
+	}
+	if err := s.recordEvent(ctx, eventDomain.MoneyReceived{
+		TransactionID:   transaction.IDString(),
+		AccountID:       receiverAccount.IDString(),
+		SenderAccountID: senderAccount.IDString(),
+		Amount:          receiverAmount.Decimal(),
+		Currency:        receiverAmount.Currency(),
+		BalanceAfter:    receiverAccount.Balance().Decimal(),
+	}, updatedAt); err != nil {
+		return nil, err
+	}
 	return transaction, nil
 }
 
-func (s *transactionService) ListWithTotal(ctx context.Context, params ListTransactionsParams) (transactions []*Transaction, total int, err error) {
+// 取引と同じトランザクションでイベントを記録します。
+func (s *transactionService) recordEvent(ctx context.Context, payload eventDomain.Payload, occurredAt time.Time) error {
+	event, err := eventDomain.New(payload, occurredAt)
+	if err != nil {
+		return err
+	}
+	return s.eventRepo.Save(ctx, event)
+}
+
+func (s *transactionService) CheckLimit(ctx context.Context, account *accountDomain.Account, operationType string, amount int64) error {
+	return s.checkLimit(ctx, account, operationType, amount, timer.Now())
+}
+
+// 取引によって口座の上限を超えないかを検証します。上限は口座の通貨のものを適用し、上限がない場合は何もしません。
+// 利用済みの金額は同じトランザクション内で集計し、同時に行われた取引は口座の楽観的排他制御で後から保存した方が失敗する為、
+// 並行して取引しても上限を超えることはありません。
+func (s *transactionService) checkLimit(
+	ctx context.Context,
+	account *accountDomain.Account,
+	operationType string,
+	amount int64,
+	now time.Time,
+) error {
+	limits, err := s.limitServ.GetEffective(ctx, account.ID(), operationType, account.Balance().Currency())
+	if err != nil || limits == nil {
+		return err
+	}
+
+	dailyUsed, err := s.transactionRepo.SumAmount(ctx, account.ID(), operationType, now.Add(-limitDomain.DailyWindow))
+	if err != nil {
+		return err
+	}
+	monthlyUsed, err := s.transactionRepo.SumAmount(ctx, account.ID(), operationType, limitDomain.StartOfMonth(now))
+	if err != nil {
+		return err
+	}
+	return limits.Check(amount, dailyUsed, monthlyUsed)
+}
+
+// 振込金額を受取口座の通貨に換算します。通貨が同じ場合は換算せず、為替レートは nil を返します。
+func (s *transactionService) convert(ctx context.Context, amount moneyVO.Money, to string) (*moneyVO.Money, *string, error) {
+	if amount.Currency() == to {
+		return &amount, nil, nil
+	}
+
+	rate, err := s.exchangeRateProvider.Quote(ctx, amount.Currency(), to)
+	if err != nil {
+		return nil, nil, err
+	}
+	converted, err := rate.Convert(amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	rateString := rate.Rate()
+	return converted, &rateString, nil
+}
+
+type ListTransactionsResult struct {
+	Transactions []*Transaction
+	// カーソルを指定した場合は件数を数えない為 nil です。
+	Total      *int
+	NextCursor *Cursor
+	PrevCursor *Cursor
+}
+
+func (s *transactionService) List(ctx context.Context, params ListTransactionsParams) (*ListTransactionsResult, error) {
 	if params.Sort == nil {
-		sort := "DESC"
+		sort := SortDesc
 		params.Sort = &sort
 	}
 	if params.Limit == nil {
@@ -127,6 +319,194 @@ func (s *transactionService) ListWithTotal(ctx context.Context, params ListTrans
 		page := 1
 		params.Page = &page
 	}
+	limit := *params.Limit
+
+	if params.Cursor == nil {
+		transactions, err := s.transactionRepo.ListByAccountID(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		total, err := s.transactionRepo.CountByAccountID(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		hasNext := *params.Page*limit < total
+		hasPrev := *params.Page > 1
+		return newListTransactionsResult(transactions, &total, hasNext, hasPrev), nil
+	}
+
+	// 件数を数えずに次のページがあるかを判定する為、1件多く取得する
+	fetchLimit := limit + 1
+	fetchParams := params
+	fetchParams.Limit = &fetchLimit
+	transactions, err := s.transactionRepo.ListByAccountID(ctx, fetchParams)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(transactions) > limit
+	if params.Cursor.Backward {
+		// 前のページを取得した場合、余分な1件はカーソルから最も遠い先頭にある
+		if hasMore {
+			transactions = transactions[len(transactions)-limit:]
+		}
+		return newListTransactionsResult(transactions, nil, true, hasMore), nil
+	}
+	if hasMore {
+		transactions = transactions[:limit]
+	}
+	return newListTransactionsResult(transactions, nil, hasMore, true), nil
+}
 
-	return s.transactionRepo.ListWithTotalByAccountID(ctx, params)
+func newListTransactionsResult(transactions []*Transaction, total *int, hasNext, hasPrev bool) *ListTransactionsResult {
+	result := &ListTransactionsResult{
+		Transactions: transactions,
+		Total:        total,
+	}
+	if len(transactions) == 0 {
+		return result
+	}
+	if hasNext {
+		next := NewCursor(transactions[len(transactions)-1], false)
+		result.NextCursor = &next
+	}
+	if hasPrev {
+		prev := NewCursor(transactions[0], true)
+		result.PrevCursor = &prev
+	}
+	return result
+}
+
+func (s *transactionService) GetByAccount(ctx context.Context, accountID idVO.AccountID, transactionID idVO.TransactionID) (*Transaction, error) {
+	transaction, err := s.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	// 他の口座の取引の存在を知られないよう、関係しない取引も存在しない取引として扱う
+	if transaction == nil || !transaction.Involves(accountID) {
+		return nil, ErrNotFound
+	}
+	return transaction, nil
+}
+
+func (s *transactionService) Reverse(ctx context.Context, account *accountDomain.Account, original *Transaction) (*Transaction, error) {
+	// 現金で払い出した出金は取り消しても資金が戻らない為、取り消せるのは入金と振込のみ
+	if original.OperationType() != Deposit && original.OperationType() != Transfer {
+		return nil, ErrNotReversible
+	}
+	if original.AccountID() != account.ID() {
+		return nil, ErrReversalNotAllowed
+	}
+	reversal, err := s.transactionRepo.FindReversalOf(ctx, original.ID())
+	if err != nil {
+		return nil, err
+	}
+	if reversal != nil {
+		return nil, ErrAlreadyReversed
+	}
+
+	var receiverAccount *accountDomain.Account
+	if original.OperationType() == Transfer {
+		receiverAccount, err = s.accountRepo.FindByID(ctx, *original.ReceiverAccountID())
+		if err != nil {
+			return nil, err
+		}
+		if receiverAccount == nil {
+			return nil, accountDomain.ErrReceiverNotFound
+		}
+	}
+
+	amount := original.TransferAmount()
+	switch original.OperationType() {
+	case Deposit:
+		err = account.Withdrawal(amount.Amount(), amount.Currency())
+	case Transfer:
+		// 受取口座が受け取った資金を既に使っている場合は取り消せない
+		receivedAmount := *original.ReceiverAmount()
+		if err := receiverAccount.Withdrawal(receivedAmount.Amount(), receivedAmount.Currency()); err != nil {
+			if errors.Is(err, moneyVO.ErrInsufficientBalance) {
+				return nil, ErrReceiverInsufficientBalance
+			}
+			return nil, err
+		}
+		err = account.Deposit(amount.Amount(), amount.Currency())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt := timer.Now()
+	account.ChangeUpdatedAt(updatedAt)
+	if err := s.accountRepo.Save(ctx, account); err != nil {
+		return nil, err
+	}
+	var receiverBalance *moneyVO.Money
+	if receiverAccount != nil {
+		receiverAccount.ChangeUpdatedAt(updatedAt)
+		if err := s.accountRepo.Save(ctx, receiverAccount); err != nil {
+			return nil, err
+		}
+		balance := receiverAccount.Balance()
+		receiverBalance = &balance
+	}
+
+	transaction, err := newReversal(original, updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	transaction.recordBalancesAfter(account.Balance(), receiverBalance)
+	if err := s.transactionRepo.Save(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	// 取り消す取引と貸借を逆にした仕訳を記帳する
+	var entry *ledgerDomain.Entry
+	switch original.OperationType() {
+	case Deposit:
+		entry, err = ledgerDomain.NewWithdrawalEntry(transaction.ID(), account.ID(), amount, updatedAt)
+	case Transfer:
+		entry, err = ledgerDomain.NewTransferEntry(
+			transaction.ID(), receiverAccount.ID(), account.ID(), *original.ReceiverAmount(), amount, updatedAt,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ledgerRepo.Save(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordReversed(ctx, transaction, account, updatedAt); err != nil {
+		return nil, err
+	}
+	if receiverAccount != nil {
+		if err := s.recordReversed(ctx, transaction, receiverAccount, updatedAt); err != nil {
+			return nil, err
+		}
+	}
+	return transaction, nil
+}
+
+// 取消によって残高が変わった口座のイベントを記録します。
+func (s *transactionService) recordReversed(ctx context.Context, reversal *Transaction, account *accountDomain.Account, occurredAt time.Time) error {
+	amount := reversal.AmountFor(account.ID())
+	return s.recordEvent(ctx, eventDomain.MoneyReversed{
+		TransactionID:         reversal.IDString(),
+		ReversedTransactionID: reversal.ReversedTransactionID().String(),
+		AccountID:             account.IDString(),
+		Direction:             reversal.DirectionFor(account.ID()),
+		Amount:                amount.Decimal(),
+		Currency:              amount.Currency(),
+		BalanceAfter:          account.Balance().Decimal(),
+	}, occurredAt)
+}
+
+func (s *transactionService) Categorize(ctx context.Context, accountID idVO.AccountID, transaction *Transaction, categories []string) error {
+	if !transaction.Involves(accountID) {
+		return ErrNotFound
+	}
+	if err := transaction.changeCategoriesFor(accountID, categories); err != nil {
+		return err
+	}
+	return s.transactionRepo.SaveCategories(ctx, transaction, accountID)
 }
@@ -2,11 +2,15 @@ package transaction_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
 	"github.com/u104rak1/pocgo/internal/domain/mock"
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
@@ -18,27 +22,35 @@ import (
 
 func TestDeposit(t *testing.T) {
 	type Mocks struct {
-		accountRepo     *mock.MockIAccountRepository
-		transactionRepo *mock.MockITransactionRepository
+		accountRepo          *mock.MockIAccountRepository
+		transactionRepo      *mock.MockITransactionRepository
+		ledgerRepo           *mock.MockILedgerRepository
+		exchangeRateProvider *mock.MockIExchangeRateProvider
+		limitServ            *mock.MockILimitService
+		eventRepo            *mock.MockIEventRepository
 	}
 
 	var (
 		userID        = idVO.NewUserIDForTest("user")
 		name          = "account-name"
 		password      = "1234"
-		balance       = 100.0
+		balance       = int64(100)
 		currency      = moneyVO.JPY
-		depositAmount = 50.0
+		depositAmount = int64(50)
 		arg           = gomock.Any()
 	)
 
+	description, err := transactionDomain.NewDescription(strutil.StrPointer("salary"), strutil.StrPointer("PAY-2024-03"))
+	assert.NoError(t, err)
+
 	tests := []struct {
-		caseName string
-		account  *accountDomain.Account
-		amount   float64
-		currency string
-		setup    func(mocks Mocks)
-		errMsg   string
+		caseName    string
+		account     *accountDomain.Account
+		amount      int64
+		currency    string
+		description transactionDomain.Description
+		setup       func(mocks Mocks)
+		errMsg      string
 	}{
 		{
 			caseName: "Positive: 入金が成功する",
@@ -47,6 +59,21 @@ func TestDeposit(t *testing.T) {
 			setup: func(mocks Mocks) {
 				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
 				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+			errMsg: "",
+		},
+		{
+			caseName:    "Positive: メモと参照情報を付けて入金が成功する",
+			amount:      depositAmount,
+			currency:    moneyVO.JPY,
+			description: description,
+			setup: func(mocks Mocks) {
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, arg).Return(nil)
 			},
 			errMsg: "",
 		},
@@ -76,6 +103,29 @@ func TestDeposit(t *testing.T) {
 			},
 			errMsg: assert.AnError.Error(),
 		},
+		{
+			caseName: "Negative: 仕訳の保存が失敗した場合はエラーが返る",
+			amount:   depositAmount,
+			currency: moneyVO.JPY,
+			setup: func(mocks Mocks) {
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: イベントの記録が失敗した場合はエラーが返る",
+			amount:   depositAmount,
+			currency: moneyVO.JPY,
+			setup: func(mocks Mocks) {
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
 		// 取引の作成を意図的に失敗させるのが難しいので、テストを省略する
 	}
 
@@ -86,16 +136,20 @@ func TestDeposit(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := Mocks{
-				accountRepo:     mock.NewMockIAccountRepository(ctrl),
-				transactionRepo: mock.NewMockITransactionRepository(ctrl),
+				accountRepo:          mock.NewMockIAccountRepository(ctrl),
+				transactionRepo:      mock.NewMockITransactionRepository(ctrl),
+				ledgerRepo:           mock.NewMockILedgerRepository(ctrl),
+				exchangeRateProvider: mock.NewMockIExchangeRateProvider(ctrl),
+				limitServ:            mock.NewMockILimitService(ctrl),
+				eventRepo:            mock.NewMockIEventRepository(ctrl),
 			}
-			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo)
+			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo, mocks.ledgerRepo, mocks.exchangeRateProvider, mocks.limitServ, mocks.eventRepo)
 			ctx := context.Background()
 			tt.setup(mocks)
 			account, err := accountDomain.New(userID, balance, name, password, currency)
 			assert.NoError(t, err)
 
-			transaction, err := service.Deposit(ctx, account, tt.amount, tt.currency)
+			transaction, err := service.Deposit(ctx, account, tt.amount, tt.currency, tt.description)
 
 			if tt.errMsg != "" {
 				assert.Error(t, err)
@@ -107,6 +161,10 @@ func TestDeposit(t *testing.T) {
 				assert.Equal(t, tt.amount, transaction.TransferAmount().Amount())
 				assert.Equal(t, tt.currency, transaction.TransferAmount().Currency())
 				assert.Equal(t, "DEPOSIT", transaction.OperationType())
+				assert.Equal(t, balance+tt.amount, transaction.BalanceAfter().Amount())
+				assert.Nil(t, transaction.ReceiverBalanceAfter())
+				assert.Equal(t, tt.description.Memo(), transaction.Memo())
+				assert.Equal(t, tt.description.Reference(), transaction.Reference())
 			}
 		})
 	}
@@ -114,24 +172,28 @@ func TestDeposit(t *testing.T) {
 
 func TestWithdrawal(t *testing.T) {
 	type Mocks struct {
-		accountRepo     *mock.MockIAccountRepository
-		transactionRepo *mock.MockITransactionRepository
+		accountRepo          *mock.MockIAccountRepository
+		transactionRepo      *mock.MockITransactionRepository
+		ledgerRepo           *mock.MockILedgerRepository
+		exchangeRateProvider *mock.MockIExchangeRateProvider
+		limitServ            *mock.MockILimitService
+		eventRepo            *mock.MockIEventRepository
 	}
 
 	var (
 		userID           = idVO.NewUserIDForTest("user")
 		name             = "account-name"
 		password         = "1234"
-		balance          = 100.0
+		balance          = int64(100)
 		currency         = moneyVO.JPY
-		withdrawalAmount = 50.0
+		withdrawalAmount = int64(50)
 		arg              = gomock.Any()
 	)
 
 	tests := []struct {
 		caseName string
 		account  *accountDomain.Account
-		amount   float64
+		amount   int64
 		currency string
 		setup    func(mocks Mocks)
 		errMsg   string
@@ -141,8 +203,11 @@ func TestWithdrawal(t *testing.T) {
 			amount:   withdrawalAmount,
 			currency: moneyVO.JPY,
 			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, nil)
 				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
 				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, arg).Return(nil)
 			},
 			errMsg: "",
 		},
@@ -153,11 +218,49 @@ func TestWithdrawal(t *testing.T) {
 			setup:    func(mocks Mocks) {},
 			errMsg:   moneyVO.ErrDifferentCurrencyOperation.Error(),
 		},
+		{
+			caseName: "Positive: 出金の上限の範囲内であれば出金が成功する",
+			amount:   withdrawalAmount,
+			currency: moneyVO.JPY,
+			setup: func(mocks Mocks) {
+				limits, _ := limitDomain.NewLimits(limitDomain.OperationWithdrawal, 100, 100, 1000, moneyVO.JPY)
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(limits, nil)
+				mocks.transactionRepo.EXPECT().SumAmount(arg, arg, limitDomain.OperationWithdrawal, arg).Return(int64(50), nil).Times(2)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: 出金の上限を超える場合は残りの金額を含むエラーが返る",
+			amount:   withdrawalAmount,
+			currency: moneyVO.JPY,
+			setup: func(mocks Mocks) {
+				limits, _ := limitDomain.NewLimits(limitDomain.OperationWithdrawal, 100, 100, 1000, moneyVO.JPY)
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(limits, nil)
+				mocks.transactionRepo.EXPECT().SumAmount(arg, arg, limitDomain.OperationWithdrawal, arg).Return(int64(80), nil).Times(2)
+			},
+			errMsg: "transaction limit exceeded: daily limit of 100 JPY for WITHDRAWAL, remaining allowance is 20 JPY",
+		},
+		{
+			caseName: "Negative: 利用済みの金額の集計が失敗した場合はエラーが返る",
+			amount:   withdrawalAmount,
+			currency: moneyVO.JPY,
+			setup: func(mocks Mocks) {
+				limits, _ := limitDomain.NewLimits(limitDomain.OperationWithdrawal, 100, 100, 1000, moneyVO.JPY)
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(limits, nil)
+				mocks.transactionRepo.EXPECT().SumAmount(arg, arg, limitDomain.OperationWithdrawal, arg).Return(int64(0), assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
 		{
 			caseName: "Negative: 口座の保存が失敗した場合はエラーが返る",
 			amount:   withdrawalAmount,
 			currency: moneyVO.JPY,
 			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, nil)
 				mocks.accountRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
 			},
 			errMsg: assert.AnError.Error(),
@@ -167,11 +270,37 @@ func TestWithdrawal(t *testing.T) {
 			amount:   withdrawalAmount,
 			currency: moneyVO.JPY,
 			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, nil)
 				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
 				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
 			},
 			errMsg: assert.AnError.Error(),
 		},
+		{
+			caseName: "Negative: 仕訳の保存が失敗した場合はエラーが返る",
+			amount:   withdrawalAmount,
+			currency: moneyVO.JPY,
+			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: イベントの記録が失敗した場合はエラーが返る",
+			amount:   withdrawalAmount,
+			currency: moneyVO.JPY,
+			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationWithdrawal, moneyVO.JPY).Return(nil, nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -181,16 +310,20 @@ func TestWithdrawal(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := Mocks{
-				accountRepo:     mock.NewMockIAccountRepository(ctrl),
-				transactionRepo: mock.NewMockITransactionRepository(ctrl),
+				accountRepo:          mock.NewMockIAccountRepository(ctrl),
+				transactionRepo:      mock.NewMockITransactionRepository(ctrl),
+				ledgerRepo:           mock.NewMockILedgerRepository(ctrl),
+				exchangeRateProvider: mock.NewMockIExchangeRateProvider(ctrl),
+				limitServ:            mock.NewMockILimitService(ctrl),
+				eventRepo:            mock.NewMockIEventRepository(ctrl),
 			}
-			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo)
+			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo, mocks.ledgerRepo, mocks.exchangeRateProvider, mocks.limitServ, mocks.eventRepo)
 			ctx := context.Background()
 			tt.setup(mocks)
 			account, err := accountDomain.New(userID, balance, name, password, currency)
 			assert.NoError(t, err)
 
-			transaction, err := service.Withdrawal(ctx, account, tt.amount, tt.currency)
+			transaction, err := service.Withdrawal(ctx, account, tt.amount, tt.currency, transactionDomain.Description{})
 
 			if tt.errMsg != "" {
 				assert.Error(t, err)
@@ -202,6 +335,8 @@ func TestWithdrawal(t *testing.T) {
 				assert.Equal(t, tt.amount, transaction.TransferAmount().Amount())
 				assert.Equal(t, tt.currency, transaction.TransferAmount().Currency())
 				assert.Equal(t, "WITHDRAWAL", transaction.OperationType())
+				assert.Equal(t, balance-tt.amount, transaction.BalanceAfter().Amount())
+				assert.Nil(t, transaction.ReceiverBalanceAfter())
 			}
 		})
 	}
@@ -209,39 +344,100 @@ func TestWithdrawal(t *testing.T) {
 
 func TestTransfer(t *testing.T) {
 	type Mocks struct {
-		accountRepo     *mock.MockIAccountRepository
-		transactionRepo *mock.MockITransactionRepository
+		accountRepo          *mock.MockIAccountRepository
+		transactionRepo      *mock.MockITransactionRepository
+		ledgerRepo           *mock.MockILedgerRepository
+		exchangeRateProvider *mock.MockIExchangeRateProvider
+		limitServ            *mock.MockILimitService
+		eventRepo            *mock.MockIEventRepository
 	}
 
 	var (
 		userID         = idVO.NewUserIDForTest("user")
 		name           = "account-name"
 		password       = "1234"
-		balance        = 100.0
+		balance        = int64(100)
 		currency       = moneyVO.JPY
-		transferAmount = 50.0
+		transferAmount = int64(50)
 		arg            = gomock.Any()
 	)
 
+	jpyToUSD, err := moneyVO.NewExchangeRate(moneyVO.JPY, moneyVO.USD, "0.0067")
+	assert.NoError(t, err)
+
 	tests := []struct {
-		caseName string
-		amount   float64
-		currency string
-		setup    func(mocks Mocks)
-		errMsg   string
+		caseName           string
+		amount             int64
+		currency           string
+		receiverCurrency   string
+		setup              func(mocks Mocks)
+		wantReceiverAmount int64
+		wantExchangeRate   *string
+		errMsg             string
 	}{
 		{
 			caseName: "Positive: 送金が成功する",
 			amount:   transferAmount,
 			currency: moneyVO.JPY,
 			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
 				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil).Times(2)
 				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, arg).Return(nil).Times(2)
 			},
-			errMsg: "",
+			wantReceiverAmount: transferAmount,
+			wantExchangeRate:   nil,
+			errMsg:             "",
 		},
 		{
-			caseName: "Negative: money.Depositが失敗した場合はエラーが返る（通貨単位が異なる）",
+			caseName:         "Positive: 受取口座の通貨が異なる場合は、為替レートで換算して送金が成功する",
+			amount:           transferAmount,
+			currency:         moneyVO.JPY,
+			receiverCurrency: moneyVO.USD,
+			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
+				mocks.exchangeRateProvider.EXPECT().Quote(arg, moneyVO.JPY, moneyVO.USD).Return(jpyToUSD, nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil).Times(2)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).DoAndReturn(func(_ context.Context, entry *ledgerDomain.Entry) error {
+					// 通貨ごとに貸借を一致させる為、為替勘定を経由した4行の仕訳になる
+					assert.Len(t, entry.Postings(), 4)
+					return nil
+				})
+				gomock.InOrder(
+					mocks.eventRepo.EXPECT().Save(arg, arg).DoAndReturn(func(_ context.Context, event *eventDomain.Event) error {
+						assert.Equal(t, eventDomain.TypeMoneyTransferred, event.Type())
+						assert.Contains(t, string(event.Payload()), `"receiverAmount":"0.34","receiverCurrency":"USD","exchangeRate":"0.0067"`)
+						return nil
+					}),
+					// 受取口座の他のイベントとの順序を保つ為、受取口座のイベントとしても記録する
+					mocks.eventRepo.EXPECT().Save(arg, arg).DoAndReturn(func(_ context.Context, event *eventDomain.Event) error {
+						assert.Equal(t, eventDomain.TypeMoneyReceived, event.Type())
+						assert.Equal(t, eventDomain.AggregateAccount, event.AggregateType())
+						assert.Contains(t, string(event.Payload()), `"amount":"0.34","currency":"USD"`)
+						return nil
+					}),
+				)
+			},
+			// 50 JPY × 0.0067 = 0.335 USD → 34 セント（四捨五入）
+			wantReceiverAmount: 34,
+			wantExchangeRate:   strutil.StrPointer("0.0067"),
+			errMsg:             "",
+		},
+		{
+			caseName:         "Negative: 為替レートが取得できない場合はエラーが返る",
+			amount:           transferAmount,
+			currency:         moneyVO.JPY,
+			receiverCurrency: moneyVO.USD,
+			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
+				mocks.exchangeRateProvider.EXPECT().Quote(arg, moneyVO.JPY, moneyVO.USD).Return(nil, moneyVO.ErrExchangeRateNotFound)
+			},
+			errMsg: moneyVO.ErrExchangeRateNotFound.Error(),
+		},
+		{
+			caseName: "Negative: money.Withdrawが失敗した場合はエラーが返る（通貨単位が異なる）",
 			amount:   transferAmount,
 			currency: moneyVO.USD,
 			setup:    func(mocks Mocks) {},
@@ -254,11 +450,23 @@ func TestTransfer(t *testing.T) {
 			setup:    func(mocks Mocks) {},
 			errMsg:   moneyVO.ErrInsufficientBalance.Error(),
 		},
+		{
+			caseName: "Negative: 振込の上限を超える場合は残りの金額を含むエラーが返る",
+			amount:   transferAmount,
+			currency: moneyVO.JPY,
+			setup: func(mocks Mocks) {
+				limits, _ := limitDomain.NewLimits(limitDomain.OperationTransfer, 10, 100, 1000, moneyVO.JPY)
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(limits, nil)
+				mocks.transactionRepo.EXPECT().SumAmount(arg, arg, limitDomain.OperationTransfer, arg).Return(int64(0), nil).Times(2)
+			},
+			errMsg: "transaction limit exceeded: per transaction limit of 10 JPY for TRANSFER, remaining allowance is 10 JPY",
+		},
 		{
 			caseName: "Negative: 送金元口座の保存が失敗した場合はエラーが返る",
 			amount:   transferAmount,
 			currency: moneyVO.JPY,
 			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
 				mocks.accountRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
 			},
 			errMsg: assert.AnError.Error(),
@@ -268,6 +476,7 @@ func TestTransfer(t *testing.T) {
 			amount:   transferAmount,
 			currency: moneyVO.JPY,
 			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
 				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
 				mocks.accountRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
 			},
@@ -278,11 +487,37 @@ func TestTransfer(t *testing.T) {
 			amount:   transferAmount,
 			currency: moneyVO.JPY,
 			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
 				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil).Times(2)
 				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
 			},
 			errMsg: assert.AnError.Error(),
 		},
+		{
+			caseName: "Negative: 仕訳の保存が失敗した場合はエラーが返る",
+			amount:   transferAmount,
+			currency: moneyVO.JPY,
+			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil).Times(2)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: イベントの記録が失敗した場合はエラーが返る",
+			amount:   transferAmount,
+			currency: moneyVO.JPY,
+			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil).Times(2)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -292,18 +527,26 @@ func TestTransfer(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := Mocks{
-				accountRepo:     mock.NewMockIAccountRepository(ctrl),
-				transactionRepo: mock.NewMockITransactionRepository(ctrl),
+				accountRepo:          mock.NewMockIAccountRepository(ctrl),
+				transactionRepo:      mock.NewMockITransactionRepository(ctrl),
+				ledgerRepo:           mock.NewMockILedgerRepository(ctrl),
+				exchangeRateProvider: mock.NewMockIExchangeRateProvider(ctrl),
+				limitServ:            mock.NewMockILimitService(ctrl),
+				eventRepo:            mock.NewMockIEventRepository(ctrl),
 			}
-			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo)
+			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo, mocks.ledgerRepo, mocks.exchangeRateProvider, mocks.limitServ, mocks.eventRepo)
 			ctx := context.Background()
 			tt.setup(mocks)
 			senderAccount, err := accountDomain.New(userID, balance, name, password, currency)
 			assert.NoError(t, err)
-			receiverAccount, err := accountDomain.New(userID, balance, name, password, currency)
+			receiverCurrency := currency
+			if tt.receiverCurrency != "" {
+				receiverCurrency = tt.receiverCurrency
+			}
+			receiverAccount, err := accountDomain.New(userID, balance, name, password, receiverCurrency)
 			assert.NoError(t, err)
 
-			transaction, err := service.Transfer(ctx, senderAccount, receiverAccount, tt.amount, tt.currency)
+			transaction, err := service.Transfer(ctx, senderAccount, receiverAccount, tt.amount, tt.currency, transactionDomain.Description{})
 
 			if tt.errMsg != "" {
 				assert.Error(t, err)
@@ -315,16 +558,30 @@ func TestTransfer(t *testing.T) {
 				assert.Equal(t, receiverAccount.ID(), *transaction.ReceiverAccountID())
 				assert.Equal(t, tt.amount, transaction.TransferAmount().Amount())
 				assert.Equal(t, tt.currency, transaction.TransferAmount().Currency())
+				assert.Equal(t, tt.wantReceiverAmount, transaction.ReceiverAmount().Amount())
+				assert.Equal(t, receiverCurrency, transaction.ReceiverAmount().Currency())
+				assert.Equal(t, balance+tt.wantReceiverAmount, receiverAccount.Balance().Amount())
+				if tt.wantExchangeRate != nil {
+					assert.Equal(t, *tt.wantExchangeRate, transaction.ExchangeRate().Rate())
+				} else {
+					assert.Nil(t, transaction.ExchangeRate())
+				}
 				assert.Equal(t, "TRANSFER", transaction.OperationType())
+				assert.Equal(t, senderAccount.Balance(), *transaction.BalanceAfter())
+				assert.Equal(t, receiverAccount.Balance(), *transaction.ReceiverBalanceAfter())
 			}
 		})
 	}
 }
 
-func TestListWithTotal(t *testing.T) {
+func TestList(t *testing.T) {
 	type Mocks struct {
-		accountRepo     *mock.MockIAccountRepository
-		transactionRepo *mock.MockITransactionRepository
+		accountRepo          *mock.MockIAccountRepository
+		transactionRepo      *mock.MockITransactionRepository
+		ledgerRepo           *mock.MockILedgerRepository
+		exchangeRateProvider *mock.MockIExchangeRateProvider
+		limitServ            *mock.MockILimitService
+		eventRepo            *mock.MockIEventRepository
 	}
 
 	var (
@@ -332,16 +589,29 @@ func TestListWithTotal(t *testing.T) {
 		arg       = gomock.Any()
 	)
 
+	tx1, err := transactionDomain.New(accountID, nil, transactionDomain.Deposit, 1000, moneyVO.JPY, nil, nil, nil, timer.GetFixedDate())
+	assert.NoError(t, err)
+	tx2, err := transactionDomain.New(accountID, nil, transactionDomain.Withdrawal, 500, moneyVO.JPY, nil, nil, nil, timer.GetFixedDate())
+	assert.NoError(t, err)
+	cursor := transactionDomain.NewCursor(tx1, false)
+	backwardCursor := transactionDomain.NewCursor(tx1, true)
+
+	expectParams := func(sort string, limit int, page int, cursor *transactionDomain.Cursor) gomock.Matcher {
+		return listParamsMatcher{sort: sort, limit: limit, page: page, cursor: cursor}
+	}
+
 	tests := []struct {
 		caseName         string
 		params           transactionDomain.ListTransactionsParams
+		setup            func(mocks Mocks)
 		wantTransactions []*transactionDomain.Transaction
-		wantTotal        int
-		setup            func(mocks Mocks, transactions []*transactionDomain.Transaction)
+		wantTotal        *int
+		wantNext         *transactionDomain.Cursor
+		wantPrev         *transactionDomain.Cursor
 		errMsg           string
 	}{
 		{
-			caseName: "Positive: 取引一覧が取得できる（パラメータを全て指定）",
+			caseName: "Positive: ページ番号を指定すると件数と前後のページのカーソルが取得できる",
 			params: transactionDomain.ListTransactionsParams{
 				AccountID: accountID,
 				From:      timer.TimePointer(timer.GetFixedDate()),
@@ -352,38 +622,112 @@ func TestListWithTotal(t *testing.T) {
 					transactionDomain.Transfer,
 				},
 				Sort:  strutil.StrPointer("ASC"),
-				Limit: numutil.IntPointer(20),
+				Limit: numutil.IntPointer(2),
 				Page:  numutil.IntPointer(2),
 			},
-			wantTotal: 2,
-			setup: func(mocks Mocks, transactions []*transactionDomain.Transaction) {
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().
+					ListByAccountID(arg, expectParams("ASC", 2, 2, nil)).Return([]*transactionDomain.Transaction{tx1, tx2}, nil)
+				mocks.transactionRepo.EXPECT().CountByAccountID(arg, arg).Return(5, nil)
+			},
+			wantTransactions: []*transactionDomain.Transaction{tx1, tx2},
+			wantTotal:        numutil.IntPointer(5),
+			wantNext:         &transactionDomain.Cursor{TransactionAt: tx2.TransactionAt(), ID: tx2.ID()},
+			wantPrev:         &backwardCursor,
+			errMsg:           "",
+		},
+		{
+			caseName: "Positive: パラメータを省略するとデフォルト値で最初のページが取得できる",
+			params: transactionDomain.ListTransactionsParams{
+				AccountID: accountID,
+			},
+			setup: func(mocks Mocks) {
 				mocks.transactionRepo.EXPECT().
-					ListWithTotalByAccountID(arg, arg).Return(transactions, 2, nil)
+					ListByAccountID(arg, expectParams("DESC", transactionDomain.ListTransactionsLimit, 1, nil)).
+					Return([]*transactionDomain.Transaction{tx1, tx2}, nil)
+				mocks.transactionRepo.EXPECT().CountByAccountID(arg, arg).Return(2, nil)
 			},
-			errMsg: "",
+			wantTransactions: []*transactionDomain.Transaction{tx1, tx2},
+			wantTotal:        numutil.IntPointer(2),
+			errMsg:           "",
 		},
 		{
-			caseName: "Positive: 取引一覧が取得できる（最低限のパラメータを指定）",
+			caseName: "Positive: カーソルを指定すると件数を数えずに次のページが取得できる",
 			params: transactionDomain.ListTransactionsParams{
 				AccountID: accountID,
+				Limit:     numutil.IntPointer(1),
+				Cursor:    &cursor,
 			},
-			wantTotal: 2,
-			setup: func(mocks Mocks, transactions []*transactionDomain.Transaction) {
+			setup: func(mocks Mocks) {
 				mocks.transactionRepo.EXPECT().
-					ListWithTotalByAccountID(arg, arg).Return(transactions, 2, nil)
+					ListByAccountID(arg, expectParams("DESC", 2, 1, &cursor)).Return([]*transactionDomain.Transaction{tx1, tx2}, nil)
 			},
-			errMsg: "",
+			wantTransactions: []*transactionDomain.Transaction{tx1},
+			wantNext:         &cursor,
+			wantPrev:         &backwardCursor,
+			errMsg:           "",
+		},
+		{
+			caseName: "Positive: カーソルの後に取引がない場合は次のページのカーソルがない",
+			params: transactionDomain.ListTransactionsParams{
+				AccountID: accountID,
+				Limit:     numutil.IntPointer(1),
+				Cursor:    &cursor,
+			},
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().
+					ListByAccountID(arg, arg).Return([]*transactionDomain.Transaction{tx1}, nil)
+			},
+			wantTransactions: []*transactionDomain.Transaction{tx1},
+			wantPrev:         &backwardCursor,
+			errMsg:           "",
 		},
 		{
-			caseName: "Negative: ListWithTotalByAccountIDがエラーを返した場合はエラーが返される",
+			caseName: "Positive: 前のページのカーソルを指定するとカーソルに近い取引が取得できる",
 			params: transactionDomain.ListTransactionsParams{
 				AccountID: accountID,
+				Limit:     numutil.IntPointer(1),
+				Cursor:    &backwardCursor,
 			},
-			wantTransactions: nil,
-			wantTotal:        0,
-			setup: func(mocks Mocks, transactions []*transactionDomain.Transaction) {
+			setup: func(mocks Mocks) {
 				mocks.transactionRepo.EXPECT().
-					ListWithTotalByAccountID(arg, arg).Return(nil, 0, assert.AnError)
+					ListByAccountID(arg, arg).Return([]*transactionDomain.Transaction{tx2, tx1}, nil)
+			},
+			wantTransactions: []*transactionDomain.Transaction{tx1},
+			wantNext:         &cursor,
+			wantPrev:         &backwardCursor,
+			errMsg:           "",
+		},
+		{
+			caseName: "Positive: 取引がない場合はカーソルがない",
+			params: transactionDomain.ListTransactionsParams{
+				AccountID: accountID,
+				Cursor:    &cursor,
+			},
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().ListByAccountID(arg, arg).Return([]*transactionDomain.Transaction{}, nil)
+			},
+			wantTransactions: []*transactionDomain.Transaction{},
+			errMsg:           "",
+		},
+		{
+			caseName: "Negative: ListByAccountIDがエラーを返した場合はエラーが返される",
+			params: transactionDomain.ListTransactionsParams{
+				AccountID: accountID,
+			},
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().ListByAccountID(arg, arg).Return(nil, assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: CountByAccountIDがエラーを返した場合はエラーが返される",
+			params: transactionDomain.ListTransactionsParams{
+				AccountID: accountID,
+			},
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().ListByAccountID(arg, arg).Return([]*transactionDomain.Transaction{tx1}, nil)
+				mocks.transactionRepo.EXPECT().CountByAccountID(arg, arg).Return(0, assert.AnError)
 			},
 			errMsg: assert.AnError.Error(),
 		},
@@ -396,45 +740,620 @@ func TestListWithTotal(t *testing.T) {
 			defer ctrl.Finish()
 
 			mocks := Mocks{
-				accountRepo:     mock.NewMockIAccountRepository(ctrl),
-				transactionRepo: mock.NewMockITransactionRepository(ctrl),
+				accountRepo:          mock.NewMockIAccountRepository(ctrl),
+				transactionRepo:      mock.NewMockITransactionRepository(ctrl),
+				ledgerRepo:           mock.NewMockILedgerRepository(ctrl),
+				exchangeRateProvider: mock.NewMockIExchangeRateProvider(ctrl),
+				limitServ:            mock.NewMockILimitService(ctrl),
+				eventRepo:            mock.NewMockIEventRepository(ctrl),
 			}
-			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo)
-			ctx := context.Background()
-			tx1, err := transactionDomain.New(
-				accountID,
-				nil,
-				transactionDomain.Deposit,
-				1000.0,
-				moneyVO.JPY,
-				timer.GetFixedDate(),
-			)
+			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo, mocks.ledgerRepo, mocks.exchangeRateProvider, mocks.limitServ, mocks.eventRepo)
+			tt.setup(mocks)
+
+			result, err := service.List(context.Background(), tt.params)
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantTransactions, result.Transactions)
+				assert.Equal(t, tt.wantTotal, result.Total)
+				assert.Equal(t, tt.wantNext, result.NextCursor)
+				assert.Equal(t, tt.wantPrev, result.PrevCursor)
+			}
+		})
+	}
+}
+
+func TestGetByAccount(t *testing.T) {
+	type Mocks struct {
+		accountRepo          *mock.MockIAccountRepository
+		transactionRepo      *mock.MockITransactionRepository
+		ledgerRepo           *mock.MockILedgerRepository
+		exchangeRateProvider *mock.MockIExchangeRateProvider
+		limitServ            *mock.MockILimitService
+		eventRepo            *mock.MockIEventRepository
+	}
+
+	var (
+		accountID         = idVO.NewAccountIDForTest("account")
+		receiverAccountID = idVO.NewAccountIDForTest("accountReceiver")
+		otherAccountID    = idVO.NewAccountIDForTest("accountOther")
+		receiverAmount    = int64(1000)
+		receiverCurrency  = moneyVO.JPY
+		arg               = gomock.Any()
+	)
+
+	transaction, err := transactionDomain.New(
+		accountID, &receiverAccountID, transactionDomain.Transfer, 1000, moneyVO.JPY,
+		&receiverAmount, &receiverCurrency, nil, timer.GetFixedDate(),
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName  string
+		accountID idVO.AccountID
+		setup     func(mocks Mocks)
+		errMsg    string
+	}{
+		{
+			caseName:  "Positive: 送金元の口座の取引が取得できる",
+			accountID: accountID,
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().FindByID(arg, transaction.ID()).Return(transaction, nil)
+			},
+			errMsg: "",
+		},
+		{
+			caseName:  "Positive: 受取口座の取引が取得できる",
+			accountID: receiverAccountID,
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().FindByID(arg, transaction.ID()).Return(transaction, nil)
+			},
+			errMsg: "",
+		},
+		{
+			caseName:  "Negative: 取引が存在しない場合は ErrNotFound が返る",
+			accountID: accountID,
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().FindByID(arg, transaction.ID()).Return(nil, nil)
+			},
+			errMsg: transactionDomain.ErrNotFound.Error(),
+		},
+		{
+			caseName:  "Negative: 口座が関係しない取引の場合は ErrNotFound が返る",
+			accountID: otherAccountID,
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().FindByID(arg, transaction.ID()).Return(transaction, nil)
+			},
+			errMsg: transactionDomain.ErrNotFound.Error(),
+		},
+		{
+			caseName:  "Negative: FindByIDがエラーを返した場合はエラーが返される",
+			accountID: accountID,
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().FindByID(arg, transaction.ID()).Return(nil, assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountRepo:          mock.NewMockIAccountRepository(ctrl),
+				transactionRepo:      mock.NewMockITransactionRepository(ctrl),
+				ledgerRepo:           mock.NewMockILedgerRepository(ctrl),
+				exchangeRateProvider: mock.NewMockIExchangeRateProvider(ctrl),
+				limitServ:            mock.NewMockILimitService(ctrl),
+				eventRepo:            mock.NewMockIEventRepository(ctrl),
+			}
+			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo, mocks.ledgerRepo, mocks.exchangeRateProvider, mocks.limitServ, mocks.eventRepo)
+			tt.setup(mocks)
+
+			got, err := service.GetByAccount(context.Background(), tt.accountID, transaction.ID())
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, transaction, got)
+			}
+		})
+	}
+}
+
+func TestReverse(t *testing.T) {
+	type Mocks struct {
+		accountRepo          *mock.MockIAccountRepository
+		transactionRepo      *mock.MockITransactionRepository
+		ledgerRepo           *mock.MockILedgerRepository
+		exchangeRateProvider *mock.MockIExchangeRateProvider
+		limitServ            *mock.MockILimitService
+		eventRepo            *mock.MockIEventRepository
+	}
+
+	var (
+		userID        = idVO.NewUserIDForTest("user")
+		name          = "account-name"
+		password      = "1234"
+		balance       = int64(1000)
+		amount        = int64(300)
+		usdAmount     = int64(200)
+		usd           = moneyVO.USD
+		rate          = "0.0067"
+		otherID       = idVO.NewAccountIDForTest("accountOther")
+		transactionID = idVO.NewTransactionIDForTest("transaction").String()
+		arg           = gomock.Any()
+	)
+
+	tests := []struct {
+		caseName string
+		// 取り消す取引を口座と受取口座から作成します。
+		original            func(account, receiver *accountDomain.Account) *transactionDomain.Transaction
+		receiverBalance     int64
+		setup               func(mocks Mocks, receiver *accountDomain.Account)
+		wantBalance         int64
+		wantReceiverBalance int64
+		wantDirection       string
+		errMsg              string
+	}{
+		{
+			caseName: "Positive: 入金を取り消すと、入金額が口座から引き落とされる",
+			original: func(account, _ *accountDomain.Account) *transactionDomain.Transaction {
+				tx, _ := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, moneyVO.JPY, nil, nil, nil, timer.GetFixedDate())
+				return tx
+			},
+			setup: func(mocks Mocks, _ *accountDomain.Account) {
+				mocks.transactionRepo.EXPECT().FindReversalOf(arg, arg).Return(nil, nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(nil)
+			},
+			wantBalance:   balance - amount,
+			wantDirection: transactionDomain.Debit,
+		},
+		{
+			caseName: "Positive: 通貨が異なる振込を取り消すと、受取金額が受取口座から引き落とされ、送金額が送金元に戻る",
+			original: func(account, receiver *accountDomain.Account) *transactionDomain.Transaction {
+				receiverID := receiver.ID()
+				tx, _ := transactionDomain.New(account.ID(), &receiverID, transactionDomain.Transfer, amount, moneyVO.JPY, &usdAmount, &usd, &rate, timer.GetFixedDate())
+				return tx
+			},
+			receiverBalance: 500,
+			setup: func(mocks Mocks, receiver *accountDomain.Account) {
+				mocks.transactionRepo.EXPECT().FindReversalOf(arg, arg).Return(nil, nil)
+				mocks.accountRepo.EXPECT().FindByID(arg, receiver.ID()).Return(receiver, nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil).Times(2)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).DoAndReturn(func(_ context.Context, entry *ledgerDomain.Entry) error {
+					// 為替勘定を経由した4行の仕訳になる
+					assert.Len(t, entry.Postings(), 4)
+					return nil
+				})
+			},
+			wantBalance:         balance + amount,
+			wantReceiverBalance: 500 - usdAmount,
+			wantDirection:       transactionDomain.Credit,
+		},
+		{
+			caseName: "Negative: 受取口座が受け取った資金を既に使っている場合は ErrReceiverInsufficientBalance が返る",
+			original: func(account, receiver *accountDomain.Account) *transactionDomain.Transaction {
+				receiverID := receiver.ID()
+				tx, _ := transactionDomain.New(account.ID(), &receiverID, transactionDomain.Transfer, amount, moneyVO.JPY, &usdAmount, &usd, &rate, timer.GetFixedDate())
+				return tx
+			},
+			receiverBalance: usdAmount - 1,
+			setup: func(mocks Mocks, receiver *accountDomain.Account) {
+				mocks.transactionRepo.EXPECT().FindReversalOf(arg, arg).Return(nil, nil)
+				mocks.accountRepo.EXPECT().FindByID(arg, receiver.ID()).Return(receiver, nil)
+			},
+			errMsg: transactionDomain.ErrReceiverInsufficientBalance.Error(),
+		},
+		{
+			caseName: "Negative: 受取口座が存在しない場合は ErrReceiverNotFound が返る",
+			original: func(account, receiver *accountDomain.Account) *transactionDomain.Transaction {
+				receiverID := receiver.ID()
+				tx, _ := transactionDomain.New(account.ID(), &receiverID, transactionDomain.Transfer, amount, moneyVO.JPY, &usdAmount, &usd, &rate, timer.GetFixedDate())
+				return tx
+			},
+			setup: func(mocks Mocks, receiver *accountDomain.Account) {
+				mocks.transactionRepo.EXPECT().FindReversalOf(arg, arg).Return(nil, nil)
+				mocks.accountRepo.EXPECT().FindByID(arg, receiver.ID()).Return(nil, nil)
+			},
+			errMsg: accountDomain.ErrReceiverNotFound.Error(),
+		},
+		{
+			caseName: "Negative: 入金の取消で残高が不足する場合は ErrInsufficientBalance が返る",
+			original: func(account, _ *accountDomain.Account) *transactionDomain.Transaction {
+				tx, _ := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, balance+1, moneyVO.JPY, nil, nil, nil, timer.GetFixedDate())
+				return tx
+			},
+			setup: func(mocks Mocks, _ *accountDomain.Account) {
+				mocks.transactionRepo.EXPECT().FindReversalOf(arg, arg).Return(nil, nil)
+			},
+			errMsg: moneyVO.ErrInsufficientBalance.Error(),
+		},
+		{
+			caseName: "Negative: 既に取り消された取引の場合は ErrAlreadyReversed が返る",
+			original: func(account, _ *accountDomain.Account) *transactionDomain.Transaction {
+				tx, _ := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, moneyVO.JPY, nil, nil, nil, timer.GetFixedDate())
+				return tx
+			},
+			setup: func(mocks Mocks, _ *accountDomain.Account) {
+				reversal, _ := transactionDomain.New(otherID, nil, transactionDomain.Deposit, amount, moneyVO.JPY, nil, nil, nil, timer.GetFixedDate())
+				mocks.transactionRepo.EXPECT().FindReversalOf(arg, arg).Return(reversal, nil)
+			},
+			errMsg: transactionDomain.ErrAlreadyReversed.Error(),
+		},
+		{
+			caseName: "Negative: 取消を取り消そうとした場合は ErrNotReversible が返る",
+			original: func(account, _ *accountDomain.Account) *transactionDomain.Transaction {
+				tx, _ := transactionDomain.Reconstruct(
+					transactionID, account.IDString(), nil, transactionDomain.Reversal, amount, moneyVO.JPY,
+					nil, nil, nil, &transactionID, strutil.StrPointer(transactionDomain.Deposit), nil, nil, nil, nil, nil, timer.GetFixedDate(),
+				)
+				return tx
+			},
+			setup:  func(mocks Mocks, _ *accountDomain.Account) {},
+			errMsg: transactionDomain.ErrNotReversible.Error(),
+		},
+		{
+			caseName: "Negative: 出金を取り消そうとした場合は ErrNotReversible が返る",
+			original: func(account, _ *accountDomain.Account) *transactionDomain.Transaction {
+				tx, _ := transactionDomain.New(account.ID(), nil, transactionDomain.Withdrawal, amount, moneyVO.JPY, nil, nil, nil, timer.GetFixedDate())
+				return tx
+			},
+			setup:  func(mocks Mocks, _ *accountDomain.Account) {},
+			errMsg: transactionDomain.ErrNotReversible.Error(),
+		},
+		{
+			caseName: "Negative: 受け取った振込を受取口座が取り消そうとした場合は ErrReversalNotAllowed が返る",
+			original: func(account, _ *accountDomain.Account) *transactionDomain.Transaction {
+				accountID := account.ID()
+				tx, _ := transactionDomain.New(otherID, &accountID, transactionDomain.Transfer, amount, moneyVO.JPY, &amount, strutil.StrPointer(moneyVO.JPY), nil, timer.GetFixedDate())
+				return tx
+			},
+			setup:  func(mocks Mocks, _ *accountDomain.Account) {},
+			errMsg: transactionDomain.ErrReversalNotAllowed.Error(),
+		},
+		{
+			caseName: "Negative: FindReversalOfがエラーを返した場合はエラーが返る",
+			original: func(account, _ *accountDomain.Account) *transactionDomain.Transaction {
+				tx, _ := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, moneyVO.JPY, nil, nil, nil, timer.GetFixedDate())
+				return tx
+			},
+			setup: func(mocks Mocks, _ *accountDomain.Account) {
+				mocks.transactionRepo.EXPECT().FindReversalOf(arg, arg).Return(nil, assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: イベントの記録が失敗した場合はエラーが返る",
+			original: func(account, _ *accountDomain.Account) *transactionDomain.Transaction {
+				tx, _ := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, moneyVO.JPY, nil, nil, nil, timer.GetFixedDate())
+				return tx
+			},
+			setup: func(mocks Mocks, _ *accountDomain.Account) {
+				mocks.transactionRepo.EXPECT().FindReversalOf(arg, arg).Return(nil, nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.ledgerRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.eventRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: 取引の保存が失敗した場合はエラーが返る",
+			original: func(account, _ *accountDomain.Account) *transactionDomain.Transaction {
+				tx, _ := transactionDomain.New(account.ID(), nil, transactionDomain.Deposit, amount, moneyVO.JPY, nil, nil, nil, timer.GetFixedDate())
+				return tx
+			},
+			setup: func(mocks Mocks, _ *accountDomain.Account) {
+				mocks.transactionRepo.EXPECT().FindReversalOf(arg, arg).Return(nil, nil)
+				mocks.accountRepo.EXPECT().Save(arg, arg).Return(nil)
+				mocks.transactionRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountRepo:          mock.NewMockIAccountRepository(ctrl),
+				transactionRepo:      mock.NewMockITransactionRepository(ctrl),
+				ledgerRepo:           mock.NewMockILedgerRepository(ctrl),
+				exchangeRateProvider: mock.NewMockIExchangeRateProvider(ctrl),
+				limitServ:            mock.NewMockILimitService(ctrl),
+				eventRepo:            mock.NewMockIEventRepository(ctrl),
+			}
+			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo, mocks.ledgerRepo, mocks.exchangeRateProvider, mocks.limitServ, mocks.eventRepo)
+			account, err := accountDomain.New(userID, balance, name, password, moneyVO.JPY)
+			assert.NoError(t, err)
+			receiver, err := accountDomain.New(userID, tt.receiverBalance, name, password, moneyVO.USD)
 			assert.NoError(t, err)
+			original := tt.original(account, receiver)
+			tt.setup(mocks, receiver)
+			var events []*eventDomain.Event
+			mocks.eventRepo.EXPECT().Save(arg, arg).DoAndReturn(func(_ context.Context, event *eventDomain.Event) error {
+				events = append(events, event)
+				return nil
+			}).AnyTimes()
+
+			reversal, err := service.Reverse(context.Background(), account, original)
 
-			tx2, err := transactionDomain.New(
-				accountID,
-				nil,
-				transactionDomain.Withdrawal,
-				500.0,
-				moneyVO.JPY,
-				timer.GetFixedDate(),
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Nil(t, reversal)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, transactionDomain.Reversal, reversal.OperationType())
+				assert.Equal(t, original.ID(), *reversal.ReversedTransactionID())
+				assert.Equal(t, original.OperationType(), *reversal.ReversedOperationType())
+				assert.Equal(t, original.TransferAmount(), reversal.TransferAmount())
+				assert.Equal(t, tt.wantBalance, account.Balance().Amount())
+				assert.Equal(t, account.Balance(), *reversal.BalanceAfter())
+				assert.Equal(t, tt.wantDirection, reversal.DirectionFor(account.ID()))
+				// 残高が変わった口座ごとに取消のイベントを記録する
+				assertReversedEvent(t, events[0], reversal, account, tt.wantDirection)
+				if original.ReceiverAccountID() != nil {
+					assert.Equal(t, tt.wantReceiverBalance, receiver.Balance().Amount())
+					assert.Equal(t, receiver.Balance(), *reversal.ReceiverBalanceAfter())
+					assert.Len(t, events, 2)
+					assertReversedEvent(t, events[1], reversal, receiver, transactionDomain.Debit)
+				} else {
+					assert.Nil(t, reversal.ReceiverBalanceAfter())
+					assert.Len(t, events, 1)
+				}
+			}
+		})
+	}
+}
+
+// 口座の取消のイベントが記録されたかを検証します。
+func assertReversedEvent(t *testing.T, event *eventDomain.Event, reversal *transactionDomain.Transaction, account *accountDomain.Account, wantDirection string) {
+	t.Helper()
+	amount := reversal.AmountFor(account.ID())
+	assert.Equal(t, eventDomain.TypeMoneyReversed, event.Type())
+	assert.Equal(t, eventDomain.AggregateAccount, event.AggregateType())
+	assert.Equal(t, account.IDString(), event.AggregateID())
+	assert.JSONEq(t, `{"transactionId":"`+reversal.IDString()+`","reversedTransactionId":"`+reversal.ReversedTransactionID().String()+
+		`","accountId":"`+account.IDString()+`","direction":"`+wantDirection+`","amount":"`+amount.Decimal()+
+		`","currency":"`+amount.Currency()+`","balanceAfter":"`+account.Balance().Decimal()+`"}`, string(event.Payload()))
+}
+
+// 期待する並び順、件数、ページ番号、カーソルでリポジトリが呼ばれたかを検証します。
+type listParamsMatcher struct {
+	sort   string
+	limit  int
+	page   int
+	cursor *transactionDomain.Cursor
+}
+
+func (m listParamsMatcher) Matches(x any) bool {
+	p, ok := x.(transactionDomain.ListTransactionsParams)
+	if !ok {
+		return false
+	}
+	return *p.Sort == m.sort && *p.Limit == m.limit && *p.Page == m.page && p.Cursor == m.cursor
+}
+
+func (m listParamsMatcher) String() string {
+	return fmt.Sprintf("sort=%s limit=%d page=%d cursor=%v", m.sort, m.limit, m.page, m.cursor)
+}
+
+func TestCategorize(t *testing.T) {
+	type Mocks struct {
+		accountRepo          *mock.MockIAccountRepository
+		transactionRepo      *mock.MockITransactionRepository
+		ledgerRepo           *mock.MockILedgerRepository
+		exchangeRateProvider *mock.MockIExchangeRateProvider
+		limitServ            *mock.MockILimitService
+		eventRepo            *mock.MockIEventRepository
+	}
+
+	var (
+		accountID         = idVO.NewAccountIDForTest("account")
+		receiverAccountID = idVO.NewAccountIDForTest("accountReceiver")
+		otherAccountID    = idVO.NewAccountIDForTest("accountOther")
+		receiverAmount    = int64(1000)
+		receiverCurrency  = moneyVO.JPY
+		arg               = gomock.Any()
+	)
+
+	tests := []struct {
+		caseName       string
+		accountID      idVO.AccountID
+		categories     []string
+		setup          func(mocks Mocks)
+		wantCategories []string
+		errMsg         string
+	}{
+		{
+			caseName:   "Positive: 送金元の口座として分類を付けると、前後の空白と重複を除いて並べ替えた分類が保存される",
+			accountID:  accountID,
+			categories: []string{" travel ", "food", "travel"},
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().SaveCategories(arg, arg, accountID).Return(nil)
+			},
+			wantCategories: []string{"food", "travel"},
+			errMsg:         "",
+		},
+		{
+			caseName:   "Positive: 受取口座として分類を付けられる",
+			accountID:  receiverAccountID,
+			categories: []string{"rent"},
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().SaveCategories(arg, arg, receiverAccountID).Return(nil)
+			},
+			wantCategories: []string{"rent"},
+			errMsg:         "",
+		},
+		{
+			caseName:   "Positive: 空の分類を指定すると分類が全て外れる",
+			accountID:  accountID,
+			categories: []string{},
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().SaveCategories(arg, arg, accountID).Return(nil)
+			},
+			wantCategories: []string{},
+			errMsg:         "",
+		},
+		{
+			caseName:   "Negative: 口座が関係しない取引の場合は ErrNotFound が返る",
+			accountID:  otherAccountID,
+			categories: []string{"food"},
+			setup:      func(mocks Mocks) {},
+			errMsg:     transactionDomain.ErrNotFound.Error(),
+		},
+		{
+			caseName:   "Negative: カンマを含む分類の場合は ErrInvalidCategory が返る",
+			accountID:  accountID,
+			categories: []string{"food,travel"},
+			setup:      func(mocks Mocks) {},
+			errMsg:     transactionDomain.ErrInvalidCategory.Error(),
+		},
+		{
+			caseName:   "Negative: 分類が多すぎる場合は ErrTooManyCategories が返る",
+			accountID:  accountID,
+			categories: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"},
+			setup:      func(mocks Mocks) {},
+			errMsg:     transactionDomain.ErrTooManyCategories.Error(),
+		},
+		{
+			caseName:   "Negative: 分類の保存が失敗した場合はエラーが返る",
+			accountID:  accountID,
+			categories: []string{"food"},
+			setup: func(mocks Mocks) {
+				mocks.transactionRepo.EXPECT().SaveCategories(arg, arg, accountID).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountRepo:          mock.NewMockIAccountRepository(ctrl),
+				transactionRepo:      mock.NewMockITransactionRepository(ctrl),
+				ledgerRepo:           mock.NewMockILedgerRepository(ctrl),
+				exchangeRateProvider: mock.NewMockIExchangeRateProvider(ctrl),
+				limitServ:            mock.NewMockILimitService(ctrl),
+				eventRepo:            mock.NewMockIEventRepository(ctrl),
+			}
+			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo, mocks.ledgerRepo, mocks.exchangeRateProvider, mocks.limitServ, mocks.eventRepo)
+			tt.setup(mocks)
+
+			transaction, err := transactionDomain.New(
+				accountID, &receiverAccountID, transactionDomain.Transfer, 1000, moneyVO.JPY,
+				&receiverAmount, &receiverCurrency, nil, timer.GetFixedDate(),
 			)
 			assert.NoError(t, err)
 
-			transactions := []*transactionDomain.Transaction{tx1, tx2}
-			tt.setup(mocks, transactions)
-
-			txs, total, err := service.ListWithTotal(ctx, tt.params)
+			err = service.Categorize(context.Background(), tt.accountID, transaction, tt.categories)
 
 			if tt.errMsg != "" {
 				assert.Error(t, err)
 				assert.Equal(t, tt.errMsg, err.Error())
-				assert.Empty(t, txs)
-				assert.Zero(t, total)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, transactions, txs)
-				assert.Equal(t, tt.wantTotal, total)
+				assert.Equal(t, tt.wantCategories, transaction.CategoriesFor(tt.accountID))
+			}
+		})
+	}
+}
+
+func TestCheckLimit(t *testing.T) {
+	type Mocks struct {
+		accountRepo          *mock.MockIAccountRepository
+		transactionRepo      *mock.MockITransactionRepository
+		ledgerRepo           *mock.MockILedgerRepository
+		exchangeRateProvider *mock.MockIExchangeRateProvider
+		limitServ            *mock.MockILimitService
+		eventRepo            *mock.MockIEventRepository
+	}
+
+	arg := gomock.Any()
+
+	tests := []struct {
+		caseName string
+		amount   int64
+		setup    func(mocks Mocks)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: 上限がない場合は検証しない",
+			amount:   1000,
+			setup: func(mocks Mocks) {
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Positive: 上限の範囲内であればエラーが返らない",
+			amount:   50,
+			setup: func(mocks Mocks) {
+				limits, _ := limitDomain.NewLimits(limitDomain.OperationTransfer, 100, 100, 1000, moneyVO.JPY)
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(limits, nil)
+				mocks.transactionRepo.EXPECT().SumAmount(arg, arg, limitDomain.OperationTransfer, arg).Return(int64(50), nil).Times(2)
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: 利用済みの金額と合わせて上限を超える場合はエラーが返る",
+			amount:   50,
+			setup: func(mocks Mocks) {
+				limits, _ := limitDomain.NewLimits(limitDomain.OperationTransfer, 100, 100, 1000, moneyVO.JPY)
+				mocks.limitServ.EXPECT().GetEffective(arg, arg, limitDomain.OperationTransfer, moneyVO.JPY).Return(limits, nil)
+				mocks.transactionRepo.EXPECT().SumAmount(arg, arg, limitDomain.OperationTransfer, arg).Return(int64(80), nil).Times(2)
+			},
+			wantErr: limitDomain.ErrLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := Mocks{
+				accountRepo:          mock.NewMockIAccountRepository(ctrl),
+				transactionRepo:      mock.NewMockITransactionRepository(ctrl),
+				ledgerRepo:           mock.NewMockILedgerRepository(ctrl),
+				exchangeRateProvider: mock.NewMockIExchangeRateProvider(ctrl),
+				limitServ:            mock.NewMockILimitService(ctrl),
+				eventRepo:            mock.NewMockIEventRepository(ctrl),
+			}
+			service := transactionDomain.NewService(mocks.accountRepo, mocks.transactionRepo, mocks.ledgerRepo, mocks.exchangeRateProvider, mocks.limitServ, mocks.eventRepo)
+			tt.setup(mocks)
+			account, err := accountDomain.New(idVO.NewUserIDForTest("user"), 1000, "account-name", "1234", moneyVO.JPY)
+			assert.NoError(t, err)
+
+			err = service.CheckLimit(context.Background(), account, transactionDomain.Transfer, tt.amount)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
 			}
 		})
 	}
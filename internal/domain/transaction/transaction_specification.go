@@ -2,6 +2,11 @@ package transaction
 
 import (
 	"errors"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
 // Operation types
@@ -9,14 +14,62 @@ const (
 	Deposit    = "DEPOSIT"
 	Withdrawal = "WITHDRAWAL"
 	Transfer   = "TRANSFER"
+	// 完了した取引を取り消す取引。取り消した取引と逆向きに残高を戻します。
+	Reversal = "REVERSAL"
+)
+
+// 取引一覧で振込を送金と受取に分けて絞り込む為の取引種別
+const (
+	TransferIn  = "TRANSFER_IN"
+	TransferOut = "TRANSFER_OUT"
+)
+
+// 口座から見た取引の向き
+const (
+	Debit  = "DEBIT"
+	Credit = "CREDIT"
 )
 
 const (
 	ListTransactionsLimit = 100
 )
 
+// 取引の説明と分類
+const (
+	MemoMaxLength      = 200
+	ReferenceMaxLength = 140
+	CategoryMaxLength  = 30
+	// 1つの口座が1つの取引に付けられる分類の数の上限です。
+	MaxCategories = 10
+	// 取引一覧のキーワード検索で指定できる文字数の上限です。
+	SearchQueryMaxLength = 100
+)
+
+// 取引一覧の並び順
+const (
+	SortAsc  = "ASC"
+	SortDesc = "DESC"
+)
+
 var (
-	ErrUnsupportedType = errors.New("unsupported transaction type")
+	ErrUnsupportedType        = errors.New("unsupported transaction type")
+	ErrInvalidReceiverAmount  = errors.New("receiver amount and receiver currency must be specified together")
+	ErrExchangeRateRequired   = errors.New("exchange rate is required for a transfer between different currencies")
+	ErrUnexpectedExchangeRate = errors.New("exchange rate must not be specified for a transaction in a single currency")
+	ErrInvalidReceiverBalance = errors.New("receiver balance must not be specified for a transaction without a receiver amount")
+	ErrNotFound               = errors.New("transaction not found")
+	ErrInvalidCursor          = errors.New("invalid cursor")
+	ErrInvalidReversal        = errors.New("reversed transaction must be specified only for a reversal")
+	ErrAlreadyReversed        = errors.New("transaction has already been reversed")
+	ErrNotReversible          = errors.New("only a deposit or a transfer can be reversed")
+	ErrReversalNotAllowed     = errors.New("only the account that made the transaction can reverse it")
+	ErrInvalidMemo            = errors.New("memo must be at most 200 characters")
+	ErrInvalidReference       = errors.New("reference must be at most 140 characters")
+	ErrInvalidCategory        = errors.New("category must be 1 to 30 characters and must not contain commas")
+	ErrTooManyCategories      = errors.New("a transaction can have at most 10 categories")
+	ErrInvalidAmountRange     = errors.New("min amount must be less than or equal to max amount")
+	// 振込の受取口座が受け取った資金を既に使っており、取消で戻せない場合のエラー
+	ErrReceiverInsufficientBalance = errors.New("receiver account has insufficient balance to reverse the transfer")
 )
 
 func validOperationType(operationType string) error {
@@ -24,6 +77,7 @@ func validOperationType(operationType string) error {
 		Deposit,
 		Withdrawal,
 		Transfer,
+		Reversal,
 	}
 	for _, validType := range validOperationTypes {
 		if operationType == validType {
@@ -32,3 +86,44 @@ func validOperationType(operationType string) error {
 	}
 	return ErrUnsupportedType
 }
+
+// 取消の場合のみ取り消した取引を持ちます。取り消せるのは入金と振込のみです。
+func validReversedTransaction(operationType string, reversedTransactionID *idVO.TransactionID, reversedOperationType *string) error {
+	if operationType != Reversal {
+		if reversedTransactionID != nil || reversedOperationType != nil {
+			return ErrInvalidReversal
+		}
+		return nil
+	}
+	if reversedTransactionID == nil || reversedOperationType == nil {
+		return ErrInvalidReversal
+	}
+	switch *reversedOperationType {
+	case Deposit, Transfer:
+		return nil
+	default:
+		return ErrNotReversible
+	}
+}
+
+// 分類の前後の空白を除き、重複を取り除いて名前順に並べた分類を返します。分類はカンマ区切りで絞り込む為、カンマを含めることはできません。
+func normalizeCategories(categories []string) ([]string, error) {
+	normalized := make([]string, 0, len(categories))
+	seen := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		category = strings.TrimSpace(category)
+		if category == "" || utf8.RuneCountInString(category) > CategoryMaxLength || strings.Contains(category, ",") {
+			return nil, ErrInvalidCategory
+		}
+		if seen[category] {
+			continue
+		}
+		seen[category] = true
+		normalized = append(normalized, category)
+	}
+	if len(normalized) > MaxCategories {
+		return nil, ErrTooManyCategories
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
@@ -8,6 +8,7 @@ import (
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/pkg/strutil"
 	"github.com/u104rak1/pocgo/pkg/timer"
 )
 
@@ -15,9 +16,13 @@ func TestNewTransaction(t *testing.T) {
 	var (
 		accountID         = idVO.NewAccountIDForTest("account")
 		receiverAccountID = idVO.NewAccountIDForTest("accountReceiver")
-		amount            = 1000.0
+		amount            = int64(1000)
 		currency          = moneyVO.JPY
 		transactionAt     = timer.GetFixedDate()
+		usdAmount         = int64(667)
+		usd               = moneyVO.USD
+		jpy               = moneyVO.JPY
+		rate              = "0.006666666667"
 	)
 
 	tests := []struct {
@@ -25,8 +30,11 @@ func TestNewTransaction(t *testing.T) {
 		accountID         idVO.AccountID
 		receiverAccountID *idVO.AccountID
 		operationType     string
-		amount            float64
+		amount            int64
 		currency          string
+		receiverAmount    *int64
+		receiverCurrency  *string
+		exchangeRate      *string
 		transactionAt     time.Time
 		errMsg            string
 	}{
@@ -70,6 +78,67 @@ func TestNewTransaction(t *testing.T) {
 			transactionAt:     transactionAt,
 			errMsg:            "",
 		},
+		{
+			caseName:          "Positive: 通貨が同じ振込取引を、受取金額付きで作成できる",
+			accountID:         accountID,
+			receiverAccountID: &receiverAccountID,
+			operationType:     transactionDomain.Transfer,
+			amount:            amount,
+			currency:          currency,
+			receiverAmount:    &amount,
+			receiverCurrency:  &jpy,
+			transactionAt:     transactionAt,
+			errMsg:            "",
+		},
+		{
+			caseName:          "Positive: 通貨が異なる振込取引を、為替レート付きで作成できる",
+			accountID:         accountID,
+			receiverAccountID: &receiverAccountID,
+			operationType:     transactionDomain.Transfer,
+			amount:            amount,
+			currency:          currency,
+			receiverAmount:    &usdAmount,
+			receiverCurrency:  &usd,
+			exchangeRate:      &rate,
+			transactionAt:     transactionAt,
+			errMsg:            "",
+		},
+		{
+			caseName:          "Negative: 受取金額のみ指定されている場合はエラーが返る",
+			accountID:         accountID,
+			receiverAccountID: &receiverAccountID,
+			operationType:     transactionDomain.Transfer,
+			amount:            amount,
+			currency:          currency,
+			receiverAmount:    &usdAmount,
+			transactionAt:     transactionAt,
+			errMsg:            transactionDomain.ErrInvalidReceiverAmount.Error(),
+		},
+		{
+			caseName:          "Negative: 通貨が異なるのに為替レートがない場合はエラーが返る",
+			accountID:         accountID,
+			receiverAccountID: &receiverAccountID,
+			operationType:     transactionDomain.Transfer,
+			amount:            amount,
+			currency:          currency,
+			receiverAmount:    &usdAmount,
+			receiverCurrency:  &usd,
+			transactionAt:     transactionAt,
+			errMsg:            transactionDomain.ErrExchangeRateRequired.Error(),
+		},
+		{
+			caseName:          "Negative: 通貨が同じなのに為替レートがある場合はエラーが返る",
+			accountID:         accountID,
+			receiverAccountID: &receiverAccountID,
+			operationType:     transactionDomain.Transfer,
+			amount:            amount,
+			currency:          currency,
+			receiverAmount:    &amount,
+			receiverCurrency:  &jpy,
+			exchangeRate:      &rate,
+			transactionAt:     transactionAt,
+			errMsg:            transactionDomain.ErrUnexpectedExchangeRate.Error(),
+		},
 		{
 			caseName:          "Negative: サポートされていない取引タイプの場合はエラーが返る",
 			accountID:         accountID,
@@ -96,7 +165,8 @@ func TestNewTransaction(t *testing.T) {
 		t.Run(tt.caseName, func(t *testing.T) {
 			t.Parallel()
 			tx, err := transactionDomain.New(
-				tt.accountID, tt.receiverAccountID, tt.operationType, tt.amount, tt.currency, tt.transactionAt,
+				tt.accountID, tt.receiverAccountID, tt.operationType, tt.amount, tt.currency,
+				tt.receiverAmount, tt.receiverCurrency, tt.exchangeRate, tt.transactionAt,
 			)
 
 			if tt.errMsg != "" {
@@ -112,6 +182,17 @@ func TestNewTransaction(t *testing.T) {
 				assert.Equal(t, tt.operationType, tx.OperationType())
 				assert.Equal(t, tt.amount, tx.TransferAmount().Amount())
 				assert.Equal(t, tt.currency, tx.TransferAmount().Currency())
+				if tt.receiverAmount != nil {
+					assert.Equal(t, *tt.receiverAmount, tx.ReceiverAmount().Amount())
+					assert.Equal(t, *tt.receiverCurrency, tx.ReceiverAmount().Currency())
+				} else {
+					assert.Nil(t, tx.ReceiverAmount())
+				}
+				if tt.exchangeRate != nil {
+					assert.Equal(t, *tt.exchangeRate, tx.ExchangeRate().Rate())
+				} else {
+					assert.Nil(t, tx.ExchangeRate())
+				}
 				assert.Equal(t, tt.transactionAt, tx.TransactionAt())
 				assert.Equal(t, timer.GetFixedDateString(), tx.TransactionAtString())
 			}
@@ -121,17 +202,25 @@ func TestNewTransaction(t *testing.T) {
 
 func TestReconstruct(t *testing.T) {
 	var (
-		transactionID     = idVO.NewTransactionIDForTest("transaction").String()
-		accountID         = idVO.NewAccountIDForTest("account").String()
-		receiverAccountID = idVO.NewAccountIDForTest("accountReceiver").String()
-		operationType     = "TRANSFER"
-		amount            = 1000.0
-		currency          = moneyVO.JPY
-		transactionAt     = timer.GetFixedDate()
+		transactionID        = idVO.NewTransactionIDForTest("transaction").String()
+		accountID            = idVO.NewAccountIDForTest("account").String()
+		receiverAccountID    = idVO.NewAccountIDForTest("accountReceiver").String()
+		operationType        = "TRANSFER"
+		amount               = int64(1000)
+		currency             = moneyVO.JPY
+		receiverAmount       = int64(667)
+		receiverCurrency     = moneyVO.USD
+		exchangeRate         = "0.006666666667"
+		balanceAfter         = int64(9000)
+		receiverBalanceAfter = int64(1667)
+		transactionAt        = timer.GetFixedDate()
 	)
 
 	t.Run("Positive: 取引を再構築できる", func(t *testing.T) {
-		tx, err := transactionDomain.Reconstruct(transactionID, accountID, &receiverAccountID, operationType, amount, currency, transactionAt)
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID, &receiverAccountID, operationType, amount, currency,
+			&receiverAmount, &receiverCurrency, &exchangeRate, nil, nil, &balanceAfter, &receiverBalanceAfter, nil, nil, nil, transactionAt,
+		)
 		assert.NoError(t, err)
 		assert.NotNil(t, tx)
 		assert.Equal(t, transactionID, tx.IDString())
@@ -140,7 +229,244 @@ func TestReconstruct(t *testing.T) {
 		assert.Equal(t, operationType, tx.OperationType())
 		assert.Equal(t, amount, tx.TransferAmount().Amount())
 		assert.Equal(t, currency, tx.TransferAmount().Currency())
+		assert.Equal(t, receiverAmount, tx.ReceiverAmount().Amount())
+		assert.Equal(t, receiverCurrency, tx.ReceiverAmount().Currency())
+		assert.Equal(t, currency, tx.ExchangeRate().Base())
+		assert.Equal(t, receiverCurrency, tx.ExchangeRate().Quote())
+		assert.Equal(t, exchangeRate, tx.ExchangeRate().Rate())
+		assert.Equal(t, balanceAfter, tx.BalanceAfter().Amount())
+		assert.Equal(t, currency, tx.BalanceAfter().Currency())
+		assert.Equal(t, receiverBalanceAfter, tx.ReceiverBalanceAfter().Amount())
+		assert.Equal(t, receiverCurrency, tx.ReceiverBalanceAfter().Currency())
 		assert.Equal(t, transactionAt, tx.TransactionAt())
 		assert.Equal(t, timer.GetFixedDateString(), tx.TransactionAtString())
 	})
+
+	t.Run("Positive: 残高が記録されていない取引を再構築できる", func(t *testing.T) {
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID, nil, transactionDomain.Deposit, amount, currency,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, transactionAt,
+		)
+		assert.NoError(t, err)
+		assert.Nil(t, tx.BalanceAfter())
+		assert.Nil(t, tx.ReceiverBalanceAfter())
+		assert.Nil(t, tx.BalanceAfterDecimalFor(tx.AccountID()))
+	})
+
+	t.Run("Negative: 受取金額がない取引に受取口座の残高を指定する", func(t *testing.T) {
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID, nil, transactionDomain.Deposit, amount, currency,
+			nil, nil, nil, nil, nil, &balanceAfter, &receiverBalanceAfter, nil, nil, nil, transactionAt,
+		)
+		assert.ErrorIs(t, err, transactionDomain.ErrInvalidReceiverBalance)
+		assert.Nil(t, tx)
+	})
+
+	t.Run("Positive: 取消を再構築できる", func(t *testing.T) {
+		reversedTransactionID := idVO.NewTransactionIDForTest("reversed").String()
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID, nil, transactionDomain.Reversal, amount, currency,
+			nil, nil, nil, &reversedTransactionID, strutil.StrPointer(transactionDomain.Deposit), &balanceAfter, nil, nil, nil, nil, transactionAt,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, &reversedTransactionID, tx.ReversedTransactionIDString())
+		assert.Equal(t, transactionDomain.Deposit, *tx.ReversedOperationType())
+	})
+
+	t.Run("Negative: 取消に取り消した取引を指定しない", func(t *testing.T) {
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID, nil, transactionDomain.Reversal, amount, currency,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, transactionAt,
+		)
+		assert.ErrorIs(t, err, transactionDomain.ErrInvalidReversal)
+		assert.Nil(t, tx)
+	})
+
+	t.Run("Negative: 取消以外の取引に取り消した取引を指定する", func(t *testing.T) {
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID, nil, transactionDomain.Deposit, amount, currency,
+			nil, nil, nil, &transactionID, strutil.StrPointer(transactionDomain.Deposit), nil, nil, nil, nil, nil, transactionAt,
+		)
+		assert.ErrorIs(t, err, transactionDomain.ErrInvalidReversal)
+		assert.Nil(t, tx)
+	})
+
+	t.Run("Negative: 出金を取り消す取消を再構築する", func(t *testing.T) {
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID, nil, transactionDomain.Reversal, amount, currency,
+			nil, nil, nil, &transactionID, strutil.StrPointer(transactionDomain.Withdrawal), nil, nil, nil, nil, nil, transactionAt,
+		)
+		assert.ErrorIs(t, err, transactionDomain.ErrNotReversible)
+		assert.Nil(t, tx)
+	})
+
+	t.Run("Negative: 取消を取り消す取消を再構築する", func(t *testing.T) {
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID, nil, transactionDomain.Reversal, amount, currency,
+			nil, nil, nil, &transactionID, strutil.StrPointer(transactionDomain.Reversal), nil, nil, nil, nil, nil, transactionAt,
+		)
+		assert.ErrorIs(t, err, transactionDomain.ErrNotReversible)
+		assert.Nil(t, tx)
+	})
+}
+
+func TestTransaction_DirectionFor(t *testing.T) {
+	var (
+		accountID         = idVO.NewAccountIDForTest("account")
+		receiverAccountID = idVO.NewAccountIDForTest("accountReceiver")
+		receiverAmount    = int64(667)
+		receiverCurrency  = moneyVO.USD
+		exchangeRate      = "0.006666666667"
+		transactionAt     = timer.GetFixedDate()
+	)
+
+	deposit, err := transactionDomain.New(accountID, nil, transactionDomain.Deposit, 1000, moneyVO.JPY, nil, nil, nil, transactionAt)
+	assert.NoError(t, err)
+	withdrawal, err := transactionDomain.New(accountID, nil, transactionDomain.Withdrawal, 1000, moneyVO.JPY, nil, nil, nil, transactionAt)
+	assert.NoError(t, err)
+	transfer, err := transactionDomain.New(
+		accountID, &receiverAccountID, transactionDomain.Transfer, 100000, moneyVO.JPY,
+		&receiverAmount, &receiverCurrency, &exchangeRate, transactionAt,
+	)
+	assert.NoError(t, err)
+	depositReversal, err := transactionDomain.Reconstruct(
+		idVO.NewTransactionIDForTest("depositReversal").String(), accountID.String(), nil, transactionDomain.Reversal, 1000, moneyVO.JPY,
+		nil, nil, nil, strutil.StrPointer(deposit.IDString()), strutil.StrPointer(transactionDomain.Deposit), nil, nil, nil, nil, nil, transactionAt,
+	)
+	assert.NoError(t, err)
+	transferReversal, err := transactionDomain.Reconstruct(
+		idVO.NewTransactionIDForTest("transferReversal").String(), accountID.String(), strutil.StrPointer(receiverAccountID.String()),
+		transactionDomain.Reversal, 100000, moneyVO.JPY, &receiverAmount, &receiverCurrency, &exchangeRate,
+		strutil.StrPointer(transfer.IDString()), strutil.StrPointer(transactionDomain.Transfer), nil, nil, nil, nil, nil, transactionAt,
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName          string
+		transaction       *transactionDomain.Transaction
+		accountID         idVO.AccountID
+		wantOperationType string
+		wantDirection     string
+		wantSignedAmount  string
+	}{
+		{
+			caseName:          "Positive: 入金は CREDIT で正の金額になる",
+			transaction:       deposit,
+			accountID:         accountID,
+			wantOperationType: transactionDomain.Deposit,
+			wantDirection:     transactionDomain.Credit,
+			wantSignedAmount:  "1000",
+		},
+		{
+			caseName:          "Positive: 出金は DEBIT で負の金額になる",
+			transaction:       withdrawal,
+			accountID:         accountID,
+			wantOperationType: transactionDomain.Withdrawal,
+			wantDirection:     transactionDomain.Debit,
+			wantSignedAmount:  "-1000",
+		},
+		{
+			caseName:          "Positive: 送金元から見た振込は TRANSFER_OUT で送金額が負の金額になる",
+			transaction:       transfer,
+			accountID:         accountID,
+			wantOperationType: transactionDomain.TransferOut,
+			wantDirection:     transactionDomain.Debit,
+			wantSignedAmount:  "-100000",
+		},
+		{
+			caseName:          "Positive: 受取口座から見た振込は TRANSFER_IN で受取金額が正の金額になる",
+			transaction:       transfer,
+			accountID:         receiverAccountID,
+			wantOperationType: transactionDomain.TransferIn,
+			wantDirection:     transactionDomain.Credit,
+			wantSignedAmount:  "6.67",
+		},
+		{
+			caseName:          "Positive: 入金の取消は REVERSAL で DEBIT になる",
+			transaction:       depositReversal,
+			accountID:         accountID,
+			wantOperationType: transactionDomain.Reversal,
+			wantDirection:     transactionDomain.Debit,
+			wantSignedAmount:  "-1000",
+		},
+		{
+			caseName:          "Positive: 送金元から見た振込の取消は CREDIT で送金額が戻る",
+			transaction:       transferReversal,
+			accountID:         accountID,
+			wantOperationType: transactionDomain.Reversal,
+			wantDirection:     transactionDomain.Credit,
+			wantSignedAmount:  "100000",
+		},
+		{
+			caseName:          "Positive: 受取口座から見た振込の取消は DEBIT で受取金額が負の金額になる",
+			transaction:       transferReversal,
+			accountID:         receiverAccountID,
+			wantOperationType: transactionDomain.Reversal,
+			wantDirection:     transactionDomain.Debit,
+			wantSignedAmount:  "-6.67",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.wantOperationType, tt.transaction.OperationTypeFor(tt.accountID))
+			assert.Equal(t, tt.wantDirection, tt.transaction.DirectionFor(tt.accountID))
+			assert.Equal(t, tt.wantSignedAmount, tt.transaction.SignedAmountDecimalFor(tt.accountID))
+		})
+	}
+}
+
+func TestTransaction_DescriptionAndCategoriesFor(t *testing.T) {
+	var (
+		transactionID     = idVO.NewTransactionIDForTest("transaction").String()
+		accountID         = idVO.NewAccountIDForTest("account")
+		receiverAccountID = idVO.NewAccountIDForTest("accountReceiver")
+		otherAccountID    = idVO.NewAccountIDForTest("accountOther")
+		receiverAmount    = int64(1000)
+		receiverCurrency  = moneyVO.JPY
+		memo              = "Rent for March"
+		reference         = "INV-2024-0001"
+		transactionAt     = timer.GetFixedDate()
+	)
+
+	t.Run("Positive: メモは取引を行った口座のみ、参照情報と分類はそれぞれの口座から参照できる", func(t *testing.T) {
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID.String(), strutil.StrPointer(receiverAccountID.String()), transactionDomain.Transfer, 1000, moneyVO.JPY,
+			&receiverAmount, &receiverCurrency, nil, nil, nil, nil, nil, &memo, &reference,
+			map[string][]string{
+				accountID.String():         {"housing"},
+				receiverAccountID.String(): {"income", "rent"},
+			},
+			transactionAt,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, &memo, tx.Memo())
+		assert.Equal(t, &memo, tx.MemoFor(accountID))
+		assert.Nil(t, tx.MemoFor(receiverAccountID))
+		assert.Equal(t, &reference, tx.Reference())
+		assert.Equal(t, []string{"housing"}, tx.CategoriesFor(accountID))
+		assert.Equal(t, []string{"income", "rent"}, tx.CategoriesFor(receiverAccountID))
+		assert.Equal(t, []string{}, tx.CategoriesFor(otherAccountID))
+	})
+
+	t.Run("Positive: 取得した分類を変更しても取引の分類は変わらない", func(t *testing.T) {
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID.String(), nil, transactionDomain.Deposit, 1000, moneyVO.JPY,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, map[string][]string{accountID.String(): {"food"}}, transactionAt,
+		)
+		assert.NoError(t, err)
+		categories := tx.CategoriesFor(accountID)
+		categories[0] = "travel"
+		assert.Equal(t, []string{"food"}, tx.CategoriesFor(accountID))
+	})
+
+	t.Run("Negative: 不正な分類を再構築する", func(t *testing.T) {
+		tx, err := transactionDomain.Reconstruct(
+			transactionID, accountID.String(), nil, transactionDomain.Deposit, 1000, moneyVO.JPY,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, map[string][]string{accountID.String(): {""}}, transactionAt,
+		)
+		assert.ErrorIs(t, err, transactionDomain.ErrInvalidCategory)
+		assert.Nil(t, tx)
+	})
 }
@@ -3,22 +3,28 @@ package user
 import (
 	"context"
 
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
 )
 
 type IUserService interface {
 	VerifyEmailUniqueness(ctx context.Context, email string) error
 	EnsureUserExists(ctx context.Context, id idVO.UserID) error
 	FindUser(ctx context.Context, id idVO.UserID) (*User, error)
+	// サインアップしたユーザーを保存し、UserSignedUp イベントを記録します。
+	Register(ctx context.Context, user *User) error
 }
 
 type userService struct {
-	userRepo IUserRepository
+	userRepo  IUserRepository
+	eventRepo eventDomain.IEventRepository
 }
 
-func NewService(userRepository IUserRepository) IUserService {
+func NewService(userRepository IUserRepository, eventRepository eventDomain.IEventRepository) IUserService {
 	return &userService{
-		userRepo: userRepository,
+		userRepo:  userRepository,
+		eventRepo: eventRepository,
 	}
 }
 
@@ -54,3 +60,19 @@ func (s *userService) FindUser(ctx context.Context, id idVO.UserID) (*User, erro
 	}
 	return user, nil
 }
+
+func (s *userService) Register(ctx context.Context, user *User) error {
+	if err := s.userRepo.Save(ctx, user); err != nil {
+		return err
+	}
+
+	event, err := eventDomain.New(eventDomain.UserSignedUp{
+		UserID: user.IDString(),
+		Name:   user.Name(),
+		Email:  user.Email(),
+	}, timer.Now())
+	if err != nil {
+		return err
+	}
+	return s.eventRepo.Save(ctx, event)
+}
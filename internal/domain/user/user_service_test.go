@@ -6,6 +6,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
 	"github.com/u104rak1/pocgo/internal/domain/mock"
 	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
@@ -54,7 +55,7 @@ func TestVerifyEmailUniqueness(t *testing.T) {
 
 			mockUserRepo := mock.NewMockIUserRepository(ctrl)
 
-			service := userDomain.NewService(mockUserRepo)
+			service := userDomain.NewService(mockUserRepo, mock.NewMockIEventRepository(ctrl))
 			ctx := context.Background()
 			tt.setup(mockUserRepo)
 
@@ -113,7 +114,7 @@ func TestEnsureUserExists(t *testing.T) {
 
 			mockUserRepo := mock.NewMockIUserRepository(ctrl)
 
-			service := userDomain.NewService(mockUserRepo)
+			service := userDomain.NewService(mockUserRepo, mock.NewMockIEventRepository(ctrl))
 			ctx := context.Background()
 			tt.setup(mockUserRepo)
 
@@ -128,3 +129,69 @@ func TestEnsureUserExists(t *testing.T) {
 		})
 	}
 }
+
+func TestRegister(t *testing.T) {
+	var arg = gomock.Any()
+
+	user, err := userDomain.New("sato taro", "sato@example.com")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName string
+		setup    func(mockUserRepo *mock.MockIUserRepository, mockEventRepo *mock.MockIEventRepository)
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: ユーザーを保存し、UserSignedUp イベントを記録する",
+			setup: func(mockUserRepo *mock.MockIUserRepository, mockEventRepo *mock.MockIEventRepository) {
+				mockUserRepo.EXPECT().Save(arg, user).Return(nil)
+				mockEventRepo.EXPECT().Save(arg, arg).DoAndReturn(func(_ context.Context, event *eventDomain.Event) error {
+					assert.Equal(t, eventDomain.TypeUserSignedUp, event.Type())
+					assert.Equal(t, eventDomain.AggregateUser, event.AggregateType())
+					assert.Equal(t, user.IDString(), event.AggregateID())
+					return nil
+				})
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: ユーザーの保存に失敗した場合はイベントを記録しない",
+			setup: func(mockUserRepo *mock.MockIUserRepository, mockEventRepo *mock.MockIEventRepository) {
+				mockUserRepo.EXPECT().Save(arg, user).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: イベントの記録に失敗した場合はエラーが返る",
+			setup: func(mockUserRepo *mock.MockIUserRepository, mockEventRepo *mock.MockIEventRepository) {
+				mockUserRepo.EXPECT().Save(arg, user).Return(nil)
+				mockEventRepo.EXPECT().Save(arg, arg).Return(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserRepo := mock.NewMockIUserRepository(ctrl)
+			mockEventRepo := mock.NewMockIEventRepository(ctrl)
+
+			service := userDomain.NewService(mockUserRepo, mockEventRepo)
+			ctx := context.Background()
+			tt.setup(mockUserRepo, mockEventRepo)
+
+			err := service.Register(ctx, user)
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package id
+
+import "fmt"
+
+type eventIDType struct{}
+
+type EventID = ID[eventIDType]
+
+func NewEventID() EventID {
+	return New[eventIDType]()
+}
+
+func EventIDFromString(value string) (EventID, error) {
+	eventID, err := NewFromString[eventIDType](value)
+	if err != nil {
+		return EventID{}, fmt.Errorf("invalid event id: %w", err)
+	}
+	return eventID, nil
+}
+
+// NewEventIDForTest テスト用のEventIDを生成します
+// 同じseedからは常に同じIDが生成されます
+func NewEventIDForTest(seed string) EventID {
+	return NewForTest[eventIDType](seed)
+}
@@ -0,0 +1,25 @@
+package id
+
+import "fmt"
+
+type holdIDType struct{}
+
+type HoldID = ID[holdIDType]
+
+func NewHoldID() HoldID {
+	return New[holdIDType]()
+}
+
+func HoldIDFromString(value string) (HoldID, error) {
+	holdID, err := NewFromString[holdIDType](value)
+	if err != nil {
+		return HoldID{}, fmt.Errorf("invalid hold id: %w", err)
+	}
+	return holdID, nil
+}
+
+// NewHoldIDForTest テスト用のHoldIDを生成します
+// 同じseedからは常に同じIDが生成されます
+func NewHoldIDForTest(seed string) HoldID {
+	return NewForTest[holdIDType](seed)
+}
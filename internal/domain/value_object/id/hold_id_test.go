@@ -0,0 +1,93 @@
+package id_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestNewHoldID(t *testing.T) {
+	t.Run("新規HoldIDが生成され、有効なULIDフォーマットであること", func(t *testing.T) {
+		id := idVO.NewHoldID()
+		assert.NotEmpty(t, id.String())
+		assert.True(t, id.IsValid())
+	})
+}
+
+func TestHoldIDFromString(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		errMsg string
+	}{
+		{
+			name:   "Positive: 有効なULIDからHoldIDを生成できること",
+			input:  "01H2X5JMIN3P8T68PYHXXVK5XN",
+			errMsg: "",
+		},
+		{
+			name:   "Negative: 不正なULIDからHoldIDを生成できないこと",
+			input:  "invalid-ulid",
+			errMsg: "invalid hold id: invalid ulid",
+		},
+		{
+			name:   "Negative: 空文字列からHoldIDを生成できないこと",
+			input:  "",
+			errMsg: "invalid hold id: id must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := idVO.HoldIDFromString(tt.input)
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.input, id.String())
+			}
+		})
+	}
+}
+
+func TestNewHoldIDForTest(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed1    string
+		seed2    string
+		wantSame bool
+	}{
+		{
+			name:     "同じシードから同じHoldIDが生成されること",
+			seed1:    "test-hold-1",
+			seed2:    "test-hold-1",
+			wantSame: true,
+		},
+		{
+			name:     "異なるシードから異なるHoldIDが生成されること",
+			seed1:    "test-hold-1",
+			seed2:    "test-hold-2",
+			wantSame: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id1 := idVO.NewHoldIDForTest(tt.seed1)
+			id2 := idVO.NewHoldIDForTest(tt.seed2)
+
+			assert.Equal(t, tt.wantSame, id1.Equals(id2))
+			assert.True(t, id1.IsValid())
+			assert.True(t, id2.IsValid())
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package id
+
+import "fmt"
+
+type payeeIDType struct{}
+
+type PayeeID = ID[payeeIDType]
+
+func NewPayeeID() PayeeID {
+	return New[payeeIDType]()
+}
+
+func PayeeIDFromString(value string) (PayeeID, error) {
+	holdID, err := NewFromString[payeeIDType](value)
+	if err != nil {
+		return PayeeID{}, fmt.Errorf("invalid payee id: %w", err)
+	}
+	return holdID, nil
+}
+
+// NewPayeeIDForTest テスト用のPayeeIDを生成します
+// 同じseedからは常に同じIDが生成されます
+func NewPayeeIDForTest(seed string) PayeeID {
+	return NewForTest[payeeIDType](seed)
+}
@@ -0,0 +1,25 @@
+package id
+
+import "fmt"
+
+type sessionIDType struct{}
+
+type SessionID = ID[sessionIDType]
+
+func NewSessionID() SessionID {
+	return New[sessionIDType]()
+}
+
+func SessionIDFromString(value string) (SessionID, error) {
+	sessionID, err := NewFromString[sessionIDType](value)
+	if err != nil {
+		return SessionID{}, fmt.Errorf("invalid session id: %w", err)
+	}
+	return sessionID, nil
+}
+
+// NewSessionIDForTest テスト用のSessionIDを生成します
+// 同じseedからは常に同じIDが生成されます
+func NewSessionIDForTest(seed string) SessionID {
+	return NewForTest[sessionIDType](seed)
+}
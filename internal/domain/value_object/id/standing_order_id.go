@@ -0,0 +1,25 @@
+package id
+
+import "fmt"
+
+type standingOrderIDType struct{}
+
+type StandingOrderID = ID[standingOrderIDType]
+
+func NewStandingOrderID() StandingOrderID {
+	return New[standingOrderIDType]()
+}
+
+func StandingOrderIDFromString(value string) (StandingOrderID, error) {
+	standingOrderID, err := NewFromString[standingOrderIDType](value)
+	if err != nil {
+		return StandingOrderID{}, fmt.Errorf("invalid standing order id: %w", err)
+	}
+	return standingOrderID, nil
+}
+
+// NewStandingOrderIDForTest テスト用のStandingOrderIDを生成します
+// 同じseedからは常に同じIDが生成されます
+func NewStandingOrderIDForTest(seed string) StandingOrderID {
+	return NewForTest[standingOrderIDType](seed)
+}
@@ -0,0 +1,93 @@
+package id_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+func TestNewStandingOrderID(t *testing.T) {
+	t.Run("新規StandingOrderIDが生成され、有効なULIDフォーマットであること", func(t *testing.T) {
+		id := idVO.NewStandingOrderID()
+		assert.NotEmpty(t, id.String())
+		assert.True(t, id.IsValid())
+	})
+}
+
+func TestStandingOrderIDFromString(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		errMsg string
+	}{
+		{
+			name:   "Positive: 有効なULIDからStandingOrderIDを生成できること",
+			input:  "01H2X5JMIN3P8T68PYHXXVK5XN",
+			errMsg: "",
+		},
+		{
+			name:   "Negative: 不正なULIDからStandingOrderIDを生成できないこと",
+			input:  "invalid-ulid",
+			errMsg: "invalid standing order id: invalid ulid",
+		},
+		{
+			name:   "Negative: 空文字列からStandingOrderIDを生成できないこと",
+			input:  "",
+			errMsg: "invalid standing order id: id must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := idVO.StandingOrderIDFromString(tt.input)
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.input, id.String())
+			}
+		})
+	}
+}
+
+func TestNewStandingOrderIDForTest(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed1    string
+		seed2    string
+		wantSame bool
+	}{
+		{
+			name:     "同じシードから同じStandingOrderIDが生成されること",
+			seed1:    "test-standing-order-1",
+			seed2:    "test-standing-order-1",
+			wantSame: true,
+		},
+		{
+			name:     "異なるシードから異なるStandingOrderIDが生成されること",
+			seed1:    "test-standing-order-1",
+			seed2:    "test-standing-order-2",
+			wantSame: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id1 := idVO.NewStandingOrderIDForTest(tt.seed1)
+			id2 := idVO.NewStandingOrderIDForTest(tt.seed2)
+
+			assert.Equal(t, tt.wantSame, id1.Equals(id2))
+			assert.True(t, id1.IsValid())
+			assert.True(t, id2.IsValid())
+		})
+	}
+}
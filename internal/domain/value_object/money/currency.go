@@ -0,0 +1,30 @@
+package money
+
+// Currency は ISO 4217 の通貨コード、小数点以下の桁数（minor unit）、表示用の記号を表します。
+type Currency struct {
+	code     string
+	exponent int
+	symbol   string
+}
+
+func NewCurrency(code string, exponent int, symbol string) (*Currency, error) {
+	if err := validCurrencyCode(code); err != nil {
+		return nil, err
+	}
+	if err := validExponent(exponent); err != nil {
+		return nil, err
+	}
+	return &Currency{code: code, exponent: exponent, symbol: symbol}, nil
+}
+
+func (c Currency) Code() string {
+	return c.code
+}
+
+func (c Currency) Exponent() int {
+	return c.exponent
+}
+
+func (c Currency) Symbol() string {
+	return c.symbol
+}
@@ -0,0 +1,73 @@
+package money
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// 利用可能な通貨を保持するレジストリです。
+// 起動時に LoadCurrencies で通貨マスタ（インメモリモードでは静的テーブル）から読み込んだ通貨が登録されます。
+// 通貨マスタが空の場合でも動作するように、JPY と USD は初期状態で登録されています。
+var registry = newCurrencyRegistry(
+	Currency{code: JPY, exponent: 0, symbol: "¥"},
+	Currency{code: USD, exponent: 2, symbol: "$"},
+)
+
+type currencyRegistry struct {
+	mu         sync.RWMutex
+	currencies map[string]Currency
+}
+
+func newCurrencyRegistry(currencies ...Currency) *currencyRegistry {
+	r := &currencyRegistry{currencies: make(map[string]Currency)}
+	for _, c := range currencies {
+		r.currencies[c.code] = c
+	}
+	return r
+}
+
+// リポジトリから通貨を読み込み、レジストリに登録します。同じ通貨コードが既に登録されている場合は上書きします。
+func LoadCurrencies(ctx context.Context, repo ICurrencyRepository) error {
+	currencies, err := repo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	RegisterCurrencies(currencies...)
+	return nil
+}
+
+// 通貨をレジストリに登録します。同じ通貨コードが既に登録されている場合は上書きします。
+func RegisterCurrencies(currencies ...*Currency) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	for _, c := range currencies {
+		if c == nil {
+			continue
+		}
+		registry.currencies[c.code] = *c
+	}
+}
+
+// 通貨コードに対応する登録済みの通貨を取得します。
+func FindCurrency(code string) (*Currency, error) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	c, ok := registry.currencies[code]
+	if !ok {
+		return nil, ErrUnsupportedCurrency
+	}
+	return &c, nil
+}
+
+// 登録済みの通貨コードを昇順で返します。
+func SupportedCurrencyCodes() []string {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	codes := make([]string, 0, len(registry.currencies))
+	for code := range registry.currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
@@ -0,0 +1,81 @@
+package money_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/u104rak1/pocgo/internal/domain/mock"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func TestLoadCurrencies(t *testing.T) {
+	// 他のテストに影響しないよう、ISO 4217 のテスト用通貨コードを使用する
+	xts, err := moneyVO.NewCurrency("XTS", 3, "")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName string
+		prepare  func(mockCurrencyRepo *mock.MockICurrencyRepository)
+		errMsg   string
+	}{
+		{
+			caseName: "Negative: 通貨の取得に失敗した場合はエラーが返り、通貨は登録されない",
+			prepare: func(mockCurrencyRepo *mock.MockICurrencyRepository) {
+				mockCurrencyRepo.EXPECT().FindAll(gomock.Any()).Return(nil, assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+		{
+			caseName: "Positive: 取得した通貨がレジストリに登録される",
+			prepare: func(mockCurrencyRepo *mock.MockICurrencyRepository) {
+				mockCurrencyRepo.EXPECT().FindAll(gomock.Any()).Return([]*moneyVO.Currency{xts}, nil)
+			},
+			errMsg: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCurrencyRepo := mock.NewMockICurrencyRepository(ctrl)
+			tt.prepare(mockCurrencyRepo)
+
+			err := moneyVO.LoadCurrencies(context.Background(), mockCurrencyRepo)
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				_, err := moneyVO.FindCurrency("XTS")
+				assert.ErrorIs(t, err, moneyVO.ErrUnsupportedCurrency)
+				return
+			}
+
+			assert.NoError(t, err)
+			c, err := moneyVO.FindCurrency("XTS")
+			assert.NoError(t, err)
+			assert.Equal(t, xts, c)
+			assert.Contains(t, moneyVO.SupportedCurrencyCodes(), "XTS")
+
+			// 登録した通貨の桁数で金額が作成できる
+			m, err := moneyVO.NewFromDecimal("1.234", "XTS")
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1234), m.Amount())
+			assert.Equal(t, "1.234", m.Decimal())
+
+			_, err = moneyVO.NewFromDecimal("1.2345", "XTS")
+			assert.True(t, errors.Is(err, moneyVO.ErrInvalidPrecision))
+		})
+	}
+}
+
+func TestSupportedCurrencyCodes(t *testing.T) {
+	t.Parallel()
+	codes := moneyVO.SupportedCurrencyCodes()
+	assert.Contains(t, codes, moneyVO.JPY)
+	assert.Contains(t, codes, moneyVO.USD)
+	assert.IsIncreasing(t, codes)
+}
@@ -0,0 +1,7 @@
+package money
+
+import "context"
+
+type ICurrencyRepository interface {
+	FindAll(ctx context.Context) ([]*Currency, error)
+}
@@ -0,0 +1,74 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func TestNewCurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		exponent int
+		symbol   string
+		errMsg   string
+	}{
+		{
+			name:     "Positive: 有効な通貨コードと桁数の場合は、通貨が作成できる",
+			code:     "EUR",
+			exponent: 2,
+			symbol:   "€",
+			errMsg:   "",
+		},
+		{
+			name:     "Positive: 記号が空でも、通貨が作成できる",
+			code:     "KWD",
+			exponent: 3,
+			symbol:   "",
+			errMsg:   "",
+		},
+		{
+			name:     "Negative: 通貨コードが小文字の場合はエラーが返る",
+			code:     "eur",
+			exponent: 2,
+			errMsg:   "currency code must be 3 uppercase letters",
+		},
+		{
+			name:     "Negative: 通貨コードが3文字でない場合はエラーが返る",
+			code:     "EURO",
+			exponent: 2,
+			errMsg:   "currency code must be 3 uppercase letters",
+		},
+		{
+			name:     "Negative: 桁数がマイナスの場合はエラーが返る",
+			code:     "EUR",
+			exponent: -1,
+			errMsg:   "currency exponent must be between 0 and 4",
+		},
+		{
+			name:     "Negative: 桁数が上限を超える場合はエラーが返る",
+			code:     "EUR",
+			exponent: 5,
+			errMsg:   "currency exponent must be between 0 and 4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, err := moneyVO.NewCurrency(tt.code, tt.exponent, tt.symbol)
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Nil(t, c)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.code, c.Code())
+				assert.Equal(t, tt.exponent, c.Exponent())
+				assert.Equal(t, tt.symbol, c.Symbol())
+			}
+		})
+	}
+}
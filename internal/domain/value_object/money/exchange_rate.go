@@ -0,0 +1,94 @@
+package money
+
+import (
+	"math/big"
+	"strings"
+)
+
+// ExchangeRate は基準通貨（base）1単位あたりの相手通貨（quote）の価格を表します。
+// 例えば USD/JPY が 150.25 の場合、1 USD = 150.25 JPY です。
+type ExchangeRate struct {
+	base  string
+	quote string
+	rate  *big.Rat
+}
+
+// "150.25" のような10進数表記のレートから生成します。小数点以下は RateScale 桁に丸められます。
+func NewExchangeRate(base, quote, rate string) (*ExchangeRate, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok {
+		return nil, ErrInvalidExchangeRate
+	}
+	return newExchangeRate(base, quote, r)
+}
+
+func newExchangeRate(base, quote string, rate *big.Rat) (*ExchangeRate, error) {
+	if _, err := FindCurrency(base); err != nil {
+		return nil, err
+	}
+	if _, err := FindCurrency(quote); err != nil {
+		return nil, err
+	}
+	rounded := roundRat(rate, RateScale)
+	if rounded.Sign() <= 0 {
+		return nil, ErrInvalidExchangeRate
+	}
+	return &ExchangeRate{base: base, quote: quote, rate: rounded}, nil
+}
+
+func (e ExchangeRate) Base() string {
+	return e.base
+}
+
+func (e ExchangeRate) Quote() string {
+	return e.quote
+}
+
+// "150.25" のような10進数表記のレートを返します。末尾の0は省略されます。
+func (e ExchangeRate) Rate() string {
+	s := e.rate.FloatString(RateScale)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// 逆方向（quote/base）のレートを返します。
+func (e ExchangeRate) Inverse() (*ExchangeRate, error) {
+	return newExchangeRate(e.quote, e.base, new(big.Rat).Inv(e.rate))
+}
+
+// 基準通貨の金額を相手通貨の金額に換算します。相手通貨の最小単位未満は四捨五入されます。
+func (e ExchangeRate) Convert(m Money) (*Money, error) {
+	if m.currency != e.base {
+		return nil, ErrDifferentCurrencyOperation
+	}
+	quote, err := FindCurrency(e.quote)
+	if err != nil {
+		return nil, err
+	}
+
+	// 最小単位の金額 × レート × 10^(相手通貨の桁数 - 基準通貨の桁数)
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(m.amount), e.rate)
+	converted.Mul(converted, pow10Rat(quote.exponent-m.Exponent()))
+	amount := roundRat(converted, 0).Num()
+	if !amount.IsInt64() {
+		return nil, ErrAmountOverflow
+	}
+
+	return New(amount.Int64(), e.quote)
+}
+
+// r を小数点以下 scale 桁に四捨五入します。r は非負である前提です。
+func roundRat(r *big.Rat, scale int) *big.Rat {
+	shift := pow10Rat(scale)
+	scaled := new(big.Rat).Mul(r, shift)
+	scaled.Add(scaled, big.NewRat(1, 2))
+	floor := new(big.Int).Quo(scaled.Num(), scaled.Denom())
+	return new(big.Rat).Quo(new(big.Rat).SetInt(floor), shift)
+}
+
+func pow10Rat(exp int) *big.Rat {
+	if exp < 0 {
+		return new(big.Rat).Inv(pow10Rat(-exp))
+	}
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+}
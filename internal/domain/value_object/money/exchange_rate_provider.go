@@ -0,0 +1,8 @@
+package money
+
+import "context"
+
+type IExchangeRateProvider interface {
+	// base 1単位あたりの quote の価格を返します。レートが存在しない場合は ErrExchangeRateNotFound を返します。
+	Quote(ctx context.Context, base, quote string) (*ExchangeRate, error)
+}
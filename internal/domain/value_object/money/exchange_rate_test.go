@@ -0,0 +1,170 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+func TestNewExchangeRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		quote    string
+		rate     string
+		wantRate string
+		errMsg   string
+	}{
+		{
+			name:     "Positive: 有効なレートの場合は、為替レートが作成できる",
+			base:     moneyVO.USD,
+			quote:    moneyVO.JPY,
+			rate:     "150.25",
+			wantRate: "150.25",
+			errMsg:   "",
+		},
+		{
+			name:     "Positive: 末尾の0は省略される",
+			base:     moneyVO.USD,
+			quote:    moneyVO.JPY,
+			rate:     "150.000",
+			wantRate: "150",
+			errMsg:   "",
+		},
+		{
+			name:     "Positive: 小数点以下12桁を超える場合は四捨五入される",
+			base:     moneyVO.JPY,
+			quote:    moneyVO.USD,
+			rate:     "0.0066666666666666",
+			wantRate: "0.006666666667",
+			errMsg:   "",
+		},
+		{
+			name:   "Negative: 0の場合はエラーが返る",
+			base:   moneyVO.USD,
+			quote:  moneyVO.JPY,
+			rate:   "0",
+			errMsg: "exchange rate must be a positive number",
+		},
+		{
+			name:   "Negative: マイナスの場合はエラーが返る",
+			base:   moneyVO.USD,
+			quote:  moneyVO.JPY,
+			rate:   "-150",
+			errMsg: "exchange rate must be a positive number",
+		},
+		{
+			name:   "Negative: 数値でない場合はエラーが返る",
+			base:   moneyVO.USD,
+			quote:  moneyVO.JPY,
+			rate:   "abc",
+			errMsg: "exchange rate must be a positive number",
+		},
+		{
+			name:   "Negative: サポートされていない通貨の場合はエラーが返る",
+			base:   "XXX",
+			quote:  moneyVO.JPY,
+			rate:   "150",
+			errMsg: "unsupported currency",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := moneyVO.NewExchangeRate(tt.base, tt.quote, tt.rate)
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Nil(t, r)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.base, r.Base())
+				assert.Equal(t, tt.quote, r.Quote())
+				assert.Equal(t, tt.wantRate, r.Rate())
+			}
+		})
+	}
+}
+
+func TestExchangeRate_Inverse(t *testing.T) {
+	t.Parallel()
+	r, err := moneyVO.NewExchangeRate(moneyVO.USD, moneyVO.JPY, "150")
+	assert.NoError(t, err)
+
+	inverse, err := r.Inverse()
+	assert.NoError(t, err)
+	assert.Equal(t, moneyVO.JPY, inverse.Base())
+	assert.Equal(t, moneyVO.USD, inverse.Quote())
+	assert.Equal(t, "0.006666666667", inverse.Rate())
+}
+
+func TestExchangeRate_Convert(t *testing.T) {
+	usdToJPY, _ := moneyVO.NewExchangeRate(moneyVO.USD, moneyVO.JPY, "150.25")
+	jpyToUSD, _ := moneyVO.NewExchangeRate(moneyVO.JPY, moneyVO.USD, "0.0067")
+
+	tests := []struct {
+		name         string
+		rate         *moneyVO.ExchangeRate
+		amount       int64
+		currency     string
+		wantAmount   int64
+		wantCurrency string
+		errMsg       string
+	}{
+		{
+			name:         "Positive: USDをJPYに換算できる（10.99 USD × 150.25 = 1651.2475 JPY → 1651 JPY）",
+			rate:         usdToJPY,
+			amount:       1099,
+			currency:     moneyVO.USD,
+			wantAmount:   1651,
+			wantCurrency: moneyVO.JPY,
+			errMsg:       "",
+		},
+		{
+			name:         "Positive: JPYをUSDに換算できる（1000 JPY × 0.0067 = 6.70 USD）",
+			rate:         jpyToUSD,
+			amount:       1000,
+			currency:     moneyVO.JPY,
+			wantAmount:   670,
+			wantCurrency: moneyVO.USD,
+			errMsg:       "",
+		},
+		{
+			name:         "Positive: 最小単位未満は四捨五入される（50 JPY × 0.0067 = 0.335 USD → 0.34 USD）",
+			rate:         jpyToUSD,
+			amount:       50,
+			currency:     moneyVO.JPY,
+			wantAmount:   34,
+			wantCurrency: moneyVO.USD,
+			errMsg:       "",
+		},
+		{
+			name:     "Negative: 基準通貨と金額の通貨が異なる場合はエラーが返る",
+			rate:     usdToJPY,
+			amount:   1000,
+			currency: moneyVO.JPY,
+			errMsg:   "operation cannot be performed on different currencies",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := moneyVO.New(tt.amount, tt.currency)
+			assert.NoError(t, err)
+
+			converted, err := tt.rate.Convert(*m)
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Nil(t, converted)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantAmount, converted.Amount())
+				assert.Equal(t, tt.wantCurrency, converted.Currency())
+			}
+		})
+	}
+}
@@ -1,41 +1,62 @@
 package money
 
 import (
+	"fmt"
 	"math"
+	"math/big"
+	"strings"
 )
 
+// Money は金額を通貨の最小単位（JPYは1円、USDは1セント）の整数で保持します。
+// 扱える通貨は通貨レジストリに登録されている通貨です。
+// 浮動小数点数を使用しない為、加減算を繰り返しても誤差が生じません。
 type Money struct {
-	amount   float64
+	amount   int64
 	currency string
 }
 
-func New(amount float64, currency string) (*Money, error) {
+// 最小単位の金額から生成します。例えば 10.99 USD の場合は 1099 を渡します。
+func New(amount int64, currency string) (*Money, error) {
 	if err := validAmount(amount); err != nil {
 		return nil, err
 	}
 
-	if err := validCurrency(currency); err != nil {
+	if _, err := FindCurrency(currency); err != nil {
 		return nil, err
 	}
 
-	switch currency {
-	case JPY:
-		if math.Round(amount) != amount {
-			return nil, ErrInvalidJPYPrecision
-		}
-		amount = math.Round(amount)
-		return &Money{amount: amount, currency: currency}, nil
-	case USD:
-		if math.Round(amount*100) != amount*100 {
-			return nil, ErrInvalidUSDPrecision
-		}
-		return &Money{amount: amount, currency: currency}, nil
-	default:
+	return &Money{amount: amount, currency: currency}, nil
+}
+
+// "10.99" のような10進数表記の文字列から生成します。通貨の小数点以下の桁数を超える場合はエラーを返します。
+func NewFromDecimal(amount string, currency string) (*Money, error) {
+	c, err := FindCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok {
 		return nil, ErrInvalidMoney
 	}
+	if r.Sign() < 0 {
+		return nil, ErrNegativeAmount
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(c.exponent)), nil)
+	r.Mul(r, new(big.Rat).SetInt(scale))
+	if !r.IsInt() {
+		return nil, precisionError(*c)
+	}
+	if !r.Num().IsInt64() {
+		return nil, ErrAmountOverflow
+	}
+
+	return New(r.Num().Int64(), currency)
 }
 
-func (m Money) Amount() float64 {
+// 最小単位の金額を返します。
+func (m Money) Amount() int64 {
 	return m.amount
 }
 
@@ -43,10 +64,36 @@ func (m Money) Currency() string {
 	return m.currency
 }
 
+// 通貨の小数点以下の桁数を返します。
+// 金額は登録済みの通貨でのみ生成できる為、通貨が見つからない場合は誤った桁数で換算しないように panic します。
+func (m Money) Exponent() int {
+	c, err := FindCurrency(m.currency)
+	if err != nil {
+		panic(fmt.Sprintf("money: currency %q is not registered", m.currency))
+	}
+	return c.exponent
+}
+
+// "10.99" のような10進数表記の文字列を返します。小数点以下は通貨の桁数で0埋めされます。
+func (m Money) Decimal() string {
+	exp := m.Exponent()
+	digits := big.NewInt(m.amount).String()
+	if exp == 0 {
+		return digits
+	}
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	return digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
 func (m Money) Add(other Money) (*Money, error) {
 	if m.currency != other.currency {
 		return nil, ErrDifferentCurrencyOperation
 	}
+	if m.amount > math.MaxInt64-other.amount {
+		return nil, ErrAmountOverflow
+	}
 	return &Money{amount: m.amount + other.amount, currency: m.currency}, nil
 }
 
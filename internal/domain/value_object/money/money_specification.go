@@ -1,32 +1,64 @@
 package money
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
 
 const (
 	JPY = "JPY"
 	USD = "USD"
 )
 
+const (
+	ExponentMin = 0
+	ExponentMax = 4
+)
+
+// 為替レートの小数点以下の桁数
+const RateScale = 12
+
+var currencyCodeRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
 var (
 	ErrInvalidMoney               = errors.New("invalid money")
 	ErrNegativeAmount             = errors.New("amount cannot be negative")
-	ErrInvalidUSDPrecision        = errors.New("amount in USD cannot have more than 2 decimal places")
-	ErrInvalidJPYPrecision        = errors.New("amount in JPY must not have decimal places")
+	ErrInvalidPrecision           = errors.New("invalid precision")
+	ErrInvalidCurrencyCode        = errors.New("currency code must be 3 uppercase letters")
+	ErrInvalidExponent            = fmt.Errorf("currency exponent must be between %d and %d", ExponentMin, ExponentMax)
 	ErrUnsupportedCurrency        = errors.New("unsupported currency")
 	ErrDifferentCurrencyOperation = errors.New("operation cannot be performed on different currencies")
 	ErrInsufficientBalance        = errors.New("insufficient balance")
+	ErrAmountOverflow             = errors.New("amount is too large")
+	ErrInvalidExchangeRate        = errors.New("exchange rate must be a positive number")
+	ErrExchangeRateNotFound       = errors.New("exchange rate not found")
 )
 
-func validAmount(amount float64) error {
+func validAmount(amount int64) error {
 	if amount < 0 {
 		return ErrNegativeAmount
 	}
 	return nil
 }
 
-func validCurrency(currency string) error {
-	if !(currency == JPY || currency == USD) {
-		return ErrUnsupportedCurrency
+func validCurrencyCode(code string) error {
+	if !currencyCodeRegex.MatchString(code) {
+		return ErrInvalidCurrencyCode
 	}
 	return nil
 }
+
+func validExponent(exponent int) error {
+	if exponent < ExponentMin || exponent > ExponentMax {
+		return ErrInvalidExponent
+	}
+	return nil
+}
+
+func precisionError(c Currency) error {
+	if c.exponent == 0 {
+		return fmt.Errorf("%w: amount in %s must not have decimal places", ErrInvalidPrecision, c.code)
+	}
+	return fmt.Errorf("%w: amount in %s cannot have more than %d decimal places", ErrInvalidPrecision, c.code, c.exponent)
+}
@@ -1,6 +1,8 @@
 package money_test
 
 import (
+	"math"
+
 	"github.com/stretchr/testify/assert"
 	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
 
@@ -10,7 +12,7 @@ import (
 func TestNew(t *testing.T) {
 	tests := []struct {
 		name     string
-		amount   float64
+		amount   int64
 		currency string
 		errMsg   string
 	}{
@@ -21,29 +23,17 @@ func TestNew(t *testing.T) {
 			errMsg:   "",
 		},
 		{
-			name:     "Negative: 小数点以下のJPYの場合はエラーが返る",
-			amount:   1000.1,
-			currency: "JPY",
-			errMsg:   "amount in JPY must not have decimal places",
-		},
-		{
-			name:     "Positive: 有効なUSDの場合は、金額が作成できる",
-			amount:   10,
+			name:     "Positive: 有効なUSDの場合は、最小単位（セント）で金額が作成できる",
+			amount:   1099,
 			currency: "USD",
 			errMsg:   "",
 		},
 		{
-			name:     "Positive: 小数点第2位までのUSDの場合は、金額が作成できる",
-			amount:   10.99,
+			name:     "Positive: 0の場合は、金額が作成できる",
+			amount:   0,
 			currency: "USD",
 			errMsg:   "",
 		},
-		{
-			name:     "Negative: 小数点第3位のUSDの場合はエラーが返る",
-			amount:   10.001,
-			currency: "USD",
-			errMsg:   "amount in USD cannot have more than 2 decimal places",
-		},
 		{
 			name:     "Negative: 金額がマイナスの場合はエラーが返る",
 			amount:   -1,
@@ -53,7 +43,7 @@ func TestNew(t *testing.T) {
 		{
 			name:     "Negative: サポートされていない通貨の場合はエラーが返る",
 			amount:   1000,
-			currency: "EUR",
+			currency: "XXX",
 			errMsg:   "unsupported currency",
 		},
 	}
@@ -76,16 +66,138 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewFromDecimal(t *testing.T) {
+	tests := []struct {
+		name        string
+		amount      string
+		currency    string
+		wantAmount  int64
+		wantDecimal string
+		errMsg      string
+	}{
+		{
+			name:        "Positive: 有効なJPYの場合は、金額が作成できる",
+			amount:      "1000",
+			currency:    "JPY",
+			wantAmount:  1000,
+			wantDecimal: "1000",
+			errMsg:      "",
+		},
+		{
+			name:     "Negative: 小数点以下のJPYの場合はエラーが返る",
+			amount:   "1000.1",
+			currency: "JPY",
+			errMsg:   "invalid precision: amount in JPY must not have decimal places",
+		},
+		{
+			name:        "Positive: 小数点以下が0のJPYの場合は、金額が作成できる",
+			amount:      "1000.00",
+			currency:    "JPY",
+			wantAmount:  1000,
+			wantDecimal: "1000",
+			errMsg:      "",
+		},
+		{
+			name:        "Positive: 整数のUSDの場合は、金額が作成できる",
+			amount:      "10",
+			currency:    "USD",
+			wantAmount:  1000,
+			wantDecimal: "10.00",
+			errMsg:      "",
+		},
+		{
+			name:        "Positive: 小数点第2位までのUSDの場合は、金額が作成できる",
+			amount:      "10.99",
+			currency:    "USD",
+			wantAmount:  1099,
+			wantDecimal: "10.99",
+			errMsg:      "",
+		},
+		{
+			name:        "Positive: 浮動小数点数で誤差が出る値でも、正確に金額が作成できる",
+			amount:      "0.29",
+			currency:    "USD",
+			wantAmount:  29,
+			wantDecimal: "0.29",
+			errMsg:      "",
+		},
+		{
+			name:        "Positive: 1未満のUSDの場合は、0埋めされた10進数表記になる",
+			amount:      "0.05",
+			currency:    "USD",
+			wantAmount:  5,
+			wantDecimal: "0.05",
+			errMsg:      "",
+		},
+		{
+			name:        "Positive: 指数表記の場合も、金額が作成できる",
+			amount:      "1e3",
+			currency:    "JPY",
+			wantAmount:  1000,
+			wantDecimal: "1000",
+			errMsg:      "",
+		},
+		{
+			name:     "Negative: 小数点第3位のUSDの場合はエラーが返る",
+			amount:   "10.001",
+			currency: "USD",
+			errMsg:   "invalid precision: amount in USD cannot have more than 2 decimal places",
+		},
+		{
+			name:     "Negative: 金額がマイナスの場合はエラーが返る",
+			amount:   "-1",
+			currency: "JPY",
+			errMsg:   "amount cannot be negative",
+		},
+		{
+			name:     "Negative: 数値でない場合はエラーが返る",
+			amount:   "abc",
+			currency: "JPY",
+			errMsg:   "invalid money",
+		},
+		{
+			name:     "Negative: 金額が大きすぎる場合はエラーが返る",
+			amount:   "92233720368547758.08",
+			currency: "USD",
+			errMsg:   "amount is too large",
+		},
+		{
+			name:     "Negative: サポートされていない通貨の場合はエラーが返る",
+			amount:   "1000",
+			currency: "XXX",
+			errMsg:   "unsupported currency",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := moneyVO.NewFromDecimal(tt.amount, tt.currency)
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Nil(t, m)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantAmount, m.Amount())
+				assert.Equal(t, tt.wantDecimal, m.Decimal())
+				assert.Equal(t, tt.currency, m.Currency())
+			}
+		})
+	}
+}
+
 func TestAdd(t *testing.T) {
 	m1, _ := moneyVO.New(1000, "JPY")
 	m2, _ := moneyVO.New(500, "JPY")
-	m3, _ := moneyVO.New(10.50, "USD")
+	m3, _ := moneyVO.New(1050, "USD")
+	m4, _ := moneyVO.New(math.MaxInt64, "JPY")
 
 	tests := []struct {
 		name   string
 		money1 *moneyVO.Money
 		money2 *moneyVO.Money
-		want   float64
+		want   int64
 		errMsg string
 	}{
 		{
@@ -102,6 +214,13 @@ func TestAdd(t *testing.T) {
 			want:   0,
 			errMsg: "operation cannot be performed on different currencies",
 		},
+		{
+			name:   "Negative: 加算結果が上限を超える場合はエラーが返る",
+			money1: m4,
+			money2: m2,
+			want:   0,
+			errMsg: "amount is too large",
+		},
 	}
 
 	for _, tt := range tests {
@@ -120,17 +239,48 @@ func TestAdd(t *testing.T) {
 	}
 }
 
+func TestAdd_NoDrift(t *testing.T) {
+	t.Parallel()
+	// 0.1 USD を 1万回加算しても、浮動小数点数のような誤差が生じないことを確認する
+	sum, _ := moneyVO.New(0, "USD")
+	dime, _ := moneyVO.NewFromDecimal("0.1", "USD")
+	for i := 0; i < 10000; i++ {
+		next, err := sum.Add(*dime)
+		assert.NoError(t, err)
+		sum = next
+	}
+	assert.Equal(t, int64(100000), sum.Amount())
+	assert.Equal(t, "1000.00", sum.Decimal())
+}
+
+func TestExponent(t *testing.T) {
+	t.Run("Positive: 通貨の小数点以下の桁数を返す", func(t *testing.T) {
+		t.Parallel()
+		m, err := moneyVO.New(1099, "USD")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, m.Exponent())
+	})
+
+	t.Run("Negative: 通貨が登録されていない場合は panic する", func(t *testing.T) {
+		t.Parallel()
+		assert.Panics(t, func() {
+			var m moneyVO.Money
+			m.Exponent()
+		})
+	})
+}
+
 func TestSub(t *testing.T) {
 	m1, _ := moneyVO.New(1000, "JPY")
 	m2, _ := moneyVO.New(500, "JPY")
 	m3, _ := moneyVO.New(2000, "JPY")
-	m4, _ := moneyVO.New(10.50, "USD")
+	m4, _ := moneyVO.New(1050, "USD")
 
 	tests := []struct {
 		name   string
 		money1 *moneyVO.Money
 		money2 *moneyVO.Money
-		want   float64
+		want   int64
 		errMsg string
 	}{
 		{
@@ -0,0 +1,30 @@
+package eventpublisher
+
+import (
+	"encoding/json"
+
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+)
+
+// 発行するイベントの形式です。全ての発行先で同じ形式を使用します。
+//
+//	{"id": "...", "type": "MoneyDeposited", "aggregateType": "ACCOUNT", "aggregateId": "...", "occurredAt": "...", "payload": {...}}
+type envelope struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	OccurredAt    string          `json:"occurredAt"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+func marshalEnvelope(event *eventDomain.Event) ([]byte, error) {
+	return json.Marshal(envelope{
+		ID:            event.IDString(),
+		Type:          event.Type(),
+		AggregateType: event.AggregateType(),
+		AggregateID:   event.AggregateID(),
+		OccurredAt:    event.OccurredAtString(),
+		Payload:       event.Payload(),
+	})
+}
@@ -0,0 +1,27 @@
+package eventpublisher_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func newEventForTest(t *testing.T) *eventDomain.Event {
+	t.Helper()
+	event, err := eventDomain.New(eventDomain.UserSignedUp{
+		UserID: idVO.NewUserIDForTest("user").String(),
+		Name:   "Sato Taro",
+		Email:  "sato@example.com",
+	}, timer.GetFixedDate())
+	assert.NoError(t, err)
+	return event
+}
+
+// 発行先が受け取るイベントの JSON です。
+func envelopeJSON(event *eventDomain.Event) string {
+	return `{"id":"` + event.IDString() + `","type":"UserSignedUp","aggregateType":"USER","aggregateId":"` + event.AggregateID() +
+		`","occurredAt":"` + event.OccurredAtString() + `","payload":` + string(event.Payload()) + `}`
+}
@@ -0,0 +1,55 @@
+package eventpublisher
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	eventApp "github.com/u104rak1/pocgo/internal/application/event"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+)
+
+// イベントを JSON Lines 形式でファイルに追記するパブリッシャーです。
+// 発行したイベントを失わないように、追記するたびにディスクに書き込みます。
+type filePublisher struct {
+	path string
+	mu   sync.Mutex
+}
+
+// ファイルが存在しない場合は作成します。
+func NewFilePublisher(path string) (eventApp.IEventPublisher, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close event file: %w", err)
+	}
+	return &filePublisher{path: path}, nil
+}
+
+func (p *filePublisher) Publish(ctx context.Context, event *eventDomain.Event) error {
+	line, err := marshalEnvelope(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	f, err := os.OpenFile(p.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open event file: %w", err)
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write event file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to sync event file: %w", err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,50 @@
+package eventpublisher_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	eventpublisher "github.com/u104rak1/pocgo/internal/infrastructure/event_publisher"
+)
+
+func TestFilePublisher_Publish(t *testing.T) {
+	t.Run("イベントを1行ずつファイルに追記できること", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "events.jsonl")
+		publisher, err := eventpublisher.NewFilePublisher(path)
+		assert.NoError(t, err)
+
+		first, second := newEventForTest(t), newEventForTest(t)
+		assert.NoError(t, publisher.Publish(context.Background(), first))
+		assert.NoError(t, publisher.Publish(context.Background(), second))
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, envelopeJSON(first)+"\n"+envelopeJSON(second)+"\n", string(data))
+	})
+
+	t.Run("既存のファイルの内容を残したまま追記すること", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "events.jsonl")
+		assert.NoError(t, os.WriteFile(path, []byte("{}\n"), 0o600))
+		publisher, err := eventpublisher.NewFilePublisher(path)
+		assert.NoError(t, err)
+
+		event := newEventForTest(t)
+		assert.NoError(t, publisher.Publish(context.Background(), event))
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, "{}\n"+envelopeJSON(event)+"\n", string(data))
+	})
+
+	t.Run("ファイルを作成できない場合はエラーを返すこと", func(t *testing.T) {
+		t.Parallel()
+		publisher, err := eventpublisher.NewFilePublisher(filepath.Join(t.TempDir(), "missing", "events.jsonl"))
+		assert.Error(t, err)
+		assert.Nil(t, publisher)
+	})
+}
@@ -0,0 +1,57 @@
+package eventpublisher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	eventApp "github.com/u104rak1/pocgo/internal/application/event"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+)
+
+// 発行先が応答しない場合に、中継処理が止まり続けないようにする為のタイムアウトです。
+const httpPublishTimeout = 10 * time.Second
+
+// イベントを JSON で指定の URL に POST するパブリッシャーです。
+// 同じイベントを再送する場合がある為、Idempotency-Key ヘッダーにイベントの ID を指定します。
+// 2xx 以外の応答は発行の失敗とし、次回に再送します。
+type httpPublisher struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPPublisher(url string) eventApp.IEventPublisher {
+	return &httpPublisher{
+		url:    url,
+		client: &http.Client{Timeout: httpPublishTimeout},
+	}
+}
+
+func (p *httpPublisher) Publish(ctx context.Context, event *eventDomain.Event) error {
+	body, err := marshalEnvelope(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", event.IDString())
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("failed to publish event: unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
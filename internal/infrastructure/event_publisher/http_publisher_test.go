@@ -0,0 +1,54 @@
+package eventpublisher_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	eventpublisher "github.com/u104rak1/pocgo/internal/infrastructure/event_publisher"
+)
+
+func TestHTTPPublisher_Publish(t *testing.T) {
+	t.Run("イベントを POST し、イベントの ID を冪等キーとして送ること", func(t *testing.T) {
+		t.Parallel()
+		event := newEventForTest(t)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, event.IDString(), r.Header.Get("Idempotency-Key"))
+			assert.JSONEq(t, envelopeJSON(event), string(body))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		publisher := eventpublisher.NewHTTPPublisher(server.URL)
+		assert.NoError(t, publisher.Publish(context.Background(), event))
+	})
+
+	t.Run("2xx 以外の応答の場合はエラーを返すこと", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		publisher := eventpublisher.NewHTTPPublisher(server.URL)
+		err := publisher.Publish(context.Background(), newEventForTest(t))
+		assert.ErrorContains(t, err, "unexpected status 503")
+	})
+
+	t.Run("発行先に接続できない場合はエラーを返すこと", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		url := server.URL
+		server.Close()
+
+		publisher := eventpublisher.NewHTTPPublisher(url)
+		assert.Error(t, publisher.Publish(context.Background(), newEventForTest(t)))
+	})
+}
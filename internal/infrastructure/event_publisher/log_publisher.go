@@ -0,0 +1,30 @@
+package eventpublisher
+
+import (
+	"context"
+
+	eventApp "github.com/u104rak1/pocgo/internal/application/event"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	"golang.org/x/exp/slog"
+)
+
+// イベントをログに出力するパブリッシャーです。発行先を用意せずに動作を確認する場合に使用します。
+type logPublisher struct {
+	logger *slog.Logger
+}
+
+func NewLogPublisher(logger *slog.Logger) eventApp.IEventPublisher {
+	return &logPublisher{logger: logger}
+}
+
+func (p *logPublisher) Publish(ctx context.Context, event *eventDomain.Event) error {
+	p.logger.InfoContext(ctx, "event published",
+		slog.String("id", event.IDString()),
+		slog.String("type", event.Type()),
+		slog.String("aggregateType", event.AggregateType()),
+		slog.String("aggregateId", event.AggregateID()),
+		slog.String("occurredAt", event.OccurredAtString()),
+		slog.Any("payload", event.Payload()),
+	)
+	return nil
+}
@@ -0,0 +1,35 @@
+package eventpublisher_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	eventpublisher "github.com/u104rak1/pocgo/internal/infrastructure/event_publisher"
+	"golang.org/x/exp/slog"
+)
+
+func TestLogPublisher_Publish(t *testing.T) {
+	t.Run("イベントをログに出力できること", func(t *testing.T) {
+		var buf bytes.Buffer
+		publisher := eventpublisher.NewLogPublisher(slog.New(slog.NewJSONHandler(&buf, nil)))
+		event := newEventForTest(t)
+
+		assert.NoError(t, publisher.Publish(context.Background(), event))
+
+		var got map[string]any
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		assert.Equal(t, "event published", got["msg"])
+		assert.Equal(t, event.IDString(), got["id"])
+		assert.Equal(t, "UserSignedUp", got["type"])
+		assert.Equal(t, "USER", got["aggregateType"])
+		assert.Equal(t, event.AggregateID(), got["aggregateId"])
+		assert.Equal(t, map[string]any{
+			"userId": event.AggregateID(),
+			"name":   "Sato Taro",
+			"email":  "sato@example.com",
+		}, got["payload"])
+	})
+}
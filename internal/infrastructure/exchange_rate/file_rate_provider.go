@@ -0,0 +1,79 @@
+package exchangerate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// レートファイルの形式です。
+//
+//	{"rates": [{"base": "USD", "quote": "JPY", "rate": "150.25"}]}
+type rateFile struct {
+	Rates []struct {
+		Base  string `json:"base"`
+		Quote string `json:"quote"`
+		Rate  string `json:"rate"`
+	} `json:"rates"`
+}
+
+// JSON ファイルからレートを読み込むプロバイダーです。ファイルが更新された場合は次回の取得時に読み込み直します。
+// レートの値は取得時に検証されます。
+type fileRateProvider struct {
+	path    string
+	mu      sync.Mutex
+	modTime time.Time
+	rates   rateTable
+}
+
+func NewFileRateProvider(path string) (moneyVO.IExchangeRateProvider, error) {
+	p := &fileRateProvider{path: path}
+	if _, err := p.load(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *fileRateProvider) Quote(ctx context.Context, base, quote string) (*moneyVO.ExchangeRate, error) {
+	rates, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+	return rates.lookup(base, quote)
+}
+
+func (p *fileRateProvider) load() (rateTable, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat exchange rate file: %w", err)
+	}
+	if p.rates != nil && info.ModTime().Equal(p.modTime) {
+		return p.rates, nil
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exchange rate file: %w", err)
+	}
+	var f rateFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse exchange rate file: %w", err)
+	}
+
+	rates := make(rateTable, len(f.Rates))
+	for _, r := range f.Rates {
+		rates[currencyPair{base: r.Base, quote: r.Quote}] = r.Rate
+	}
+
+	p.rates = rates
+	p.modTime = info.ModTime()
+	return p.rates, nil
+}
@@ -0,0 +1,70 @@
+package exchangerate_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	exchangerate "github.com/u104rak1/pocgo/internal/infrastructure/exchange_rate"
+)
+
+func writeRateFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestFileRateProvider_Quote(t *testing.T) {
+	t.Run("ファイルに記載されたレートを取得できること", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "rates.json")
+		writeRateFile(t, path, `{"rates": [{"base": "USD", "quote": "JPY", "rate": "150.25"}]}`, time.Now())
+
+		provider, err := exchangerate.NewFileRateProvider(path)
+		assert.NoError(t, err)
+
+		rate, err := provider.Quote(context.Background(), moneyVO.USD, moneyVO.JPY)
+		assert.NoError(t, err)
+		assert.Equal(t, "150.25", rate.Rate())
+
+		_, err = provider.Quote(context.Background(), moneyVO.USD, "XXX")
+		assert.ErrorIs(t, err, moneyVO.ErrExchangeRateNotFound)
+	})
+
+	t.Run("ファイルが更新された場合は読み込み直すこと", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "rates.json")
+		modTime := time.Now().Add(-time.Hour)
+		writeRateFile(t, path, `{"rates": [{"base": "USD", "quote": "JPY", "rate": "150"}]}`, modTime)
+
+		provider, err := exchangerate.NewFileRateProvider(path)
+		assert.NoError(t, err)
+
+		writeRateFile(t, path, `{"rates": [{"base": "USD", "quote": "JPY", "rate": "155"}]}`, modTime.Add(time.Minute))
+
+		rate, err := provider.Quote(context.Background(), moneyVO.USD, moneyVO.JPY)
+		assert.NoError(t, err)
+		assert.Equal(t, "155", rate.Rate())
+	})
+
+	t.Run("ファイルが存在しない場合はエラーを返すこと", func(t *testing.T) {
+		t.Parallel()
+		provider, err := exchangerate.NewFileRateProvider(filepath.Join(t.TempDir(), "missing.json"))
+		assert.Error(t, err)
+		assert.Nil(t, provider)
+	})
+
+	t.Run("ファイルが JSON として不正な場合はエラーを返すこと", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "rates.json")
+		writeRateFile(t, path, `invalid`, time.Now())
+
+		provider, err := exchangerate.NewFileRateProvider(path)
+		assert.Error(t, err)
+		assert.Nil(t, provider)
+	})
+}
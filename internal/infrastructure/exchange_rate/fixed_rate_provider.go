@@ -0,0 +1,29 @@
+package exchangerate
+
+import (
+	"context"
+
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// 固定レートのテーブルです。外部のレート配信に接続しない環境で使用します。
+var defaultRates = rateTable{
+	{base: moneyVO.USD, quote: moneyVO.JPY}: "150",
+	{base: "EUR", quote: moneyVO.JPY}:       "162",
+	{base: "GBP", quote: moneyVO.JPY}:       "190",
+	{base: "EUR", quote: moneyVO.USD}:       "1.08",
+	{base: "GBP", quote: moneyVO.USD}:       "1.27",
+	{base: "GBP", quote: "EUR"}:             "1.17",
+}
+
+type fixedRateProvider struct {
+	rates rateTable
+}
+
+func NewFixedRateProvider() moneyVO.IExchangeRateProvider {
+	return &fixedRateProvider{rates: defaultRates}
+}
+
+func (p *fixedRateProvider) Quote(ctx context.Context, base, quote string) (*moneyVO.ExchangeRate, error) {
+	return p.rates.lookup(base, quote)
+}
@@ -0,0 +1,60 @@
+package exchangerate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	exchangerate "github.com/u104rak1/pocgo/internal/infrastructure/exchange_rate"
+)
+
+func TestFixedRateProvider_Quote(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		quote    string
+		wantRate string
+		errMsg   string
+	}{
+		{
+			name:     "テーブルにある通貨ペアのレートを取得できること",
+			base:     moneyVO.USD,
+			quote:    moneyVO.JPY,
+			wantRate: "150",
+			errMsg:   "",
+		},
+		{
+			name:     "逆方向の通貨ペアしかない場合は、逆数のレートを取得できること",
+			base:     moneyVO.JPY,
+			quote:    moneyVO.USD,
+			wantRate: "0.006666666667",
+			errMsg:   "",
+		},
+		{
+			name:   "テーブルにない通貨ペアの場合はエラーを返すこと",
+			base:   moneyVO.JPY,
+			quote:  "XXX",
+			errMsg: moneyVO.ErrExchangeRateNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			provider := exchangerate.NewFixedRateProvider()
+
+			rate, err := provider.Quote(context.Background(), tt.base, tt.quote)
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				assert.Nil(t, rate)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.base, rate.Base())
+				assert.Equal(t, tt.quote, rate.Quote())
+				assert.Equal(t, tt.wantRate, rate.Rate())
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package exchangerate
+
+import (
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+type currencyPair struct {
+	base  string
+	quote string
+}
+
+// 通貨ペアごとのレートを保持するテーブルです。
+type rateTable map[currencyPair]string
+
+// base/quote のレートを返します。テーブルに quote/base しかない場合は、その逆数を返します。
+func (t rateTable) lookup(base, quote string) (*moneyVO.ExchangeRate, error) {
+	if rate, ok := t[currencyPair{base: base, quote: quote}]; ok {
+		return moneyVO.NewExchangeRate(base, quote, rate)
+	}
+	if rate, ok := t[currencyPair{base: quote, quote: base}]; ok {
+		inverse, err := moneyVO.NewExchangeRate(quote, base, rate)
+		if err != nil {
+			return nil, err
+		}
+		return inverse.Inverse()
+	}
+	return nil, moneyVO.ErrExchangeRateNotFound
+}
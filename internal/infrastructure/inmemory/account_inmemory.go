@@ -2,48 +2,108 @@ package inmemory
 
 import (
 	"context"
-	"sync"
+	"sort"
+	"time"
 
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
 type accountInMemoryRepository struct {
-	mu       sync.RWMutex
-	accounts map[string]*accountDomain.Account
+	store    *Store
+	accounts *table[accountDomain.Account]
 }
 
-func NewAccountInMemoryRepository() accountDomain.IAccountRepository {
+func NewAccountInMemoryRepository(store *Store) accountDomain.IAccountRepository {
 	return &accountInMemoryRepository{
-		accounts: make(map[string]*accountDomain.Account),
+		store:    store,
+		accounts: newTable(store, "accounts", jsonCodec(toAccountRecord, fromAccountRecord)),
 	}
 }
 
+type accountRecord struct {
+	ID           string    `json:"id"`
+	UserID       string    `json:"userId"`
+	Name         string    `json:"name"`
+	PasswordHash string    `json:"passwordHash"`
+	Currency     string    `json:"currency"`
+	Amount       int64     `json:"amount"`
+	HeldAmount   int64     `json:"heldAmount"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+	Version      int64     `json:"version"`
+}
+
+func toAccountRecord(account *accountDomain.Account) accountRecord {
+	return accountRecord{
+		ID:           account.IDString(),
+		UserID:       account.UserIDString(),
+		Name:         account.Name(),
+		PasswordHash: account.PasswordHash(),
+		Currency:     account.Balance().Currency(),
+		Amount:       account.Balance().Amount(),
+		HeldAmount:   account.HeldBalance().Amount(),
+		UpdatedAt:    account.UpdatedAt(),
+		Version:      account.Version(),
+	}
+}
+
+func fromAccountRecord(r accountRecord) (*accountDomain.Account, error) {
+	return accountDomain.Reconstruct(r.ID, r.UserID, r.Name, r.PasswordHash, r.Currency, r.Amount, r.HeldAmount, r.UpdatedAt, r.Version)
+}
+
+// 口座ごとに読み込んだ時点のバージョンと比較し、一致する場合のみ保存します（楽観的排他制御）。
+// 呼び出し元とエンティティを共有しないように、コピーを保存します。
 func (r *accountInMemoryRepository) Save(ctx context.Context, account *accountDomain.Account) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	r.accounts[account.IDString()] = account
-	return nil
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		stored, exists := r.accounts.get(ctx, account.IDString())
+		if exists && stored.Version() != account.Version() {
+			return accountDomain.ErrConcurrentModification
+		}
+		account.IncrementVersion()
+		r.accounts.put(ctx, account.IDString(), *account)
+		return nil
+	})
 }
 
 func (r *accountInMemoryRepository) FindByID(ctx context.Context, id idVO.AccountID) (*accountDomain.Account, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	account, exists := r.accounts[id.String()]
+	account, exists := r.accounts.get(ctx, id.String())
 	if !exists {
 		return nil, nil
 	}
-	return account, nil
+	return &account, nil
+}
+
+func (r *accountInMemoryRepository) ListByUserID(ctx context.Context, userID idVO.UserID) ([]*accountDomain.Account, error) {
+	accounts := []*accountDomain.Account{}
+	for _, account := range r.accounts.all(ctx) {
+		if account.UserIDString() == userID.String() {
+			found := account
+			accounts = append(accounts, &found)
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].IDString() < accounts[j].IDString()
+	})
+	return accounts, nil
 }
 
 func (r *accountInMemoryRepository) CountByUserID(ctx context.Context, userID idVO.UserID) (int, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
 	count := 0
-	for _, account := range r.accounts {
+	for _, account := range r.accounts.all(ctx) {
 		if account.UserIDString() == userID.String() {
 			count++
 		}
 	}
 	return count, nil
 }
+
+func (r *accountInMemoryRepository) Delete(ctx context.Context, account *accountDomain.Account) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		stored, exists := r.accounts.get(ctx, account.IDString())
+		if !exists || stored.Version() != account.Version() {
+			return accountDomain.ErrConcurrentModification
+		}
+		r.accounts.delete(ctx, account.IDString())
+		return nil
+	})
+}
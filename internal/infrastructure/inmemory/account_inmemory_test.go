@@ -0,0 +1,119 @@
+package inmemory_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+	transactionlimit "github.com/u104rak1/pocgo/internal/infrastructure/transaction_limit"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestAccountInMemoryRepository_Save_ConcurrentModification(t *testing.T) {
+	ctx := context.Background()
+	repo := inmemory.NewAccountInMemoryRepository(inmemory.NewStore())
+	account, err := accountDomain.New(idVO.NewUserIDForTest("user"), 1000, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, account))
+
+	first, err := repo.FindByID(ctx, account.ID())
+	assert.NoError(t, err)
+	second, err := repo.FindByID(ctx, account.ID())
+	assert.NoError(t, err)
+
+	assert.NoError(t, first.Withdrawal(100, moneyVO.JPY))
+	assert.NoError(t, repo.Save(ctx, first))
+
+	assert.NoError(t, second.Withdrawal(100, moneyVO.JPY))
+	assert.ErrorIs(t, repo.Save(ctx, second), accountDomain.ErrConcurrentModification)
+
+	stored, err := repo.FindByID(ctx, account.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(900), stored.Balance().Amount())
+}
+
+func TestExecuteTransaction_ParallelWithdrawals(t *testing.T) {
+	const (
+		initialBalance = int64(10000)
+		amount         = "100"
+		requests       = 20
+		password       = "1234"
+	)
+	ctx := context.Background()
+	store := inmemory.NewStore()
+	userID := idVO.NewUserIDForTest("user")
+
+	accountRepo := inmemory.NewAccountInMemoryRepository(store)
+	transactionRepo := inmemory.NewTransactionInMemoryRepository(store)
+	ledgerRepo := inmemory.NewLedgerInMemoryRepository(store, accountRepo)
+	eventRepo := inmemory.NewEventInMemoryRepository(store)
+	accountServ := accountDomain.NewService(accountRepo, lockoutDomain.NewService(inmemory.NewLockoutInMemoryRepository(store), timer.Now), eventRepo)
+	limitServ := limitDomain.NewService(inmemory.NewAccountLimitInMemoryRepository(store), transactionlimit.NewFixedLimitProvider())
+	uc := transactionApp.NewExecuteTransactionUsecase(
+		accountServ,
+		transactionDomain.NewService(accountRepo, transactionRepo, ledgerRepo, nil, limitServ, eventRepo),
+		payeeDomain.NewService(inmemory.NewPayeeInMemoryRepository(store), inmemory.NewUserInMemoryRepository(store)),
+		inmemory.NewIdempotencyKeyInMemoryRepository(store),
+		inmemory.NewUnitOfWorkInMemoryWithResult[transactionDomain.Transaction](store),
+	)
+
+	account, err := accountDomain.New(userID, initialBalance, "For work", password, moneyVO.JPY)
+	assert.NoError(t, err)
+	assert.NoError(t, accountRepo.Save(ctx, account))
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		conflicts int
+	)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := uc.Run(ctx, transactionApp.ExecuteTransactionCommand{
+				UserID:        userID.String(),
+				AccountID:     account.IDString(),
+				Password:      password,
+				OperationType: transactionDomain.Withdrawal,
+				Amount:        amount,
+				Currency:      moneyVO.JPY,
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				succeeded++
+			case errors.Is(err, accountDomain.ErrConcurrentModification):
+				conflicts++
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 競合したリクエストは失敗し、成功したリクエストの出金だけが残高に反映されていること（更新が失われていないこと）を確認する
+	stored, err := accountRepo.FindByID(ctx, account.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, requests, succeeded+conflicts)
+	assert.GreaterOrEqual(t, succeeded, 1)
+	assert.Equal(t, initialBalance-int64(succeeded)*100, stored.Balance().Amount())
+	assert.Equal(t, int64(succeeded)+1, stored.Version())
+
+	// 競合したリクエストのイベントは取引と共にロールバックされ、成功したリクエストのイベントだけが残ること
+	events, err := eventRepo.ListUnpublished(ctx, requests, nil)
+	assert.NoError(t, err)
+	assert.Len(t, events, succeeded)
+}
@@ -0,0 +1,67 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type accountLimitInMemoryRepository struct {
+	store         *Store
+	accountLimits *table[limitDomain.AccountLimit]
+}
+
+func NewAccountLimitInMemoryRepository(store *Store) limitDomain.IAccountLimitRepository {
+	return &accountLimitInMemoryRepository{
+		store:         store,
+		accountLimits: newTable(store, "account_limits", jsonCodec(toAccountLimitRecord, fromAccountLimitRecord)),
+	}
+}
+
+type accountLimitRecord struct {
+	AccountID      string    `json:"accountId"`
+	OperationType  string    `json:"operationType"`
+	PerTransaction int64     `json:"perTransaction"`
+	Daily          int64     `json:"daily"`
+	Monthly        int64     `json:"monthly"`
+	Currency       string    `json:"currency"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+func toAccountLimitRecord(accountLimit *limitDomain.AccountLimit) accountLimitRecord {
+	limits := accountLimit.Limits()
+	return accountLimitRecord{
+		AccountID:      accountLimit.AccountIDString(),
+		OperationType:  accountLimit.OperationType(),
+		PerTransaction: limits.PerTransaction().Amount(),
+		Daily:          limits.Daily().Amount(),
+		Monthly:        limits.Monthly().Amount(),
+		Currency:       limits.Currency(),
+		UpdatedAt:      accountLimit.UpdatedAt(),
+	}
+}
+
+func fromAccountLimitRecord(r accountLimitRecord) (*limitDomain.AccountLimit, error) {
+	return limitDomain.Reconstruct(r.AccountID, r.OperationType, r.PerTransaction, r.Daily, r.Monthly, r.Currency, r.UpdatedAt)
+}
+
+func accountLimitKey(accountID, operationType string) string {
+	return accountID + "/" + operationType
+}
+
+func (r *accountLimitInMemoryRepository) Save(ctx context.Context, accountLimit *limitDomain.AccountLimit) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.accountLimits.put(ctx, accountLimitKey(accountLimit.AccountIDString(), accountLimit.OperationType()), *accountLimit)
+		return nil
+	})
+}
+
+func (r *accountLimitInMemoryRepository) Find(ctx context.Context, accountID idVO.AccountID, operationType string) (*limitDomain.AccountLimit, error) {
+	accountLimit, exists := r.accountLimits.get(ctx, accountLimitKey(accountID.String(), operationType))
+	if !exists {
+		return nil, nil
+	}
+	return &accountLimit, nil
+}
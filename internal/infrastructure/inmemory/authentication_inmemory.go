@@ -2,43 +2,52 @@ package inmemory
 
 import (
 	"context"
-	"sync"
 
 	authDomain "github.com/u104rak1/pocgo/internal/domain/authentication"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
 type authenticationInMemoryRepository struct {
-	mu              sync.RWMutex
-	authentications map[string]*authDomain.Authentication
+	store           *Store
+	authentications *table[authDomain.Authentication]
 }
 
-func NewAuthenticationInMemoryRepository() authDomain.IAuthenticationRepository {
+func NewAuthenticationInMemoryRepository(store *Store) authDomain.IAuthenticationRepository {
 	return &authenticationInMemoryRepository{
-		authentications: make(map[string]*authDomain.Authentication),
+		store:           store,
+		authentications: newTable(store, "authentications", jsonCodec(toAuthenticationRecord, fromAuthenticationRecord)),
 	}
 }
 
+type authenticationRecord struct {
+	UserID       string `json:"userId"`
+	PasswordHash string `json:"passwordHash"`
+}
+
+func toAuthenticationRecord(authentication *authDomain.Authentication) authenticationRecord {
+	return authenticationRecord{UserID: authentication.UserIDString(), PasswordHash: authentication.PasswordHash()}
+}
+
+func fromAuthenticationRecord(r authenticationRecord) (*authDomain.Authentication, error) {
+	return authDomain.Reconstruct(r.UserID, r.PasswordHash)
+}
+
 func (r *authenticationInMemoryRepository) Save(ctx context.Context, authentication *authDomain.Authentication) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	r.authentications[authentication.UserIDString()] = authentication
-	return nil
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.authentications.put(ctx, authentication.UserIDString(), *authentication)
+		return nil
+	})
 }
 
 func (r *authenticationInMemoryRepository) FindByUserID(ctx context.Context, userID idVO.UserID) (*authDomain.Authentication, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	auth, exists := r.authentications[userID.String()]
+	auth, exists := r.authentications.get(ctx, userID.String())
 	if !exists {
 		return nil, nil
 	}
-	return auth, nil
+	return &auth, nil
 }
 
 func (r *authenticationInMemoryRepository) ExistsByUserID(ctx context.Context, userID idVO.UserID) (bool, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	_, exists := r.authentications[userID.String()]
+	_, exists := r.authentications.get(ctx, userID.String())
 	return exists, nil
 }
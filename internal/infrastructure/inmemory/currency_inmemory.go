@@ -0,0 +1,37 @@
+package inmemory
+
+import (
+	"context"
+
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// インメモリモードで利用する通貨の静的テーブルです。通貨マスタのシードと同じ内容を保持します。
+var currencyTable = []struct {
+	code     string
+	exponent int
+	symbol   string
+}{
+	{code: "EUR", exponent: 2, symbol: "€"},
+	{code: "GBP", exponent: 2, symbol: "£"},
+	{code: moneyVO.JPY, exponent: 0, symbol: "¥"},
+	{code: moneyVO.USD, exponent: 2, symbol: "$"},
+}
+
+type currencyInMemoryRepository struct{}
+
+func NewCurrencyInMemoryRepository() moneyVO.ICurrencyRepository {
+	return &currencyInMemoryRepository{}
+}
+
+func (r *currencyInMemoryRepository) FindAll(ctx context.Context) ([]*moneyVO.Currency, error) {
+	currencies := make([]*moneyVO.Currency, 0, len(currencyTable))
+	for _, c := range currencyTable {
+		currency, err := moneyVO.NewCurrency(c.code, c.exponent, c.symbol)
+		if err != nil {
+			return nil, err
+		}
+		currencies = append(currencies, currency)
+	}
+	return currencies, nil
+}
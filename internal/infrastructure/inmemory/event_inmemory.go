@@ -0,0 +1,74 @@
+package inmemory
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+)
+
+type eventInMemoryRepository struct {
+	store  *Store
+	events *table[eventDomain.Event]
+}
+
+// 行は追加順を保って保持する為、記録した順に取得できます。発行日時を更新しても順序は変わりません。
+func NewEventInMemoryRepository(store *Store) eventDomain.IEventRepository {
+	return &eventInMemoryRepository{
+		store:  store,
+		events: newTable(store, "outbox", jsonCodec(toEventRecord, fromEventRecord)),
+	}
+}
+
+type eventRecord struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+	PublishedAt   *time.Time      `json:"publishedAt,omitempty"`
+}
+
+func toEventRecord(event *eventDomain.Event) eventRecord {
+	return eventRecord{
+		ID:            event.IDString(),
+		Type:          event.Type(),
+		AggregateType: event.AggregateType(),
+		AggregateID:   event.AggregateID(),
+		Payload:       event.Payload(),
+		OccurredAt:    event.OccurredAt(),
+		PublishedAt:   event.PublishedAt(),
+	}
+}
+
+func fromEventRecord(r eventRecord) (*eventDomain.Event, error) {
+	return eventDomain.Reconstruct(r.ID, r.Type, r.AggregateType, r.AggregateID, r.Payload, r.OccurredAt, r.PublishedAt)
+}
+
+func (r *eventInMemoryRepository) Save(ctx context.Context, event *eventDomain.Event) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.events.put(ctx, event.IDString(), *event)
+		return nil
+	})
+}
+
+func (r *eventInMemoryRepository) ListUnpublished(ctx context.Context, limit int, excluded []eventDomain.Aggregate) ([]*eventDomain.Event, error) {
+	excludedSet := make(map[eventDomain.Aggregate]bool, len(excluded))
+	for _, aggregate := range excluded {
+		excludedSet[aggregate] = true
+	}
+
+	events := []*eventDomain.Event{}
+	for _, event := range r.events.all(ctx) {
+		if len(events) >= limit {
+			break
+		}
+		if !event.IsPublished() && !excludedSet[event.Aggregate()] {
+			found := event
+			events = append(events, &found)
+		}
+	}
+	return events, nil
+}
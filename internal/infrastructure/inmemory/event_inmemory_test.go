@@ -0,0 +1,60 @@
+package inmemory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestEventInMemoryRepository(t *testing.T) {
+	ctx := context.Background()
+	store := inmemory.NewStore()
+	repo := inmemory.NewEventInMemoryRepository(store)
+
+	newEvent := func(seed string) *eventDomain.Event {
+		event, err := eventDomain.New(eventDomain.UserSignedUp{UserID: idVO.NewUserIDForTest(seed).String()}, timer.GetFixedDate())
+		assert.NoError(t, err)
+		return event
+	}
+	first, second, third := newEvent("first"), newEvent("second"), newEvent("third")
+	for _, event := range []*eventDomain.Event{first, second, third} {
+		assert.NoError(t, repo.Save(ctx, event))
+	}
+
+	// ロールバックしたトランザクションで記録したイベントは残らない
+	rolledBack := newEvent("rolled-back")
+	errRollback := errors.New("rollback")
+	err := inmemory.NewUnitOfWorkInMemory(store).RunInTx(ctx, func(ctx context.Context) error {
+		assert.NoError(t, repo.Save(ctx, rolledBack))
+		return errRollback
+	})
+	assert.ErrorIs(t, err, errRollback)
+
+	// 発行済みにしたイベントは取得せず、残りは記録した順に取得する
+	second.MarkPublished(timer.GetFixedDate())
+	assert.NoError(t, repo.Save(ctx, second))
+
+	events, err := repo.ListUnpublished(ctx, eventDomain.RelayBatchSize, nil)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, first.ID(), events[0].ID())
+	assert.Equal(t, third.ID(), events[1].ID())
+	assert.Equal(t, first.Payload(), events[0].Payload())
+
+	events, err = repo.ListUnpublished(ctx, 1, nil)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, first.ID(), events[0].ID())
+
+	// 除外した集約のイベントは取得しない
+	events, err = repo.ListUnpublished(ctx, eventDomain.RelayBatchSize, []eventDomain.Aggregate{first.Aggregate()})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, third.ID(), events[0].ID())
+}
@@ -0,0 +1,130 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type holdInMemoryRepository struct {
+	store *Store
+	holds *table[holdDomain.Hold]
+}
+
+func NewHoldInMemoryRepository(store *Store) holdDomain.IHoldRepository {
+	return &holdInMemoryRepository{
+		store: store,
+		holds: newTable(store, "holds", jsonCodec(toHoldRecord, fromHoldRecord)),
+	}
+}
+
+type holdRecord struct {
+	ID                string    `json:"id"`
+	AccountID         string    `json:"accountId"`
+	ReceiverAccountID *string   `json:"receiverAccountId,omitempty"`
+	Amount            int64     `json:"amount"`
+	Currency          string    `json:"currency"`
+	Status            string    `json:"status"`
+	ExpiresAt         time.Time `json:"expiresAt"`
+	CapturedAmount    *int64    `json:"capturedAmount,omitempty"`
+	TransactionID     *string   `json:"transactionId,omitempty"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+	Version           int64     `json:"version"`
+}
+
+func toHoldRecord(hold *holdDomain.Hold) holdRecord {
+	var capturedAmount *int64
+	if captured := hold.CapturedAmount(); captured != nil {
+		amount := captured.Amount()
+		capturedAmount = &amount
+	}
+	return holdRecord{
+		ID:                hold.IDString(),
+		AccountID:         hold.AccountIDString(),
+		ReceiverAccountID: hold.ReceiverAccountIDString(),
+		Amount:            hold.Amount().Amount(),
+		Currency:          hold.Amount().Currency(),
+		Status:            hold.Status(),
+		ExpiresAt:         hold.ExpiresAt(),
+		CapturedAmount:    capturedAmount,
+		TransactionID:     hold.TransactionIDString(),
+		CreatedAt:         hold.CreatedAt(),
+		UpdatedAt:         hold.UpdatedAt(),
+		Version:           hold.Version(),
+	}
+}
+
+func fromHoldRecord(r holdRecord) (*holdDomain.Hold, error) {
+	return holdDomain.Reconstruct(
+		r.ID, r.AccountID, r.ReceiverAccountID,
+		r.Amount, r.Currency,
+		r.Status, r.ExpiresAt,
+		r.CapturedAmount, r.TransactionID,
+		r.CreatedAt, r.UpdatedAt, r.Version,
+	)
+}
+
+// 口座と同様に、読み込んだ時点のバージョンと一致する場合のみコピーを保存します。
+func (r *holdInMemoryRepository) Save(ctx context.Context, hold *holdDomain.Hold) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		stored, exists := r.holds.get(ctx, hold.IDString())
+		if exists && stored.Version() != hold.Version() {
+			return holdDomain.ErrConcurrentModification
+		}
+		hold.IncrementVersion()
+		r.holds.put(ctx, hold.IDString(), *hold)
+		return nil
+	})
+}
+
+func (r *holdInMemoryRepository) FindByID(ctx context.Context, id idVO.HoldID) (*holdDomain.Hold, error) {
+	hold, exists := r.holds.get(ctx, id.String())
+	if !exists {
+		return nil, nil
+	}
+	return &hold, nil
+}
+
+// ID は作成順に並ぶ ULID の為、ID の降順に並べると新しい順になります。
+func (r *holdInMemoryRepository) ListByAccountID(ctx context.Context, accountID idVO.AccountID, status *string) ([]*holdDomain.Hold, error) {
+	holds := []*holdDomain.Hold{}
+	for _, hold := range r.holds.all(ctx) {
+		if hold.AccountID() != accountID {
+			continue
+		}
+		if status != nil && hold.Status() != *status {
+			continue
+		}
+		found := hold
+		holds = append(holds, &found)
+	}
+	sort.Slice(holds, func(i, j int) bool {
+		return holds[i].IDString() > holds[j].IDString()
+	})
+	return holds, nil
+}
+
+func (r *holdInMemoryRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*holdDomain.Hold, error) {
+	holds := []*holdDomain.Hold{}
+	for _, hold := range r.holds.all(ctx) {
+		if hold.IsExpired(now) {
+			found := hold
+			holds = append(holds, &found)
+		}
+	}
+	sort.Slice(holds, func(i, j int) bool {
+		ei, ej := holds[i].ExpiresAt(), holds[j].ExpiresAt()
+		if !ei.Equal(ej) {
+			return ei.Before(ej)
+		}
+		return holds[i].IDString() < holds[j].IDString()
+	})
+	if len(holds) > limit {
+		holds = holds[:limit]
+	}
+	return holds, nil
+}
@@ -0,0 +1,97 @@
+package inmemory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+// 固定の日時から expiry 後に有効期限を迎える仮押さえを作成します。
+func newHold(t *testing.T, expiry time.Duration) *holdDomain.Hold {
+	t.Helper()
+	now := timer.GetFixedDate()
+	hold, err := holdDomain.New(idVO.NewAccountIDForTest("account"), nil, 1000, moneyVO.JPY, now.Add(expiry), now)
+	assert.NoError(t, err)
+	return hold
+}
+
+func TestHoldInMemoryRepository_ListExpired(t *testing.T) {
+	ctx := context.Background()
+	repo := inmemory.NewHoldInMemoryRepository(inmemory.NewStore())
+
+	late := newHold(t, 2*time.Hour)
+	early := newHold(t, time.Hour)
+	notExpired := newHold(t, 4*time.Hour)
+	voided := newHold(t, time.Hour)
+	assert.NoError(t, voided.Void(timer.GetFixedDate()))
+	for _, hold := range []*holdDomain.Hold{late, early, notExpired, voided} {
+		assert.NoError(t, repo.Save(ctx, hold))
+	}
+
+	now := timer.GetFixedDate().Add(3 * time.Hour)
+	holds, err := repo.ListExpired(ctx, now, holdDomain.ExpireBatchSize)
+	assert.NoError(t, err)
+	assert.Len(t, holds, 2)
+	assert.Equal(t, early.ID(), holds[0].ID())
+	assert.Equal(t, late.ID(), holds[1].ID())
+
+	holds, err = repo.ListExpired(ctx, now, 1)
+	assert.NoError(t, err)
+	assert.Len(t, holds, 1)
+	assert.Equal(t, early.ID(), holds[0].ID())
+}
+
+func TestHoldInMemoryRepository_ListByAccountID(t *testing.T) {
+	ctx := context.Background()
+	repo := inmemory.NewHoldInMemoryRepository(inmemory.NewStore())
+
+	authorized := newHold(t, time.Hour)
+	voided := newHold(t, time.Hour)
+	assert.NoError(t, voided.Void(timer.GetFixedDate()))
+	for _, hold := range []*holdDomain.Hold{authorized, voided} {
+		assert.NoError(t, repo.Save(ctx, hold))
+	}
+
+	holds, err := repo.ListByAccountID(ctx, idVO.NewAccountIDForTest("account"), nil)
+	assert.NoError(t, err)
+	assert.Len(t, holds, 2)
+
+	status := holdDomain.StatusVoided
+	holds, err = repo.ListByAccountID(ctx, idVO.NewAccountIDForTest("account"), &status)
+	assert.NoError(t, err)
+	assert.Len(t, holds, 1)
+	assert.Equal(t, voided.ID(), holds[0].ID())
+
+	holds, err = repo.ListByAccountID(ctx, idVO.NewAccountIDForTest("other"), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, holds)
+}
+
+func TestHoldInMemoryRepository_Save_ConcurrentModification(t *testing.T) {
+	ctx := context.Background()
+	repo := inmemory.NewHoldInMemoryRepository(inmemory.NewStore())
+	hold := newHold(t, time.Hour)
+	assert.NoError(t, repo.Save(ctx, hold))
+
+	first, err := repo.FindByID(ctx, hold.ID())
+	assert.NoError(t, err)
+	second, err := repo.FindByID(ctx, hold.ID())
+	assert.NoError(t, err)
+
+	assert.NoError(t, first.Void(timer.GetFixedDate()))
+	assert.NoError(t, repo.Save(ctx, first))
+
+	assert.NoError(t, second.Capture(1000, idVO.NewTransactionIDForTest("transaction"), timer.GetFixedDate()))
+	assert.ErrorIs(t, repo.Save(ctx, second), holdDomain.ErrConcurrentModification)
+
+	stored, err := repo.FindByID(ctx, hold.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, holdDomain.StatusVoided, stored.Status())
+}
@@ -0,0 +1,72 @@
+package inmemory
+
+import (
+	"context"
+
+	"github.com/u104rak1/pocgo/internal/application/idempotency"
+)
+
+type idempotencyKeyInMemoryRepository struct {
+	store *Store
+	keys  *table[idempotency.IdempotencyKey]
+}
+
+func NewIdempotencyKeyInMemoryRepository(store *Store) idempotency.IIdempotencyKeyRepository {
+	return &idempotencyKeyInMemoryRepository{
+		store: store,
+		keys:  newTable(store, "idempotency_keys", jsonCodec(toIdempotencyKeyRecord, fromIdempotencyKeyRecord)),
+	}
+}
+
+// 冪等キーは公開フィールドのみを持つ為、そのまま永続化します。
+func toIdempotencyKeyRecord(key *idempotency.IdempotencyKey) idempotency.IdempotencyKey {
+	return *key
+}
+
+func fromIdempotencyKeyRecord(key idempotency.IdempotencyKey) (*idempotency.IdempotencyKey, error) {
+	return &key, nil
+}
+
+func idempotencyMapKey(userID, key string) string {
+	return userID + "/" + key
+}
+
+func (r *idempotencyKeyInMemoryRepository) Create(ctx context.Context, key *idempotency.IdempotencyKey) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		mapKey := idempotencyMapKey(key.UserID, key.Key)
+		if _, exists := r.keys.get(ctx, mapKey); exists {
+			return idempotency.ErrKeyAlreadyExists
+		}
+		r.keys.put(ctx, mapKey, *key)
+		return nil
+	})
+}
+
+func (r *idempotencyKeyInMemoryRepository) FindByUserIDAndKey(ctx context.Context, userID, key string) (*idempotency.IdempotencyKey, error) {
+	stored, exists := r.keys.get(ctx, idempotencyMapKey(userID, key))
+	if !exists {
+		return nil, nil
+	}
+	return &stored, nil
+}
+
+func (r *idempotencyKeyInMemoryRepository) SaveResponse(ctx context.Context, userID, key string, response []byte) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		mapKey := idempotencyMapKey(userID, key)
+		if stored, exists := r.keys.get(ctx, mapKey); exists {
+			stored.Response = response
+			r.keys.put(ctx, mapKey, stored)
+		}
+		return nil
+	})
+}
+
+func (r *idempotencyKeyInMemoryRepository) DeletePending(ctx context.Context, userID, key string) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		mapKey := idempotencyMapKey(userID, key)
+		if stored, exists := r.keys.get(ctx, mapKey); exists && stored.Response == nil {
+			r.keys.delete(ctx, mapKey)
+		}
+		return nil
+	})
+}
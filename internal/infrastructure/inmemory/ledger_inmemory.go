@@ -0,0 +1,163 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type ledgerInMemoryRepository struct {
+	store       *Store
+	entries     *table[ledgerDomain.Entry]
+	accountRepo accountDomain.IAccountRepository
+}
+
+// 残高の照合に口座を参照する為、口座のリポジトリを受け取ります。
+// インメモリモードでは口座は残高0で作成され、以降の残高の変更は全て記帳される為、記帳の無い口座は照合の対象外とします。
+func NewLedgerInMemoryRepository(store *Store, accountRepository accountDomain.IAccountRepository) ledgerDomain.ILedgerRepository {
+	return &ledgerInMemoryRepository{
+		store:       store,
+		entries:     newTable(store, "ledger_entries", jsonCodec(toEntryRecord, fromEntryRecord)),
+		accountRepo: accountRepository,
+	}
+}
+
+type entryRecord struct {
+	TransactionID string          `json:"transactionId"`
+	Postings      []postingRecord `json:"postings"`
+	PostedAt      time.Time       `json:"postedAt"`
+}
+
+type postingRecord struct {
+	AccountID     *string `json:"accountId,omitempty"`
+	SystemAccount *string `json:"systemAccount,omitempty"`
+	Side          string  `json:"side"`
+	Amount        int64   `json:"amount"`
+	Currency      string  `json:"currency"`
+}
+
+func toEntryRecord(entry *ledgerDomain.Entry) entryRecord {
+	postings := make([]postingRecord, 0, len(entry.Postings()))
+	for _, p := range entry.Postings() {
+		postings = append(postings, postingRecord{
+			AccountID:     p.AccountIDString(),
+			SystemAccount: p.SystemAccount(),
+			Side:          p.Side(),
+			Amount:        p.Amount().Amount(),
+			Currency:      p.Amount().Currency(),
+		})
+	}
+	return entryRecord{TransactionID: entry.TransactionIDString(), Postings: postings, PostedAt: entry.PostedAt()}
+}
+
+func fromEntryRecord(r entryRecord) (*ledgerDomain.Entry, error) {
+	transactionID, err := idVO.TransactionIDFromString(r.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+	postings := make([]*ledgerDomain.Posting, 0, len(r.Postings))
+	for _, p := range r.Postings {
+		var posting *ledgerDomain.Posting
+		if p.AccountID != nil {
+			accountID, err := idVO.AccountIDFromString(*p.AccountID)
+			if err != nil {
+				return nil, err
+			}
+			posting, err = ledgerDomain.NewAccountPosting(accountID, p.Side, p.Amount, p.Currency)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			var systemAccount string
+			if p.SystemAccount != nil {
+				systemAccount = *p.SystemAccount
+			}
+			posting, err = ledgerDomain.NewSystemPosting(systemAccount, p.Side, p.Amount, p.Currency)
+			if err != nil {
+				return nil, err
+			}
+		}
+		postings = append(postings, posting)
+	}
+	return ledgerDomain.NewEntry(transactionID, postings, r.PostedAt)
+}
+
+func (r *ledgerInMemoryRepository) Save(ctx context.Context, entry *ledgerDomain.Entry) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.entries.insert(ctx, *entry)
+		return nil
+	})
+}
+
+func (r *ledgerInMemoryRepository) BalanceByAccountID(ctx context.Context, accountID idVO.AccountID, currency string) (int64, error) {
+	var balance int64
+	for _, e := range r.entries.all(ctx) {
+		for _, p := range e.Postings() {
+			if p.AccountID() != nil && *p.AccountID() == accountID && p.Amount().Currency() == currency {
+				balance += p.SignedAmount()
+			}
+		}
+	}
+	return balance, nil
+}
+
+func (r *ledgerInMemoryRepository) BalanceByAccountIDBefore(ctx context.Context, accountID idVO.AccountID, currency string, before time.Time) (int64, error) {
+	var balance int64
+	for _, e := range r.entries.all(ctx) {
+		if !e.PostedAt().Before(before) {
+			continue
+		}
+		for _, p := range e.Postings() {
+			if p.AccountID() != nil && *p.AccountID() == accountID && p.Amount().Currency() == currency {
+				balance += p.SignedAmount()
+			}
+		}
+	}
+	return balance, nil
+}
+
+func (r *ledgerInMemoryRepository) ListBalanceMismatches(ctx context.Context) ([]*ledgerDomain.BalanceMismatch, error) {
+	accountIDs := make(map[string]idVO.AccountID)
+	for _, e := range r.entries.all(ctx) {
+		for _, p := range e.Postings() {
+			if p.AccountID() != nil {
+				accountIDs[p.AccountID().String()] = *p.AccountID()
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(accountIDs))
+	for k := range accountIDs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	mismatches := []*ledgerDomain.BalanceMismatch{}
+	for _, k := range keys {
+		account, err := r.accountRepo.FindByID(ctx, accountIDs[k])
+		if err != nil {
+			return nil, err
+		}
+		if account == nil {
+			continue
+		}
+		currency := account.Balance().Currency()
+		ledgerBalance, err := r.BalanceByAccountID(ctx, account.ID(), currency)
+		if err != nil {
+			return nil, err
+		}
+		if ledgerBalance != account.Balance().Amount() {
+			mismatches = append(mismatches, &ledgerDomain.BalanceMismatch{
+				AccountID:     account.IDString(),
+				Currency:      currency,
+				StoredBalance: account.Balance().Amount(),
+				LedgerBalance: ledgerBalance,
+			})
+		}
+	}
+	return mismatches, nil
+}
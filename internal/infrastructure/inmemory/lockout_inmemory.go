@@ -0,0 +1,99 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+)
+
+type lockoutInMemoryRepository struct {
+	mu       sync.Mutex
+	lockouts *table[lockoutDomain.Lockout]
+}
+
+// 失敗回数はトランザクションのロールバック後も残す必要がある為、PostgreSQL の実装と同様にストアのトランザクションには参加せず、直ちに反映します。
+func NewLockoutInMemoryRepository(store *Store) lockoutDomain.ILockoutRepository {
+	return &lockoutInMemoryRepository{
+		lockouts: newTable(store, "lockouts", jsonCodec(toLockoutRecord, fromLockoutRecord)),
+	}
+}
+
+type lockoutRecord struct {
+	Subject     string     `json:"subject"`
+	SubjectID   string     `json:"subjectId"`
+	FailedCount int        `json:"failedCount"`
+	LockedUntil *time.Time `json:"lockedUntil,omitempty"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+func toLockoutRecord(lockout *lockoutDomain.Lockout) lockoutRecord {
+	return lockoutRecord{
+		Subject:     lockout.SubjectString(),
+		SubjectID:   lockout.SubjectID(),
+		FailedCount: lockout.FailedCount(),
+		LockedUntil: lockout.LockedUntil(),
+		UpdatedAt:   lockout.UpdatedAt(),
+	}
+}
+
+func fromLockoutRecord(r lockoutRecord) (*lockoutDomain.Lockout, error) {
+	return lockoutDomain.Reconstruct(r.Subject, r.SubjectID, r.FailedCount, r.LockedUntil, r.UpdatedAt)
+}
+
+func lockoutKey(subject lockoutDomain.Subject, subjectID string) string {
+	return string(subject) + "/" + subjectID
+}
+
+func (r *lockoutInMemoryRepository) Find(ctx context.Context, subject lockoutDomain.Subject, subjectID string) (*lockoutDomain.Lockout, error) {
+	stored, exists := r.lockouts.get(ctx, lockoutKey(subject, subjectID))
+	if !exists {
+		return nil, nil
+	}
+	return &stored, nil
+}
+
+func (r *lockoutInMemoryRepository) IncrementFailedCount(ctx context.Context, subject lockoutDomain.Subject, subjectID string, now time.Time) (*lockoutDomain.Lockout, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := lockoutKey(subject, subjectID)
+	stored, exists := r.lockouts.get(ctx, key)
+	if !exists {
+		lockout, err := lockoutDomain.New(subject, subjectID, now)
+		if err != nil {
+			return nil, err
+		}
+		stored = *lockout
+	}
+	stored.IncrementFailedCount(now)
+	if err := r.lockouts.putDetached(key, stored); err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+func (r *lockoutInMemoryRepository) SaveLockedUntil(ctx context.Context, lockout *lockoutDomain.Lockout) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := lockoutKey(lockout.Subject(), lockout.SubjectID())
+	stored, exists := r.lockouts.get(ctx, key)
+	if !exists {
+		return nil
+	}
+	current := stored.LockedUntil()
+	if current != nil && lockout.LockedUntil() != nil && current.After(*lockout.LockedUntil()) {
+		return nil
+	}
+	updated, err := lockoutDomain.Reconstruct(stored.SubjectString(), stored.SubjectID(), stored.FailedCount(), lockout.LockedUntil(), lockout.UpdatedAt())
+	if err != nil {
+		return err
+	}
+	return r.lockouts.putDetached(key, *updated)
+}
+
+func (r *lockoutInMemoryRepository) Delete(ctx context.Context, subject lockoutDomain.Subject, subjectID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lockouts.deleteDetached(lockoutKey(subject, subjectID))
+}
@@ -0,0 +1,57 @@
+package inmemory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestLockoutInMemoryRepository_LockoutWindows(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		subject = lockoutDomain.SubjectAccount
+		id      = "account"
+		now     = timer.GetFixedDate()
+		clock   = func() time.Time { return now }
+	)
+	service := lockoutDomain.NewService(inmemory.NewLockoutInMemoryRepository(inmemory.NewStore()), clock)
+
+	fail := func(times int) {
+		for i := 0; i < times; i++ {
+			assert.NoError(t, service.Check(ctx, subject, id))
+			assert.NoError(t, service.RecordFailure(ctx, subject, id))
+		}
+	}
+
+	// 上限回数までは失敗してもロックされない
+	fail(lockoutDomain.MaxFailedAttempts - 1)
+	assert.NoError(t, service.Check(ctx, subject, id))
+
+	// 上限回数に達すると BaseLockoutDuration ロックされ、期限ちょうどに解除される
+	fail(1)
+	assert.ErrorIs(t, service.Check(ctx, subject, id), lockoutDomain.ErrLocked)
+	now = now.Add(lockoutDomain.BaseLockoutDuration - time.Second)
+	assert.ErrorIs(t, service.Check(ctx, subject, id), lockoutDomain.ErrLocked)
+	now = now.Add(time.Second)
+	assert.NoError(t, service.Check(ctx, subject, id))
+
+	// 解除後にもう一度失敗するとロック期間が 2 倍になる
+	fail(1)
+	now = now.Add(2*lockoutDomain.BaseLockoutDuration - time.Second)
+	assert.ErrorIs(t, service.Check(ctx, subject, id), lockoutDomain.ErrLocked)
+	now = now.Add(time.Second)
+	assert.NoError(t, service.Check(ctx, subject, id))
+
+	// 成功すると失敗回数がリセットされ、再び上限回数まで失敗できる
+	assert.NoError(t, service.RecordSuccess(ctx, subject, id))
+	fail(lockoutDomain.MaxFailedAttempts - 1)
+	assert.NoError(t, service.Check(ctx, subject, id))
+
+	// 他の対象には影響しない
+	assert.NoError(t, service.Check(ctx, lockoutDomain.SubjectUser, id))
+}
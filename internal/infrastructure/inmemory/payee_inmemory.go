@@ -0,0 +1,162 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type payeeInMemoryRepository struct {
+	store             *Store
+	payees            *table[payeeDomain.Payee]
+	receivingAccounts *table[payeeDomain.ReceivingAccount]
+}
+
+func NewPayeeInMemoryRepository(store *Store) payeeDomain.IPayeeRepository {
+	return &payeeInMemoryRepository{
+		store:             store,
+		payees:            newTable(store, "payees", jsonCodec(toPayeeRecord, fromPayeeRecord)),
+		receivingAccounts: newTable(store, "receiving_accounts", jsonCodec(toReceivingAccountRecord, fromReceivingAccountRecord)),
+	}
+}
+
+type payeeRecord struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"userId"`
+	PayeeUserID string     `json:"payeeUserId"`
+	MaskedName  string     `json:"maskedName"`
+	Nickname    *string    `json:"nickname,omitempty"`
+	ConfirmedAt *time.Time `json:"confirmedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+func toPayeeRecord(payee *payeeDomain.Payee) payeeRecord {
+	return payeeRecord{
+		ID:          payee.IDString(),
+		UserID:      payee.UserIDString(),
+		PayeeUserID: payee.PayeeUserIDString(),
+		MaskedName:  payee.MaskedName(),
+		Nickname:    payee.Nickname(),
+		ConfirmedAt: payee.ConfirmedAt(),
+		CreatedAt:   payee.CreatedAt(),
+	}
+}
+
+func fromPayeeRecord(r payeeRecord) (*payeeDomain.Payee, error) {
+	return payeeDomain.Reconstruct(r.ID, r.UserID, r.PayeeUserID, r.MaskedName, r.Nickname, r.ConfirmedAt, r.CreatedAt)
+}
+
+type receivingAccountRecord struct {
+	UserID    string    `json:"userId"`
+	Currency  string    `json:"currency"`
+	AccountID string    `json:"accountId"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func toReceivingAccountRecord(receivingAccount *payeeDomain.ReceivingAccount) receivingAccountRecord {
+	return receivingAccountRecord{
+		UserID:    receivingAccount.UserIDString(),
+		Currency:  receivingAccount.Currency(),
+		AccountID: receivingAccount.AccountIDString(),
+		UpdatedAt: receivingAccount.UpdatedAt(),
+	}
+}
+
+func fromReceivingAccountRecord(r receivingAccountRecord) (*payeeDomain.ReceivingAccount, error) {
+	return payeeDomain.ReconstructReceivingAccount(r.UserID, r.Currency, r.AccountID, r.UpdatedAt)
+}
+
+func receivingAccountKey(userID, currency string) string {
+	return userID + "/" + currency
+}
+
+func (r *payeeInMemoryRepository) Save(ctx context.Context, payee *payeeDomain.Payee) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.payees.put(ctx, payee.IDString(), *payee)
+		return nil
+	})
+}
+
+func (r *payeeInMemoryRepository) FindByID(ctx context.Context, id idVO.PayeeID) (*payeeDomain.Payee, error) {
+	payee, exists := r.payees.get(ctx, id.String())
+	if !exists {
+		return nil, nil
+	}
+	return &payee, nil
+}
+
+func (r *payeeInMemoryRepository) ListByUserID(ctx context.Context, userID idVO.UserID) ([]*payeeDomain.Payee, error) {
+	payees := []*payeeDomain.Payee{}
+	for _, payee := range r.payees.all(ctx) {
+		if payee.UserID() == userID {
+			found := payee
+			payees = append(payees, &found)
+		}
+	}
+	sort.Slice(payees, func(i, j int) bool {
+		ci, cj := payees[i].CreatedAt(), payees[j].CreatedAt()
+		if !ci.Equal(cj) {
+			return ci.Before(cj)
+		}
+		return payees[i].IDString() < payees[j].IDString()
+	})
+	return payees, nil
+}
+
+func (r *payeeInMemoryRepository) CountByUserID(ctx context.Context, userID idVO.UserID) (int, error) {
+	count := 0
+	for _, payee := range r.payees.all(ctx) {
+		if payee.UserID() == userID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *payeeInMemoryRepository) ExistsByUserIDAndPayeeUserID(ctx context.Context, userID, payeeUserID idVO.UserID) (bool, error) {
+	for _, payee := range r.payees.all(ctx) {
+		if payee.UserID() == userID && payee.PayeeUserID() == payeeUserID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *payeeInMemoryRepository) Delete(ctx context.Context, payee *payeeDomain.Payee) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.payees.delete(ctx, payee.IDString())
+		return nil
+	})
+}
+
+func (r *payeeInMemoryRepository) SaveReceivingAccount(ctx context.Context, receivingAccount *payeeDomain.ReceivingAccount) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.receivingAccounts.put(ctx, receivingAccountKey(receivingAccount.UserIDString(), receivingAccount.Currency()), *receivingAccount)
+		return nil
+	})
+}
+
+func (r *payeeInMemoryRepository) FindReceivingAccount(ctx context.Context, userID idVO.UserID, currency string) (*payeeDomain.ReceivingAccount, error) {
+	receivingAccount, exists := r.receivingAccounts.get(ctx, receivingAccountKey(userID.String(), currency))
+	if !exists {
+		return nil, nil
+	}
+	return &receivingAccount, nil
+}
+
+func (r *payeeInMemoryRepository) ListReceivingAccounts(ctx context.Context, userID idVO.UserID) ([]*payeeDomain.ReceivingAccount, error) {
+	receivingAccounts := []*payeeDomain.ReceivingAccount{}
+	for _, receivingAccount := range r.receivingAccounts.all(ctx) {
+		if receivingAccount.UserIDString() == userID.String() {
+			found := receivingAccount
+			receivingAccounts = append(receivingAccounts, &found)
+		}
+	}
+	sort.Slice(receivingAccounts, func(i, j int) bool {
+		return receivingAccounts[i].Currency() < receivingAccounts[j].Currency()
+	})
+	return receivingAccounts, nil
+}
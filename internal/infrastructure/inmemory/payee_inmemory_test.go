@@ -0,0 +1,89 @@
+package inmemory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestPayeeInMemoryRepository_Payees(t *testing.T) {
+	ctx := context.Background()
+	repo := inmemory.NewPayeeInMemoryRepository(inmemory.NewStore())
+	userID := idVO.NewUserIDForTest("user")
+
+	newPayee := func(seed string, daysAfter int) *payeeDomain.Payee {
+		payee, err := payeeDomain.New(userID, idVO.NewUserIDForTest(seed), "Sato Taro", nil, timer.GetFixedDate().AddDate(0, 0, daysAfter))
+		assert.NoError(t, err)
+		return payee
+	}
+	later := newPayee("later", 1)
+	earlier := newPayee("earlier", 0)
+	other, err := payeeDomain.New(idVO.NewUserIDForTest("other"), userID, "Yamada Hanako", nil, timer.GetFixedDate())
+	assert.NoError(t, err)
+	for _, payee := range []*payeeDomain.Payee{later, earlier, other} {
+		assert.NoError(t, repo.Save(ctx, payee))
+	}
+
+	payees, err := repo.ListByUserID(ctx, userID)
+	assert.NoError(t, err)
+	assert.Len(t, payees, 2)
+	assert.Equal(t, earlier.ID(), payees[0].ID())
+	assert.Equal(t, later.ID(), payees[1].ID())
+
+	count, err := repo.CountByUserID(ctx, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	exists, err := repo.ExistsByUserIDAndPayeeUserID(ctx, userID, later.PayeeUserID())
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	// 保存したコピーは呼び出し元での変更の影響を受けない
+	later.Confirm(timer.GetFixedDate())
+	found, err := repo.FindByID(ctx, later.ID())
+	assert.NoError(t, err)
+	assert.False(t, found.IsConfirmed())
+
+	assert.NoError(t, repo.Delete(ctx, later))
+	found, err = repo.FindByID(ctx, later.ID())
+	assert.NoError(t, err)
+	assert.Nil(t, found)
+}
+
+func TestPayeeInMemoryRepository_ReceivingAccounts(t *testing.T) {
+	ctx := context.Background()
+	repo := inmemory.NewPayeeInMemoryRepository(inmemory.NewStore())
+	userID := idVO.NewUserIDForTest("user")
+
+	newReceivingAccount := func(currency, accountSeed string) *payeeDomain.ReceivingAccount {
+		receivingAccount, err := payeeDomain.ReconstructReceivingAccount(
+			userID.String(), currency, idVO.NewAccountIDForTest(accountSeed).String(), timer.GetFixedDate(),
+		)
+		assert.NoError(t, err)
+		return receivingAccount
+	}
+	assert.NoError(t, repo.SaveReceivingAccount(ctx, newReceivingAccount(moneyVO.USD, "usd")))
+	assert.NoError(t, repo.SaveReceivingAccount(ctx, newReceivingAccount(moneyVO.JPY, "jpy")))
+	// 同じ通貨の受取口座は置き換えられる
+	assert.NoError(t, repo.SaveReceivingAccount(ctx, newReceivingAccount(moneyVO.JPY, "jpy-2")))
+
+	found, err := repo.FindReceivingAccount(ctx, userID, moneyVO.JPY)
+	assert.NoError(t, err)
+	assert.Equal(t, idVO.NewAccountIDForTest("jpy-2"), found.AccountID())
+
+	found, err = repo.FindReceivingAccount(ctx, idVO.NewUserIDForTest("other"), moneyVO.JPY)
+	assert.NoError(t, err)
+	assert.Nil(t, found)
+
+	receivingAccounts, err := repo.ListReceivingAccounts(ctx, userID)
+	assert.NoError(t, err)
+	assert.Len(t, receivingAccounts, 2)
+	assert.Equal(t, moneyVO.JPY, receivingAccounts[0].Currency())
+	assert.Equal(t, moneyVO.USD, receivingAccounts[1].Currency())
+}
@@ -0,0 +1,482 @@
+package inmemory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+var (
+	ErrCorruptedData       = errors.New("persisted data is corrupted")
+	ErrInvalidFsyncPolicy  = errors.New("invalid fsync policy, must be one of always, periodic or never")
+	ErrInvalidFsyncPeriod  = errors.New("fsync interval must be positive for the periodic fsync policy")
+	ErrStoreAlreadyOpened  = errors.New("store is already opened")
+	ErrUnknownPersistedRow = errors.New("persisted row belongs to an unknown table")
+)
+
+// 先行書き込みログを fsync する契機です。
+type FsyncPolicy string
+
+const (
+	// コミットごとに fsync します。コミットが完了した書き込みは失われません。
+	FsyncAlways FsyncPolicy = "always"
+	// FsyncInterval ごとにまとめて fsync します。障害時は直近の間隔分のコミットを失う可能性があります。
+	FsyncPeriodic FsyncPolicy = "periodic"
+	// fsync を OS に任せます。プロセスの異常終了では失われませんが、OS の障害時は失われる可能性があります。
+	FsyncNever FsyncPolicy = "never"
+)
+
+const (
+	walFileName      = "wal.log"
+	snapshotFileName = "snapshot.dat"
+)
+
+// 永続化の設定です。
+type PersistenceConfig struct {
+	// 先行書き込みログとスナップショットを保存するディレクトリ
+	Dir         string
+	FsyncPolicy FsyncPolicy
+	// FsyncPeriodic の場合に fsync する間隔
+	FsyncInterval time.Duration
+	// スナップショットを作成する間隔。0 の場合は Close 時のみ作成します。
+	SnapshotInterval time.Duration
+	// バックグラウンドでの fsync やスナップショットの作成に失敗した場合に呼び出されます。
+	OnError func(err error)
+}
+
+func (c PersistenceConfig) validate() error {
+	switch c.FsyncPolicy {
+	case FsyncAlways, FsyncNever:
+		return nil
+	case FsyncPeriodic:
+		if c.FsyncInterval <= 0 {
+			return ErrInvalidFsyncPeriod
+		}
+		return nil
+	default:
+		return ErrInvalidFsyncPolicy
+	}
+}
+
+// 先行書き込みログとスナップショットに保存する1行です。
+type persistedRow struct {
+	Table   string          `json:"table"`
+	Key     string          `json:"key"`
+	Seq     uint64          `json:"seq,omitempty"`
+	Value   json.RawMessage `json:"value,omitempty"`
+	Deleted bool            `json:"deleted,omitempty"`
+
+	value any
+}
+
+// 1回のコミットで書き込んだ行です。先行書き込みログの1レコードになります。
+type walRecord struct {
+	Rows []persistedRow `json:"rows"`
+}
+
+type snapshot struct {
+	Seq  uint64         `json:"seq"`
+	Rows []persistedRow `json:"rows"`
+}
+
+// 永続化を有効にし、データディレクトリのスナップショットと先行書き込みログを読み込みます。
+// 全てのリポジトリを作成し、テーブルを登録した後に呼び出します。
+// 読み込み時にチェックサムを検証し、書き込み途中で停止した末尾のレコードは破棄しますが、
+// それ以外の破損を検出した場合は ErrCorruptedData を返します。
+func (s *Store) Open(config PersistenceConfig) error {
+	if err := config.validate(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(config.Dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	s.txMu.Lock()
+	defer s.txMu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.wal != nil {
+		return ErrStoreAlreadyOpened
+	}
+	if err := s.loadSnapshot(filepath.Join(config.Dir, snapshotFileName)); err != nil {
+		return err
+	}
+	walSize, err := s.replayWAL(filepath.Join(config.Dir, walFileName))
+	if err != nil {
+		return err
+	}
+
+	wal, err := os.OpenFile(filepath.Join(config.Dir, walFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open write-ahead log: %w", err)
+	}
+	// 書き込み途中で停止した末尾のレコードを取り除き、続けて追記できるようにする
+	if err := wal.Truncate(walSize); err != nil {
+		wal.Close()
+		return fmt.Errorf("failed to truncate write-ahead log: %w", err)
+	}
+	if err := syncDir(config.Dir); err != nil {
+		wal.Close()
+		return err
+	}
+
+	s.config = config
+	s.wal = wal
+	s.walSize = walSize
+	s.stop = make(chan struct{})
+	s.stopped = make(chan struct{})
+	go s.runBackground()
+	return nil
+}
+
+// 前回のスナップショット以降の書き込みがある場合はスナップショットを作成し、永続化を終了します。
+func (s *Store) Close() error {
+	if s.stop == nil {
+		return nil
+	}
+	close(s.stop)
+	<-s.stopped
+
+	s.txMu.Lock()
+	defer s.txMu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.snapshot()
+	if closeErr := s.wal.Close(); closeErr != nil {
+		err = errors.Join(err, fmt.Errorf("failed to close write-ahead log: %w", closeErr))
+	}
+	s.wal = nil
+	s.stop = nil
+	return err
+}
+
+// 全てのテーブルのスナップショットを作成し、先行書き込みログを空にします。
+// 前回のスナップショット以降に書き込みがない場合は何もしません。
+func (s *Store) Snapshot() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.wal == nil {
+		return nil
+	}
+	return s.snapshot()
+}
+
+func (s *Store) runBackground() {
+	defer close(s.stopped)
+
+	var fsyncC, snapshotC <-chan time.Time
+	if s.config.FsyncPolicy == FsyncPeriodic {
+		ticker := time.NewTicker(s.config.FsyncInterval)
+		defer ticker.Stop()
+		fsyncC = ticker.C
+	}
+	if s.config.SnapshotInterval > 0 {
+		ticker := time.NewTicker(s.config.SnapshotInterval)
+		defer ticker.Stop()
+		snapshotC = ticker.C
+	}
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-fsyncC:
+			s.report(s.syncWAL())
+		case <-snapshotC:
+			s.report(s.Snapshot())
+		}
+	}
+}
+
+func (s *Store) report(err error) {
+	if err != nil && s.config.OnError != nil {
+		s.config.OnError(err)
+	}
+}
+
+func (s *Store) syncWAL() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.wal == nil {
+		return nil
+	}
+	if err := s.wal.Sync(); err != nil {
+		return fmt.Errorf("failed to sync write-ahead log: %w", err)
+	}
+	return nil
+}
+
+// コミットする行を1レコードとして先行書き込みログに追記します。Store.mu を保持した状態で呼び出します。
+// 追記に失敗した場合は、後続のレコードを読み込めるように書き込み途中のレコードを取り除きます。
+func (s *Store) appendWAL(writes map[storeTable]map[string]stagedRow) error {
+	if s.wal == nil {
+		return nil
+	}
+
+	var record walRecord
+	for t, rows := range writes {
+		for key, row := range rows {
+			persisted := persistedRow{Table: t.name(), Key: key, Seq: row.seq, Deleted: row.deleted}
+			if !row.deleted {
+				value, err := t.encode(row.value)
+				if err != nil {
+					return fmt.Errorf("failed to encode %s row: %w", t.name(), err)
+				}
+				persisted.Value = value
+			}
+			record.Rows = append(record.Rows, persisted)
+		}
+	}
+	if len(record.Rows) == 0 {
+		return nil
+	}
+	sortRows(record.Rows)
+	payload, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode write-ahead log record: %w", err)
+	}
+
+	frame := encodeFrame(payload)
+	if _, err := s.wal.Write(frame); err != nil {
+		_ = s.wal.Truncate(s.walSize)
+		return fmt.Errorf("failed to append write-ahead log: %w", err)
+	}
+	if s.config.FsyncPolicy == FsyncAlways {
+		if err := s.wal.Sync(); err != nil {
+			_ = s.wal.Truncate(s.walSize)
+			return fmt.Errorf("failed to sync write-ahead log: %w", err)
+		}
+	}
+	s.walSize += int64(len(frame))
+	return nil
+}
+
+// Store.mu を保持した状態で呼び出します。一時ファイルに書き込んでから置き換える為、
+// 作成中に停止しても直前のスナップショットと先行書き込みログから復元できます。
+func (s *Store) snapshot() error {
+	if s.walSize == 0 {
+		return nil
+	}
+
+	var rows []persistedRow
+	for _, t := range s.tables {
+		for _, row := range t.rows() {
+			value, err := t.encode(row.value)
+			if err != nil {
+				return fmt.Errorf("failed to encode %s row: %w", t.name(), err)
+			}
+			row.Value = value
+			rows = append(rows, row)
+		}
+	}
+	sortRows(rows)
+	payload, err := json.Marshal(snapshot{Seq: s.seq.Load(), Rows: rows})
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+
+	path := filepath.Join(s.config.Dir, snapshotFileName)
+	tmpPath := path + ".tmp"
+	if err := writeFileSync(tmpPath, encodeFrame(payload)); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to replace snapshot: %w", err)
+	}
+	if err := syncDir(s.config.Dir); err != nil {
+		return err
+	}
+
+	// スナップショットに含まれる為、先行書き込みログは不要になる
+	if err := s.wal.Truncate(0); err != nil {
+		return fmt.Errorf("failed to truncate write-ahead log: %w", err)
+	}
+	if err := s.wal.Sync(); err != nil {
+		return fmt.Errorf("failed to sync write-ahead log: %w", err)
+	}
+	s.walSize = 0
+	return nil
+}
+
+// スナップショットは置き換えで作成される為、書き込み途中の状態は存在しません。読み込めない場合は全て破損として扱います。
+func (s *Store) loadSnapshot(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	payloads, valid, err := decodeFrames(data)
+	if err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+	if len(payloads) != 1 || valid != int64(len(data)) {
+		return fmt.Errorf("%w: snapshot is incomplete", ErrCorruptedData)
+	}
+	var snap snapshot
+	if err := json.Unmarshal(payloads[0], &snap); err != nil {
+		return fmt.Errorf("%w: snapshot: %v", ErrCorruptedData, err)
+	}
+	if err := s.restore(snap.Rows); err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+	if snap.Seq > s.seq.Load() {
+		s.seq.Store(snap.Seq)
+	}
+	return nil
+}
+
+// 先行書き込みログのレコードを順に反映し、有効なレコードの末尾の位置を返します。
+func (s *Store) replayWAL(path string) (int64, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read write-ahead log: %w", err)
+	}
+
+	payloads, valid, err := decodeFrames(data)
+	if err != nil {
+		return 0, fmt.Errorf("write-ahead log: %w", err)
+	}
+	for i, payload := range payloads {
+		var record walRecord
+		if err := json.Unmarshal(payload, &record); err != nil {
+			return 0, fmt.Errorf("%w: write-ahead log record %d: %v", ErrCorruptedData, i, err)
+		}
+		if err := s.restore(record.Rows); err != nil {
+			return 0, fmt.Errorf("write-ahead log record %d: %w", i, err)
+		}
+	}
+	return valid, nil
+}
+
+// Store.mu を保持した状態で呼び出します。
+func (s *Store) restore(rows []persistedRow) error {
+	for _, row := range rows {
+		t, exists := s.tables[row.Table]
+		if !exists {
+			return fmt.Errorf("%w: %s", ErrUnknownPersistedRow, row.Table)
+		}
+		staged := stagedRow{seq: row.Seq, deleted: row.Deleted}
+		if !row.Deleted {
+			value, err := t.decode(row.Value)
+			if err != nil {
+				return fmt.Errorf("failed to decode %s row %q: %w", row.Table, row.Key, err)
+			}
+			staged.value = value
+		}
+		t.apply(row.Key, staged)
+		if row.Seq > s.seq.Load() {
+			s.seq.Store(row.Seq)
+		}
+	}
+	return nil
+}
+
+func sortRows(rows []persistedRow) {
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Table != rows[j].Table {
+			return rows[i].Table < rows[j].Table
+		}
+		if rows[i].Seq != rows[j].Seq {
+			return rows[i].Seq < rows[j].Seq
+		}
+		return rows[i].Key < rows[j].Key
+	})
+}
+
+// レコードの形式です。ヘッダーにはペイロードの長さとチェックサム、ヘッダー自体のチェックサムを含めます。
+//
+//	| length (4) | payload crc (4) | header crc (4) | payload (length) |
+const frameHeaderSize = 12
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+func encodeFrame(payload []byte) []byte {
+	frame := make([]byte, frameHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(frame[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(frame[4:8], crc32.Checksum(payload, crcTable))
+	binary.BigEndian.PutUint32(frame[8:12], crc32.Checksum(frame[0:8], crcTable))
+	copy(frame[frameHeaderSize:], payload)
+	return frame
+}
+
+// data を先頭から読み込み、ペイロードと有効なレコードの末尾の位置を返します。
+// 書き込み途中で停止した末尾のレコード（長さが足りない、または末尾が0で埋められている）はそこで読み込みを終えます。
+// それ以外でチェックサムが一致しない場合は ErrCorruptedData を返します。
+func decodeFrames(data []byte) ([][]byte, int64, error) {
+	var payloads [][]byte
+	offset := 0
+	for offset < len(data) {
+		rest := data[offset:]
+		if len(rest) < frameHeaderSize {
+			break
+		}
+		if crc32.Checksum(rest[0:8], crcTable) != binary.BigEndian.Uint32(rest[8:12]) {
+			if isZero(rest) {
+				break
+			}
+			return nil, 0, fmt.Errorf("%w: invalid header at offset %d", ErrCorruptedData, offset)
+		}
+		end := frameHeaderSize + int(binary.BigEndian.Uint32(rest[0:4]))
+		if end > len(rest) {
+			break
+		}
+		payload := rest[frameHeaderSize:end]
+		if crc32.Checksum(payload, crcTable) != binary.BigEndian.Uint32(rest[4:8]) {
+			if end == len(rest) {
+				break
+			}
+			return nil, 0, fmt.Errorf("%w: checksum mismatch at offset %d", ErrCorruptedData, offset)
+		}
+		payloads = append(payloads, payload)
+		offset += end
+	}
+	return payloads, int64(offset), nil
+}
+
+func isZero(data []byte) bool {
+	return len(bytes.Trim(data, "\x00")) == 0
+}
+
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Base(path), err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", filepath.Base(path), err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to sync %s: %w", filepath.Base(path), err)
+	}
+	return f.Close()
+}
+
+// ファイルの作成や置き換えを永続化する為に、ディレクトリを fsync します。
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("failed to open data directory: %w", err)
+	}
+	defer d.Close()
+	if err := d.Sync(); err != nil {
+		return fmt.Errorf("failed to sync data directory: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package inmemory_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type persistentRepositories struct {
+	store       *inmemory.Store
+	user        userDomain.IUserRepository
+	account     accountDomain.IAccountRepository
+	transaction transactionDomain.ITransactionRepository
+	ledger      ledgerDomain.ILedgerRepository
+	lockout     lockoutDomain.ILockoutRepository
+}
+
+func openPersistentRepositories(t *testing.T, dir string) (*persistentRepositories, error) {
+	store := inmemory.NewStore()
+	account := inmemory.NewAccountInMemoryRepository(store)
+	r := &persistentRepositories{
+		store:       store,
+		user:        inmemory.NewUserInMemoryRepository(store),
+		account:     account,
+		transaction: inmemory.NewTransactionInMemoryRepository(store),
+		ledger:      inmemory.NewLedgerInMemoryRepository(store, account),
+		lockout:     inmemory.NewLockoutInMemoryRepository(store),
+	}
+	if err := store.Open(inmemory.PersistenceConfig{Dir: dir, FsyncPolicy: inmemory.FsyncAlways}); err != nil {
+		return nil, err
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return r, nil
+}
+
+func TestStore_Persistence(t *testing.T) {
+	tests := []struct {
+		caseName        string
+		restart         func(t *testing.T, r *persistentRepositories)
+		wantFailedCount int
+	}{
+		{
+			caseName: "Positive: 停止時に作成したスナップショットから復元できる",
+			restart: func(t *testing.T, r *persistentRepositories) {
+				assert.NoError(t, r.store.Close())
+			},
+			wantFailedCount: 1,
+		},
+		{
+			caseName:        "Positive: 異常終了した場合も先行書き込みログから復元できる",
+			restart:         func(t *testing.T, r *persistentRepositories) {},
+			wantFailedCount: 1,
+		},
+		{
+			caseName: "Positive: スナップショット後の書き込みを先行書き込みログから復元できる",
+			restart: func(t *testing.T, r *persistentRepositories) {
+				assert.NoError(t, r.store.Snapshot())
+				_, err := r.lockout.IncrementFailedCount(context.Background(), lockoutDomain.SubjectAccount, "account", timer.GetFixedDate())
+				assert.NoError(t, err)
+			},
+			wantFailedCount: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			ctx := context.Background()
+			dir := t.TempDir()
+			r, err := openPersistentRepositories(t, dir)
+			assert.NoError(t, err)
+
+			user, err := userDomain.New("sato taro", "sato@example.com")
+			assert.NoError(t, err)
+			assert.NoError(t, r.user.Save(ctx, user))
+			sender, err := accountDomain.New(user.ID(), 0, "For work", "1234", moneyVO.JPY)
+			assert.NoError(t, err)
+			receiver, err := accountDomain.New(user.ID(), 0, "For savings", "1234", moneyVO.JPY)
+			assert.NoError(t, err)
+
+			// 振込の為の入金と振込を1つのトランザクションで保存する
+			amount, currency, receiverID := int64(300), moneyVO.JPY, receiver.ID()
+			transaction, err := transactionDomain.New(sender.ID(), &receiverID, transactionDomain.Transfer, amount, currency, &amount, &currency, nil, timer.GetFixedDate())
+			assert.NoError(t, err)
+			entry, err := ledgerDomain.NewTransferEntry(transaction.ID(), sender.ID(), receiver.ID(), transaction.TransferAmount(), *transaction.ReceiverAmount(), timer.GetFixedDate())
+			assert.NoError(t, err)
+			assert.NoError(t, sender.Deposit(1000, moneyVO.JPY))
+			assert.NoError(t, sender.Withdrawal(amount, currency))
+			assert.NoError(t, receiver.Deposit(amount, currency))
+			uow := inmemory.NewUnitOfWorkInMemory(r.store)
+			assert.NoError(t, uow.RunInTx(ctx, func(ctx context.Context) error {
+				for _, account := range []*accountDomain.Account{sender, receiver} {
+					if err := r.account.Save(ctx, account); err != nil {
+						return err
+					}
+				}
+				if err := r.transaction.Save(ctx, transaction); err != nil {
+					return err
+				}
+				return r.ledger.Save(ctx, entry)
+			}))
+
+			// ロールバックした書き込みは永続化されない
+			assert.Error(t, uow.RunInTx(ctx, func(ctx context.Context) error {
+				if err := r.account.Delete(ctx, receiver); err != nil {
+					return err
+				}
+				return errors.New("rollback")
+			}))
+
+			// ロックアウトはトランザクション外で保存される
+			_, err = r.lockout.IncrementFailedCount(ctx, lockoutDomain.SubjectAccount, "account", timer.GetFixedDate())
+			assert.NoError(t, err)
+
+			tt.restart(t, r)
+
+			restored, err := openPersistentRepositories(t, dir)
+			assert.NoError(t, err)
+
+			foundUser, err := restored.user.FindByEmail(ctx, "sato@example.com")
+			assert.NoError(t, err)
+			assert.Equal(t, user.IDString(), foundUser.IDString())
+			foundSender, err := restored.account.FindByID(ctx, sender.ID())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(700), foundSender.Balance().Amount())
+			assert.Equal(t, sender.Version(), foundSender.Version())
+			foundReceiver, err := restored.account.FindByID(ctx, receiver.ID())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(300), foundReceiver.Balance().Amount())
+			foundTransaction, err := restored.transaction.FindByID(ctx, transaction.ID())
+			assert.NoError(t, err)
+			assert.Equal(t, transaction.ReceiverAccountIDString(), foundTransaction.ReceiverAccountIDString())
+			assert.True(t, transaction.TransactionAt().Equal(foundTransaction.TransactionAt()))
+			balance, err := restored.ledger.BalanceByAccountID(ctx, receiver.ID(), moneyVO.JPY)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(300), balance)
+			lockout, err := restored.lockout.Find(ctx, lockoutDomain.SubjectAccount, "account")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFailedCount, lockout.FailedCount())
+
+			// 復元後も続けて書き込み、追加順を保って永続化できる
+			second, err := accountDomain.New(user.ID(), 0, "For travel", "1234", moneyVO.JPY)
+			assert.NoError(t, err)
+			assert.NoError(t, restored.account.Save(ctx, second))
+			accounts, err := restored.account.ListByUserID(ctx, user.ID())
+			assert.NoError(t, err)
+			assert.Len(t, accounts, 3)
+		})
+	}
+}
+
+func TestStore_Open_CorruptionCheck(t *testing.T) {
+	tests := []struct {
+		caseName     string
+		corrupt      func(t *testing.T, dir string)
+		wantErr      error
+		wantAccounts int
+	}{
+		{
+			caseName:     "Positive: 書き込み途中で停止した末尾のレコードは破棄して起動できる",
+			corrupt:      func(t *testing.T, dir string) { appendFile(t, filepath.Join(dir, "wal.log"), []byte{0, 0, 1}) },
+			wantAccounts: 2,
+		},
+		{
+			caseName: "Positive: 末尾のレコードのペイロードが途中までの場合は破棄して起動できる",
+			corrupt: func(t *testing.T, dir string) {
+				path := filepath.Join(dir, "wal.log")
+				info, err := os.Stat(path)
+				assert.NoError(t, err)
+				assert.NoError(t, os.Truncate(path, info.Size()-1))
+			},
+			wantAccounts: 1,
+		},
+		{
+			caseName:     "Negative: 途中のレコードが破損している場合は ErrCorruptedData を返す",
+			corrupt:      func(t *testing.T, dir string) { flipByte(t, filepath.Join(dir, "wal.log"), 20) },
+			wantErr:      inmemory.ErrCorruptedData,
+			wantAccounts: 0,
+		},
+		{
+			caseName: "Negative: スナップショットが破損している場合は ErrCorruptedData を返す",
+			corrupt: func(t *testing.T, dir string) {
+				data, err := os.ReadFile(filepath.Join(dir, "wal.log"))
+				assert.NoError(t, err)
+				assert.NoError(t, os.WriteFile(filepath.Join(dir, "snapshot.dat"), data, 0o600))
+			},
+			wantErr:      inmemory.ErrCorruptedData,
+			wantAccounts: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			ctx := context.Background()
+			dir := t.TempDir()
+			r, err := openPersistentRepositories(t, dir)
+			assert.NoError(t, err)
+			for _, name := range []string{"For work", "For savings"} {
+				account, err := accountDomain.New(idVO.NewUserIDForTest("user"), 0, name, "1234", moneyVO.JPY)
+				assert.NoError(t, err)
+				assert.NoError(t, r.account.Save(ctx, account))
+			}
+
+			tt.corrupt(t, dir)
+
+			restored, err := openPersistentRepositories(t, dir)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+			accounts, err := restored.account.ListByUserID(ctx, idVO.NewUserIDForTest("user"))
+			assert.NoError(t, err)
+			assert.Len(t, accounts, tt.wantAccounts)
+		})
+	}
+}
+
+func TestStore_Open_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		caseName string
+		config   inmemory.PersistenceConfig
+		wantErr  error
+	}{
+		{
+			caseName: "Negative: 不明な fsync の契機の場合は ErrInvalidFsyncPolicy を返す",
+			config:   inmemory.PersistenceConfig{Dir: t.TempDir(), FsyncPolicy: "sometimes"},
+			wantErr:  inmemory.ErrInvalidFsyncPolicy,
+		},
+		{
+			caseName: "Negative: periodic で間隔が指定されていない場合は ErrInvalidFsyncPeriod を返す",
+			config:   inmemory.PersistenceConfig{Dir: t.TempDir(), FsyncPolicy: inmemory.FsyncPeriodic},
+			wantErr:  inmemory.ErrInvalidFsyncPeriod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			err := inmemory.NewStore().Open(tt.config)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func appendFile(t *testing.T, path string, data []byte) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	assert.NoError(t, err)
+	_, err = f.Write(data)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+}
+
+func flipByte(t *testing.T, path string, offset int) {
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	data[offset] ^= 0xff
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+}
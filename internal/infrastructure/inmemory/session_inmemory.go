@@ -0,0 +1,102 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type sessionInMemoryRepository struct {
+	store         *Store
+	sessions      *table[sessionDomain.Session]
+	refreshTokens *table[sessionDomain.RefreshToken]
+}
+
+// セッションとリフレッシュトークンは値として保持し、呼び出し元でのエンティティの変更が保存前に反映されないようにします。
+func NewSessionInMemoryRepository(store *Store) sessionDomain.ISessionRepository {
+	return &sessionInMemoryRepository{
+		store:         store,
+		sessions:      newTable(store, "sessions", jsonCodec(toSessionRecord, fromSessionRecord)),
+		refreshTokens: newTable(store, "refresh_tokens", jsonCodec(toRefreshTokenRecord, fromRefreshTokenRecord)),
+	}
+}
+
+type sessionRecord struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"userId"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+func toSessionRecord(session *sessionDomain.Session) sessionRecord {
+	return sessionRecord{ID: session.IDString(), UserID: session.UserIDString(), CreatedAt: session.CreatedAt(), RevokedAt: session.RevokedAt()}
+}
+
+func fromSessionRecord(r sessionRecord) (*sessionDomain.Session, error) {
+	return sessionDomain.Reconstruct(r.ID, r.UserID, r.CreatedAt, r.RevokedAt)
+}
+
+type refreshTokenRecord struct {
+	TokenHash string     `json:"tokenHash"`
+	SessionID string     `json:"sessionId"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+	UsedAt    *time.Time `json:"usedAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func toRefreshTokenRecord(refreshToken *sessionDomain.RefreshToken) refreshTokenRecord {
+	return refreshTokenRecord{
+		TokenHash: refreshToken.TokenHash(),
+		SessionID: refreshToken.SessionIDString(),
+		ExpiresAt: refreshToken.ExpiresAt(),
+		UsedAt:    refreshToken.UsedAt(),
+		CreatedAt: refreshToken.CreatedAt(),
+	}
+}
+
+func fromRefreshTokenRecord(r refreshTokenRecord) (*sessionDomain.RefreshToken, error) {
+	return sessionDomain.ReconstructRefreshToken(r.TokenHash, r.SessionID, r.ExpiresAt, r.UsedAt, r.CreatedAt)
+}
+
+func (r *sessionInMemoryRepository) Save(ctx context.Context, session *sessionDomain.Session) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.sessions.put(ctx, session.IDString(), *session)
+		return nil
+	})
+}
+
+func (r *sessionInMemoryRepository) FindByID(ctx context.Context, id idVO.SessionID) (*sessionDomain.Session, error) {
+	stored, exists := r.sessions.get(ctx, id.String())
+	if !exists {
+		return nil, nil
+	}
+	return &stored, nil
+}
+
+func (r *sessionInMemoryRepository) SaveRefreshToken(ctx context.Context, refreshToken *sessionDomain.RefreshToken) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.refreshTokens.put(ctx, refreshToken.TokenHash(), *refreshToken)
+		return nil
+	})
+}
+
+func (r *sessionInMemoryRepository) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*sessionDomain.RefreshToken, error) {
+	stored, exists := r.refreshTokens.get(ctx, tokenHash)
+	if !exists {
+		return nil, nil
+	}
+	return &stored, nil
+}
+
+func (r *sessionInMemoryRepository) MarkRefreshTokenUsed(ctx context.Context, refreshToken *sessionDomain.RefreshToken) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		stored, exists := r.refreshTokens.get(ctx, refreshToken.TokenHash())
+		if !exists || stored.UsedAt() != nil {
+			return sessionDomain.ErrRefreshTokenReused
+		}
+		r.refreshTokens.put(ctx, refreshToken.TokenHash(), *refreshToken)
+		return nil
+	})
+}
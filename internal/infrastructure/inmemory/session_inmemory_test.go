@@ -0,0 +1,31 @@
+package inmemory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+)
+
+func TestSessionInMemoryRepository_RefreshTokenReuseRevokesFamily(t *testing.T) {
+	ctx := context.Background()
+	service := sessionDomain.NewService(inmemory.NewSessionInMemoryRepository(inmemory.NewStore()))
+
+	session, first, err := service.Start(ctx, idVO.NewUserIDForTest("user"))
+	assert.NoError(t, err)
+
+	_, second, err := service.Rotate(ctx, first)
+	assert.NoError(t, err)
+	assert.NoError(t, service.EnsureActive(ctx, session.ID()))
+
+	// ローテーション済みのリフレッシュトークンを再利用するとファミリー全体が失効する
+	_, _, err = service.Rotate(ctx, first)
+	assert.ErrorIs(t, err, sessionDomain.ErrRefreshTokenReused)
+
+	_, _, err = service.Rotate(ctx, second)
+	assert.ErrorIs(t, err, sessionDomain.ErrSessionRevoked)
+	assert.ErrorIs(t, service.EnsureActive(ctx, session.ID()), sessionDomain.ErrSessionRevoked)
+}
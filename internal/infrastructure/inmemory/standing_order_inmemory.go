@@ -0,0 +1,186 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+)
+
+type standingOrderInMemoryRepository struct {
+	store      *Store
+	orders     *table[standingOrderDomain.StandingOrder]
+	executions *table[standingOrderDomain.Execution]
+}
+
+func NewStandingOrderInMemoryRepository(store *Store) standingOrderDomain.IStandingOrderRepository {
+	return &standingOrderInMemoryRepository{
+		store:      store,
+		orders:     newTable(store, "standing_orders", jsonCodec(toStandingOrderRecord, fromStandingOrderRecord)),
+		executions: newTable(store, "standing_order_executions", jsonCodec(toExecutionRecord, fromExecutionRecord)),
+	}
+}
+
+type standingOrderRecord struct {
+	ID                string     `json:"id"`
+	AccountID         string     `json:"accountId"`
+	ReceiverAccountID string     `json:"receiverAccountId"`
+	Amount            int64      `json:"amount"`
+	Currency          string     `json:"currency"`
+	Frequency         string     `json:"frequency"`
+	DayOfMonth        *int       `json:"dayOfMonth,omitempty"`
+	StartDate         time.Time  `json:"startDate"`
+	EndDate           *time.Time `json:"endDate,omitempty"`
+	MaxExecutions     *int       `json:"maxExecutions,omitempty"`
+	MaxRetries        int        `json:"maxRetries"`
+	FailurePolicy     string     `json:"failurePolicy"`
+	Status            string     `json:"status"`
+	NextRunDate       time.Time  `json:"nextRunDate"`
+	RetryCount        int        `json:"retryCount"`
+	ExecutionCount    int        `json:"executionCount"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+	Version           int64      `json:"version"`
+}
+
+func toStandingOrderRecord(order *standingOrderDomain.StandingOrder) standingOrderRecord {
+	return standingOrderRecord{
+		ID:                order.IDString(),
+		AccountID:         order.AccountIDString(),
+		ReceiverAccountID: order.ReceiverAccountIDString(),
+		Amount:            order.Amount().Amount(),
+		Currency:          order.Amount().Currency(),
+		Frequency:         order.Schedule().Frequency(),
+		DayOfMonth:        order.Schedule().DayOfMonth(),
+		StartDate:         order.StartDate(),
+		EndDate:           order.EndDate(),
+		MaxExecutions:     order.MaxExecutions(),
+		MaxRetries:        order.MaxRetries(),
+		FailurePolicy:     order.FailurePolicy(),
+		Status:            order.Status(),
+		NextRunDate:       order.NextRunDate(),
+		RetryCount:        order.RetryCount(),
+		ExecutionCount:    order.ExecutionCount(),
+		CreatedAt:         order.CreatedAt(),
+		UpdatedAt:         order.UpdatedAt(),
+		Version:           order.Version(),
+	}
+}
+
+func fromStandingOrderRecord(r standingOrderRecord) (*standingOrderDomain.StandingOrder, error) {
+	return standingOrderDomain.Reconstruct(
+		r.ID, r.AccountID, r.ReceiverAccountID,
+		r.Amount, r.Currency,
+		r.Frequency, r.DayOfMonth,
+		r.StartDate, r.EndDate, r.MaxExecutions,
+		r.MaxRetries, r.FailurePolicy, r.Status,
+		r.NextRunDate, r.RetryCount, r.ExecutionCount,
+		r.CreatedAt, r.UpdatedAt, r.Version,
+	)
+}
+
+type executionRecord struct {
+	StandingOrderID string    `json:"standingOrderId"`
+	ScheduledDate   time.Time `json:"scheduledDate"`
+	Attempt         int       `json:"attempt"`
+	Result          string    `json:"result"`
+	TransactionID   *string   `json:"transactionId,omitempty"`
+	FailureReason   *string   `json:"failureReason,omitempty"`
+	ExecutedAt      time.Time `json:"executedAt"`
+}
+
+func toExecutionRecord(execution *standingOrderDomain.Execution) executionRecord {
+	return executionRecord{
+		StandingOrderID: execution.StandingOrderIDString(),
+		ScheduledDate:   execution.ScheduledDate(),
+		Attempt:         execution.Attempt(),
+		Result:          execution.Result(),
+		TransactionID:   execution.TransactionIDString(),
+		FailureReason:   execution.FailureReason(),
+		ExecutedAt:      execution.ExecutedAt(),
+	}
+}
+
+func fromExecutionRecord(r executionRecord) (*standingOrderDomain.Execution, error) {
+	return standingOrderDomain.ReconstructExecution(r.StandingOrderID, r.ScheduledDate, r.Attempt, r.Result, r.TransactionID, r.FailureReason, r.ExecutedAt)
+}
+
+// 口座と同様に、読み込んだ時点のバージョンと一致する場合のみコピーを保存します。
+func (r *standingOrderInMemoryRepository) Save(ctx context.Context, order *standingOrderDomain.StandingOrder) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		stored, exists := r.orders.get(ctx, order.IDString())
+		if exists && stored.Version() != order.Version() {
+			return standingOrderDomain.ErrConcurrentModification
+		}
+		order.IncrementVersion()
+		r.orders.put(ctx, order.IDString(), *order)
+		return nil
+	})
+}
+
+func (r *standingOrderInMemoryRepository) FindByID(ctx context.Context, id idVO.StandingOrderID) (*standingOrderDomain.StandingOrder, error) {
+	order, exists := r.orders.get(ctx, id.String())
+	if !exists {
+		return nil, nil
+	}
+	return &order, nil
+}
+
+func (r *standingOrderInMemoryRepository) ListByAccountID(ctx context.Context, accountID idVO.AccountID) ([]*standingOrderDomain.StandingOrder, error) {
+	orders := []*standingOrderDomain.StandingOrder{}
+	for _, order := range r.orders.all(ctx) {
+		if order.AccountID() == accountID {
+			found := order
+			orders = append(orders, &found)
+		}
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].IDString() < orders[j].IDString()
+	})
+	return orders, nil
+}
+
+func (r *standingOrderInMemoryRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*standingOrderDomain.StandingOrder, error) {
+	orders := []*standingOrderDomain.StandingOrder{}
+	for _, order := range r.orders.all(ctx) {
+		if order.IsDue(now) {
+			found := order
+			orders = append(orders, &found)
+		}
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		di, dj := orders[i].NextAttemptDate(), orders[j].NextAttemptDate()
+		if !di.Equal(dj) {
+			return di.Before(dj)
+		}
+		return orders[i].IDString() < orders[j].IDString()
+	})
+	if len(orders) > limit {
+		orders = orders[:limit]
+	}
+	return orders, nil
+}
+
+func (r *standingOrderInMemoryRepository) SaveExecution(ctx context.Context, execution *standingOrderDomain.Execution) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.executions.insert(ctx, *execution)
+		return nil
+	})
+}
+
+// 実行履歴は時系列に追加される為、末尾から取得すると新しい順になります。
+func (r *standingOrderInMemoryRepository) ListExecutions(ctx context.Context, id idVO.StandingOrderID, limit int) ([]*standingOrderDomain.Execution, error) {
+	var stored []standingOrderDomain.Execution
+	for _, execution := range r.executions.all(ctx) {
+		if execution.StandingOrderID() == id {
+			stored = append(stored, execution)
+		}
+	}
+	executions := make([]*standingOrderDomain.Execution, 0, min(len(stored), limit))
+	for i := len(stored) - 1; i >= 0 && len(executions) < limit; i-- {
+		executions = append(executions, &stored[i])
+	}
+	return executions, nil
+}
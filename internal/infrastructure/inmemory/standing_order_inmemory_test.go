@@ -0,0 +1,178 @@
+package inmemory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+	transactionlimit "github.com/u104rak1/pocgo/internal/infrastructure/transaction_limit"
+	"github.com/u104rak1/pocgo/pkg/numutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func newStandingOrder(t *testing.T, dayOfMonth int) *standingOrderDomain.StandingOrder {
+	t.Helper()
+	schedule, err := standingOrderDomain.NewSchedule(standingOrderDomain.FrequencyMonthly, numutil.IntPointer(dayOfMonth))
+	assert.NoError(t, err)
+	order, err := standingOrderDomain.New(
+		idVO.NewAccountIDForTest("account"), idVO.NewAccountIDForTest("receiver"),
+		1000, moneyVO.JPY, *schedule, timer.GetFixedDate(), nil, nil,
+		0, standingOrderDomain.FailurePolicySkip, timer.GetFixedDate(),
+	)
+	assert.NoError(t, err)
+	return order
+}
+
+func TestStandingOrderInMemoryRepository_ListDue(t *testing.T) {
+	ctx := context.Background()
+	repo := inmemory.NewStandingOrderInMemoryRepository(inmemory.NewStore())
+
+	late := newStandingOrder(t, 20)
+	early := newStandingOrder(t, 10)
+	notDue := newStandingOrder(t, 28)
+	paused := newStandingOrder(t, 5)
+	assert.NoError(t, paused.Pause(timer.GetFixedDate()))
+	for _, order := range []*standingOrderDomain.StandingOrder{late, early, notDue, paused} {
+		assert.NoError(t, repo.Save(ctx, order))
+	}
+
+	now := time.Date(2021, 1, 25, 9, 0, 0, 0, time.UTC)
+	orders, err := repo.ListDue(ctx, now, standingOrderDomain.ExecuteBatchSize)
+	assert.NoError(t, err)
+	assert.Len(t, orders, 2)
+	assert.Equal(t, early.ID(), orders[0].ID())
+	assert.Equal(t, late.ID(), orders[1].ID())
+
+	orders, err = repo.ListDue(ctx, now, 1)
+	assert.NoError(t, err)
+	assert.Len(t, orders, 1)
+	assert.Equal(t, early.ID(), orders[0].ID())
+}
+
+func TestStandingOrderInMemoryRepository_Save_ConcurrentModification(t *testing.T) {
+	ctx := context.Background()
+	repo := inmemory.NewStandingOrderInMemoryRepository(inmemory.NewStore())
+	order := newStandingOrder(t, 25)
+	assert.NoError(t, repo.Save(ctx, order))
+
+	first, err := repo.FindByID(ctx, order.ID())
+	assert.NoError(t, err)
+	second, err := repo.FindByID(ctx, order.ID())
+	assert.NoError(t, err)
+
+	first.RecordSuccess(idVO.NewTransactionIDForTest("transaction"), time.Date(2021, 1, 25, 9, 0, 0, 0, time.UTC))
+	assert.NoError(t, repo.Save(ctx, first))
+
+	assert.NoError(t, second.Pause(time.Date(2021, 1, 25, 9, 0, 0, 0, time.UTC)))
+	assert.ErrorIs(t, repo.Save(ctx, second), standingOrderDomain.ErrConcurrentModification)
+
+	stored, err := repo.FindByID(ctx, order.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, standingOrderDomain.StatusActive, stored.Status())
+	assert.Equal(t, 1, stored.ExecutionCount())
+}
+
+func TestExecuteDueStandingOrders_FakeClock(t *testing.T) {
+	ctx := context.Background()
+	store := inmemory.NewStore()
+	accountRepo := inmemory.NewAccountInMemoryRepository(store)
+	standingOrderRepo := inmemory.NewStandingOrderInMemoryRepository(store)
+	eventRepo := inmemory.NewEventInMemoryRepository(store)
+	accountServ := accountDomain.NewService(accountRepo, lockoutDomain.NewService(inmemory.NewLockoutInMemoryRepository(store), timer.Now), eventRepo)
+	transactionServ := transactionDomain.NewService(
+		accountRepo, inmemory.NewTransactionInMemoryRepository(store), inmemory.NewLedgerInMemoryRepository(store, accountRepo), nil,
+		limitDomain.NewService(inmemory.NewAccountLimitInMemoryRepository(store), transactionlimit.NewFixedLimitProvider()),
+		eventRepo,
+	)
+
+	var now time.Time
+	uc := standingOrderApp.NewExecuteDueStandingOrdersUsecase(
+		accountServ, transactionServ, standingOrderRepo, inmemory.NewUnitOfWorkInMemory(store),
+		func() time.Time { return now },
+	)
+
+	sender, err := accountDomain.New(idVO.NewUserIDForTest("user"), 1500, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	receiver, err := accountDomain.New(idVO.NewUserIDForTest("landlord"), 0, "Rent", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	assert.NoError(t, accountRepo.Save(ctx, sender))
+	assert.NoError(t, accountRepo.Save(ctx, receiver))
+
+	schedule, err := standingOrderDomain.NewSchedule(standingOrderDomain.FrequencyMonthly, numutil.IntPointer(25))
+	assert.NoError(t, err)
+	order, err := standingOrderDomain.New(
+		sender.ID(), receiver.ID(), 1000, moneyVO.JPY, *schedule,
+		timer.GetFixedDate(), nil, nil, 1, standingOrderDomain.FailurePolicySkip, timer.GetFixedDate(),
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, standingOrderRepo.Save(ctx, order))
+
+	// 時刻を進めながら実行し、各時点の実行件数を確認する
+	steps := []struct {
+		now           time.Time
+		wantSucceeded int
+		wantFailed    int
+	}{
+		{time.Date(2021, 1, 24, 9, 0, 0, 0, time.UTC), 0, 0},
+		{time.Date(2021, 1, 25, 9, 0, 0, 0, time.UTC), 1, 0},
+		{time.Date(2021, 1, 25, 10, 0, 0, 0, time.UTC), 0, 0},
+		// 残高不足で失敗し、翌日に再試行する
+		{time.Date(2021, 2, 25, 9, 0, 0, 0, time.UTC), 0, 1},
+		// 再試行も失敗し、2月分を見送る
+		{time.Date(2021, 2, 26, 9, 0, 0, 0, time.UTC), 0, 1},
+		{time.Date(2021, 2, 27, 9, 0, 0, 0, time.UTC), 0, 0},
+	}
+	for _, step := range steps {
+		now = step.now
+		dto, err := uc.Run(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, step.wantSucceeded, dto.Succeeded, now)
+		assert.Equal(t, step.wantFailed, dto.Failed, now)
+	}
+
+	// 入金後は3月分から再開する
+	stored, err := accountRepo.FindByID(ctx, sender.ID())
+	assert.NoError(t, err)
+	assert.NoError(t, stored.Deposit(1000, moneyVO.JPY))
+	assert.NoError(t, accountRepo.Save(ctx, stored))
+	now = time.Date(2021, 3, 25, 9, 0, 0, 0, time.UTC)
+	dto, err := uc.Run(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, dto.Succeeded)
+
+	storedOrder, err := standingOrderRepo.FindByID(ctx, order.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, storedOrder.ExecutionCount())
+	assert.Equal(t, time.Date(2021, 4, 25, 0, 0, 0, 0, time.UTC), storedOrder.NextRunDate())
+
+	executions, err := standingOrderRepo.ListExecutions(ctx, order.ID(), standingOrderDomain.RecentExecutionsLimit)
+	assert.NoError(t, err)
+	results := make([]string, len(executions))
+	for i, execution := range executions {
+		results[i] = execution.Result()
+	}
+	assert.Equal(t, []string{
+		standingOrderDomain.ResultSucceeded,
+		standingOrderDomain.ResultSkipped,
+		standingOrderDomain.ResultRetryScheduled,
+		standingOrderDomain.ResultSucceeded,
+	}, results)
+	assert.Equal(t, "insufficient balance", *executions[1].FailureReason())
+
+	storedSender, err := accountRepo.FindByID(ctx, sender.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), storedSender.Balance().Amount())
+	storedReceiver, err := accountRepo.FindByID(ctx, receiver.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2000), storedReceiver.Balance().Amount())
+}
@@ -0,0 +1,307 @@
+package inmemory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// インメモリのリポジトリが共有する保存先です。
+// トランザクション内の書き込みはトランザクションごとに退避し（コピーオンライト）、
+// f が成功した場合のみまとめて反映し、エラーの場合は破棄します。
+// トランザクションはストア単位で直列化します。テーブルへの書き込み（put、insert、delete）は runInTx の中でのみ行え、
+// トランザクション外で呼び出すと panic する為、リポジトリは書き込みを runInTx で囲み、1件ごとのトランザクションとして実行します。
+// Open を呼び出した場合は、コミットした書き込みをデータディレクトリに永続化します。
+type Store struct {
+	txMu   sync.Mutex
+	mu     sync.RWMutex
+	seq    atomic.Uint64
+	tables map[string]storeTable
+
+	// 以下は永続化を有効にした場合のみ使用し、mu で保護します。
+	config  PersistenceConfig
+	wal     *os.File
+	walSize int64
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+func NewStore() *Store {
+	return &Store{
+		tables: make(map[string]storeTable),
+	}
+}
+
+type storeTxKey struct {
+	store *Store
+}
+
+type storeTx struct {
+	writes map[storeTable]map[string]stagedRow
+}
+
+type stagedRow struct {
+	seq     uint64
+	value   any
+	deleted bool
+}
+
+type storeTable interface {
+	name() string
+	apply(key string, row stagedRow)
+	encode(value any) (json.RawMessage, error)
+	decode(data json.RawMessage) (any, error)
+	rows() []persistedRow
+}
+
+func (s *Store) register(t storeTable) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.tables[t.name()]; exists {
+		panic(fmt.Sprintf("inmemory: table %q is already registered", t.name()))
+	}
+	s.tables[t.name()] = t
+}
+
+func (s *Store) txFrom(ctx context.Context) *storeTx {
+	tx, _ := ctx.Value(storeTxKey{store: s}).(*storeTx)
+	return tx
+}
+
+// 既にトランザクション内の場合は、そのトランザクションに参加します。
+func (s *Store) runInTx(ctx context.Context, f func(ctx context.Context) error) error {
+	if s.txFrom(ctx) != nil {
+		return f(ctx)
+	}
+
+	s.txMu.Lock()
+	defer s.txMu.Unlock()
+
+	tx := &storeTx{writes: make(map[storeTable]map[string]stagedRow)}
+	if err := f(context.WithValue(ctx, storeTxKey{store: s}, tx)); err != nil {
+		return err
+	}
+	return s.commit(tx.writes)
+}
+
+// 書き込みを先行書き込みログに追記してから反映します。追記に失敗した場合は反映せずにエラーを返します。
+func (s *Store) commit(writes map[storeTable]map[string]stagedRow) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.appendWAL(writes); err != nil {
+		return err
+	}
+	for t, rows := range writes {
+		for key, row := range rows {
+			t.apply(key, row)
+		}
+	}
+	return nil
+}
+
+// 追加順を保つ為の連番を払い出します。
+func (s *Store) nextSeq() uint64 {
+	return s.seq.Add(1)
+}
+
+// 値をエンコード・デコードする関数の組です。ドメインのエンティティは非公開のフィールドを持つ為、
+// テーブルごとに公開フィールドのみのレコードに変換して永続化します。
+type codec[V any] struct {
+	encode func(value *V) (json.RawMessage, error)
+	decode func(data json.RawMessage) (V, error)
+}
+
+func jsonCodec[V, R any](toRecord func(value *V) R, fromRecord func(record R) (*V, error)) codec[V] {
+	return codec[V]{
+		encode: func(value *V) (json.RawMessage, error) {
+			return json.Marshal(toRecord(value))
+		},
+		decode: func(data json.RawMessage) (V, error) {
+			var zero V
+			var record R
+			if err := json.Unmarshal(data, &record); err != nil {
+				return zero, err
+			}
+			value, err := fromRecord(record)
+			if err != nil {
+				return zero, err
+			}
+			return *value, nil
+		},
+	}
+}
+
+// ストア内の1つのテーブルです。行は値として保持し、呼び出し元とエンティティを共有しないようにします。
+type table[V any] struct {
+	store     *Store
+	tableName string
+	codec     codec[V]
+	data      map[string]tableRow[V]
+}
+
+type tableRow[V any] struct {
+	seq   uint64
+	value V
+}
+
+func newTable[V any](store *Store, name string, codec codec[V]) *table[V] {
+	t := &table[V]{
+		store:     store,
+		tableName: name,
+		codec:     codec,
+		data:      make(map[string]tableRow[V]),
+	}
+	store.register(t)
+	return t
+}
+
+func (t *table[V]) name() string {
+	return t.tableName
+}
+
+func (t *table[V]) apply(key string, row stagedRow) {
+	if row.deleted {
+		delete(t.data, key)
+		return
+	}
+	t.data[key] = tableRow[V]{seq: row.seq, value: row.value.(V)}
+}
+
+func (t *table[V]) encode(value any) (json.RawMessage, error) {
+	v := value.(V)
+	return t.codec.encode(&v)
+}
+
+func (t *table[V]) decode(data json.RawMessage) (any, error) {
+	return t.codec.decode(data)
+}
+
+// 永続化する為に全ての行を返します。Store.mu を保持した状態で呼び出します。
+func (t *table[V]) rows() []persistedRow {
+	rows := make([]persistedRow, 0, len(t.data))
+	for key, row := range t.data {
+		rows = append(rows, persistedRow{Table: t.tableName, Key: key, Seq: row.seq, value: row.value})
+	}
+	return rows
+}
+
+// トランザクション内では、退避した書き込みをコミット済みのデータに重ねて参照します。
+func (t *table[V]) get(ctx context.Context, key string) (V, bool) {
+	if tx := t.store.txFrom(ctx); tx != nil {
+		if row, staged := tx.writes[t][key]; staged {
+			if row.deleted {
+				var zero V
+				return zero, false
+			}
+			return row.value.(V), true
+		}
+	}
+
+	t.store.mu.RLock()
+	defer t.store.mu.RUnlock()
+	row, exists := t.data[key]
+	return row.value, exists
+}
+
+// 参照可能な全ての行を追加順に返します。
+func (t *table[V]) all(ctx context.Context) []V {
+	t.store.mu.RLock()
+	rows := make(map[string]tableRow[V], len(t.data))
+	for key, row := range t.data {
+		rows[key] = row
+	}
+	t.store.mu.RUnlock()
+
+	if tx := t.store.txFrom(ctx); tx != nil {
+		for key, row := range tx.writes[t] {
+			if row.deleted {
+				delete(rows, key)
+				continue
+			}
+			rows[key] = tableRow[V]{seq: row.seq, value: row.value.(V)}
+		}
+	}
+
+	sorted := make([]tableRow[V], 0, len(rows))
+	for _, row := range rows {
+		sorted = append(sorted, row)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].seq < sorted[j].seq
+	})
+	values := make([]V, 0, len(sorted))
+	for _, row := range sorted {
+		values = append(values, row.value)
+	}
+	return values
+}
+
+// 書き込みは Store.runInTx の中でのみ行います。既存の行を上書きする場合は追加順を保ちます。
+func (t *table[V]) put(ctx context.Context, key string, value V) {
+	tx := t.mustTx(ctx)
+	seq, exists := t.seqOf(tx, key)
+	if !exists {
+		seq = t.store.nextSeq()
+	}
+	t.stage(tx, key, stagedRow{seq: seq, value: value})
+}
+
+// キーを持たない行を追加します。
+func (t *table[V]) insert(ctx context.Context, value V) {
+	tx := t.mustTx(ctx)
+	seq := t.store.nextSeq()
+	t.stage(tx, strconv.FormatUint(seq, 10), stagedRow{seq: seq, value: value})
+}
+
+func (t *table[V]) delete(ctx context.Context, key string) {
+	t.stage(t.mustTx(ctx), key, stagedRow{deleted: true})
+}
+
+// トランザクションに参加せずに、直ちに書き込みを反映します。
+// ロールバックされても残す必要がある書き込みに使用し、同じ行への書き込みの排他は呼び出し元で行います。
+func (t *table[V]) putDetached(key string, value V) error {
+	t.store.mu.RLock()
+	row, exists := t.data[key]
+	t.store.mu.RUnlock()
+	seq := row.seq
+	if !exists {
+		seq = t.store.nextSeq()
+	}
+	return t.store.commit(map[storeTable]map[string]stagedRow{t: {key: {seq: seq, value: value}}})
+}
+
+func (t *table[V]) deleteDetached(key string) error {
+	return t.store.commit(map[storeTable]map[string]stagedRow{t: {key: {deleted: true}}})
+}
+
+func (t *table[V]) mustTx(ctx context.Context) *storeTx {
+	tx := t.store.txFrom(ctx)
+	if tx == nil {
+		panic("inmemory: write outside of a store transaction")
+	}
+	return tx
+}
+
+func (t *table[V]) seqOf(tx *storeTx, key string) (uint64, bool) {
+	if row, staged := tx.writes[t][key]; staged {
+		return row.seq, !row.deleted
+	}
+	t.store.mu.RLock()
+	defer t.store.mu.RUnlock()
+	row, exists := t.data[key]
+	return row.seq, exists
+}
+
+func (t *table[V]) stage(tx *storeTx, key string, row stagedRow) {
+	rows, exists := tx.writes[t]
+	if !exists {
+		rows = make(map[string]stagedRow)
+		tx.writes[t] = rows
+	}
+	rows[key] = row
+}
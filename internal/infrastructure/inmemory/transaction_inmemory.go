@@ -3,84 +3,312 @@ package inmemory
 import (
 	"context"
 	"sort"
-	"sync"
+	"strings"
+	"time"
+	"unicode"
 
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
 type transactionInMemoryRepository struct {
-	mu           sync.RWMutex
-	transactions []*transactionDomain.Transaction
+	store        *Store
+	transactions *table[transactionDomain.Transaction]
 }
 
-func NewTransactionInMemoryRepository() transactionDomain.ITransactionRepository {
+func NewTransactionInMemoryRepository(store *Store) transactionDomain.ITransactionRepository {
 	return &transactionInMemoryRepository{
-		transactions: []*transactionDomain.Transaction{},
+		store:        store,
+		transactions: newTable(store, "transactions", jsonCodec(toTransactionRecord, fromTransactionRecord)),
 	}
 }
 
+type transactionRecord struct {
+	ID                    string              `json:"id"`
+	AccountID             string              `json:"accountId"`
+	ReceiverAccountID     *string             `json:"receiverAccountId,omitempty"`
+	OperationType         string              `json:"operationType"`
+	Amount                int64               `json:"amount"`
+	Currency              string              `json:"currency"`
+	ReceiverAmount        *int64              `json:"receiverAmount,omitempty"`
+	ReceiverCurrency      *string             `json:"receiverCurrency,omitempty"`
+	ExchangeRate          *string             `json:"exchangeRate,omitempty"`
+	ReversedTransactionID *string             `json:"reversedTransactionId,omitempty"`
+	ReversedOperationType *string             `json:"reversedOperationType,omitempty"`
+	BalanceAfter          *int64              `json:"balanceAfter,omitempty"`
+	ReceiverBalanceAfter  *int64              `json:"receiverBalanceAfter,omitempty"`
+	Memo                  *string             `json:"memo,omitempty"`
+	Reference             *string             `json:"reference,omitempty"`
+	Categories            map[string][]string `json:"categories,omitempty"`
+	TransactionAt         time.Time           `json:"transactionAt"`
+}
+
+// 分類は取引の送金元と受取口座のみが付けられる為、両方の口座の分類を保存します。
+func toTransactionRecord(t *transactionDomain.Transaction) transactionRecord {
+	var receiverAmount, balanceAfter, receiverBalanceAfter *int64
+	if amount := t.ReceiverAmount(); amount != nil {
+		a := amount.Amount()
+		receiverAmount = &a
+	}
+	if balance := t.BalanceAfter(); balance != nil {
+		a := balance.Amount()
+		balanceAfter = &a
+	}
+	if balance := t.ReceiverBalanceAfter(); balance != nil {
+		a := balance.Amount()
+		receiverBalanceAfter = &a
+	}
+
+	categories := make(map[string][]string)
+	accountIDs := []idVO.AccountID{t.AccountID()}
+	if receiverAccountID := t.ReceiverAccountID(); receiverAccountID != nil {
+		accountIDs = append(accountIDs, *receiverAccountID)
+	}
+	for _, accountID := range accountIDs {
+		if c := t.CategoriesFor(accountID); len(c) > 0 {
+			categories[accountID.String()] = c
+		}
+	}
+
+	return transactionRecord{
+		ID:                    t.IDString(),
+		AccountID:             t.AccountIDString(),
+		ReceiverAccountID:     t.ReceiverAccountIDString(),
+		OperationType:         t.OperationType(),
+		Amount:                t.TransferAmount().Amount(),
+		Currency:              t.TransferAmount().Currency(),
+		ReceiverAmount:        receiverAmount,
+		ReceiverCurrency:      t.ReceiverCurrency(),
+		ExchangeRate:          t.ExchangeRateString(),
+		ReversedTransactionID: t.ReversedTransactionIDString(),
+		ReversedOperationType: t.ReversedOperationType(),
+		BalanceAfter:          balanceAfter,
+		ReceiverBalanceAfter:  receiverBalanceAfter,
+		Memo:                  t.Memo(),
+		Reference:             t.Reference(),
+		Categories:            categories,
+		TransactionAt:         t.TransactionAt(),
+	}
+}
+
+func fromTransactionRecord(r transactionRecord) (*transactionDomain.Transaction, error) {
+	return transactionDomain.Reconstruct(
+		r.ID,
+		r.AccountID,
+		r.ReceiverAccountID,
+		r.OperationType,
+		r.Amount,
+		r.Currency,
+		r.ReceiverAmount,
+		r.ReceiverCurrency,
+		r.ExchangeRate,
+		r.ReversedTransactionID,
+		r.ReversedOperationType,
+		r.BalanceAfter,
+		r.ReceiverBalanceAfter,
+		r.Memo,
+		r.Reference,
+		r.Categories,
+		r.TransactionAt,
+	)
+}
+
 func (r *transactionInMemoryRepository) Save(ctx context.Context, transaction *transactionDomain.Transaction) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		r.transactions.put(ctx, transaction.IDString(), *transaction)
+		return nil
+	})
+}
+
+func (r *transactionInMemoryRepository) FindByID(ctx context.Context, id idVO.TransactionID) (*transactionDomain.Transaction, error) {
+	t, exists := r.transactions.get(ctx, id.String())
+	if !exists {
+		return nil, nil
+	}
+	return &t, nil
+}
 
-	r.transactions = append(r.transactions, transaction)
-	return nil
+func (r *transactionInMemoryRepository) FindReversalOf(ctx context.Context, id idVO.TransactionID) (*transactionDomain.Transaction, error) {
+	for _, t := range r.transactions.all(ctx) {
+		if reversedID := t.ReversedTransactionID(); reversedID != nil && *reversedID == id {
+			return &t, nil
+		}
+	}
+	return nil, nil
 }
 
-func (r *transactionInMemoryRepository) ListWithTotalByAccountID(ctx context.Context, params transactionDomain.ListTransactionsParams) (transactions []*transactionDomain.Transaction, total int, err error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+func (r *transactionInMemoryRepository) ListByAccountID(ctx context.Context, params transactionDomain.ListTransactionsParams) ([]*transactionDomain.Transaction, error) {
+	sortOrder := transactionDomain.SortDesc
+	if params.Sort != nil {
+		sortOrder = *params.Sort
+	}
 
-	var filteredTransactions []*transactionDomain.Transaction
-	for _, t := range r.transactions {
-		if t.AccountIDString() == params.AccountID.String() {
-			if params.From != nil && t.TransactionAt().Before(*params.From) {
-				continue
+	filteredTransactions := r.filter(ctx, params)
+	sort.Slice(filteredTransactions, func(i, j int) bool {
+		a, b := filteredTransactions[i], filteredTransactions[j]
+		if !a.TransactionAt().Equal(b.TransactionAt()) {
+			if sortOrder == transactionDomain.SortAsc {
+				return a.TransactionAt().Before(b.TransactionAt())
 			}
-			if params.To != nil && t.TransactionAt().After(*params.To) {
-				continue
+			return a.TransactionAt().After(b.TransactionAt())
+		}
+		if sortOrder == transactionDomain.SortAsc {
+			return a.IDString() < b.IDString()
+		}
+		return a.IDString() > b.IDString()
+	})
+
+	if params.Cursor != nil {
+		var afterCursor []*transactionDomain.Transaction
+		for _, t := range filteredTransactions {
+			if params.Cursor.Includes(t, sortOrder) {
+				afterCursor = append(afterCursor, t)
 			}
-			if len(params.OperationTypes) > 0 {
-				match := false
-				for _, opType := range params.OperationTypes {
-					if t.OperationType() == opType {
-						match = true
-						break
-					}
-				}
-				if !match {
-					continue
+		}
+		if params.Cursor.Backward && params.Limit != nil && len(afterCursor) > *params.Limit {
+			// 前のページはカーソルに近い側から Limit 件を取得する
+			afterCursor = afterCursor[len(afterCursor)-*params.Limit:]
+		}
+		if !params.Cursor.Backward && params.Limit != nil && len(afterCursor) > *params.Limit {
+			afterCursor = afterCursor[:*params.Limit]
+		}
+		return afterCursor, nil
+	}
+
+	total := len(filteredTransactions)
+	if params.Limit != nil && params.Page != nil {
+		start := (*params.Page - 1) * *params.Limit
+		end := start + *params.Limit
+		if start >= total {
+			return nil, nil
+		}
+		if end > total {
+			end = total
+		}
+		return filteredTransactions[start:end], nil
+	}
+	return filteredTransactions, nil
+}
+
+func (r *transactionInMemoryRepository) CountByAccountID(ctx context.Context, params transactionDomain.ListTransactionsParams) (int, error) {
+	return len(r.filter(ctx, params)), nil
+}
+
+func (r *transactionInMemoryRepository) filter(ctx context.Context, params transactionDomain.ListTransactionsParams) []*transactionDomain.Transaction {
+	var filteredTransactions []*transactionDomain.Transaction
+	for _, stored := range r.transactions.all(ctx) {
+		t := &stored
+		if !t.Involves(params.AccountID) {
+			continue
+		}
+		if params.From != nil && t.TransactionAt().Before(*params.From) {
+			continue
+		}
+		if params.To != nil && t.TransactionAt().After(*params.To) {
+			continue
+		}
+		if params.Before != nil && !t.TransactionAt().Before(*params.Before) {
+			continue
+		}
+		if len(params.OperationTypes) > 0 {
+			match := false
+			for _, opType := range params.OperationTypes {
+				if t.OperationType() == opType || t.OperationTypeFor(params.AccountID) == opType {
+					match = true
+					break
 				}
 			}
-			filteredTransactions = append(filteredTransactions, t)
+			if !match {
+				continue
+			}
+		}
+		if params.Query != nil && !matchesQuery(t, params.AccountID, *params.Query) {
+			continue
+		}
+		amount := t.AmountFor(params.AccountID).Amount()
+		if params.MinAmount != nil && amount < *params.MinAmount {
+			continue
 		}
+		if params.MaxAmount != nil && amount > *params.MaxAmount {
+			continue
+		}
+		if len(params.Categories) > 0 && !hasAnyCategory(t.CategoriesFor(params.AccountID), params.Categories) {
+			continue
+		}
+		filteredTransactions = append(filteredTransactions, t)
 	}
+	return filteredTransactions
+}
 
-	total = len(filteredTransactions)
+// PostgreSQL の全文検索 (to_tsvector('simple', ...) @@ plainto_tsquery('simple', ...)) と同じく、
+// 文字と数字以外で区切った単語を小文字で比較し、キーワードの全ての単語を含む取引に一致させます。
+func matchesQuery(t *transactionDomain.Transaction, accountID idVO.AccountID, query string) bool {
+	words := searchWords(query)
+	if len(words) == 0 {
+		return false
+	}
 
-	// Sort and paginate
-	if params.Sort != nil && *params.Sort == "ASC" {
-		sort.Slice(filteredTransactions, func(i, j int) bool {
-			return filteredTransactions[i].TransactionAt().Before(filteredTransactions[j].TransactionAt())
-		})
-	} else {
-		sort.Slice(filteredTransactions, func(i, j int) bool {
-			return filteredTransactions[i].TransactionAt().After(filteredTransactions[j].TransactionAt())
-		})
+	var text []string
+	if memo := t.MemoFor(accountID); memo != nil {
+		text = append(text, *memo)
 	}
+	if reference := t.Reference(); reference != nil {
+		text = append(text, *reference)
+	}
+	indexed := make(map[string]bool)
+	for _, word := range searchWords(strings.Join(text, " ")) {
+		indexed[word] = true
+	}
+	for _, word := range words {
+		if !indexed[word] {
+			return false
+		}
+	}
+	return true
+}
 
-	if params.Limit != nil && params.Page != nil {
-		start := (*params.Page - 1) * *params.Limit
-		end := start + *params.Limit
-		if start < total {
-			if end > total {
-				end = total
+func searchWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func hasAnyCategory(categories, targets []string) bool {
+	for _, category := range categories {
+		for _, target := range targets {
+			if category == target {
+				return true
 			}
-			transactions = filteredTransactions[start:end]
 		}
-	} else {
-		transactions = filteredTransactions
 	}
+	return false
+}
 
-	return transactions, total, nil
+func (r *transactionInMemoryRepository) SumAmount(ctx context.Context, accountID idVO.AccountID, operationType string, from time.Time) (int64, error) {
+	transactions := r.transactions.all(ctx)
+	reversed := make(map[idVO.TransactionID]bool)
+	for _, t := range transactions {
+		if reversedID := t.ReversedTransactionID(); reversedID != nil {
+			reversed[*reversedID] = true
+		}
+	}
+
+	var total int64
+	for _, t := range transactions {
+		if t.AccountID() != accountID || t.OperationType() != operationType || t.TransactionAt().Before(from) || reversed[t.ID()] {
+			continue
+		}
+		total += t.TransferAmount().Amount()
+	}
+	return total, nil
+}
+
+func (r *transactionInMemoryRepository) SaveCategories(ctx context.Context, transaction *transactionDomain.Transaction, accountID idVO.AccountID) error {
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		if _, exists := r.transactions.get(ctx, transaction.IDString()); !exists {
+			return transactionDomain.ErrNotFound
+		}
+		r.transactions.put(ctx, transaction.IDString(), *transaction)
+		return nil
+	})
 }
@@ -0,0 +1,272 @@
+package inmemory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+	"github.com/u104rak1/pocgo/pkg/numutil"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestTransactionInMemoryRepository_CursorPagination(t *testing.T) {
+	var (
+		ctx            = context.Background()
+		accountID      = idVO.NewAccountIDForTest("account")
+		otherAccountID = idVO.NewAccountIDForTest("accountOther")
+		base           = timer.GetFixedDate()
+	)
+	repo := inmemory.NewTransactionInMemoryRepository(inmemory.NewStore())
+	service := transactionDomain.NewService(nil, repo, nil, nil, nil, nil)
+
+	save := func(accountID idVO.AccountID, receiverAccountID *idVO.AccountID, operationType string, at time.Time) *transactionDomain.Transaction {
+		var receiverAmount *int64
+		var receiverCurrency *string
+		if receiverAccountID != nil {
+			amount, currency := int64(1000), moneyVO.JPY
+			receiverAmount, receiverCurrency = &amount, &currency
+		}
+		tx, err := transactionDomain.New(accountID, receiverAccountID, operationType, 1000, moneyVO.JPY, receiverAmount, receiverCurrency, nil, at)
+		assert.NoError(t, err)
+		assert.NoError(t, repo.Save(ctx, tx))
+		return tx
+	}
+	ids := func(transactions []*transactionDomain.Transaction) []string {
+		result := make([]string, len(transactions))
+		for i, tx := range transactions {
+			result[i] = tx.IDString()
+		}
+		return result
+	}
+
+	// 同じ取引日時の取引は取引IDの順に並ぶ
+	tx1 := save(accountID, nil, transactionDomain.Deposit, base)
+	tx2 := save(accountID, nil, transactionDomain.Withdrawal, base.Add(time.Minute))
+	tx3 := save(accountID, nil, transactionDomain.Deposit, base.Add(time.Minute))
+	if tx3.IDString() < tx2.IDString() {
+		tx2, tx3 = tx3, tx2
+	}
+	tx4 := save(otherAccountID, &accountID, transactionDomain.Transfer, base.Add(2*time.Minute))
+	save(otherAccountID, nil, transactionDomain.Deposit, base.Add(3*time.Minute))
+
+	// 最初のページは件数とページ番号で取得する
+	first, err := service.List(ctx, transactionDomain.ListTransactionsParams{AccountID: accountID, Limit: numutil.IntPointer(2)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{tx4.IDString(), tx3.IDString()}, ids(first.Transactions))
+	assert.Equal(t, 4, *first.Total)
+	assert.Nil(t, first.PrevCursor)
+
+	// ページングの途中で新しい取引が追加されても、次のページの結果はずれない
+	save(accountID, nil, transactionDomain.Deposit, base.Add(4*time.Minute))
+
+	second, err := service.List(ctx, transactionDomain.ListTransactionsParams{
+		AccountID: accountID, Limit: numutil.IntPointer(2), Cursor: first.NextCursor,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{tx2.IDString(), tx1.IDString()}, ids(second.Transactions))
+	assert.Nil(t, second.Total)
+	assert.Nil(t, second.NextCursor)
+
+	// 前のページに戻ると最初のページと同じ取引が取得できる
+	back, err := service.List(ctx, transactionDomain.ListTransactionsParams{
+		AccountID: accountID, Limit: numutil.IntPointer(2), Cursor: second.PrevCursor,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{tx4.IDString(), tx3.IDString()}, ids(back.Transactions))
+	assert.NotNil(t, back.PrevCursor)
+
+	// 昇順の場合も同じ取引を逆の順で辿れる
+	asc, err := service.List(ctx, transactionDomain.ListTransactionsParams{
+		AccountID: accountID, Sort: strutil.StrPointer(transactionDomain.SortAsc), Limit: numutil.IntPointer(3),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{tx1.IDString(), tx2.IDString(), tx3.IDString()}, ids(asc.Transactions))
+	ascNext, err := service.List(ctx, transactionDomain.ListTransactionsParams{
+		AccountID: accountID, Sort: strutil.StrPointer(transactionDomain.SortAsc), Limit: numutil.IntPointer(3), Cursor: asc.NextCursor,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, tx4.IDString(), ascNext.Transactions[0].IDString())
+}
+
+func TestTransactionInMemoryRepository_SumAmount(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		accountID = idVO.NewAccountIDForTest("account")
+		base      = timer.GetFixedDate()
+	)
+	repo := inmemory.NewTransactionInMemoryRepository(inmemory.NewStore())
+
+	save := func(accountID idVO.AccountID, operationType string, amount int64, at time.Time) *transactionDomain.Transaction {
+		tx, err := transactionDomain.New(accountID, nil, operationType, amount, moneyVO.JPY, nil, nil, nil, at)
+		assert.NoError(t, err)
+		assert.NoError(t, repo.Save(ctx, tx))
+		return tx
+	}
+
+	save(accountID, transactionDomain.Withdrawal, 100, base.Add(-time.Minute))
+	save(accountID, transactionDomain.Withdrawal, 200, base)
+	save(accountID, transactionDomain.Deposit, 400, base)
+	save(idVO.NewAccountIDForTest("accountOther"), transactionDomain.Withdrawal, 800, base)
+	receiverID := idVO.NewAccountIDForTest("accountReceiver")
+	receiverAmount, receiverCurrency := int64(1600), moneyVO.JPY
+	reversed, err := transactionDomain.New(
+		accountID, &receiverID, transactionDomain.Transfer, 1600, moneyVO.JPY, &receiverAmount, &receiverCurrency, nil, base.Add(time.Minute),
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, reversed))
+
+	// 取り消した取引は集計に含めない
+	reversal, err := transactionDomain.Reconstruct(
+		idVO.NewTransactionIDForTest("reversal").String(), accountID.String(), strutil.StrPointer(receiverID.String()), transactionDomain.Reversal, 1600, moneyVO.JPY,
+		&receiverAmount, &receiverCurrency, nil, strutil.StrPointer(reversed.IDString()), strutil.StrPointer(transactionDomain.Transfer), nil, nil, nil, nil, nil, base.Add(2*time.Minute),
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, reversal))
+
+	total, err := repo.SumAmount(ctx, accountID, transactionDomain.Withdrawal, base)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(200), total)
+
+	total, err = repo.SumAmount(ctx, accountID, transactionDomain.Transfer, base)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+}
+
+func TestTransactionInMemoryRepository_Before(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		accountID = idVO.NewAccountIDForTest("account")
+		before    = timer.GetFixedDate()
+	)
+	repo := inmemory.NewTransactionInMemoryRepository(inmemory.NewStore())
+
+	// 記録する日時はマイクロ秒に切り捨てない為、指定日時の1ナノ秒前の取引も取得できる
+	included, err := transactionDomain.New(accountID, nil, transactionDomain.Deposit, 100, moneyVO.JPY, nil, nil, nil, before.Add(-time.Nanosecond))
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, included))
+	excluded, err := transactionDomain.New(accountID, nil, transactionDomain.Deposit, 200, moneyVO.JPY, nil, nil, nil, before)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, excluded))
+
+	transactions, err := repo.ListByAccountID(ctx, transactionDomain.ListTransactionsParams{AccountID: accountID, Before: &before})
+	assert.NoError(t, err)
+	if assert.Len(t, transactions, 1) {
+		assert.Equal(t, included.IDString(), transactions[0].IDString())
+	}
+}
+
+func TestTransactionInMemoryRepository_SearchFilters(t *testing.T) {
+	var (
+		ctx            = context.Background()
+		accountID      = idVO.NewAccountIDForTest("account")
+		otherAccountID = idVO.NewAccountIDForTest("accountOther")
+		at             = timer.GetFixedDate()
+	)
+	repo := inmemory.NewTransactionInMemoryRepository(inmemory.NewStore())
+
+	reconstruct := func(id string, accountID idVO.AccountID, receiverAccountID *string, operationType string, amount int64, memo, reference *string, categories map[string][]string) *transactionDomain.Transaction {
+		var receiverAmount *int64
+		var receiverCurrency *string
+		if receiverAccountID != nil {
+			receiverCurrency = strutil.StrPointer(moneyVO.JPY)
+			receiverAmount = &amount
+		}
+		tx, err := transactionDomain.Reconstruct(
+			idVO.NewTransactionIDForTest(id).String(), accountID.String(), receiverAccountID, operationType, amount, moneyVO.JPY,
+			receiverAmount, receiverCurrency, nil, nil, nil, nil, nil, memo, reference, categories, at,
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, repo.Save(ctx, tx))
+		return tx
+	}
+
+	lunch := reconstruct("lunch", accountID, nil, transactionDomain.Withdrawal, 1200,
+		strutil.StrPointer("Lunch with team"), nil, map[string][]string{accountID.String(): {"food"}})
+	invoice := reconstruct("invoice", accountID, nil, transactionDomain.Withdrawal, 5000,
+		nil, strutil.StrPointer("INV-2024-0001"), map[string][]string{accountID.String(): {"travel"}})
+	// 受け取った振込の送金元のメモは検索の対象にならない
+	rent := reconstruct("rent", otherAccountID, strutil.StrPointer(accountID.String()), transactionDomain.Transfer, 800,
+		strutil.StrPointer("lunch money"), strutil.StrPointer("Rent March"), nil)
+
+	list := func(params transactionDomain.ListTransactionsParams) []string {
+		params.AccountID = accountID
+		transactions, err := repo.ListByAccountID(ctx, params)
+		assert.NoError(t, err)
+		ids := make([]string, len(transactions))
+		for i, tx := range transactions {
+			ids[i] = tx.IDString()
+		}
+		return ids
+	}
+	amount := func(amount int64) *int64 { return &amount }
+
+	tests := []struct {
+		caseName string
+		params   transactionDomain.ListTransactionsParams
+		want     []*transactionDomain.Transaction
+	}{
+		{
+			caseName: "Positive: 検索語は大文字と小文字を区別せずに単語で一致する",
+			params:   transactionDomain.ListTransactionsParams{Query: strutil.StrPointer("LUNCH team")},
+			want:     []*transactionDomain.Transaction{lunch},
+		},
+		{
+			caseName: "Positive: 記号で区切られた参照情報も単語で検索できる",
+			params:   transactionDomain.ListTransactionsParams{Query: strutil.StrPointer("inv 2024")},
+			want:     []*transactionDomain.Transaction{invoice},
+		},
+		{
+			caseName: "Positive: 受け取った振込は参照情報で検索できる",
+			params:   transactionDomain.ListTransactionsParams{Query: strutil.StrPointer("rent")},
+			want:     []*transactionDomain.Transaction{rent},
+		},
+		{
+			caseName: "Positive: 単語を含まない検索語は何も一致しない",
+			params:   transactionDomain.ListTransactionsParams{Query: strutil.StrPointer("!!!")},
+			want:     []*transactionDomain.Transaction{},
+		},
+		{
+			caseName: "Positive: 金額の範囲で絞り込める",
+			params:   transactionDomain.ListTransactionsParams{MinAmount: amount(1000), MaxAmount: amount(2000)},
+			want:     []*transactionDomain.Transaction{lunch},
+		},
+		{
+			caseName: "Positive: いずれかの分類が付いた取引に絞り込める",
+			params:   transactionDomain.ListTransactionsParams{Categories: []string{"food", "travel"}},
+			want:     []*transactionDomain.Transaction{lunch, invoice},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			want := make([]string, len(tt.want))
+			for i, tx := range tt.want {
+				want[i] = tx.IDString()
+			}
+			assert.ElementsMatch(t, want, list(tt.params))
+		})
+	}
+
+	t.Run("Positive: 保存した分類で絞り込める", func(t *testing.T) {
+		service := transactionDomain.NewService(nil, repo, nil, nil, nil, nil)
+		assert.NoError(t, service.Categorize(ctx, accountID, rent, []string{"housing"}))
+		assert.Equal(t, []string{rent.IDString()}, list(transactionDomain.ListTransactionsParams{Categories: []string{"housing"}}))
+
+		// 分類は口座ごとに付けられる為、送金元の口座からは一致しない
+		transactions, err := repo.ListByAccountID(ctx, transactionDomain.ListTransactionsParams{AccountID: otherAccountID, Categories: []string{"housing"}})
+		assert.NoError(t, err)
+		assert.Empty(t, transactions)
+	})
+
+	t.Run("Negative: 保存されていない取引の分類は保存できない", func(t *testing.T) {
+		tx, err := transactionDomain.New(accountID, nil, transactionDomain.Deposit, 1000, moneyVO.JPY, nil, nil, nil, at)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, repo.SaveCategories(ctx, tx, accountID), transactionDomain.ErrNotFound)
+	})
+}
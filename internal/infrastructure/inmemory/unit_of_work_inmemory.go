@@ -6,22 +6,40 @@ import (
 	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
 )
 
-type unitOfWorkInMemory struct{}
+// リポジトリと同じストアを受け取り、f 内の書き込みを1つのトランザクションとして扱います。
+type unitOfWorkInMemory struct {
+	store *Store
+}
 
-func NewUnitOfWorkInMemory() unitofwork.IUnitOfWork {
-	return &unitOfWorkInMemory{}
+func NewUnitOfWorkInMemory(store *Store) unitofwork.IUnitOfWork {
+	return &unitOfWorkInMemory{
+		store: store,
+	}
 }
 
 func (u *unitOfWorkInMemory) RunInTx(ctx context.Context, f func(ctx context.Context) error) error {
-	return f(ctx)
+	return u.store.runInTx(ctx, f)
 }
 
-type unitOfWorkInMemoryWithResult[T any] struct{}
+type unitOfWorkInMemoryWithResult[T any] struct {
+	store *Store
+}
 
-func NewUnitOfWorkInMemoryWithResult[T any]() unitofwork.IUnitOfWorkWithResult[T] {
-	return &unitOfWorkInMemoryWithResult[T]{}
+func NewUnitOfWorkInMemoryWithResult[T any](store *Store) unitofwork.IUnitOfWorkWithResult[T] {
+	return &unitOfWorkInMemoryWithResult[T]{
+		store: store,
+	}
 }
 
 func (u *unitOfWorkInMemoryWithResult[T]) RunInTx(ctx context.Context, f func(ctx context.Context) (*T, error)) (*T, error) {
-	return f(ctx)
+	var result *T
+	err := u.store.runInTx(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = f(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
@@ -0,0 +1,161 @@
+package inmemory_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestUnitOfWorkInMemory_RunInTx(t *testing.T) {
+	errFault := errors.New("fault")
+
+	tests := []struct {
+		caseName        string
+		err             error
+		wantBalance     int64
+		wantTransaction bool
+	}{
+		{
+			caseName:        "Positive: 成功した場合は、送金元の出金と取引がコミットされる",
+			wantBalance:     900,
+			wantTransaction: true,
+		},
+		{
+			caseName:        "Negative: 送金元の保存後に失敗した場合は、出金と取引が破棄される",
+			err:             errFault,
+			wantBalance:     1000,
+			wantTransaction: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			ctx := context.Background()
+			store := inmemory.NewStore()
+			accountRepo := inmemory.NewAccountInMemoryRepository(store)
+			transactionRepo := inmemory.NewTransactionInMemoryRepository(store)
+			uow := inmemory.NewUnitOfWorkInMemory(store)
+
+			sender, err := accountDomain.New(idVO.NewUserIDForTest("user"), 1000, "For work", "1234", moneyVO.JPY)
+			assert.NoError(t, err)
+			assert.NoError(t, accountRepo.Save(ctx, sender))
+			receiverID := idVO.NewAccountIDForTest("receiver")
+			amount, currency := int64(100), moneyVO.JPY
+			transaction, err := transactionDomain.New(sender.ID(), &receiverID, transactionDomain.Transfer, amount, currency, &amount, &currency, nil, timer.GetFixedDate())
+			assert.NoError(t, err)
+
+			err = uow.RunInTx(ctx, func(ctx context.Context) error {
+				debited, err := accountRepo.FindByID(ctx, sender.ID())
+				assert.NoError(t, err)
+				assert.NoError(t, debited.Withdrawal(amount, currency))
+				assert.NoError(t, accountRepo.Save(ctx, debited))
+				assert.NoError(t, transactionRepo.Save(ctx, transaction))
+
+				// トランザクション内では書き込みが参照できること
+				staged, err := accountRepo.FindByID(ctx, sender.ID())
+				assert.NoError(t, err)
+				assert.Equal(t, int64(900), staged.Balance().Amount())
+				return tt.err
+			})
+			assert.ErrorIs(t, err, tt.err)
+
+			stored, err := accountRepo.FindByID(ctx, sender.ID())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBalance, stored.Balance().Amount())
+			found, err := transactionRepo.FindByID(ctx, transaction.ID())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTransaction, found != nil)
+		})
+	}
+}
+
+func TestUnitOfWorkInMemory_RunInTx_Isolation(t *testing.T) {
+	ctx := context.Background()
+	store := inmemory.NewStore()
+	accountRepo := inmemory.NewAccountInMemoryRepository(store)
+	uow := inmemory.NewUnitOfWorkInMemory(store)
+
+	account, err := accountDomain.New(idVO.NewUserIDForTest("user"), 1000, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	assert.NoError(t, accountRepo.Save(ctx, account))
+
+	staged := make(chan struct{})
+	checked := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- uow.RunInTx(ctx, func(ctx context.Context) error {
+			found, err := accountRepo.FindByID(ctx, account.ID())
+			if err != nil {
+				return err
+			}
+			if err := found.Deposit(500, moneyVO.JPY); err != nil {
+				return err
+			}
+			if err := accountRepo.Save(ctx, found); err != nil {
+				return err
+			}
+			close(staged)
+			<-checked
+			return nil
+		})
+	}()
+
+	// コミット前の書き込みはトランザクション外から参照できないこと
+	<-staged
+	before, err := accountRepo.FindByID(ctx, account.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), before.Balance().Amount())
+	close(checked)
+	assert.NoError(t, <-done)
+
+	after, err := accountRepo.FindByID(ctx, account.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1500), after.Balance().Amount())
+}
+
+func TestUnitOfWorkInMemory_RunInTx_Serialized(t *testing.T) {
+	const requests = 20
+	ctx := context.Background()
+	store := inmemory.NewStore()
+	accountRepo := inmemory.NewAccountInMemoryRepository(store)
+	uow := inmemory.NewUnitOfWorkInMemory(store)
+
+	account, err := accountDomain.New(idVO.NewUserIDForTest("user"), 0, "For work", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	assert.NoError(t, accountRepo.Save(ctx, account))
+
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := uow.RunInTx(ctx, func(ctx context.Context) error {
+				found, err := accountRepo.FindByID(ctx, account.ID())
+				if err != nil {
+					return err
+				}
+				if err := found.Deposit(100, moneyVO.JPY); err != nil {
+					return err
+				}
+				return accountRepo.Save(ctx, found)
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// トランザクションが直列化され、読み込みから保存までの間に競合が発生しないこと
+	stored, err := accountRepo.FindByID(ctx, account.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(requests*100), stored.Balance().Amount())
+	assert.Equal(t, int64(requests)+1, stored.Version())
+}
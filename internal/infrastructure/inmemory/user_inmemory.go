@@ -2,62 +2,75 @@ package inmemory
 
 import (
 	"context"
-	"sync"
 
 	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 )
 
 type userInMemoryRepository struct {
-	mu    sync.RWMutex
-	users map[string]*userDomain.User
+	store *Store
+	users *table[userDomain.User]
 }
 
-func NewUserInMemoryRepository() userDomain.IUserRepository {
+func NewUserInMemoryRepository(store *Store) userDomain.IUserRepository {
 	return &userInMemoryRepository{
-		users: make(map[string]*userDomain.User),
+		store: store,
+		users: newTable(store, "users", jsonCodec(toUserRecord, fromUserRecord)),
 	}
 }
 
+type userRecord struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+func toUserRecord(user *userDomain.User) userRecord {
+	return userRecord{ID: user.IDString(), Name: user.Name(), Email: user.Email()}
+}
+
+func fromUserRecord(r userRecord) (*userDomain.User, error) {
+	return userDomain.Reconstruct(r.ID, r.Name, r.Email)
+}
+
+// データベースのメールアドレスの一意制約と同様に、他のユーザーが使用しているメールアドレスの場合は ErrEmailAlreadyExists を返します。
 func (r *userInMemoryRepository) Save(ctx context.Context, user *userDomain.User) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	r.users[user.IDString()] = user
-	return nil
+	return r.store.runInTx(ctx, func(ctx context.Context) error {
+		for _, saved := range r.users.all(ctx) {
+			if saved.IDString() != user.IDString() && saved.Email() == user.Email() {
+				return userDomain.ErrEmailAlreadyExists
+			}
+		}
+		r.users.put(ctx, user.IDString(), *user)
+		return nil
+	})
 }
 
 func (r *userInMemoryRepository) FindByID(ctx context.Context, id idVO.UserID) (*userDomain.User, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	user, exists := r.users[id.String()]
+	user, exists := r.users.get(ctx, id.String())
 	if !exists {
 		return nil, nil
 	}
-	return user, nil
+	return &user, nil
 }
 
 func (r *userInMemoryRepository) FindByEmail(ctx context.Context, email string) (*userDomain.User, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	for _, user := range r.users {
+	for _, user := range r.users.all(ctx) {
 		if user.Email() == email {
-			return user, nil
+			found := user
+			return &found, nil
 		}
 	}
 	return nil, nil
 }
 
 func (r *userInMemoryRepository) ExistsByID(ctx context.Context, id idVO.UserID) (bool, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	_, exists := r.users[id.String()]
+	_, exists := r.users.get(ctx, id.String())
 	return exists, nil
 }
 
 func (r *userInMemoryRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	for _, user := range r.users {
+	for _, user := range r.users.all(ctx) {
 		if user.Email() == email {
 			return true, nil
 		}
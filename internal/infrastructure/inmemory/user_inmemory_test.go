@@ -0,0 +1,31 @@
+package inmemory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
+	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
+)
+
+func TestUserInMemoryRepository_Save(t *testing.T) {
+	ctx := context.Background()
+	repo := inmemory.NewUserInMemoryRepository(inmemory.NewStore())
+
+	user, err := userDomain.New("sato taro", "sato@example.com")
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, user))
+
+	// 同じユーザーは同じメールアドレスのまま更新できる
+	assert.NoError(t, repo.Save(ctx, user))
+
+	// 他のユーザーは同じメールアドレスで保存できない
+	other, err := userDomain.New("yamada hanako", "sato@example.com")
+	assert.NoError(t, err)
+	assert.ErrorIs(t, repo.Save(ctx, other), userDomain.ErrEmailAlreadyExists)
+
+	exists, err := repo.ExistsByID(ctx, other.ID())
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -9,49 +9,163 @@ import (
 	"github.com/u104rak1/pocgo/pkg/timer"
 )
 
+// アクセストークンの有効期間
+// 期限切れ後はリフレッシュトークンを使用して再発行します。
+const AccessTokenTTL = 15 * time.Minute
+
 var (
 	ErrUnexpectedSigningMethod = errors.New("unexpected signing method")
 	ErrInvalidAccessToken      = errors.New("invalid access token")
 	ErrUserIDMissing           = errors.New("user id missing")
+	ErrSessionIDMissing        = errors.New("session id missing")
+	ErrUnknownKeyID            = errors.New("unknown key id")
 )
 
+// 署名に使用する鍵です。共通鍵の場合は kid を持ちません。
+type signingKey struct {
+	kid    string
+	method jwt.SigningMethod
+	key    any
+}
+
+// 署名の検証に使用する鍵です。
+type verificationKey struct {
+	method jwt.SigningMethod
+	key    any
+}
+
 type jwtService struct {
-	secretKey []byte
+	signingKey signingKey
+	// kid ごとの検証鍵。共通鍵の場合は空文字列をキーにします。
+	verificationKeys map[string]verificationKey
+	publicKeys       []authApp.JSONWebKey
 }
 
+// 共通鍵 (HS256) で署名するサービスを生成します。開発環境での利用を想定しています。
 func NewService(secretKey []byte) authApp.IJWTService {
 	return &jwtService{
-		secretKey: secretKey,
+		signingKey: signingKey{
+			method: jwt.SigningMethodHS256,
+			key:    secretKey,
+		},
+		verificationKeys: map[string]verificationKey{
+			"": {method: jwt.SigningMethodHS256, key: secretKey},
+		},
+		publicKeys: []authApp.JSONWebKey{},
+	}
+}
+
+// PEM 形式の秘密鍵で署名するサービスを生成します。RSA 鍵の場合は RS256、Ed25519 鍵の場合は EdDSA で署名します。
+// 鍵のローテーション中は、以前の署名鍵を verificationKeyPEMs に指定することで発行済みのトークンを引き続き検証できます。
+func NewKeyPairService(signingKeyPEM []byte, verificationKeyPEMs ...[]byte) (authApp.IJWTService, error) {
+	signer, err := parsePrivateKeyPEM(signingKeyPEM)
+	if err != nil {
+		return nil, err
 	}
+	signingPub, err := newPublicKey(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	s := &jwtService{
+		signingKey: signingKey{
+			kid:    signingPub.kid,
+			method: signingPub.method,
+			key:    signer,
+		},
+		verificationKeys: map[string]verificationKey{},
+		publicKeys:       []authApp.JSONWebKey{},
+	}
+	s.addVerificationKey(signingPub)
+
+	for _, data := range verificationKeyPEMs {
+		pub, err := parsePublicKeyPEM(data)
+		if err != nil {
+			return nil, err
+		}
+		s.addVerificationKey(pub)
+	}
+
+	return s, nil
 }
 
-func (s *jwtService) GenerateAccessToken(userID string) (string, error) {
+// PEM ファイルから鍵を読み込み、NewKeyPairService でサービスを生成します。
+func NewKeyPairServiceFromFiles(signingKeyPath string, verificationKeyPaths ...string) (authApp.IJWTService, error) {
+	signingKeyPEM, err := readPEMFile(signingKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	verificationKeyPEMs := make([][]byte, 0, len(verificationKeyPaths))
+	for _, path := range verificationKeyPaths {
+		data, err := readPEMFile(path)
+		if err != nil {
+			return nil, err
+		}
+		verificationKeyPEMs = append(verificationKeyPEMs, data)
+	}
+	return NewKeyPairService(signingKeyPEM, verificationKeyPEMs...)
+}
+
+func (s *jwtService) addVerificationKey(pub *publicKey) {
+	if _, exists := s.verificationKeys[pub.kid]; exists {
+		return
+	}
+	s.verificationKeys[pub.kid] = verificationKey{method: pub.method, key: pub.key}
+	s.publicKeys = append(s.publicKeys, pub.jwk)
+}
+
+func (s *jwtService) GenerateAccessToken(userID, sessionID string) (string, error) {
 	claims := jwt.MapClaims{
 		"sub": userID,
-		"exp": timer.Now().Add(time.Hour * 24).Unix(),
+		"sid": sessionID,
+		"exp": timer.Now().Add(AccessTokenTTL).Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secretKey)
+	token := jwt.NewWithClaims(s.signingKey.method, claims)
+	if s.signingKey.kid != "" {
+		token.Header["kid"] = s.signingKey.kid
+	}
+	return token.SignedString(s.signingKey.key)
 }
 
-func (s *jwtService) GetUserIDFromAccessToken(accessToken string) (string, error) {
+func (s *jwtService) ParseAccessToken(accessToken string) (*authApp.AccessTokenClaims, error) {
 	token, err := jwt.Parse(accessToken, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := s.verificationKeys[kid]
+		if !ok {
+			return nil, ErrUnknownKeyID
+		}
+		if token.Method.Alg() != key.method.Alg() {
 			return nil, ErrUnexpectedSigningMethod
 		}
-		return s.secretKey, nil
+		return key.key, nil
 	})
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		if userID, ok := claims["sub"].(string); ok {
-			return userID, nil
-		}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, ErrInvalidAccessToken
 	}
+	userID, ok := claims["sub"].(string)
+	if !ok || userID == "" {
+		return nil, ErrUserIDMissing
+	}
+	sessionID, ok := claims["sid"].(string)
+	if !ok || sessionID == "" {
+		return nil, ErrSessionIDMissing
+	}
+
+	return &authApp.AccessTokenClaims{
+		UserID:    userID,
+		SessionID: sessionID,
+	}, nil
+}
 
-	return "", ErrInvalidAccessToken
+func (s *jwtService) PublicKeys() []authApp.JSONWebKey {
+	keys := make([]authApp.JSONWebKey, len(s.publicKeys))
+	copy(keys, s.publicKeys)
+	return keys
 }
@@ -1,8 +1,18 @@
 package jwt_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
 	"testing"
 
+	gojwt "github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
@@ -15,36 +25,47 @@ func TestGenerateAccessToken(t *testing.T) {
 
 		service := jwt.NewService([]byte("validSecretKey"))
 		userID := "01H2X5JMIN3P8T68PYHXXVK5XN"
+		sessionID := "01H2X5JMIN3P8T68PYHXXVK5XM"
 
-		token, err := service.GenerateAccessToken(userID)
+		token, err := service.GenerateAccessToken(userID, sessionID)
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, token)
 	})
 }
 
-func TestGetUserIDFromAccessToken(t *testing.T) {
+func TestParseAccessToken(t *testing.T) {
 	var (
-		userID = idVO.NewUserIDForTest("testUserID")
+		userID    = idVO.NewUserIDForTest("testUserID")
+		sessionID = idVO.NewSessionIDForTest("testSessionID")
 	)
 
+	signWithClaims := func(claims gojwt.MapClaims) string {
+		token, err := gojwt.NewWithClaims(gojwt.SigningMethodHS256, claims).SignedString([]byte("validSecretKey"))
+		assert.NoError(t, err)
+		return token
+	}
+
 	tests := []struct {
 		name         string
 		setupToken   func(service authApp.IJWTService) string
 		jwtSecretKey []byte
-		expectedID   string
+		expected     *authApp.AccessTokenClaims
 		errMsg       string
 	}{
 		{
-			name: "有効なトークンからユーザーIDを取得できること",
+			name: "有効なトークンからユーザーIDとセッションIDを取得できること",
 			setupToken: func(service authApp.IJWTService) string {
-				token, err := service.GenerateAccessToken(userID.String())
+				token, err := service.GenerateAccessToken(userID.String(), sessionID.String())
 				assert.NoError(t, err)
 				return token
 			},
 			jwtSecretKey: []byte("validSecretKey"),
-			expectedID:   userID.String(),
-			errMsg:       "",
+			expected: &authApp.AccessTokenClaims{
+				UserID:    userID.String(),
+				SessionID: sessionID.String(),
+			},
+			errMsg: "",
 		},
 		{
 			name: "無効なトークンの場合エラーを返すこと",
@@ -52,9 +73,47 @@ func TestGetUserIDFromAccessToken(t *testing.T) {
 				return "invalid.token.format"
 			},
 			jwtSecretKey: []byte("validSecretKey"),
-			expectedID:   "",
+			expected:     nil,
 			errMsg:       "token is malformed:",
 		},
+		{
+			name: "署名の鍵が異なる場合エラーを返すこと",
+			setupToken: func(service authApp.IJWTService) string {
+				token, err := jwt.NewService([]byte("otherSecretKey")).GenerateAccessToken(userID.String(), sessionID.String())
+				assert.NoError(t, err)
+				return token
+			},
+			jwtSecretKey: []byte("validSecretKey"),
+			expected:     nil,
+			errMsg:       "token signature is invalid",
+		},
+		{
+			name: "有効期限切れのトークンの場合エラーを返すこと",
+			setupToken: func(service authApp.IJWTService) string {
+				return signWithClaims(gojwt.MapClaims{"sub": userID.String(), "sid": sessionID.String(), "exp": 1})
+			},
+			jwtSecretKey: []byte("validSecretKey"),
+			expected:     nil,
+			errMsg:       "token is expired",
+		},
+		{
+			name: "セッションIDを含まないトークンの場合エラーを返すこと",
+			setupToken: func(service authApp.IJWTService) string {
+				return signWithClaims(gojwt.MapClaims{"sub": userID.String()})
+			},
+			jwtSecretKey: []byte("validSecretKey"),
+			expected:     nil,
+			errMsg:       "session id missing",
+		},
+		{
+			name: "ユーザーIDを含まないトークンの場合エラーを返すこと",
+			setupToken: func(service authApp.IJWTService) string {
+				return signWithClaims(gojwt.MapClaims{"sid": sessionID.String()})
+			},
+			jwtSecretKey: []byte("validSecretKey"),
+			expected:     nil,
+			errMsg:       "user id missing",
+		},
 	}
 
 	for _, tt := range tests {
@@ -65,16 +124,211 @@ func TestGetUserIDFromAccessToken(t *testing.T) {
 			service := jwt.NewService(tt.jwtSecretKey)
 			token := tt.setupToken(service)
 
-			userID, err := service.GetUserIDFromAccessToken(token)
+			claims, err := service.ParseAccessToken(token)
 
 			if tt.errMsg != "" {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errMsg)
-				assert.Empty(t, userID)
+				assert.Nil(t, claims)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedID, userID)
+				assert.Equal(t, tt.expected, claims)
 			}
 		})
 	}
 }
+
+func TestKeyPairService(t *testing.T) {
+	var (
+		userID    = idVO.NewUserIDForTest("testUserID")
+		sessionID = idVO.NewSessionIDForTest("testSessionID")
+		expected  = &authApp.AccessTokenClaims{UserID: userID.String(), SessionID: sessionID.String()}
+	)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	_, oldEdKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	rsaPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})
+	edPEM := marshalPKCS8PEM(t, edKey)
+	oldEdPEM := marshalPKCS8PEM(t, oldEdKey)
+	oldEdPubPEM := marshalPublicKeyPEM(t, oldEdKey.Public())
+
+	t.Run("RSA 鍵で RS256 のトークンを生成・検証できること", func(t *testing.T) {
+		t.Parallel()
+
+		service, err := jwt.NewKeyPairService(rsaPEM)
+		assert.NoError(t, err)
+
+		token, err := service.GenerateAccessToken(userID.String(), sessionID.String())
+		assert.NoError(t, err)
+
+		header := parseHeader(t, token)
+		assert.Equal(t, "RS256", header["alg"])
+		assert.Equal(t, service.PublicKeys()[0].Kid, header["kid"])
+
+		claims, err := service.ParseAccessToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, claims)
+	})
+
+	t.Run("Ed25519 鍵で EdDSA のトークンを生成・検証できること", func(t *testing.T) {
+		t.Parallel()
+
+		service, err := jwt.NewKeyPairService(edPEM)
+		assert.NoError(t, err)
+
+		token, err := service.GenerateAccessToken(userID.String(), sessionID.String())
+		assert.NoError(t, err)
+		assert.Equal(t, "EdDSA", parseHeader(t, token)["alg"])
+
+		claims, err := service.ParseAccessToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, claims)
+	})
+
+	t.Run("ローテーション中は以前の鍵で署名されたトークンも検証できること", func(t *testing.T) {
+		t.Parallel()
+
+		oldService, err := jwt.NewKeyPairService(oldEdPEM)
+		assert.NoError(t, err)
+		oldToken, err := oldService.GenerateAccessToken(userID.String(), sessionID.String())
+		assert.NoError(t, err)
+
+		service, err := jwt.NewKeyPairService(edPEM, oldEdPubPEM)
+		assert.NoError(t, err)
+		claims, err := service.ParseAccessToken(oldToken)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, claims)
+
+		// 以前の鍵を検証鍵から外すと検証できなくなる
+		rotated, err := jwt.NewKeyPairService(edPEM)
+		assert.NoError(t, err)
+		_, err = rotated.ParseAccessToken(oldToken)
+		assert.ErrorIs(t, err, jwt.ErrUnknownKeyID)
+	})
+
+	t.Run("共通鍵で署名されたトークンは拒否すること", func(t *testing.T) {
+		t.Parallel()
+
+		service, err := jwt.NewKeyPairService(edPEM)
+		assert.NoError(t, err)
+		kid := service.PublicKeys()[0].Kid
+
+		token := gojwt.NewWithClaims(gojwt.SigningMethodHS256, gojwt.MapClaims{"sub": userID.String(), "sid": sessionID.String()})
+		token.Header["kid"] = kid
+		signed, err := token.SignedString([]byte(kid))
+		assert.NoError(t, err)
+
+		_, err = service.ParseAccessToken(signed)
+		assert.ErrorIs(t, err, jwt.ErrUnexpectedSigningMethod)
+	})
+
+	t.Run("公開鍵の一覧に署名鍵と検証鍵が重複なく含まれること", func(t *testing.T) {
+		t.Parallel()
+
+		service, err := jwt.NewKeyPairService(edPEM, oldEdPubPEM, oldEdPEM, rsaPEM)
+		assert.NoError(t, err)
+
+		keys := service.PublicKeys()
+		assert.Len(t, keys, 3)
+		assert.Equal(t, "OKP", keys[0].Kty)
+		assert.Equal(t, "Ed25519", keys[0].Crv)
+		assert.Equal(t, "EdDSA", keys[0].Alg)
+		assert.Equal(t, "sig", keys[0].Use)
+		assert.Equal(t, "RSA", keys[2].Kty)
+		assert.Equal(t, "RS256", keys[2].Alg)
+		assert.Equal(t, "AQAB", keys[2].E)
+		assert.NotEqual(t, keys[0].Kid, keys[1].Kid)
+	})
+
+	t.Run("共通鍵のサービスは公開鍵を公開しないこと", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, jwt.NewService([]byte("validSecretKey")).PublicKeys())
+	})
+
+	t.Run("PEM ファイルから鍵を読み込めること", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		signingPath := filepath.Join(dir, "signing.pem")
+		verificationPath := filepath.Join(dir, "old.pub.pem")
+		assert.NoError(t, os.WriteFile(signingPath, edPEM, 0o600))
+		assert.NoError(t, os.WriteFile(verificationPath, oldEdPubPEM, 0o600))
+
+		service, err := jwt.NewKeyPairServiceFromFiles(signingPath, verificationPath)
+		assert.NoError(t, err)
+		assert.Len(t, service.PublicKeys(), 2)
+
+		_, err = jwt.NewKeyPairServiceFromFiles(filepath.Join(dir, "missing.pem"))
+		assert.ErrorContains(t, err, "failed to read key file")
+	})
+}
+
+func TestNewKeyPairService_InvalidKey(t *testing.T) {
+	shortRSAKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		caseName      string
+		signingKeyPEM []byte
+		wantErr       error
+	}{
+		{
+			caseName:      "PEM 形式でない場合エラーを返すこと",
+			signingKeyPEM: []byte("not a pem"),
+			wantErr:       jwt.ErrInvalidPEM,
+		},
+		{
+			caseName:      "公開鍵を署名鍵に指定した場合エラーを返すこと",
+			signingKeyPEM: marshalPublicKeyPEM(t, shortRSAKey.Public()),
+			wantErr:       jwt.ErrUnsupportedKeyType,
+		},
+		{
+			caseName:      "ECDSA 鍵の場合エラーを返すこと",
+			signingKeyPEM: marshalPKCS8PEM(t, ecKey),
+			wantErr:       jwt.ErrUnsupportedKeyType,
+		},
+		{
+			caseName:      "RSA 鍵長が不足している場合エラーを返すこと",
+			signingKeyPEM: marshalPKCS8PEM(t, shortRSAKey),
+			wantErr:       jwt.ErrRSAKeyTooShort,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+
+			service, err := jwt.NewKeyPairService(tt.signingKeyPEM)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, service)
+		})
+	}
+}
+
+func marshalPKCS8PEM(t *testing.T, key any) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func marshalPublicKeyPEM(t *testing.T, key any) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func parseHeader(t *testing.T, token string) map[string]interface{} {
+	parsed, _, err := gojwt.NewParser().ParseUnverified(token, gojwt.MapClaims{})
+	assert.NoError(t, err)
+	return parsed.Header
+}
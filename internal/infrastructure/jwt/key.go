@@ -0,0 +1,164 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+)
+
+// RSA 鍵に求める最小の鍵長(ビット)
+const MinRSAKeyBits = 2048
+
+var (
+	ErrInvalidPEM         = errors.New("failed to decode PEM block")
+	ErrUnsupportedKeyType = errors.New("unsupported key type, only RSA and Ed25519 keys are supported")
+	ErrRSAKeyTooShort     = fmt.Errorf("RSA key must be at least %d bits", MinRSAKeyBits)
+)
+
+// 署名の検証に使用する公開鍵です。kid は RFC 7638 の JWK Thumbprint から求めるため、
+// 同じ鍵であれば設定の順番やファイル名に関係なく同じ kid になります。
+type publicKey struct {
+	kid    string
+	method jwt.SigningMethod
+	key    crypto.PublicKey
+	jwk    authApp.JSONWebKey
+}
+
+// PEM 形式の秘密鍵を読み込みます。PKCS#8 と PKCS#1 (RSA) に対応しています。
+func parsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidPEM
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyType, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	default:
+		return nil, ErrUnsupportedKeyType
+	}
+}
+
+// PEM 形式の公開鍵を読み込みます。秘密鍵が渡された場合は対応する公開鍵を返すため、
+// ローテーション前の署名鍵ファイルをそのまま検証鍵として指定できます。
+func parsePublicKeyPEM(data []byte) (*publicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidPEM
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PRIVATE KEY", "RSA PRIVATE KEY":
+		var signer crypto.Signer
+		signer, err = parsePrivateKeyPEM(data)
+		if err == nil {
+			key = signer.Public()
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyType, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	return newPublicKey(key)
+}
+
+func newPublicKey(key crypto.PublicKey) (*publicKey, error) {
+	var method jwt.SigningMethod
+	var jwk authApp.JSONWebKey
+	var thumbprintInput any
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if k.N.BitLen() < MinRSAKeyBits {
+			return nil, ErrRSAKeyTooShort
+		}
+		method = jwt.SigningMethodRS256
+		jwk = authApp.JSONWebKey{
+			Kty: "RSA",
+			N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}
+		// 必須メンバーのみを辞書順に並べたものが Thumbprint の入力になります。
+		thumbprintInput = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	case ed25519.PublicKey:
+		method = jwt.SigningMethodEdDSA
+		jwk = authApp.JSONWebKey{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(k),
+		}
+		thumbprintInput = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	default:
+		return nil, ErrUnsupportedKeyType
+	}
+
+	b, err := json.Marshal(thumbprintInput)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(b)
+	kid := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	jwk.Kid = kid
+	jwk.Use = "sig"
+	jwk.Alg = method.Alg()
+
+	return &publicKey{
+		kid:    kid,
+		method: method,
+		key:    key,
+		jwk:    jwk,
+	}, nil
+}
+
+func readPEMFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,8 @@
+-- reverse: modify "transactions" table
+ALTER TABLE "public"."transactions" ALTER COLUMN "amount" TYPE double precision;
+-- reverse: scale "amount" of "transactions" table back to major units (USD: dollar)
+UPDATE "public"."transactions" AS "t" SET "amount" = "t"."amount" / 100 FROM "public"."currency_master" AS "c" WHERE "c"."id" = "t"."currency_id" AND "c"."code" = 'USD';
+-- reverse: modify "accounts" table
+ALTER TABLE "public"."accounts" ALTER COLUMN "balance" TYPE double precision;
+-- reverse: scale "balance" of "accounts" table back to major units (USD: dollar)
+UPDATE "public"."accounts" AS "a" SET "balance" = "a"."balance" / 100 FROM "public"."currency_master" AS "c" WHERE "c"."id" = "a"."currency_id" AND "c"."code" = 'USD';
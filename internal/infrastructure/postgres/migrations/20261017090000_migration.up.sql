@@ -0,0 +1,8 @@
+-- scale "balance" of "accounts" table to minor units (USD: cent)
+UPDATE "public"."accounts" AS "a" SET "balance" = "a"."balance" * 100 FROM "public"."currency_master" AS "c" WHERE "c"."id" = "a"."currency_id" AND "c"."code" = 'USD';
+-- modify "accounts" table
+ALTER TABLE "public"."accounts" ALTER COLUMN "balance" TYPE bigint USING round("balance")::bigint;
+-- scale "amount" of "transactions" table to minor units (USD: cent)
+UPDATE "public"."transactions" AS "t" SET "amount" = "t"."amount" * 100 FROM "public"."currency_master" AS "c" WHERE "c"."id" = "t"."currency_id" AND "c"."code" = 'USD';
+-- modify "transactions" table
+ALTER TABLE "public"."transactions" ALTER COLUMN "amount" TYPE bigint USING round("amount")::bigint;
@@ -0,0 +1,2 @@
+-- reverse: modify "currency_master" table
+ALTER TABLE "public"."currency_master" DROP COLUMN "symbol", DROP COLUMN "exponent";
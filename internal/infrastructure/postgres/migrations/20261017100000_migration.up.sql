@@ -0,0 +1,5 @@
+-- modify "currency_master" table
+ALTER TABLE "public"."currency_master" ADD COLUMN "exponent" smallint NOT NULL DEFAULT 0, ADD COLUMN "symbol" character varying(8) NOT NULL DEFAULT '';
+-- set minor unit and symbol of existing currencies
+UPDATE "public"."currency_master" SET "exponent" = 0, "symbol" = '¥' WHERE "code" = 'JPY';
+UPDATE "public"."currency_master" SET "exponent" = 2, "symbol" = '$' WHERE "code" = 'USD';
@@ -0,0 +1,2 @@
+-- reverse: modify "transactions" table
+ALTER TABLE "public"."transactions" DROP CONSTRAINT "fk_transaction_receiver_currency_id", DROP COLUMN "exchange_rate", DROP COLUMN "receiver_currency_id", DROP COLUMN "receiver_amount";
@@ -0,0 +1,4 @@
+-- modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "receiver_amount" bigint NULL, ADD COLUMN "receiver_currency_id" character(26) NULL, ADD COLUMN "exchange_rate" numeric(24,12) NULL, ADD CONSTRAINT "fk_transaction_receiver_currency_id" FOREIGN KEY ("receiver_currency_id") REFERENCES "public"."currency_master" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- backfill receiver amount of existing transfers (same currency, no conversion)
+UPDATE "public"."transactions" SET "receiver_amount" = "amount", "receiver_currency_id" = "currency_id" WHERE "operation_type" = 'TRANSFER';
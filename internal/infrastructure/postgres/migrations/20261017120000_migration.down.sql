@@ -0,0 +1,2 @@
+-- reverse: create "idempotency_keys" table
+DROP TABLE "public"."idempotency_keys";
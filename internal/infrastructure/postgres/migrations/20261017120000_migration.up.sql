@@ -0,0 +1,2 @@
+-- create "idempotency_keys" table
+CREATE TABLE "public"."idempotency_keys" ("user_id" character(26) NOT NULL, "key" character varying(255) NOT NULL, "fingerprint" character(64) NOT NULL, "response" text NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("user_id", "key"), CONSTRAINT "fk_idempotency_key_user_id" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
@@ -0,0 +1,2 @@
+-- reverse: modify "accounts" table
+ALTER TABLE "public"."accounts" DROP COLUMN "version";
@@ -0,0 +1,2 @@
+-- modify "accounts" table
+ALTER TABLE "public"."accounts" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,4 @@
+-- reverse: create index "ledger_posting_account_id_idx" to table: "ledger_postings"
+DROP INDEX "public"."ledger_posting_account_id_idx";
+-- reverse: create "ledger_postings" table
+DROP TABLE "public"."ledger_postings";
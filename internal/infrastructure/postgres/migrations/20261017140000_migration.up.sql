@@ -0,0 +1,28 @@
+-- create "ledger_postings" table
+CREATE TABLE "public"."ledger_postings" ("transaction_id" character(26) NOT NULL, "line" smallint NOT NULL, "account_id" character(26) NULL, "system_account" character varying(32) NULL, "side" character varying(6) NOT NULL, "amount" bigint NOT NULL, "currency_id" character(26) NOT NULL, "posted_at" timestamptz NOT NULL, PRIMARY KEY ("transaction_id", "line"), CONSTRAINT "fk_ledger_posting_account_id" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_ledger_posting_currency_id" FOREIGN KEY ("currency_id") REFERENCES "public"."currency_master" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_ledger_posting_transaction_id" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "ledger_posting_account_id_idx" to table: "ledger_postings"
+CREATE INDEX "ledger_posting_account_id_idx" ON "public"."ledger_postings" ("account_id");
+-- backfill "ledger_postings" from existing deposits
+INSERT INTO "public"."ledger_postings" ("transaction_id", "line", "account_id", "system_account", "side", "amount", "currency_id", "posted_at")
+SELECT "id", 1, NULL, 'SYSTEM_CASH', 'DEBIT', "amount", "currency_id", "transaction_at" FROM "public"."transactions" WHERE "operation_type" = 'DEPOSIT'
+UNION ALL
+SELECT "id", 2, "account_id", NULL, 'CREDIT', "amount", "currency_id", "transaction_at" FROM "public"."transactions" WHERE "operation_type" = 'DEPOSIT';
+-- backfill "ledger_postings" from existing withdrawals
+INSERT INTO "public"."ledger_postings" ("transaction_id", "line", "account_id", "system_account", "side", "amount", "currency_id", "posted_at")
+SELECT "id", 1, "account_id", NULL, 'DEBIT', "amount", "currency_id", "transaction_at" FROM "public"."transactions" WHERE "operation_type" = 'WITHDRAWAL'
+UNION ALL
+SELECT "id", 2, NULL, 'SYSTEM_CASH', 'CREDIT', "amount", "currency_id", "transaction_at" FROM "public"."transactions" WHERE "operation_type" = 'WITHDRAWAL';
+-- backfill "ledger_postings" from existing same-currency transfers
+INSERT INTO "public"."ledger_postings" ("transaction_id", "line", "account_id", "system_account", "side", "amount", "currency_id", "posted_at")
+SELECT "id", 1, "account_id", NULL, 'DEBIT', "amount", "currency_id", "transaction_at" FROM "public"."transactions" WHERE "operation_type" = 'TRANSFER' AND COALESCE("receiver_currency_id", "currency_id") = "currency_id"
+UNION ALL
+SELECT "id", 2, "receiver_account_id", NULL, 'CREDIT', COALESCE("receiver_amount", "amount"), "currency_id", "transaction_at" FROM "public"."transactions" WHERE "operation_type" = 'TRANSFER' AND COALESCE("receiver_currency_id", "currency_id") = "currency_id";
+-- backfill "ledger_postings" from existing cross-currency transfers
+INSERT INTO "public"."ledger_postings" ("transaction_id", "line", "account_id", "system_account", "side", "amount", "currency_id", "posted_at")
+SELECT "id", 1, "account_id", NULL, 'DEBIT', "amount", "currency_id", "transaction_at" FROM "public"."transactions" WHERE "operation_type" = 'TRANSFER' AND "receiver_currency_id" <> "currency_id"
+UNION ALL
+SELECT "id", 2, NULL, 'SYSTEM_FX_CLEARING', 'CREDIT', "amount", "currency_id", "transaction_at" FROM "public"."transactions" WHERE "operation_type" = 'TRANSFER' AND "receiver_currency_id" <> "currency_id"
+UNION ALL
+SELECT "id", 3, NULL, 'SYSTEM_FX_CLEARING', 'DEBIT', "receiver_amount", "receiver_currency_id", "transaction_at" FROM "public"."transactions" WHERE "operation_type" = 'TRANSFER' AND "receiver_currency_id" <> "currency_id"
+UNION ALL
+SELECT "id", 4, "receiver_account_id", NULL, 'CREDIT', "receiver_amount", "receiver_currency_id", "transaction_at" FROM "public"."transactions" WHERE "operation_type" = 'TRANSFER' AND "receiver_currency_id" <> "currency_id";
@@ -0,0 +1,6 @@
+-- reverse: create index "refresh_token_session_id_idx" to table: "refresh_tokens"
+DROP INDEX "public"."refresh_token_session_id_idx";
+-- reverse: create "refresh_tokens" table
+DROP TABLE "public"."refresh_tokens";
+-- reverse: create "sessions" table
+DROP TABLE "public"."sessions";
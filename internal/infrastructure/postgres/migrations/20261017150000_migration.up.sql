@@ -0,0 +1,6 @@
+-- create "sessions" table
+CREATE TABLE "public"."sessions" ("id" character(26) NOT NULL, "user_id" character(26) NOT NULL, "created_at" timestamptz NOT NULL, "revoked_at" timestamptz NULL, PRIMARY KEY ("id"), CONSTRAINT "fk_session_user_id" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create "refresh_tokens" table
+CREATE TABLE "public"."refresh_tokens" ("token_hash" character(64) NOT NULL, "session_id" character(26) NOT NULL, "expires_at" timestamptz NOT NULL, "used_at" timestamptz NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("token_hash"), CONSTRAINT "fk_refresh_token_session_id" FOREIGN KEY ("session_id") REFERENCES "public"."sessions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "refresh_token_session_id_idx" to table: "refresh_tokens"
+CREATE INDEX "refresh_token_session_id_idx" ON "public"."refresh_tokens" ("session_id");
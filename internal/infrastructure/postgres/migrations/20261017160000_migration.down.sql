@@ -0,0 +1,2 @@
+-- reverse: create "lockouts" table
+DROP TABLE "public"."lockouts";
@@ -0,0 +1,2 @@
+-- create "lockouts" table
+CREATE TABLE "public"."lockouts" ("subject" character varying(20) NOT NULL, "subject_id" character(26) NOT NULL, "failed_count" bigint NOT NULL, "locked_until" timestamptz NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("subject", "subject_id"));
@@ -0,0 +1,2 @@
+-- reverse: modify "transactions" table
+ALTER TABLE "public"."transactions" DROP COLUMN "receiver_balance_after", DROP COLUMN "balance_after";
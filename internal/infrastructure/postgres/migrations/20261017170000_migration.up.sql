@@ -0,0 +1,8 @@
+-- modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "balance_after" bigint NULL, ADD COLUMN "receiver_balance_after" bigint NULL;
+-- backfill "balance_after" of existing transactions from the running sum of "ledger_postings" in the account currency
+WITH "running_balances" AS (SELECT "p"."transaction_id", "p"."account_id", SUM(CASE WHEN "p"."side" = 'CREDIT' THEN "p"."amount" ELSE -"p"."amount" END) OVER (PARTITION BY "p"."account_id" ORDER BY "p"."posted_at", "p"."transaction_id", "p"."line") AS "balance" FROM "public"."ledger_postings" AS "p" JOIN "public"."accounts" AS "a" ON "a"."id" = "p"."account_id" AND "a"."currency_id" = "p"."currency_id")
+UPDATE "public"."transactions" AS "t" SET "balance_after" = "r"."balance" FROM "running_balances" AS "r" WHERE "r"."transaction_id" = "t"."id" AND "r"."account_id" = "t"."account_id";
+-- backfill "receiver_balance_after" of existing transfers from the running sum of "ledger_postings" in the receiver account currency
+WITH "running_balances" AS (SELECT "p"."transaction_id", "p"."account_id", SUM(CASE WHEN "p"."side" = 'CREDIT' THEN "p"."amount" ELSE -"p"."amount" END) OVER (PARTITION BY "p"."account_id" ORDER BY "p"."posted_at", "p"."transaction_id", "p"."line") AS "balance" FROM "public"."ledger_postings" AS "p" JOIN "public"."accounts" AS "a" ON "a"."id" = "p"."account_id" AND "a"."currency_id" = "p"."currency_id")
+UPDATE "public"."transactions" AS "t" SET "receiver_balance_after" = "r"."balance" FROM "running_balances" AS "r" WHERE "r"."transaction_id" = "t"."id" AND "r"."account_id" = "t"."receiver_account_id";
@@ -0,0 +1,8 @@
+-- reverse: create "standing_order_executions" table
+DROP TABLE "public"."standing_order_executions";
+-- reverse: create index "standing_order_status_next_attempt_date_idx" to table: "standing_orders"
+DROP INDEX "public"."standing_order_status_next_attempt_date_idx";
+-- reverse: create index "standing_order_account_id_idx" to table: "standing_orders"
+DROP INDEX "public"."standing_order_account_id_idx";
+-- reverse: create "standing_orders" table
+DROP TABLE "public"."standing_orders";
@@ -0,0 +1,8 @@
+-- create "standing_orders" table
+CREATE TABLE "public"."standing_orders" ("id" character(26) NOT NULL, "account_id" character(26) NOT NULL, "receiver_account_id" character(26) NOT NULL, "amount" bigint NOT NULL, "currency_id" character(26) NOT NULL, "frequency" character varying(10) NOT NULL, "day_of_month" smallint NULL, "start_date" date NOT NULL, "end_date" date NULL, "max_executions" integer NULL, "max_retries" smallint NOT NULL, "failure_policy" character varying(10) NOT NULL, "status" character varying(10) NOT NULL, "next_run_date" date NOT NULL, "next_attempt_date" date NOT NULL, "retry_count" smallint NOT NULL, "execution_count" integer NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "version" bigint NOT NULL DEFAULT 1, PRIMARY KEY ("id"), CONSTRAINT "fk_standing_order_account_id" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_standing_order_currency_id" FOREIGN KEY ("currency_id") REFERENCES "public"."currency_master" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_standing_order_receiver_account_id" FOREIGN KEY ("receiver_account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "standing_order_account_id_idx" to table: "standing_orders"
+CREATE INDEX "standing_order_account_id_idx" ON "public"."standing_orders" ("account_id");
+-- create index "standing_order_status_next_attempt_date_idx" to table: "standing_orders"
+CREATE INDEX "standing_order_status_next_attempt_date_idx" ON "public"."standing_orders" ("status", "next_attempt_date");
+-- create "standing_order_executions" table
+CREATE TABLE "public"."standing_order_executions" ("standing_order_id" character(26) NOT NULL, "scheduled_date" date NOT NULL, "attempt" smallint NOT NULL, "result" character varying(16) NOT NULL, "transaction_id" character(26) NULL, "failure_reason" text NULL, "executed_at" timestamptz NOT NULL, PRIMARY KEY ("standing_order_id", "scheduled_date", "attempt"), CONSTRAINT "fk_standing_order_execution_standing_order_id" FOREIGN KEY ("standing_order_id") REFERENCES "public"."standing_orders" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_standing_order_execution_transaction_id" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
@@ -0,0 +1,6 @@
+-- reverse: create index "transaction_reversed_transaction_id_idx" to table: "transactions"
+DROP INDEX "public"."transaction_reversed_transaction_id_idx";
+-- reverse: modify "transactions" table
+ALTER TABLE "public"."transactions" DROP CONSTRAINT "fk_transaction_reversed_transaction_id", DROP CONSTRAINT "fk_transaction_reversed_operation_type", DROP COLUMN "reversed_operation_type", DROP COLUMN "reversed_transaction_id";
+-- reverse: add "REVERSAL" to "operation_type_master" table
+DELETE FROM "public"."operation_type_master" WHERE "type" = 'REVERSAL';
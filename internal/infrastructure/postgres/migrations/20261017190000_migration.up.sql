@@ -0,0 +1,6 @@
+-- add "REVERSAL" to "operation_type_master" table
+INSERT INTO "public"."operation_type_master" ("type") VALUES ('REVERSAL') ON CONFLICT ("type") DO NOTHING;
+-- modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "reversed_transaction_id" character(26) NULL, ADD COLUMN "reversed_operation_type" character varying(20) NULL, ADD CONSTRAINT "fk_transaction_reversed_operation_type" FOREIGN KEY ("reversed_operation_type") REFERENCES "public"."operation_type_master" ("type") ON UPDATE NO ACTION ON DELETE NO ACTION, ADD CONSTRAINT "fk_transaction_reversed_transaction_id" FOREIGN KEY ("reversed_transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- create index "transaction_reversed_transaction_id_idx" to table: "transactions"
+CREATE UNIQUE INDEX "transaction_reversed_transaction_id_idx" ON "public"."transactions" ("reversed_transaction_id");
@@ -0,0 +1,8 @@
+-- reverse: create index "hold_status_expires_at_idx" to table: "holds"
+DROP INDEX "public"."hold_status_expires_at_idx";
+-- reverse: create index "hold_account_id_idx" to table: "holds"
+DROP INDEX "public"."hold_account_id_idx";
+-- reverse: create "holds" table
+DROP TABLE "public"."holds";
+-- reverse: modify "accounts" table
+ALTER TABLE "public"."accounts" DROP COLUMN "held_amount";
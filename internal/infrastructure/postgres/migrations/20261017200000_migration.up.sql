@@ -0,0 +1,8 @@
+-- modify "accounts" table
+ALTER TABLE "public"."accounts" ADD COLUMN "held_amount" bigint NOT NULL DEFAULT 0;
+-- create "holds" table
+CREATE TABLE "public"."holds" ("id" character(26) NOT NULL, "account_id" character(26) NOT NULL, "receiver_account_id" character(26) NULL, "amount" bigint NOT NULL, "currency_id" character(26) NOT NULL, "status" character varying(10) NOT NULL, "expires_at" timestamptz NOT NULL, "captured_amount" bigint NULL, "transaction_id" character(26) NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "version" bigint NOT NULL DEFAULT 1, PRIMARY KEY ("id"), CONSTRAINT "fk_hold_account_id" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_hold_currency_id" FOREIGN KEY ("currency_id") REFERENCES "public"."currency_master" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_hold_receiver_account_id" FOREIGN KEY ("receiver_account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_hold_transaction_id" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "hold_account_id_idx" to table: "holds"
+CREATE INDEX "hold_account_id_idx" ON "public"."holds" ("account_id");
+-- create index "hold_status_expires_at_idx" to table: "holds"
+CREATE INDEX "hold_status_expires_at_idx" ON "public"."holds" ("status", "expires_at");
@@ -0,0 +1,2 @@
+-- reverse: create "account_limits" table
+DROP TABLE "public"."account_limits";
@@ -0,0 +1,2 @@
+-- create "account_limits" table
+CREATE TABLE "public"."account_limits" ("account_id" character(26) NOT NULL, "operation_type" character varying(20) NOT NULL, "per_transaction_amount" bigint NOT NULL, "daily_amount" bigint NOT NULL, "monthly_amount" bigint NOT NULL, "currency_id" character(26) NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("account_id", "operation_type"), CONSTRAINT "fk_account_limit_account_id" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_account_limit_currency_id" FOREIGN KEY ("currency_id") REFERENCES "public"."currency_master" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_account_limit_operation_type" FOREIGN KEY ("operation_type") REFERENCES "public"."operation_type_master" ("type") ON UPDATE NO ACTION ON DELETE NO ACTION);
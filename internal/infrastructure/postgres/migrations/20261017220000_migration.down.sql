@@ -0,0 +1,10 @@
+-- reverse: create index "transaction_category_account_id_category_idx" to table: "transaction_categories"
+DROP INDEX "public"."transaction_category_account_id_category_idx";
+-- reverse: create "transaction_categories" table
+DROP TABLE "public"."transaction_categories";
+-- reverse: create index "transaction_reference_search_idx" to table: "transactions"
+DROP INDEX "public"."transaction_reference_search_idx";
+-- reverse: create index "transaction_search_idx" to table: "transactions"
+DROP INDEX "public"."transaction_search_idx";
+-- reverse: modify "transactions" table
+ALTER TABLE "public"."transactions" DROP COLUMN "reference", DROP COLUMN "memo";
@@ -0,0 +1,10 @@
+-- modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "memo" character varying(200) NULL, ADD COLUMN "reference" character varying(140) NULL;
+-- create index "transaction_search_idx" to table: "transactions"
+CREATE INDEX "transaction_search_idx" ON "public"."transactions" USING GIN ((to_tsvector('simple'::regconfig, (((COALESCE(memo, ''::character varying))::text || ' '::text) || (COALESCE(reference, ''::character varying))::text))));
+-- create index "transaction_reference_search_idx" to table: "transactions"
+CREATE INDEX "transaction_reference_search_idx" ON "public"."transactions" USING GIN ((to_tsvector('simple'::regconfig, (COALESCE(reference, ''::character varying))::text)));
+-- create "transaction_categories" table
+CREATE TABLE "public"."transaction_categories" ("transaction_id" character(26) NOT NULL, "account_id" character(26) NOT NULL, "category" character varying(30) NOT NULL, PRIMARY KEY ("transaction_id", "account_id", "category"), CONSTRAINT "fk_transaction_category_account_id" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_transaction_category_transaction_id" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "transaction_category_account_id_category_idx" to table: "transaction_categories"
+CREATE INDEX "transaction_category_account_id_category_idx" ON "public"."transaction_categories" ("account_id", "category");
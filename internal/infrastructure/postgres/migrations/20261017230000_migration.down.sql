@@ -0,0 +1,6 @@
+-- reverse: create "receiving_accounts" table
+DROP TABLE "public"."receiving_accounts";
+-- reverse: create index "payee_user_id_payee_user_id_idx" to table: "payees"
+DROP INDEX "public"."payee_user_id_payee_user_id_idx";
+-- reverse: create "payees" table
+DROP TABLE "public"."payees";
@@ -0,0 +1,6 @@
+-- create "payees" table
+CREATE TABLE "public"."payees" ("id" character(26) NOT NULL, "user_id" character(26) NOT NULL, "payee_user_id" character(26) NOT NULL, "masked_name" character varying(20) NOT NULL, "nickname" character varying(30) NULL, "confirmed_at" timestamptz NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "fk_payee_payee_user_id" FOREIGN KEY ("payee_user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_payee_user_id" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "payee_user_id_payee_user_id_idx" to table: "payees"
+CREATE UNIQUE INDEX "payee_user_id_payee_user_id_idx" ON "public"."payees" ("user_id", "payee_user_id");
+-- create "receiving_accounts" table
+CREATE TABLE "public"."receiving_accounts" ("user_id" character(26) NOT NULL, "currency_id" character(26) NOT NULL, "account_id" character(26) NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("user_id", "currency_id"), CONSTRAINT "fk_receiving_account_account_id" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_receiving_account_currency_id" FOREIGN KEY ("currency_id") REFERENCES "public"."currency_master" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "fk_receiving_account_user_id" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
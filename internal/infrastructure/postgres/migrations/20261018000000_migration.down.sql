@@ -0,0 +1,4 @@
+-- reverse: create index "outbox_unpublished_idx" to table: "outbox"
+DROP INDEX "public"."outbox_unpublished_idx";
+-- reverse: create "outbox" table
+DROP TABLE "public"."outbox";
@@ -0,0 +1,4 @@
+-- create "outbox" table
+CREATE TABLE "public"."outbox" ("sequence" bigserial NOT NULL, "id" character(26) NOT NULL, "event_type" character varying(40) NOT NULL, "aggregate_type" character varying(20) NOT NULL, "aggregate_id" character(26) NOT NULL, "payload" jsonb NOT NULL, "occurred_at" timestamptz NOT NULL, "published_at" timestamptz NULL, PRIMARY KEY ("sequence"), CONSTRAINT "outbox_id_key" UNIQUE ("id"));
+-- create index "outbox_unpublished_idx" to table: "outbox"
+CREATE INDEX "outbox_unpublished_idx" ON "public"."outbox" ("sequence") WHERE (published_at IS NULL);
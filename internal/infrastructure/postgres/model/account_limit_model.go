@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// AccountLimit は利用者が口座に設定した取引種別ごとの取引金額の上限を表します。金額は口座の通貨の最小単位です。
+type AccountLimit struct {
+	bun.BaseModel        `bun:"table:account_limits"`
+	AccountID            string    `bun:"account_id,pk,type:char(26),notnull"`
+	OperationType        string    `bun:"operation_type,pk,type:varchar(20),notnull"`
+	PerTransactionAmount int64     `bun:"per_transaction_amount,type:bigint,notnull"`
+	DailyAmount          int64     `bun:"daily_amount,type:bigint,notnull"`
+	MonthlyAmount        int64     `bun:"monthly_amount,type:bigint,notnull"`
+	CurrencyID           string    `bun:"currency_id,type:char(26),notnull"`
+	UpdatedAt            time.Time `bun:"updated_at,notnull"`
+
+	Account             *Account             `bun:"rel:belongs-to,join:account_id=id"`
+	Currency            *CurrencyMaster      `bun:"rel:belongs-to,join:currency_id=id"`
+	OperationTypeMaster *OperationTypeMaster `bun:"rel:belongs-to,join:operation_type=type"`
+}
+
+var AccountLimitAccountFK = ForeignKey{
+	Table:            "account_limits",
+	ConstraintName:   "fk_account_limit_account_id",
+	Column:           "account_id",
+	ReferencedTable:  "accounts",
+	ReferencedColumn: "id",
+}
+
+var AccountLimitCurrencyFK = ForeignKey{
+	Table:            "account_limits",
+	ConstraintName:   "fk_account_limit_currency_id",
+	Column:           "currency_id",
+	ReferencedTable:  "currency_master",
+	ReferencedColumn: "id",
+}
+
+var AccountLimitOperationTypeFK = ForeignKey{
+	Table:            "account_limits",
+	ConstraintName:   "fk_account_limit_operation_type",
+	Column:           "operation_type",
+	ReferencedTable:  "operation_type_master",
+	ReferencedColumn: "type",
+}
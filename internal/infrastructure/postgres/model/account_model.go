@@ -12,9 +12,11 @@ type Account struct {
 	UserID        string    `bun:"user_id,type:char(26),notnull"`
 	Name          string    `bun:"name,type:varchar(20)"`
 	PasswordHash  string    `bun:"password_hash,notnull"`
-	Balance       float64   `bun:"balance,type:float8,notnull"`
+	Balance       int64     `bun:"balance,type:bigint,notnull"`
+	HeldAmount    int64     `bun:"held_amount,type:bigint,notnull,default:0"`
 	CurrencyID    string    `bun:"currency_id,notnull"`
 	UpdatedAt     time.Time `bun:"updated_at,notnull"`
+	Version       int64     `bun:"version,type:bigint,notnull,default:1"`
 	DeletedAt     time.Time `bun:",soft_delete,nullzero"`
 
 	User                 *User           `bun:"rel:belongs-to,join:user_id=id"`
@@ -8,4 +8,6 @@ type CurrencyMaster struct {
 	bun.BaseModel `bun:"table:currency_master"`
 	ID            string `bun:"id,pk,type:char(26),notnull"`
 	Code          string `bun:"code,type:varchar(3),notnull,unique"`
+	Exponent      int    `bun:"exponent,type:smallint,notnull,default:0"`
+	Symbol        string `bun:"symbol,type:varchar(8),notnull,default:''"`
 }
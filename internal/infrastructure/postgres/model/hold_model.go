@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Hold は口座の残高の仮押さえを表します。仮押さえ中の金額の合計は accounts.held_amount に保持します。
+type Hold struct {
+	bun.BaseModel     `bun:"table:holds"`
+	ID                string    `bun:"id,pk,type:char(26),notnull"`
+	AccountID         string    `bun:"account_id,type:char(26),notnull"`
+	ReceiverAccountID *string   `bun:"receiver_account_id,type:char(26)"`
+	Amount            int64     `bun:"amount,type:bigint,notnull"`
+	CurrencyID        string    `bun:"currency_id,type:char(26),notnull"`
+	Status            string    `bun:"status,type:varchar(10),notnull"`
+	ExpiresAt         time.Time `bun:"expires_at,notnull"`
+	CapturedAmount    *int64    `bun:"captured_amount,type:bigint"`
+	TransactionID     *string   `bun:"transaction_id,type:char(26)"`
+	CreatedAt         time.Time `bun:"created_at,notnull"`
+	UpdatedAt         time.Time `bun:"updated_at,notnull"`
+	Version           int64     `bun:"version,type:bigint,notnull,default:1"`
+
+	Account         *Account        `bun:"rel:belongs-to,join:account_id=id"`
+	ReceiverAccount *Account        `bun:"rel:belongs-to,join:receiver_account_id=id"`
+	Currency        *CurrencyMaster `bun:"rel:belongs-to,join:currency_id=id"`
+	Transaction     *Transaction    `bun:"rel:belongs-to,join:transaction_id=id"`
+}
+
+var HoldAccountFK = ForeignKey{
+	Table:            "holds",
+	ConstraintName:   "fk_hold_account_id",
+	Column:           "account_id",
+	ReferencedTable:  "accounts",
+	ReferencedColumn: "id",
+}
+
+var HoldReceiverAccountFK = ForeignKey{
+	Table:            "holds",
+	ConstraintName:   "fk_hold_receiver_account_id",
+	Column:           "receiver_account_id",
+	ReferencedTable:  "accounts",
+	ReferencedColumn: "id",
+}
+
+var HoldCurrencyFK = ForeignKey{
+	Table:            "holds",
+	ConstraintName:   "fk_hold_currency_id",
+	Column:           "currency_id",
+	ReferencedTable:  "currency_master",
+	ReferencedColumn: "id",
+}
+
+var HoldTransactionFK = ForeignKey{
+	Table:            "holds",
+	ConstraintName:   "fk_hold_transaction_id",
+	Column:           "transaction_id",
+	ReferencedTable:  "transactions",
+	ReferencedColumn: "id",
+}
+
+var HoldIdxCreators = []IndexQueryCreators{
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*Hold)(nil)).
+			Index("hold_account_id_idx").
+			Column("account_id")
+	},
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*Hold)(nil)).
+			Index("hold_status_expires_at_idx").
+			Column("status", "expires_at")
+	},
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type IdempotencyKey struct {
+	bun.BaseModel `bun:"table:idempotency_keys"`
+	UserID        string    `bun:"user_id,pk,type:char(26),notnull"`
+	Key           string    `bun:"key,pk,type:varchar(255),notnull"`
+	Fingerprint   string    `bun:"fingerprint,type:char(64),notnull"`
+	Response      *string   `bun:"response,type:text"`
+	CreatedAt     time.Time `bun:"created_at,notnull"`
+}
+
+var IdempotencyKeyUserFK = ForeignKey{
+	Table:            "idempotency_keys",
+	ConstraintName:   "fk_idempotency_key_user_id",
+	Column:           "user_id",
+	ReferencedTable:  "users",
+	ReferencedColumn: "id",
+}
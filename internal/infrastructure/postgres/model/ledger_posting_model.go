@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// LedgerPosting は仕訳の1行を表します。顧客口座（account_id）またはシステム勘定（system_account）のどちらか一方に記帳します。
+type LedgerPosting struct {
+	bun.BaseModel `bun:"table:ledger_postings"`
+	TransactionID string    `bun:"transaction_id,pk,type:char(26),notnull"`
+	Line          int       `bun:"line,pk,type:smallint,notnull"`
+	AccountID     *string   `bun:"account_id,type:char(26)"`
+	SystemAccount *string   `bun:"system_account,type:varchar(32)"`
+	Side          string    `bun:"side,type:varchar(6),notnull"`
+	Amount        int64     `bun:"amount,type:bigint,notnull"`
+	CurrencyID    string    `bun:"currency_id,type:char(26),notnull"`
+	PostedAt      time.Time `bun:"posted_at,notnull"`
+
+	Transaction *Transaction    `bun:"rel:belongs-to,join:transaction_id=id"`
+	Account     *Account        `bun:"rel:belongs-to,join:account_id=id"`
+	Currency    *CurrencyMaster `bun:"rel:belongs-to,join:currency_id=id"`
+}
+
+var LedgerPostingTransactionFK = ForeignKey{
+	Table:            "ledger_postings",
+	ConstraintName:   "fk_ledger_posting_transaction_id",
+	Column:           "transaction_id",
+	ReferencedTable:  "transactions",
+	ReferencedColumn: "id",
+}
+
+var LedgerPostingAccountFK = ForeignKey{
+	Table:            "ledger_postings",
+	ConstraintName:   "fk_ledger_posting_account_id",
+	Column:           "account_id",
+	ReferencedTable:  "accounts",
+	ReferencedColumn: "id",
+}
+
+var LedgerPostingCurrencyFK = ForeignKey{
+	Table:            "ledger_postings",
+	ConstraintName:   "fk_ledger_posting_currency_id",
+	Column:           "currency_id",
+	ReferencedTable:  "currency_master",
+	ReferencedColumn: "id",
+}
+
+var LedgerPostingAccountIDIdxCreator = []IndexQueryCreators{
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*LedgerPosting)(nil)).
+			Index("ledger_posting_account_id_idx").
+			Column("account_id")
+	},
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Lockout はパスワードや暗証番号の連続失敗回数を保持します。subject はロックの対象の種類 (user, account) です。
+type Lockout struct {
+	bun.BaseModel `bun:"table:lockouts"`
+	Subject       string     `bun:"subject,pk,type:varchar(20),notnull"`
+	SubjectID     string     `bun:"subject_id,pk,type:char(26),notnull"`
+	FailedCount   int        `bun:"failed_count,notnull"`
+	LockedUntil   *time.Time `bun:"locked_until"`
+	UpdatedAt     time.Time  `bun:"updated_at,notnull"`
+}
@@ -8,15 +8,49 @@ var Models = []interface{}{
 	(*User)(nil),
 	(*Account)(nil),
 	(*Transaction)(nil),
+	(*TransactionCategory)(nil),
+	(*LedgerPosting)(nil),
 	(*Authentication)(nil),
+	(*IdempotencyKey)(nil),
+	(*Session)(nil),
+	(*RefreshToken)(nil),
+	(*Lockout)(nil),
+	(*StandingOrder)(nil),
+	(*StandingOrderExecution)(nil),
+	(*Hold)(nil),
+	(*AccountLimit)(nil),
+	(*Payee)(nil),
+	(*ReceivingAccount)(nil),
+	(*OutboxEvent)(nil),
 }
 
 type IndexQueryCreators func(db *bun.DB) *bun.CreateIndexQuery
 
 func AllIdxCreators() []IndexQueryCreators {
 	return append(
-		append(AccountUserIDIdxCreator, UserEmailIdxCreator...),
-		append(TransactionSenderAccountIDIdxCreator, TransactionReceiverAccountIDIdxCreator...)...,
+		append(
+			append(
+				append(
+					append(
+						append(AccountUserIDIdxCreator, UserEmailIdxCreator...),
+						append(
+							append(TransactionSenderAccountIDIdxCreator, TransactionReceiverAccountIDIdxCreator...),
+							TransactionReversedTransactionIDIdxCreator...,
+						)...,
+					),
+					LedgerPostingAccountIDIdxCreator...,
+				),
+				RefreshTokenSessionIDIdxCreator...,
+			),
+			StandingOrderIdxCreators...,
+		),
+		append(
+			append(
+				append(HoldIdxCreators, TransactionSearchIdxCreators...),
+				TransactionCategoryIdxCreators...,
+			),
+			append(PayeeIdxCreators, OutboxEventIdxCreators...)...,
+		)...,
 	)
 }
 
@@ -35,5 +69,33 @@ var ForeignKeys = []ForeignKey{
 	TransactionAccountFK,
 	TransactionReceiverAccountFK,
 	TransactionCurrencyFK,
+	TransactionReceiverCurrencyFK,
 	OperationTypeFK,
+	TransactionReversedTransactionFK,
+	TransactionReversedOperationTypeFK,
+	LedgerPostingTransactionFK,
+	LedgerPostingAccountFK,
+	LedgerPostingCurrencyFK,
+	IdempotencyKeyUserFK,
+	SessionUserFK,
+	RefreshTokenSessionFK,
+	StandingOrderAccountFK,
+	StandingOrderReceiverAccountFK,
+	StandingOrderCurrencyFK,
+	StandingOrderExecutionStandingOrderFK,
+	StandingOrderExecutionTransactionFK,
+	HoldAccountFK,
+	HoldReceiverAccountFK,
+	HoldCurrencyFK,
+	HoldTransactionFK,
+	AccountLimitAccountFK,
+	AccountLimitCurrencyFK,
+	AccountLimitOperationTypeFK,
+	TransactionCategoryTransactionFK,
+	TransactionCategoryAccountFK,
+	PayeeUserFK,
+	PayeePayeeUserFK,
+	ReceivingAccountUserFK,
+	ReceivingAccountCurrencyFK,
+	ReceivingAccountAccountFK,
 }
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// OutboxEvent はドメインイベントを状態の変更と同じトランザクションで記録するアウトボックスです。
+// sequence は記録した順序を表し、中継処理は未発行のイベントをこの順に発行します。集約をまたぐ為、外部キーは持ちません。
+type OutboxEvent struct {
+	bun.BaseModel `bun:"table:outbox"`
+	Sequence      int64           `bun:"sequence,pk,autoincrement"`
+	ID            string          `bun:"id,type:char(26),notnull,unique"`
+	EventType     string          `bun:"event_type,type:varchar(40),notnull"`
+	AggregateType string          `bun:"aggregate_type,type:varchar(20),notnull"`
+	AggregateID   string          `bun:"aggregate_id,type:char(26),notnull"`
+	Payload       json.RawMessage `bun:"payload,type:jsonb,notnull"`
+	OccurredAt    time.Time       `bun:"occurred_at,notnull"`
+	PublishedAt   *time.Time      `bun:"published_at"`
+}
+
+// 発行済みのイベントは増え続ける為、未発行のイベントのみを索引に含めます。
+var OutboxEventIdxCreators = []IndexQueryCreators{
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*OutboxEvent)(nil)).
+			Index("outbox_unpublished_idx").
+			Column("sequence").
+			Where("published_at IS NULL")
+	},
+}
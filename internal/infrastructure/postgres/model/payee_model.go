@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Payee は利用者が振込先として登録した他の利用者を表します。masked_name は登録時点の支払先の名前を伏せたものです。
+type Payee struct {
+	bun.BaseModel `bun:"table:payees"`
+	ID            string     `bun:"id,pk,type:char(26),notnull"`
+	UserID        string     `bun:"user_id,type:char(26),notnull"`
+	PayeeUserID   string     `bun:"payee_user_id,type:char(26),notnull"`
+	MaskedName    string     `bun:"masked_name,type:varchar(20),notnull"`
+	Nickname      *string    `bun:"nickname,type:varchar(30)"`
+	ConfirmedAt   *time.Time `bun:"confirmed_at"`
+	CreatedAt     time.Time  `bun:"created_at,notnull"`
+
+	User      *User `bun:"rel:belongs-to,join:user_id=id"`
+	PayeeUser *User `bun:"rel:belongs-to,join:payee_user_id=id"`
+}
+
+var PayeeUserFK = ForeignKey{
+	Table:            "payees",
+	ConstraintName:   "fk_payee_user_id",
+	Column:           "user_id",
+	ReferencedTable:  "users",
+	ReferencedColumn: "id",
+}
+
+var PayeePayeeUserFK = ForeignKey{
+	Table:            "payees",
+	ConstraintName:   "fk_payee_payee_user_id",
+	Column:           "payee_user_id",
+	ReferencedTable:  "users",
+	ReferencedColumn: "id",
+}
+
+var PayeeIdxCreators = []IndexQueryCreators{
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*Payee)(nil)).
+			Index("payee_user_id_payee_user_id_idx").
+			Unique().
+			Column("user_id", "payee_user_id")
+	},
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// ReceivingAccount は利用者が支払先として振込を受け取る、通貨ごとの既定の口座を表します。
+type ReceivingAccount struct {
+	bun.BaseModel `bun:"table:receiving_accounts"`
+	UserID        string    `bun:"user_id,pk,type:char(26),notnull"`
+	CurrencyID    string    `bun:"currency_id,pk,type:char(26),notnull"`
+	AccountID     string    `bun:"account_id,type:char(26),notnull"`
+	UpdatedAt     time.Time `bun:"updated_at,notnull"`
+
+	User     *User           `bun:"rel:belongs-to,join:user_id=id"`
+	Currency *CurrencyMaster `bun:"rel:belongs-to,join:currency_id=id"`
+	Account  *Account        `bun:"rel:belongs-to,join:account_id=id"`
+}
+
+var ReceivingAccountUserFK = ForeignKey{
+	Table:            "receiving_accounts",
+	ConstraintName:   "fk_receiving_account_user_id",
+	Column:           "user_id",
+	ReferencedTable:  "users",
+	ReferencedColumn: "id",
+}
+
+var ReceivingAccountCurrencyFK = ForeignKey{
+	Table:            "receiving_accounts",
+	ConstraintName:   "fk_receiving_account_currency_id",
+	Column:           "currency_id",
+	ReferencedTable:  "currency_master",
+	ReferencedColumn: "id",
+}
+
+var ReceivingAccountAccountFK = ForeignKey{
+	Table:            "receiving_accounts",
+	ConstraintName:   "fk_receiving_account_account_id",
+	Column:           "account_id",
+	ReferencedTable:  "accounts",
+	ReferencedColumn: "id",
+}
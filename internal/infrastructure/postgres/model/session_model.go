@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type Session struct {
+	bun.BaseModel `bun:"table:sessions"`
+	ID            string     `bun:"id,pk,type:char(26),notnull"`
+	UserID        string     `bun:"user_id,type:char(26),notnull"`
+	CreatedAt     time.Time  `bun:"created_at,notnull"`
+	RevokedAt     *time.Time `bun:"revoked_at"`
+
+	User          *User           `bun:"rel:belongs-to,join:user_id=id"`
+	RefreshTokens []*RefreshToken `bun:"rel:has-many,join:id=session_id"`
+}
+
+// RefreshToken はリフレッシュトークンの SHA-256 ハッシュ値と、そのトークンが属するセッション（ファミリー）を保持します。
+type RefreshToken struct {
+	bun.BaseModel `bun:"table:refresh_tokens"`
+	TokenHash     string     `bun:"token_hash,pk,type:char(64),notnull"`
+	SessionID     string     `bun:"session_id,type:char(26),notnull"`
+	ExpiresAt     time.Time  `bun:"expires_at,notnull"`
+	UsedAt        *time.Time `bun:"used_at"`
+	CreatedAt     time.Time  `bun:"created_at,notnull"`
+
+	Session *Session `bun:"rel:belongs-to,join:session_id=id"`
+}
+
+var SessionUserFK = ForeignKey{
+	Table:            "sessions",
+	ConstraintName:   "fk_session_user_id",
+	Column:           "user_id",
+	ReferencedTable:  "users",
+	ReferencedColumn: "id",
+}
+
+var RefreshTokenSessionFK = ForeignKey{
+	Table:            "refresh_tokens",
+	ConstraintName:   "fk_refresh_token_session_id",
+	Column:           "session_id",
+	ReferencedTable:  "sessions",
+	ReferencedColumn: "id",
+}
+
+var RefreshTokenSessionIDIdxCreator = []IndexQueryCreators{
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*RefreshToken)(nil)).
+			Index("refresh_token_session_id_idx").
+			Column("session_id")
+	},
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// StandingOrder は自動振込を表します。next_attempt_date は next_run_date に retry_count 日を加えた日付で、
+// 実行すべき自動振込を索引で検索する為に保持します。
+type StandingOrder struct {
+	bun.BaseModel     `bun:"table:standing_orders"`
+	ID                string     `bun:"id,pk,type:char(26),notnull"`
+	AccountID         string     `bun:"account_id,type:char(26),notnull"`
+	ReceiverAccountID string     `bun:"receiver_account_id,type:char(26),notnull"`
+	Amount            int64      `bun:"amount,type:bigint,notnull"`
+	CurrencyID        string     `bun:"currency_id,type:char(26),notnull"`
+	Frequency         string     `bun:"frequency,type:varchar(10),notnull"`
+	DayOfMonth        *int       `bun:"day_of_month,type:smallint"`
+	StartDate         time.Time  `bun:"start_date,type:date,notnull"`
+	EndDate           *time.Time `bun:"end_date,type:date"`
+	MaxExecutions     *int       `bun:"max_executions,type:integer"`
+	MaxRetries        int        `bun:"max_retries,type:smallint,notnull"`
+	FailurePolicy     string     `bun:"failure_policy,type:varchar(10),notnull"`
+	Status            string     `bun:"status,type:varchar(10),notnull"`
+	NextRunDate       time.Time  `bun:"next_run_date,type:date,notnull"`
+	NextAttemptDate   time.Time  `bun:"next_attempt_date,type:date,notnull"`
+	RetryCount        int        `bun:"retry_count,type:smallint,notnull"`
+	ExecutionCount    int        `bun:"execution_count,type:integer,notnull"`
+	CreatedAt         time.Time  `bun:"created_at,notnull"`
+	UpdatedAt         time.Time  `bun:"updated_at,notnull"`
+	Version           int64      `bun:"version,type:bigint,notnull,default:1"`
+
+	Account         *Account        `bun:"rel:belongs-to,join:account_id=id"`
+	ReceiverAccount *Account        `bun:"rel:belongs-to,join:receiver_account_id=id"`
+	Currency        *CurrencyMaster `bun:"rel:belongs-to,join:currency_id=id"`
+}
+
+// StandingOrderExecution は自動振込の1回の実行の試みを表します。
+type StandingOrderExecution struct {
+	bun.BaseModel   `bun:"table:standing_order_executions"`
+	StandingOrderID string    `bun:"standing_order_id,pk,type:char(26),notnull"`
+	ScheduledDate   time.Time `bun:"scheduled_date,pk,type:date,notnull"`
+	Attempt         int       `bun:"attempt,pk,type:smallint,notnull"`
+	Result          string    `bun:"result,type:varchar(16),notnull"`
+	TransactionID   *string   `bun:"transaction_id,type:char(26)"`
+	FailureReason   *string   `bun:"failure_reason,type:text"`
+	ExecutedAt      time.Time `bun:"executed_at,notnull"`
+
+	StandingOrder *StandingOrder `bun:"rel:belongs-to,join:standing_order_id=id"`
+	Transaction   *Transaction   `bun:"rel:belongs-to,join:transaction_id=id"`
+}
+
+var StandingOrderAccountFK = ForeignKey{
+	Table:            "standing_orders",
+	ConstraintName:   "fk_standing_order_account_id",
+	Column:           "account_id",
+	ReferencedTable:  "accounts",
+	ReferencedColumn: "id",
+}
+
+var StandingOrderReceiverAccountFK = ForeignKey{
+	Table:            "standing_orders",
+	ConstraintName:   "fk_standing_order_receiver_account_id",
+	Column:           "receiver_account_id",
+	ReferencedTable:  "accounts",
+	ReferencedColumn: "id",
+}
+
+var StandingOrderCurrencyFK = ForeignKey{
+	Table:            "standing_orders",
+	ConstraintName:   "fk_standing_order_currency_id",
+	Column:           "currency_id",
+	ReferencedTable:  "currency_master",
+	ReferencedColumn: "id",
+}
+
+var StandingOrderExecutionStandingOrderFK = ForeignKey{
+	Table:            "standing_order_executions",
+	ConstraintName:   "fk_standing_order_execution_standing_order_id",
+	Column:           "standing_order_id",
+	ReferencedTable:  "standing_orders",
+	ReferencedColumn: "id",
+}
+
+var StandingOrderExecutionTransactionFK = ForeignKey{
+	Table:            "standing_order_executions",
+	ConstraintName:   "fk_standing_order_execution_transaction_id",
+	Column:           "transaction_id",
+	ReferencedTable:  "transactions",
+	ReferencedColumn: "id",
+}
+
+var StandingOrderIdxCreators = []IndexQueryCreators{
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*StandingOrder)(nil)).
+			Index("standing_order_account_id_idx").
+			Column("account_id")
+	},
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*StandingOrder)(nil)).
+			Index("standing_order_status_next_attempt_date_idx").
+			Column("status", "next_attempt_date")
+	},
+}
@@ -0,0 +1,40 @@
+package model
+
+import "github.com/uptrace/bun"
+
+// TransactionCategory は口座の所有者が取引に付けた分類を表します。振込の送金元と受取口座はそれぞれ別の分類を持ちます。
+type TransactionCategory struct {
+	bun.BaseModel `bun:"table:transaction_categories"`
+	TransactionID string `bun:"transaction_id,pk,type:char(26),notnull"`
+	AccountID     string `bun:"account_id,pk,type:char(26),notnull"`
+	Category      string `bun:"category,pk,type:varchar(30),notnull"`
+
+	Transaction *Transaction `bun:"rel:belongs-to,join:transaction_id=id"`
+	Account     *Account     `bun:"rel:belongs-to,join:account_id=id"`
+}
+
+var TransactionCategoryTransactionFK = ForeignKey{
+	Table:            "transaction_categories",
+	ConstraintName:   "fk_transaction_category_transaction_id",
+	Column:           "transaction_id",
+	ReferencedTable:  "transactions",
+	ReferencedColumn: "id",
+}
+
+var TransactionCategoryAccountFK = ForeignKey{
+	Table:            "transaction_categories",
+	ConstraintName:   "fk_transaction_category_account_id",
+	Column:           "account_id",
+	ReferencedTable:  "accounts",
+	ReferencedColumn: "id",
+}
+
+// 分類による取引一覧の絞り込みに使用します。
+var TransactionCategoryIdxCreators = []IndexQueryCreators{
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*TransactionCategory)(nil)).
+			Index("transaction_category_account_id_category_idx").
+			Column("account_id", "category")
+	},
+}
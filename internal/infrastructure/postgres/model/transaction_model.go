@@ -7,19 +7,30 @@ import (
 )
 
 type Transaction struct {
-	bun.BaseModel     `bun:"table:transactions"`
-	ID                string    `bun:"id,pk,type:char(26),notnull"`
-	AccountID         string    `bun:"account_id,type:char(26),notnull"`
-	ReceiverAccountID *string   `bun:"receiver_account_id,type:char(26)"`
-	OperationType     string    `bun:"operation_type,type:varchar(20),notnull"`
-	Amount            float64   `bun:"amount,type:float8,notnull"`
-	CurrencyID        string    `bun:"currency_id,type:char(26),notnull"`
-	TransactionAt     time.Time `bun:"transaction_at,notnull"`
-
-	SenderAccount       *Account             `bun:"rel:belongs-to,join:account_id=id"`
-	ReceiverAccount     *Account             `bun:"rel:belongs-to,join:receiver_account_id=id"`
-	Currency            *CurrencyMaster      `bun:"rel:belongs-to,join:currency_id=id"`
-	OperationTypeMaster *OperationTypeMaster `bun:"rel:belongs-to,join:operation_type=type"`
+	bun.BaseModel         `bun:"table:transactions"`
+	ID                    string    `bun:"id,pk,type:char(26),notnull"`
+	AccountID             string    `bun:"account_id,type:char(26),notnull"`
+	ReceiverAccountID     *string   `bun:"receiver_account_id,type:char(26)"`
+	OperationType         string    `bun:"operation_type,type:varchar(20),notnull"`
+	Amount                int64     `bun:"amount,type:bigint,notnull"`
+	CurrencyID            string    `bun:"currency_id,type:char(26),notnull"`
+	ReceiverAmount        *int64    `bun:"receiver_amount,type:bigint"`
+	ReceiverCurrencyID    *string   `bun:"receiver_currency_id,type:char(26)"`
+	ExchangeRate          *string   `bun:"exchange_rate,type:numeric(24,12)"`
+	BalanceAfter          *int64    `bun:"balance_after,type:bigint"`
+	ReceiverBalanceAfter  *int64    `bun:"receiver_balance_after,type:bigint"`
+	ReversedTransactionID *string   `bun:"reversed_transaction_id,type:char(26)"`
+	ReversedOperationType *string   `bun:"reversed_operation_type,type:varchar(20)"`
+	Memo                  *string   `bun:"memo,type:varchar(200)"`
+	Reference             *string   `bun:"reference,type:varchar(140)"`
+	TransactionAt         time.Time `bun:"transaction_at,notnull"`
+
+	SenderAccount       *Account              `bun:"rel:belongs-to,join:account_id=id"`
+	ReceiverAccount     *Account              `bun:"rel:belongs-to,join:receiver_account_id=id"`
+	Currency            *CurrencyMaster       `bun:"rel:belongs-to,join:currency_id=id"`
+	ReceiverCurrency    *CurrencyMaster       `bun:"rel:belongs-to,join:receiver_currency_id=id"`
+	OperationTypeMaster *OperationTypeMaster  `bun:"rel:belongs-to,join:operation_type=type"`
+	Categories          []TransactionCategory `bun:"rel:has-many,join:id=transaction_id"`
 }
 
 var TransactionAccountFK = ForeignKey{
@@ -46,6 +57,14 @@ var TransactionCurrencyFK = ForeignKey{
 	ReferencedColumn: "id",
 }
 
+var TransactionReceiverCurrencyFK = ForeignKey{
+	Table:            "transactions",
+	ConstraintName:   "fk_transaction_receiver_currency_id",
+	Column:           "receiver_currency_id",
+	ReferencedTable:  "currency_master",
+	ReferencedColumn: "id",
+}
+
 var OperationTypeFK = ForeignKey{
 	Table:            "transactions",
 	ConstraintName:   "fk_transaction_operation_type",
@@ -54,6 +73,22 @@ var OperationTypeFK = ForeignKey{
 	ReferencedColumn: "type",
 }
 
+var TransactionReversedTransactionFK = ForeignKey{
+	Table:            "transactions",
+	ConstraintName:   "fk_transaction_reversed_transaction_id",
+	Column:           "reversed_transaction_id",
+	ReferencedTable:  "transactions",
+	ReferencedColumn: "id",
+}
+
+var TransactionReversedOperationTypeFK = ForeignKey{
+	Table:            "transactions",
+	ConstraintName:   "fk_transaction_reversed_operation_type",
+	Column:           "reversed_operation_type",
+	ReferencedTable:  "operation_type_master",
+	ReferencedColumn: "type",
+}
+
 var TransactionSenderAccountIDIdxCreator = []IndexQueryCreators{
 	func(db *bun.DB) *bun.CreateIndexQuery {
 		return db.NewCreateIndex().
@@ -71,3 +106,40 @@ var TransactionReceiverAccountIDIdxCreator = []IndexQueryCreators{
 			Column("receiver_account_id")
 	},
 }
+
+// 全文検索の対象となる列の式です。インデックスを使用する為、検索でも同じ式を使用します。
+// 単語の区切りと小文字への変換のみを行う simple 設定を使用し、言語による語形の変化は考慮しません。
+const (
+	// 取引を行った口座から見た場合の検索対象 (メモと参照情報) です。
+	TransactionSearchVector = `to_tsvector('simple', coalesce(memo, '') || ' ' || coalesce(reference, ''))`
+	// 振込を受け取った口座から見た場合の検索対象 (参照情報) です。
+	TransactionReferenceSearchVector = `to_tsvector('simple', coalesce(reference, ''))`
+)
+
+var TransactionSearchIdxCreators = []IndexQueryCreators{
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*Transaction)(nil)).
+			Index("transaction_search_idx").
+			Using("GIN").
+			ColumnExpr(TransactionSearchVector)
+	},
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*Transaction)(nil)).
+			Index("transaction_reference_search_idx").
+			Using("GIN").
+			ColumnExpr(TransactionReferenceSearchVector)
+	},
+}
+
+// 1つの取引は1回しか取り消せない為、取り消した取引のIDは一意です。
+var TransactionReversedTransactionIDIdxCreator = []IndexQueryCreators{
+	func(db *bun.DB) *bun.CreateIndexQuery {
+		return db.NewCreateIndex().
+			Model((*Transaction)(nil)).
+			Index("transaction_reversed_transaction_id_idx").
+			Unique().
+			Column("reversed_transaction_id")
+	},
+}
@@ -17,11 +17,14 @@ type User struct {
 	Accounts       []*Account      `bun:"rel:has-many,join:id=user_id"`
 }
 
+// メールアドレスの一意制約の名前です。同じメールアドレスでの同時のサインアップはこの制約で検出します。
+const UserEmailIndex = "user_email_idx"
+
 var UserEmailIdxCreator = []IndexQueryCreators{
 	func(db *bun.DB) *bun.CreateIndexQuery {
 		return db.NewCreateIndex().
 			Model((*User)(nil)).
-			Index("user_email_idx").
+			Index(UserEmailIndex).
 			Unique().
 			Column("email")
 	},
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+type accountLimitRepository struct {
+	*Repository[model.AccountLimit]
+}
+
+func NewAccountLimitRepository(db *bun.DB) limitDomain.IAccountLimitRepository {
+	return &accountLimitRepository{Repository: NewRepository[model.AccountLimit](db)}
+}
+
+func (r *accountLimitRepository) Save(ctx context.Context, accountLimit *limitDomain.AccountLimit) error {
+	limits := accountLimit.Limits()
+	currencyID, err := findCurrencyID(ctx, r.ExecDB(ctx), limits.Currency())
+	if err != nil {
+		return err
+	}
+
+	accountLimitModel := &model.AccountLimit{
+		AccountID:            accountLimit.AccountIDString(),
+		OperationType:        accountLimit.OperationType(),
+		PerTransactionAmount: limits.PerTransaction().Amount(),
+		DailyAmount:          limits.Daily().Amount(),
+		MonthlyAmount:        limits.Monthly().Amount(),
+		CurrencyID:           currencyID,
+		UpdatedAt:            accountLimit.UpdatedAt(),
+	}
+	_, err = r.ExecDB(ctx).NewInsert().Model(accountLimitModel).On("CONFLICT (account_id, operation_type) DO UPDATE").
+		Set("per_transaction_amount = EXCLUDED.per_transaction_amount").
+		Set("daily_amount = EXCLUDED.daily_amount").
+		Set("monthly_amount = EXCLUDED.monthly_amount").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
+
+func (r *accountLimitRepository) Find(ctx context.Context, accountID idVO.AccountID, operationType string) (*limitDomain.AccountLimit, error) {
+	accountLimitModel := &model.AccountLimit{}
+
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(accountLimitModel).
+		Relation("Currency").
+		Where("account_limit.account_id = ?", accountID.String()).
+		Where("account_limit.operation_type = ?", operationType).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return limitDomain.Reconstruct(
+		accountLimitModel.AccountID,
+		accountLimitModel.OperationType,
+		accountLimitModel.PerTransactionAmount,
+		accountLimitModel.DailyAmount,
+		accountLimitModel.MonthlyAmount,
+		accountLimitModel.Currency.Code,
+		accountLimitModel.UpdatedAt,
+	)
+}
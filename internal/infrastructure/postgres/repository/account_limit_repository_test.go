@@ -0,0 +1,158 @@
+package repository_test
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func newAccountLimitForTest(t *testing.T) *limitDomain.AccountLimit {
+	t.Helper()
+	limits, err := limitDomain.NewLimits(limitDomain.OperationWithdrawal, 1000, 3000, 10000, moneyVO.JPY)
+	assert.NoError(t, err)
+	return limitDomain.New(idVO.NewAccountIDForTest("account"), *limits, timer.GetFixedDate())
+}
+
+func TestAccountLimitRepository_Save(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewAccountLimitRepository)
+	accountLimit := newAccountLimitForTest(t)
+	currencyID := idVO.GenerateStaticULID("JPY")
+
+	currencySelectQuery := `SELECT "currency_master"."id" FROM "currency_master" WHERE (code = 'JPY')`
+	expectInsertQuery := fmt.Sprintf(`
+		INSERT INTO "account_limits" AS "account_limit" ("account_id", "operation_type", "per_transaction_amount",
+		"daily_amount", "monthly_amount", "currency_id", "updated_at")
+		VALUES ('%s', 'WITHDRAWAL', 1000, 3000, 10000, '%s', '%s')
+		ON CONFLICT (account_id, operation_type) DO UPDATE SET
+		per_transaction_amount = EXCLUDED.per_transaction_amount,
+		daily_amount = EXCLUDED.daily_amount,
+		monthly_amount = EXCLUDED.monthly_amount,
+		updated_at = EXCLUDED.updated_at
+	`, accountLimit.AccountIDString(), currencyID, accountLimit.UpdatedAt().Format(timestampFormat))
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 口座の上限の保存が成功する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectExec(regexp.QuoteMeta(expectInsertQuery)).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: 通貨マスタの取得に失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectExec(regexp.QuoteMeta(expectInsertQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.Save(ctx, accountLimit)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAccountLimitRepository_Find(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewAccountLimitRepository)
+	accountLimit := newAccountLimitForTest(t)
+	currencyID := idVO.GenerateStaticULID("JPY")
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "account_limit"."account_id", "account_limit"."operation_type", "account_limit"."per_transaction_amount",
+		"account_limit"."daily_amount", "account_limit"."monthly_amount", "account_limit"."currency_id", "account_limit"."updated_at",
+		"currency"."id" AS "currency__id", "currency"."code" AS "currency__code", "currency"."exponent" AS "currency__exponent", "currency"."symbol" AS "currency__symbol"
+		FROM "account_limits" AS "account_limit"
+		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "account_limit"."currency_id")
+		WHERE (account_limit.account_id = '%s') AND (account_limit.operation_type = 'WITHDRAWAL')
+	`, accountLimit.AccountIDString())
+	columns := []string{
+		"account_id", "operation_type", "per_transaction_amount", "daily_amount", "monthly_amount", "currency_id", "updated_at",
+		"currency__id", "currency__code",
+	}
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		want     *limitDomain.AccountLimit
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 口座の上限の取得が成功する",
+			prepare: func() {
+				rows := sqlmock.NewRows(columns).AddRow(
+					accountLimit.AccountIDString(), "WITHDRAWAL", 1000, 3000, 10000, currencyID, accountLimit.UpdatedAt(),
+					currencyID, "JPY",
+				)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			want:    accountLimit,
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 口座の上限が設定されていない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			got, err := repo.Find(ctx, accountLimit.AccountID(), limitDomain.OperationWithdrawal)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -20,15 +20,7 @@ func NewAccountRepository(db *bun.DB) accountDomain.IAccountRepository {
 }
 
 func (r *accountRepository) Save(ctx context.Context, account *accountDomain.Account) error {
-	currencyCode := account.Balance().Currency()
-
-	var currencyID string
-	err := r.ExecDB(ctx).NewSelect().
-		Model((*model.CurrencyMaster)(nil)).
-		Column("id").
-		Where("code = ?", currencyCode).
-		Scan(ctx, &currencyID)
-
+	currencyID, err := findCurrencyID(ctx, r.ExecDB(ctx), account.Balance().Currency())
 	if err != nil {
 		return err
 	}
@@ -39,22 +31,40 @@ func (r *accountRepository) Save(ctx context.Context, account *accountDomain.Acc
 		UserID:       account.UserIDString(),
 		PasswordHash: account.PasswordHash(),
 		Balance:      account.Balance().Amount(),
+		HeldAmount:   account.HeldBalance().Amount(),
 		CurrencyID:   currencyID,
 		UpdatedAt:    account.UpdatedAt(),
+		Version:      account.Version() + 1,
 	}
 
 	// TODO: If use a subquery, the following error will occur, so first get the current_id and then update it.
 	// pgdriver.Error: ERROR: insert or update on table "accounts" violates foreign key constraint "fk_account_currency_id" (SQLSTATE=23503)
-	_, err = r.ExecDB(ctx).NewInsert().Model(accountModel).On("CONFLICT (id) DO UPDATE").
+	// 読み込んだ時点のバージョンと一致する場合のみ更新する（楽観的排他制御）。
+	// 更新されなかった場合は、他のリクエストが先に口座を更新している。
+	result, err := r.ExecDB(ctx).NewInsert().Model(accountModel).On("CONFLICT (id) DO UPDATE").
 		Set("name = EXCLUDED.name").
 		Set("user_id = EXCLUDED.user_id").
 		Set("password_hash = EXCLUDED.password_hash").
 		Set("balance = EXCLUDED.balance").
+		Set("held_amount = EXCLUDED.held_amount").
 		Set("currency_id = EXCLUDED.currency_id").
 		Set("updated_at = EXCLUDED.updated_at").
+		Set("version = EXCLUDED.version").
+		Where("account.version = ?", account.Version()).
 		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return accountDomain.ErrConcurrentModification
+	}
 
-	return err
+	account.IncrementVersion()
+	return nil
 }
 
 func (r *accountRepository) FindByID(ctx context.Context, id idVO.AccountID) (*accountDomain.Account, error) {
@@ -71,6 +81,57 @@ func (r *accountRepository) FindByID(ctx context.Context, id idVO.AccountID) (*a
 		return nil, err
 	}
 
+	return reconstructAccount(accountModel)
+}
+
+func (r *accountRepository) ListByUserID(ctx context.Context, userID idVO.UserID) ([]*accountDomain.Account, error) {
+	var accountModels []*model.Account
+
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(&accountModels).
+		Relation("Currency").
+		Where("account.user_id = ?", userID.String()).
+		Order("account.id").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*accountDomain.Account, 0, len(accountModels))
+	for _, accountModel := range accountModels {
+		account, err := reconstructAccount(accountModel)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+func (r *accountRepository) CountByUserID(ctx context.Context, userID idVO.UserID) (int, error) {
+	return r.ExecDB(ctx).NewSelect().Model((*model.Account)(nil)).Where("user_id = ?", userID.String()).Count(ctx)
+}
+
+// deleted_at を設定して論理削除します。Save と同様に読み込んだ時点のバージョンと一致する場合のみ削除します。
+func (r *accountRepository) Delete(ctx context.Context, account *accountDomain.Account) error {
+	result, err := r.ExecDB(ctx).NewDelete().
+		Model((*model.Account)(nil)).
+		Where("id = ?", account.IDString()).
+		Where("version = ?", account.Version()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return accountDomain.ErrConcurrentModification
+	}
+	return nil
+}
+
+func reconstructAccount(accountModel *model.Account) (*accountDomain.Account, error) {
 	return accountDomain.Reconstruct(
 		accountModel.ID,
 		accountModel.UserID,
@@ -78,10 +139,8 @@ func (r *accountRepository) FindByID(ctx context.Context, id idVO.AccountID) (*a
 		accountModel.PasswordHash,
 		accountModel.Currency.Code,
 		accountModel.Balance,
+		accountModel.HeldAmount,
 		accountModel.UpdatedAt,
+		accountModel.Version,
 	)
 }
-
-func (r *accountRepository) CountByUserID(ctx context.Context, userID idVO.UserID) (int, error) {
-	return r.ExecDB(ctx).NewSelect().Model((*model.Account)(nil)).Where("user_id = ?", userID.String()).Count(ctx)
-}
@@ -19,69 +19,100 @@ func TestAccountRepository_Save(t *testing.T) {
 	userID := idVO.NewUserIDForTest("user")
 	money, err := moneyVO.New(1000, moneyVO.JPY)
 	assert.NoError(t, err)
-	account, err := accountDomain.New(userID, money.Amount(), "Test Account", "1234", money.Currency())
-	assert.NoError(t, err)
 	currencyID := idVO.GenerateStaticULID("JPY")
 
 	currencySelectQuery := `SELECT "currency_master"."id" FROM "currency_master" WHERE (code = 'JPY')`
-	expectInsertQuery := fmt.Sprintf(`
-		INSERT INTO "accounts" AS "account" ("id", "user_id", "name", "password_hash", "balance", "currency_id", "updated_at", "deleted_at")
-		VALUES ('%s', '%s', '%s', '%s', %.0f, '%s', '%s', DEFAULT)
-		ON CONFLICT (id) DO UPDATE SET
-		name = EXCLUDED.name,
-		user_id = EXCLUDED.user_id,
-		password_hash = EXCLUDED.password_hash,
-		balance = EXCLUDED.balance,
-		currency_id = EXCLUDED.currency_id,
-		updated_at = EXCLUDED.updated_at
-		RETURNING "deleted_at"
-	`, account.IDString(), account.UserIDString(), account.Name(), account.PasswordHash(), account.Balance().Amount(), currencyID, account.UpdatedAt().Format("2006-01-02 15:04:05-07:00"))
+	expectInsertQuery := func(account *accountDomain.Account) string {
+		return fmt.Sprintf(`
+			INSERT INTO "accounts" AS "account" ("id", "user_id", "name", "password_hash", "balance", "held_amount", "currency_id", "updated_at", "version", "deleted_at")
+			VALUES ('%s', '%s', '%s', '%s', %d, DEFAULT, '%s', '%s', %d, DEFAULT)
+			ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			user_id = EXCLUDED.user_id,
+			password_hash = EXCLUDED.password_hash,
+			balance = EXCLUDED.balance,
+			held_amount = EXCLUDED.held_amount,
+			currency_id = EXCLUDED.currency_id,
+			updated_at = EXCLUDED.updated_at,
+			version = EXCLUDED.version
+			WHERE (account.version = %d)
+			RETURNING "held_amount", "deleted_at"
+		`, account.IDString(), account.UserIDString(), account.Name(), account.PasswordHash(), account.Balance().Amount(),
+			currencyID, account.UpdatedAt().Format("2006-01-02 15:04:05-07:00"), account.Version()+1, account.Version())
+	}
 
 	tests := []struct {
-		caseName string
-		prepare  func()
-		wantErr  bool
+		caseName    string
+		prepare     func(account *accountDomain.Account)
+		wantVersion int64
+		errMsg      string
 	}{
 		{
-			caseName: "Positive: アカウントの保存が成功する",
-			prepare: func() {
+			caseName: "Positive: アカウントの保存が成功し、バージョンが進む",
+			prepare: func(account *accountDomain.Account) {
 				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
-				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery)).
-					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}))
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery(account))).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(nil))
 			},
-			wantErr: false,
+			wantVersion: 1,
+			errMsg:      "",
 		},
 		{
 			caseName: "Negative: 通貨マスタの取得に失敗する",
-			prepare: func() {
+			prepare: func(account *accountDomain.Account) {
 				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
 					WillReturnError(assert.AnError)
 			},
-			wantErr: true,
+			wantVersion: 0,
+			errMsg:      assert.AnError.Error(),
+		},
+		{
+			caseName: "Negative: 通貨マスタに存在しない通貨の場合、ErrUnsupportedCurrency を返す",
+			prepare: func(account *accountDomain.Account) {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantVersion: 0,
+			errMsg:      moneyVO.ErrUnsupportedCurrency.Error(),
+		},
+		{
+			caseName: "Negative: 他のリクエストが先に口座を更新していた場合、ErrConcurrentModification を返す",
+			prepare: func(account *accountDomain.Account) {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery(account))).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}))
+			},
+			wantVersion: 0,
+			errMsg:      accountDomain.ErrConcurrentModification.Error(),
 		},
 		{
 			caseName: "Negative: アカウントの保存に失敗する",
-			prepare: func() {
+			prepare: func(account *accountDomain.Account) {
 				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
-				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery)).
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery(account))).
 					WillReturnError(assert.AnError)
 			},
-			wantErr: true,
+			wantVersion: 0,
+			errMsg:      assert.AnError.Error(),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.caseName, func(t *testing.T) {
-			tt.prepare()
-			err := repo.Save(ctx, account)
+			account, err := accountDomain.New(userID, money.Amount(), "Test Account", "1234", money.Currency())
+			assert.NoError(t, err)
+			tt.prepare(account)
+			err = repo.Save(ctx, account)
 
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
 			} else {
 				assert.NoError(t, err)
 			}
+			assert.Equal(t, tt.wantVersion, account.Version())
 			err = mock.ExpectationsWereMet()
 			assert.NoError(t, err)
 		})
@@ -95,12 +126,13 @@ func TestAccountRepository_FindByID(t *testing.T) {
 	assert.NoError(t, err)
 	account, err := accountDomain.New(userID, money.Amount(), "Test Account", "1234", money.Currency())
 	assert.NoError(t, err)
+	account.IncrementVersion()
 	currencyID := idVO.GenerateStaticULID("JPY")
 
 	expectQuery := fmt.Sprintf(`
 		SELECT "account"."id", "account"."user_id", "account"."name", "account"."password_hash",
-		"account"."balance", "account"."currency_id", "account"."updated_at", "account"."deleted_at",
-		"currency"."id" AS "currency__id", "currency"."code" AS "currency__code"
+		"account"."balance", "account"."held_amount", "account"."currency_id", "account"."updated_at", "account"."version", "account"."deleted_at",
+		"currency"."id" AS "currency__id", "currency"."code" AS "currency__code", "currency"."exponent" AS "currency__exponent", "currency"."symbol" AS "currency__symbol"
 		FROM "accounts" AS "account"
 		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "account"."currency_id")
 		WHERE (account.id = '%s') AND "account"."deleted_at" IS NULL
@@ -116,12 +148,12 @@ func TestAccountRepository_FindByID(t *testing.T) {
 			caseName: "Positive: IDでアカウント取得が成功する",
 			prepare: func() {
 				rows := sqlmock.NewRows([]string{
-					"id", "name", "user_id", "password_hash", "balance", "currency_id",
-					"updated_at", "deleted_at", "currency__id", "currency__code",
+					"id", "name", "user_id", "password_hash", "balance", "held_amount", "currency_id",
+					"updated_at", "version", "deleted_at", "currency__id", "currency__code",
 				}).AddRow(
 					account.IDString(), account.Name(), account.UserIDString(),
-					account.PasswordHash(), account.Balance().Amount(), currencyID,
-					account.UpdatedAt(), nil, currencyID, "JPY",
+					account.PasswordHash(), account.Balance().Amount(), account.HeldBalance().Amount(), currencyID,
+					account.UpdatedAt(), account.Version(), nil, currencyID, "JPY",
 				)
 				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
 			},
@@ -216,3 +248,144 @@ func TestAccountRepository_CountByUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountRepository_ListByUserID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewAccountRepository)
+	userID := idVO.NewUserIDForTest("user_id_1")
+	currencyID := idVO.GenerateStaticULID("JPY")
+
+	var accounts []*accountDomain.Account
+	for _, name := range []string{"For work", "For private"} {
+		account, err := accountDomain.New(userID, 1000, name, "1234", moneyVO.JPY)
+		assert.NoError(t, err)
+		account.IncrementVersion()
+		accounts = append(accounts, account)
+	}
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "account"."id", "account"."user_id", "account"."name", "account"."password_hash",
+		"account"."balance", "account"."held_amount", "account"."currency_id", "account"."updated_at", "account"."version", "account"."deleted_at",
+		"currency"."id" AS "currency__id", "currency"."code" AS "currency__code", "currency"."exponent" AS "currency__exponent", "currency"."symbol" AS "currency__symbol"
+		FROM "accounts" AS "account"
+		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "account"."currency_id")
+		WHERE (account.user_id = '%s') AND "account"."deleted_at" IS NULL
+		ORDER BY "account"."id"
+	`, userID.String())
+
+	tests := []struct {
+		caseName     string
+		prepare      func()
+		wantAccounts []*accountDomain.Account
+		wantErr      bool
+	}{
+		{
+			caseName: "Positive: ユーザーIDで口座一覧の取得が成功する",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{
+					"id", "name", "user_id", "password_hash", "balance", "held_amount", "currency_id",
+					"updated_at", "version", "deleted_at", "currency__id", "currency__code",
+				})
+				for _, account := range accounts {
+					rows.AddRow(
+						account.IDString(), account.Name(), account.UserIDString(),
+						account.PasswordHash(), account.Balance().Amount(), account.HeldBalance().Amount(), currencyID,
+						account.UpdatedAt(), account.Version(), nil, currencyID, "JPY",
+					)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantAccounts: accounts,
+			wantErr:      false,
+		},
+		{
+			caseName: "Positive: 口座が存在しない場合、空のスライスを返す",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{"id"})
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantAccounts: []*accountDomain.Account{},
+			wantErr:      false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantAccounts: nil,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			foundAccounts, err := repo.ListByUserID(ctx, userID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, foundAccounts)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantAccounts, foundAccounts)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAccountRepository_Delete(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewAccountRepository)
+	userID := idVO.NewUserIDForTest("user_id_1")
+	account, err := accountDomain.New(userID, 0, "Test Account", "1234", moneyVO.JPY)
+	assert.NoError(t, err)
+	account.IncrementVersion()
+
+	// deleted_at には削除時の現在時刻が設定される為、任意の値に一致させる
+	expectQuery := regexp.QuoteMeta(`UPDATE "accounts" AS "account" SET "deleted_at" = '`) + `[^']+` +
+		regexp.QuoteMeta(fmt.Sprintf(`' WHERE (id = '%s') AND (version = 1) AND "account"."deleted_at" IS NULL`, account.IDString()))
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 口座の論理削除が成功する",
+			prepare: func() {
+				mock.ExpectExec(expectQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: 他のリクエストが先に口座を更新している場合、エラーが返る",
+			prepare: func() {
+				mock.ExpectExec(expectQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			errMsg: accountDomain.ErrConcurrentModification.Error(),
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(expectQuery).WillReturnError(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.Delete(ctx, account)
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
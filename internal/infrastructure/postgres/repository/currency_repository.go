@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+type currencyRepository struct {
+	*Repository[model.CurrencyMaster]
+}
+
+func NewCurrencyRepository(db *bun.DB) moneyVO.ICurrencyRepository {
+	return &currencyRepository{Repository: NewRepository[model.CurrencyMaster](db)}
+}
+
+func (r *currencyRepository) FindAll(ctx context.Context) ([]*moneyVO.Currency, error) {
+	var currencyModels []model.CurrencyMaster
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(&currencyModels).
+		Order("code ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	currencies := make([]*moneyVO.Currency, 0, len(currencyModels))
+	for _, m := range currencyModels {
+		currency, err := moneyVO.NewCurrency(m.Code, m.Exponent, m.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		currencies = append(currencies, currency)
+	}
+	return currencies, nil
+}
+
+// 通貨コードに対応する通貨マスタのIDを取得します。通貨マスタに存在しない場合は ErrUnsupportedCurrency を返します。
+func findCurrencyID(ctx context.Context, db bun.IDB, code string) (string, error) {
+	var currencyID string
+	err := db.NewSelect().
+		Model((*model.CurrencyMaster)(nil)).
+		Column("id").
+		Where("code = ?", code).
+		Scan(ctx, &currencyID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", moneyVO.ErrUnsupportedCurrency
+		}
+		return "", err
+	}
+	return currencyID, nil
+}
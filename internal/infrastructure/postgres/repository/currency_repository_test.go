@@ -0,0 +1,89 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+)
+
+func TestCurrencyRepository_FindAll(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewCurrencyRepository)
+	jpy, err := moneyVO.NewCurrency(moneyVO.JPY, 0, "¥")
+	assert.NoError(t, err)
+	usd, err := moneyVO.NewCurrency(moneyVO.USD, 2, "$")
+	assert.NoError(t, err)
+
+	expectQuery := `
+		SELECT "currency_master"."id", "currency_master"."code", "currency_master"."exponent", "currency_master"."symbol"
+		FROM "currency_master"
+		ORDER BY "code" ASC
+	`
+	columns := []string{"id", "code", "exponent", "symbol"}
+
+	tests := []struct {
+		caseName       string
+		prepare        func()
+		wantCurrencies []*moneyVO.Currency
+		wantErr        bool
+	}{
+		{
+			caseName: "Positive: 通貨の一覧取得が成功する",
+			prepare: func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow(idVO.GenerateStaticULID("JPY"), moneyVO.JPY, 0, "¥").
+					AddRow(idVO.GenerateStaticULID("USD"), moneyVO.USD, 2, "$")
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantCurrencies: []*moneyVO.Currency{jpy, usd},
+			wantErr:        false,
+		},
+		{
+			caseName: "Positive: 通貨マスタが空の場合、空のスライスを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			wantCurrencies: []*moneyVO.Currency{},
+			wantErr:        false,
+		},
+		{
+			caseName: "Negative: 通貨マスタに不正なデータがある場合、エラーを返す",
+			prepare: func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow(idVO.GenerateStaticULID("JPY"), "jpy", 0, "¥")
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantCurrencies: nil,
+			wantErr:        true,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantCurrencies: nil,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			currencies, err := repo.FindAll(ctx)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, currencies)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCurrencies, currencies)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+type eventRepository struct {
+	*Repository[model.OutboxEvent]
+}
+
+// SQL は PostgreSQL に依存しない為、SQLite でもそのまま使用します。
+func NewEventRepository(db *bun.DB) eventDomain.IEventRepository {
+	return &eventRepository{Repository: NewRepository[model.OutboxEvent](db)}
+}
+
+// 既に記録したイベントは発行日時のみ更新し、記録した順序は変わりません。
+func (r *eventRepository) Save(ctx context.Context, event *eventDomain.Event) error {
+	eventModel := &model.OutboxEvent{
+		ID:            event.IDString(),
+		EventType:     event.Type(),
+		AggregateType: event.AggregateType(),
+		AggregateID:   event.AggregateID(),
+		Payload:       event.Payload(),
+		OccurredAt:    event.OccurredAt(),
+		PublishedAt:   event.PublishedAt(),
+	}
+	_, err := r.ExecDB(ctx).NewInsert().Model(eventModel).On("CONFLICT (id) DO UPDATE").
+		Set("published_at = EXCLUDED.published_at").
+		Returning("NULL").
+		Exec(ctx)
+	return err
+}
+
+func (r *eventRepository) ListUnpublished(ctx context.Context, limit int, excluded []eventDomain.Aggregate) ([]*eventDomain.Event, error) {
+	var eventModels []*model.OutboxEvent
+	query := r.ExecDB(ctx).NewSelect().
+		Model(&eventModels).
+		Where("outbox_event.published_at IS NULL")
+	for _, aggregate := range excluded {
+		query = query.Where("NOT (outbox_event.aggregate_type = ? AND outbox_event.aggregate_id = ?)", aggregate.Type, aggregate.ID)
+	}
+	if err := query.
+		Order("outbox_event.sequence ASC").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	events := make([]*eventDomain.Event, 0, len(eventModels))
+	for _, eventModel := range eventModels {
+		event, err := eventDomain.Reconstruct(
+			eventModel.ID,
+			eventModel.EventType,
+			eventModel.AggregateType,
+			eventModel.AggregateID,
+			eventModel.Payload,
+			eventModel.OccurredAt,
+			eventModel.PublishedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
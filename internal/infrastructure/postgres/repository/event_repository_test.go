@@ -0,0 +1,129 @@
+package repository_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+var outboxEventColumns = []string{"sequence", "id", "event_type", "aggregate_type", "aggregate_id", "payload", "occurred_at", "published_at"}
+
+func newEventForTest(t *testing.T) *eventDomain.Event {
+	t.Helper()
+	event, err := eventDomain.New(eventDomain.AccountOpened{
+		AccountID: idVO.NewAccountIDForTest("account").String(),
+		UserID:    idVO.NewUserIDForTest("user").String(),
+		Name:      "For work",
+		Currency:  "JPY",
+	}, timer.GetFixedDate())
+	assert.NoError(t, err)
+	return event
+}
+
+func TestEventRepository_Save(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewEventRepository)
+	event := newEventForTest(t)
+
+	expectQuery := fmt.Sprintf(`
+		INSERT INTO "outbox" AS "outbox_event" ("sequence", "id", "event_type", "aggregate_type", "aggregate_id", "payload", "occurred_at", "published_at")
+		VALUES (DEFAULT, '%s', 'AccountOpened', 'ACCOUNT', '%s', '%s', '%s', DEFAULT)
+		ON CONFLICT (id) DO UPDATE SET
+		published_at = EXCLUDED.published_at
+	`, event.IDString(), event.AggregateID(), string(event.Payload()), event.OccurredAt().Format(timestampFormat))
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: イベントの保存が成功する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.Save(ctx, event)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEventRepository_ListUnpublished(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewEventRepository)
+	event := newEventForTest(t)
+
+	expectQuery := `
+		SELECT "outbox_event"."sequence", "outbox_event"."id", "outbox_event"."event_type", "outbox_event"."aggregate_type",
+		"outbox_event"."aggregate_id", "outbox_event"."payload", "outbox_event"."occurred_at", "outbox_event"."published_at"
+		FROM "outbox" AS "outbox_event"
+		WHERE (outbox_event.published_at IS NULL)
+		ORDER BY "outbox_event"."sequence" ASC
+		LIMIT 100
+	`
+
+	t.Run("Positive: 未発行のイベントを記録した順に取得できる", func(t *testing.T) {
+		rows := sqlmock.NewRows(outboxEventColumns).AddRow(
+			1, event.IDString(), event.Type(), event.AggregateType(), event.AggregateID(),
+			[]byte(event.Payload()), event.OccurredAt(), nil,
+		)
+		mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+
+		got, err := repo.ListUnpublished(ctx, eventDomain.RelayBatchSize, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []*eventDomain.Event{event}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Positive: 除外した集約のイベントは取得しない", func(t *testing.T) {
+		excludeQuery := fmt.Sprintf(`
+			SELECT "outbox_event"."sequence", "outbox_event"."id", "outbox_event"."event_type", "outbox_event"."aggregate_type",
+			"outbox_event"."aggregate_id", "outbox_event"."payload", "outbox_event"."occurred_at", "outbox_event"."published_at"
+			FROM "outbox" AS "outbox_event"
+			WHERE (outbox_event.published_at IS NULL)
+			AND (NOT (outbox_event.aggregate_type = 'ACCOUNT' AND outbox_event.aggregate_id = '%s'))
+			ORDER BY "outbox_event"."sequence" ASC
+			LIMIT 99
+		`, event.AggregateID())
+		mock.ExpectQuery(regexp.QuoteMeta(excludeQuery)).WillReturnRows(sqlmock.NewRows(outboxEventColumns))
+
+		got, err := repo.ListUnpublished(ctx, eventDomain.RelayBatchSize-1, []eventDomain.Aggregate{event.Aggregate()})
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Negative: SQLエラーで失敗する", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+
+		got, err := repo.ListUnpublished(ctx, eventDomain.RelayBatchSize, nil)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
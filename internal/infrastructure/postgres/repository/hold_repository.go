@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+type holdRepository struct {
+	*Repository[model.Hold]
+}
+
+func NewHoldRepository(db *bun.DB) holdDomain.IHoldRepository {
+	return &holdRepository{Repository: NewRepository[model.Hold](db)}
+}
+
+func (r *holdRepository) Save(ctx context.Context, hold *holdDomain.Hold) error {
+	currencyID, err := findCurrencyID(ctx, r.ExecDB(ctx), hold.Amount().Currency())
+	if err != nil {
+		return err
+	}
+
+	var capturedAmount *int64
+	if hold.CapturedAmount() != nil {
+		amount := hold.CapturedAmount().Amount()
+		capturedAmount = &amount
+	}
+	holdModel := &model.Hold{
+		ID:                hold.IDString(),
+		AccountID:         hold.AccountIDString(),
+		ReceiverAccountID: hold.ReceiverAccountIDString(),
+		Amount:            hold.Amount().Amount(),
+		CurrencyID:        currencyID,
+		Status:            hold.Status(),
+		ExpiresAt:         hold.ExpiresAt(),
+		CapturedAmount:    capturedAmount,
+		TransactionID:     hold.TransactionIDString(),
+		CreatedAt:         hold.CreatedAt(),
+		UpdatedAt:         hold.UpdatedAt(),
+		Version:           hold.Version() + 1,
+	}
+
+	// 口座と同様に、読み込んだ時点のバージョンと一致する場合のみ更新する（楽観的排他制御）。
+	// 確定や取消とスケジューラーによる解除が同時に行われた場合は、後から保存した方が失敗する。
+	result, err := r.ExecDB(ctx).NewInsert().Model(holdModel).On("CONFLICT (id) DO UPDATE").
+		Set("status = EXCLUDED.status").
+		Set("captured_amount = EXCLUDED.captured_amount").
+		Set("transaction_id = EXCLUDED.transaction_id").
+		Set("updated_at = EXCLUDED.updated_at").
+		Set("version = EXCLUDED.version").
+		Where("hold.version = ?", hold.Version()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return holdDomain.ErrConcurrentModification
+	}
+
+	hold.IncrementVersion()
+	return nil
+}
+
+func (r *holdRepository) FindByID(ctx context.Context, id idVO.HoldID) (*holdDomain.Hold, error) {
+	holdModel := &model.Hold{}
+
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(holdModel).
+		Relation("Currency").
+		Where("hold.id = ?", id.String()).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return reconstructHold(holdModel)
+}
+
+func (r *holdRepository) ListByAccountID(ctx context.Context, accountID idVO.AccountID, status *string) ([]*holdDomain.Hold, error) {
+	var holdModels []*model.Hold
+
+	query := r.ExecDB(ctx).NewSelect().
+		Model(&holdModels).
+		Relation("Currency").
+		Where("hold.account_id = ?", accountID.String())
+	if status != nil {
+		query = query.Where("hold.status = ?", *status)
+	}
+	if err := query.Order("hold.id DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reconstructHolds(holdModels)
+}
+
+func (r *holdRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*holdDomain.Hold, error) {
+	var holdModels []*model.Hold
+
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(&holdModels).
+		Relation("Currency").
+		Where("hold.status = ?", holdDomain.StatusAuthorized).
+		Where("hold.expires_at <= ?", now).
+		Order("hold.expires_at", "hold.id").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reconstructHolds(holdModels)
+}
+
+func reconstructHolds(holdModels []*model.Hold) ([]*holdDomain.Hold, error) {
+	holds := make([]*holdDomain.Hold, 0, len(holdModels))
+	for _, holdModel := range holdModels {
+		hold, err := reconstructHold(holdModel)
+		if err != nil {
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+	return holds, nil
+}
+
+func reconstructHold(m *model.Hold) (*holdDomain.Hold, error) {
+	return holdDomain.Reconstruct(
+		m.ID,
+		m.AccountID,
+		m.ReceiverAccountID,
+		m.Amount,
+		m.Currency.Code,
+		m.Status,
+		m.ExpiresAt,
+		m.CapturedAmount,
+		m.TransactionID,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.Version,
+	)
+}
@@ -0,0 +1,324 @@
+package repository_test
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func newHoldForTest(t *testing.T) *holdDomain.Hold {
+	t.Helper()
+	now := timer.GetFixedDate()
+	hold, err := holdDomain.New(idVO.NewAccountIDForTest("account"), nil, 1000, moneyVO.JPY, now.Add(24*time.Hour), now)
+	assert.NoError(t, err)
+	return hold
+}
+
+func TestHoldRepository_Save(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewHoldRepository)
+	currencyID := idVO.GenerateStaticULID("JPY")
+
+	currencySelectQuery := `SELECT "currency_master"."id" FROM "currency_master" WHERE (code = 'JPY')`
+	expectInsertQuery := func(hold *holdDomain.Hold) string {
+		return fmt.Sprintf(`
+			INSERT INTO "holds" AS "hold" ("id", "account_id", "receiver_account_id", "amount", "currency_id", "status",
+			"expires_at", "captured_amount", "transaction_id", "created_at", "updated_at", "version")
+			VALUES ('%s', '%s', DEFAULT, 1000, '%s', 'AUTHORIZED', '%s', DEFAULT, DEFAULT, '%s', '%s', %d)
+			ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			captured_amount = EXCLUDED.captured_amount,
+			transaction_id = EXCLUDED.transaction_id,
+			updated_at = EXCLUDED.updated_at,
+			version = EXCLUDED.version
+			WHERE (hold.version = %d)
+			RETURNING "receiver_account_id", "captured_amount", "transaction_id"
+		`, hold.IDString(), hold.AccountIDString(), currencyID,
+			hold.ExpiresAt().Format(timestampFormat), hold.CreatedAt().Format(timestampFormat),
+			hold.UpdatedAt().Format(timestampFormat), hold.Version()+1, hold.Version())
+	}
+	returningColumns := []string{"receiver_account_id", "captured_amount", "transaction_id"}
+
+	tests := []struct {
+		caseName    string
+		prepare     func(hold *holdDomain.Hold)
+		wantVersion int64
+		wantErr     error
+	}{
+		{
+			caseName: "Positive: 仮押さえの保存が成功し、バージョンが進む",
+			prepare: func(hold *holdDomain.Hold) {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery(hold))).
+					WillReturnRows(sqlmock.NewRows(returningColumns).AddRow(nil, nil, nil))
+			},
+			wantVersion: 1,
+			wantErr:     nil,
+		},
+		{
+			caseName: "Negative: 他のリクエストが先に仮押さえを更新していた場合、ErrConcurrentModification を返す",
+			prepare: func(hold *holdDomain.Hold) {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery(hold))).
+					WillReturnRows(sqlmock.NewRows(returningColumns))
+			},
+			wantVersion: 0,
+			wantErr:     holdDomain.ErrConcurrentModification,
+		},
+		{
+			caseName: "Negative: 通貨マスタの取得に失敗する",
+			prepare: func(hold *holdDomain.Hold) {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnError(assert.AnError)
+			},
+			wantVersion: 0,
+			wantErr:     assert.AnError,
+		},
+		{
+			caseName: "Negative: 仮押さえの保存に失敗する",
+			prepare: func(hold *holdDomain.Hold) {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery(hold))).
+					WillReturnError(assert.AnError)
+			},
+			wantVersion: 0,
+			wantErr:     assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			hold := newHoldForTest(t)
+			tt.prepare(hold)
+			err := repo.Save(ctx, hold)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantVersion, hold.Version())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+var holdColumns = []string{
+	"id", "account_id", "receiver_account_id", "amount", "currency_id", "status", "expires_at",
+	"captured_amount", "transaction_id", "created_at", "updated_at", "version",
+	"currency__id", "currency__code",
+}
+
+const holdSelectColumns = `
+	SELECT "hold"."id", "hold"."account_id", "hold"."receiver_account_id", "hold"."amount", "hold"."currency_id",
+	"hold"."status", "hold"."expires_at", "hold"."captured_amount", "hold"."transaction_id",
+	"hold"."created_at", "hold"."updated_at", "hold"."version",
+	"currency"."id" AS "currency__id", "currency"."code" AS "currency__code", "currency"."exponent" AS "currency__exponent", "currency"."symbol" AS "currency__symbol"
+	FROM "holds" AS "hold"
+	LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "hold"."currency_id")
+`
+
+func holdRow(rows *sqlmock.Rows, hold *holdDomain.Hold) *sqlmock.Rows {
+	currencyID := idVO.GenerateStaticULID("JPY")
+	return rows.AddRow(
+		hold.IDString(), hold.AccountIDString(), nil, hold.Amount().Amount(), currencyID, hold.Status(), hold.ExpiresAt(),
+		nil, nil, hold.CreatedAt(), hold.UpdatedAt(), hold.Version(),
+		currencyID, "JPY",
+	)
+}
+
+func TestHoldRepository_FindByID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewHoldRepository)
+	hold := newHoldForTest(t)
+	hold.IncrementVersion()
+
+	expectQuery := holdSelectColumns + fmt.Sprintf(`WHERE (hold.id = '%s')`, hold.IDString())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantHold *holdDomain.Hold
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: IDで仮押さえの取得が成功する",
+			prepare: func() {
+				rows := holdRow(sqlmock.NewRows(holdColumns), hold)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantHold: hold,
+			wantErr:  false,
+		},
+		{
+			caseName: "Positive: 仮押さえが見つからない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			wantHold: nil,
+			wantErr:  false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantHold: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			got, err := repo.FindByID(ctx, hold.ID())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantHold, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHoldRepository_ListByAccountID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewHoldRepository)
+	hold := newHoldForTest(t)
+	hold.IncrementVersion()
+	status := holdDomain.StatusAuthorized
+
+	tests := []struct {
+		caseName    string
+		status      *string
+		expectQuery string
+		prepare     func(expectQuery string)
+		wantLen     int
+		wantErr     bool
+	}{
+		{
+			caseName: "Positive: 口座の仮押さえの取得が成功する",
+			status:   nil,
+			expectQuery: holdSelectColumns + fmt.Sprintf(`
+				WHERE (hold.account_id = '%s')
+				ORDER BY "hold"."id" DESC
+			`, hold.AccountIDString()),
+			prepare: func(expectQuery string) {
+				rows := holdRow(sqlmock.NewRows(holdColumns), hold)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantLen: 1,
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 状態を指定して口座の仮押さえの取得が成功する",
+			status:   &status,
+			expectQuery: holdSelectColumns + fmt.Sprintf(`
+				WHERE (hold.account_id = '%s') AND (hold.status = 'AUTHORIZED')
+				ORDER BY "hold"."id" DESC
+			`, hold.AccountIDString()),
+			prepare: func(expectQuery string) {
+				rows := holdRow(sqlmock.NewRows(holdColumns), hold)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantLen: 1,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			status:   nil,
+			expectQuery: holdSelectColumns + fmt.Sprintf(`
+				WHERE (hold.account_id = '%s')
+				ORDER BY "hold"."id" DESC
+			`, hold.AccountIDString()),
+			prepare: func(expectQuery string) {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantLen: 0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare(tt.expectQuery)
+			got, err := repo.ListByAccountID(ctx, hold.AccountID(), tt.status)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []*holdDomain.Hold{hold}, got)
+			}
+			assert.Len(t, got, tt.wantLen)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHoldRepository_ListExpired(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewHoldRepository)
+	hold := newHoldForTest(t)
+	hold.IncrementVersion()
+	now := time.Date(2021, 1, 2, 9, 30, 0, 0, time.UTC)
+
+	expectQuery := holdSelectColumns + `
+		WHERE (hold.status = 'AUTHORIZED') AND (hold.expires_at <= '2021-01-02 09:30:00+00:00')
+		ORDER BY "hold"."expires_at", "hold"."id"
+		LIMIT 100
+	`
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantLen  int
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 有効期限を過ぎた仮押さえの取得が成功する",
+			prepare: func() {
+				rows := holdRow(sqlmock.NewRows(holdColumns), hold)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantLen: 1,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantLen: 0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			got, err := repo.ListExpired(ctx, now, holdDomain.ExpireBatchSize)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []*holdDomain.Hold{hold}, got)
+			}
+			assert.Len(t, got, tt.wantLen)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
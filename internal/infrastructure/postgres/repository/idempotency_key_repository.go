@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/u104rak1/pocgo/internal/application/idempotency"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+type idempotencyKeyRepository struct {
+	*Repository[model.IdempotencyKey]
+}
+
+func NewIdempotencyKeyRepository(db *bun.DB) idempotency.IIdempotencyKeyRepository {
+	return &idempotencyKeyRepository{Repository: NewRepository[model.IdempotencyKey](db)}
+}
+
+// 同じキーを処理中の別トランザクションが存在する場合、INSERT はそのトランザクションの終了まで待機します。
+func (r *idempotencyKeyRepository) Create(ctx context.Context, key *idempotency.IdempotencyKey) error {
+	keyModel := &model.IdempotencyKey{
+		UserID:      key.UserID,
+		Key:         key.Key,
+		Fingerprint: key.Fingerprint,
+		CreatedAt:   key.CreatedAt,
+	}
+	result, err := r.ExecDB(ctx).NewInsert().Model(keyModel).
+		On("CONFLICT (user_id, key) DO NOTHING").
+		Returning("NULL").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return idempotency.ErrKeyAlreadyExists
+	}
+	return nil
+}
+
+func (r *idempotencyKeyRepository) FindByUserIDAndKey(ctx context.Context, userID, key string) (*idempotency.IdempotencyKey, error) {
+	keyModel := &model.IdempotencyKey{}
+	if err := r.ExecDB(ctx).NewSelect().Model(keyModel).
+		Where("user_id = ?", userID).
+		Where("key = ?", key).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var response []byte
+	if keyModel.Response != nil {
+		response = []byte(*keyModel.Response)
+	}
+	return &idempotency.IdempotencyKey{
+		UserID:      keyModel.UserID,
+		Key:         keyModel.Key,
+		Fingerprint: keyModel.Fingerprint,
+		Response:    response,
+		CreatedAt:   keyModel.CreatedAt,
+	}, nil
+}
+
+func (r *idempotencyKeyRepository) SaveResponse(ctx context.Context, userID, key string, response []byte) error {
+	_, err := r.ExecDB(ctx).NewUpdate().Model((*model.IdempotencyKey)(nil)).
+		Set("response = ?", string(response)).
+		Where("user_id = ?", userID).
+		Where("key = ?", key).
+		Exec(ctx)
+	return err
+}
+
+func (r *idempotencyKeyRepository) DeletePending(ctx context.Context, userID, key string) error {
+	_, err := r.ExecDB(ctx).NewDelete().Model((*model.IdempotencyKey)(nil)).
+		Where("user_id = ?", userID).
+		Where("key = ?", key).
+		Where("response IS NULL").
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,248 @@
+package repository_test
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/u104rak1/pocgo/internal/application/idempotency"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestIdempotencyKeyRepository_Create(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewIdempotencyKeyRepository)
+	userID := idVO.NewUserIDForTest("user_id_1")
+	key := &idempotency.IdempotencyKey{
+		UserID:      userID.String(),
+		Key:         "key",
+		Fingerprint: "fingerprint",
+		CreatedAt:   timer.GetFixedDate(),
+	}
+
+	expectQuery := fmt.Sprintf(`
+		INSERT INTO "idempotency_keys" AS "idempotency_key" ("user_id", "key", "fingerprint", "response", "created_at")
+		VALUES ('%s', 'key', 'fingerprint', DEFAULT, '%s')
+		ON CONFLICT (user_id, key) DO NOTHING
+	`, userID.String(), timer.GetFixedDate().Format("2006-01-02 15:04:05-07:00"))
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: キーの登録が成功する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: 同じキーが既に存在する場合はエラーが返る",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			errMsg: idempotency.ErrKeyAlreadyExists.Error(),
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.Create(ctx, key)
+
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestIdempotencyKeyRepository_FindByUserIDAndKey(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewIdempotencyKeyRepository)
+	userID := idVO.NewUserIDForTest("user_id_1")
+	response := `{"ID":"transaction"}`
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "idempotency_key"."user_id", "idempotency_key"."key", "idempotency_key"."fingerprint", "idempotency_key"."response", "idempotency_key"."created_at"
+		FROM "idempotency_keys" AS "idempotency_key"
+		WHERE (user_id = '%s') AND (key = 'key')
+	`, userID.String())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		want     *idempotency.IdempotencyKey
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 保存済みのレスポンスを含むキーが取得できる",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{"user_id", "key", "fingerprint", "response", "created_at"}).
+					AddRow(userID.String(), "key", "fingerprint", response, timer.GetFixedDate())
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			want: &idempotency.IdempotencyKey{
+				UserID: userID.String(), Key: "key", Fingerprint: "fingerprint",
+				Response: []byte(response), CreatedAt: timer.GetFixedDate(),
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 処理中のキーはレスポンスが nil で取得できる",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{"user_id", "key", "fingerprint", "response", "created_at"}).
+					AddRow(userID.String(), "key", "fingerprint", nil, timer.GetFixedDate())
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			want: &idempotency.IdempotencyKey{
+				UserID: userID.String(), Key: "key", Fingerprint: "fingerprint",
+				CreatedAt: timer.GetFixedDate(),
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: キーが見つからない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			found, err := repo.FindByUserIDAndKey(ctx, userID.String(), "key")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, found)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, found)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestIdempotencyKeyRepository_SaveResponse(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewIdempotencyKeyRepository)
+	userID := idVO.NewUserIDForTest("user_id_1")
+	response := `{"ID":"transaction"}`
+
+	expectQuery := fmt.Sprintf(`
+		UPDATE "idempotency_keys" AS "idempotency_key"
+		SET response = '%s'
+		WHERE (user_id = '%s') AND (key = 'key')
+	`, response, userID.String())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: レスポンスの保存が成功する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.SaveResponse(ctx, userID.String(), "key", []byte(response))
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestIdempotencyKeyRepository_DeletePending(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewIdempotencyKeyRepository)
+	userID := idVO.NewUserIDForTest("user_id_1")
+
+	expectQuery := fmt.Sprintf(`
+		DELETE FROM "idempotency_keys" AS "idempotency_key"
+		WHERE (user_id = '%s') AND (key = 'key') AND (response IS NULL)
+	`, userID.String())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 処理中のキーの削除が成功する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.DeletePending(ctx, userID.String(), "key")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+type ledgerRepository struct {
+	*Repository[model.LedgerPosting]
+}
+
+func NewLedgerRepository(db *bun.DB) ledgerDomain.ILedgerRepository {
+	return &ledgerRepository{Repository: NewRepository[model.LedgerPosting](db)}
+}
+
+// 顧客口座の残高に対する増減額（貸方は加算、借方は減算）を求める式です。
+const signedAmountExpr = "CASE WHEN ledger_posting.side = 'CREDIT' THEN ledger_posting.amount ELSE -ledger_posting.amount END"
+
+func (r *ledgerRepository) Save(ctx context.Context, entry *ledgerDomain.Entry) error {
+	currencyIDs := make(map[string]string)
+	postingModels := make([]model.LedgerPosting, 0, len(entry.Postings()))
+	for i, p := range entry.Postings() {
+		currency := p.Amount().Currency()
+		currencyID, ok := currencyIDs[currency]
+		if !ok {
+			id, err := findCurrencyID(ctx, r.ExecDB(ctx), currency)
+			if err != nil {
+				return err
+			}
+			currencyIDs[currency] = id
+			currencyID = id
+		}
+
+		postingModels = append(postingModels, model.LedgerPosting{
+			TransactionID: entry.TransactionIDString(),
+			Line:          i + 1,
+			AccountID:     p.AccountIDString(),
+			SystemAccount: p.SystemAccount(),
+			Side:          p.Side(),
+			Amount:        p.Amount().Amount(),
+			CurrencyID:    currencyID,
+			PostedAt:      entry.PostedAt(),
+		})
+	}
+
+	_, err := r.ExecDB(ctx).NewInsert().Model(&postingModels).Exec(ctx)
+	return err
+}
+
+func (r *ledgerRepository) BalanceByAccountID(ctx context.Context, accountID idVO.AccountID, currency string) (int64, error) {
+	var balance int64
+	err := r.ExecDB(ctx).NewSelect().
+		Model((*model.LedgerPosting)(nil)).
+		ColumnExpr("COALESCE(SUM("+signedAmountExpr+"), 0)").
+		Join(`JOIN "currency_master" AS "currency" ON "currency"."id" = "ledger_posting"."currency_id"`).
+		Where("ledger_posting.account_id = ?", accountID.String()).
+		Where("currency.code = ?", currency).
+		Scan(ctx, &balance)
+	if err != nil {
+		return 0, err
+	}
+	return balance, nil
+}
+
+func (r *ledgerRepository) BalanceByAccountIDBefore(ctx context.Context, accountID idVO.AccountID, currency string, before time.Time) (int64, error) {
+	var balance int64
+	err := r.ExecDB(ctx).NewSelect().
+		Model((*model.LedgerPosting)(nil)).
+		ColumnExpr("COALESCE(SUM("+signedAmountExpr+"), 0)").
+		Join(`JOIN "currency_master" AS "currency" ON "currency"."id" = "ledger_posting"."currency_id"`).
+		Where("ledger_posting.account_id = ?", accountID.String()).
+		Where("currency.code = ?", currency).
+		Where("ledger_posting.posted_at < ?", before).
+		Scan(ctx, &balance)
+	if err != nil {
+		return 0, err
+	}
+	return balance, nil
+}
+
+func (r *ledgerRepository) ListBalanceMismatches(ctx context.Context) ([]*ledgerDomain.BalanceMismatch, error) {
+	var rows []struct {
+		AccountID     string `bun:"account_id"`
+		Currency      string `bun:"currency"`
+		StoredBalance int64  `bun:"stored_balance"`
+		LedgerBalance int64  `bun:"ledger_balance"`
+	}
+
+	// 口座の通貨以外で記帳されたものは残高に含めない
+	ledgerBalance := "COALESCE(SUM(" + signedAmountExpr + "), 0)"
+	err := r.ExecDB(ctx).NewSelect().
+		Model((*model.Account)(nil)).
+		ColumnExpr("account.id AS account_id").
+		ColumnExpr("currency.code AS currency").
+		ColumnExpr("account.balance AS stored_balance").
+		ColumnExpr(ledgerBalance+" AS ledger_balance").
+		Join(`JOIN "currency_master" AS "currency" ON "currency"."id" = "account"."currency_id"`).
+		Join(`LEFT JOIN "ledger_postings" AS "ledger_posting" ON "ledger_posting"."account_id" = "account"."id" AND "ledger_posting"."currency_id" = "account"."currency_id"`).
+		Group("account.id", "currency.code", "account.balance").
+		Having("account.balance <> "+ledgerBalance).
+		Order("account.id").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	mismatches := make([]*ledgerDomain.BalanceMismatch, 0, len(rows))
+	for _, row := range rows {
+		mismatches = append(mismatches, &ledgerDomain.BalanceMismatch{
+			AccountID:     row.AccountID,
+			Currency:      row.Currency,
+			StoredBalance: row.StoredBalance,
+			LedgerBalance: row.LedgerBalance,
+		})
+	}
+	return mismatches, nil
+}
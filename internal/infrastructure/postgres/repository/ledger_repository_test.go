@@ -0,0 +1,264 @@
+package repository_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestLedgerRepository_Save(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewLedgerRepository)
+	transactionID := idVO.NewTransactionIDForTest("transaction")
+	accountID := idVO.NewAccountIDForTest("account")
+	amount, err := moneyVO.New(1000, moneyVO.JPY)
+	assert.NoError(t, err)
+	entry, err := ledgerDomain.NewDepositEntry(transactionID, accountID, *amount, timer.GetFixedDate())
+	assert.NoError(t, err)
+	currencyID := idVO.GenerateStaticULID("JPY")
+	postedAt := timer.GetFixedDate().Format("2006-01-02 15:04:05-07:00")
+
+	currencySelectQuery := `SELECT "currency_master"."id" FROM "currency_master" WHERE (code = 'JPY')`
+	expectInsertQuery := fmt.Sprintf(`
+		INSERT INTO "ledger_postings" ("transaction_id", "line", "account_id", "system_account", "side", "amount", "currency_id", "posted_at")
+		VALUES ('%[1]s', 1, DEFAULT, 'SYSTEM_CASH', 'DEBIT', 1000, '%[3]s', '%[4]s'),
+		('%[1]s', 2, '%[2]s', DEFAULT, 'CREDIT', 1000, '%[3]s', '%[4]s')
+		RETURNING "account_id", "system_account"
+	`, transactionID.String(), accountID.String(), currencyID, postedAt)
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 仕訳の保存が成功する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"account_id", "system_account"}).
+						AddRow(nil, ledgerDomain.SystemCash).
+						AddRow(accountID.String(), nil))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: 通貨マスタの取得に失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: 仕訳の保存に失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery)).
+					WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.Save(ctx, entry)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLedgerRepository_BalanceByAccountID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewLedgerRepository)
+	accountID := idVO.NewAccountIDForTest("account")
+
+	expectQuery := fmt.Sprintf(`
+		SELECT COALESCE(SUM(CASE WHEN ledger_posting.side = 'CREDIT' THEN ledger_posting.amount ELSE -ledger_posting.amount END), 0)
+		FROM "ledger_postings" AS "ledger_posting"
+		JOIN "currency_master" AS "currency" ON "currency"."id" = "ledger_posting"."currency_id"
+		WHERE (ledger_posting.account_id = '%s') AND (currency.code = 'JPY')
+	`, accountID.String())
+
+	tests := []struct {
+		caseName    string
+		prepare     func()
+		wantBalance int64
+		wantErr     bool
+	}{
+		{
+			caseName: "Positive: 記帳から残高を算出できる",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(1500))
+			},
+			wantBalance: 1500,
+			wantErr:     false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantBalance: 0,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			balance, err := repo.BalanceByAccountID(ctx, accountID, moneyVO.JPY)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantBalance, balance)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLedgerRepository_ListBalanceMismatches(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewLedgerRepository)
+	accountID := idVO.NewAccountIDForTest("account")
+
+	expectQuery := `
+		SELECT account.id AS account_id, currency.code AS currency, account.balance AS stored_balance,
+		COALESCE(SUM(CASE WHEN ledger_posting.side = 'CREDIT' THEN ledger_posting.amount ELSE -ledger_posting.amount END), 0) AS ledger_balance
+		FROM "accounts" AS "account"
+		JOIN "currency_master" AS "currency" ON "currency"."id" = "account"."currency_id"
+		LEFT JOIN "ledger_postings" AS "ledger_posting" ON "ledger_posting"."account_id" = "account"."id" AND "ledger_posting"."currency_id" = "account"."currency_id"
+		WHERE "account"."deleted_at" IS NULL
+		GROUP BY "account"."id", "currency"."code", "account"."balance"
+		HAVING (account.balance <> COALESCE(SUM(CASE WHEN ledger_posting.side = 'CREDIT' THEN ledger_posting.amount ELSE -ledger_posting.amount END), 0))
+		ORDER BY "account"."id"
+	`
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		want     []*ledgerDomain.BalanceMismatch
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 残高が一致しない口座を取得できる",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{"account_id", "currency", "stored_balance", "ledger_balance"}).
+					AddRow(accountID.String(), moneyVO.JPY, 2000, 1500)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			want: []*ledgerDomain.BalanceMismatch{
+				{AccountID: accountID.String(), Currency: moneyVO.JPY, StoredBalance: 2000, LedgerBalance: 1500},
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 全ての口座の残高が一致する場合は空のスライスを返す",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{"account_id", "currency", "stored_balance", "ledger_balance"})
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			want:    []*ledgerDomain.BalanceMismatch{},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			mismatches, err := repo.ListBalanceMismatches(ctx)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, mismatches)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLedgerRepository_BalanceByAccountIDBefore(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewLedgerRepository)
+	accountID := idVO.NewAccountIDForTest("account")
+	before := timer.GetFixedDate()
+
+	expectQuery := fmt.Sprintf(`
+		SELECT COALESCE(SUM(CASE WHEN ledger_posting.side = 'CREDIT' THEN ledger_posting.amount ELSE -ledger_posting.amount END), 0)
+		FROM "ledger_postings" AS "ledger_posting"
+		JOIN "currency_master" AS "currency" ON "currency"."id" = "ledger_posting"."currency_id"
+		WHERE (ledger_posting.account_id = '%s') AND (currency.code = 'JPY') AND (ledger_posting.posted_at < '%s')
+	`, accountID.String(), before.Format("2006-01-02 15:04:05-07:00"))
+
+	tests := []struct {
+		caseName    string
+		prepare     func()
+		wantBalance int64
+		wantErr     bool
+	}{
+		{
+			caseName: "Positive: 指定日時より前の記帳から残高を算出できる",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(700))
+			},
+			wantBalance: 700,
+			wantErr:     false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantBalance: 0,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			balance, err := repo.BalanceByAccountIDBefore(ctx, accountID, moneyVO.JPY, before)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantBalance, balance)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
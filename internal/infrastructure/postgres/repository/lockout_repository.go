@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+// 失敗回数は照合に失敗したリクエストがロールバックされても残す必要がある為、
+// ExecDB ではなく常にトランザクション外の接続で読み書きします。
+type lockoutRepository struct {
+	*Repository[model.Lockout]
+}
+
+func NewLockoutRepository(db *bun.DB) lockoutDomain.ILockoutRepository {
+	return &lockoutRepository{Repository: NewRepository[model.Lockout](db)}
+}
+
+func (r *lockoutRepository) Find(ctx context.Context, subject lockoutDomain.Subject, subjectID string) (*lockoutDomain.Lockout, error) {
+	lockoutModel := &model.Lockout{}
+	if err := r.db.NewSelect().Model(lockoutModel).
+		Where("subject = ?", string(subject)).
+		Where("subject_id = ?", subjectID).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toDomain(lockoutModel)
+}
+
+func (r *lockoutRepository) IncrementFailedCount(ctx context.Context, subject lockoutDomain.Subject, subjectID string, now time.Time) (*lockoutDomain.Lockout, error) {
+	lockoutModel := &model.Lockout{
+		Subject:     string(subject),
+		SubjectID:   subjectID,
+		FailedCount: 1,
+		UpdatedAt:   now,
+	}
+	if _, err := r.db.NewInsert().Model(lockoutModel).
+		On("CONFLICT (subject, subject_id) DO UPDATE").
+		Set("failed_count = lockout.failed_count + 1").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return r.toDomain(lockoutModel)
+}
+
+// GREATEST は NULL を無視する為、初めてロックする場合はそのまま期限が設定されます。
+func (r *lockoutRepository) SaveLockedUntil(ctx context.Context, lockout *lockoutDomain.Lockout) error {
+	_, err := r.db.NewUpdate().Model((*model.Lockout)(nil)).
+		Set("locked_until = GREATEST(locked_until, ?)", lockout.LockedUntil()).
+		Set("updated_at = ?", lockout.UpdatedAt()).
+		Where("subject = ?", lockout.SubjectString()).
+		Where("subject_id = ?", lockout.SubjectID()).
+		Exec(ctx)
+	return err
+}
+
+func (r *lockoutRepository) Delete(ctx context.Context, subject lockoutDomain.Subject, subjectID string) error {
+	_, err := r.db.NewDelete().Model((*model.Lockout)(nil)).
+		Where("subject = ?", string(subject)).
+		Where("subject_id = ?", subjectID).
+		Exec(ctx)
+	return err
+}
+
+func (r *lockoutRepository) toDomain(lockoutModel *model.Lockout) (*lockoutDomain.Lockout, error) {
+	return lockoutDomain.Reconstruct(
+		lockoutModel.Subject,
+		lockoutModel.SubjectID,
+		lockoutModel.FailedCount,
+		lockoutModel.LockedUntil,
+		lockoutModel.UpdatedAt,
+	)
+}
@@ -0,0 +1,239 @@
+package repository_test
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestLockoutRepository_Find(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewLockoutRepository)
+	var (
+		accountID = idVO.NewAccountIDForTest("account")
+		updatedAt = timer.GetFixedDate()
+	)
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "lockout"."subject", "lockout"."subject_id", "lockout"."failed_count", "lockout"."locked_until", "lockout"."updated_at"
+		FROM "lockouts" AS "lockout"
+		WHERE (subject = 'account') AND (subject_id = '%s')
+	`, accountID.String())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantNil  bool
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: ロックの状態が取得できる",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{"subject", "subject_id", "failed_count", "locked_until", "updated_at"}).
+					AddRow("account", accountID.String(), 2, nil, updatedAt)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantNil: false,
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 失敗の記録がない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			wantNil: true,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantNil: true,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			lockout, err := repo.Find(ctx, lockoutDomain.SubjectAccount, accountID.String())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantNil {
+				assert.Nil(t, lockout)
+			} else {
+				assert.Equal(t, 2, lockout.FailedCount())
+				assert.Equal(t, updatedAt, lockout.UpdatedAt())
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLockoutRepository_IncrementFailedCount(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewLockoutRepository)
+	var (
+		userID = idVO.NewUserIDForTest("user")
+		now    = timer.GetFixedDate()
+	)
+
+	expectQuery := fmt.Sprintf(`
+		INSERT INTO "lockouts" AS "lockout" ("subject", "subject_id", "failed_count", "locked_until", "updated_at")
+		VALUES ('user', '%s', 1, DEFAULT, '%s')
+		ON CONFLICT (subject, subject_id) DO UPDATE SET failed_count = lockout.failed_count + 1, updated_at = EXCLUDED.updated_at
+		RETURNING *
+	`, userID.String(), now.Format("2006-01-02 15:04:05-07:00"))
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 失敗回数を増やし、更新後の状態を返す",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{"subject", "subject_id", "failed_count", "locked_until", "updated_at"}).
+					AddRow("user", userID.String(), 3, nil, now)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			lockout, err := repo.IncrementFailedCount(ctx, lockoutDomain.SubjectUser, userID.String(), now)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, lockout)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 3, lockout.FailedCount())
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLockoutRepository_SaveLockedUntil(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewLockoutRepository)
+	var (
+		userID      = idVO.NewUserIDForTest("user")
+		now         = timer.GetFixedDate()
+		lockedUntil = now.Add(time.Minute)
+	)
+	lockout, err := lockoutDomain.Reconstruct("user", userID.String(), lockoutDomain.MaxFailedAttempts, &lockedUntil, now)
+	assert.NoError(t, err)
+
+	expectQuery := fmt.Sprintf(`
+		UPDATE "lockouts" AS "lockout"
+		SET locked_until = GREATEST(locked_until, '%s'), updated_at = '%s'
+		WHERE (subject = 'user') AND (subject_id = '%s')
+	`, lockedUntil.Format("2006-01-02 15:04:05-07:00"), now.Format("2006-01-02 15:04:05-07:00"), userID.String())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: ロックの期限を保存できる",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.SaveLockedUntil(ctx, lockout)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLockoutRepository_Delete(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewLockoutRepository)
+	accountID := idVO.NewAccountIDForTest("account")
+
+	expectQuery := fmt.Sprintf(`
+		DELETE FROM "lockouts" AS "lockout"
+		WHERE (subject = 'account') AND (subject_id = '%s')
+	`, accountID.String())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 失敗回数をリセットできる",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.Delete(ctx, lockoutDomain.SubjectAccount, accountID.String())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
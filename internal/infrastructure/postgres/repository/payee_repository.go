@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+type payeeRepository struct {
+	*Repository[model.Payee]
+}
+
+func NewPayeeRepository(db *bun.DB) payeeDomain.IPayeeRepository {
+	return &payeeRepository{Repository: NewRepository[model.Payee](db)}
+}
+
+func (r *payeeRepository) Save(ctx context.Context, payee *payeeDomain.Payee) error {
+	payeeModel := &model.Payee{
+		ID:          payee.IDString(),
+		UserID:      payee.UserIDString(),
+		PayeeUserID: payee.PayeeUserIDString(),
+		MaskedName:  payee.MaskedName(),
+		Nickname:    payee.Nickname(),
+		ConfirmedAt: payee.ConfirmedAt(),
+		CreatedAt:   payee.CreatedAt(),
+	}
+	_, err := r.ExecDB(ctx).NewInsert().Model(payeeModel).On("CONFLICT (id) DO UPDATE").
+		Set("nickname = EXCLUDED.nickname").
+		Set("confirmed_at = EXCLUDED.confirmed_at").
+		Exec(ctx)
+	return err
+}
+
+func (r *payeeRepository) FindByID(ctx context.Context, id idVO.PayeeID) (*payeeDomain.Payee, error) {
+	payeeModel := &model.Payee{}
+	if err := r.ExecDB(ctx).NewSelect().Model(payeeModel).Where("payee.id = ?", id.String()).Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return reconstructPayee(payeeModel)
+}
+
+func (r *payeeRepository) ListByUserID(ctx context.Context, userID idVO.UserID) ([]*payeeDomain.Payee, error) {
+	var payeeModels []*model.Payee
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(&payeeModels).
+		Where("payee.user_id = ?", userID.String()).
+		Order("payee.created_at ASC", "payee.id ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	payees := make([]*payeeDomain.Payee, 0, len(payeeModels))
+	for _, payeeModel := range payeeModels {
+		payee, err := reconstructPayee(payeeModel)
+		if err != nil {
+			return nil, err
+		}
+		payees = append(payees, payee)
+	}
+	return payees, nil
+}
+
+func (r *payeeRepository) CountByUserID(ctx context.Context, userID idVO.UserID) (int, error) {
+	return r.ExecDB(ctx).NewSelect().Model((*model.Payee)(nil)).Where("user_id = ?", userID.String()).Count(ctx)
+}
+
+func (r *payeeRepository) ExistsByUserIDAndPayeeUserID(ctx context.Context, userID, payeeUserID idVO.UserID) (bool, error) {
+	return r.ExecDB(ctx).NewSelect().Model((*model.Payee)(nil)).
+		Where("user_id = ?", userID.String()).
+		Where("payee_user_id = ?", payeeUserID.String()).
+		Exists(ctx)
+}
+
+func (r *payeeRepository) Delete(ctx context.Context, payee *payeeDomain.Payee) error {
+	_, err := r.ExecDB(ctx).NewDelete().Model((*model.Payee)(nil)).Where("id = ?", payee.IDString()).Exec(ctx)
+	return err
+}
+
+func (r *payeeRepository) SaveReceivingAccount(ctx context.Context, receivingAccount *payeeDomain.ReceivingAccount) error {
+	currencyID, err := findCurrencyID(ctx, r.ExecDB(ctx), receivingAccount.Currency())
+	if err != nil {
+		return err
+	}
+
+	receivingAccountModel := &model.ReceivingAccount{
+		UserID:     receivingAccount.UserIDString(),
+		CurrencyID: currencyID,
+		AccountID:  receivingAccount.AccountIDString(),
+		UpdatedAt:  receivingAccount.UpdatedAt(),
+	}
+	_, err = r.ExecDB(ctx).NewInsert().Model(receivingAccountModel).On("CONFLICT (user_id, currency_id) DO UPDATE").
+		Set("account_id = EXCLUDED.account_id").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
+
+func (r *payeeRepository) FindReceivingAccount(ctx context.Context, userID idVO.UserID, currency string) (*payeeDomain.ReceivingAccount, error) {
+	receivingAccountModel := &model.ReceivingAccount{}
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(receivingAccountModel).
+		Relation("Currency").
+		Where("receiving_account.user_id = ?", userID.String()).
+		Where("currency.code = ?", currency).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return reconstructReceivingAccount(receivingAccountModel)
+}
+
+func (r *payeeRepository) ListReceivingAccounts(ctx context.Context, userID idVO.UserID) ([]*payeeDomain.ReceivingAccount, error) {
+	var receivingAccountModels []*model.ReceivingAccount
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(&receivingAccountModels).
+		Relation("Currency").
+		Where("receiving_account.user_id = ?", userID.String()).
+		Order("currency.code ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	receivingAccounts := make([]*payeeDomain.ReceivingAccount, 0, len(receivingAccountModels))
+	for _, receivingAccountModel := range receivingAccountModels {
+		receivingAccount, err := reconstructReceivingAccount(receivingAccountModel)
+		if err != nil {
+			return nil, err
+		}
+		receivingAccounts = append(receivingAccounts, receivingAccount)
+	}
+	return receivingAccounts, nil
+}
+
+func reconstructPayee(payeeModel *model.Payee) (*payeeDomain.Payee, error) {
+	return payeeDomain.Reconstruct(
+		payeeModel.ID,
+		payeeModel.UserID,
+		payeeModel.PayeeUserID,
+		payeeModel.MaskedName,
+		payeeModel.Nickname,
+		payeeModel.ConfirmedAt,
+		payeeModel.CreatedAt,
+	)
+}
+
+func reconstructReceivingAccount(receivingAccountModel *model.ReceivingAccount) (*payeeDomain.ReceivingAccount, error) {
+	return payeeDomain.ReconstructReceivingAccount(
+		receivingAccountModel.UserID,
+		receivingAccountModel.Currency.Code,
+		receivingAccountModel.AccountID,
+		receivingAccountModel.UpdatedAt,
+	)
+}
@@ -0,0 +1,339 @@
+package repository_test
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+var payeeColumns = []string{"id", "user_id", "payee_user_id", "masked_name", "nickname", "confirmed_at", "created_at"}
+
+func newPayeeForTest(t *testing.T) *payeeDomain.Payee {
+	t.Helper()
+	payee, err := payeeDomain.New(
+		idVO.NewUserIDForTest("user"), idVO.NewUserIDForTest("payee"), "Sato Taro", strutil.StrPointer("Landlord"), timer.GetFixedDate(),
+	)
+	assert.NoError(t, err)
+	return payee
+}
+
+func TestPayeeRepository_Save(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewPayeeRepository)
+	payee := newPayeeForTest(t)
+	payee.Confirm(timer.GetFixedDate())
+
+	expectQuery := fmt.Sprintf(`
+		INSERT INTO "payees" AS "payee" ("id", "user_id", "payee_user_id", "masked_name", "nickname", "confirmed_at", "created_at")
+		VALUES ('%s', '%s', '%s', 'S*** T***', 'Landlord', '%s', '%s')
+		ON CONFLICT (id) DO UPDATE SET
+		nickname = EXCLUDED.nickname,
+		confirmed_at = EXCLUDED.confirmed_at
+	`, payee.IDString(), payee.UserIDString(), payee.PayeeUserIDString(),
+		payee.ConfirmedAt().Format(timestampFormat), payee.CreatedAt().Format(timestampFormat))
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 支払先の保存が成功する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.Save(ctx, payee)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPayeeRepository_FindByID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewPayeeRepository)
+	payee := newPayeeForTest(t)
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "payee"."id", "payee"."user_id", "payee"."payee_user_id", "payee"."masked_name", "payee"."nickname",
+		"payee"."confirmed_at", "payee"."created_at"
+		FROM "payees" AS "payee"
+		WHERE (payee.id = '%s')
+	`, payee.IDString())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		want     *payeeDomain.Payee
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 支払先の取得が成功する",
+			prepare: func() {
+				rows := sqlmock.NewRows(payeeColumns).AddRow(
+					payee.IDString(), payee.UserIDString(), payee.PayeeUserIDString(), "S*** T***", "Landlord", nil, payee.CreatedAt(),
+				)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			want:    payee,
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 支払先が存在しない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			got, err := repo.FindByID(ctx, payee.ID())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPayeeRepository_ListByUserID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewPayeeRepository)
+	payee := newPayeeForTest(t)
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "payee"."id", "payee"."user_id", "payee"."payee_user_id", "payee"."masked_name", "payee"."nickname",
+		"payee"."confirmed_at", "payee"."created_at"
+		FROM "payees" AS "payee"
+		WHERE (payee.user_id = '%s')
+		ORDER BY "payee"."created_at" ASC, "payee"."id" ASC
+	`, payee.UserIDString())
+
+	t.Run("Positive: 利用者の支払先の一覧の取得が成功する", func(t *testing.T) {
+		rows := sqlmock.NewRows(payeeColumns).AddRow(
+			payee.IDString(), payee.UserIDString(), payee.PayeeUserIDString(), "S*** T***", "Landlord", nil, payee.CreatedAt(),
+		)
+		mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+
+		got, err := repo.ListByUserID(ctx, payee.UserID())
+		assert.NoError(t, err)
+		assert.Equal(t, []*payeeDomain.Payee{payee}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Negative: SQLエラーで失敗する", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+
+		got, err := repo.ListByUserID(ctx, payee.UserID())
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPayeeRepository_ExistsByUserIDAndPayeeUserID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewPayeeRepository)
+	payee := newPayeeForTest(t)
+
+	expectQuery := fmt.Sprintf(`
+		SELECT EXISTS
+			(SELECT "payee"."id", "payee"."user_id", "payee"."payee_user_id", "payee"."masked_name", "payee"."nickname",
+			"payee"."confirmed_at", "payee"."created_at"
+			FROM "payees" AS "payee"
+			WHERE (user_id = '%s') AND (payee_user_id = '%s'))
+	`, payee.UserIDString(), payee.PayeeUserIDString())
+
+	mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+
+	exists, err := repo.ExistsByUserIDAndPayeeUserID(ctx, payee.UserID(), payee.PayeeUserID())
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPayeeRepository_Delete(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewPayeeRepository)
+	payee := newPayeeForTest(t)
+
+	expectQuery := fmt.Sprintf(`DELETE FROM "payees" AS "payee" WHERE (id = '%s')`, payee.IDString())
+	mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Delete(ctx, payee))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPayeeRepository_SaveReceivingAccount(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewPayeeRepository)
+	receivingAccount, err := payeeDomain.ReconstructReceivingAccount(
+		idVO.NewUserIDForTest("user").String(), moneyVO.JPY, idVO.NewAccountIDForTest("account").String(), timer.GetFixedDate(),
+	)
+	assert.NoError(t, err)
+	currencyID := idVO.GenerateStaticULID("JPY")
+
+	currencySelectQuery := `SELECT "currency_master"."id" FROM "currency_master" WHERE (code = 'JPY')`
+	expectInsertQuery := fmt.Sprintf(`
+		INSERT INTO "receiving_accounts" AS "receiving_account" ("user_id", "currency_id", "account_id", "updated_at")
+		VALUES ('%s', '%s', '%s', '%s')
+		ON CONFLICT (user_id, currency_id) DO UPDATE SET
+		account_id = EXCLUDED.account_id,
+		updated_at = EXCLUDED.updated_at
+	`, receivingAccount.UserIDString(), currencyID, receivingAccount.AccountIDString(), receivingAccount.UpdatedAt().Format(timestampFormat))
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 受取口座の保存が成功する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectExec(regexp.QuoteMeta(expectInsertQuery)).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: 通貨マスタの取得に失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectExec(regexp.QuoteMeta(expectInsertQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.SaveReceivingAccount(ctx, receivingAccount)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPayeeRepository_FindReceivingAccount(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewPayeeRepository)
+	receivingAccount, err := payeeDomain.ReconstructReceivingAccount(
+		idVO.NewUserIDForTest("user").String(), moneyVO.JPY, idVO.NewAccountIDForTest("account").String(), timer.GetFixedDate(),
+	)
+	assert.NoError(t, err)
+	currencyID := idVO.GenerateStaticULID("JPY")
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "receiving_account"."user_id", "receiving_account"."currency_id", "receiving_account"."account_id", "receiving_account"."updated_at",
+		"currency"."id" AS "currency__id", "currency"."code" AS "currency__code", "currency"."exponent" AS "currency__exponent", "currency"."symbol" AS "currency__symbol"
+		FROM "receiving_accounts" AS "receiving_account"
+		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "receiving_account"."currency_id")
+		WHERE (receiving_account.user_id = '%s') AND (currency.code = 'JPY')
+	`, receivingAccount.UserIDString())
+	columns := []string{"user_id", "currency_id", "account_id", "updated_at", "currency__id", "currency__code"}
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		want     *payeeDomain.ReceivingAccount
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 受取口座の取得が成功する",
+			prepare: func() {
+				rows := sqlmock.NewRows(columns).AddRow(
+					receivingAccount.UserIDString(), currencyID, receivingAccount.AccountIDString(), receivingAccount.UpdatedAt(),
+					currencyID, "JPY",
+				)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			want:    receivingAccount,
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: 受取口座が設定されていない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			got, err := repo.FindReceivingAccount(ctx, receivingAccount.UserID(), moneyVO.JPY)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
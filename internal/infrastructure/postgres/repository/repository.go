@@ -2,10 +2,14 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/uptrace/bun"
 )
 
+// 一意制約違反を表す PostgreSQL のエラーコードです。
+const uniqueViolationCode = "23505"
+
 type Repository[T any] struct {
 	db *bun.DB
 }
@@ -21,3 +25,13 @@ func (r *Repository[T]) ExecDB(ctx context.Context) bun.IDB {
 	}
 	return r.db
 }
+
+// 指定された一意制約に違反した為のエラーかどうかを判定します。
+// pgdriver.Error はフィールドを持つエラーとして判定する為、ドライバーに依存せずにテストできます。
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr interface{ Field(k byte) string }
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Field('C') == uniqueViolationCode && pgErr.Field('n') == constraint
+}
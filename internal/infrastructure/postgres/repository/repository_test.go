@@ -65,3 +65,21 @@ func PrepareTestRepository[T any](t *testing.T, newRepo func(db *bun.DB) T) (T,
 
 	return repo, mock, ctx, bunDB
 }
+
+// テストで PostgreSQL のエラーを再現する為のエラーです。pgdriver.Error と同じくフィールドでエラーコードや制約名を返します。
+type pgError struct {
+	fields map[byte]string
+}
+
+func (e *pgError) Field(k byte) string {
+	return e.fields[k]
+}
+
+func (e *pgError) Error() string {
+	return e.fields['M']
+}
+
+// 指定された制約の一意制約違反のエラーを作成します。
+func uniqueViolation(constraint string) error {
+	return &pgError{fields: map[byte]string{'C': "23505", 'n': constraint, 'M': "duplicate key value violates unique constraint"}}
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+type sessionRepository struct {
+	*Repository[model.Session]
+}
+
+func NewSessionRepository(db *bun.DB) sessionDomain.ISessionRepository {
+	return &sessionRepository{Repository: NewRepository[model.Session](db)}
+}
+
+func (r *sessionRepository) Save(ctx context.Context, session *sessionDomain.Session) error {
+	sessionModel := &model.Session{
+		ID:        session.IDString(),
+		UserID:    session.UserIDString(),
+		CreatedAt: session.CreatedAt(),
+		RevokedAt: session.RevokedAt(),
+	}
+	_, err := r.ExecDB(ctx).NewInsert().Model(sessionModel).
+		On("CONFLICT (id) DO UPDATE").
+		Set("revoked_at = EXCLUDED.revoked_at").
+		Returning("NULL").
+		Exec(ctx)
+	return err
+}
+
+func (r *sessionRepository) FindByID(ctx context.Context, id idVO.SessionID) (*sessionDomain.Session, error) {
+	sessionModel := &model.Session{}
+	if err := r.ExecDB(ctx).NewSelect().Model(sessionModel).Where("id = ?", id.String()).Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return sessionDomain.Reconstruct(sessionModel.ID, sessionModel.UserID, sessionModel.CreatedAt, sessionModel.RevokedAt)
+}
+
+func (r *sessionRepository) SaveRefreshToken(ctx context.Context, refreshToken *sessionDomain.RefreshToken) error {
+	refreshTokenModel := &model.RefreshToken{
+		TokenHash: refreshToken.TokenHash(),
+		SessionID: refreshToken.SessionIDString(),
+		ExpiresAt: refreshToken.ExpiresAt(),
+		UsedAt:    refreshToken.UsedAt(),
+		CreatedAt: refreshToken.CreatedAt(),
+	}
+	_, err := r.ExecDB(ctx).NewInsert().Model(refreshTokenModel).Returning("NULL").Exec(ctx)
+	return err
+}
+
+func (r *sessionRepository) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*sessionDomain.RefreshToken, error) {
+	refreshTokenModel := &model.RefreshToken{}
+	if err := r.ExecDB(ctx).NewSelect().Model(refreshTokenModel).Where("token_hash = ?", tokenHash).Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return sessionDomain.ReconstructRefreshToken(
+		refreshTokenModel.TokenHash,
+		refreshTokenModel.SessionID,
+		refreshTokenModel.ExpiresAt,
+		refreshTokenModel.UsedAt,
+		refreshTokenModel.CreatedAt,
+	)
+}
+
+// used_at が NULL の行のみを更新する事で、同じリフレッシュトークンが同時に使用された場合でも一方のみが成功します。
+func (r *sessionRepository) MarkRefreshTokenUsed(ctx context.Context, refreshToken *sessionDomain.RefreshToken) error {
+	result, err := r.ExecDB(ctx).NewUpdate().Model((*model.RefreshToken)(nil)).
+		Set("used_at = ?", refreshToken.UsedAt()).
+		Where("token_hash = ?", refreshToken.TokenHash()).
+		Where("used_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sessionDomain.ErrRefreshTokenReused
+	}
+	return nil
+}
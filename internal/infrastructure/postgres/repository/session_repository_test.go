@@ -0,0 +1,329 @@
+package repository_test
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestSessionRepository_Save(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewSessionRepository)
+	var (
+		sessionID = idVO.NewSessionIDForTest("session")
+		userID    = idVO.NewUserIDForTest("user")
+		createdAt = timer.GetFixedDate()
+		revokedAt = createdAt.Add(time.Hour)
+	)
+	session, err := sessionDomain.Reconstruct(sessionID.String(), userID.String(), createdAt, &revokedAt)
+	assert.NoError(t, err)
+
+	expectQuery := fmt.Sprintf(`
+		INSERT INTO "sessions" AS "session" ("id", "user_id", "created_at", "revoked_at")
+		VALUES ('%s', '%s', '%s', '%s')
+		ON CONFLICT (id) DO UPDATE SET revoked_at = EXCLUDED.revoked_at
+	`, sessionID.String(), userID.String(), createdAt.Format("2006-01-02 15:04:05-07:00"), revokedAt.Format("2006-01-02 15:04:05-07:00"))
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: セッションの保存が成功する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.Save(ctx, session)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSessionRepository_FindByID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewSessionRepository)
+	var (
+		sessionID = idVO.NewSessionIDForTest("session")
+		userID    = idVO.NewUserIDForTest("user")
+		createdAt = timer.GetFixedDate()
+	)
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "session"."id", "session"."user_id", "session"."created_at", "session"."revoked_at"
+		FROM "sessions" AS "session"
+		WHERE (id = '%s')
+	`, sessionID.String())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantNil  bool
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: セッションが取得できる",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "created_at", "revoked_at"}).
+					AddRow(sessionID.String(), userID.String(), createdAt, nil)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantNil: false,
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: セッションが見つからない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			wantNil: true,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantNil: true,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			session, err := repo.FindByID(ctx, sessionID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantNil {
+				assert.Nil(t, session)
+			} else {
+				assert.Equal(t, sessionID, session.ID())
+				assert.Equal(t, userID, session.UserID())
+				assert.Equal(t, createdAt, session.CreatedAt())
+				assert.False(t, session.IsRevoked())
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSessionRepository_SaveRefreshToken(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewSessionRepository)
+	var (
+		tokenHash = sessionDomain.HashRefreshToken("token")
+		sessionID = idVO.NewSessionIDForTest("session")
+		createdAt = timer.GetFixedDate()
+		expiresAt = createdAt.Add(time.Hour)
+	)
+	refreshToken, err := sessionDomain.ReconstructRefreshToken(tokenHash, sessionID.String(), expiresAt, nil, createdAt)
+	assert.NoError(t, err)
+
+	expectQuery := fmt.Sprintf(`
+		INSERT INTO "refresh_tokens" ("token_hash", "session_id", "expires_at", "used_at", "created_at")
+		VALUES ('%s', '%s', '%s', DEFAULT, '%s')
+	`, tokenHash, sessionID.String(), expiresAt.Format("2006-01-02 15:04:05-07:00"), createdAt.Format("2006-01-02 15:04:05-07:00"))
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: リフレッシュトークンの保存が成功する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.SaveRefreshToken(ctx, refreshToken)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSessionRepository_FindRefreshTokenByHash(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewSessionRepository)
+	var (
+		tokenHash = sessionDomain.HashRefreshToken("token")
+		sessionID = idVO.NewSessionIDForTest("session")
+		createdAt = timer.GetFixedDate()
+		expiresAt = createdAt.Add(time.Hour)
+	)
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "refresh_token"."token_hash", "refresh_token"."session_id", "refresh_token"."expires_at", "refresh_token"."used_at", "refresh_token"."created_at"
+		FROM "refresh_tokens" AS "refresh_token"
+		WHERE (token_hash = '%s')
+	`, tokenHash)
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantNil  bool
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: リフレッシュトークンが取得できる",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{"token_hash", "session_id", "expires_at", "used_at", "created_at"}).
+					AddRow(tokenHash, sessionID.String(), expiresAt, nil, createdAt)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantNil: false,
+			wantErr: false,
+		},
+		{
+			caseName: "Positive: リフレッシュトークンが見つからない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			wantNil: true,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantNil: true,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			refreshToken, err := repo.FindRefreshTokenByHash(ctx, tokenHash)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantNil {
+				assert.Nil(t, refreshToken)
+			} else {
+				assert.Equal(t, tokenHash, refreshToken.TokenHash())
+				assert.Equal(t, sessionID, refreshToken.SessionID())
+				assert.Equal(t, expiresAt, refreshToken.ExpiresAt())
+				assert.Nil(t, refreshToken.UsedAt())
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSessionRepository_MarkRefreshTokenUsed(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewSessionRepository)
+	var (
+		tokenHash = sessionDomain.HashRefreshToken("token")
+		sessionID = idVO.NewSessionIDForTest("session")
+		createdAt = timer.GetFixedDate()
+		usedAt    = createdAt.Add(time.Minute)
+	)
+	refreshToken, err := sessionDomain.ReconstructRefreshToken(tokenHash, sessionID.String(), createdAt.Add(time.Hour), &usedAt, createdAt)
+	assert.NoError(t, err)
+
+	expectQuery := fmt.Sprintf(`
+		UPDATE "refresh_tokens" AS "refresh_token"
+		SET used_at = '%s'
+		WHERE (token_hash = '%s') AND (used_at IS NULL)
+	`, usedAt.Format("2006-01-02 15:04:05-07:00"), tokenHash)
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: リフレッシュトークンを使用済みにできる",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			errMsg: "",
+		},
+		{
+			caseName: "Negative: 既に使用済みの場合はエラーが返る",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			errMsg: sessionDomain.ErrRefreshTokenReused.Error(),
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			errMsg: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.MarkRefreshTokenUsed(ctx, refreshToken)
+
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
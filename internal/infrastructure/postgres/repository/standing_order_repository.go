@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+type standingOrderRepository struct {
+	*Repository[model.StandingOrder]
+}
+
+func NewStandingOrderRepository(db *bun.DB) standingOrderDomain.IStandingOrderRepository {
+	return &standingOrderRepository{Repository: NewRepository[model.StandingOrder](db)}
+}
+
+func (r *standingOrderRepository) Save(ctx context.Context, order *standingOrderDomain.StandingOrder) error {
+	currencyID, err := findCurrencyID(ctx, r.ExecDB(ctx), order.Amount().Currency())
+	if err != nil {
+		return err
+	}
+
+	schedule := order.Schedule()
+	orderModel := &model.StandingOrder{
+		ID:                order.IDString(),
+		AccountID:         order.AccountIDString(),
+		ReceiverAccountID: order.ReceiverAccountIDString(),
+		Amount:            order.Amount().Amount(),
+		CurrencyID:        currencyID,
+		Frequency:         schedule.Frequency(),
+		DayOfMonth:        schedule.DayOfMonth(),
+		StartDate:         order.StartDate(),
+		EndDate:           order.EndDate(),
+		MaxExecutions:     order.MaxExecutions(),
+		MaxRetries:        order.MaxRetries(),
+		FailurePolicy:     order.FailurePolicy(),
+		Status:            order.Status(),
+		NextRunDate:       order.NextRunDate(),
+		NextAttemptDate:   order.NextAttemptDate(),
+		RetryCount:        order.RetryCount(),
+		ExecutionCount:    order.ExecutionCount(),
+		CreatedAt:         order.CreatedAt(),
+		UpdatedAt:         order.UpdatedAt(),
+		Version:           order.Version() + 1,
+	}
+
+	// 口座と同様に、読み込んだ時点のバージョンと一致する場合のみ更新する（楽観的排他制御）。
+	// スケジューラーと利用者の変更が同時に行われた場合は、後から保存した方が失敗する。
+	result, err := r.ExecDB(ctx).NewInsert().Model(orderModel).On("CONFLICT (id) DO UPDATE").
+		Set("amount = EXCLUDED.amount").
+		Set("end_date = EXCLUDED.end_date").
+		Set("max_executions = EXCLUDED.max_executions").
+		Set("max_retries = EXCLUDED.max_retries").
+		Set("failure_policy = EXCLUDED.failure_policy").
+		Set("status = EXCLUDED.status").
+		Set("next_run_date = EXCLUDED.next_run_date").
+		Set("next_attempt_date = EXCLUDED.next_attempt_date").
+		Set("retry_count = EXCLUDED.retry_count").
+		Set("execution_count = EXCLUDED.execution_count").
+		Set("updated_at = EXCLUDED.updated_at").
+		Set("version = EXCLUDED.version").
+		Where("standing_order.version = ?", order.Version()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return standingOrderDomain.ErrConcurrentModification
+	}
+
+	order.IncrementVersion()
+	return nil
+}
+
+func (r *standingOrderRepository) FindByID(ctx context.Context, id idVO.StandingOrderID) (*standingOrderDomain.StandingOrder, error) {
+	orderModel := &model.StandingOrder{}
+
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(orderModel).
+		Relation("Currency").
+		Where("standing_order.id = ?", id.String()).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return reconstructStandingOrder(orderModel)
+}
+
+func (r *standingOrderRepository) ListByAccountID(ctx context.Context, accountID idVO.AccountID) ([]*standingOrderDomain.StandingOrder, error) {
+	var orderModels []*model.StandingOrder
+
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(&orderModels).
+		Relation("Currency").
+		Where("standing_order.account_id = ?", accountID.String()).
+		Order("standing_order.id").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reconstructStandingOrders(orderModels)
+}
+
+func (r *standingOrderRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*standingOrderDomain.StandingOrder, error) {
+	var orderModels []*model.StandingOrder
+	today := time.Date(now.UTC().Year(), now.UTC().Month(), now.UTC().Day(), 0, 0, 0, 0, time.UTC)
+
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(&orderModels).
+		Relation("Currency").
+		Where("standing_order.status = ?", standingOrderDomain.StatusActive).
+		Where("standing_order.next_attempt_date <= ?", today).
+		Order("standing_order.next_attempt_date", "standing_order.id").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reconstructStandingOrders(orderModels)
+}
+
+func (r *standingOrderRepository) SaveExecution(ctx context.Context, execution *standingOrderDomain.Execution) error {
+	executionModel := &model.StandingOrderExecution{
+		StandingOrderID: execution.StandingOrderIDString(),
+		ScheduledDate:   execution.ScheduledDate(),
+		Attempt:         execution.Attempt(),
+		Result:          execution.Result(),
+		TransactionID:   execution.TransactionIDString(),
+		FailureReason:   execution.FailureReason(),
+		ExecutedAt:      execution.ExecutedAt(),
+	}
+	_, err := r.ExecDB(ctx).NewInsert().Model(executionModel).Exec(ctx)
+	return err
+}
+
+func (r *standingOrderRepository) ListExecutions(ctx context.Context, id idVO.StandingOrderID, limit int) ([]*standingOrderDomain.Execution, error) {
+	var executionModels []*model.StandingOrderExecution
+
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(&executionModels).
+		Where("standing_order_id = ?", id.String()).
+		Order("scheduled_date DESC", "attempt DESC").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	executions := make([]*standingOrderDomain.Execution, 0, len(executionModels))
+	for _, m := range executionModels {
+		execution, err := standingOrderDomain.ReconstructExecution(
+			m.StandingOrderID,
+			m.ScheduledDate,
+			m.Attempt,
+			m.Result,
+			m.TransactionID,
+			m.FailureReason,
+			m.ExecutedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, execution)
+	}
+	return executions, nil
+}
+
+func reconstructStandingOrders(orderModels []*model.StandingOrder) ([]*standingOrderDomain.StandingOrder, error) {
+	orders := make([]*standingOrderDomain.StandingOrder, 0, len(orderModels))
+	for _, orderModel := range orderModels {
+		order, err := reconstructStandingOrder(orderModel)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+func reconstructStandingOrder(m *model.StandingOrder) (*standingOrderDomain.StandingOrder, error) {
+	return standingOrderDomain.Reconstruct(
+		m.ID,
+		m.AccountID,
+		m.ReceiverAccountID,
+		m.Amount,
+		m.Currency.Code,
+		m.Frequency,
+		m.DayOfMonth,
+		m.StartDate,
+		m.EndDate,
+		m.MaxExecutions,
+		m.MaxRetries,
+		m.FailurePolicy,
+		m.Status,
+		m.NextRunDate,
+		m.RetryCount,
+		m.ExecutionCount,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.Version,
+	)
+}
@@ -0,0 +1,386 @@
+package repository_test
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/pkg/numutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+const timestampFormat = "2006-01-02 15:04:05-07:00"
+
+func newStandingOrderForTest(t *testing.T) *standingOrderDomain.StandingOrder {
+	t.Helper()
+	schedule, err := standingOrderDomain.NewSchedule(standingOrderDomain.FrequencyMonthly, numutil.IntPointer(25))
+	assert.NoError(t, err)
+	order, err := standingOrderDomain.New(
+		idVO.NewAccountIDForTest("account"), idVO.NewAccountIDForTest("receiver"),
+		1000, moneyVO.JPY, *schedule, timer.GetFixedDate(), nil, nil,
+		1, standingOrderDomain.FailurePolicySkip, timer.GetFixedDate(),
+	)
+	assert.NoError(t, err)
+	return order
+}
+
+func TestStandingOrderRepository_Save(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewStandingOrderRepository)
+	currencyID := idVO.GenerateStaticULID("JPY")
+
+	currencySelectQuery := `SELECT "currency_master"."id" FROM "currency_master" WHERE (code = 'JPY')`
+	expectInsertQuery := func(order *standingOrderDomain.StandingOrder) string {
+		return fmt.Sprintf(`
+			INSERT INTO "standing_orders" AS "standing_order" ("id", "account_id", "receiver_account_id", "amount", "currency_id",
+			"frequency", "day_of_month", "start_date", "end_date", "max_executions", "max_retries", "failure_policy", "status",
+			"next_run_date", "next_attempt_date", "retry_count", "execution_count", "created_at", "updated_at", "version")
+			VALUES ('%s', '%s', '%s', 1000, '%s', 'MONTHLY', 25, '%s', DEFAULT, DEFAULT, 1, 'SKIP', 'ACTIVE', '%s', '%s', 0, 0, '%s', '%s', %d)
+			ON CONFLICT (id) DO UPDATE SET
+			amount = EXCLUDED.amount,
+			end_date = EXCLUDED.end_date,
+			max_executions = EXCLUDED.max_executions,
+			max_retries = EXCLUDED.max_retries,
+			failure_policy = EXCLUDED.failure_policy,
+			status = EXCLUDED.status,
+			next_run_date = EXCLUDED.next_run_date,
+			next_attempt_date = EXCLUDED.next_attempt_date,
+			retry_count = EXCLUDED.retry_count,
+			execution_count = EXCLUDED.execution_count,
+			updated_at = EXCLUDED.updated_at,
+			version = EXCLUDED.version
+			WHERE (standing_order.version = %d)
+			RETURNING "end_date", "max_executions"
+		`, order.IDString(), order.AccountIDString(), order.ReceiverAccountIDString(), currencyID,
+			order.StartDate().Format(timestampFormat), order.NextRunDate().Format(timestampFormat),
+			order.NextAttemptDate().Format(timestampFormat), order.CreatedAt().Format(timestampFormat),
+			order.UpdatedAt().Format(timestampFormat), order.Version()+1, order.Version())
+	}
+
+	tests := []struct {
+		caseName    string
+		prepare     func(order *standingOrderDomain.StandingOrder)
+		wantVersion int64
+		wantErr     error
+	}{
+		{
+			caseName: "Positive: 自動振込の保存が成功し、バージョンが進む",
+			prepare: func(order *standingOrderDomain.StandingOrder) {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery(order))).
+					WillReturnRows(sqlmock.NewRows([]string{"end_date", "max_executions"}).AddRow(nil, nil))
+			},
+			wantVersion: 1,
+			wantErr:     nil,
+		},
+		{
+			caseName: "Negative: 他のリクエストが先に自動振込を更新していた場合、ErrConcurrentModification を返す",
+			prepare: func(order *standingOrderDomain.StandingOrder) {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery(order))).
+					WillReturnRows(sqlmock.NewRows([]string{"end_date", "max_executions"}))
+			},
+			wantVersion: 0,
+			wantErr:     standingOrderDomain.ErrConcurrentModification,
+		},
+		{
+			caseName: "Negative: 通貨マスタの取得に失敗する",
+			prepare: func(order *standingOrderDomain.StandingOrder) {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnError(assert.AnError)
+			},
+			wantVersion: 0,
+			wantErr:     assert.AnError,
+		},
+		{
+			caseName: "Negative: 自動振込の保存に失敗する",
+			prepare: func(order *standingOrderDomain.StandingOrder) {
+				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
+				mock.ExpectQuery(regexp.QuoteMeta(expectInsertQuery(order))).
+					WillReturnError(assert.AnError)
+			},
+			wantVersion: 0,
+			wantErr:     assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			order := newStandingOrderForTest(t)
+			tt.prepare(order)
+			err := repo.Save(ctx, order)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantVersion, order.Version())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+var standingOrderColumns = []string{
+	"id", "account_id", "receiver_account_id", "amount", "currency_id", "frequency", "day_of_month",
+	"start_date", "end_date", "max_executions", "max_retries", "failure_policy", "status",
+	"next_run_date", "next_attempt_date", "retry_count", "execution_count", "created_at", "updated_at", "version",
+	"currency__id", "currency__code",
+}
+
+const standingOrderSelectColumns = `
+	SELECT "standing_order"."id", "standing_order"."account_id", "standing_order"."receiver_account_id", "standing_order"."amount",
+	"standing_order"."currency_id", "standing_order"."frequency", "standing_order"."day_of_month", "standing_order"."start_date",
+	"standing_order"."end_date", "standing_order"."max_executions", "standing_order"."max_retries", "standing_order"."failure_policy",
+	"standing_order"."status", "standing_order"."next_run_date", "standing_order"."next_attempt_date", "standing_order"."retry_count",
+	"standing_order"."execution_count", "standing_order"."created_at", "standing_order"."updated_at", "standing_order"."version",
+	"currency"."id" AS "currency__id", "currency"."code" AS "currency__code", "currency"."exponent" AS "currency__exponent", "currency"."symbol" AS "currency__symbol"
+	FROM "standing_orders" AS "standing_order"
+	LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "standing_order"."currency_id")
+`
+
+func standingOrderRow(rows *sqlmock.Rows, order *standingOrderDomain.StandingOrder) *sqlmock.Rows {
+	currencyID := idVO.GenerateStaticULID("JPY")
+	return rows.AddRow(
+		order.IDString(), order.AccountIDString(), order.ReceiverAccountIDString(), order.Amount().Amount(),
+		currencyID, order.Schedule().Frequency(), *order.Schedule().DayOfMonth(), order.StartDate(), nil, nil,
+		order.MaxRetries(), order.FailurePolicy(), order.Status(), order.NextRunDate(), order.NextAttemptDate(),
+		order.RetryCount(), order.ExecutionCount(), order.CreatedAt(), order.UpdatedAt(), order.Version(),
+		currencyID, "JPY",
+	)
+}
+
+func TestStandingOrderRepository_FindByID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewStandingOrderRepository)
+	order := newStandingOrderForTest(t)
+	order.IncrementVersion()
+
+	expectQuery := standingOrderSelectColumns + fmt.Sprintf(`WHERE (standing_order.id = '%s')`, order.IDString())
+
+	tests := []struct {
+		caseName  string
+		prepare   func()
+		wantOrder *standingOrderDomain.StandingOrder
+		wantErr   bool
+	}{
+		{
+			caseName: "Positive: IDで自動振込の取得が成功する",
+			prepare: func() {
+				rows := standingOrderRow(sqlmock.NewRows(standingOrderColumns), order)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantOrder: order,
+			wantErr:   false,
+		},
+		{
+			caseName: "Positive: 自動振込が見つからない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			wantOrder: nil,
+			wantErr:   false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantOrder: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			got, err := repo.FindByID(ctx, order.ID())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOrder, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStandingOrderRepository_ListDue(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewStandingOrderRepository)
+	order := newStandingOrderForTest(t)
+	order.IncrementVersion()
+	now := time.Date(2021, 1, 25, 9, 30, 0, 0, time.UTC)
+
+	expectQuery := standingOrderSelectColumns + `
+		WHERE (standing_order.status = 'ACTIVE') AND (standing_order.next_attempt_date <= '2021-01-25 00:00:00+00:00')
+		ORDER BY "standing_order"."next_attempt_date", "standing_order"."id"
+		LIMIT 100
+	`
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantLen  int
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 実行すべき自動振込の取得が成功する",
+			prepare: func() {
+				rows := standingOrderRow(sqlmock.NewRows(standingOrderColumns), order)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantLen: 1,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantLen: 0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			got, err := repo.ListDue(ctx, now, standingOrderDomain.ExecuteBatchSize)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []*standingOrderDomain.StandingOrder{order}, got)
+			}
+			assert.Len(t, got, tt.wantLen)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStandingOrderRepository_SaveExecution(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewStandingOrderRepository)
+	order := newStandingOrderForTest(t)
+	transactionID := idVO.NewTransactionIDForTest("transaction")
+	executedAt := time.Date(2021, 1, 25, 9, 30, 0, 0, time.UTC)
+	execution := order.RecordSuccess(transactionID, executedAt)
+
+	expectQuery := fmt.Sprintf(`
+		INSERT INTO "standing_order_executions"
+		("standing_order_id", "scheduled_date", "attempt", "result", "transaction_id", "failure_reason", "executed_at")
+		VALUES ('%s', '2021-01-25 00:00:00+00:00', 1, 'SUCCEEDED', '%s', DEFAULT, '2021-01-25 09:30:00+00:00')
+		RETURNING "failure_reason"
+	`, order.IDString(), transactionID.String())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 実行履歴の保存が成功する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"failure_reason"}).AddRow(nil))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.SaveExecution(ctx, execution)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStandingOrderRepository_ListExecutions(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewStandingOrderRepository)
+	orderID := idVO.NewStandingOrderIDForTest("order")
+	scheduledDate := time.Date(2021, 1, 25, 0, 0, 0, 0, time.UTC)
+	executedAt := time.Date(2021, 1, 25, 9, 30, 0, 0, time.UTC)
+	reason := "insufficient balance"
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "standing_order_execution"."standing_order_id", "standing_order_execution"."scheduled_date",
+		"standing_order_execution"."attempt", "standing_order_execution"."result", "standing_order_execution"."transaction_id",
+		"standing_order_execution"."failure_reason", "standing_order_execution"."executed_at"
+		FROM "standing_order_executions" AS "standing_order_execution"
+		WHERE (standing_order_id = '%s')
+		ORDER BY "scheduled_date" DESC, "attempt" DESC
+		LIMIT 20
+	`, orderID.String())
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantLen  int
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 実行履歴の取得が成功する",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{
+					"standing_order_id", "scheduled_date", "attempt", "result", "transaction_id", "failure_reason", "executed_at",
+				}).AddRow(orderID.String(), scheduledDate, 1, standingOrderDomain.ResultRetryScheduled, nil, reason, executedAt)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+			},
+			wantLen: 1,
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantLen: 0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			got, err := repo.ListExecutions(ctx, orderID, standingOrderDomain.RecentExecutionsLimit)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, scheduledDate, got[0].ScheduledDate())
+				assert.Equal(t, standingOrderDomain.ResultRetryScheduled, got[0].Result())
+				assert.Equal(t, &reason, got[0].FailureReason())
+				assert.Nil(t, got[0].TransactionID())
+			}
+			assert.Len(t, got, tt.wantLen)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,9 +2,13 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
 	"github.com/uptrace/bun"
 )
@@ -18,83 +22,167 @@ func NewTransactionRepository(db *bun.DB) transactionDomain.ITransactionReposito
 }
 
 func (r *transactionRepository) Save(ctx context.Context, transaction *transactionDomain.Transaction) error {
-	currencyCode := transaction.TransferAmount().Currency()
-
-	var currencyID string
-	err := r.ExecDB(ctx).NewSelect().
-		Model((*model.CurrencyMaster)(nil)).
-		Column("id").
-		Where("code = ?", currencyCode).
-		Scan(ctx, &currencyID)
-
+	currencyID, err := findCurrencyID(ctx, r.ExecDB(ctx), transaction.TransferAmount().Currency())
 	if err != nil {
 		return err
 	}
 
+	var receiverAmount *int64
+	var receiverCurrencyID *string
+	if rAmount := transaction.ReceiverAmount(); rAmount != nil {
+		rCurrencyID, err := findCurrencyID(ctx, r.ExecDB(ctx), rAmount.Currency())
+		if err != nil {
+			return err
+		}
+		amount := rAmount.Amount()
+		receiverAmount = &amount
+		receiverCurrencyID = &rCurrencyID
+	}
+
+	var exchangeRate *string
+	if rate := transaction.ExchangeRate(); rate != nil {
+		rateString := rate.Rate()
+		exchangeRate = &rateString
+	}
+
+	var balanceAfter, receiverBalanceAfter *int64
+	if balance := transaction.BalanceAfter(); balance != nil {
+		amount := balance.Amount()
+		balanceAfter = &amount
+	}
+	if balance := transaction.ReceiverBalanceAfter(); balance != nil {
+		amount := balance.Amount()
+		receiverBalanceAfter = &amount
+	}
+
 	transactionModel := &model.Transaction{
-		ID:                transaction.IDString(),
-		AccountID:         transaction.AccountIDString(),
-		ReceiverAccountID: transaction.ReceiverAccountIDString(),
-		OperationType:     transaction.OperationType(),
-		Amount:            transaction.TransferAmount().Amount(),
-		CurrencyID:        currencyID,
-		TransactionAt:     transaction.TransactionAt(),
+		ID:                    transaction.IDString(),
+		AccountID:             transaction.AccountIDString(),
+		ReceiverAccountID:     transaction.ReceiverAccountIDString(),
+		OperationType:         transaction.OperationType(),
+		Amount:                transaction.TransferAmount().Amount(),
+		CurrencyID:            currencyID,
+		ReceiverAmount:        receiverAmount,
+		ReceiverCurrencyID:    receiverCurrencyID,
+		ExchangeRate:          exchangeRate,
+		BalanceAfter:          balanceAfter,
+		ReceiverBalanceAfter:  receiverBalanceAfter,
+		ReversedTransactionID: transaction.ReversedTransactionIDString(),
+		ReversedOperationType: transaction.ReversedOperationType(),
+		Memo:                  transaction.Memo(),
+		Reference:             transaction.Reference(),
+		TransactionAt:         transaction.TransactionAt(),
 	}
 	_, err = r.ExecDB(ctx).NewInsert().Model(transactionModel).Exec(ctx)
 	return err
 }
 
-func (r *transactionRepository) ListWithTotalByAccountID(ctx context.Context, params transactionDomain.ListTransactionsParams) (transactions []*transactionDomain.Transaction, total int, err error) {
-	totalCountQuery := r.ExecDB(ctx).NewSelect().Model(&model.Transaction{})
-	r.buildListQuery(totalCountQuery, params)
+func (r *transactionRepository) FindByID(ctx context.Context, id idVO.TransactionID) (*transactionDomain.Transaction, error) {
+	transactionModel := &model.Transaction{}
 
-	total, err = totalCountQuery.Count(ctx)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to count total transactions: %w", err)
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(transactionModel).
+		Relation("Currency").
+		Relation("ReceiverCurrency").
+		Relation("Categories", orderCategories).
+		Where("transaction.id = ?", id.String()).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
 	}
 
+	return reconstructTransaction(transactionModel)
+}
+
+func (r *transactionRepository) FindReversalOf(ctx context.Context, id idVO.TransactionID) (*transactionDomain.Transaction, error) {
+	transactionModel := &model.Transaction{}
+
+	if err := r.ExecDB(ctx).NewSelect().
+		Model(transactionModel).
+		Relation("Currency").
+		Relation("ReceiverCurrency").
+		Relation("Categories", orderCategories).
+		Where("transaction.reversed_transaction_id = ?", id.String()).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return reconstructTransaction(transactionModel)
+}
+
+func (r *transactionRepository) ListByAccountID(ctx context.Context, params transactionDomain.ListTransactionsParams) ([]*transactionDomain.Transaction, error) {
 	var transactionModels = []model.Transaction{}
-	getQuery := r.ExecDB(ctx).NewSelect().Model(&transactionModels)
+	getQuery := r.ExecDB(ctx).NewSelect().Model(&transactionModels).Relation("Categories", orderCategories)
 	r.buildListQuery(getQuery, params)
 
-	if *params.Sort == "ASC" {
-		getQuery.Order("transaction_at ASC")
+	// 前のページを取得する場合は逆順で取得し、取得後に並べ直す
+	ascending := *params.Sort == transactionDomain.SortAsc
+	backward := params.Cursor != nil && params.Cursor.Backward
+	if backward {
+		ascending = !ascending
+	}
+
+	if params.Cursor != nil {
+		operator := "<"
+		if ascending {
+			operator = ">"
+		}
+		getQuery.Where("(transaction_at, transaction.id) "+operator+" (?, ?)", params.Cursor.TransactionAt, params.Cursor.ID.String())
+	}
+	if ascending {
+		getQuery.Order("transaction_at ASC", "transaction.id ASC")
 	} else {
-		getQuery.Order("transaction_at DESC")
+		getQuery.Order("transaction_at DESC", "transaction.id DESC")
 	}
 	if params.Limit != nil {
 		getQuery.Limit(*params.Limit)
 	}
-	if params.Page != nil && params.Limit != nil {
+	if params.Cursor == nil && params.Page != nil && params.Limit != nil {
 		getQuery.Offset((*params.Page - 1) * *params.Limit)
 	}
 
 	if err := getQuery.Scan(ctx); err != nil {
-		return nil, 0, fmt.Errorf("failed to retrieve transactions: %w", err)
+		return nil, fmt.Errorf("failed to retrieve transactions: %w", err)
 	}
 
-	transactions = make([]*transactionDomain.Transaction, len(transactionModels))
+	transactions := make([]*transactionDomain.Transaction, len(transactionModels))
 	for i, m := range transactionModels {
-		transaction, err := transactionDomain.Reconstruct(
-			m.ID,
-			m.AccountID,
-			m.ReceiverAccountID,
-			m.OperationType,
-			m.Amount,
-			m.Currency.Code,
-			m.TransactionAt,
-		)
+		transaction, err := reconstructTransaction(&m)
 		if err != nil {
-			return nil, 0, err
+			return nil, err
+		}
+		if backward {
+			transactions[len(transactionModels)-1-i] = transaction
+		} else {
+			transactions[i] = transaction
 		}
-		transactions[i] = transaction
 	}
 
-	return transactions, total, nil
+	return transactions, nil
+}
+
+func (r *transactionRepository) CountByAccountID(ctx context.Context, params transactionDomain.ListTransactionsParams) (int, error) {
+	totalCountQuery := r.ExecDB(ctx).NewSelect().Model(&model.Transaction{})
+	r.buildListQuery(totalCountQuery, params)
+
+	total, err := totalCountQuery.Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count total transactions: %w", err)
+	}
+	return total, nil
 }
 
 func (r *transactionRepository) buildListQuery(query *bun.SelectQuery, params transactionDomain.ListTransactionsParams) {
-	query.Relation("Currency").Where("account_id = ?", params.AccountID.String())
+	accountID := params.AccountID.String()
+	query.Relation("Currency").Relation("ReceiverCurrency").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("account_id = ?", accountID).WhereOr("receiver_account_id = ?", accountID)
+		})
 
 	if params.From != nil {
 		query.Where("transaction_at >= ?", *params.From)
@@ -104,7 +192,128 @@ func (r *transactionRepository) buildListQuery(query *bun.SelectQuery, params tr
 		query.Where("transaction_at <= ?", *params.To)
 	}
 
+	if params.Before != nil {
+		query.Where("transaction_at < ?", *params.Before)
+	}
+
 	if len(params.OperationTypes) > 0 {
-		query.Where("operation_type IN (?)", bun.In(params.OperationTypes))
+		query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			var operationTypes []string
+			for _, opType := range params.OperationTypes {
+				switch opType {
+				case transactionDomain.TransferIn:
+					q.WhereOr("operation_type = ? AND receiver_account_id = ? AND account_id <> ?", transactionDomain.Transfer, accountID, accountID)
+				case transactionDomain.TransferOut:
+					q.WhereOr("operation_type = ? AND account_id = ?", transactionDomain.Transfer, accountID)
+				default:
+					operationTypes = append(operationTypes, opType)
+				}
+			}
+			if len(operationTypes) > 0 {
+				q.WhereOr("operation_type IN (?)", bun.In(operationTypes))
+			}
+			return q
+		})
+	}
+
+	// メモは取引を行った口座から見た場合のみ検索の対象にする
+	if params.Query != nil {
+		query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("account_id = ? AND "+model.TransactionSearchVector+" @@ plainto_tsquery('simple', ?)", accountID, *params.Query).
+				WhereOr("account_id <> ? AND "+model.TransactionReferenceSearchVector+" @@ plainto_tsquery('simple', ?)", accountID, *params.Query)
+		})
+	}
+
+	// 受け取った振込の場合は受取口座の通貨での入金額で比較する
+	if params.MinAmount != nil {
+		query.Where("CASE WHEN account_id = ? THEN amount ELSE COALESCE(receiver_amount, amount) END >= ?", accountID, *params.MinAmount)
+	}
+	if params.MaxAmount != nil {
+		query.Where("CASE WHEN account_id = ? THEN amount ELSE COALESCE(receiver_amount, amount) END <= ?", accountID, *params.MaxAmount)
+	}
+
+	if len(params.Categories) > 0 {
+		query.Where(
+			`EXISTS (SELECT 1 FROM "transaction_categories" AS "tc" WHERE "tc"."transaction_id" = "transaction"."id" AND "tc"."account_id" = ? AND "tc"."category" IN (?))`,
+			accountID, bun.In(params.Categories),
+		)
+	}
+}
+
+func orderCategories(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("transaction_category.category ASC")
+}
+
+func reconstructTransaction(m *model.Transaction) (*transactionDomain.Transaction, error) {
+	var receiverCurrency *string
+	if m.ReceiverCurrencyID != nil && m.ReceiverCurrency != nil {
+		receiverCurrency = &m.ReceiverCurrency.Code
+	}
+	categories := make(map[string][]string)
+	for _, c := range m.Categories {
+		categories[c.AccountID] = append(categories[c.AccountID], c.Category)
+	}
+	return transactionDomain.Reconstruct(
+		m.ID,
+		m.AccountID,
+		m.ReceiverAccountID,
+		m.OperationType,
+		m.Amount,
+		m.Currency.Code,
+		m.ReceiverAmount,
+		receiverCurrency,
+		m.ExchangeRate,
+		m.ReversedTransactionID,
+		m.ReversedOperationType,
+		m.BalanceAfter,
+		m.ReceiverBalanceAfter,
+		m.Memo,
+		m.Reference,
+		categories,
+		m.TransactionAt,
+	)
+}
+
+func (r *transactionRepository) SumAmount(ctx context.Context, accountID idVO.AccountID, operationType string, from time.Time) (int64, error) {
+	var total int64
+	err := r.ExecDB(ctx).NewSelect().
+		Model((*model.Transaction)(nil)).
+		ColumnExpr("COALESCE(SUM(transaction.amount), 0)").
+		Where("transaction.account_id = ?", accountID.String()).
+		Where("transaction.operation_type = ?", operationType).
+		Where("transaction.transaction_at >= ?", from).
+		Where(`NOT EXISTS (SELECT 1 FROM "transactions" AS "reversal" WHERE "reversal"."reversed_transaction_id" = "transaction"."id")`).
+		Scan(ctx, &total)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum transaction amounts: %w", err)
+	}
+	return total, nil
+}
+
+func (r *transactionRepository) SaveCategories(ctx context.Context, transaction *transactionDomain.Transaction, accountID idVO.AccountID) error {
+	if _, err := r.ExecDB(ctx).NewDelete().
+		Model((*model.TransactionCategory)(nil)).
+		Where("transaction_id = ?", transaction.IDString()).
+		Where("account_id = ?", accountID.String()).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete transaction categories: %w", err)
+	}
+
+	categories := transaction.CategoriesFor(accountID)
+	if len(categories) == 0 {
+		return nil
+	}
+	categoryModels := make([]model.TransactionCategory, len(categories))
+	for i, category := range categories {
+		categoryModels[i] = model.TransactionCategory{
+			TransactionID: transaction.IDString(),
+			AccountID:     accountID.String(),
+			Category:      category,
+		}
+	}
+	if _, err := r.ExecDB(ctx).NewInsert().Model(&categoryModels).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save transaction categories: %w", err)
 	}
+	return nil
 }
@@ -1,9 +1,12 @@
 package repository_test
 
 import (
+	"database/sql"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -11,6 +14,8 @@ import (
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
 	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/pkg/numutil"
+	"github.com/u104rak1/pocgo/pkg/strutil"
 	"github.com/u104rak1/pocgo/pkg/timer"
 )
 
@@ -22,16 +27,16 @@ func TestTransactionRepository_Save(t *testing.T) {
 	assert.NoError(t, err)
 
 	transactionAt := timer.GetFixedDate()
-	transaction, err := transactionDomain.New(accountID, nil, transactionDomain.Deposit, money.Amount(), money.Currency(), transactionAt)
+	transaction, err := transactionDomain.New(accountID, nil, transactionDomain.Deposit, money.Amount(), money.Currency(), nil, nil, nil, transactionAt)
 	assert.NoError(t, err)
 
 	currencyID := idVO.GenerateStaticULID(moneyVO.JPY)
 	currencySelectQuery := `SELECT "currency_master"."id" FROM "currency_master" WHERE (code = 'JPY')`
 
 	expectQuery := fmt.Sprintf(
-		`INSERT INTO "transactions" ("id", "account_id", "receiver_account_id", "operation_type", "amount", "currency_id", "transaction_at")
-		VALUES ('%s', '%s', DEFAULT, '%s', %.0f, '%s', '%s')
-		RETURNING "receiver_account_id"`,
+		`INSERT INTO "transactions" ("id", "account_id", "receiver_account_id", "operation_type", "amount", "currency_id", "receiver_amount", "receiver_currency_id", "exchange_rate", "balance_after", "receiver_balance_after", "reversed_transaction_id", "reversed_operation_type", "memo", "reference", "transaction_at")
+		VALUES ('%s', '%s', DEFAULT, '%s', %d, '%s', DEFAULT, DEFAULT, DEFAULT, DEFAULT, DEFAULT, DEFAULT, DEFAULT, DEFAULT, DEFAULT, '%s')
+		RETURNING "receiver_account_id", "receiver_amount", "receiver_currency_id", "exchange_rate", "balance_after", "receiver_balance_after", "reversed_transaction_id", "reversed_operation_type", "memo", "reference"`,
 		transaction.IDString(), transaction.AccountIDString(), transaction.OperationType(),
 		transaction.TransferAmount().Amount(), currencyID, transactionAt.Format("2006-01-02 15:04:05-07:00"),
 	)
@@ -47,7 +52,7 @@ func TestTransactionRepository_Save(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(currencySelectQuery)).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(currencyID))
 				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).
-					WillReturnRows(sqlmock.NewRows([]string{"receiver_account_id"}))
+					WillReturnRows(sqlmock.NewRows([]string{"receiver_account_id", "receiver_amount", "receiver_currency_id", "exchange_rate", "balance_after", "receiver_balance_after", "reversed_transaction_id", "reversed_operation_type"}))
 			},
 			wantErr: false,
 		},
@@ -87,100 +92,601 @@ func TestTransactionRepository_Save(t *testing.T) {
 	}
 }
 
-// func TestTransactionRepository_ListWithTotalByAccountID(t *testing.T) {
-// 	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewTransactionRepository)
-
-// 	accountID := idVO.NewAccountIDForTest("account")
-// 	now := timer.GetFixedDate()
-// 	params := transactionDomain.ListTransactionsParams{
-// 		AccountID:      accountID,
-// 		From:           &now,
-// 		To:             &now,
-// 		OperationTypes: []string{transactionDomain.Deposit, transactionDomain.Withdrawal},
-// 		Sort:           new(string),
-// 		Limit:          new(int),
-// 		Page:           new(int),
-// 	}
-
-// 	// expectCountQuery := fmt.Sprintf(`
-// 	// 	SELECT count(*) FROM "transactions" AS "transaction"
-// 	// 	LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "transaction"."currency_id")
-// 	// 	WHERE (account_id = '%s')
-// 	// 	AND (transaction_at >= '%s')
-// 	// 	AND (transaction_at <= '%s')
-// 	// 	AND (operation_type IN ('DEPOSIT','WITHDRAWAL'))
-// 	// `, accountID.String(), params.From.Format("2006-01-02 15:04:05-07:00"),
-// 	// 	params.To.Format("2006-01-02 15:04:05-07:00"))
-
-// 	expectSelectQuery := fmt.Sprintf(`
-// 		SELECT "id", "account_id", "amount", "operation_type", "description", "transaction_at"
-// 		FROM "transactions" AS "transaction"
-// 		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "transaction"."currency_id")
-// 		WHERE (account_id = '%s')
-// 		AND (transaction_at >= '%s')
-// 		AND (transaction_at <= '%s')
-// 		AND (operation_type IN ('DEPOSIT','WITHDRAWAL'))
-// 		ORDER BY transaction_at DESC
-// 	`, accountID.String(), params.From.Format("2006-01-02 15:04:05-07:00"),
-// 		params.To.Format("2006-01-02 15:04:05-07:00"))
-
-// 	tests := []struct {
-// 		caseName    string
-// 		params      transactionDomain.ListTransactionsParams
-// 		prepare     func()
-// 		wantTotal   int
-// 		wantTxCount int
-// 		wantErr     bool
-// 	}{
-// 		{
-// 			caseName: "Positive: 全てのパラメータが設定された場合",
-// 			params:   params,
-// 			prepare: func() {
-// 				expectCountQuery := fmt.Sprintf(`SELECT count(*) FROM "transactions" AS "transaction" LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "transaction"."currency_id") WHERE (account_id = '%s') AND (transaction_at >= '%s') AND (transaction_at <= '%s') AND (operation_type IN ('DEPOSIT','WITHDRAWAL'))`, accountID.String(), params.From.Format("2006-01-02 15:04:05-07:00"), params.To.Format("2006-01-02 15:04:05-07:00"))
-
-// 				mock.ExpectQuery(regexp.QuoteMeta(expectCountQuery)).
-// 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
-
-// 				fmt.Println("Executing count query:", expectCountQuery)
-
-// 				rows := sqlmock.NewRows([]string{
-// 					"id", "account_id", "amount", "operation_type", "description", "transaction_at",
-// 				})
-// 				for i := 0; i < 2; i++ {
-// 					rows.AddRow(
-// 						fmt.Sprintf("tx_%d", i),
-// 						accountID.String(),
-// 						1000,
-// 						"DEPOSIT",
-// 						"Test Transaction",
-// 						now,
-// 					)
-// 				}
-// 				mock.ExpectQuery(regexp.QuoteMeta(expectSelectQuery)).
-// 					WillReturnRows(rows)
-// 			},
-// 			wantTotal:   2,
-// 			wantTxCount: 2,
-// 			wantErr:     false,
-// 		},
-// 	}
-
-// 	for _, tt := range tests {
-// 		t.Run(tt.caseName, func(t *testing.T) {
-// 			tt.prepare()
-// 			_, _, err := repo.ListWithTotalByAccountID(ctx, tt.params)
-
-// 			// if tt.wantErr {
-// 			// 	assert.Error(t, err)
-// 			// 	assert.Equal(t, 0, total)
-// 			// 	assert.Len(t, transactions, 0)
-// 			// } else {
-// 			// 	assert.NoError(t, err)
-// 			// 	assert.Equal(t, tt.wantTotal, total)
-// 			// 	assert.Len(t, transactions, tt.wantTxCount)
-// 			// }
-// 			err = mock.ExpectationsWereMet()
-// 			assert.NoError(t, err)
-// 		})
-// 	}
-// }
+func TestTransactionRepository_Save_CrossCurrencyTransfer(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewTransactionRepository)
+
+	accountID := idVO.NewAccountIDForTest("account")
+	receiverAccountID := idVO.NewAccountIDForTest("receiver")
+	receiverAccountIDString := receiverAccountID.String()
+	receiverAmount := int64(34)
+	receiverCurrency := moneyVO.USD
+	exchangeRate := "0.0067"
+	balanceAfter := int64(950)
+	receiverBalanceAfter := int64(134)
+
+	transactionAt := timer.GetFixedDate()
+	transaction, err := transactionDomain.Reconstruct(
+		idVO.NewTransactionIDForTest("transaction").String(), accountID.String(), &receiverAccountIDString,
+		transactionDomain.Transfer, 50, moneyVO.JPY,
+		&receiverAmount, &receiverCurrency, &exchangeRate, nil, nil, &balanceAfter, &receiverBalanceAfter, nil, nil, nil, transactionAt,
+	)
+	assert.NoError(t, err)
+
+	jpyID := idVO.GenerateStaticULID(moneyVO.JPY)
+	usdID := idVO.GenerateStaticULID(moneyVO.USD)
+	jpySelectQuery := `SELECT "currency_master"."id" FROM "currency_master" WHERE (code = 'JPY')`
+	usdSelectQuery := `SELECT "currency_master"."id" FROM "currency_master" WHERE (code = 'USD')`
+
+	expectQuery := fmt.Sprintf(
+		`INSERT INTO "transactions" ("id", "account_id", "receiver_account_id", "operation_type", "amount", "currency_id", "receiver_amount", "receiver_currency_id", "exchange_rate", "balance_after", "receiver_balance_after", "reversed_transaction_id", "reversed_operation_type", "memo", "reference", "transaction_at")
+		VALUES ('%s', '%s', '%s', '%s', %d, '%s', %d, '%s', '%s', %d, %d, DEFAULT, DEFAULT, DEFAULT, DEFAULT, '%s')
+		RETURNING "reversed_transaction_id", "reversed_operation_type", "memo", "reference"`,
+		transaction.IDString(), transaction.AccountIDString(), receiverAccountID.String(), transaction.OperationType(),
+		transaction.TransferAmount().Amount(), jpyID, receiverAmount, usdID, exchangeRate, balanceAfter, receiverBalanceAfter,
+		transactionAt.Format("2006-01-02 15:04:05-07:00"),
+	)
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 受取金額と為替レートを含む取引の保存が成功する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(jpySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(jpyID))
+				mock.ExpectQuery(regexp.QuoteMeta(usdSelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(usdID))
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"reversed_transaction_id", "reversed_operation_type"}))
+			},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: 受取通貨の取得に失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(jpySelectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(jpyID))
+				mock.ExpectQuery(regexp.QuoteMeta(usdSelectQuery)).
+					WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.Save(ctx, transaction)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// 取引に付けられた分類を取得するクエリを返します。
+func categoriesQuery(transactionIDs ...string) string {
+	return fmt.Sprintf(
+		`SELECT "transaction_category"."transaction_id", "transaction_category"."account_id", "transaction_category"."category" FROM "transaction_categories" AS "transaction_category" WHERE ("transaction_category"."transaction_id" IN ('%s')) ORDER BY "transaction_category"."category" ASC`,
+		strings.Join(transactionIDs, "', '"),
+	)
+}
+
+func TestTransactionRepository_FindByID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewTransactionRepository)
+
+	transactionID := idVO.NewTransactionIDForTest("transaction")
+	accountID := idVO.NewAccountIDForTest("account")
+	jpyID := idVO.GenerateStaticULID(moneyVO.JPY)
+	transactionAt := timer.GetFixedDate()
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "transaction"."id", "transaction"."account_id", "transaction"."receiver_account_id", "transaction"."operation_type",
+		"transaction"."amount", "transaction"."currency_id", "transaction"."receiver_amount", "transaction"."receiver_currency_id",
+		"transaction"."exchange_rate", "transaction"."balance_after", "transaction"."receiver_balance_after",
+		"transaction"."reversed_transaction_id", "transaction"."reversed_operation_type", "transaction"."memo", "transaction"."reference", "transaction"."transaction_at",
+		"currency"."id" AS "currency__id", "currency"."code" AS "currency__code", "currency"."exponent" AS "currency__exponent", "currency"."symbol" AS "currency__symbol",
+		"receiver_currency"."id" AS "receiver_currency__id", "receiver_currency"."code" AS "receiver_currency__code", "receiver_currency"."exponent" AS "receiver_currency__exponent", "receiver_currency"."symbol" AS "receiver_currency__symbol"
+		FROM "transactions" AS "transaction"
+		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "transaction"."currency_id")
+		LEFT JOIN "currency_master" AS "receiver_currency" ON ("receiver_currency"."id" = "transaction"."receiver_currency_id")
+		WHERE (transaction.id = '%s')
+	`, transactionID.String())
+
+	tests := []struct {
+		caseName         string
+		prepare          func()
+		wantTransaction  bool
+		wantBalanceAfter string
+		wantErr          bool
+	}{
+		{
+			caseName: "Positive: IDで取引の取得が成功する",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{
+					"id", "account_id", "operation_type", "amount", "currency_id", "balance_after",
+					"transaction_at", "currency__id", "currency__code",
+				}).AddRow(
+					transactionID.String(), accountID.String(), transactionDomain.Deposit, 1000, jpyID, 3000,
+					transactionAt, jpyID, moneyVO.JPY,
+				)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+				categoryRows := sqlmock.NewRows([]string{"transaction_id", "account_id", "category"}).
+					AddRow(transactionID.String(), accountID.String(), "food").
+					AddRow(transactionID.String(), accountID.String(), "travel")
+				mock.ExpectQuery(regexp.QuoteMeta(categoriesQuery(transactionID.String()))).WillReturnRows(categoryRows)
+			},
+			wantTransaction:  true,
+			wantBalanceAfter: "3000",
+			wantErr:          false,
+		},
+		{
+			caseName: "Positive: 取引が見つからない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			wantTransaction: false,
+			wantErr:         false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantTransaction: false,
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			transaction, err := repo.FindByID(ctx, transactionID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, transaction)
+			} else {
+				assert.NoError(t, err)
+				if tt.wantTransaction {
+					assert.Equal(t, transactionID, transaction.ID())
+					assert.Equal(t, accountID, transaction.AccountID())
+					assert.Equal(t, tt.wantBalanceAfter, *transaction.BalanceAfterDecimalFor(accountID))
+					assert.Equal(t, []string{"food", "travel"}, transaction.CategoriesFor(accountID))
+				} else {
+					assert.Nil(t, transaction)
+				}
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTransactionRepository_FindReversalOf(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewTransactionRepository)
+
+	originalID := idVO.NewTransactionIDForTest("original")
+	reversalID := idVO.NewTransactionIDForTest("reversal")
+	accountID := idVO.NewAccountIDForTest("account")
+	jpyID := idVO.GenerateStaticULID(moneyVO.JPY)
+	transactionAt := timer.GetFixedDate()
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "transaction"."id", "transaction"."account_id", "transaction"."receiver_account_id", "transaction"."operation_type",
+		"transaction"."amount", "transaction"."currency_id", "transaction"."receiver_amount", "transaction"."receiver_currency_id",
+		"transaction"."exchange_rate", "transaction"."balance_after", "transaction"."receiver_balance_after",
+		"transaction"."reversed_transaction_id", "transaction"."reversed_operation_type", "transaction"."memo", "transaction"."reference", "transaction"."transaction_at",
+		"currency"."id" AS "currency__id", "currency"."code" AS "currency__code", "currency"."exponent" AS "currency__exponent", "currency"."symbol" AS "currency__symbol",
+		"receiver_currency"."id" AS "receiver_currency__id", "receiver_currency"."code" AS "receiver_currency__code", "receiver_currency"."exponent" AS "receiver_currency__exponent", "receiver_currency"."symbol" AS "receiver_currency__symbol"
+		FROM "transactions" AS "transaction"
+		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "transaction"."currency_id")
+		LEFT JOIN "currency_master" AS "receiver_currency" ON ("receiver_currency"."id" = "transaction"."receiver_currency_id")
+		WHERE (transaction.reversed_transaction_id = '%s')
+	`, originalID.String())
+
+	tests := []struct {
+		caseName     string
+		prepare      func()
+		wantReversal bool
+		wantErr      bool
+	}{
+		{
+			caseName: "Positive: 取引を取り消した取引の取得が成功する",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{
+					"id", "account_id", "operation_type", "amount", "currency_id", "reversed_transaction_id", "reversed_operation_type",
+					"transaction_at", "currency__id", "currency__code",
+				}).AddRow(
+					reversalID.String(), accountID.String(), transactionDomain.Reversal, 1000, jpyID, originalID.String(), transactionDomain.Deposit,
+					transactionAt, jpyID, moneyVO.JPY,
+				)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(categoriesQuery(reversalID.String()))).
+					WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "account_id", "category"}))
+			},
+			wantReversal: true,
+			wantErr:      false,
+		},
+		{
+			caseName: "Positive: 取り消されていない場合、nilを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(sql.ErrNoRows)
+			},
+			wantReversal: false,
+			wantErr:      false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantReversal: false,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			reversal, err := repo.FindReversalOf(ctx, originalID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, reversal)
+			} else {
+				assert.NoError(t, err)
+				if tt.wantReversal {
+					assert.Equal(t, reversalID, reversal.ID())
+					assert.Equal(t, originalID, *reversal.ReversedTransactionID())
+					assert.Equal(t, transactionDomain.Debit, reversal.DirectionFor(accountID))
+				} else {
+					assert.Nil(t, reversal)
+				}
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTransactionRepository_ListByAccountID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewTransactionRepository)
+
+	accountID := idVO.NewAccountIDForTest("account")
+	jpyID := idVO.GenerateStaticULID(moneyVO.JPY)
+	cursorAt := timer.GetFixedDate()
+	cursor := transactionDomain.Cursor{TransactionAt: cursorAt, ID: idVO.NewTransactionIDForTest("cursor"), Backward: true}
+	olderID := idVO.NewTransactionIDForTest("older")
+	newerID := idVO.NewTransactionIDForTest("newer")
+
+	expectQuery := fmt.Sprintf(`
+		SELECT "transaction"."id", "transaction"."account_id", "transaction"."receiver_account_id", "transaction"."operation_type",
+		"transaction"."amount", "transaction"."currency_id", "transaction"."receiver_amount", "transaction"."receiver_currency_id",
+		"transaction"."exchange_rate", "transaction"."balance_after", "transaction"."receiver_balance_after",
+		"transaction"."reversed_transaction_id", "transaction"."reversed_operation_type", "transaction"."memo", "transaction"."reference", "transaction"."transaction_at",
+		"currency"."id" AS "currency__id", "currency"."code" AS "currency__code", "currency"."exponent" AS "currency__exponent", "currency"."symbol" AS "currency__symbol",
+		"receiver_currency"."id" AS "receiver_currency__id", "receiver_currency"."code" AS "receiver_currency__code", "receiver_currency"."exponent" AS "receiver_currency__exponent", "receiver_currency"."symbol" AS "receiver_currency__symbol"
+		FROM "transactions" AS "transaction"
+		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "transaction"."currency_id")
+		LEFT JOIN "currency_master" AS "receiver_currency" ON ("receiver_currency"."id" = "transaction"."receiver_currency_id")
+		WHERE ((account_id = '%[1]s') OR (receiver_account_id = '%[1]s'))
+		AND ((operation_type = 'TRANSFER' AND receiver_account_id = '%[1]s' AND account_id <> '%[1]s') OR (operation_type IN ('DEPOSIT')))
+		AND ((transaction_at, transaction.id) > ('%[2]s', '%[3]s'))
+		ORDER BY "transaction_at" ASC, "transaction"."id" ASC
+		LIMIT 2
+	`, accountID.String(), cursorAt.Format("2006-01-02 15:04:05-07:00"), cursor.ID.String())
+
+	params := transactionDomain.ListTransactionsParams{
+		AccountID:      accountID,
+		OperationTypes: []string{transactionDomain.TransferIn, transactionDomain.Deposit},
+		Sort:           strutil.StrPointer(transactionDomain.SortDesc),
+		Limit:          numutil.IntPointer(2),
+		Page:           numutil.IntPointer(3),
+		Cursor:         &cursor,
+	}
+
+	tests := []struct {
+		caseName string
+		prepare  func()
+		wantIDs  []string
+		wantErr  bool
+	}{
+		{
+			caseName: "Positive: 前のページのカーソルを指定すると逆順で取得し、指定した並び順に戻して返す",
+			prepare: func() {
+				rows := sqlmock.NewRows([]string{
+					"id", "account_id", "operation_type", "amount", "currency_id", "transaction_at", "currency__id", "currency__code",
+				}).
+					AddRow(olderID.String(), accountID.String(), transactionDomain.Deposit, 1000, jpyID, cursorAt.Add(time.Minute), jpyID, moneyVO.JPY).
+					AddRow(newerID.String(), accountID.String(), transactionDomain.Deposit, 1000, jpyID, cursorAt.Add(time.Hour), jpyID, moneyVO.JPY)
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(categoriesQuery(olderID.String(), newerID.String()))).
+					WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "account_id", "category"}))
+			},
+			wantIDs: []string{newerID.String(), olderID.String()},
+			wantErr: false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			transactions, err := repo.ListByAccountID(ctx, params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, transactions)
+			} else {
+				assert.NoError(t, err)
+				ids := make([]string, len(transactions))
+				for i, tx := range transactions {
+					ids[i] = tx.IDString()
+				}
+				assert.Equal(t, tt.wantIDs, ids)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTransactionRepository_CountByAccountID(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewTransactionRepository)
+
+	accountID := idVO.NewAccountIDForTest("account")
+	params := transactionDomain.ListTransactionsParams{AccountID: accountID}
+
+	expectQuery := fmt.Sprintf(`
+		SELECT count(*) FROM "transactions" AS "transaction"
+		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "transaction"."currency_id")
+		LEFT JOIN "currency_master" AS "receiver_currency" ON ("receiver_currency"."id" = "transaction"."receiver_currency_id")
+		WHERE ((account_id = '%[1]s') OR (receiver_account_id = '%[1]s'))
+	`, accountID.String())
+
+	tests := []struct {
+		caseName  string
+		prepare   func()
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			caseName: "Positive: 件数の取得が成功する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+			},
+			wantTotal: 3,
+			wantErr:   false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantTotal: 0,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			total, err := repo.CountByAccountID(ctx, params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantTotal, total)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTransactionRepository_SumAmount(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewTransactionRepository)
+
+	accountID := idVO.NewAccountIDForTest("account")
+	from := timer.GetFixedDate()
+
+	expectQuery := fmt.Sprintf(`
+		SELECT COALESCE(SUM(transaction.amount), 0) FROM "transactions" AS "transaction"
+		WHERE (transaction.account_id = '%s') AND (transaction.operation_type = 'WITHDRAWAL')
+		AND (transaction.transaction_at >= '%s')
+		AND (NOT EXISTS (SELECT 1 FROM "transactions" AS "reversal" WHERE "reversal"."reversed_transaction_id" = "transaction"."id"))
+	`, accountID.String(), from.Format(timestampFormat))
+
+	tests := []struct {
+		caseName  string
+		prepare   func()
+		wantTotal int64
+		wantErr   bool
+	}{
+		{
+			caseName: "Positive: 取り消されていない取引の金額の合計の取得が成功する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(1500))
+			},
+			wantTotal: 1500,
+			wantErr:   false,
+		},
+		{
+			caseName: "Negative: SQLエラーで失敗する",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
+			},
+			wantTotal: 0,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			total, err := repo.SumAmount(ctx, accountID, transactionDomain.Withdrawal, from)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantTotal, total)
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTransactionRepository_CountByAccountID_Before(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewTransactionRepository)
+
+	accountID := idVO.NewAccountIDForTest("account")
+	before := timer.GetFixedDate()
+	params := transactionDomain.ListTransactionsParams{AccountID: accountID, Before: &before}
+
+	expectQuery := fmt.Sprintf(`
+		SELECT count(*) FROM "transactions" AS "transaction"
+		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "transaction"."currency_id")
+		LEFT JOIN "currency_master" AS "receiver_currency" ON ("receiver_currency"."id" = "transaction"."receiver_currency_id")
+		WHERE ((account_id = '%[1]s') OR (receiver_account_id = '%[1]s'))
+		AND (transaction_at < '%[2]s')
+	`, accountID.String(), before.Format(timestampFormat))
+	mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	total, err := repo.CountByAccountID(ctx, params)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransactionRepository_CountByAccountID_SearchFilters(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewTransactionRepository)
+
+	accountID := idVO.NewAccountIDForTest("account")
+	minAmount, maxAmount := int64(1000), int64(5000)
+	params := transactionDomain.ListTransactionsParams{
+		AccountID:  accountID,
+		Query:      strutil.StrPointer("lunch"),
+		MinAmount:  &minAmount,
+		MaxAmount:  &maxAmount,
+		Categories: []string{"food", "travel"},
+	}
+
+	expectQuery := fmt.Sprintf(`
+		SELECT count(*) FROM "transactions" AS "transaction"
+		LEFT JOIN "currency_master" AS "currency" ON ("currency"."id" = "transaction"."currency_id")
+		LEFT JOIN "currency_master" AS "receiver_currency" ON ("receiver_currency"."id" = "transaction"."receiver_currency_id")
+		WHERE ((account_id = '%[1]s') OR (receiver_account_id = '%[1]s'))
+		AND ((account_id = '%[1]s' AND to_tsvector('simple', coalesce(memo, '') || ' ' || coalesce(reference, '')) @@ plainto_tsquery('simple', 'lunch'))
+		OR (account_id <> '%[1]s' AND to_tsvector('simple', coalesce(reference, '')) @@ plainto_tsquery('simple', 'lunch')))
+		AND (CASE WHEN account_id = '%[1]s' THEN amount ELSE COALESCE(receiver_amount, amount) END >= 1000)
+		AND (CASE WHEN account_id = '%[1]s' THEN amount ELSE COALESCE(receiver_amount, amount) END <= 5000)
+		AND (EXISTS (SELECT 1 FROM "transaction_categories" AS "tc" WHERE "tc"."transaction_id" = "transaction"."id" AND "tc"."account_id" = '%[1]s' AND "tc"."category" IN ('food', 'travel')))
+	`, accountID.String())
+	mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	total, err := repo.CountByAccountID(ctx, params)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransactionRepository_SaveCategories(t *testing.T) {
+	repo, mock, ctx, _ := PrepareTestRepository(t, repository.NewTransactionRepository)
+
+	accountID := idVO.NewAccountIDForTest("account")
+	transactionID := idVO.NewTransactionIDForTest("transaction")
+
+	reconstruct := func(categories []string) *transactionDomain.Transaction {
+		transaction, err := transactionDomain.Reconstruct(
+			transactionID.String(), accountID.String(), nil, transactionDomain.Deposit, 1000, moneyVO.JPY,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, map[string][]string{accountID.String(): categories}, timer.GetFixedDate(),
+		)
+		assert.NoError(t, err)
+		return transaction
+	}
+
+	expectDeleteQuery := fmt.Sprintf(
+		`DELETE FROM "transaction_categories" AS "transaction_category" WHERE (transaction_id = '%s') AND (account_id = '%s')`,
+		transactionID.String(), accountID.String(),
+	)
+	expectInsertQuery := fmt.Sprintf(
+		`INSERT INTO "transaction_categories" ("transaction_id", "account_id", "category") VALUES ('%[1]s', '%[2]s', 'food'), ('%[1]s', '%[2]s', 'travel')`,
+		transactionID.String(), accountID.String(),
+	)
+
+	tests := []struct {
+		caseName    string
+		transaction *transactionDomain.Transaction
+		prepare     func()
+		wantErr     bool
+	}{
+		{
+			caseName:    "Positive: 既存の分類を削除して新しい分類を保存する",
+			transaction: reconstruct([]string{"travel", "food"}),
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectDeleteQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(expectInsertQuery)).WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			wantErr: false,
+		},
+		{
+			caseName:    "Positive: 分類がない場合は削除のみ行う",
+			transaction: reconstruct(nil),
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectDeleteQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			caseName:    "Negative: 削除に失敗する",
+			transaction: reconstruct([]string{"travel", "food"}),
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectDeleteQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			caseName:    "Negative: 保存に失敗する",
+			transaction: reconstruct([]string{"travel", "food"}),
+			prepare: func() {
+				mock.ExpectExec(regexp.QuoteMeta(expectDeleteQuery)).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(expectInsertQuery)).WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			tt.prepare()
+			err := repo.SaveCategories(ctx, tt.transaction, accountID)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, assert.AnError)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
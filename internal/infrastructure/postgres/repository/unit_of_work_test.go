@@ -2,10 +2,14 @@ package repository_test
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	authDomain "github.com/u104rak1/pocgo/internal/domain/authentication"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
 	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
@@ -110,3 +114,105 @@ func TestUnitOfWorkWithResult_RunInTx(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+// サインアップと同じ順序でユーザー、認証情報、セッションを保存する途中に障害を注入し、
+// それまでの保存がコミットされずにロールバックされることを確認します。
+func TestUnitOfWork_RunInTx_FaultInjection(t *testing.T) {
+	user, err := userDomain.New("sato taro", "sato@example.com")
+	assert.NoError(t, err)
+	auth, err := authDomain.New(user.ID(), "password123")
+	assert.NoError(t, err)
+	session, err := sessionDomain.New(user.ID())
+	assert.NoError(t, err)
+
+	var (
+		insertUser    = regexp.QuoteMeta(`INSERT INTO "users"`)
+		insertAuth    = regexp.QuoteMeta(`INSERT INTO "authentications"`)
+		insertSession = regexp.QuoteMeta(`INSERT INTO "sessions"`)
+	)
+
+	tests := []struct {
+		caseName string
+		prepare  func(mock sqlmock.Sqlmock)
+		wantErr  error
+	}{
+		{
+			caseName: "Positive: すべての保存に成功した場合、コミットされる",
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(insertUser).WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}))
+				mock.ExpectQuery(insertAuth).WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}))
+				mock.ExpectExec(insertSession).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: メールアドレスの一意制約に違反した場合、ロールバックされ ErrEmailAlreadyExists を返す",
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(insertUser).WillReturnError(uniqueViolation("user_email_idx"))
+				mock.ExpectRollback()
+			},
+			wantErr: userDomain.ErrEmailAlreadyExists,
+		},
+		{
+			caseName: "Negative: 認証情報の保存に失敗した場合、ユーザーの保存もロールバックされる",
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(insertUser).WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}))
+				mock.ExpectQuery(insertAuth).WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			caseName: "Negative: セッションの保存に失敗した場合、ユーザーと認証情報の保存もロールバックされる",
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(insertUser).WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}))
+				mock.ExpectQuery(insertAuth).WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}))
+				mock.ExpectExec(insertSession).WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer func() {
+				mock.ExpectClose()
+				err := db.Close()
+				assert.NoError(t, err)
+			}()
+
+			bunDB := bun.NewDB(db, pgdialect.New())
+			userRepo := repository.NewUserRepository(bunDB)
+			authRepo := repository.NewAuthenticationRepository(bunDB)
+			sessionRepo := repository.NewSessionRepository(bunDB)
+			uow := repository.NewUnitOfWork(bunDB)
+			tt.prepare(mock)
+
+			err = uow.RunInTx(context.Background(), func(ctx context.Context) error {
+				if err := userRepo.Save(ctx, user); err != nil {
+					return err
+				}
+				if err := authRepo.Save(ctx, auth); err != nil {
+					return err
+				}
+				return sessionRepo.Save(ctx, session)
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			// 期待していない COMMIT が発行された場合は、ここで検出される
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -13,10 +13,18 @@ import (
 
 type userRepository struct {
 	*Repository[model.User]
+	isEmailConflict func(err error) bool
 }
 
 func NewUserRepository(db *bun.DB) userDomain.IUserRepository {
-	return &userRepository{Repository: NewRepository[model.User](db)}
+	return NewUserRepositoryWithEmailConflict(db, func(err error) bool {
+		return isUniqueViolation(err, model.UserEmailIndex)
+	})
+}
+
+// メールアドレスの一意制約に違反した為のエラーかどうかの判定のみが PostgreSQL と異なるデータベースで使用します。
+func NewUserRepositoryWithEmailConflict(db *bun.DB, isEmailConflict func(err error) bool) userDomain.IUserRepository {
+	return &userRepository{Repository: NewRepository[model.User](db), isEmailConflict: isEmailConflict}
 }
 
 func (r *userRepository) Save(ctx context.Context, user *userDomain.User) error {
@@ -29,6 +37,9 @@ func (r *userRepository) Save(ctx context.Context, user *userDomain.User) error
 		Set("name = EXCLUDED.name").
 		Set("email = EXCLUDED.email").
 		Exec(ctx)
+	if r.isEmailConflict(err) {
+		return userDomain.ErrEmailAlreadyExists
+	}
 	return err
 }
 
@@ -24,24 +24,40 @@ func TestUserRepository_Save(t *testing.T) {
 		RETURNING "deleted_at"
 	`, user.IDString(), user.Name(), user.Email())
 
+	otherConstraintErr := uniqueViolation("users_pkey")
+
 	tests := []struct {
 		caseName string
 		prepare  func()
-		wantErr  bool
+		wantErr  error
 	}{
 		{
 			caseName: "Positive: ユーザー保存が成功する",
 			prepare: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}))
 			},
-			wantErr: false,
+			wantErr: nil,
+		},
+		{
+			caseName: "Negative: メールアドレスの一意制約に違反した場合、ErrEmailAlreadyExists を返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(uniqueViolation("user_email_idx"))
+			},
+			wantErr: userDomain.ErrEmailAlreadyExists,
+		},
+		{
+			caseName: "Negative: 他の制約に違反した場合、そのままのエラーを返す",
+			prepare: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(otherConstraintErr)
+			},
+			wantErr: otherConstraintErr,
 		},
 		{
 			caseName: "Negative: SQLエラーで失敗する",
 			prepare: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(expectQuery)).WillReturnError(assert.AnError)
 			},
-			wantErr: true,
+			wantErr: assert.AnError,
 		},
 	}
 
@@ -50,8 +66,8 @@ func TestUserRepository_Save(t *testing.T) {
 			tt.prepare()
 			err := repo.Save(ctx, user)
 
-			if tt.wantErr {
-				assert.Error(t, assert.AnError)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
 			} else {
 				assert.NoError(t, err)
 			}
@@ -1,17 +1,70 @@
-CREATE TABLE "currency_master" ("id" char(26) NOT NULL, "code" varchar(3) NOT NULL, PRIMARY KEY ("id"), UNIQUE ("code"));
+CREATE TABLE "currency_master" ("id" char(26) NOT NULL, "code" varchar(3) NOT NULL, "exponent" smallint NOT NULL DEFAULT 0, "symbol" varchar(8) NOT NULL DEFAULT '', PRIMARY KEY ("id"), UNIQUE ("code"));
 CREATE TABLE "operation_type_master" ("type" varchar(20) NOT NULL, PRIMARY KEY ("type"));
 CREATE TABLE "users" ("id" char(26) NOT NULL, "name" varchar(20) NOT NULL, "email" VARCHAR NOT NULL, "deleted_at" TIMESTAMPTZ, PRIMARY KEY ("id"));
-CREATE TABLE "accounts" ("id" char(26) NOT NULL, "user_id" char(26) NOT NULL, "name" varchar(20), "password_hash" VARCHAR NOT NULL, "balance" float8 NOT NULL, "currency_id" VARCHAR NOT NULL, "updated_at" TIMESTAMPTZ NOT NULL, "deleted_at" TIMESTAMPTZ, PRIMARY KEY ("id"));
-CREATE TABLE "transactions" ("id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "receiver_account_id" char(26), "operation_type" varchar(20) NOT NULL, "amount" float8 NOT NULL, "currency_id" char(26) NOT NULL, "transaction_at" TIMESTAMPTZ NOT NULL, PRIMARY KEY ("id"));
+CREATE TABLE "accounts" ("id" char(26) NOT NULL, "user_id" char(26) NOT NULL, "name" varchar(20), "password_hash" VARCHAR NOT NULL, "balance" bigint NOT NULL, "held_amount" bigint NOT NULL DEFAULT 0, "currency_id" VARCHAR NOT NULL, "updated_at" TIMESTAMPTZ NOT NULL, "version" bigint NOT NULL DEFAULT 1, "deleted_at" TIMESTAMPTZ, PRIMARY KEY ("id"));
+CREATE TABLE "transactions" ("id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "receiver_account_id" char(26), "operation_type" varchar(20) NOT NULL, "amount" bigint NOT NULL, "currency_id" char(26) NOT NULL, "receiver_amount" bigint, "receiver_currency_id" char(26), "exchange_rate" numeric(24,12), "balance_after" bigint, "receiver_balance_after" bigint, "reversed_transaction_id" char(26), "reversed_operation_type" varchar(20), "memo" varchar(200), "reference" varchar(140), "transaction_at" TIMESTAMPTZ NOT NULL, PRIMARY KEY ("id"));
+CREATE TABLE "transaction_categories" ("transaction_id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "category" varchar(30) NOT NULL, PRIMARY KEY ("transaction_id", "account_id", "category"));
+CREATE TABLE "ledger_postings" ("transaction_id" char(26) NOT NULL, "line" smallint NOT NULL, "account_id" char(26), "system_account" varchar(32), "side" varchar(6) NOT NULL, "amount" bigint NOT NULL, "currency_id" char(26) NOT NULL, "posted_at" TIMESTAMPTZ NOT NULL, PRIMARY KEY ("transaction_id", "line"));
 CREATE TABLE "authentications" ("user_id" char(26) NOT NULL, "password_hash" VARCHAR NOT NULL, "deleted_at" TIMESTAMPTZ, PRIMARY KEY ("user_id"));
+CREATE TABLE "idempotency_keys" ("user_id" char(26) NOT NULL, "key" varchar(255) NOT NULL, "fingerprint" char(64) NOT NULL, "response" text, "created_at" TIMESTAMPTZ NOT NULL, PRIMARY KEY ("user_id", "key"));
+CREATE TABLE "sessions" ("id" char(26) NOT NULL, "user_id" char(26) NOT NULL, "created_at" TIMESTAMPTZ NOT NULL, "revoked_at" TIMESTAMPTZ, PRIMARY KEY ("id"));
+CREATE TABLE "refresh_tokens" ("token_hash" char(64) NOT NULL, "session_id" char(26) NOT NULL, "expires_at" TIMESTAMPTZ NOT NULL, "used_at" TIMESTAMPTZ, "created_at" TIMESTAMPTZ NOT NULL, PRIMARY KEY ("token_hash"));
+CREATE TABLE "lockouts" ("subject" varchar(20) NOT NULL, "subject_id" char(26) NOT NULL, "failed_count" BIGINT NOT NULL, "locked_until" TIMESTAMPTZ, "updated_at" TIMESTAMPTZ NOT NULL, PRIMARY KEY ("subject", "subject_id"));
+CREATE TABLE "standing_orders" ("id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "receiver_account_id" char(26) NOT NULL, "amount" bigint NOT NULL, "currency_id" char(26) NOT NULL, "frequency" varchar(10) NOT NULL, "day_of_month" smallint, "start_date" date NOT NULL, "end_date" date, "max_executions" integer, "max_retries" smallint NOT NULL, "failure_policy" varchar(10) NOT NULL, "status" varchar(10) NOT NULL, "next_run_date" date NOT NULL, "next_attempt_date" date NOT NULL, "retry_count" smallint NOT NULL, "execution_count" integer NOT NULL, "created_at" TIMESTAMPTZ NOT NULL, "updated_at" TIMESTAMPTZ NOT NULL, "version" bigint NOT NULL DEFAULT 1, PRIMARY KEY ("id"));
+CREATE TABLE "standing_order_executions" ("standing_order_id" char(26) NOT NULL, "scheduled_date" date NOT NULL, "attempt" smallint NOT NULL, "result" varchar(16) NOT NULL, "transaction_id" char(26), "failure_reason" text, "executed_at" TIMESTAMPTZ NOT NULL, PRIMARY KEY ("standing_order_id", "scheduled_date", "attempt"));
+CREATE TABLE "holds" ("id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "receiver_account_id" char(26), "amount" bigint NOT NULL, "currency_id" char(26) NOT NULL, "status" varchar(10) NOT NULL, "expires_at" TIMESTAMPTZ NOT NULL, "captured_amount" bigint, "transaction_id" char(26), "created_at" TIMESTAMPTZ NOT NULL, "updated_at" TIMESTAMPTZ NOT NULL, "version" bigint NOT NULL DEFAULT 1, PRIMARY KEY ("id"));
+CREATE TABLE "account_limits" ("account_id" char(26) NOT NULL, "operation_type" varchar(20) NOT NULL, "per_transaction_amount" bigint NOT NULL, "daily_amount" bigint NOT NULL, "monthly_amount" bigint NOT NULL, "currency_id" char(26) NOT NULL, "updated_at" TIMESTAMPTZ NOT NULL, PRIMARY KEY ("account_id", "operation_type"));
+CREATE TABLE "payees" ("id" char(26) NOT NULL, "user_id" char(26) NOT NULL, "payee_user_id" char(26) NOT NULL, "masked_name" varchar(20) NOT NULL, "nickname" varchar(30), "confirmed_at" TIMESTAMPTZ, "created_at" TIMESTAMPTZ NOT NULL, PRIMARY KEY ("id"));
+CREATE TABLE "receiving_accounts" ("user_id" char(26) NOT NULL, "currency_id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "updated_at" TIMESTAMPTZ NOT NULL, PRIMARY KEY ("user_id", "currency_id"));
+CREATE TABLE "outbox" ("sequence" BIGSERIAL NOT NULL, "id" char(26) NOT NULL, "event_type" varchar(40) NOT NULL, "aggregate_type" varchar(20) NOT NULL, "aggregate_id" char(26) NOT NULL, "payload" jsonb NOT NULL, "occurred_at" TIMESTAMPTZ NOT NULL, "published_at" TIMESTAMPTZ, PRIMARY KEY ("sequence"), UNIQUE ("id"));
 CREATE INDEX "account_user_id_idx" ON "accounts" ("user_id");
 CREATE UNIQUE INDEX "user_email_idx" ON "users" ("email");
 CREATE INDEX "transaction_account_id_idx" ON "transactions" ("account_id");
 CREATE INDEX "transaction_receiver_account_id_idx" ON "transactions" ("receiver_account_id");
+CREATE UNIQUE INDEX "transaction_reversed_transaction_id_idx" ON "transactions" ("reversed_transaction_id");
+CREATE INDEX "ledger_posting_account_id_idx" ON "ledger_postings" ("account_id");
+CREATE INDEX "refresh_token_session_id_idx" ON "refresh_tokens" ("session_id");
+CREATE INDEX "standing_order_account_id_idx" ON "standing_orders" ("account_id");
+CREATE INDEX "standing_order_status_next_attempt_date_idx" ON "standing_orders" ("status", "next_attempt_date");
+CREATE INDEX "hold_account_id_idx" ON "holds" ("account_id");
+CREATE INDEX "hold_status_expires_at_idx" ON "holds" ("status", "expires_at");
+CREATE INDEX "transaction_search_idx" ON "transactions" USING GIN (to_tsvector('simple', coalesce(memo, '') || ' ' || coalesce(reference, '')));
+CREATE INDEX "transaction_reference_search_idx" ON "transactions" USING GIN (to_tsvector('simple', coalesce(reference, '')));
+CREATE INDEX "transaction_category_account_id_category_idx" ON "transaction_categories" ("account_id", "category");
+CREATE UNIQUE INDEX "payee_user_id_payee_user_id_idx" ON "payees" ("user_id", "payee_user_id");
+CREATE INDEX "outbox_unpublished_idx" ON "outbox" ("sequence") WHERE (published_at IS NULL);
 ALTER TABLE accounts ADD CONSTRAINT fk_account_user_id FOREIGN KEY (user_id) REFERENCES users(id);
 ALTER TABLE accounts ADD CONSTRAINT fk_account_currency_id FOREIGN KEY (currency_id) REFERENCES currency_master(id);
 ALTER TABLE authentications ADD CONSTRAINT fk_auth_user_id FOREIGN KEY (user_id) REFERENCES users(id);
 ALTER TABLE transactions ADD CONSTRAINT fk_transaction_account_id FOREIGN KEY (account_id) REFERENCES accounts(id);
 ALTER TABLE transactions ADD CONSTRAINT fk_transaction_receiver_account_id FOREIGN KEY (receiver_account_id) REFERENCES accounts(id);
 ALTER TABLE transactions ADD CONSTRAINT fk_transaction_currency_id FOREIGN KEY (currency_id) REFERENCES currency_master(id);
+ALTER TABLE transactions ADD CONSTRAINT fk_transaction_receiver_currency_id FOREIGN KEY (receiver_currency_id) REFERENCES currency_master(id);
 ALTER TABLE transactions ADD CONSTRAINT fk_transaction_operation_type FOREIGN KEY (operation_type) REFERENCES operation_type_master(type);
+ALTER TABLE transactions ADD CONSTRAINT fk_transaction_reversed_transaction_id FOREIGN KEY (reversed_transaction_id) REFERENCES transactions(id);
+ALTER TABLE transactions ADD CONSTRAINT fk_transaction_reversed_operation_type FOREIGN KEY (reversed_operation_type) REFERENCES operation_type_master(type);
+ALTER TABLE ledger_postings ADD CONSTRAINT fk_ledger_posting_transaction_id FOREIGN KEY (transaction_id) REFERENCES transactions(id);
+ALTER TABLE ledger_postings ADD CONSTRAINT fk_ledger_posting_account_id FOREIGN KEY (account_id) REFERENCES accounts(id);
+ALTER TABLE ledger_postings ADD CONSTRAINT fk_ledger_posting_currency_id FOREIGN KEY (currency_id) REFERENCES currency_master(id);
+ALTER TABLE idempotency_keys ADD CONSTRAINT fk_idempotency_key_user_id FOREIGN KEY (user_id) REFERENCES users(id);
+ALTER TABLE sessions ADD CONSTRAINT fk_session_user_id FOREIGN KEY (user_id) REFERENCES users(id);
+ALTER TABLE refresh_tokens ADD CONSTRAINT fk_refresh_token_session_id FOREIGN KEY (session_id) REFERENCES sessions(id);
+ALTER TABLE standing_orders ADD CONSTRAINT fk_standing_order_account_id FOREIGN KEY (account_id) REFERENCES accounts(id);
+ALTER TABLE standing_orders ADD CONSTRAINT fk_standing_order_receiver_account_id FOREIGN KEY (receiver_account_id) REFERENCES accounts(id);
+ALTER TABLE standing_orders ADD CONSTRAINT fk_standing_order_currency_id FOREIGN KEY (currency_id) REFERENCES currency_master(id);
+ALTER TABLE standing_order_executions ADD CONSTRAINT fk_standing_order_execution_standing_order_id FOREIGN KEY (standing_order_id) REFERENCES standing_orders(id);
+ALTER TABLE standing_order_executions ADD CONSTRAINT fk_standing_order_execution_transaction_id FOREIGN KEY (transaction_id) REFERENCES transactions(id);
+ALTER TABLE holds ADD CONSTRAINT fk_hold_account_id FOREIGN KEY (account_id) REFERENCES accounts(id);
+ALTER TABLE holds ADD CONSTRAINT fk_hold_receiver_account_id FOREIGN KEY (receiver_account_id) REFERENCES accounts(id);
+ALTER TABLE holds ADD CONSTRAINT fk_hold_currency_id FOREIGN KEY (currency_id) REFERENCES currency_master(id);
+ALTER TABLE holds ADD CONSTRAINT fk_hold_transaction_id FOREIGN KEY (transaction_id) REFERENCES transactions(id);
+ALTER TABLE account_limits ADD CONSTRAINT fk_account_limit_account_id FOREIGN KEY (account_id) REFERENCES accounts(id);
+ALTER TABLE account_limits ADD CONSTRAINT fk_account_limit_currency_id FOREIGN KEY (currency_id) REFERENCES currency_master(id);
+ALTER TABLE account_limits ADD CONSTRAINT fk_account_limit_operation_type FOREIGN KEY (operation_type) REFERENCES operation_type_master(type);
+ALTER TABLE transaction_categories ADD CONSTRAINT fk_transaction_category_transaction_id FOREIGN KEY (transaction_id) REFERENCES transactions(id);
+ALTER TABLE transaction_categories ADD CONSTRAINT fk_transaction_category_account_id FOREIGN KEY (account_id) REFERENCES accounts(id);
+ALTER TABLE payees ADD CONSTRAINT fk_payee_user_id FOREIGN KEY (user_id) REFERENCES users(id);
+ALTER TABLE payees ADD CONSTRAINT fk_payee_payee_user_id FOREIGN KEY (payee_user_id) REFERENCES users(id);
+ALTER TABLE receiving_accounts ADD CONSTRAINT fk_receiving_account_user_id FOREIGN KEY (user_id) REFERENCES users(id);
+ALTER TABLE receiving_accounts ADD CONSTRAINT fk_receiving_account_currency_id FOREIGN KEY (currency_id) REFERENCES currency_master(id);
+ALTER TABLE receiving_accounts ADD CONSTRAINT fk_receiving_account_account_id FOREIGN KEY (account_id) REFERENCES accounts(id);
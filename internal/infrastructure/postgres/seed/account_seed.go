@@ -42,7 +42,7 @@ func saveAccount(db *bun.DB) error {
 			UserID:       JaneSmithID,
 			Name:         "work",
 			PasswordHash: passwordHash,
-			Balance:      3000.55,
+			Balance:      300055,
 			CurrencyID:   USDID,
 			UpdatedAt:    timer.Now(),
 		},
@@ -51,7 +51,7 @@ func saveAccount(db *bun.DB) error {
 			UserID:       JaneSmithID,
 			Name:         "private",
 			PasswordHash: passwordHash,
-			Balance:      4000.55,
+			Balance:      400055,
 			CurrencyID:   USDID,
 			UpdatedAt:    timer.Now(),
 		},
@@ -11,12 +11,16 @@ import (
 const (
 	JPYID = "01J9R7YPV1FH1V0PPKVSB5C9TQ"
 	USDID = "01J9R7ZQZQZQZQZQZQZQZQZQZQ"
+	EURID = "01JA4F2K8Q6M3X9V7T5R1N0P4B"
+	GBPID = "01JA4F3D2W8Y6H4J2K0M9N7Q5C"
 )
 
 func saveCurrencyMaster(db *bun.DB) error {
 	data := []model.CurrencyMaster{
-		{ID: JPYID, Code: money.JPY},
-		{ID: USDID, Code: money.USD},
+		{ID: JPYID, Code: money.JPY, Exponent: 0, Symbol: "¥"},
+		{ID: USDID, Code: money.USD, Exponent: 2, Symbol: "$"},
+		{ID: EURID, Code: "EUR", Exponent: 2, Symbol: "€"},
+		{ID: GBPID, Code: "GBP", Exponent: 2, Symbol: "£"},
 	}
 	if _, err := db.NewInsert().Model(&data).Exec(context.Background()); err != nil {
 		return err
@@ -0,0 +1,45 @@
+package seed
+
+import (
+	"context"
+
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+// シードの入金取引に対応する仕訳を登録します。口座残高と記帳の合計を一致させる為に必要です。
+func saveLedgerPosting(db *bun.DB) error {
+	systemCash := ledgerDomain.SystemCash
+
+	postings := make([]model.LedgerPosting, 0, len(seedTransactions)*2)
+	for _, tx := range seedTransactions {
+		accountID := tx.AccountID
+		postings = append(postings,
+			model.LedgerPosting{
+				TransactionID: tx.ID,
+				Line:          1,
+				SystemAccount: &systemCash,
+				Side:          ledgerDomain.Debit,
+				Amount:        tx.Amount,
+				CurrencyID:    tx.CurrencyID,
+				PostedAt:      tx.TransactionAt,
+			},
+			model.LedgerPosting{
+				TransactionID: tx.ID,
+				Line:          2,
+				AccountID:     &accountID,
+				Side:          ledgerDomain.Credit,
+				Amount:        tx.Amount,
+				CurrencyID:    tx.CurrencyID,
+				PostedAt:      tx.TransactionAt,
+			},
+		)
+	}
+
+	if _, err := db.NewInsert().Model(&postings).Exec(context.Background()); err != nil {
+		return err
+	}
+
+	return nil
+}
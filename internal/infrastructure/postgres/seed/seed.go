@@ -30,4 +30,7 @@ func InsertSeedData(db *bun.DB) {
 	if err := saveTransaction(db); err != nil {
 		log.Println("Error inserting transaction data:", err)
 	}
+	if err := saveLedgerPosting(db); err != nil {
+		log.Println("Error inserting ledger posting data:", err)
+	}
 }
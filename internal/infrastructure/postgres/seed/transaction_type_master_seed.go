@@ -13,8 +13,10 @@ func saveOperationTypeMaster(db *bun.DB) error {
 		{Type: transactionDomain.Deposit},
 		{Type: transactionDomain.Withdrawal},
 		{Type: transactionDomain.Transfer},
+		{Type: transactionDomain.Reversal},
 	}
-	if _, err := db.NewInsert().Model(&data).Exec(context.Background()); err != nil {
+	// マイグレーションで追加された取引種別は既に存在する為、重複は無視する
+	if _, err := db.NewInsert().Model(&data).On("CONFLICT (type) DO NOTHING").Exec(context.Background()); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,62 @@
+-- reverse: create "receiving_accounts" table
+DROP TABLE "receiving_accounts";
+-- reverse: create index "payee_user_id_payee_user_id_idx" to table: "payees"
+DROP INDEX "payee_user_id_payee_user_id_idx";
+-- reverse: create "payees" table
+DROP TABLE "payees";
+-- reverse: create "account_limits" table
+DROP TABLE "account_limits";
+-- reverse: create index "hold_status_expires_at_idx" to table: "holds"
+DROP INDEX "hold_status_expires_at_idx";
+-- reverse: create index "hold_account_id_idx" to table: "holds"
+DROP INDEX "hold_account_id_idx";
+-- reverse: create "holds" table
+DROP TABLE "holds";
+-- reverse: create "standing_order_executions" table
+DROP TABLE "standing_order_executions";
+-- reverse: create index "standing_order_status_next_attempt_date_idx" to table: "standing_orders"
+DROP INDEX "standing_order_status_next_attempt_date_idx";
+-- reverse: create index "standing_order_account_id_idx" to table: "standing_orders"
+DROP INDEX "standing_order_account_id_idx";
+-- reverse: create "standing_orders" table
+DROP TABLE "standing_orders";
+-- reverse: create "lockouts" table
+DROP TABLE "lockouts";
+-- reverse: create index "refresh_token_session_id_idx" to table: "refresh_tokens"
+DROP INDEX "refresh_token_session_id_idx";
+-- reverse: create "refresh_tokens" table
+DROP TABLE "refresh_tokens";
+-- reverse: create "sessions" table
+DROP TABLE "sessions";
+-- reverse: create "idempotency_keys" table
+DROP TABLE "idempotency_keys";
+-- reverse: create index "ledger_posting_account_id_idx" to table: "ledger_postings"
+DROP INDEX "ledger_posting_account_id_idx";
+-- reverse: create "ledger_postings" table
+DROP TABLE "ledger_postings";
+-- reverse: create index "transaction_category_account_id_category_idx" to table: "transaction_categories"
+DROP INDEX "transaction_category_account_id_category_idx";
+-- reverse: create "transaction_categories" table
+DROP TABLE "transaction_categories";
+-- reverse: create index "transaction_reversed_transaction_id_idx" to table: "transactions"
+DROP INDEX "transaction_reversed_transaction_id_idx";
+-- reverse: create index "transaction_receiver_account_id_idx" to table: "transactions"
+DROP INDEX "transaction_receiver_account_id_idx";
+-- reverse: create index "transaction_account_id_idx" to table: "transactions"
+DROP INDEX "transaction_account_id_idx";
+-- reverse: create "transactions" table
+DROP TABLE "transactions";
+-- reverse: create "authentications" table
+DROP TABLE "authentications";
+-- reverse: create index "account_user_id_idx" to table: "accounts"
+DROP INDEX "account_user_id_idx";
+-- reverse: create "accounts" table
+DROP TABLE "accounts";
+-- reverse: create index "user_email_idx" to table: "users"
+DROP INDEX "user_email_idx";
+-- reverse: create "users" table
+DROP TABLE "users";
+-- reverse: create "operation_type_master" table
+DROP TABLE "operation_type_master";
+-- reverse: create "currency_master" table
+DROP TABLE "currency_master";
@@ -0,0 +1,62 @@
+-- create "currency_master" table
+CREATE TABLE "currency_master" ("id" char(26) NOT NULL, "code" varchar(3) NOT NULL, "exponent" smallint NOT NULL DEFAULT 0, "symbol" varchar(8) NOT NULL DEFAULT '', PRIMARY KEY ("id"), UNIQUE ("code"));
+-- create "operation_type_master" table
+CREATE TABLE "operation_type_master" ("type" varchar(20) NOT NULL, PRIMARY KEY ("type"));
+-- create "users" table
+CREATE TABLE "users" ("id" char(26) NOT NULL, "name" varchar(20) NOT NULL, "email" varchar NOT NULL, "deleted_at" TIMESTAMP NULL, PRIMARY KEY ("id"));
+-- create index "user_email_idx" to table: "users"
+CREATE UNIQUE INDEX "user_email_idx" ON "users" ("email");
+-- create "accounts" table
+CREATE TABLE "accounts" ("id" char(26) NOT NULL, "user_id" char(26) NOT NULL, "name" varchar(20) NULL, "password_hash" varchar NOT NULL, "balance" bigint NOT NULL, "held_amount" bigint NOT NULL DEFAULT 0, "currency_id" char(26) NOT NULL, "updated_at" TIMESTAMP NOT NULL, "version" bigint NOT NULL DEFAULT 1, "deleted_at" TIMESTAMP NULL, PRIMARY KEY ("id"), CONSTRAINT "fk_account_user_id" FOREIGN KEY ("user_id") REFERENCES "users" ("id"), CONSTRAINT "fk_account_currency_id" FOREIGN KEY ("currency_id") REFERENCES "currency_master" ("id"));
+-- create index "account_user_id_idx" to table: "accounts"
+CREATE INDEX "account_user_id_idx" ON "accounts" ("user_id");
+-- create "authentications" table
+CREATE TABLE "authentications" ("user_id" char(26) NOT NULL, "password_hash" varchar NOT NULL, "deleted_at" TIMESTAMP NULL, PRIMARY KEY ("user_id"), CONSTRAINT "fk_auth_user_id" FOREIGN KEY ("user_id") REFERENCES "users" ("id"));
+-- create "transactions" table
+CREATE TABLE "transactions" ("id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "receiver_account_id" char(26) NULL, "operation_type" varchar(20) NOT NULL, "amount" bigint NOT NULL, "currency_id" char(26) NOT NULL, "receiver_amount" bigint NULL, "receiver_currency_id" char(26) NULL, "exchange_rate" text NULL, "balance_after" bigint NULL, "receiver_balance_after" bigint NULL, "reversed_transaction_id" char(26) NULL, "reversed_operation_type" varchar(20) NULL, "memo" varchar(200) NULL, "reference" varchar(140) NULL, "transaction_at" TIMESTAMP NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "fk_transaction_account_id" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id"), CONSTRAINT "fk_transaction_receiver_account_id" FOREIGN KEY ("receiver_account_id") REFERENCES "accounts" ("id"), CONSTRAINT "fk_transaction_currency_id" FOREIGN KEY ("currency_id") REFERENCES "currency_master" ("id"), CONSTRAINT "fk_transaction_receiver_currency_id" FOREIGN KEY ("receiver_currency_id") REFERENCES "currency_master" ("id"), CONSTRAINT "fk_transaction_operation_type" FOREIGN KEY ("operation_type") REFERENCES "operation_type_master" ("type"), CONSTRAINT "fk_transaction_reversed_transaction_id" FOREIGN KEY ("reversed_transaction_id") REFERENCES "transactions" ("id"), CONSTRAINT "fk_transaction_reversed_operation_type" FOREIGN KEY ("reversed_operation_type") REFERENCES "operation_type_master" ("type"));
+-- create index "transaction_account_id_idx" to table: "transactions"
+CREATE INDEX "transaction_account_id_idx" ON "transactions" ("account_id");
+-- create index "transaction_receiver_account_id_idx" to table: "transactions"
+CREATE INDEX "transaction_receiver_account_id_idx" ON "transactions" ("receiver_account_id");
+-- create index "transaction_reversed_transaction_id_idx" to table: "transactions"
+CREATE UNIQUE INDEX "transaction_reversed_transaction_id_idx" ON "transactions" ("reversed_transaction_id");
+-- create "transaction_categories" table
+CREATE TABLE "transaction_categories" ("transaction_id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "category" varchar(30) NOT NULL, PRIMARY KEY ("transaction_id", "account_id", "category"), CONSTRAINT "fk_transaction_category_transaction_id" FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id"), CONSTRAINT "fk_transaction_category_account_id" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id"));
+-- create index "transaction_category_account_id_category_idx" to table: "transaction_categories"
+CREATE INDEX "transaction_category_account_id_category_idx" ON "transaction_categories" ("account_id", "category");
+-- create "ledger_postings" table
+CREATE TABLE "ledger_postings" ("transaction_id" char(26) NOT NULL, "line" smallint NOT NULL, "account_id" char(26) NULL, "system_account" varchar(32) NULL, "side" varchar(6) NOT NULL, "amount" bigint NOT NULL, "currency_id" char(26) NOT NULL, "posted_at" TIMESTAMP NOT NULL, PRIMARY KEY ("transaction_id", "line"), CONSTRAINT "fk_ledger_posting_transaction_id" FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id"), CONSTRAINT "fk_ledger_posting_account_id" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id"), CONSTRAINT "fk_ledger_posting_currency_id" FOREIGN KEY ("currency_id") REFERENCES "currency_master" ("id"));
+-- create index "ledger_posting_account_id_idx" to table: "ledger_postings"
+CREATE INDEX "ledger_posting_account_id_idx" ON "ledger_postings" ("account_id");
+-- create "idempotency_keys" table
+CREATE TABLE "idempotency_keys" ("user_id" char(26) NOT NULL, "key" varchar(255) NOT NULL, "fingerprint" char(64) NOT NULL, "response" text NULL, "created_at" TIMESTAMP NOT NULL, PRIMARY KEY ("user_id", "key"), CONSTRAINT "fk_idempotency_key_user_id" FOREIGN KEY ("user_id") REFERENCES "users" ("id"));
+-- create "sessions" table
+CREATE TABLE "sessions" ("id" char(26) NOT NULL, "user_id" char(26) NOT NULL, "created_at" TIMESTAMP NOT NULL, "revoked_at" TIMESTAMP NULL, PRIMARY KEY ("id"), CONSTRAINT "fk_session_user_id" FOREIGN KEY ("user_id") REFERENCES "users" ("id"));
+-- create "refresh_tokens" table
+CREATE TABLE "refresh_tokens" ("token_hash" char(64) NOT NULL, "session_id" char(26) NOT NULL, "expires_at" TIMESTAMP NOT NULL, "used_at" TIMESTAMP NULL, "created_at" TIMESTAMP NOT NULL, PRIMARY KEY ("token_hash"), CONSTRAINT "fk_refresh_token_session_id" FOREIGN KEY ("session_id") REFERENCES "sessions" ("id"));
+-- create index "refresh_token_session_id_idx" to table: "refresh_tokens"
+CREATE INDEX "refresh_token_session_id_idx" ON "refresh_tokens" ("session_id");
+-- create "lockouts" table
+CREATE TABLE "lockouts" ("subject" varchar(20) NOT NULL, "subject_id" char(26) NOT NULL, "failed_count" bigint NOT NULL, "locked_until" TIMESTAMP NULL, "updated_at" TIMESTAMP NOT NULL, PRIMARY KEY ("subject", "subject_id"));
+-- create "standing_orders" table
+CREATE TABLE "standing_orders" ("id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "receiver_account_id" char(26) NOT NULL, "amount" bigint NOT NULL, "currency_id" char(26) NOT NULL, "frequency" varchar(10) NOT NULL, "day_of_month" smallint NULL, "start_date" DATE NOT NULL, "end_date" DATE NULL, "max_executions" integer NULL, "max_retries" smallint NOT NULL, "failure_policy" varchar(10) NOT NULL, "status" varchar(10) NOT NULL, "next_run_date" DATE NOT NULL, "next_attempt_date" DATE NOT NULL, "retry_count" smallint NOT NULL, "execution_count" integer NOT NULL, "created_at" TIMESTAMP NOT NULL, "updated_at" TIMESTAMP NOT NULL, "version" bigint NOT NULL DEFAULT 1, PRIMARY KEY ("id"), CONSTRAINT "fk_standing_order_account_id" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id"), CONSTRAINT "fk_standing_order_receiver_account_id" FOREIGN KEY ("receiver_account_id") REFERENCES "accounts" ("id"), CONSTRAINT "fk_standing_order_currency_id" FOREIGN KEY ("currency_id") REFERENCES "currency_master" ("id"));
+-- create index "standing_order_account_id_idx" to table: "standing_orders"
+CREATE INDEX "standing_order_account_id_idx" ON "standing_orders" ("account_id");
+-- create index "standing_order_status_next_attempt_date_idx" to table: "standing_orders"
+CREATE INDEX "standing_order_status_next_attempt_date_idx" ON "standing_orders" ("status", "next_attempt_date");
+-- create "standing_order_executions" table
+CREATE TABLE "standing_order_executions" ("standing_order_id" char(26) NOT NULL, "scheduled_date" DATE NOT NULL, "attempt" smallint NOT NULL, "result" varchar(16) NOT NULL, "transaction_id" char(26) NULL, "failure_reason" text NULL, "executed_at" TIMESTAMP NOT NULL, PRIMARY KEY ("standing_order_id", "scheduled_date", "attempt"), CONSTRAINT "fk_standing_order_execution_standing_order_id" FOREIGN KEY ("standing_order_id") REFERENCES "standing_orders" ("id"), CONSTRAINT "fk_standing_order_execution_transaction_id" FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id"));
+-- create "holds" table
+CREATE TABLE "holds" ("id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "receiver_account_id" char(26) NULL, "amount" bigint NOT NULL, "currency_id" char(26) NOT NULL, "status" varchar(10) NOT NULL, "expires_at" TIMESTAMP NOT NULL, "captured_amount" bigint NULL, "transaction_id" char(26) NULL, "created_at" TIMESTAMP NOT NULL, "updated_at" TIMESTAMP NOT NULL, "version" bigint NOT NULL DEFAULT 1, PRIMARY KEY ("id"), CONSTRAINT "fk_hold_account_id" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id"), CONSTRAINT "fk_hold_receiver_account_id" FOREIGN KEY ("receiver_account_id") REFERENCES "accounts" ("id"), CONSTRAINT "fk_hold_currency_id" FOREIGN KEY ("currency_id") REFERENCES "currency_master" ("id"), CONSTRAINT "fk_hold_transaction_id" FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id"));
+-- create index "hold_account_id_idx" to table: "holds"
+CREATE INDEX "hold_account_id_idx" ON "holds" ("account_id");
+-- create index "hold_status_expires_at_idx" to table: "holds"
+CREATE INDEX "hold_status_expires_at_idx" ON "holds" ("status", "expires_at");
+-- create "account_limits" table
+CREATE TABLE "account_limits" ("account_id" char(26) NOT NULL, "operation_type" varchar(20) NOT NULL, "per_transaction_amount" bigint NOT NULL, "daily_amount" bigint NOT NULL, "monthly_amount" bigint NOT NULL, "currency_id" char(26) NOT NULL, "updated_at" TIMESTAMP NOT NULL, PRIMARY KEY ("account_id", "operation_type"), CONSTRAINT "fk_account_limit_account_id" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id"), CONSTRAINT "fk_account_limit_currency_id" FOREIGN KEY ("currency_id") REFERENCES "currency_master" ("id"), CONSTRAINT "fk_account_limit_operation_type" FOREIGN KEY ("operation_type") REFERENCES "operation_type_master" ("type"));
+-- create "payees" table
+CREATE TABLE "payees" ("id" char(26) NOT NULL, "user_id" char(26) NOT NULL, "payee_user_id" char(26) NOT NULL, "masked_name" varchar(20) NOT NULL, "nickname" varchar(30) NULL, "confirmed_at" TIMESTAMP NULL, "created_at" TIMESTAMP NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "fk_payee_user_id" FOREIGN KEY ("user_id") REFERENCES "users" ("id"), CONSTRAINT "fk_payee_payee_user_id" FOREIGN KEY ("payee_user_id") REFERENCES "users" ("id"));
+-- create index "payee_user_id_payee_user_id_idx" to table: "payees"
+CREATE UNIQUE INDEX "payee_user_id_payee_user_id_idx" ON "payees" ("user_id", "payee_user_id");
+-- create "receiving_accounts" table
+CREATE TABLE "receiving_accounts" ("user_id" char(26) NOT NULL, "currency_id" char(26) NOT NULL, "account_id" char(26) NOT NULL, "updated_at" TIMESTAMP NOT NULL, PRIMARY KEY ("user_id", "currency_id"), CONSTRAINT "fk_receiving_account_user_id" FOREIGN KEY ("user_id") REFERENCES "users" ("id"), CONSTRAINT "fk_receiving_account_currency_id" FOREIGN KEY ("currency_id") REFERENCES "currency_master" ("id"), CONSTRAINT "fk_receiving_account_account_id" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id"));
@@ -0,0 +1,4 @@
+-- create "outbox" table
+CREATE TABLE "outbox" ("sequence" INTEGER PRIMARY KEY AUTOINCREMENT, "id" char(26) NOT NULL, "event_type" varchar(40) NOT NULL, "aggregate_type" varchar(20) NOT NULL, "aggregate_id" char(26) NOT NULL, "payload" TEXT NOT NULL, "occurred_at" TIMESTAMP NOT NULL, "published_at" TIMESTAMP NULL, CONSTRAINT "outbox_id_key" UNIQUE ("id"));
+-- create index "outbox_unpublished_idx" to table: "outbox"
+CREATE INDEX "outbox_unpublished_idx" ON "outbox" ("sequence") WHERE (published_at IS NULL);
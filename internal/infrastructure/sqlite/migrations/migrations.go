@@ -0,0 +1,8 @@
+// SQLite のマイグレーションファイルです。
+// PostgreSQL の schema.sql と同じテーブルを作成しますが、SQLite は外部キーを後から追加できない為、テーブルの作成時に定義します。
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,118 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	postgresRepository "github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+)
+
+func TestAccountRepository_Save(t *testing.T) {
+	tests := []struct {
+		caseName    string
+		modify      func(t *testing.T, account *accountDomain.Account) *accountDomain.Account
+		wantErr     error
+		wantBalance int64
+		wantVersion int64
+	}{
+		{
+			caseName: "Positive: 読み込んだ時点のバージョンと一致する場合は更新する",
+			modify: func(t *testing.T, account *accountDomain.Account) *accountDomain.Account {
+				assert.NoError(t, account.Deposit(500, moneyVO.JPY))
+				return account
+			},
+			wantBalance: 1500,
+			wantVersion: 2,
+		},
+		{
+			caseName: "Negative: 他のリクエストが先に更新している場合は ErrConcurrentModification を返す",
+			modify: func(t *testing.T, account *accountDomain.Account) *accountDomain.Account {
+				stale, err := accountDomain.Reconstruct(
+					account.IDString(), account.UserIDString(), account.Name(), account.PasswordHash(),
+					moneyVO.JPY, 2000, 0, account.UpdatedAt(), account.Version()-1,
+				)
+				assert.NoError(t, err)
+				return stale
+			},
+			wantErr:     accountDomain.ErrConcurrentModification,
+			wantBalance: 1000,
+			wantVersion: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			repo, ctx, db := PrepareTestRepository(t, postgresRepository.NewAccountRepository)
+			_, accounts := saveUserAndAccounts(t, ctx, db, "sato@example.com", "For work")
+
+			err := repo.Save(ctx, tt.modify(t, accounts[0]))
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			found, err := repo.FindByID(ctx, accounts[0].ID())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBalance, found.Balance().Amount())
+			assert.Equal(t, tt.wantVersion, found.Version())
+		})
+	}
+}
+
+func TestAccountRepository_Find(t *testing.T) {
+	repo, ctx, db := PrepareTestRepository(t, postgresRepository.NewAccountRepository)
+	user, accounts := saveUserAndAccounts(t, ctx, db, "sato@example.com", "For work", "For savings")
+
+	t.Run("Positive: IDで口座を取得できる", func(t *testing.T) {
+		found, err := repo.FindByID(ctx, accounts[0].ID())
+		assert.NoError(t, err)
+		assert.Equal(t, accounts[0].IDString(), found.IDString())
+		assert.Equal(t, accounts[0].Name(), found.Name())
+		assert.Equal(t, int64(1000), found.Balance().Amount())
+		assert.Equal(t, moneyVO.JPY, found.Balance().Currency())
+		assert.True(t, accounts[0].UpdatedAt().Equal(found.UpdatedAt()))
+	})
+
+	t.Run("Positive: ユーザーの口座をIDの順に取得できる", func(t *testing.T) {
+		found, err := repo.ListByUserID(ctx, user.ID())
+		assert.NoError(t, err)
+		assert.Len(t, found, 2)
+		assert.Less(t, found[0].IDString(), found[1].IDString())
+
+		count, err := repo.CountByUserID(ctx, user.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("Positive: 口座が見つからない場合、nilを返す", func(t *testing.T) {
+		found, err := repo.FindByID(ctx, idVO.NewAccountIDForTest("unknown"))
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	})
+}
+
+func TestAccountRepository_Delete(t *testing.T) {
+	repo, ctx, db := PrepareTestRepository(t, postgresRepository.NewAccountRepository)
+	user, accounts := saveUserAndAccounts(t, ctx, db, "sato@example.com", "For work", "For savings")
+
+	t.Run("Negative: 他のリクエストが先に更新している場合は ErrConcurrentModification を返す", func(t *testing.T) {
+		stale, err := accountDomain.Reconstruct(
+			accounts[0].IDString(), accounts[0].UserIDString(), accounts[0].Name(), accounts[0].PasswordHash(),
+			moneyVO.JPY, 0, 0, accounts[0].UpdatedAt(), accounts[0].Version()-1,
+		)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, repo.Delete(ctx, stale), accountDomain.ErrConcurrentModification)
+	})
+
+	t.Run("Positive: 論理削除した口座は取得できない", func(t *testing.T) {
+		assert.NoError(t, repo.Delete(ctx, accounts[0]))
+
+		found, err := repo.FindByID(ctx, accounts[0].ID())
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+
+		count, err := repo.CountByUserID(ctx, user.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
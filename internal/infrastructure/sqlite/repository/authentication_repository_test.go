@@ -0,0 +1,47 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	authDomain "github.com/u104rak1/pocgo/internal/domain/authentication"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	postgresRepository "github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+)
+
+func TestAuthenticationRepository(t *testing.T) {
+	repo, ctx, db := PrepareTestRepository(t, postgresRepository.NewAuthenticationRepository)
+	user, _ := saveUserAndAccounts(t, ctx, db, "sato@example.com")
+
+	t.Run("Positive: 認証情報を保存し、同じユーザーの場合は更新する", func(t *testing.T) {
+		for _, password := range []string{"password", "new-password"} {
+			authentication, err := authDomain.New(user.ID(), password)
+			assert.NoError(t, err)
+			assert.NoError(t, repo.Save(ctx, authentication))
+
+			found, err := repo.FindByUserID(ctx, user.ID())
+			assert.NoError(t, err)
+			assert.Equal(t, authentication.PasswordHash(), found.PasswordHash())
+		}
+
+		exists, err := repo.ExistsByUserID(ctx, user.ID())
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("Positive: 認証情報が見つからない場合、nilを返す", func(t *testing.T) {
+		found, err := repo.FindByUserID(ctx, idVO.NewUserIDForTest("unknown"))
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+
+		exists, err := repo.ExistsByUserID(ctx, idVO.NewUserIDForTest("unknown"))
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Negative: 存在しないユーザーの認証情報は外部キー制約により保存できない", func(t *testing.T) {
+		authentication, err := authDomain.New(idVO.NewUserIDForTest("unknown"), "password")
+		assert.NoError(t, err)
+		assert.Error(t, repo.Save(ctx, authentication))
+	})
+}
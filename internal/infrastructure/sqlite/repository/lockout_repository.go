@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+// PostgreSQL ではロールバックされても失敗回数を残す為にトランザクション外の接続で書き込みますが、
+// SQLite は接続を1つに制限しており、トランザクション外で書き込むとトランザクションの終了を待ち続ける為、ExecDB で読み書きします。
+// トランザクション内の書き込みはロールバックされた場合に備えて登録しておき、ロールバック後に同じ書き込みをやり直す為、
+// トランザクション内で照合に失敗した場合も失敗回数は残ります。
+type lockoutRepository struct {
+	*Repository[model.Lockout]
+}
+
+func NewLockoutRepository(db *bun.DB) lockoutDomain.ILockoutRepository {
+	return &lockoutRepository{Repository: NewRepository[model.Lockout](db)}
+}
+
+func (r *lockoutRepository) Find(ctx context.Context, subject lockoutDomain.Subject, subjectID string) (*lockoutDomain.Lockout, error) {
+	lockoutModel := &model.Lockout{}
+	if err := r.ExecDB(ctx).NewSelect().Model(lockoutModel).
+		Where("subject = ?", string(subject)).
+		Where("subject_id = ?", subjectID).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toDomain(lockoutModel)
+}
+
+func (r *lockoutRepository) IncrementFailedCount(ctx context.Context, subject lockoutDomain.Subject, subjectID string, now time.Time) (*lockoutDomain.Lockout, error) {
+	var lockoutModel *model.Lockout
+	if err := r.write(ctx, func(ctx context.Context, db bun.IDB) error {
+		lockoutModel = &model.Lockout{
+			Subject:     string(subject),
+			SubjectID:   subjectID,
+			FailedCount: 1,
+			UpdatedAt:   now,
+		}
+		_, err := db.NewInsert().Model(lockoutModel).
+			On("CONFLICT (subject, subject_id) DO UPDATE").
+			Set("failed_count = lockout.failed_count + 1").
+			Set("updated_at = EXCLUDED.updated_at").
+			Returning("*").
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return r.toDomain(lockoutModel)
+}
+
+// SQLite には GREATEST が無く、複数の引数を取る MAX は NULL を含むと NULL を返す為、初めてロックする場合は COALESCE で期限を補います。
+// 日時は同じ形式の文字列で保存される為、文字列の比較で遅い方の期限を選べます。
+func (r *lockoutRepository) SaveLockedUntil(ctx context.Context, lockout *lockoutDomain.Lockout) error {
+	return r.write(ctx, func(ctx context.Context, db bun.IDB) error {
+		_, err := db.NewUpdate().Model((*model.Lockout)(nil)).
+			Set("locked_until = MAX(COALESCE(locked_until, ?0), ?0)", lockout.LockedUntil()).
+			Set("updated_at = ?", lockout.UpdatedAt()).
+			Where("subject = ?", lockout.SubjectString()).
+			Where("subject_id = ?", lockout.SubjectID()).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (r *lockoutRepository) Delete(ctx context.Context, subject lockoutDomain.Subject, subjectID string) error {
+	return r.write(ctx, func(ctx context.Context, db bun.IDB) error {
+		_, err := db.NewDelete().Model((*model.Lockout)(nil)).
+			Where("subject = ?", string(subject)).
+			Where("subject_id = ?", subjectID).
+			Exec(ctx)
+		return err
+	})
+}
+
+// ExecDB で書き込み、トランザクション内の場合はロールバック後にトランザクション外の接続で同じ書き込みをやり直すよう登録します。
+func (r *lockoutRepository) write(ctx context.Context, exec func(ctx context.Context, db bun.IDB) error) error {
+	if err := exec(ctx, r.ExecDB(ctx)); err != nil {
+		return err
+	}
+	registerAfterRollback(ctx, func(ctx context.Context) error {
+		return exec(ctx, r.db)
+	})
+	return nil
+}
+
+func (r *lockoutRepository) toDomain(lockoutModel *model.Lockout) (*lockoutDomain.Lockout, error) {
+	return lockoutDomain.Reconstruct(
+		lockoutModel.Subject,
+		lockoutModel.SubjectID,
+		lockoutModel.FailedCount,
+		lockoutModel.LockedUntil,
+		lockoutModel.UpdatedAt,
+	)
+}
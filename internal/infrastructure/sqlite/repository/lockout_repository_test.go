@@ -0,0 +1,104 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	postgresRepository "github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/internal/infrastructure/sqlite/repository"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestLockoutRepository(t *testing.T) {
+	repo, ctx, _ := PrepareTestRepository(t, repository.NewLockoutRepository)
+	now := timer.GetFixedDate()
+
+	t.Run("Positive: 失敗回数を加算できる", func(t *testing.T) {
+		for i := 1; i <= 2; i++ {
+			lockout, err := repo.IncrementFailedCount(ctx, lockoutDomain.SubjectUser, "user", now)
+			assert.NoError(t, err)
+			assert.Equal(t, i, lockout.FailedCount())
+		}
+	})
+
+	t.Run("Positive: ロックの期限は遅い方の期限を保持する", func(t *testing.T) {
+		for _, tt := range []struct {
+			lockedUntil time.Time
+			want        time.Time
+		}{
+			{lockedUntil: now.Add(time.Hour), want: now.Add(time.Hour)},
+			{lockedUntil: now.Add(time.Minute), want: now.Add(time.Hour)},
+			{lockedUntil: now.Add(2 * time.Hour), want: now.Add(2 * time.Hour)},
+		} {
+			lockout, err := lockoutDomain.Reconstruct(string(lockoutDomain.SubjectUser), "user", 2, &tt.lockedUntil, now)
+			assert.NoError(t, err)
+			assert.NoError(t, repo.SaveLockedUntil(ctx, lockout))
+
+			found, err := repo.Find(ctx, lockoutDomain.SubjectUser, "user")
+			assert.NoError(t, err)
+			assert.True(t, tt.want.Equal(*found.LockedUntil()))
+		}
+	})
+
+	t.Run("Positive: 削除した場合は見つからない", func(t *testing.T) {
+		assert.NoError(t, repo.Delete(ctx, lockoutDomain.SubjectUser, "user"))
+
+		found, err := repo.Find(ctx, lockoutDomain.SubjectUser, "user")
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	})
+}
+
+func TestLockoutRepositoryInTx(t *testing.T) {
+	uow, ctx, db := PrepareTestRepository(t, repository.NewUnitOfWork)
+	repo := repository.NewLockoutRepository(db)
+	now := timer.GetFixedDate()
+
+	t.Run("Positive: ロールバックされても失敗回数は残る", func(t *testing.T) {
+		err := uow.RunInTx(ctx, func(ctx context.Context) error {
+			if _, err := repo.IncrementFailedCount(ctx, lockoutDomain.SubjectUser, "rollback", now); err != nil {
+				return err
+			}
+			return accountDomain.ErrUnmatchedPassword
+		})
+		assert.ErrorIs(t, err, accountDomain.ErrUnmatchedPassword)
+
+		found, err := repo.Find(ctx, lockoutDomain.SubjectUser, "rollback")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, found.FailedCount())
+	})
+
+	t.Run("Positive: コミットした場合は失敗回数を重複して加算しない", func(t *testing.T) {
+		err := uow.RunInTx(ctx, func(ctx context.Context) error {
+			_, err := repo.IncrementFailedCount(ctx, lockoutDomain.SubjectUser, "commit", now)
+			return err
+		})
+		assert.NoError(t, err)
+
+		found, err := repo.Find(ctx, lockoutDomain.SubjectUser, "commit")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, found.FailedCount())
+	})
+
+	t.Run("Positive: トランザクション内で口座のパスワードの照合に失敗し続けるとロックされる", func(t *testing.T) {
+		_, accounts := saveUserAndAccounts(t, ctx, db, "lockout@example.com", "For work")
+		lockoutService := lockoutDomain.NewService(repo, timer.Now)
+		accountService := accountDomain.NewService(postgresRepository.NewAccountRepository(db), lockoutService, nil)
+		wrongPassword := "9999"
+
+		for i := 0; i < lockoutDomain.MaxFailedAttempts; i++ {
+			err := uow.RunInTx(ctx, func(ctx context.Context) error {
+				_, err := accountService.GetAndAuthorize(ctx, accounts[0].ID(), nil, &wrongPassword)
+				return err
+			})
+			assert.ErrorIs(t, err, accountDomain.ErrUnmatchedPassword)
+		}
+
+		err := lockoutService.Check(ctx, lockoutDomain.SubjectAccount, accounts[0].IDString())
+		assert.ErrorIs(t, err, lockoutDomain.ErrLocked)
+	})
+}
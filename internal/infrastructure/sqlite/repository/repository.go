@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/uptrace/bun"
+)
+
+// 一意制約違反を表す SQLite の拡張エラーコード (SQLITE_CONSTRAINT_UNIQUE) です。
+const constraintUniqueCode = 2067
+
+type Repository[T any] struct {
+	db *bun.DB
+}
+
+func NewRepository[T any](db *bun.DB) *Repository[T] {
+	return &Repository[T]{db: db}
+}
+
+func (r *Repository[T]) ExecDB(ctx context.Context) bun.IDB {
+	tx := getTx(ctx)
+	if tx != nil {
+		return tx
+	}
+	return r.db
+}
+
+// 指定された列の一意制約に違反した為のエラーかどうかを判定します。
+// SQLite のエラーは制約の名前を持たず、メッセージに違反した列を "テーブル名.列名" の形式で含む為、列で判定します。
+// sqlite.Error はエラーコードを返すエラーとして判定する為、ドライバーに依存せずにテストできます。
+func isUniqueViolation(err error, column string) bool {
+	var sqliteErr interface{ Code() int }
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.Code() == constraintUniqueCode && strings.Contains(err.Error(), column)
+}
+
+// 通貨コードに対応する通貨マスタのIDを取得します。通貨マスタに存在しない場合は ErrUnsupportedCurrency を返します。
+func findCurrencyID(ctx context.Context, db bun.IDB, code string) (string, error) {
+	var currencyID string
+	err := db.NewSelect().
+		Model((*model.CurrencyMaster)(nil)).
+		Column("id").
+		Where("code = ?", code).
+		Scan(ctx, &currencyID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", moneyVO.ErrUnsupportedCurrency
+		}
+		return "", err
+	}
+	return currencyID, nil
+}
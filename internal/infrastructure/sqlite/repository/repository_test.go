@@ -0,0 +1,77 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	postgresRepository "github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/seed"
+	"github.com/u104rak1/pocgo/internal/infrastructure/sqlite/repository"
+	"github.com/uptrace/bun"
+)
+
+type testStruct struct {
+	ID   int
+	Name string
+}
+
+func TestExecDB(t *testing.T) {
+	repo, ctx, bunDB := PrepareTestRepository(t, func(db *bun.DB) *repository.Repository[testStruct] {
+		return repository.NewRepository[testStruct](db)
+	})
+
+	t.Run("トランザクションなしの場合", func(t *testing.T) {
+		execDB := repo.ExecDB(ctx)
+		assert.Equal(t, bunDB, execDB)
+	})
+
+	t.Run("トランザクションありの場合", func(t *testing.T) {
+		tx, err := bunDB.BeginTx(ctx, nil)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, tx.Rollback())
+		}()
+
+		txCtx := context.WithValue(ctx, config.CtxTransactionKey(), tx)
+		execDB := repo.ExecDB(txCtx)
+		assert.Equal(t, tx, execDB)
+	})
+}
+
+// テスト用のリポジトリを作成するためのヘルパー関数です。
+// SQL の組み立てではなく SQLite での実行結果を確認する為、マイグレーションを適用したインメモリのデータベースを使用します。
+func PrepareTestRepository[T any](t *testing.T, newRepo func(db *bun.DB) T) (T, context.Context, *bun.DB) {
+	ctx := context.Background()
+	db, err := config.OpenSQLite(":memory:")
+	assert.NoError(t, err)
+	seed.InsertMasterData(db)
+
+	repo := newRepo(db)
+
+	t.Cleanup(func() {
+		assert.NoError(t, db.Close())
+	})
+
+	return repo, ctx, db
+}
+
+// 外部キーの参照先となるユーザーと口座を保存します。
+func saveUserAndAccounts(t *testing.T, ctx context.Context, db *bun.DB, email string, accountNames ...string) (*userDomain.User, []*accountDomain.Account) {
+	user, err := userDomain.New("sato taro", email)
+	assert.NoError(t, err)
+	assert.NoError(t, repository.NewUserRepository(db).Save(ctx, user))
+
+	accounts := make([]*accountDomain.Account, 0, len(accountNames))
+	for _, name := range accountNames {
+		account, err := accountDomain.New(user.ID(), 1000, name, "1234", moneyVO.JPY)
+		assert.NoError(t, err)
+		assert.NoError(t, postgresRepository.NewAccountRepository(db).Save(ctx, account))
+		accounts = append(accounts, account)
+	}
+	return user, accounts
+}
@@ -0,0 +1,182 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/infrastructure/sqlite/repository"
+	"github.com/u104rak1/pocgo/pkg/timer"
+	"github.com/uptrace/bun"
+)
+
+// 送金元の口座から受取口座への振込を、メモと参照情報と分類を付けて保存します。
+func saveTransfer(t *testing.T, ctx context.Context, repo transactionDomain.ITransactionRepository, sender, receiver *accountDomain.Account, memo, reference string, transactionAt time.Time) *transactionDomain.Transaction {
+	amount, currency, receiverID := int64(100), moneyVO.JPY, receiver.IDString()
+	transaction, err := transactionDomain.Reconstruct(
+		idVO.NewTransactionID().String(), sender.IDString(), &receiverID, transactionDomain.Transfer,
+		amount, currency, &amount, &currency, nil, nil, nil, nil, nil, &memo, &reference,
+		map[string][]string{sender.IDString(): {"rent"}}, transactionAt,
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, transaction))
+	assert.NoError(t, repo.SaveCategories(ctx, transaction, sender.ID()))
+	return transaction
+}
+
+func prepareTransactionRepository(t *testing.T) (transactionDomain.ITransactionRepository, context.Context, *bun.DB, []*accountDomain.Account) {
+	repo, ctx, db := PrepareTestRepository(t, repository.NewTransactionRepository)
+	_, accounts := saveUserAndAccounts(t, ctx, db, "sato@example.com", "For work", "For savings")
+	return repo, ctx, db, accounts
+}
+
+func TestTransactionRepository_FindByID(t *testing.T) {
+	repo, ctx, _, accounts := prepareTransactionRepository(t)
+	sender, receiver := accounts[0], accounts[1]
+	transaction := saveTransfer(t, ctx, repo, sender, receiver, "June rent", "INV-001", timer.GetFixedDate())
+
+	t.Run("Positive: 保存した取引を分類と共に取得できる", func(t *testing.T) {
+		found, err := repo.FindByID(ctx, transaction.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, transaction.IDString(), found.IDString())
+		assert.Equal(t, transaction.ReceiverAccountIDString(), found.ReceiverAccountIDString())
+		assert.Equal(t, transaction.Memo(), found.Memo())
+		assert.Equal(t, transaction.Reference(), found.Reference())
+		assert.Equal(t, []string{"rent"}, found.CategoriesFor(sender.ID()))
+		assert.True(t, transaction.TransactionAt().Equal(found.TransactionAt()))
+	})
+
+	t.Run("Positive: 取引が見つからない場合、nilを返す", func(t *testing.T) {
+		found, err := repo.FindByID(ctx, idVO.NewTransactionIDForTest("unknown"))
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+
+		reversal, err := repo.FindReversalOf(ctx, transaction.ID())
+		assert.NoError(t, err)
+		assert.Nil(t, reversal)
+	})
+}
+
+func TestTransactionRepository_ListByAccountID(t *testing.T) {
+	repo, ctx, _, accounts := prepareTransactionRepository(t)
+	sender, receiver := accounts[0], accounts[1]
+	base := timer.GetFixedDate()
+	rent := saveTransfer(t, ctx, repo, sender, receiver, "June rent", "INV-001", base)
+	utilities := saveTransfer(t, ctx, repo, sender, receiver, "Water bill", "INV_002", base.Add(time.Hour))
+	bonus := saveTransfer(t, ctx, repo, sender, receiver, "Rent bonus", "GIFT", base.Add(2*time.Hour))
+
+	sortAsc := transactionDomain.SortAsc
+	limit := 2
+	before := base.Add(time.Hour)
+	query := func(q string) *string { return &q }
+
+	tests := []struct {
+		caseName string
+		params   transactionDomain.ListTransactionsParams
+		want     []*transactionDomain.Transaction
+	}{
+		{
+			caseName: "Positive: 取引日時の昇順に取得できる",
+			params:   transactionDomain.ListTransactionsParams{AccountID: sender.ID(), Sort: &sortAsc},
+			want:     []*transactionDomain.Transaction{rent, utilities, bonus},
+		},
+		{
+			caseName: "Positive: Before を指定すると指定日時より前の取引のみ取得できる",
+			params:   transactionDomain.ListTransactionsParams{AccountID: sender.ID(), Sort: &sortAsc, Before: &before},
+			want:     []*transactionDomain.Transaction{rent},
+		},
+		{
+			caseName: "Positive: 全ての検索語を大文字と小文字を区別せずに含む取引に一致する",
+			params:   transactionDomain.ListTransactionsParams{AccountID: sender.ID(), Sort: &sortAsc, Query: query("RENT inv")},
+			want:     []*transactionDomain.Transaction{rent},
+		},
+		{
+			caseName: "Positive: 検索語のワイルドカードはそのままの文字として扱う",
+			params:   transactionDomain.ListTransactionsParams{AccountID: sender.ID(), Sort: &sortAsc, Query: query("INV_")},
+			want:     []*transactionDomain.Transaction{utilities},
+		},
+		{
+			caseName: "Positive: 受け取った振込の場合はメモを検索の対象にしない",
+			params:   transactionDomain.ListTransactionsParams{AccountID: receiver.ID(), Sort: &sortAsc, Query: query("rent")},
+			want:     []*transactionDomain.Transaction{},
+		},
+		{
+			caseName: "Positive: カーソルより後の取引を取得できる",
+			params: transactionDomain.ListTransactionsParams{
+				AccountID: sender.ID(), Sort: &sortAsc, Limit: &limit,
+				Cursor: &transactionDomain.Cursor{TransactionAt: rent.TransactionAt(), ID: rent.ID()},
+			},
+			want: []*transactionDomain.Transaction{utilities, bonus},
+		},
+		{
+			caseName: "Positive: カーソルより前の取引を取得できる",
+			params: transactionDomain.ListTransactionsParams{
+				AccountID: sender.ID(), Sort: &sortAsc, Limit: &limit,
+				Cursor: &transactionDomain.Cursor{TransactionAt: bonus.TransactionAt(), ID: bonus.ID(), Backward: true},
+			},
+			want: []*transactionDomain.Transaction{rent, utilities},
+		},
+		{
+			caseName: "Positive: 口座が付けた分類で絞り込める",
+			params:   transactionDomain.ListTransactionsParams{AccountID: receiver.ID(), Sort: &sortAsc, Categories: []string{"rent"}},
+			want:     []*transactionDomain.Transaction{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			found, err := repo.ListByAccountID(ctx, tt.params)
+			assert.NoError(t, err)
+			foundIDs := make([]string, len(found))
+			for i, transaction := range found {
+				foundIDs[i] = transaction.IDString()
+			}
+			wantIDs := make([]string, len(tt.want))
+			for i, transaction := range tt.want {
+				wantIDs[i] = transaction.IDString()
+			}
+			assert.Equal(t, wantIDs, foundIDs)
+
+			if tt.params.Cursor == nil {
+				count, err := repo.CountByAccountID(ctx, tt.params)
+				assert.NoError(t, err)
+				assert.Equal(t, len(tt.want), count)
+			}
+		})
+	}
+}
+
+func TestTransactionRepository_SumAmount(t *testing.T) {
+	repo, ctx, _, accounts := prepareTransactionRepository(t)
+	sender, receiver := accounts[0], accounts[1]
+	base := timer.GetFixedDate()
+	saveTransfer(t, ctx, repo, sender, receiver, "June rent", "INV-001", base)
+	reversed := saveTransfer(t, ctx, repo, sender, receiver, "Water bill", "INV-002", base.Add(time.Hour))
+
+	reversedID, reversedOperationType := reversed.IDString(), transactionDomain.Transfer
+	amount, currency, receiverID := int64(100), moneyVO.JPY, receiver.IDString()
+	reversal, err := transactionDomain.Reconstruct(
+		idVO.NewTransactionID().String(), sender.IDString(), &receiverID, transactionDomain.Reversal,
+		amount, currency, &amount, &currency, nil, &reversedID, &reversedOperationType, nil, nil, nil, nil,
+		nil, base.Add(2*time.Hour),
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, reversal))
+
+	t.Run("Positive: 取り消された取引を除いて合計する", func(t *testing.T) {
+		total, err := repo.SumAmount(ctx, sender.ID(), transactionDomain.Transfer, base)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), total)
+	})
+
+	t.Run("Positive: 取引を取り消した取引を取得できる", func(t *testing.T) {
+		found, err := repo.FindReversalOf(ctx, reversed.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, reversal.IDString(), found.IDString())
+	})
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	"github.com/u104rak1/pocgo/internal/config"
+	"github.com/uptrace/bun"
+)
+
+// SQLite への接続は1つに制限している為、トランザクション内で新たにトランザクションを開始すると接続が解放されるのを待ち続けます。
+// 既にトランザクション内の場合は、新たに開始せずにそのトランザクションに参加します。
+type unitOfWork struct {
+	db *bun.DB
+}
+
+func NewUnitOfWork(db *bun.DB) unitofwork.IUnitOfWork {
+	return &unitOfWork{
+		db: db,
+	}
+}
+
+func (u *unitOfWork) RunInTx(ctx context.Context, f func(ctx context.Context) error) error {
+	if getTx(ctx) != nil {
+		return f(ctx)
+	}
+
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	hooks := &afterRollback{}
+	ctxWithTx := context.WithValue(setTx(ctx, tx), afterRollbackKey{}, hooks)
+
+	err = f(ctxWithTx)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return rollbackErr
+		}
+		if hookErr := hooks.run(ctx); hookErr != nil {
+			return hookErr
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type unitOfWorkWithResult[T any] struct {
+	db *bun.DB
+}
+
+func NewUnitOfWorkWithResult[T any](db *bun.DB) unitofwork.IUnitOfWorkWithResult[T] {
+	return &unitOfWorkWithResult[T]{
+		db: db,
+	}
+}
+
+func (u *unitOfWorkWithResult[T]) RunInTx(ctx context.Context, f func(ctx context.Context) (*T, error)) (*T, error) {
+	if getTx(ctx) != nil {
+		return f(ctx)
+	}
+
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := &afterRollback{}
+	ctxWithTx := context.WithValue(setTx(ctx, tx), afterRollbackKey{}, hooks)
+
+	result, err := f(ctxWithTx)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return nil, rollbackErr
+		}
+		if hookErr := hooks.run(ctx); hookErr != nil {
+			return nil, hookErr
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	return result, err
+}
+
+// PostgreSQL のリポジトリと同じキーでトランザクションを保持し、SQLite でもそのまま使用できるリポジトリはトランザクションを共有します。
+func setTx(ctx context.Context, tx bun.Tx) context.Context {
+	return context.WithValue(ctx, config.CtxTransactionKey(), tx)
+}
+
+func getTx(ctx context.Context) bun.IDB {
+	tx, ok := ctx.Value(config.CtxTransactionKey()).(bun.IDB)
+	if !ok {
+		return nil
+	}
+	return tx
+}
+
+type afterRollbackKey struct{}
+
+// ロールバックされても残す必要がある書き込みを、ロールバックして接続が解放された後に登録順に実行します。
+// 接続を1つに制限している為、PostgreSQL のようにトランザクション外の接続で書き込むことができない代わりに使用します。
+type afterRollback struct {
+	writes []func(ctx context.Context) error
+}
+
+func (h *afterRollback) run(ctx context.Context) error {
+	for _, write := range h.writes {
+		if err := write(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// トランザクション内の場合は、ロールバックされた時に write を実行するよう登録します。トランザクション外の場合は何もしません。
+func registerAfterRollback(ctx context.Context, write func(ctx context.Context) error) {
+	if hooks, ok := ctx.Value(afterRollbackKey{}).(*afterRollback); ok {
+		hooks.writes = append(hooks.writes, write)
+	}
+}
@@ -0,0 +1,111 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
+	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
+	"github.com/u104rak1/pocgo/internal/infrastructure/sqlite/repository"
+	"github.com/uptrace/bun"
+)
+
+func TestUnitOfWork_RunInTx(t *testing.T) {
+	errTest := errors.New("test error")
+
+	tests := []struct {
+		caseName string
+		nested   bool
+		fnErr    error
+		wantSave bool
+	}{
+		{
+			caseName: "Positive: トランザクションが正常にコミットされる",
+			wantSave: true,
+		},
+		{
+			caseName: "Positive: トランザクション内で開始すると、外側のトランザクションに参加してコミットされる",
+			nested:   true,
+			wantSave: true,
+		},
+		{
+			caseName: "Negative: エラーが発生した場合、ロールバックされる",
+			fnErr:    errTest,
+		},
+		{
+			caseName: "Negative: 内側でエラーが発生した場合、外側のトランザクションごとロールバックされる",
+			nested:   true,
+			fnErr:    errTest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			uow, ctx, db := PrepareTestRepository(t, repository.NewUnitOfWork)
+			userRepo := repository.NewUserRepository(db)
+			user, err := userDomain.New("sato taro", "sato@example.com")
+			assert.NoError(t, err)
+
+			save := func(ctx context.Context) error {
+				if err := userRepo.Save(ctx, user); err != nil {
+					return err
+				}
+				return tt.fnErr
+			}
+			err = uow.RunInTx(ctx, func(ctx context.Context) error {
+				if tt.nested {
+					return uow.RunInTx(ctx, save)
+				}
+				return save(ctx)
+			})
+
+			assert.ErrorIs(t, err, tt.fnErr)
+			found, err := userRepo.FindByID(ctx, user.ID())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSave, found != nil)
+		})
+	}
+}
+
+func TestUnitOfWorkWithResult_RunInTx(t *testing.T) {
+	uow, ctx, db := PrepareTestRepository(t, func(db *bun.DB) unitofwork.IUnitOfWorkWithResult[userDomain.User] {
+		return repository.NewUnitOfWorkWithResult[userDomain.User](db)
+	})
+	userRepo := repository.NewUserRepository(db)
+
+	t.Run("Positive: トランザクションが正常にコミットされ、結果を返す", func(t *testing.T) {
+		user, err := userDomain.New("sato taro", "sato@example.com")
+		assert.NoError(t, err)
+
+		result, err := uow.RunInTx(ctx, func(ctx context.Context) (*userDomain.User, error) {
+			return user, userRepo.Save(ctx, user)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, user, result)
+		found, err := userRepo.FindByID(ctx, user.ID())
+		assert.NoError(t, err)
+		assert.NotNil(t, found)
+	})
+
+	t.Run("Negative: エラーが発生した場合、ロールバックされる", func(t *testing.T) {
+		user, err := userDomain.New("suzuki hanako", "suzuki@example.com")
+		assert.NoError(t, err)
+		errTest := errors.New("test error")
+
+		result, err := uow.RunInTx(ctx, func(ctx context.Context) (*userDomain.User, error) {
+			if err := userRepo.Save(ctx, user); err != nil {
+				return nil, err
+			}
+			return nil, errTest
+		})
+
+		assert.ErrorIs(t, err, errTest)
+		assert.Nil(t, result)
+		found, err := userRepo.FindByID(ctx, user.ID())
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	})
+}
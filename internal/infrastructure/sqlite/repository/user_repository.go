@@ -0,0 +1,17 @@
+package repository
+
+import (
+	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	"github.com/uptrace/bun"
+)
+
+// メールアドレスの一意制約に違反した場合に、エラーのメッセージに含まれる列です。
+const userEmailColumn = "users.email"
+
+// SQLite のエラーは制約の名前を持たない為、一意制約の違反を列で判定する点のみ PostgreSQL のリポジトリと異なります。
+func NewUserRepository(db *bun.DB) userDomain.IUserRepository {
+	return repository.NewUserRepositoryWithEmailConflict(db, func(err error) bool {
+		return isUniqueViolation(err, userEmailColumn)
+	})
+}
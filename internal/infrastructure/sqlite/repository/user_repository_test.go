@@ -0,0 +1,115 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/sqlite/repository"
+)
+
+func TestUserRepository_Save(t *testing.T) {
+	tests := []struct {
+		caseName  string
+		saveUser  func(t *testing.T, existing *userDomain.User) *userDomain.User
+		wantErr   error
+		wantName  string
+		wantEmail string
+	}{
+		{
+			caseName: "Positive: ユーザー保存が成功する",
+			saveUser: func(t *testing.T, existing *userDomain.User) *userDomain.User {
+				user, err := userDomain.New("suzuki jiro", "suzuki@example.com")
+				assert.NoError(t, err)
+				return user
+			},
+			wantName:  "suzuki jiro",
+			wantEmail: "suzuki@example.com",
+		},
+		{
+			caseName: "Positive: 既に存在するユーザーの場合は更新する",
+			saveUser: func(t *testing.T, existing *userDomain.User) *userDomain.User {
+				user, err := userDomain.Reconstruct(existing.IDString(), "sato jiro", "sato.jiro@example.com")
+				assert.NoError(t, err)
+				return user
+			},
+			wantName:  "sato jiro",
+			wantEmail: "sato.jiro@example.com",
+		},
+		{
+			caseName: "Negative: メールアドレスの一意制約に違反した場合、ErrEmailAlreadyExists を返す",
+			saveUser: func(t *testing.T, existing *userDomain.User) *userDomain.User {
+				user, err := userDomain.New("suzuki jiro", existing.Email())
+				assert.NoError(t, err)
+				return user
+			},
+			wantErr: userDomain.ErrEmailAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			repo, ctx, _ := PrepareTestRepository(t, repository.NewUserRepository)
+			existing, err := userDomain.New("sato taro", "sato@example.com")
+			assert.NoError(t, err)
+			assert.NoError(t, repo.Save(ctx, existing))
+
+			user := tt.saveUser(t, existing)
+			err = repo.Save(ctx, user)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			found, err := repo.FindByID(ctx, user.ID())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, found.Name())
+			assert.Equal(t, tt.wantEmail, found.Email())
+		})
+	}
+}
+
+func TestUserRepository_Find(t *testing.T) {
+	repo, ctx, _ := PrepareTestRepository(t, repository.NewUserRepository)
+	user, err := userDomain.New("sato taro", "sato@example.com")
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, user))
+
+	t.Run("Positive: IDとメールアドレスでユーザーを取得できる", func(t *testing.T) {
+		foundByID, err := repo.FindByID(ctx, user.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, user, foundByID)
+
+		foundByEmail, err := repo.FindByEmail(ctx, user.Email())
+		assert.NoError(t, err)
+		assert.Equal(t, user, foundByEmail)
+
+		existsByID, err := repo.ExistsByID(ctx, user.ID())
+		assert.NoError(t, err)
+		assert.True(t, existsByID)
+
+		existsByEmail, err := repo.ExistsByEmail(ctx, user.Email())
+		assert.NoError(t, err)
+		assert.True(t, existsByEmail)
+	})
+
+	t.Run("Positive: ユーザーが見つからない場合、nilを返す", func(t *testing.T) {
+		foundByID, err := repo.FindByID(ctx, idVO.NewUserIDForTest("unknown"))
+		assert.NoError(t, err)
+		assert.Nil(t, foundByID)
+
+		foundByEmail, err := repo.FindByEmail(ctx, "unknown@example.com")
+		assert.NoError(t, err)
+		assert.Nil(t, foundByEmail)
+
+		existsByID, err := repo.ExistsByID(ctx, idVO.NewUserIDForTest("unknown"))
+		assert.NoError(t, err)
+		assert.False(t, existsByID)
+
+		existsByEmail, err := repo.ExistsByEmail(ctx, "unknown@example.com")
+		assert.NoError(t, err)
+		assert.False(t, existsByEmail)
+	})
+}
@@ -0,0 +1,88 @@
+package transactionlimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+)
+
+// 上限ファイルの形式です。金額は10進数表記の文字列で指定します。ファイルにない取引種別と通貨の組は上限なしになります。
+//
+//	{"limits": [{"operationType": "WITHDRAWAL", "currency": "JPY", "perTransaction": "500000", "daily": "1000000", "monthly": "5000000"}]}
+type limitFile struct {
+	Limits []struct {
+		OperationType  string `json:"operationType"`
+		Currency       string `json:"currency"`
+		PerTransaction string `json:"perTransaction"`
+		Daily          string `json:"daily"`
+		Monthly        string `json:"monthly"`
+	} `json:"limits"`
+}
+
+// JSON ファイルから既定の上限を読み込むプロバイダーです。ファイルが更新された場合は次回の取得時に読み込み直します。
+// 上限は読み込み時に検証し、不正な上限を含む場合は読み込みに失敗します。
+type fileLimitProvider struct {
+	path    string
+	mu      sync.Mutex
+	modTime time.Time
+	limits  limitTable
+}
+
+func NewFileLimitProvider(path string) (limitDomain.IDefaultLimitProvider, error) {
+	p := &fileLimitProvider{path: path}
+	if _, err := p.load(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *fileLimitProvider) Default(ctx context.Context, operationType, currency string) (*limitDomain.Limits, error) {
+	limits, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+	return limits.lookup(operationType, currency)
+}
+
+func (p *fileLimitProvider) load() (limitTable, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat transaction limit file: %w", err)
+	}
+	if p.limits != nil && info.ModTime().Equal(p.modTime) {
+		return p.limits, nil
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transaction limit file: %w", err)
+	}
+	var f limitFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse transaction limit file: %w", err)
+	}
+
+	limits := make(limitTable, len(f.Limits))
+	for _, l := range f.Limits {
+		limits[limitKey{operationType: l.OperationType, currency: l.Currency}] = limitAmounts{
+			perTransaction: l.PerTransaction,
+			daily:          l.Daily,
+			monthly:        l.Monthly,
+		}
+	}
+	if err := limits.validate(); err != nil {
+		return nil, fmt.Errorf("invalid transaction limit file: %w", err)
+	}
+
+	p.limits = limits
+	p.modTime = info.ModTime()
+	return p.limits, nil
+}
@@ -0,0 +1,84 @@
+package transactionlimit_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	transactionlimit "github.com/u104rak1/pocgo/internal/infrastructure/transaction_limit"
+)
+
+func writeLimitFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestFileLimitProvider_Default(t *testing.T) {
+	t.Run("ファイルに記載された上限を取得できること", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "limits.json")
+		writeLimitFile(t, path, `{"limits": [{"operationType": "WITHDRAWAL", "currency": "JPY", "perTransaction": "1000", "daily": "3000", "monthly": "10000"}]}`, time.Now())
+
+		provider, err := transactionlimit.NewFileLimitProvider(path)
+		assert.NoError(t, err)
+
+		limits, err := provider.Default(context.Background(), limitDomain.OperationWithdrawal, moneyVO.JPY)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1000), limits.PerTransaction().Amount())
+		assert.Equal(t, int64(3000), limits.Daily().Amount())
+		assert.Equal(t, int64(10000), limits.Monthly().Amount())
+
+		limits, err = provider.Default(context.Background(), limitDomain.OperationTransfer, moneyVO.JPY)
+		assert.NoError(t, err)
+		assert.Nil(t, limits)
+	})
+
+	t.Run("ファイルが更新された場合は読み込み直すこと", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "limits.json")
+		modTime := time.Now().Add(-time.Hour)
+		writeLimitFile(t, path, `{"limits": [{"operationType": "TRANSFER", "currency": "USD", "perTransaction": "10", "daily": "20", "monthly": "30"}]}`, modTime)
+
+		provider, err := transactionlimit.NewFileLimitProvider(path)
+		assert.NoError(t, err)
+
+		writeLimitFile(t, path, `{"limits": [{"operationType": "TRANSFER", "currency": "USD", "perTransaction": "10.50", "daily": "20", "monthly": "30"}]}`, modTime.Add(time.Minute))
+
+		limits, err := provider.Default(context.Background(), limitDomain.OperationTransfer, moneyVO.USD)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1050), limits.PerTransaction().Amount())
+	})
+
+	t.Run("上限が不正な場合はエラーを返すこと", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "limits.json")
+		writeLimitFile(t, path, `{"limits": [{"operationType": "WITHDRAWAL", "currency": "JPY", "perTransaction": "5000", "daily": "3000", "monthly": "10000"}]}`, time.Now())
+
+		provider, err := transactionlimit.NewFileLimitProvider(path)
+		assert.ErrorIs(t, err, limitDomain.ErrInconsistentLimits)
+		assert.Nil(t, provider)
+	})
+
+	t.Run("ファイルが存在しない場合はエラーを返すこと", func(t *testing.T) {
+		t.Parallel()
+		provider, err := transactionlimit.NewFileLimitProvider(filepath.Join(t.TempDir(), "missing.json"))
+		assert.Error(t, err)
+		assert.Nil(t, provider)
+	})
+
+	t.Run("ファイルが JSON として不正な場合はエラーを返すこと", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "limits.json")
+		writeLimitFile(t, path, `invalid`, time.Now())
+
+		provider, err := transactionlimit.NewFileLimitProvider(path)
+		assert.Error(t, err)
+		assert.Nil(t, provider)
+	})
+}
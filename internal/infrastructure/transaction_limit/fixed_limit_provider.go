@@ -0,0 +1,28 @@
+package transactionlimit
+
+import (
+	"context"
+
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+// 既定の上限のテーブルです。上限のファイルを指定しない環境で使用します。
+var defaultLimits = limitTable{
+	{operationType: limitDomain.OperationWithdrawal, currency: moneyVO.JPY}: {perTransaction: "500000", daily: "1000000", monthly: "5000000"},
+	{operationType: limitDomain.OperationTransfer, currency: moneyVO.JPY}:   {perTransaction: "1000000", daily: "2000000", monthly: "10000000"},
+	{operationType: limitDomain.OperationWithdrawal, currency: moneyVO.USD}: {perTransaction: "5000", daily: "10000", monthly: "50000"},
+	{operationType: limitDomain.OperationTransfer, currency: moneyVO.USD}:   {perTransaction: "10000", daily: "20000", monthly: "100000"},
+}
+
+type fixedLimitProvider struct {
+	limits limitTable
+}
+
+func NewFixedLimitProvider() limitDomain.IDefaultLimitProvider {
+	return &fixedLimitProvider{limits: defaultLimits}
+}
+
+func (p *fixedLimitProvider) Default(ctx context.Context, operationType, currency string) (*limitDomain.Limits, error) {
+	return p.limits.lookup(operationType, currency)
+}
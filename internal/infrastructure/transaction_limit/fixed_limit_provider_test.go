@@ -0,0 +1,29 @@
+package transactionlimit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	transactionlimit "github.com/u104rak1/pocgo/internal/infrastructure/transaction_limit"
+)
+
+func TestFixedLimitProvider_Default(t *testing.T) {
+	provider := transactionlimit.NewFixedLimitProvider()
+
+	t.Run("テーブルにある取引種別と通貨の上限を取得できること", func(t *testing.T) {
+		limits, err := provider.Default(context.Background(), limitDomain.OperationWithdrawal, moneyVO.USD)
+		assert.NoError(t, err)
+		assert.Equal(t, "5000.00", limits.PerTransaction().Decimal())
+		assert.Equal(t, "10000.00", limits.Daily().Decimal())
+		assert.Equal(t, "50000.00", limits.Monthly().Decimal())
+	})
+
+	t.Run("テーブルにない通貨の場合は nil を返すこと", func(t *testing.T) {
+		limits, err := provider.Default(context.Background(), limitDomain.OperationWithdrawal, "EUR")
+		assert.NoError(t, err)
+		assert.Nil(t, limits)
+	})
+}
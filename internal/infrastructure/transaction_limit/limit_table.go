@@ -0,0 +1,53 @@
+package transactionlimit
+
+import (
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+)
+
+type limitKey struct {
+	operationType string
+	currency      string
+}
+
+// 上限の金額を "10000.00" のような10進数表記で保持します。
+type limitAmounts struct {
+	perTransaction string
+	daily          string
+	monthly        string
+}
+
+// 取引種別と通貨ごとの既定の上限を保持するテーブルです。
+type limitTable map[limitKey]limitAmounts
+
+// 取引種別と通貨の上限を返します。テーブルにない場合は nil を返します。
+func (t limitTable) lookup(operationType, currency string) (*limitDomain.Limits, error) {
+	amounts, ok := t[limitKey{operationType: operationType, currency: currency}]
+	if !ok {
+		return nil, nil
+	}
+
+	perTransaction, err := moneyVO.NewFromDecimal(amounts.perTransaction, currency)
+	if err != nil {
+		return nil, err
+	}
+	daily, err := moneyVO.NewFromDecimal(amounts.daily, currency)
+	if err != nil {
+		return nil, err
+	}
+	monthly, err := moneyVO.NewFromDecimal(amounts.monthly, currency)
+	if err != nil {
+		return nil, err
+	}
+	return limitDomain.NewLimits(operationType, perTransaction.Amount(), daily.Amount(), monthly.Amount(), currency)
+}
+
+// テーブルの全ての上限が正しいかを検証します。
+func (t limitTable) validate() error {
+	for key := range t {
+		if _, err := t.lookup(key.operationType, key.currency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package jwks
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+// JWKS をキャッシュしてよい秒数。鍵のローテーション時は、新しい鍵を検証鍵として公開してからこの時間以上経過した後に署名鍵を切り替えてください。
+const CacheMaxAge = "300"
+
+type JWKSHandler struct {
+	listPublicKeysUC authApp.IListPublicKeysUsecase
+}
+
+func NewJWKSHandler(listPublicKeysUsecase authApp.IListPublicKeysUsecase) *JWKSHandler {
+	return &JWKSHandler{
+		listPublicKeysUC: listPublicKeysUsecase,
+	}
+}
+
+type JWKSResponse struct {
+	// アクセストークンの検証に使用できる公開鍵の一覧
+	Keys []JSONWebKeyResponse `json:"keys"`
+}
+
+type JSONWebKeyResponse struct {
+	// 鍵の種類 (RSA または OKP)
+	Kty string `json:"kty" example:"OKP"`
+
+	// 鍵のID。アクセストークンのヘッダーの kid と一致する鍵で検証します。
+	Kid string `json:"kid" example:"kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k"`
+
+	// 鍵の用途
+	Use string `json:"use" example:"sig"`
+
+	// 署名アルゴリズム (RS256 または EdDSA)
+	Alg string `json:"alg" example:"EdDSA"`
+
+	// RSA 鍵のモジュラス
+	N string `json:"n,omitempty"`
+
+	// RSA 鍵の公開指数
+	E string `json:"e,omitempty"`
+
+	// 楕円曲線の名前
+	Crv string `json:"crv,omitempty" example:"Ed25519"`
+
+	// Ed25519 の公開鍵
+	X string `json:"x,omitempty" example:"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"`
+}
+
+// @Summary JWKS の取得
+// @Description アクセストークンの検証に使用できる公開鍵を JWK Set 形式で返します。鍵のローテーション中は以前の鍵も含まれます。共通鍵で署名している場合は空の一覧を返します。
+// @Tags Authentication API
+// @Produce json
+// @Success 200 {object} JWKSResponse
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /.well-known/jwks.json [get]
+func (h *JWKSHandler) Run(ctx echo.Context) error {
+	dto, err := h.listPublicKeysUC.Run(ctx.Request().Context())
+	if err != nil {
+		return response.InternalServerError(ctx, err)
+	}
+
+	keys := make([]JSONWebKeyResponse, 0, len(dto.Keys))
+	for _, k := range dto.Keys {
+		keys = append(keys, JSONWebKeyResponse{
+			Kty: k.Kty,
+			Kid: k.Kid,
+			Use: k.Use,
+			Alg: k.Alg,
+			N:   k.N,
+			E:   k.E,
+			Crv: k.Crv,
+			X:   k.X,
+		})
+	}
+
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "public, max-age="+CacheMaxAge)
+	return ctx.JSON(http.StatusOK, JWKSResponse{
+		Keys: keys,
+	})
+}
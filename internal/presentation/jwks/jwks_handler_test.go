@@ -0,0 +1,109 @@
+package jwks_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/presentation/jwks"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+func TestJWKSHandler(t *testing.T) {
+	var (
+		uri = "/.well-known/jwks.json"
+		arg = gomock.Any()
+	)
+
+	tests := []struct {
+		caseName             string
+		prepare              func(mockListPublicKeysUC *appMock.MockIListPublicKeysUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName: "Positive: 公開鍵の一覧を返す",
+			prepare: func(mockListPublicKeysUC *appMock.MockIListPublicKeysUsecase) {
+				mockListPublicKeysUC.EXPECT().Run(arg).Return(&authApp.ListPublicKeysDTO{
+					Keys: []authApp.JSONWebKey{
+						{Kty: "OKP", Kid: "kid1", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "x"},
+						{Kty: "RSA", Kid: "kid2", Use: "sig", Alg: "RS256", N: "n", E: "AQAB"},
+					},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: jwks.JWKSResponse{
+				Keys: []jwks.JSONWebKeyResponse{
+					{Kty: "OKP", Kid: "kid1", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "x"},
+					{Kty: "RSA", Kid: "kid2", Use: "sig", Alg: "RS256", N: "n", E: "AQAB"},
+				},
+			},
+		},
+		{
+			caseName: "Positive: 公開鍵がない場合は空の一覧を返す",
+			prepare: func(mockListPublicKeysUC *appMock.MockIListPublicKeysUsecase) {
+				mockListPublicKeysUC.EXPECT().Run(arg).Return(&authApp.ListPublicKeysDTO{
+					Keys: []authApp.JSONWebKey{},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: jwks.JWKSResponse{
+				Keys: []jwks.JSONWebKeyResponse{},
+			},
+		},
+		{
+			caseName: "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			prepare: func(mockListPublicKeysUC *appMock.MockIListPublicKeysUsecase) {
+				mockListPublicKeysUC.EXPECT().Run(arg).Return(nil, assert.AnError)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLInternalServerError,
+				Title:    response.TitleInternalServerError,
+				Status:   http.StatusInternalServerError,
+				Detail:   assert.AnError.Error(),
+				Instance: uri,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, uri, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			mockListPublicKeysUC := appMock.NewMockIListPublicKeysUsecase(ctrl)
+			tt.prepare(mockListPublicKeysUC)
+
+			h := jwks.NewJWKSHandler(mockListPublicKeysUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "public, max-age="+jwks.CacheMaxAge, rec.Header().Get(echo.HeaderCacheControl))
+				var body jwks.JWKSResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, tt.expectedResponseBody, body)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				assert.Equal(t, tt.expectedResponseBody, he.Message)
+			}
+		})
+	}
+}
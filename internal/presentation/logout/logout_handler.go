@@ -0,0 +1,50 @@
+package logout
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+	"github.com/u104rak1/pocgo/internal/config"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type LogoutHandler struct {
+	logoutUC authApp.ILogoutUsecase
+}
+
+func NewLogoutHandler(logoutUsecase authApp.ILogoutUsecase) *LogoutHandler {
+	return &LogoutHandler{
+		logoutUC: logoutUsecase,
+	}
+}
+
+// @Summary ログアウト
+// @Description 現在のセッションを失効させます。このセッションで発行したアクセストークンとリフレッシュトークンは全て使用できなくなります。
+// @Tags Authentication API
+// @Security BearerAuth
+// @Success 204 "No Content"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/logout [post]
+func (h *LogoutHandler) Run(ctx echo.Context) error {
+	sessionID, ok := ctx.Request().Context().Value(config.CtxSessionIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrSessionIDMissing)
+	}
+
+	err := h.logoutUC.Run(ctx.Request().Context(), authApp.LogoutCommand{
+		SessionID: sessionID,
+	})
+	if err != nil {
+		switch err {
+		case sessionDomain.ErrNotFound:
+			return response.Unauthorized(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
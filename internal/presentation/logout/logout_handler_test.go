@@ -0,0 +1,117 @@
+package logout_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/presentation/logout"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+func TestLogoutHandler(t *testing.T) {
+	var (
+		sessionID = idVO.NewSessionIDForTest("session")
+		uri       = "/api/v1/logout"
+		arg       = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxSessionIDKey(), sessionID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		setupContext         func() context.Context
+		prepare              func(mockLogoutUC *appMock.MockILogoutUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: ログアウトに成功する",
+			setupContext: happyContext,
+			prepare: func(mockLogoutUC *appMock.MockILogoutUsecase) {
+				mockLogoutUC.EXPECT().Run(arg, authApp.LogoutCommand{
+					SessionID: sessionID.String(),
+				}).Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			caseName: "Negative: コンテキストにセッションIDがない場合、Unauthorized を返す",
+			setupContext: func() context.Context {
+				return context.Background()
+			},
+			prepare:              func(mockLogoutUC *appMock.MockILogoutUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrSessionIDMissing),
+		},
+		{
+			caseName:     "Negative: セッションが見つからない場合、Unauthorized を返す",
+			setupContext: happyContext,
+			prepare: func(mockLogoutUC *appMock.MockILogoutUsecase) {
+				mockLogoutUC.EXPECT().Run(arg, arg).Return(sessionDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, sessionDomain.ErrNotFound),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			setupContext: happyContext,
+			prepare: func(mockLogoutUC *appMock.MockILogoutUsecase) {
+				mockLogoutUC.EXPECT().Run(arg, arg).Return(assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, uri, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockLogoutUC := appMock.NewMockILogoutUsecase(ctrl)
+			tt.prepare(mockLogoutUC)
+
+			h := logout.NewLogoutHandler(mockLogoutUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusNoContent {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				assert.Equal(t, tt.expectedResponseBody, he.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package accounts
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountApp "github.com/u104rak1/pocgo/internal/application/account"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ChangeAccountPasswordHandler struct {
+	changeAccountPasswordUC accountApp.IChangeAccountPasswordUsecase
+}
+
+func NewChangeAccountPasswordHandler(changeAccountPasswordUsecase accountApp.IChangeAccountPasswordUsecase) *ChangeAccountPasswordHandler {
+	return &ChangeAccountPasswordHandler{
+		changeAccountPasswordUC: changeAccountPasswordUsecase,
+	}
+}
+
+type ChangeAccountPasswordParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type ChangeAccountPasswordRequestBody struct {
+	// 現在の 4 桁のパスワード
+	CurrentPassword string `json:"currentPassword" example:"1234"`
+
+	// 新しい 4 桁のパスワード
+	NewPassword string `json:"newPassword" example:"5678"`
+}
+
+type ChangeAccountPasswordRequest struct {
+	ChangeAccountPasswordParams
+	ChangeAccountPasswordRequestBody
+}
+
+// @Summary 口座パスワードの変更
+// @Description 現在のパスワードを確認した上で、指定された口座のパスワードを変更します。
+// @Tags Account API
+// @Security BearerAuth
+// @Accept json
+// @Param account_id path string true "口座ID"
+// @Param request body ChangeAccountPasswordRequestBody true "Request Body"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 409 {object} response.ProblemDetail "Conflict"
+// @Failure 429 {object} response.ProblemDetail "Too Many Requests"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/password [put]
+func (h *ChangeAccountPasswordHandler) Run(ctx echo.Context) error {
+	req := new(ChangeAccountPasswordRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	err := h.changeAccountPasswordUC.Run(ctx.Request().Context(), accountApp.ChangeAccountPasswordCommand{
+		UserID:          userID,
+		AccountID:       req.AccountID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized,
+			accountDomain.ErrUnmatchedPassword:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		case accountDomain.ErrConcurrentModification:
+			return response.Conflict(ctx, err)
+		case lockoutDomain.ErrLocked:
+			return response.TooManyRequests(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (h *ChangeAccountPasswordHandler) validation(req *ChangeAccountPasswordRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "param.account_id",
+			Message: err.Error(),
+		})
+	}
+	if err := validation.ValidAccountPassword(req.CurrentPassword); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "body.currentPassword",
+			Message: err.Error(),
+		})
+	}
+	if err := validation.ValidAccountPassword(req.NewPassword); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "body.newPassword",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,214 @@
+package accounts_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	accountApp "github.com/u104rak1/pocgo/internal/application/account"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+func TestChangeAccountPasswordHandler(t *testing.T) {
+	var (
+		accountID       = idVO.NewAccountIDForTest("account")
+		userID          = idVO.NewUserIDForTest("user")
+		currentPassword = "1234"
+		newPassword     = "5678"
+		uri             = "/api/v1/me/accounts/" + accountID.String() + "/password"
+		arg             = gomock.Any()
+	)
+
+	happyRequestBody := accounts.ChangeAccountPasswordRequestBody{
+		CurrentPassword: currentPassword,
+		NewPassword:     newPassword,
+	}
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		requestBody          interface{}
+		setupContext         func() context.Context
+		prepare              func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: パスワードの変更に成功する",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase) {
+				mockChangeAccountPasswordUC.EXPECT().Run(arg, accountApp.ChangeAccountPasswordCommand{
+					UserID:          userID.String(),
+					AccountID:       accountID.String(),
+					CurrentPassword: currentPassword,
+					NewPassword:     newPassword,
+				}).Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			caseName:             "Negative: リクエストボディが無効なJSONの場合、Bad Request を返す",
+			requestBody:          "invalid json",
+			setupContext:         happyContext,
+			prepare:              func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase) {},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, response.ErrInvalidJSON),
+		},
+		{
+			caseName:     "Negative: バリデーションエラーが発生した場合、Bad Request を返す",
+			requestBody:  accounts.ChangeAccountPasswordRequestBody{},
+			setupContext: happyContext,
+			prepare:      func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:    "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			requestBody: happyRequestBody,
+			setupContext: func() context.Context {
+				return context.Background()
+			},
+			prepare:              func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 現在のパスワードが一致しない場合、Forbidden を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase) {
+				mockChangeAccountPasswordUC.EXPECT().Run(arg, arg).Return(accountDomain.ErrUnmatchedPassword)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnmatchedPassword),
+		},
+		{
+			caseName:     "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase) {
+				mockChangeAccountPasswordUC.EXPECT().Run(arg, arg).Return(accountDomain.ErrUnauthorized)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnauthorized),
+		},
+		{
+			caseName:     "Negative: 口座が見つからない場合、Not Found を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase) {
+				mockChangeAccountPasswordUC.EXPECT().Run(arg, arg).Return(accountDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, accountDomain.ErrNotFound),
+		},
+		{
+			caseName:     "Negative: 他のリクエストが先に口座を更新した場合、Conflict を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase) {
+				mockChangeAccountPasswordUC.EXPECT().Run(arg, arg).Return(accountDomain.ErrConcurrentModification)
+			},
+			expectedCode:         http.StatusConflict,
+			expectedResponseBody: problem(http.StatusConflict, response.TypeURLConflict, response.TitleConflict, accountDomain.ErrConcurrentModification),
+		},
+		{
+			caseName:     "Negative: 連続してパスワードの照合に失敗し口座がロックされている場合、Too Many Requests を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase) {
+				mockChangeAccountPasswordUC.EXPECT().Run(arg, arg).Return(lockoutDomain.ErrLocked)
+			},
+			expectedCode:         http.StatusTooManyRequests,
+			expectedResponseBody: problem(http.StatusTooManyRequests, response.TypeURLTooManyRequests, response.TitleTooManyRequests, lockoutDomain.ErrLocked),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockChangeAccountPasswordUC *appMock.MockIChangeAccountPasswordUsecase) {
+				mockChangeAccountPasswordUC.EXPECT().Run(arg, arg).Return(assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPut, uri, bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id")
+			ctx.SetParamValues(accountID.String())
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockChangeAccountPasswordUC := appMock.NewMockIChangeAccountPasswordUsecase(ctrl)
+			tt.prepare(mockChangeAccountPasswordUC)
+
+			h := accounts.NewChangeAccountPasswordHandler(mockChangeAccountPasswordUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusNoContent {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+				assert.Empty(t, rec.Body.Bytes())
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Len(t, resp.Errors, 2)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
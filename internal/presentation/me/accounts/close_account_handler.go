@@ -0,0 +1,89 @@
+package accounts
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountApp "github.com/u104rak1/pocgo/internal/application/account"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type CloseAccountHandler struct {
+	closeAccountUC accountApp.ICloseAccountUsecase
+}
+
+func NewCloseAccountHandler(closeAccountUsecase accountApp.ICloseAccountUsecase) *CloseAccountHandler {
+	return &CloseAccountHandler{
+		closeAccountUC: closeAccountUsecase,
+	}
+}
+
+type CloseAccountParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type CloseAccountRequest struct {
+	CloseAccountParams
+}
+
+// @Summary 口座の解約
+// @Description 指定された口座を解約します。残高が残っている口座は解約できません。
+// @Tags Account API
+// @Security BearerAuth
+// @Param account_id path string true "口座ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 409 {object} response.ProblemDetail "Conflict"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id} [delete]
+func (h *CloseAccountHandler) Run(ctx echo.Context) error {
+	req := new(CloseAccountRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	err := h.closeAccountUC.Run(ctx.Request().Context(), accountApp.CloseAccountCommand{
+		UserID:    userID,
+		AccountID: req.AccountID,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		case accountDomain.ErrNonZeroBalance,
+			accountDomain.ErrConcurrentModification:
+			return response.Conflict(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (h *CloseAccountHandler) validation(req *CloseAccountRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "param.account_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,170 @@
+package accounts_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	accountApp "github.com/u104rak1/pocgo/internal/application/account"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+func TestCloseAccountHandler(t *testing.T) {
+	var (
+		accountID = idVO.NewAccountIDForTest("account")
+		userID    = idVO.NewUserIDForTest("user")
+		arg       = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: "/api/v1/me/accounts/" + accountID.String(),
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		accountID            string
+		setupContext         func() context.Context
+		prepare              func(mockCloseAccountUC *appMock.MockICloseAccountUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 口座の解約に成功する",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockCloseAccountUC *appMock.MockICloseAccountUsecase) {
+				mockCloseAccountUC.EXPECT().Run(arg, accountApp.CloseAccountCommand{
+					UserID:    userID.String(),
+					AccountID: accountID.String(),
+				}).Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			caseName:     "Negative: 口座IDが不正な場合、Bad Request を返す",
+			accountID:    "invalid",
+			setupContext: happyContext,
+			prepare:      func(mockCloseAccountUC *appMock.MockICloseAccountUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: "/api/v1/me/accounts/invalid",
+				},
+			},
+		},
+		{
+			caseName:  "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			accountID: accountID.String(),
+			setupContext: func() context.Context {
+				return context.Background()
+			},
+			prepare:              func(mockCloseAccountUC *appMock.MockICloseAccountUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockCloseAccountUC *appMock.MockICloseAccountUsecase) {
+				mockCloseAccountUC.EXPECT().Run(arg, arg).Return(accountDomain.ErrUnauthorized)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnauthorized),
+		},
+		{
+			caseName:     "Negative: 口座が見つからない場合、Not Found を返す",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockCloseAccountUC *appMock.MockICloseAccountUsecase) {
+				mockCloseAccountUC.EXPECT().Run(arg, arg).Return(accountDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, accountDomain.ErrNotFound),
+		},
+		{
+			caseName:     "Negative: 残高が残っている場合、Conflict を返す",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockCloseAccountUC *appMock.MockICloseAccountUsecase) {
+				mockCloseAccountUC.EXPECT().Run(arg, arg).Return(accountDomain.ErrNonZeroBalance)
+			},
+			expectedCode:         http.StatusConflict,
+			expectedResponseBody: problem(http.StatusConflict, response.TypeURLConflict, response.TitleConflict, accountDomain.ErrNonZeroBalance),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockCloseAccountUC *appMock.MockICloseAccountUsecase) {
+				mockCloseAccountUC.EXPECT().Run(arg, arg).Return(assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/me/accounts/"+tt.accountID, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id")
+			ctx.SetParamValues(tt.accountID)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockCloseAccountUC := appMock.NewMockICloseAccountUsecase(ctrl)
+			tt.prepare(mockCloseAccountUC)
+
+			h := accounts.NewCloseAccountHandler(mockCloseAccountUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusNoContent {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
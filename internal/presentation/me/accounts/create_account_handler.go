@@ -1,6 +1,7 @@
 package accounts
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -29,7 +30,7 @@ type CreateAccountRequestBody struct {
 	// 4 桁のパスワード
 	Password string `json:"password" example:"1234"`
 
-	// 通貨（JPY または USD）
+	// 通貨（通貨マスタに登録されている ISO 4217 通貨コード）
 	Currency string `json:"currency" example:"JPY"`
 }
 
@@ -45,7 +46,7 @@ type CreateAccountResponse struct {
 	Name string `json:"name" example:"For work"`
 
 	// 口座残高
-	Balance float64 `json:"balance" example:"0"`
+	Balance json.Number `json:"balance" swaggertype:"number" example:"0"`
 
 	// 通貨
 	Currency string `json:"currency" example:"JPY"`
@@ -103,7 +104,7 @@ func (h *CreateAccountHandler) Run(ctx echo.Context) error {
 	return ctx.JSON(http.StatusCreated, CreateAccountResponse{
 		ID:        dto.ID,
 		Name:      dto.Name,
-		Balance:   dto.Balance,
+		Balance:   json.Number(dto.Balance),
 		Currency:  dto.Currency,
 		UpdatedAt: dto.UpdatedAt,
 	})
@@ -62,6 +62,7 @@ func TestCreateAccountHandler(t *testing.T) {
 					ID:        accountID.String(),
 					UserID:    userID.String(),
 					Name:      name,
+					Balance:   "0",
 					Currency:  currency,
 					UpdatedAt: updatedAt,
 				}, nil)
@@ -70,7 +71,7 @@ func TestCreateAccountHandler(t *testing.T) {
 			expectedResponseBody: accounts.CreateAccountResponse{
 				ID:        accountID.String(),
 				Name:      name,
-				Balance:   0,
+				Balance:   "0",
 				Currency:  currency,
 				UpdatedAt: updatedAt,
 			},
@@ -0,0 +1,193 @@
+package holds
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	holdApp "github.com/u104rak1/pocgo/internal/application/hold"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type AuthorizeHoldHandler struct {
+	authorizeHoldUC holdApp.IAuthorizeHoldUsecase
+}
+
+func NewAuthorizeHoldHandler(authorizeHoldUsecase holdApp.IAuthorizeHoldUsecase) *AuthorizeHoldHandler {
+	return &AuthorizeHoldHandler{
+		authorizeHoldUC: authorizeHoldUsecase,
+	}
+}
+
+type AuthorizeHoldParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type AuthorizeHoldRequestBody struct {
+	// 口座パスワード
+	Password string `json:"password" example:"1234"`
+
+	// 受取口座ID (指定した場合は確定時に振込、指定しない場合は確定時に出金)
+	ReceiverAccountID *string `json:"receiverAccountId" example:"01J9R8AJ1Q2YDH1X9836GS9D87"`
+
+	// 仮押さえする金額 (通貨の小数点以下の桁数まで指定可能)
+	Amount json.Number `json:"amount" swaggertype:"number" example:"1000"`
+
+	// 通貨 (口座と同じ通貨)
+	Currency string `json:"currency" example:"JPY"`
+
+	// 有効期限 (ISO8601、30日後まで指定可能、デフォルト 7日後)
+	ExpiresAt *string `json:"expiresAt" example:"2026-10-24T09:00:00Z"`
+}
+
+type AuthorizeHoldRequest struct {
+	AuthorizeHoldParams
+	AuthorizeHoldRequestBody
+}
+
+// @Summary 仮押さえの作成
+// @Description 指定された口座の利用可能な金額から仮押さえを行います。仮押さえした金額は確定または取り消すまで出金や振込に利用できません。
+// @Description 有効期限までに確定しなかった仮押さえは自動的に期限切れになり、仮押さえした金額は再び利用できるようになります。
+// @Tags Hold API
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param request body AuthorizeHoldRequestBody true "Request Body"
+// @Success 201 {object} HoldResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 409 {object} response.ProblemDetail "Conflict"
+// @Failure 422 {object} response.ProblemDetail "Unprocessable Entity"
+// @Failure 429 {object} response.ProblemDetail "Too Many Requests"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/holds [post]
+func (h *AuthorizeHoldHandler) Run(ctx echo.Context) error {
+	req := new(AuthorizeHoldRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t, err := time.Parse(time.RFC3339, *req.ExpiresAt)
+		if err != nil {
+			return response.BadRequest(ctx, err)
+		}
+		expiresAt = &t
+	}
+
+	dto, err := h.authorizeHoldUC.Run(ctx.Request().Context(), holdApp.AuthorizeHoldCommand{
+		UserID:            userID,
+		AccountID:         req.AccountID,
+		Password:          req.Password,
+		ReceiverAccountID: req.ReceiverAccountID,
+		Amount:            req.Amount.String(),
+		Currency:          req.Currency,
+		ExpiresAt:         expiresAt,
+	})
+	if err != nil {
+		switch err {
+		case moneyVO.ErrDifferentCurrencyOperation,
+			holdDomain.ErrInvalidAmount,
+			holdDomain.ErrSameAccount,
+			holdDomain.ErrInvalidExpiry:
+			return response.BadRequest(ctx, err)
+		case accountDomain.ErrUnauthorized,
+			accountDomain.ErrUnmatchedPassword:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound,
+			accountDomain.ErrReceiverNotFound:
+			return response.NotFound(ctx, err)
+		case accountDomain.ErrConcurrentModification:
+			return response.Conflict(ctx, err)
+		case moneyVO.ErrInsufficientBalance:
+			return response.UnprocessableEntity(ctx, err)
+		case lockoutDomain.ErrLocked:
+			return response.TooManyRequests(ctx, err)
+		default:
+			// 仮押さえする金額が口座の取引金額の上限を超える場合です。
+			if errors.Is(err, limitDomain.ErrLimitExceeded) {
+				return response.UnprocessableEntity(ctx, err)
+			}
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, newHoldResponse(*dto))
+}
+
+func (h *AuthorizeHoldHandler) validation(req *AuthorizeHoldRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+
+	if err := validation.ValidAccountPassword(req.Password); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "password",
+			Message: err.Error(),
+		})
+	}
+
+	if req.ReceiverAccountID != nil {
+		if err := validation.ValidULID(*req.ReceiverAccountID); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "receiverAccountId",
+				Message: err.Error(),
+			})
+		} else if req.AccountID == *req.ReceiverAccountID {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "receiverAccountId",
+				Message: "receiverAccountId must be different from account_id",
+			})
+		}
+	}
+
+	if err := validation.ValidCurrency(req.Currency); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "currency",
+			Message: err.Error(),
+		})
+	} else {
+		if err := validation.ValidAmount(req.Currency, req.Amount.String()); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "amount",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if req.ExpiresAt != nil {
+		if err := validation.ValidHoldExpiresAt(*req.ExpiresAt); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "expiresAt",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return validationErrors
+}
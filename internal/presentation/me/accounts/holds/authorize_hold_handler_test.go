@@ -0,0 +1,282 @@
+package holds_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	holdApp "github.com/u104rak1/pocgo/internal/application/hold"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/holds"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestAuthorizeHoldHandler(t *testing.T) {
+	var (
+		accountID         = idVO.NewAccountIDForTest("account")
+		receiverAccountID = idVO.NewAccountIDForTest("receiver").String()
+		userID            = idVO.NewUserIDForTest("user")
+		holdID            = idVO.NewHoldIDForTest("hold")
+		expiresAt         = "2026-10-24T09:00:00Z"
+		uri               = "/api/v1/me/accounts/" + accountID.String() + "/holds"
+		arg               = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+	validationFailed := response.ValidationProblemDetail{
+		ProblemDetail: response.ProblemDetail{
+			Type:     response.TypeURLValidationFailed,
+			Title:    response.TitleValidationFailed,
+			Status:   http.StatusBadRequest,
+			Detail:   response.DetailValidationFailed,
+			Instance: uri,
+		},
+	}
+
+	happyRequestBody := func() holds.AuthorizeHoldRequestBody {
+		return holds.AuthorizeHoldRequestBody{
+			Password:          "1234",
+			ReceiverAccountID: &receiverAccountID,
+			Amount:            json.Number("1000"),
+			Currency:          moneyVO.JPY,
+			ExpiresAt:         &expiresAt,
+		}
+	}
+	dto := &holdApp.HoldDTO{
+		ID:                holdID.String(),
+		AccountID:         accountID.String(),
+		ReceiverAccountID: &receiverAccountID,
+		Amount:            "1000",
+		Currency:          moneyVO.JPY,
+		Status:            holdDomain.StatusAuthorized,
+		ExpiresAt:         expiresAt,
+		CreatedAt:         timer.GetFixedDateString(),
+		UpdatedAt:         timer.GetFixedDateString(),
+	}
+
+	tests := []struct {
+		caseName             string
+		requestBody          interface{}
+		setupContext         func() context.Context
+		prepare              func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 仮押さえの作成に成功する",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {
+				parsed, _ := time.Parse(time.RFC3339, expiresAt)
+				mockAuthorizeHoldUC.EXPECT().Run(arg, holdApp.AuthorizeHoldCommand{
+					UserID:            userID.String(),
+					AccountID:         accountID.String(),
+					Password:          "1234",
+					ReceiverAccountID: &receiverAccountID,
+					Amount:            "1000",
+					Currency:          moneyVO.JPY,
+					ExpiresAt:         &parsed,
+				}).Return(dto, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedResponseBody: holds.HoldResponse{
+				ID:                holdID.String(),
+				AccountID:         accountID.String(),
+				ReceiverAccountID: &receiverAccountID,
+				Amount:            json.Number("1000"),
+				Currency:          moneyVO.JPY,
+				Status:            holdDomain.StatusAuthorized,
+				ExpiresAt:         expiresAt,
+				CreatedAt:         timer.GetFixedDateString(),
+				UpdatedAt:         timer.GetFixedDateString(),
+			},
+		},
+		{
+			caseName:             "Negative: リクエストボディが不正な場合、Bad Request を返す",
+			requestBody:          "invalid",
+			setupContext:         happyContext,
+			prepare:              func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, response.ErrInvalidJSON),
+		},
+		{
+			caseName: "Negative: 受取口座が自身の口座の場合、Validation Failed を返す",
+			requestBody: func() holds.AuthorizeHoldRequestBody {
+				body := happyRequestBody()
+				own := accountID.String()
+				body.ReceiverAccountID = &own
+				return body
+			}(),
+			setupContext:         happyContext,
+			prepare:              func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: validationFailed,
+		},
+		{
+			caseName: "Negative: 有効期限の形式が不正な場合、Validation Failed を返す",
+			requestBody: func() holds.AuthorizeHoldRequestBody {
+				body := happyRequestBody()
+				invalid := "20261024"
+				body.ExpiresAt = &invalid
+				return body
+			}(),
+			setupContext:         happyContext,
+			prepare:              func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: validationFailed,
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			requestBody:          happyRequestBody(),
+			setupContext:         context.Background,
+			prepare:              func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 有効期限が範囲外の場合、Bad Request を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {
+				mockAuthorizeHoldUC.EXPECT().Run(arg, arg).Return(nil, holdDomain.ErrInvalidExpiry)
+			},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, holdDomain.ErrInvalidExpiry),
+		},
+		{
+			caseName:     "Negative: パスワードが一致しない場合、Forbidden を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {
+				mockAuthorizeHoldUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnmatchedPassword),
+		},
+		{
+			caseName:     "Negative: 受取口座が見つからない場合、Not Found を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {
+				mockAuthorizeHoldUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrReceiverNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, accountDomain.ErrReceiverNotFound),
+		},
+		{
+			caseName:     "Negative: 利用可能な金額が不足している場合、Unprocessable Entity を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {
+				mockAuthorizeHoldUC.EXPECT().Run(arg, arg).Return(nil, moneyVO.ErrInsufficientBalance)
+			},
+			expectedCode:         http.StatusUnprocessableEntity,
+			expectedResponseBody: problem(http.StatusUnprocessableEntity, response.TypeURLUnprocessableEntity, response.TitleUnprocessableEntity, moneyVO.ErrInsufficientBalance),
+		},
+		{
+			caseName:     "Negative: 取引金額の上限を超える場合、Unprocessable Entity を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {
+				mockAuthorizeHoldUC.EXPECT().Run(arg, arg).Return(nil, limitDomain.ErrLimitExceeded)
+			},
+			expectedCode:         http.StatusUnprocessableEntity,
+			expectedResponseBody: problem(http.StatusUnprocessableEntity, response.TypeURLUnprocessableEntity, response.TitleUnprocessableEntity, limitDomain.ErrLimitExceeded),
+		},
+		{
+			caseName:     "Negative: 口座がロックされている場合、Too Many Requests を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {
+				mockAuthorizeHoldUC.EXPECT().Run(arg, arg).Return(nil, lockoutDomain.ErrLocked)
+			},
+			expectedCode:         http.StatusTooManyRequests,
+			expectedResponseBody: problem(http.StatusTooManyRequests, response.TypeURLTooManyRequests, response.TitleTooManyRequests, lockoutDomain.ErrLocked),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockAuthorizeHoldUC *appMock.MockIAuthorizeHoldUsecase) {
+				mockAuthorizeHoldUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id")
+			ctx.SetParamValues(accountID.String())
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockAuthorizeHoldUC := appMock.NewMockIAuthorizeHoldUsecase(ctrl)
+			tt.prepare(mockAuthorizeHoldUC)
+
+			h := holds.NewAuthorizeHoldHandler(mockAuthorizeHoldUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusCreated {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusCreated, rec.Code)
+				var resp holds.HoldResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package holds
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	holdApp "github.com/u104rak1/pocgo/internal/application/hold"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type CaptureHoldHandler struct {
+	captureHoldUC holdApp.ICaptureHoldUsecase
+}
+
+func NewCaptureHoldHandler(captureHoldUsecase holdApp.ICaptureHoldUsecase) *CaptureHoldHandler {
+	return &CaptureHoldHandler{
+		captureHoldUC: captureHoldUsecase,
+	}
+}
+
+type CaptureHoldParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+	HoldID    string `param:"hold_id" example:"01J9R8AJ1Q2YDH1X9836GS9H34"`
+}
+
+type CaptureHoldRequestBody struct {
+	// 口座パスワード
+	Password string `json:"password" example:"1234"`
+
+	// 確定する金額 (仮押さえした金額以下、指定しない場合は全額)
+	Amount *json.Number `json:"amount" swaggertype:"number" example:"800"`
+}
+
+type CaptureHoldRequest struct {
+	CaptureHoldParams
+	CaptureHoldRequestBody
+}
+
+// @Summary 仮押さえの確定
+// @Description 指定された仮押さえを確定し、確定した金額の出金または振込を行います。
+// @Description 一部の金額のみを確定した場合、残りの金額は再び利用できるようになります。
+// @Description 確定時の出金または振込には口座の取引金額の上限が適用されます。
+// @Tags Hold API
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param hold_id path string true "仮押さえID"
+// @Param request body CaptureHoldRequestBody true "Request Body"
+// @Success 200 {object} HoldResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 409 {object} response.ProblemDetail "Conflict"
+// @Failure 422 {object} response.ProblemDetail "Unprocessable Entity"
+// @Failure 429 {object} response.ProblemDetail "Too Many Requests"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/holds/{hold_id}/capture [post]
+func (h *CaptureHoldHandler) Run(ctx echo.Context) error {
+	req := new(CaptureHoldRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	var amount *string
+	if req.Amount != nil {
+		value := req.Amount.String()
+		amount = &value
+	}
+
+	dto, err := h.captureHoldUC.Run(ctx.Request().Context(), holdApp.CaptureHoldCommand{
+		UserID:    userID,
+		AccountID: req.AccountID,
+		HoldID:    req.HoldID,
+		Password:  req.Password,
+		Amount:    amount,
+	})
+	if err != nil {
+		switch err {
+		case holdDomain.ErrInvalidAmount,
+			holdDomain.ErrCaptureExceedsHold,
+			moneyVO.ErrInvalidMoney,
+			moneyVO.ErrNegativeAmount,
+			moneyVO.ErrAmountOverflow:
+			return response.BadRequest(ctx, err)
+		case accountDomain.ErrUnauthorized,
+			accountDomain.ErrUnmatchedPassword:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound,
+			accountDomain.ErrReceiverNotFound,
+			holdDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		case holdDomain.ErrNotAuthorized,
+			holdDomain.ErrExpired,
+			holdDomain.ErrConcurrentModification,
+			accountDomain.ErrConcurrentModification:
+			return response.Conflict(ctx, err)
+		case moneyVO.ErrInsufficientBalance,
+			moneyVO.ErrExchangeRateNotFound:
+			return response.UnprocessableEntity(ctx, err)
+		case lockoutDomain.ErrLocked:
+			return response.TooManyRequests(ctx, err)
+		default:
+			// 確定する金額は仮押さえの通貨で解釈する為、精度の誤りはユースケースで検出され、通貨ごとのメッセージでラップされています。
+			if errors.Is(err, moneyVO.ErrInvalidPrecision) {
+				return response.BadRequest(ctx, err)
+			}
+			// 確定時の出金または振込が口座の取引金額の上限を超える場合です。
+			if errors.Is(err, limitDomain.ErrLimitExceeded) {
+				return response.UnprocessableEntity(ctx, err)
+			}
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, newHoldResponse(*dto))
+}
+
+func (h *CaptureHoldHandler) validation(req *CaptureHoldRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+
+	if err := validation.ValidULID(req.HoldID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "hold_id",
+			Message: err.Error(),
+		})
+	}
+
+	if err := validation.ValidAccountPassword(req.Password); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "password",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,242 @@
+package holds_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	holdApp "github.com/u104rak1/pocgo/internal/application/hold"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/holds"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestCaptureHoldHandler(t *testing.T) {
+	var (
+		accountID      = idVO.NewAccountIDForTest("account")
+		userID         = idVO.NewUserIDForTest("user")
+		holdID         = idVO.NewHoldIDForTest("hold")
+		transactionID  = idVO.NewTransactionIDForTest("transaction").String()
+		capturedAmount = "800"
+		uri            = "/api/v1/me/accounts/" + accountID.String() + "/holds/" + holdID.String() + "/capture"
+		arg            = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+
+	happyRequestBody := func() holds.CaptureHoldRequestBody {
+		amount := json.Number(capturedAmount)
+		return holds.CaptureHoldRequestBody{
+			Password: "1234",
+			Amount:   &amount,
+		}
+	}
+	precisionErr := fmt.Errorf("%w: amount in JPY must not have decimal places", moneyVO.ErrInvalidPrecision)
+
+	tests := []struct {
+		caseName             string
+		requestBody          interface{}
+		setupContext         func() context.Context
+		prepare              func(mockCaptureHoldUC *appMock.MockICaptureHoldUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 仮押さえの一部の金額の確定に成功する",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCaptureHoldUC *appMock.MockICaptureHoldUsecase) {
+				mockCaptureHoldUC.EXPECT().Run(arg, holdApp.CaptureHoldCommand{
+					UserID:    userID.String(),
+					AccountID: accountID.String(),
+					HoldID:    holdID.String(),
+					Password:  "1234",
+					Amount:    &capturedAmount,
+				}).Return(&holdApp.HoldDTO{
+					ID:             holdID.String(),
+					AccountID:      accountID.String(),
+					Amount:         "1000",
+					Currency:       moneyVO.JPY,
+					Status:         holdDomain.StatusCaptured,
+					ExpiresAt:      "2021-01-08T00:00:00Z",
+					CapturedAmount: &capturedAmount,
+					TransactionID:  &transactionID,
+					CreatedAt:      timer.GetFixedDateString(),
+					UpdatedAt:      timer.GetFixedDateString(),
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: func() holds.HoldResponse {
+				captured := json.Number(capturedAmount)
+				return holds.HoldResponse{
+					ID:             holdID.String(),
+					AccountID:      accountID.String(),
+					Amount:         json.Number("1000"),
+					Currency:       moneyVO.JPY,
+					Status:         holdDomain.StatusCaptured,
+					ExpiresAt:      "2021-01-08T00:00:00Z",
+					CapturedAmount: &captured,
+					TransactionID:  &transactionID,
+					CreatedAt:      timer.GetFixedDateString(),
+					UpdatedAt:      timer.GetFixedDateString(),
+				}
+			}(),
+		},
+		{
+			caseName:     "Negative: パスワードが不正な場合、Validation Failed を返す",
+			requestBody:  holds.CaptureHoldRequestBody{Password: "abc"},
+			setupContext: happyContext,
+			prepare:      func(mockCaptureHoldUC *appMock.MockICaptureHoldUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			requestBody:          happyRequestBody(),
+			setupContext:         context.Background,
+			prepare:              func(mockCaptureHoldUC *appMock.MockICaptureHoldUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 確定する金額が仮押さえした金額を超える場合、Bad Request を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCaptureHoldUC *appMock.MockICaptureHoldUsecase) {
+				mockCaptureHoldUC.EXPECT().Run(arg, arg).Return(nil, holdDomain.ErrCaptureExceedsHold)
+			},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, holdDomain.ErrCaptureExceedsHold),
+		},
+		{
+			caseName:     "Negative: 確定する金額が通貨の精度を超える場合、Bad Request を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCaptureHoldUC *appMock.MockICaptureHoldUsecase) {
+				mockCaptureHoldUC.EXPECT().Run(arg, arg).Return(nil, precisionErr)
+			},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, precisionErr),
+		},
+		{
+			caseName:     "Negative: 仮押さえが見つからない場合、Not Found を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCaptureHoldUC *appMock.MockICaptureHoldUsecase) {
+				mockCaptureHoldUC.EXPECT().Run(arg, arg).Return(nil, holdDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, holdDomain.ErrNotFound),
+		},
+		{
+			caseName:     "Negative: 有効期限を過ぎている場合、Conflict を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCaptureHoldUC *appMock.MockICaptureHoldUsecase) {
+				mockCaptureHoldUC.EXPECT().Run(arg, arg).Return(nil, holdDomain.ErrExpired)
+			},
+			expectedCode:         http.StatusConflict,
+			expectedResponseBody: problem(http.StatusConflict, response.TypeURLConflict, response.TitleConflict, holdDomain.ErrExpired),
+		},
+		{
+			caseName:     "Negative: パスワードが一致しない場合、Forbidden を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCaptureHoldUC *appMock.MockICaptureHoldUsecase) {
+				mockCaptureHoldUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnmatchedPassword),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCaptureHoldUC *appMock.MockICaptureHoldUsecase) {
+				mockCaptureHoldUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id", "hold_id")
+			ctx.SetParamValues(accountID.String(), holdID.String())
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockCaptureHoldUC := appMock.NewMockICaptureHoldUsecase(ctrl)
+			tt.prepare(mockCaptureHoldUC)
+
+			h := holds.NewCaptureHoldHandler(mockCaptureHoldUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp holds.HoldResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package holds
+
+import (
+	"encoding/json"
+
+	holdApp "github.com/u104rak1/pocgo/internal/application/hold"
+)
+
+type HoldResponse struct {
+	// 仮押さえID
+	ID string `json:"id" example:"01J9R8AJ1Q2YDH1X9836GS9H34"`
+
+	// 口座ID
+	AccountID string `json:"accountId" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+
+	// 受取口座ID (確定時に振込を行う場合)
+	ReceiverAccountID *string `json:"receiverAccountId" example:"01J9R8AJ1Q2YDH1X9836GS9D87"`
+
+	// 仮押さえした金額
+	Amount json.Number `json:"amount" swaggertype:"number" example:"1000"`
+
+	// 通貨
+	Currency string `json:"currency" example:"JPY"`
+
+	// 状態 (AUTHORIZED, CAPTURED, VOIDED, EXPIRED)
+	Status string `json:"status" example:"AUTHORIZED"`
+
+	// 有効期限
+	ExpiresAt string `json:"expiresAt" example:"2026-10-24T09:00:00Z"`
+
+	// 確定した金額 (確定した場合)
+	CapturedAmount *json.Number `json:"capturedAmount" swaggertype:"number" example:"800"`
+
+	// 確定時に行った取引ID (確定した場合)
+	TransactionID *string `json:"transactionId" example:"01J9R8AJ1Q2YDH1X9836GS9E89"`
+
+	// 作成日時
+	CreatedAt string `json:"createdAt" example:"2026-10-17T09:00:00Z"`
+
+	// 更新日時
+	UpdatedAt string `json:"updatedAt" example:"2026-10-17T09:00:00Z"`
+}
+
+func newHoldResponse(dto holdApp.HoldDTO) HoldResponse {
+	var capturedAmount *json.Number
+	if dto.CapturedAmount != nil {
+		amount := json.Number(*dto.CapturedAmount)
+		capturedAmount = &amount
+	}
+	return HoldResponse{
+		ID:                dto.ID,
+		AccountID:         dto.AccountID,
+		ReceiverAccountID: dto.ReceiverAccountID,
+		Amount:            json.Number(dto.Amount),
+		Currency:          dto.Currency,
+		Status:            dto.Status,
+		ExpiresAt:         dto.ExpiresAt,
+		CapturedAmount:    capturedAmount,
+		TransactionID:     dto.TransactionID,
+		CreatedAt:         dto.CreatedAt,
+		UpdatedAt:         dto.UpdatedAt,
+	}
+}
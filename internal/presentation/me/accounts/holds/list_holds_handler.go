@@ -0,0 +1,113 @@
+package holds
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	holdApp "github.com/u104rak1/pocgo/internal/application/hold"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ListHoldsHandler struct {
+	listHoldsUC holdApp.IListHoldsUsecase
+}
+
+func NewListHoldsHandler(listHoldsUsecase holdApp.IListHoldsUsecase) *ListHoldsHandler {
+	return &ListHoldsHandler{
+		listHoldsUC: listHoldsUsecase,
+	}
+}
+
+type ListHoldsParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type ListHoldsQuery struct {
+	Status *string `query:"status" example:"AUTHORIZED"`
+}
+
+type ListHoldsRequest struct {
+	ListHoldsParams
+	ListHoldsQuery
+}
+
+type ListHoldsResponse struct {
+	// 仮押さえ一覧
+	Holds []HoldResponse `json:"holds"`
+}
+
+// @Summary 仮押さえ一覧の取得
+// @Description 指定された口座の仮押さえを新しい順に取得します。
+// @Tags Hold API
+// @Security BearerAuth
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param status query string false "状態 (AUTHORIZED, CAPTURED, VOIDED, EXPIRED)"
+// @Success 200 {object} ListHoldsResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/holds [get]
+func (h *ListHoldsHandler) Run(ctx echo.Context) error {
+	req := new(ListHoldsRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.listHoldsUC.Run(ctx.Request().Context(), holdApp.ListHoldsCommand{
+		UserID:    userID,
+		AccountID: req.AccountID,
+		Status:    req.Status,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	holds := make([]HoldResponse, 0, len(dto.Holds))
+	for _, hold := range dto.Holds {
+		holds = append(holds, newHoldResponse(hold))
+	}
+	return ctx.JSON(http.StatusOK, ListHoldsResponse{
+		Holds: holds,
+	})
+}
+
+func (h *ListHoldsHandler) validation(req *ListHoldsRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+
+	if req.Status != nil {
+		if err := validation.ValidHoldStatus(*req.Status); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "status",
+				Message: err.Error(),
+			})
+		}
+	}
+	return validationErrors
+}
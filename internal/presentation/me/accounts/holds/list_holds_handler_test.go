@@ -0,0 +1,183 @@
+package holds_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	holdApp "github.com/u104rak1/pocgo/internal/application/hold"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/holds"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestListHoldsHandler(t *testing.T) {
+	var (
+		accountID = idVO.NewAccountIDForTest("account")
+		userID    = idVO.NewUserIDForTest("user")
+		holdID    = idVO.NewHoldIDForTest("hold")
+		status    = holdDomain.StatusAuthorized
+		uri       = "/api/v1/me/accounts/" + accountID.String() + "/holds"
+		arg       = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		query                string
+		setupContext         func() context.Context
+		prepare              func(mockListHoldsUC *appMock.MockIListHoldsUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 状態で絞り込んだ仮押さえ一覧の取得に成功する",
+			query:        "?status=" + status,
+			setupContext: happyContext,
+			prepare: func(mockListHoldsUC *appMock.MockIListHoldsUsecase) {
+				mockListHoldsUC.EXPECT().Run(arg, holdApp.ListHoldsCommand{
+					UserID:    userID.String(),
+					AccountID: accountID.String(),
+					Status:    &status,
+				}).Return(&holdApp.ListHoldsDTO{
+					Holds: []holdApp.HoldDTO{
+						{
+							ID:        holdID.String(),
+							AccountID: accountID.String(),
+							Amount:    "1000",
+							Currency:  moneyVO.JPY,
+							Status:    holdDomain.StatusAuthorized,
+							ExpiresAt: "2021-01-08T00:00:00Z",
+							CreatedAt: timer.GetFixedDateString(),
+							UpdatedAt: timer.GetFixedDateString(),
+						},
+					},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: holds.ListHoldsResponse{
+				Holds: []holds.HoldResponse{
+					{
+						ID:        holdID.String(),
+						AccountID: accountID.String(),
+						Amount:    json.Number("1000"),
+						Currency:  moneyVO.JPY,
+						Status:    holdDomain.StatusAuthorized,
+						ExpiresAt: "2021-01-08T00:00:00Z",
+						CreatedAt: timer.GetFixedDateString(),
+						UpdatedAt: timer.GetFixedDateString(),
+					},
+				},
+			},
+		},
+		{
+			caseName:     "Negative: 状態が不正な場合、Validation Failed を返す",
+			query:        "?status=PENDING",
+			setupContext: happyContext,
+			prepare:      func(mockListHoldsUC *appMock.MockIListHoldsUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			setupContext:         context.Background,
+			prepare:              func(mockListHoldsUC *appMock.MockIListHoldsUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			setupContext: happyContext,
+			prepare: func(mockListHoldsUC *appMock.MockIListHoldsUsecase) {
+				mockListHoldsUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnauthorized),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			setupContext: happyContext,
+			prepare: func(mockListHoldsUC *appMock.MockIListHoldsUsecase) {
+				mockListHoldsUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, uri+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id")
+			ctx.SetParamValues(accountID.String())
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockListHoldsUC := appMock.NewMockIListHoldsUsecase(ctrl)
+			tt.prepare(mockListHoldsUC)
+
+			h := holds.NewListHoldsHandler(mockListHoldsUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp holds.ListHoldsResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
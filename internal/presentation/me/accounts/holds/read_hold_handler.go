@@ -0,0 +1,98 @@
+package holds
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	holdApp "github.com/u104rak1/pocgo/internal/application/hold"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ReadHoldHandler struct {
+	readHoldUC holdApp.IReadHoldUsecase
+}
+
+func NewReadHoldHandler(readHoldUsecase holdApp.IReadHoldUsecase) *ReadHoldHandler {
+	return &ReadHoldHandler{
+		readHoldUC: readHoldUsecase,
+	}
+}
+
+type ReadHoldParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+	HoldID    string `param:"hold_id" example:"01J9R8AJ1Q2YDH1X9836GS9H34"`
+}
+
+type ReadHoldRequest struct {
+	ReadHoldParams
+}
+
+// @Summary 仮押さえの取得
+// @Description 指定された仮押さえを取得します。
+// @Tags Hold API
+// @Security BearerAuth
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param hold_id path string true "仮押さえID"
+// @Success 200 {object} HoldResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/holds/{hold_id} [get]
+func (h *ReadHoldHandler) Run(ctx echo.Context) error {
+	req := new(ReadHoldRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.readHoldUC.Run(ctx.Request().Context(), holdApp.ReadHoldCommand{
+		UserID:    userID,
+		AccountID: req.AccountID,
+		HoldID:    req.HoldID,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound,
+			holdDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, newHoldResponse(*dto))
+}
+
+func (h *ReadHoldHandler) validation(req *ReadHoldRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+
+	if err := validation.ValidULID(req.HoldID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "hold_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
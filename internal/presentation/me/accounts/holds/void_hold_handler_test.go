@@ -0,0 +1,199 @@
+package holds_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	holdApp "github.com/u104rak1/pocgo/internal/application/hold"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/holds"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestVoidHoldHandler(t *testing.T) {
+	var (
+		accountID = idVO.NewAccountIDForTest("account")
+		userID    = idVO.NewUserIDForTest("user")
+		holdID    = idVO.NewHoldIDForTest("hold")
+		arg       = gomock.Any()
+	)
+
+	uri := func(holdID string) string {
+		return "/api/v1/me/accounts/" + accountID.String() + "/holds/" + holdID + "/void"
+	}
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri(holdID.String()),
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		holdID               string
+		setupContext         func() context.Context
+		prepare              func(mockVoidHoldUC *appMock.MockIVoidHoldUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 仮押さえの取り消しに成功する",
+			holdID:       holdID.String(),
+			setupContext: happyContext,
+			prepare: func(mockVoidHoldUC *appMock.MockIVoidHoldUsecase) {
+				mockVoidHoldUC.EXPECT().Run(arg, holdApp.VoidHoldCommand{
+					UserID:    userID.String(),
+					AccountID: accountID.String(),
+					HoldID:    holdID.String(),
+				}).Return(&holdApp.HoldDTO{
+					ID:        holdID.String(),
+					AccountID: accountID.String(),
+					Amount:    "1000",
+					Currency:  moneyVO.JPY,
+					Status:    holdDomain.StatusVoided,
+					ExpiresAt: "2021-01-08T00:00:00Z",
+					CreatedAt: timer.GetFixedDateString(),
+					UpdatedAt: timer.GetFixedDateString(),
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: holds.HoldResponse{
+				ID:        holdID.String(),
+				AccountID: accountID.String(),
+				Amount:    json.Number("1000"),
+				Currency:  moneyVO.JPY,
+				Status:    holdDomain.StatusVoided,
+				ExpiresAt: "2021-01-08T00:00:00Z",
+				CreatedAt: timer.GetFixedDateString(),
+				UpdatedAt: timer.GetFixedDateString(),
+			},
+		},
+		{
+			caseName:     "Negative: 仮押さえIDが不正な場合、Validation Failed を返す",
+			holdID:       "invalid",
+			setupContext: happyContext,
+			prepare:      func(mockVoidHoldUC *appMock.MockIVoidHoldUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri("invalid"),
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			holdID:               holdID.String(),
+			setupContext:         context.Background,
+			prepare:              func(mockVoidHoldUC *appMock.MockIVoidHoldUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			holdID:       holdID.String(),
+			setupContext: happyContext,
+			prepare: func(mockVoidHoldUC *appMock.MockIVoidHoldUsecase) {
+				mockVoidHoldUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnauthorized),
+		},
+		{
+			caseName:     "Negative: 仮押さえが見つからない場合、Not Found を返す",
+			holdID:       holdID.String(),
+			setupContext: happyContext,
+			prepare: func(mockVoidHoldUC *appMock.MockIVoidHoldUsecase) {
+				mockVoidHoldUC.EXPECT().Run(arg, arg).Return(nil, holdDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, holdDomain.ErrNotFound),
+		},
+		{
+			caseName:     "Negative: 既に確定されている場合、Conflict を返す",
+			holdID:       holdID.String(),
+			setupContext: happyContext,
+			prepare: func(mockVoidHoldUC *appMock.MockIVoidHoldUsecase) {
+				mockVoidHoldUC.EXPECT().Run(arg, arg).Return(nil, holdDomain.ErrNotAuthorized)
+			},
+			expectedCode:         http.StatusConflict,
+			expectedResponseBody: problem(http.StatusConflict, response.TypeURLConflict, response.TitleConflict, holdDomain.ErrNotAuthorized),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			holdID:       holdID.String(),
+			setupContext: happyContext,
+			prepare: func(mockVoidHoldUC *appMock.MockIVoidHoldUsecase) {
+				mockVoidHoldUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, uri(tt.holdID), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id", "hold_id")
+			ctx.SetParamValues(accountID.String(), tt.holdID)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockVoidHoldUC := appMock.NewMockIVoidHoldUsecase(ctrl)
+			tt.prepare(mockVoidHoldUC)
+
+			h := holds.NewVoidHoldHandler(mockVoidHoldUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp holds.HoldResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package limits
+
+import (
+	"encoding/json"
+
+	limitApp "github.com/u104rak1/pocgo/internal/application/limit"
+)
+
+type LimitsResponse struct {
+	// 1回の取引の上限
+	PerTransaction json.Number `json:"perTransaction" swaggertype:"number" example:"500000"`
+
+	// 直近24時間の合計の上限
+	Daily json.Number `json:"daily" swaggertype:"number" example:"1000000"`
+
+	// 当月の合計の上限
+	Monthly json.Number `json:"monthly" swaggertype:"number" example:"5000000"`
+}
+
+type AccountLimitResponse struct {
+	// 取引種別 (WITHDRAWAL, TRANSFER)
+	OperationType string `json:"operationType" example:"WITHDRAWAL"`
+
+	// 通貨
+	Currency string `json:"currency" example:"JPY"`
+
+	// 口座に適用される上限 (上限がない場合は null)
+	Effective *LimitsResponse `json:"effective"`
+
+	// 既定の上限 (設定されていない場合は null)
+	Default *LimitsResponse `json:"default"`
+}
+
+func newLimitsResponse(dto *limitApp.LimitsDTO) *LimitsResponse {
+	if dto == nil {
+		return nil
+	}
+	return &LimitsResponse{
+		PerTransaction: json.Number(dto.PerTransaction),
+		Daily:          json.Number(dto.Daily),
+		Monthly:        json.Number(dto.Monthly),
+	}
+}
+
+func newAccountLimitResponse(dto limitApp.AccountLimitDTO) AccountLimitResponse {
+	return AccountLimitResponse{
+		OperationType: dto.OperationType,
+		Currency:      dto.Currency,
+		Effective:     newLimitsResponse(dto.Effective),
+		Default:       newLimitsResponse(dto.Default),
+	}
+}
@@ -0,0 +1,94 @@
+package limits
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	limitApp "github.com/u104rak1/pocgo/internal/application/limit"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ListAccountLimitsHandler struct {
+	listAccountLimitsUC limitApp.IListAccountLimitsUsecase
+}
+
+func NewListAccountLimitsHandler(listAccountLimitsUsecase limitApp.IListAccountLimitsUsecase) *ListAccountLimitsHandler {
+	return &ListAccountLimitsHandler{
+		listAccountLimitsUC: listAccountLimitsUsecase,
+	}
+}
+
+type ListAccountLimitsRequest struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type ListAccountLimitsResponse struct {
+	// 取引種別ごとの上限
+	Limits []AccountLimitResponse `json:"limits"`
+}
+
+// @Summary 取引金額の上限の取得
+// @Description 出金と振込のそれぞれについて、口座に適用される取引金額の上限と既定の上限を取得します。
+// @Description 口座に上限を設定している場合は、上限ごとに既定の上限と口座の上限の小さい方が適用されます。
+// @Tags Limit API
+// @Security BearerAuth
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Success 200 {object} ListAccountLimitsResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/limits [get]
+func (h *ListAccountLimitsHandler) Run(ctx echo.Context) error {
+	req := new(ListAccountLimitsRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.listAccountLimitsUC.Run(ctx.Request().Context(), limitApp.ListAccountLimitsCommand{
+		UserID:    userID,
+		AccountID: req.AccountID,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	limits := make([]AccountLimitResponse, 0, len(dto.Limits))
+	for _, limit := range dto.Limits {
+		limits = append(limits, newAccountLimitResponse(limit))
+	}
+	return ctx.JSON(http.StatusOK, ListAccountLimitsResponse{
+		Limits: limits,
+	})
+}
+
+func (h *ListAccountLimitsHandler) validation(req *ListAccountLimitsRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,192 @@
+package limits_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	limitApp "github.com/u104rak1/pocgo/internal/application/limit"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/limits"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+func TestListAccountLimitsHandler(t *testing.T) {
+	var (
+		accountID = idVO.NewAccountIDForTest("account")
+		userID    = idVO.NewUserIDForTest("user")
+		uri       = "/api/v1/me/accounts/" + accountID.String() + "/limits"
+		arg       = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		accountID            string
+		setupContext         func() context.Context
+		prepare              func(mockListAccountLimitsUC *appMock.MockIListAccountLimitsUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 取引金額の上限の取得に成功する",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockListAccountLimitsUC *appMock.MockIListAccountLimitsUsecase) {
+				mockListAccountLimitsUC.EXPECT().Run(arg, limitApp.ListAccountLimitsCommand{
+					UserID:    userID.String(),
+					AccountID: accountID.String(),
+				}).Return(&limitApp.ListAccountLimitsDTO{
+					Limits: []limitApp.AccountLimitDTO{
+						{
+							OperationType: limitDomain.OperationWithdrawal,
+							Currency:      moneyVO.JPY,
+							Effective:     &limitApp.LimitsDTO{PerTransaction: "1000", Daily: "3000", Monthly: "5000"},
+							Default:       &limitApp.LimitsDTO{PerTransaction: "1000", Daily: "3000", Monthly: "10000"},
+						},
+						{
+							OperationType: limitDomain.OperationTransfer,
+							Currency:      moneyVO.JPY,
+						},
+					},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: limits.ListAccountLimitsResponse{
+				Limits: []limits.AccountLimitResponse{
+					{
+						OperationType: limitDomain.OperationWithdrawal,
+						Currency:      moneyVO.JPY,
+						Effective:     &limits.LimitsResponse{PerTransaction: "1000", Daily: "3000", Monthly: "5000"},
+						Default:       &limits.LimitsResponse{PerTransaction: "1000", Daily: "3000", Monthly: "10000"},
+					},
+					{
+						OperationType: limitDomain.OperationTransfer,
+						Currency:      moneyVO.JPY,
+					},
+				},
+			},
+		},
+		{
+			caseName:     "Negative: 口座IDが不正な場合、Validation Failed を返す",
+			accountID:    "invalid",
+			setupContext: happyContext,
+			prepare:      func(mockListAccountLimitsUC *appMock.MockIListAccountLimitsUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			accountID:            accountID.String(),
+			setupContext:         context.Background,
+			prepare:              func(mockListAccountLimitsUC *appMock.MockIListAccountLimitsUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 口座の所有者でない場合、Forbidden を返す",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockListAccountLimitsUC *appMock.MockIListAccountLimitsUsecase) {
+				mockListAccountLimitsUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnauthorized),
+		},
+		{
+			caseName:     "Negative: 口座が見つからない場合、Not Found を返す",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockListAccountLimitsUC *appMock.MockIListAccountLimitsUsecase) {
+				mockListAccountLimitsUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, accountDomain.ErrNotFound),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockListAccountLimitsUC *appMock.MockIListAccountLimitsUsecase) {
+				mockListAccountLimitsUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, uri, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id")
+			ctx.SetParamValues(tt.accountID)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockListAccountLimitsUC := appMock.NewMockIListAccountLimitsUsecase(ctrl)
+			tt.prepare(mockListAccountLimitsUC)
+
+			h := limits.NewListAccountLimitsHandler(mockListAccountLimitsUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp limits.ListAccountLimitsResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
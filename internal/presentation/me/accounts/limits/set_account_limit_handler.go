@@ -0,0 +1,164 @@
+package limits
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	limitApp "github.com/u104rak1/pocgo/internal/application/limit"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type SetAccountLimitHandler struct {
+	setAccountLimitUC limitApp.ISetAccountLimitUsecase
+}
+
+func NewSetAccountLimitHandler(setAccountLimitUsecase limitApp.ISetAccountLimitUsecase) *SetAccountLimitHandler {
+	return &SetAccountLimitHandler{
+		setAccountLimitUC: setAccountLimitUsecase,
+	}
+}
+
+type SetAccountLimitParams struct {
+	AccountID     string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+	OperationType string `param:"operation_type" example:"WITHDRAWAL"`
+}
+
+type SetAccountLimitRequestBody struct {
+	// 口座パスワード
+	Password string `json:"password" example:"1234"`
+
+	// 1回の取引の上限 (口座の通貨)
+	PerTransaction json.Number `json:"perTransaction" swaggertype:"number" example:"100000"`
+
+	// 直近24時間の合計の上限 (口座の通貨)
+	Daily json.Number `json:"daily" swaggertype:"number" example:"300000"`
+
+	// 当月の合計の上限 (口座の通貨)
+	Monthly json.Number `json:"monthly" swaggertype:"number" example:"1000000"`
+}
+
+type SetAccountLimitRequest struct {
+	SetAccountLimitParams
+	SetAccountLimitRequestBody
+}
+
+// @Summary 取引金額の上限の設定
+// @Description 指定された取引種別について、口座の取引金額の上限を設定します。
+// @Description 上限は 1回 <= 1日 <= 1ヶ月 の関係を満たし、既定の上限以下である必要があります。
+// @Description 既に設定している場合は上書きします。
+// @Tags Limit API
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param operation_type path string true "取引種別 (WITHDRAWAL, TRANSFER)"
+// @Param request body SetAccountLimitRequestBody true "Request Body"
+// @Success 200 {object} AccountLimitResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 422 {object} response.ProblemDetail "Unprocessable Entity"
+// @Failure 429 {object} response.ProblemDetail "Too Many Requests"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/limits/{operation_type} [put]
+func (h *SetAccountLimitHandler) Run(ctx echo.Context) error {
+	req := new(SetAccountLimitRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.setAccountLimitUC.Run(ctx.Request().Context(), limitApp.SetAccountLimitCommand{
+		UserID:         userID,
+		AccountID:      req.AccountID,
+		OperationType:  req.OperationType,
+		Password:       req.Password,
+		PerTransaction: req.PerTransaction.String(),
+		Daily:          req.Daily.String(),
+		Monthly:        req.Monthly.String(),
+	})
+	if err != nil {
+		switch err {
+		case limitDomain.ErrInvalidLimit,
+			limitDomain.ErrInconsistentLimits,
+			moneyVO.ErrInvalidMoney,
+			moneyVO.ErrAmountOverflow:
+			return response.BadRequest(ctx, err)
+		case accountDomain.ErrUnauthorized,
+			accountDomain.ErrUnmatchedPassword:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		case limitDomain.ErrLooserThanDefault:
+			return response.UnprocessableEntity(ctx, err)
+		case lockoutDomain.ErrLocked:
+			return response.TooManyRequests(ctx, err)
+		default:
+			// 金額は口座の通貨で解釈する為、精度の誤りはユースケースで検出され、通貨ごとのメッセージでラップされています。
+			if errors.Is(err, moneyVO.ErrInvalidPrecision) {
+				return response.BadRequest(ctx, err)
+			}
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, newAccountLimitResponse(*dto))
+}
+
+func (h *SetAccountLimitHandler) validation(req *SetAccountLimitRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+
+	if err := validation.ValidLimitOperationType(req.OperationType); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "operation_type",
+			Message: err.Error(),
+		})
+	}
+
+	if err := validation.ValidAccountPassword(req.Password); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "password",
+			Message: err.Error(),
+		})
+	}
+
+	amounts := []struct {
+		field  string
+		amount json.Number
+	}{
+		{field: "perTransaction", amount: req.PerTransaction},
+		{field: "daily", amount: req.Daily},
+		{field: "monthly", amount: req.Monthly},
+	}
+	for _, a := range amounts {
+		if err := validation.ValidLimitAmount(a.amount.String()); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   a.field,
+				Message: err.Error(),
+			})
+		}
+	}
+	return validationErrors
+}
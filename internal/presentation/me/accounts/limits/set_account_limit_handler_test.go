@@ -0,0 +1,264 @@
+package limits_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	limitApp "github.com/u104rak1/pocgo/internal/application/limit"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/limits"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+func TestSetAccountLimitHandler(t *testing.T) {
+	var (
+		accountID     = idVO.NewAccountIDForTest("account")
+		userID        = idVO.NewUserIDForTest("user")
+		operationType = limitDomain.OperationWithdrawal
+		uri           = "/api/v1/me/accounts/" + accountID.String() + "/limits/" + operationType
+		arg           = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+
+	happyRequestBody := limits.SetAccountLimitRequestBody{
+		Password:       "1234",
+		PerTransaction: json.Number("1000"),
+		Daily:          json.Number("3000"),
+		Monthly:        json.Number("5000"),
+	}
+	precisionErr := fmt.Errorf("%w: amount in JPY must not have decimal places", moneyVO.ErrInvalidPrecision)
+
+	tests := []struct {
+		caseName             string
+		operationType        string
+		requestBody          interface{}
+		setupContext         func() context.Context
+		prepare              func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:      "Positive: 取引金額の上限の設定に成功する",
+			operationType: operationType,
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {
+				mockSetAccountLimitUC.EXPECT().Run(arg, limitApp.SetAccountLimitCommand{
+					UserID:         userID.String(),
+					AccountID:      accountID.String(),
+					OperationType:  operationType,
+					Password:       "1234",
+					PerTransaction: "1000",
+					Daily:          "3000",
+					Monthly:        "5000",
+				}).Return(&limitApp.AccountLimitDTO{
+					OperationType: operationType,
+					Currency:      moneyVO.JPY,
+					Effective:     &limitApp.LimitsDTO{PerTransaction: "1000", Daily: "3000", Monthly: "5000"},
+					Default:       &limitApp.LimitsDTO{PerTransaction: "5000", Daily: "10000", Monthly: "50000"},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: limits.AccountLimitResponse{
+				OperationType: operationType,
+				Currency:      moneyVO.JPY,
+				Effective:     &limits.LimitsResponse{PerTransaction: "1000", Daily: "3000", Monthly: "5000"},
+				Default:       &limits.LimitsResponse{PerTransaction: "5000", Daily: "10000", Monthly: "50000"},
+			},
+		},
+		{
+			caseName:      "Negative: 上限を設定できない取引種別の場合、Validation Failed を返す",
+			operationType: "DEPOSIT",
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare:       func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {},
+			expectedCode:  http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:      "Negative: 上限の金額が指定されていない場合、Validation Failed を返す",
+			operationType: operationType,
+			requestBody:   map[string]interface{}{"password": "1234", "perTransaction": 1000},
+			setupContext:  happyContext,
+			prepare:       func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {},
+			expectedCode:  http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			operationType:        operationType,
+			requestBody:          happyRequestBody,
+			setupContext:         context.Background,
+			prepare:              func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:      "Negative: 上限の大小関係が不正な場合、Bad Request を返す",
+			operationType: operationType,
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {
+				mockSetAccountLimitUC.EXPECT().Run(arg, arg).Return(nil, limitDomain.ErrInconsistentLimits)
+			},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, limitDomain.ErrInconsistentLimits),
+		},
+		{
+			caseName:      "Negative: 上限の金額が通貨の精度を超える場合、Bad Request を返す",
+			operationType: operationType,
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {
+				mockSetAccountLimitUC.EXPECT().Run(arg, arg).Return(nil, precisionErr)
+			},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, precisionErr),
+		},
+		{
+			caseName:      "Negative: パスワードが一致しない場合、Forbidden を返す",
+			operationType: operationType,
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {
+				mockSetAccountLimitUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnmatchedPassword),
+		},
+		{
+			caseName:      "Negative: 口座が見つからない場合、Not Found を返す",
+			operationType: operationType,
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {
+				mockSetAccountLimitUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, accountDomain.ErrNotFound),
+		},
+		{
+			caseName:      "Negative: 既定の上限を超える場合、Unprocessable Entity を返す",
+			operationType: operationType,
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {
+				mockSetAccountLimitUC.EXPECT().Run(arg, arg).Return(nil, limitDomain.ErrLooserThanDefault)
+			},
+			expectedCode:         http.StatusUnprocessableEntity,
+			expectedResponseBody: problem(http.StatusUnprocessableEntity, response.TypeURLUnprocessableEntity, response.TitleUnprocessableEntity, limitDomain.ErrLooserThanDefault),
+		},
+		{
+			caseName:      "Negative: 口座がロックされている場合、Too Many Requests を返す",
+			operationType: operationType,
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {
+				mockSetAccountLimitUC.EXPECT().Run(arg, arg).Return(nil, lockoutDomain.ErrLocked)
+			},
+			expectedCode:         http.StatusTooManyRequests,
+			expectedResponseBody: problem(http.StatusTooManyRequests, response.TypeURLTooManyRequests, response.TitleTooManyRequests, lockoutDomain.ErrLocked),
+		},
+		{
+			caseName:      "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			operationType: operationType,
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockSetAccountLimitUC *appMock.MockISetAccountLimitUsecase) {
+				mockSetAccountLimitUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPut, uri, bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id", "operation_type")
+			ctx.SetParamValues(accountID.String(), tt.operationType)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockSetAccountLimitUC := appMock.NewMockISetAccountLimitUsecase(ctrl)
+			tt.prepare(mockSetAccountLimitUC)
+
+			h := limits.NewSetAccountLimitHandler(mockSetAccountLimitUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp limits.AccountLimitResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package accounts
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountApp "github.com/u104rak1/pocgo/internal/application/account"
+	"github.com/u104rak1/pocgo/internal/config"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ListAccountsHandler struct {
+	listAccountsUC accountApp.IListAccountsUsecase
+}
+
+func NewListAccountsHandler(listAccountsUsecase accountApp.IListAccountsUsecase) *ListAccountsHandler {
+	return &ListAccountsHandler{
+		listAccountsUC: listAccountsUsecase,
+	}
+}
+
+type ListAccountsResponse struct {
+	// 口座一覧
+	Accounts []ListAccountsAccount `json:"accounts"`
+}
+
+type ListAccountsAccount struct {
+	// 口座ID
+	ID string `json:"id" example:"01J9R7YPV1FH1V0PPKVSB5C7LE"`
+
+	// 口座名
+	Name string `json:"name" example:"For work"`
+
+	// 口座残高
+	Balance json.Number `json:"balance" swaggertype:"number" example:"1000"`
+
+	// 利用可能な金額（口座残高から仮押さえ中の金額を引いた金額）
+	AvailableBalance json.Number `json:"availableBalance" swaggertype:"number" example:"700"`
+
+	// 仮押さえ中の金額
+	HeldBalance json.Number `json:"heldBalance" swaggertype:"number" example:"300"`
+
+	// 通貨
+	Currency string `json:"currency" example:"JPY"`
+
+	// 口座の更新日時
+	UpdatedAt string `json:"updatedAt" example:"2021-08-01T00:00:00Z"`
+}
+
+// @Summary 口座一覧の取得
+// @Description ログインユーザーが所有する口座の一覧を取得します。解約済みの口座は含まれません。
+// @Tags Account API
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} ListAccountsResponse
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts [get]
+func (h *ListAccountsHandler) Run(ctx echo.Context) error {
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.listAccountsUC.Run(ctx.Request().Context(), accountApp.ListAccountsCommand{
+		UserID: userID,
+	})
+	if err != nil {
+		return response.InternalServerError(ctx, err)
+	}
+
+	accounts := make([]ListAccountsAccount, len(dto.Accounts))
+	for i, a := range dto.Accounts {
+		accounts[i] = ListAccountsAccount{
+			ID:               a.ID,
+			Name:             a.Name,
+			Balance:          json.Number(a.Balance),
+			AvailableBalance: json.Number(a.AvailableBalance),
+			HeldBalance:      json.Number(a.HeldBalance),
+			Currency:         a.Currency,
+			UpdatedAt:        a.UpdatedAt,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, ListAccountsResponse{
+		Accounts: accounts,
+	})
+}
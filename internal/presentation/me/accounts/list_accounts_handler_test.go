@@ -0,0 +1,162 @@
+package accounts_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	accountApp "github.com/u104rak1/pocgo/internal/application/account"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestListAccountsHandler(t *testing.T) {
+	var (
+		accountID = idVO.NewAccountIDForTest("account")
+		userID    = idVO.NewUserIDForTest("user")
+		name      = "For work"
+		currency  = money.JPY
+		updatedAt = timer.GetFixedDateString()
+		uri       = "/api/v1/me/accounts"
+		arg       = gomock.Any()
+	)
+
+	tests := []struct {
+		caseName             string
+		setupContext         func() context.Context
+		prepare              func(mockListAccountsUC *appMock.MockIListAccountsUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName: "Positive: 口座一覧の取得に成功する",
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockListAccountsUC *appMock.MockIListAccountsUsecase) {
+				mockListAccountsUC.EXPECT().Run(arg, accountApp.ListAccountsCommand{UserID: userID.String()}).Return(&accountApp.ListAccountsDTO{
+					Accounts: []accountApp.ListAccountDTO{
+						{
+							ID:               accountID.String(),
+							UserID:           userID.String(),
+							Name:             name,
+							Balance:          "1000",
+							AvailableBalance: "1000",
+							HeldBalance:      "0",
+							Currency:         currency,
+							UpdatedAt:        updatedAt,
+						},
+					},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: accounts.ListAccountsResponse{
+				Accounts: []accounts.ListAccountsAccount{
+					{
+						ID:               accountID.String(),
+						Name:             name,
+						Balance:          "1000",
+						AvailableBalance: "1000",
+						HeldBalance:      "0",
+						Currency:         currency,
+						UpdatedAt:        updatedAt,
+					},
+				},
+			},
+		},
+		{
+			caseName: "Positive: 口座が存在しない場合、空の一覧を返す",
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockListAccountsUC *appMock.MockIListAccountsUsecase) {
+				mockListAccountsUC.EXPECT().Run(arg, arg).Return(&accountApp.ListAccountsDTO{
+					Accounts: []accountApp.ListAccountDTO{},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: accounts.ListAccountsResponse{
+				Accounts: []accounts.ListAccountsAccount{},
+			},
+		},
+		{
+			caseName: "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			setupContext: func() context.Context {
+				return context.Background()
+			},
+			prepare:      func(mockListAccountsUC *appMock.MockIListAccountsUsecase) {},
+			expectedCode: http.StatusUnauthorized,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLUnauthorized,
+				Title:    response.TitleUnauthorized,
+				Status:   http.StatusUnauthorized,
+				Detail:   config.ErrUserIDMissing.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName: "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockListAccountsUC *appMock.MockIListAccountsUsecase) {
+				mockListAccountsUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLInternalServerError,
+				Title:    response.TitleInternalServerError,
+				Status:   http.StatusInternalServerError,
+				Detail:   assert.AnError.Error(),
+				Instance: uri,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, uri, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockListAccountsUC := appMock.NewMockIListAccountsUsecase(ctrl)
+			tt.prepare(mockListAccountsUC)
+
+			h := accounts.NewListAccountsHandler(mockListAccountsUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp accounts.ListAccountsResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				assert.Equal(t, tt.expectedResponseBody, he.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package accounts
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountApp "github.com/u104rak1/pocgo/internal/application/account"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ReadAccountHandler struct {
+	readAccountUC accountApp.IReadAccountUsecase
+}
+
+func NewReadAccountHandler(readAccountUsecase accountApp.IReadAccountUsecase) *ReadAccountHandler {
+	return &ReadAccountHandler{
+		readAccountUC: readAccountUsecase,
+	}
+}
+
+type ReadAccountParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type ReadAccountRequest struct {
+	ReadAccountParams
+}
+
+type ReadAccountResponse struct {
+	// 口座ID
+	ID string `json:"id" example:"01J9R7YPV1FH1V0PPKVSB5C7LE"`
+
+	// 口座名
+	Name string `json:"name" example:"For work"`
+
+	// 口座残高
+	Balance json.Number `json:"balance" swaggertype:"number" example:"1000"`
+
+	// 利用可能な金額（口座残高から仮押さえ中の金額を引いた金額）
+	AvailableBalance json.Number `json:"availableBalance" swaggertype:"number" example:"700"`
+
+	// 仮押さえ中の金額
+	HeldBalance json.Number `json:"heldBalance" swaggertype:"number" example:"300"`
+
+	// 通貨
+	Currency string `json:"currency" example:"JPY"`
+
+	// 口座の更新日時
+	UpdatedAt string `json:"updatedAt" example:"2021-08-01T00:00:00Z"`
+}
+
+// @Summary 口座の取得
+// @Description 指定された口座の情報を取得します。
+// @Tags Account API
+// @Security BearerAuth
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Success 200 {object} ReadAccountResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id} [get]
+func (h *ReadAccountHandler) Run(ctx echo.Context) error {
+	req := new(ReadAccountRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.readAccountUC.Run(ctx.Request().Context(), accountApp.ReadAccountCommand{
+		UserID:    userID,
+		AccountID: req.AccountID,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, ReadAccountResponse{
+		ID:               dto.ID,
+		Name:             dto.Name,
+		Balance:          json.Number(dto.Balance),
+		AvailableBalance: json.Number(dto.AvailableBalance),
+		HeldBalance:      json.Number(dto.HeldBalance),
+		Currency:         dto.Currency,
+		UpdatedAt:        dto.UpdatedAt,
+	})
+}
+
+func (h *ReadAccountHandler) validation(req *ReadAccountRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "param.account_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,202 @@
+package accounts_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	accountApp "github.com/u104rak1/pocgo/internal/application/account"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestReadAccountHandler(t *testing.T) {
+	var (
+		accountID = idVO.NewAccountIDForTest("account")
+		userID    = idVO.NewUserIDForTest("user")
+		name      = "For work"
+		currency  = money.JPY
+		updatedAt = timer.GetFixedDateString()
+		arg       = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+
+	tests := []struct {
+		caseName             string
+		accountID            string
+		setupContext         func() context.Context
+		prepare              func(mockReadAccountUC *appMock.MockIReadAccountUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 口座の取得に成功する",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockReadAccountUC *appMock.MockIReadAccountUsecase) {
+				mockReadAccountUC.EXPECT().Run(arg, accountApp.ReadAccountCommand{
+					UserID:    userID.String(),
+					AccountID: accountID.String(),
+				}).Return(&accountApp.ReadAccountDTO{
+					ID:               accountID.String(),
+					UserID:           userID.String(),
+					Name:             name,
+					Balance:          "1000",
+					AvailableBalance: "1000",
+					HeldBalance:      "0",
+					Currency:         currency,
+					UpdatedAt:        updatedAt,
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: accounts.ReadAccountResponse{
+				ID:               accountID.String(),
+				Name:             name,
+				Balance:          "1000",
+				AvailableBalance: "1000",
+				HeldBalance:      "0",
+				Currency:         currency,
+				UpdatedAt:        updatedAt,
+			},
+		},
+		{
+			caseName:     "Negative: 口座IDが不正な場合、Bad Request を返す",
+			accountID:    "invalid",
+			setupContext: happyContext,
+			prepare:      func(mockReadAccountUC *appMock.MockIReadAccountUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: "/api/v1/me/accounts/invalid",
+				},
+			},
+		},
+		{
+			caseName:  "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			accountID: accountID.String(),
+			setupContext: func() context.Context {
+				return context.Background()
+			},
+			prepare:      func(mockReadAccountUC *appMock.MockIReadAccountUsecase) {},
+			expectedCode: http.StatusUnauthorized,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLUnauthorized,
+				Title:    response.TitleUnauthorized,
+				Status:   http.StatusUnauthorized,
+				Detail:   config.ErrUserIDMissing.Error(),
+				Instance: "/api/v1/me/accounts/" + accountID.String(),
+			},
+		},
+		{
+			caseName:     "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockReadAccountUC *appMock.MockIReadAccountUsecase) {
+				mockReadAccountUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			expectedCode: http.StatusForbidden,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLForbidden,
+				Title:    response.TitleForbidden,
+				Status:   http.StatusForbidden,
+				Detail:   accountDomain.ErrUnauthorized.Error(),
+				Instance: "/api/v1/me/accounts/" + accountID.String(),
+			},
+		},
+		{
+			caseName:     "Negative: 口座が見つからない場合、Not Found を返す",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockReadAccountUC *appMock.MockIReadAccountUsecase) {
+				mockReadAccountUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLNotFound,
+				Title:    response.TitleNotFound,
+				Status:   http.StatusNotFound,
+				Detail:   accountDomain.ErrNotFound.Error(),
+				Instance: "/api/v1/me/accounts/" + accountID.String(),
+			},
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			accountID:    accountID.String(),
+			setupContext: happyContext,
+			prepare: func(mockReadAccountUC *appMock.MockIReadAccountUsecase) {
+				mockReadAccountUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLInternalServerError,
+				Title:    response.TitleInternalServerError,
+				Status:   http.StatusInternalServerError,
+				Detail:   assert.AnError.Error(),
+				Instance: "/api/v1/me/accounts/" + accountID.String(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/me/accounts/"+tt.accountID, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id")
+			ctx.SetParamValues(tt.accountID)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockReadAccountUC := appMock.NewMockIReadAccountUsecase(ctrl)
+			tt.prepare(mockReadAccountUC)
+
+			h := accounts.NewReadAccountHandler(mockReadAccountUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp accounts.ReadAccountResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
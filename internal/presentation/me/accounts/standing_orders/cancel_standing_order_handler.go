@@ -0,0 +1,100 @@
+package standingorders
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type CancelStandingOrderHandler struct {
+	cancelStandingOrderUC standingOrderApp.ICancelStandingOrderUsecase
+}
+
+func NewCancelStandingOrderHandler(cancelStandingOrderUsecase standingOrderApp.ICancelStandingOrderUsecase) *CancelStandingOrderHandler {
+	return &CancelStandingOrderHandler{
+		cancelStandingOrderUC: cancelStandingOrderUsecase,
+	}
+}
+
+type CancelStandingOrderParams struct {
+	AccountID       string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+	StandingOrderID string `param:"standing_order_id" example:"01J9R8AJ1Q2YDH1X9836GS9F12"`
+}
+
+type CancelStandingOrderRequest struct {
+	CancelStandingOrderParams
+}
+
+// @Summary 自動振込の解約
+// @Description 指定された自動振込を解約します。実行履歴は引き続き参照できます。
+// @Tags Standing Order API
+// @Security BearerAuth
+// @Param account_id path string true "口座ID"
+// @Param standing_order_id path string true "自動振込ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 409 {object} response.ProblemDetail "Conflict"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/standing-orders/{standing_order_id} [delete]
+func (h *CancelStandingOrderHandler) Run(ctx echo.Context) error {
+	req := new(CancelStandingOrderRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	err := h.cancelStandingOrderUC.Run(ctx.Request().Context(), standingOrderApp.CancelStandingOrderCommand{
+		UserID:          userID,
+		AccountID:       req.AccountID,
+		StandingOrderID: req.StandingOrderID,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound,
+			standingOrderDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		case standingOrderDomain.ErrNotModifiable,
+			standingOrderDomain.ErrConcurrentModification:
+			return response.Conflict(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (h *CancelStandingOrderHandler) validation(req *CancelStandingOrderRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+	if err := validation.ValidULID(req.StandingOrderID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "standing_order_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,174 @@
+package standingorders_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	standingorders "github.com/u104rak1/pocgo/internal/presentation/me/accounts/standing_orders"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+func TestCancelStandingOrderHandler(t *testing.T) {
+	var (
+		accountID       = idVO.NewAccountIDForTest("account")
+		userID          = idVO.NewUserIDForTest("user")
+		standingOrderID = idVO.NewStandingOrderIDForTest("standing-order")
+		arg             = gomock.Any()
+	)
+
+	uri := func(standingOrderID string) string {
+		return "/api/v1/me/accounts/" + accountID.String() + "/standing-orders/" + standingOrderID
+	}
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri(standingOrderID.String()),
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		standingOrderID      string
+		setupContext         func() context.Context
+		prepare              func(mockCancelStandingOrderUC *appMock.MockICancelStandingOrderUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:        "Positive: 自動振込の解約に成功する",
+			standingOrderID: standingOrderID.String(),
+			setupContext:    happyContext,
+			prepare: func(mockCancelStandingOrderUC *appMock.MockICancelStandingOrderUsecase) {
+				mockCancelStandingOrderUC.EXPECT().Run(arg, standingOrderApp.CancelStandingOrderCommand{
+					UserID:          userID.String(),
+					AccountID:       accountID.String(),
+					StandingOrderID: standingOrderID.String(),
+				}).Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			caseName:        "Negative: 自動振込IDが不正な場合、Validation Failed を返す",
+			standingOrderID: "invalid",
+			setupContext:    happyContext,
+			prepare:         func(mockCancelStandingOrderUC *appMock.MockICancelStandingOrderUsecase) {},
+			expectedCode:    http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri("invalid"),
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			standingOrderID:      standingOrderID.String(),
+			setupContext:         context.Background,
+			prepare:              func(mockCancelStandingOrderUC *appMock.MockICancelStandingOrderUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:        "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			standingOrderID: standingOrderID.String(),
+			setupContext:    happyContext,
+			prepare: func(mockCancelStandingOrderUC *appMock.MockICancelStandingOrderUsecase) {
+				mockCancelStandingOrderUC.EXPECT().Run(arg, arg).Return(accountDomain.ErrUnauthorized)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnauthorized),
+		},
+		{
+			caseName:        "Negative: 自動振込が見つからない場合、Not Found を返す",
+			standingOrderID: standingOrderID.String(),
+			setupContext:    happyContext,
+			prepare: func(mockCancelStandingOrderUC *appMock.MockICancelStandingOrderUsecase) {
+				mockCancelStandingOrderUC.EXPECT().Run(arg, arg).Return(standingOrderDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, standingOrderDomain.ErrNotFound),
+		},
+		{
+			caseName:        "Negative: 解約済みの自動振込の場合、Conflict を返す",
+			standingOrderID: standingOrderID.String(),
+			setupContext:    happyContext,
+			prepare: func(mockCancelStandingOrderUC *appMock.MockICancelStandingOrderUsecase) {
+				mockCancelStandingOrderUC.EXPECT().Run(arg, arg).Return(standingOrderDomain.ErrNotModifiable)
+			},
+			expectedCode:         http.StatusConflict,
+			expectedResponseBody: problem(http.StatusConflict, response.TypeURLConflict, response.TitleConflict, standingOrderDomain.ErrNotModifiable),
+		},
+		{
+			caseName:        "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			standingOrderID: standingOrderID.String(),
+			setupContext:    happyContext,
+			prepare: func(mockCancelStandingOrderUC *appMock.MockICancelStandingOrderUsecase) {
+				mockCancelStandingOrderUC.EXPECT().Run(arg, arg).Return(assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, uri(tt.standingOrderID), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id", "standing_order_id")
+			ctx.SetParamValues(accountID.String(), tt.standingOrderID)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockCancelStandingOrderUC := appMock.NewMockICancelStandingOrderUsecase(ctrl)
+			tt.prepare(mockCancelStandingOrderUC)
+
+			h := standingorders.NewCancelStandingOrderHandler(mockCancelStandingOrderUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusNoContent {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package standingorders
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type CreateStandingOrderHandler struct {
+	createStandingOrderUC standingOrderApp.ICreateStandingOrderUsecase
+}
+
+func NewCreateStandingOrderHandler(createStandingOrderUsecase standingOrderApp.ICreateStandingOrderUsecase) *CreateStandingOrderHandler {
+	return &CreateStandingOrderHandler{
+		createStandingOrderUC: createStandingOrderUsecase,
+	}
+}
+
+type CreateStandingOrderParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type CreateStandingOrderRequestBody struct {
+	// 口座パスワード
+	Password string `json:"password" example:"1234"`
+
+	// 受取口座ID
+	ReceiverAccountID string `json:"receiverAccountId" example:"01J9R8AJ1Q2YDH1X9836GS9D87"`
+
+	// 振込金額 (通貨の小数点以下の桁数まで指定可能)
+	Amount json.Number `json:"amount" swaggertype:"number" example:"1000"`
+
+	// 通貨 (口座と同じ通貨)
+	Currency string `json:"currency" example:"JPY"`
+
+	// 実行の間隔 (WEEKLY, MONTHLY)
+	Frequency string `json:"frequency" example:"MONTHLY"`
+
+	// 実行日 (MONTHLYの場合必須。指定日が存在しない月は月末日に実行)
+	DayOfMonth *int `json:"dayOfMonth" example:"25"`
+
+	// 開始日 (YYYYMMDD)
+	StartDate string `json:"startDate" example:"20261101"`
+
+	// 終了日 (YYYYMMDD)
+	EndDate *string `json:"endDate" example:"20271031"`
+
+	// 実行回数の上限
+	MaxExecutions *int `json:"maxExecutions" example:"12"`
+
+	// 1回の実行日あたりの再試行回数 (0 ～ 5、デフォルト 3)
+	MaxRetries *int `json:"maxRetries" example:"3"`
+
+	// 再試行の上限に達した場合の方針 (SKIP, SUSPEND、デフォルト SKIP)
+	FailurePolicy *string `json:"failurePolicy" example:"SKIP"`
+}
+
+type CreateStandingOrderRequest struct {
+	CreateStandingOrderParams
+	CreateStandingOrderRequestBody
+}
+
+// @Summary 自動振込の作成
+// @Description 指定された口座から他の口座へ、毎週または毎月決められた日に振込を行う自動振込を作成します。
+// @Description 実行日に残高不足などで振込できなかった場合は、1日ごとに maxRetries 回まで再試行します。
+// @Tags Standing Order API
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param request body CreateStandingOrderRequestBody true "Request Body"
+// @Success 201 {object} StandingOrderResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 429 {object} response.ProblemDetail "Too Many Requests"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/standing-orders [post]
+func (h *CreateStandingOrderHandler) Run(ctx echo.Context) error {
+	req := new(CreateStandingOrderRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	startDate, err := timer.ParseYYYYMMDD(req.StartDate)
+	if err != nil {
+		return response.BadRequest(ctx, err)
+	}
+	var endDate *time.Time
+	if req.EndDate != nil {
+		date, err := timer.ParseYYYYMMDD(*req.EndDate)
+		if err != nil {
+			return response.BadRequest(ctx, err)
+		}
+		endDate = &date
+	}
+
+	dto, err := h.createStandingOrderUC.Run(ctx.Request().Context(), standingOrderApp.CreateStandingOrderCommand{
+		UserID:            userID,
+		AccountID:         req.AccountID,
+		Password:          req.Password,
+		ReceiverAccountID: req.ReceiverAccountID,
+		Amount:            req.Amount.String(),
+		Currency:          req.Currency,
+		Frequency:         req.Frequency,
+		DayOfMonth:        req.DayOfMonth,
+		StartDate:         startDate,
+		EndDate:           endDate,
+		MaxExecutions:     req.MaxExecutions,
+		MaxRetries:        req.MaxRetries,
+		FailurePolicy:     req.FailurePolicy,
+	})
+	if err != nil {
+		switch err {
+		case moneyVO.ErrDifferentCurrencyOperation,
+			standingOrderDomain.ErrInvalidAmount,
+			standingOrderDomain.ErrSameAccount,
+			standingOrderDomain.ErrStartDateInPast,
+			standingOrderDomain.ErrInvalidEndDate:
+			return response.BadRequest(ctx, err)
+		case accountDomain.ErrUnauthorized,
+			accountDomain.ErrUnmatchedPassword:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound,
+			accountDomain.ErrReceiverNotFound:
+			return response.NotFound(ctx, err)
+		case lockoutDomain.ErrLocked:
+			return response.TooManyRequests(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, newStandingOrderResponse(*dto))
+}
+
+func (h *CreateStandingOrderHandler) validation(req *CreateStandingOrderRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+
+	if err := validation.ValidAccountPassword(req.Password); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "password",
+			Message: err.Error(),
+		})
+	}
+
+	if err := validation.ValidULID(req.ReceiverAccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "receiverAccountId",
+			Message: err.Error(),
+		})
+	} else if req.AccountID == req.ReceiverAccountID {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "receiverAccountId",
+			Message: "receiverAccountId must be different from account_id",
+		})
+	}
+
+	if err := validation.ValidCurrency(req.Currency); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "currency",
+			Message: err.Error(),
+		})
+	} else {
+		if err := validation.ValidAmount(req.Currency, req.Amount.String()); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "amount",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if err := validation.ValidStandingOrderFrequency(req.Frequency); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "frequency",
+			Message: err.Error(),
+		})
+	} else {
+		if err := validation.ValidStandingOrderDayOfMonth(req.Frequency, req.DayOfMonth); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "dayOfMonth",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if err := validation.ValidStandingOrderStartDate(req.StartDate); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "startDate",
+			Message: err.Error(),
+		})
+	}
+
+	if req.EndDate != nil {
+		if err := validation.ValidYYYYMMDD(*req.EndDate); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "endDate",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if req.MaxExecutions != nil {
+		if err := validation.ValidStandingOrderMaxExecutions(*req.MaxExecutions); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "maxExecutions",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if req.MaxRetries != nil {
+		if err := validation.ValidStandingOrderMaxRetries(*req.MaxRetries); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "maxRetries",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if req.FailurePolicy != nil {
+		if err := validation.ValidStandingOrderFailurePolicy(*req.FailurePolicy); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "failurePolicy",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return validationErrors
+}
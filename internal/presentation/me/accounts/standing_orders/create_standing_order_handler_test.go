@@ -0,0 +1,303 @@
+package standingorders_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	standingorders "github.com/u104rak1/pocgo/internal/presentation/me/accounts/standing_orders"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestCreateStandingOrderHandler(t *testing.T) {
+	var (
+		accountID         = idVO.NewAccountIDForTest("account")
+		receiverAccountID = idVO.NewAccountIDForTest("receiver")
+		userID            = idVO.NewUserIDForTest("user")
+		standingOrderID   = idVO.NewStandingOrderIDForTest("standing-order")
+		dayOfMonth        = 25
+		endDate           = "20271031"
+		uri               = "/api/v1/me/accounts/" + accountID.String() + "/standing-orders"
+		arg               = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+	validationFailed := response.ValidationProblemDetail{
+		ProblemDetail: response.ProblemDetail{
+			Type:     response.TypeURLValidationFailed,
+			Title:    response.TitleValidationFailed,
+			Status:   http.StatusBadRequest,
+			Detail:   response.DetailValidationFailed,
+			Instance: uri,
+		},
+	}
+
+	happyRequestBody := func() standingorders.CreateStandingOrderRequestBody {
+		return standingorders.CreateStandingOrderRequestBody{
+			Password:          "1234",
+			ReceiverAccountID: receiverAccountID.String(),
+			Amount:            json.Number("1000"),
+			Currency:          moneyVO.JPY,
+			Frequency:         standingOrderDomain.FrequencyMonthly,
+			DayOfMonth:        &dayOfMonth,
+			StartDate:         "20261101",
+			EndDate:           &endDate,
+		}
+	}
+	dto := &standingOrderApp.StandingOrderDTO{
+		ID:                standingOrderID.String(),
+		AccountID:         accountID.String(),
+		ReceiverAccountID: receiverAccountID.String(),
+		Amount:            "1000",
+		Currency:          moneyVO.JPY,
+		Frequency:         standingOrderDomain.FrequencyMonthly,
+		DayOfMonth:        &dayOfMonth,
+		StartDate:         "20261101",
+		EndDate:           &endDate,
+		MaxRetries:        standingOrderDomain.DefaultMaxRetries,
+		FailurePolicy:     standingOrderDomain.DefaultFailurePolicy,
+		Status:            standingOrderDomain.StatusActive,
+		NextRunDate:       "20261125",
+		NextAttemptDate:   "20261125",
+		CreatedAt:         timer.GetFixedDateString(),
+		UpdatedAt:         timer.GetFixedDateString(),
+	}
+
+	tests := []struct {
+		caseName             string
+		requestBody          interface{}
+		setupContext         func() context.Context
+		prepare              func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 自動振込の作成に成功する",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {
+				startDate, _ := timer.ParseYYYYMMDD("20261101")
+				end, _ := timer.ParseYYYYMMDD(endDate)
+				mockCreateStandingOrderUC.EXPECT().Run(arg, standingOrderApp.CreateStandingOrderCommand{
+					UserID:            userID.String(),
+					AccountID:         accountID.String(),
+					Password:          "1234",
+					ReceiverAccountID: receiverAccountID.String(),
+					Amount:            "1000",
+					Currency:          moneyVO.JPY,
+					Frequency:         standingOrderDomain.FrequencyMonthly,
+					DayOfMonth:        &dayOfMonth,
+					StartDate:         startDate,
+					EndDate:           &end,
+				}).Return(dto, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedResponseBody: standingorders.StandingOrderResponse{
+				ID:                standingOrderID.String(),
+				AccountID:         accountID.String(),
+				ReceiverAccountID: receiverAccountID.String(),
+				Amount:            json.Number("1000"),
+				Currency:          moneyVO.JPY,
+				Frequency:         standingOrderDomain.FrequencyMonthly,
+				DayOfMonth:        &dayOfMonth,
+				StartDate:         "20261101",
+				EndDate:           &endDate,
+				MaxRetries:        standingOrderDomain.DefaultMaxRetries,
+				FailurePolicy:     standingOrderDomain.DefaultFailurePolicy,
+				Status:            standingOrderDomain.StatusActive,
+				NextRunDate:       "20261125",
+				NextAttemptDate:   "20261125",
+				CreatedAt:         timer.GetFixedDateString(),
+				UpdatedAt:         timer.GetFixedDateString(),
+			},
+		},
+		{
+			caseName:             "Negative: リクエストボディが不正な場合、Bad Request を返す",
+			requestBody:          "invalid",
+			setupContext:         happyContext,
+			prepare:              func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, response.ErrInvalidJSON),
+		},
+		{
+			caseName: "Negative: 毎月実行する場合に実行日を指定しない場合、Validation Failed を返す",
+			requestBody: func() standingorders.CreateStandingOrderRequestBody {
+				body := happyRequestBody()
+				body.DayOfMonth = nil
+				return body
+			}(),
+			setupContext:         happyContext,
+			prepare:              func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: validationFailed,
+		},
+		{
+			caseName: "Negative: 受取口座が自身の口座の場合、Validation Failed を返す",
+			requestBody: func() standingorders.CreateStandingOrderRequestBody {
+				body := happyRequestBody()
+				body.ReceiverAccountID = accountID.String()
+				return body
+			}(),
+			setupContext:         happyContext,
+			prepare:              func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: validationFailed,
+		},
+		{
+			caseName: "Negative: 再試行回数が上限を超える場合、Validation Failed を返す",
+			requestBody: func() standingorders.CreateStandingOrderRequestBody {
+				body := happyRequestBody()
+				maxRetries := standingOrderDomain.MaxRetriesLimit + 1
+				body.MaxRetries = &maxRetries
+				return body
+			}(),
+			setupContext:         happyContext,
+			prepare:              func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: validationFailed,
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			requestBody:          happyRequestBody(),
+			setupContext:         context.Background,
+			prepare:              func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 開始日が過去の場合、Bad Request を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {
+				mockCreateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, standingOrderDomain.ErrStartDateInPast)
+			},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, standingOrderDomain.ErrStartDateInPast),
+		},
+		{
+			caseName:     "Negative: 口座と通貨が異なる場合、Bad Request を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {
+				mockCreateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, moneyVO.ErrDifferentCurrencyOperation)
+			},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, moneyVO.ErrDifferentCurrencyOperation),
+		},
+		{
+			caseName:     "Negative: パスワードが一致しない場合、Forbidden を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {
+				mockCreateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnmatchedPassword),
+		},
+		{
+			caseName:     "Negative: 受取口座が見つからない場合、Not Found を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {
+				mockCreateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrReceiverNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, accountDomain.ErrReceiverNotFound),
+		},
+		{
+			caseName:     "Negative: 口座がロックされている場合、Too Many Requests を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {
+				mockCreateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, lockoutDomain.ErrLocked)
+			},
+			expectedCode:         http.StatusTooManyRequests,
+			expectedResponseBody: problem(http.StatusTooManyRequests, response.TypeURLTooManyRequests, response.TitleTooManyRequests, lockoutDomain.ErrLocked),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			requestBody:  happyRequestBody(),
+			setupContext: happyContext,
+			prepare: func(mockCreateStandingOrderUC *appMock.MockICreateStandingOrderUsecase) {
+				mockCreateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id")
+			ctx.SetParamValues(accountID.String())
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockCreateStandingOrderUC := appMock.NewMockICreateStandingOrderUsecase(ctrl)
+			tt.prepare(mockCreateStandingOrderUC)
+
+			h := standingorders.NewCreateStandingOrderHandler(mockCreateStandingOrderUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusCreated {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusCreated, rec.Code)
+				var resp standingorders.StandingOrderResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
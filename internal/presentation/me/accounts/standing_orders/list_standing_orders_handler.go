@@ -0,0 +1,97 @@
+package standingorders
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ListStandingOrdersHandler struct {
+	listStandingOrdersUC standingOrderApp.IListStandingOrdersUsecase
+}
+
+func NewListStandingOrdersHandler(listStandingOrdersUsecase standingOrderApp.IListStandingOrdersUsecase) *ListStandingOrdersHandler {
+	return &ListStandingOrdersHandler{
+		listStandingOrdersUC: listStandingOrdersUsecase,
+	}
+}
+
+type ListStandingOrdersParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type ListStandingOrdersRequest struct {
+	ListStandingOrdersParams
+}
+
+type ListStandingOrdersResponse struct {
+	// 自動振込一覧
+	StandingOrders []StandingOrderResponse `json:"standingOrders"`
+}
+
+// @Summary 自動振込一覧の取得
+// @Description 指定された口座の自動振込を、解約済みや終了したものも含めて取得します。
+// @Tags Standing Order API
+// @Security BearerAuth
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Success 200 {object} ListStandingOrdersResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/standing-orders [get]
+func (h *ListStandingOrdersHandler) Run(ctx echo.Context) error {
+	req := new(ListStandingOrdersRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.listStandingOrdersUC.Run(ctx.Request().Context(), standingOrderApp.ListStandingOrdersCommand{
+		UserID:    userID,
+		AccountID: req.AccountID,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	orders := make([]StandingOrderResponse, 0, len(dto.StandingOrders))
+	for _, order := range dto.StandingOrders {
+		orders = append(orders, newStandingOrderResponse(order))
+	}
+	return ctx.JSON(http.StatusOK, ListStandingOrdersResponse{
+		StandingOrders: orders,
+	})
+}
+
+func (h *ListStandingOrdersHandler) validation(req *ListStandingOrdersRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,138 @@
+package standingorders
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ReadStandingOrderHandler struct {
+	readStandingOrderUC standingOrderApp.IReadStandingOrderUsecase
+}
+
+func NewReadStandingOrderHandler(readStandingOrderUsecase standingOrderApp.IReadStandingOrderUsecase) *ReadStandingOrderHandler {
+	return &ReadStandingOrderHandler{
+		readStandingOrderUC: readStandingOrderUsecase,
+	}
+}
+
+type ReadStandingOrderParams struct {
+	AccountID       string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+	StandingOrderID string `param:"standing_order_id" example:"01J9R8AJ1Q2YDH1X9836GS9F12"`
+}
+
+type ReadStandingOrderRequest struct {
+	ReadStandingOrderParams
+}
+
+type ReadStandingOrderResponse struct {
+	StandingOrderResponse
+
+	// 直近の実行履歴 (新しい順)
+	RecentExecutions []ExecutionResponse `json:"recentExecutions"`
+}
+
+type ExecutionResponse struct {
+	// 実行日
+	ScheduledDate string `json:"scheduledDate" example:"20261125"`
+
+	// 実行日に対する何回目の試行か
+	Attempt int `json:"attempt" example:"1"`
+
+	// 結果 (SUCCEEDED, RETRY_SCHEDULED, SKIPPED, SUSPENDED)
+	Result string `json:"result" example:"SUCCEEDED"`
+
+	// 取引ID (SUCCEEDEDの場合)
+	TransactionID *string `json:"transactionId" example:"01J9R8AJ1Q2YDH1X9836GS9E89"`
+
+	// 失敗した理由 (SUCCEEDED以外の場合)
+	FailureReason *string `json:"failureReason" example:"insufficient balance"`
+
+	// 実行日時
+	ExecutedAt string `json:"executedAt" example:"2026-11-25T00:01:00Z"`
+}
+
+// @Summary 自動振込の取得
+// @Description 指定された自動振込と、直近の実行履歴を取得します。
+// @Tags Standing Order API
+// @Security BearerAuth
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param standing_order_id path string true "自動振込ID"
+// @Success 200 {object} ReadStandingOrderResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/standing-orders/{standing_order_id} [get]
+func (h *ReadStandingOrderHandler) Run(ctx echo.Context) error {
+	req := new(ReadStandingOrderRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.readStandingOrderUC.Run(ctx.Request().Context(), standingOrderApp.ReadStandingOrderCommand{
+		UserID:          userID,
+		AccountID:       req.AccountID,
+		StandingOrderID: req.StandingOrderID,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound,
+			standingOrderDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	executions := make([]ExecutionResponse, 0, len(dto.RecentExecutions))
+	for _, execution := range dto.RecentExecutions {
+		executions = append(executions, ExecutionResponse{
+			ScheduledDate: execution.ScheduledDate,
+			Attempt:       execution.Attempt,
+			Result:        execution.Result,
+			TransactionID: execution.TransactionID,
+			FailureReason: execution.FailureReason,
+			ExecutedAt:    execution.ExecutedAt,
+		})
+	}
+	return ctx.JSON(http.StatusOK, ReadStandingOrderResponse{
+		StandingOrderResponse: newStandingOrderResponse(dto.StandingOrderDTO),
+		RecentExecutions:      executions,
+	})
+}
+
+func (h *ReadStandingOrderHandler) validation(req *ReadStandingOrderRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+	if err := validation.ValidULID(req.StandingOrderID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "standing_order_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,242 @@
+package standingorders_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	standingorders "github.com/u104rak1/pocgo/internal/presentation/me/accounts/standing_orders"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestReadStandingOrderHandler(t *testing.T) {
+	var (
+		accountID         = idVO.NewAccountIDForTest("account")
+		receiverAccountID = idVO.NewAccountIDForTest("receiver")
+		userID            = idVO.NewUserIDForTest("user")
+		standingOrderID   = idVO.NewStandingOrderIDForTest("standing-order")
+		transactionID     = idVO.NewTransactionIDForTest("transaction").String()
+		failureReason     = moneyVO.ErrInsufficientBalance.Error()
+		arg               = gomock.Any()
+	)
+
+	uri := func(accountID, standingOrderID string) string {
+		return "/api/v1/me/accounts/" + accountID + "/standing-orders/" + standingOrderID
+	}
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri(accountID.String(), standingOrderID.String()),
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		standingOrderID      string
+		setupContext         func() context.Context
+		prepare              func(mockReadStandingOrderUC *appMock.MockIReadStandingOrderUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:        "Positive: 自動振込と実行履歴の取得に成功する",
+			standingOrderID: standingOrderID.String(),
+			setupContext:    happyContext,
+			prepare: func(mockReadStandingOrderUC *appMock.MockIReadStandingOrderUsecase) {
+				mockReadStandingOrderUC.EXPECT().Run(arg, standingOrderApp.ReadStandingOrderCommand{
+					UserID:          userID.String(),
+					AccountID:       accountID.String(),
+					StandingOrderID: standingOrderID.String(),
+				}).Return(&standingOrderApp.ReadStandingOrderDTO{
+					StandingOrderDTO: standingOrderApp.StandingOrderDTO{
+						ID:                standingOrderID.String(),
+						AccountID:         accountID.String(),
+						ReceiverAccountID: receiverAccountID.String(),
+						Amount:            "1000",
+						Currency:          moneyVO.JPY,
+						Frequency:         standingOrderDomain.FrequencyWeekly,
+						StartDate:         "20261101",
+						MaxRetries:        standingOrderDomain.DefaultMaxRetries,
+						FailurePolicy:     standingOrderDomain.DefaultFailurePolicy,
+						Status:            standingOrderDomain.StatusActive,
+						NextRunDate:       "20261115",
+						NextAttemptDate:   "20261115",
+						ExecutionCount:    1,
+						CreatedAt:         timer.GetFixedDateString(),
+						UpdatedAt:         timer.GetFixedDateString(),
+					},
+					RecentExecutions: []standingOrderApp.ExecutionDTO{
+						{
+							ScheduledDate: "20261108",
+							Attempt:       2,
+							Result:        standingOrderDomain.ResultSucceeded,
+							TransactionID: &transactionID,
+							ExecutedAt:    timer.GetFixedDateString(),
+						},
+						{
+							ScheduledDate: "20261108",
+							Attempt:       1,
+							Result:        standingOrderDomain.ResultRetryScheduled,
+							FailureReason: &failureReason,
+							ExecutedAt:    timer.GetFixedDateString(),
+						},
+					},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: standingorders.ReadStandingOrderResponse{
+				StandingOrderResponse: standingorders.StandingOrderResponse{
+					ID:                standingOrderID.String(),
+					AccountID:         accountID.String(),
+					ReceiverAccountID: receiverAccountID.String(),
+					Amount:            json.Number("1000"),
+					Currency:          moneyVO.JPY,
+					Frequency:         standingOrderDomain.FrequencyWeekly,
+					StartDate:         "20261101",
+					MaxRetries:        standingOrderDomain.DefaultMaxRetries,
+					FailurePolicy:     standingOrderDomain.DefaultFailurePolicy,
+					Status:            standingOrderDomain.StatusActive,
+					NextRunDate:       "20261115",
+					NextAttemptDate:   "20261115",
+					ExecutionCount:    1,
+					CreatedAt:         timer.GetFixedDateString(),
+					UpdatedAt:         timer.GetFixedDateString(),
+				},
+				RecentExecutions: []standingorders.ExecutionResponse{
+					{
+						ScheduledDate: "20261108",
+						Attempt:       2,
+						Result:        standingOrderDomain.ResultSucceeded,
+						TransactionID: &transactionID,
+						ExecutedAt:    timer.GetFixedDateString(),
+					},
+					{
+						ScheduledDate: "20261108",
+						Attempt:       1,
+						Result:        standingOrderDomain.ResultRetryScheduled,
+						FailureReason: &failureReason,
+						ExecutedAt:    timer.GetFixedDateString(),
+					},
+				},
+			},
+		},
+		{
+			caseName:        "Negative: 自動振込IDが不正な場合、Validation Failed を返す",
+			standingOrderID: "invalid",
+			setupContext:    happyContext,
+			prepare:         func(mockReadStandingOrderUC *appMock.MockIReadStandingOrderUsecase) {},
+			expectedCode:    http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri(accountID.String(), "invalid"),
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			standingOrderID:      standingOrderID.String(),
+			setupContext:         context.Background,
+			prepare:              func(mockReadStandingOrderUC *appMock.MockIReadStandingOrderUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:        "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			standingOrderID: standingOrderID.String(),
+			setupContext:    happyContext,
+			prepare: func(mockReadStandingOrderUC *appMock.MockIReadStandingOrderUsecase) {
+				mockReadStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnauthorized),
+		},
+		{
+			caseName:        "Negative: 自動振込が見つからない場合、Not Found を返す",
+			standingOrderID: standingOrderID.String(),
+			setupContext:    happyContext,
+			prepare: func(mockReadStandingOrderUC *appMock.MockIReadStandingOrderUsecase) {
+				mockReadStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, standingOrderDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, standingOrderDomain.ErrNotFound),
+		},
+		{
+			caseName:        "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			standingOrderID: standingOrderID.String(),
+			setupContext:    happyContext,
+			prepare: func(mockReadStandingOrderUC *appMock.MockIReadStandingOrderUsecase) {
+				mockReadStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, uri(accountID.String(), tt.standingOrderID), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id", "standing_order_id")
+			ctx.SetParamValues(accountID.String(), tt.standingOrderID)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockReadStandingOrderUC := appMock.NewMockIReadStandingOrderUsecase(ctrl)
+			tt.prepare(mockReadStandingOrderUC)
+
+			h := standingorders.NewReadStandingOrderHandler(mockReadStandingOrderUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp standingorders.ReadStandingOrderResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package standingorders
+
+import (
+	"encoding/json"
+
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+)
+
+type StandingOrderResponse struct {
+	// 自動振込ID
+	ID string `json:"id" example:"01J9R8AJ1Q2YDH1X9836GS9F12"`
+
+	// 口座ID
+	AccountID string `json:"accountId" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+
+	// 受取口座ID
+	ReceiverAccountID string `json:"receiverAccountId" example:"01J9R8AJ1Q2YDH1X9836GS9D87"`
+
+	// 振込金額
+	Amount json.Number `json:"amount" swaggertype:"number" example:"1000"`
+
+	// 通貨
+	Currency string `json:"currency" example:"JPY"`
+
+	// 実行の間隔 (WEEKLY, MONTHLY)
+	Frequency string `json:"frequency" example:"MONTHLY"`
+
+	// 実行日 (MONTHLYの場合)
+	DayOfMonth *int `json:"dayOfMonth" example:"25"`
+
+	// 開始日
+	StartDate string `json:"startDate" example:"20261101"`
+
+	// 終了日
+	EndDate *string `json:"endDate" example:"20271031"`
+
+	// 実行回数の上限
+	MaxExecutions *int `json:"maxExecutions" example:"12"`
+
+	// 1回の実行日あたりの再試行回数
+	MaxRetries int `json:"maxRetries" example:"3"`
+
+	// 再試行の上限に達した場合の方針 (SKIP, SUSPEND)
+	FailurePolicy string `json:"failurePolicy" example:"SKIP"`
+
+	// 状態 (ACTIVE, PAUSED, SUSPENDED, COMPLETED, CANCELLED)
+	Status string `json:"status" example:"ACTIVE"`
+
+	// 次に実行する回の実行日
+	NextRunDate string `json:"nextRunDate" example:"20261125"`
+
+	// 次に実行を試みる日付 (失敗した場合は実行日より後になります)
+	NextAttemptDate string `json:"nextAttemptDate" example:"20261125"`
+
+	// 次に実行する回で失敗した回数
+	RetryCount int `json:"retryCount" example:"0"`
+
+	// 振込に成功した回数
+	ExecutionCount int `json:"executionCount" example:"0"`
+
+	// 作成日時
+	CreatedAt string `json:"createdAt" example:"2026-10-17T09:00:00Z"`
+
+	// 更新日時
+	UpdatedAt string `json:"updatedAt" example:"2026-10-17T09:00:00Z"`
+}
+
+func newStandingOrderResponse(dto standingOrderApp.StandingOrderDTO) StandingOrderResponse {
+	return StandingOrderResponse{
+		ID:                dto.ID,
+		AccountID:         dto.AccountID,
+		ReceiverAccountID: dto.ReceiverAccountID,
+		Amount:            json.Number(dto.Amount),
+		Currency:          dto.Currency,
+		Frequency:         dto.Frequency,
+		DayOfMonth:        dto.DayOfMonth,
+		StartDate:         dto.StartDate,
+		EndDate:           dto.EndDate,
+		MaxExecutions:     dto.MaxExecutions,
+		MaxRetries:        dto.MaxRetries,
+		FailurePolicy:     dto.FailurePolicy,
+		Status:            dto.Status,
+		NextRunDate:       dto.NextRunDate,
+		NextAttemptDate:   dto.NextAttemptDate,
+		RetryCount:        dto.RetryCount,
+		ExecutionCount:    dto.ExecutionCount,
+		CreatedAt:         dto.CreatedAt,
+		UpdatedAt:         dto.UpdatedAt,
+	}
+}
@@ -0,0 +1,208 @@
+package standingorders
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type UpdateStandingOrderHandler struct {
+	updateStandingOrderUC standingOrderApp.IUpdateStandingOrderUsecase
+}
+
+func NewUpdateStandingOrderHandler(updateStandingOrderUsecase standingOrderApp.IUpdateStandingOrderUsecase) *UpdateStandingOrderHandler {
+	return &UpdateStandingOrderHandler{
+		updateStandingOrderUC: updateStandingOrderUsecase,
+	}
+}
+
+type UpdateStandingOrderParams struct {
+	AccountID       string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+	StandingOrderID string `param:"standing_order_id" example:"01J9R8AJ1Q2YDH1X9836GS9F12"`
+}
+
+// 指定しない項目は変更しません。
+type UpdateStandingOrderRequestBody struct {
+	// 振込金額 (自動振込の通貨で指定)
+	Amount *json.Number `json:"amount" swaggertype:"number" example:"2000"`
+
+	// 終了日 (YYYYMMDD)
+	EndDate *string `json:"endDate" example:"20271031"`
+
+	// 実行回数の上限
+	MaxExecutions *int `json:"maxExecutions" example:"12"`
+
+	// 1回の実行日あたりの再試行回数 (0 ～ 5)
+	MaxRetries *int `json:"maxRetries" example:"3"`
+
+	// 再試行の上限に達した場合の方針 (SKIP, SUSPEND)
+	FailurePolicy *string `json:"failurePolicy" example:"SUSPEND"`
+
+	// 状態 (ACTIVE で再開、PAUSED で一時停止)
+	Status *string `json:"status" example:"PAUSED"`
+}
+
+type UpdateStandingOrderRequest struct {
+	UpdateStandingOrderParams
+	UpdateStandingOrderRequestBody
+}
+
+// @Summary 自動振込の変更
+// @Description 指定された自動振込の金額、終了条件、再試行の方針を変更、または一時停止・再開します。
+// @Description 再開した場合、停止中に過ぎた実行日の振込は行わず、今日以降の実行日から再開します。
+// @Tags Standing Order API
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param standing_order_id path string true "自動振込ID"
+// @Param request body UpdateStandingOrderRequestBody true "Request Body"
+// @Success 200 {object} StandingOrderResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 409 {object} response.ProblemDetail "Conflict"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/standing-orders/{standing_order_id} [patch]
+func (h *UpdateStandingOrderHandler) Run(ctx echo.Context) error {
+	req := new(UpdateStandingOrderRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	var amount *string
+	if req.Amount != nil {
+		s := req.Amount.String()
+		amount = &s
+	}
+	var endDate *time.Time
+	if req.EndDate != nil {
+		date, err := timer.ParseYYYYMMDD(*req.EndDate)
+		if err != nil {
+			return response.BadRequest(ctx, err)
+		}
+		endDate = &date
+	}
+
+	dto, err := h.updateStandingOrderUC.Run(ctx.Request().Context(), standingOrderApp.UpdateStandingOrderCommand{
+		UserID:          userID,
+		AccountID:       req.AccountID,
+		StandingOrderID: req.StandingOrderID,
+		Amount:          amount,
+		EndDate:         endDate,
+		MaxExecutions:   req.MaxExecutions,
+		MaxRetries:      req.MaxRetries,
+		FailurePolicy:   req.FailurePolicy,
+		Status:          req.Status,
+	})
+	if err != nil {
+		switch err {
+		case standingOrderDomain.ErrInvalidAmount,
+			standingOrderDomain.ErrInvalidEndDate,
+			moneyVO.ErrInvalidMoney,
+			moneyVO.ErrNegativeAmount,
+			moneyVO.ErrAmountOverflow:
+			return response.BadRequest(ctx, err)
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound,
+			standingOrderDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		case standingOrderDomain.ErrNotModifiable,
+			standingOrderDomain.ErrConcurrentModification:
+			return response.Conflict(ctx, err)
+		default:
+			// 金額の精度の誤りは通貨ごとのメッセージでラップされている為、errors.Is で判定します。
+			if errors.Is(err, moneyVO.ErrInvalidPrecision) {
+				return response.BadRequest(ctx, err)
+			}
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, newStandingOrderResponse(*dto))
+}
+
+func (h *UpdateStandingOrderHandler) validation(req *UpdateStandingOrderRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+
+	if err := validation.ValidULID(req.StandingOrderID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "standing_order_id",
+			Message: err.Error(),
+		})
+	}
+
+	if req.EndDate != nil {
+		if err := validation.ValidYYYYMMDD(*req.EndDate); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "endDate",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if req.MaxExecutions != nil {
+		if err := validation.ValidStandingOrderMaxExecutions(*req.MaxExecutions); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "maxExecutions",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if req.MaxRetries != nil {
+		if err := validation.ValidStandingOrderMaxRetries(*req.MaxRetries); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "maxRetries",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if req.FailurePolicy != nil {
+		if err := validation.ValidStandingOrderFailurePolicy(*req.FailurePolicy); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "failurePolicy",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if req.Status != nil {
+		if err := validation.ValidStandingOrderStatus(*req.Status); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "status",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return validationErrors
+}
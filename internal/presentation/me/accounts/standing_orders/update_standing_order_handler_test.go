@@ -0,0 +1,250 @@
+package standingorders_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	standingorders "github.com/u104rak1/pocgo/internal/presentation/me/accounts/standing_orders"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestUpdateStandingOrderHandler(t *testing.T) {
+	var (
+		accountID         = idVO.NewAccountIDForTest("account")
+		receiverAccountID = idVO.NewAccountIDForTest("receiver")
+		userID            = idVO.NewUserIDForTest("user")
+		standingOrderID   = idVO.NewStandingOrderIDForTest("standing-order")
+		amount            = json.Number("2000")
+		amountString      = "2000"
+		paused            = standingOrderDomain.StatusPaused
+		uri               = "/api/v1/me/accounts/" + accountID.String() + "/standing-orders/" + standingOrderID.String()
+		arg               = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+	happyRequestBody := standingorders.UpdateStandingOrderRequestBody{
+		Amount: &amount,
+		Status: &paused,
+	}
+
+	tests := []struct {
+		caseName             string
+		requestBody          interface{}
+		setupContext         func() context.Context
+		prepare              func(mockUpdateStandingOrderUC *appMock.MockIUpdateStandingOrderUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 自動振込の金額の変更と一時停止に成功する",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockUpdateStandingOrderUC *appMock.MockIUpdateStandingOrderUsecase) {
+				mockUpdateStandingOrderUC.EXPECT().Run(arg, standingOrderApp.UpdateStandingOrderCommand{
+					UserID:          userID.String(),
+					AccountID:       accountID.String(),
+					StandingOrderID: standingOrderID.String(),
+					Amount:          &amountString,
+					Status:          &paused,
+				}).Return(&standingOrderApp.StandingOrderDTO{
+					ID:                standingOrderID.String(),
+					AccountID:         accountID.String(),
+					ReceiverAccountID: receiverAccountID.String(),
+					Amount:            "2000",
+					Currency:          moneyVO.JPY,
+					Frequency:         standingOrderDomain.FrequencyWeekly,
+					StartDate:         "20261101",
+					MaxRetries:        standingOrderDomain.DefaultMaxRetries,
+					FailurePolicy:     standingOrderDomain.DefaultFailurePolicy,
+					Status:            standingOrderDomain.StatusPaused,
+					NextRunDate:       "20261101",
+					NextAttemptDate:   "20261101",
+					CreatedAt:         timer.GetFixedDateString(),
+					UpdatedAt:         timer.GetFixedDateString(),
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: standingorders.StandingOrderResponse{
+				ID:                standingOrderID.String(),
+				AccountID:         accountID.String(),
+				ReceiverAccountID: receiverAccountID.String(),
+				Amount:            json.Number("2000"),
+				Currency:          moneyVO.JPY,
+				Frequency:         standingOrderDomain.FrequencyWeekly,
+				StartDate:         "20261101",
+				MaxRetries:        standingOrderDomain.DefaultMaxRetries,
+				FailurePolicy:     standingOrderDomain.DefaultFailurePolicy,
+				Status:            standingOrderDomain.StatusPaused,
+				NextRunDate:       "20261101",
+				NextAttemptDate:   "20261101",
+				CreatedAt:         timer.GetFixedDateString(),
+				UpdatedAt:         timer.GetFixedDateString(),
+			},
+		},
+		{
+			caseName: "Negative: 利用者が変更できない状態を指定した場合、Validation Failed を返す",
+			requestBody: func() standingorders.UpdateStandingOrderRequestBody {
+				status := standingOrderDomain.StatusCompleted
+				return standingorders.UpdateStandingOrderRequestBody{Status: &status}
+			}(),
+			setupContext: happyContext,
+			prepare:      func(mockUpdateStandingOrderUC *appMock.MockIUpdateStandingOrderUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			requestBody:          happyRequestBody,
+			setupContext:         context.Background,
+			prepare:              func(mockUpdateStandingOrderUC *appMock.MockIUpdateStandingOrderUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 金額が通貨の精度を超える場合、Bad Request を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockUpdateStandingOrderUC *appMock.MockIUpdateStandingOrderUsecase) {
+				_, err := moneyVO.NewFromDecimal("0.5", moneyVO.JPY)
+				mockUpdateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, err)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: func() response.ProblemDetail {
+				_, err := moneyVO.NewFromDecimal("0.5", moneyVO.JPY)
+				return problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, err)
+			}(),
+		},
+		{
+			caseName:     "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockUpdateStandingOrderUC *appMock.MockIUpdateStandingOrderUsecase) {
+				mockUpdateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnauthorized),
+		},
+		{
+			caseName:     "Negative: 自動振込が見つからない場合、Not Found を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockUpdateStandingOrderUC *appMock.MockIUpdateStandingOrderUsecase) {
+				mockUpdateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, standingOrderDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, standingOrderDomain.ErrNotFound),
+		},
+		{
+			caseName:     "Negative: 解約済みの自動振込の場合、Conflict を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockUpdateStandingOrderUC *appMock.MockIUpdateStandingOrderUsecase) {
+				mockUpdateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, standingOrderDomain.ErrNotModifiable)
+			},
+			expectedCode:         http.StatusConflict,
+			expectedResponseBody: problem(http.StatusConflict, response.TypeURLConflict, response.TitleConflict, standingOrderDomain.ErrNotModifiable),
+		},
+		{
+			caseName:     "Negative: 他のリクエストが先に自動振込を更新していた場合、Conflict を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockUpdateStandingOrderUC *appMock.MockIUpdateStandingOrderUsecase) {
+				mockUpdateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, standingOrderDomain.ErrConcurrentModification)
+			},
+			expectedCode:         http.StatusConflict,
+			expectedResponseBody: problem(http.StatusConflict, response.TypeURLConflict, response.TitleConflict, standingOrderDomain.ErrConcurrentModification),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockUpdateStandingOrderUC *appMock.MockIUpdateStandingOrderUsecase) {
+				mockUpdateStandingOrderUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPatch, uri, bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id", "standing_order_id")
+			ctx.SetParamValues(accountID.String(), standingOrderID.String())
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockUpdateStandingOrderUC := appMock.NewMockIUpdateStandingOrderUsecase(ctrl)
+			tt.prepare(mockUpdateStandingOrderUC)
+
+			h := standingorders.NewUpdateStandingOrderHandler(mockUpdateStandingOrderUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp standingorders.StandingOrderResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package statements
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+)
+
+const camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"
+
+type camt053Writer struct {
+	w      io.Writer
+	header transactionApp.StatementHeaderDTO
+}
+
+// ISO 20022 camt.053.001.08 (BankToCustomerStatement) を出力します。
+// 金額は符号を含めずに出力し、入出金の向きは CdtDbtInd (CRDT / DBIT) で表します。
+func NewCamt053Writer(w io.Writer) transactionApp.IStatementWriter {
+	return &camt053Writer{w: w}
+}
+
+func formatISODate(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+func formatISODateTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// 金額を CdtDbtInd と符号の無い金額に分けます。
+func camt053Amount(decimal string) (amount, creditDebit string) {
+	amount, negative := splitSign(decimal)
+	if negative {
+		return amount, "DBIT"
+	}
+	return amount, "CRDT"
+}
+
+// ISO の取引コード (Domain / Family / SubFamily) を返します。
+func camt053BankTransactionCode(t transactionApp.ListTransactionDTO) (family, subFamily string) {
+	switch t.OperationType {
+	case transactionDomain.Deposit:
+		return "CNTR", "CDPT"
+	case transactionDomain.Withdrawal:
+		return "CNTR", "CWDL"
+	case transactionDomain.Reversal:
+		if t.Direction == transactionDomain.Credit {
+			return "MCOP", "OTHR"
+		}
+		return "MDOP", "OTHR"
+	default:
+		if t.Direction == transactionDomain.Credit {
+			return "RCDT", "BOOK"
+		}
+		return "ICDT", "BOOK"
+	}
+}
+
+func (c *camt053Writer) balance(code, decimal string, date time.Time) string {
+	amount, creditDebit := camt053Amount(decimal)
+	return fmt.Sprintf(`      <Bal>
+        <Tp>
+          <CdOrPrtry>
+            <Cd>%s</Cd>
+          </CdOrPrtry>
+        </Tp>
+        <Amt Ccy="%s">%s</Amt>
+        <CdtDbtInd>%s</CdtDbtInd>
+        <Dt>
+          <Dt>%s</Dt>
+        </Dt>
+      </Bal>
+`, code, escapeXML(c.header.Currency), escapeXML(amount), creditDebit, formatISODate(date))
+}
+
+func (c *camt053Writer) WriteHeader(header transactionApp.StatementHeaderDTO) error {
+	c.header = header
+	// 口座ID (26文字) と開始日 (8文字) から、Max35Text に収まる明細のIDを作成します。
+	statementID := fmt.Sprintf("%s-%s", header.AccountID, header.PeriodStart.UTC().Format("20060102"))
+
+	_, err := fmt.Fprintf(c.w, `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="%s">
+  <BkToCstmrStmt>
+    <GrpHdr>
+      <MsgId>%s</MsgId>
+      <CreDtTm>%s</CreDtTm>
+    </GrpHdr>
+    <Stmt>
+      <Id>%s</Id>
+      <CreDtTm>%s</CreDtTm>
+      <FrToDt>
+        <FrDtTm>%s</FrDtTm>
+        <ToDtTm>%s</ToDtTm>
+      </FrToDt>
+      <Acct>
+        <Id>
+          <Othr>
+            <Id>%s</Id>
+          </Othr>
+        </Id>
+        <Ccy>%s</Ccy>
+        <Nm>%s</Nm>
+      </Acct>
+%s%s`,
+		camt053Namespace,
+		escapeXML(statementID),
+		formatISODateTime(header.GeneratedAt),
+		escapeXML(statementID),
+		formatISODateTime(header.GeneratedAt),
+		formatISODateTime(header.PeriodStart),
+		formatISODateTime(periodLastSecond(header)),
+		escapeXML(header.AccountID),
+		escapeXML(header.Currency),
+		escapeXML(header.AccountName),
+		c.balance("OPBD", header.OpeningBalance, header.PeriodStart),
+		c.balance("CLBD", header.ClosingBalance, periodLastSecond(header)),
+	)
+	return err
+}
+
+func (c *camt053Writer) WriteTransaction(t transactionApp.ListTransactionDTO) error {
+	transactionAt, err := time.Parse(time.RFC3339, t.TransactionAt)
+	if err != nil {
+		return err
+	}
+
+	amount, creditDebit := camt053Amount(t.SignedAmount)
+	family, subFamily := camt053BankTransactionCode(t)
+
+	relatedParties := ""
+	if id := counterpartyAccountID(c.header, t); id != nil {
+		// 受け取った振込では相手口座は支払人 (Dbtr)、送金した振込では受取人 (Cdtr) の口座になります。
+		party := "CdtrAcct"
+		if creditDebit == "CRDT" {
+			party = "DbtrAcct"
+		}
+		relatedParties = fmt.Sprintf(`
+            <RltdPties>
+              <%[1]s>
+                <Id>
+                  <Othr>
+                    <Id>%[2]s</Id>
+                  </Othr>
+                </Id>
+              </%[1]s>
+            </RltdPties>`, party, escapeXML(*id))
+	}
+
+	_, err = fmt.Fprintf(c.w, `      <Ntry>
+        <NtryRef>%[1]s</NtryRef>
+        <Amt Ccy="%[2]s">%[3]s</Amt>
+        <CdtDbtInd>%[4]s</CdtDbtInd>
+        <Sts>
+          <Cd>BOOK</Cd>
+        </Sts>
+        <BookgDt>
+          <DtTm>%[5]s</DtTm>
+        </BookgDt>
+        <ValDt>
+          <Dt>%[6]s</Dt>
+        </ValDt>
+        <AcctSvcrRef>%[1]s</AcctSvcrRef>
+        <BkTxCd>
+          <Domn>
+            <Cd>PMNT</Cd>
+            <Fmly>
+              <Cd>%[7]s</Cd>
+              <SubFmlyCd>%[8]s</SubFmlyCd>
+            </Fmly>
+          </Domn>
+          <Prtry>
+            <Cd>%[9]s</Cd>
+          </Prtry>
+        </BkTxCd>
+        <NtryDtls>
+          <TxDtls>
+            <Refs>
+              <AcctSvcrRef>%[1]s</AcctSvcrRef>
+            </Refs>%[10]s
+          </TxDtls>
+        </NtryDtls>
+      </Ntry>
+`,
+		escapeXML(t.ID),
+		escapeXML(c.header.Currency),
+		escapeXML(amount),
+		creditDebit,
+		formatISODateTime(transactionAt),
+		formatISODate(transactionAt),
+		family,
+		subFamily,
+		escapeXML(t.OperationType),
+		relatedParties,
+	)
+	return err
+}
+
+func (c *camt053Writer) WriteFooter() error {
+	_, err := io.WriteString(c.w, `    </Stmt>
+  </BkToCstmrStmt>
+</Document>
+`)
+	return err
+}
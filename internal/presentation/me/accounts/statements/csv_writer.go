@@ -0,0 +1,67 @@
+package statements
+
+import (
+	"encoding/csv"
+	"io"
+	"time"
+
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+)
+
+// CSV の各行の種別です。期首残高、取引、期末残高の順に出力します。
+const (
+	csvRecordOpeningBalance = "OPENING_BALANCE"
+	csvRecordTransaction    = "TRANSACTION"
+	csvRecordClosingBalance = "CLOSING_BALANCE"
+)
+
+var csvColumns = []string{
+	"record_type", "booked_at", "transaction_id", "operation_type", "direction",
+	"amount", "currency", "balance", "counterparty_account_id",
+}
+
+type csvWriter struct {
+	w      *csv.Writer
+	header transactionApp.StatementHeaderDTO
+}
+
+// 1行目を列名とし、金額は口座の通貨の小数点以下の桁数に合わせた符号付きの10進数表記で出力します。
+func NewCSVWriter(w io.Writer) transactionApp.IStatementWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) WriteHeader(header transactionApp.StatementHeaderDTO) error {
+	c.header = header
+	if err := c.w.Write(csvColumns); err != nil {
+		return err
+	}
+	return c.w.Write([]string{
+		csvRecordOpeningBalance, header.PeriodStart.Format(time.RFC3339), "", "", "",
+		"", header.Currency, header.OpeningBalance, "",
+	})
+}
+
+func (c *csvWriter) WriteTransaction(t transactionApp.ListTransactionDTO) error {
+	var balance, counterparty string
+	if t.BalanceAfter != nil {
+		balance = *t.BalanceAfter
+	}
+	if id := counterpartyAccountID(c.header, t); id != nil {
+		counterparty = *id
+	}
+	return c.w.Write([]string{
+		csvRecordTransaction, t.TransactionAt, t.ID, t.OperationType, t.Direction,
+		t.SignedAmount, c.header.Currency, balance, counterparty,
+	})
+}
+
+func (c *csvWriter) WriteFooter() error {
+	if err := c.w.Write([]string{
+		csvRecordClosingBalance, periodLastSecond(c.header).Format(time.RFC3339), "", "", "",
+		"", c.header.Currency, c.header.ClosingBalance, "",
+	}); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
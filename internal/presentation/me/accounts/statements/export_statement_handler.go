@@ -0,0 +1,163 @@
+package statements
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type ExportStatementHandler struct {
+	exportStatementUC transactionApp.IExportStatementUsecase
+}
+
+func NewExportStatementHandler(exportStatementUC transactionApp.IExportStatementUsecase) *ExportStatementHandler {
+	return &ExportStatementHandler{
+		exportStatementUC: exportStatementUC,
+	}
+}
+
+type ExportStatementParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type ExportStatementQuery struct {
+	From   string `query:"from" example:"20240101"`
+	To     string `query:"to" example:"20241231"`
+	Format string `query:"format" example:"csv"`
+}
+
+type ExportStatementRequest struct {
+	ExportStatementParams
+	ExportStatementQuery
+}
+
+// @Summary 取引明細のエクスポート
+// @Description 指定された期間の取引明細を CSV、OFX、ISO 20022 camt.053 のいずれかの形式で出力します。
+// @Description 期間内の全ての取引を件数の上限なく出力し、期首残高と期末残高を含みます。金額は口座の通貨の小数点以下の桁数で出力します。
+// @Tags Transaction API
+// @Security BearerAuth
+// @Produce text/csv,application/x-ofx,application/xml,json
+// @Param account_id path string true "口座ID"
+// @Param from query string true "開始日 (YYYYMMDD)"
+// @Param to query string true "終了日 (YYYYMMDD、終了日の取引も含みます)"
+// @Param format query string true "出力形式" Enums(csv, ofx, camt053)
+// @Success 200 {file} file "取引明細"
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/statements [get]
+func (h *ExportStatementHandler) Run(ctx echo.Context) error {
+	req := new(ExportStatementRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	from, err := timer.ParseYYYYMMDD(req.From)
+	if err != nil {
+		return response.BadRequest(ctx, err)
+	}
+	to, err := timer.ParseYYYYMMDD(req.To)
+	if err != nil {
+		return response.BadRequest(ctx, err)
+	}
+
+	attachment := &attachmentWriter{ctx: ctx}
+	writer, contentType, extension := NewStatementWriter(req.Format, attachment)
+	attachment.contentType = contentType
+	attachment.filename = fmt.Sprintf("statement_%s_%s_%s.%s", req.AccountID, req.From, req.To, extension)
+
+	err = h.exportStatementUC.Run(ctx.Request().Context(), transactionApp.ExportStatementCommand{
+		UserID:    userID,
+		AccountID: req.AccountID,
+		From:      from,
+		To:        to,
+	}, writer)
+	if err != nil {
+		// 書き出しを始めた後はステータスコードを変更できない為、エラーをそのまま返して記録のみ行います。
+		if ctx.Response().Committed {
+			return err
+		}
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return nil
+}
+
+// 最初の書き込み時にファイルとしてのレスポンスヘッダーを付けて書き出す io.Writer です。
+// 書き出しの前にエラーとなった場合は、通常のエラーレスポンスを返せます。
+type attachmentWriter struct {
+	ctx         echo.Context
+	contentType string
+	filename    string
+}
+
+func (w *attachmentWriter) Write(p []byte) (int, error) {
+	res := w.ctx.Response()
+	if !res.Committed {
+		res.Header().Set(echo.HeaderContentType, w.contentType)
+		res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, w.filename))
+	}
+	return res.Write(p)
+}
+
+func (h *ExportStatementHandler) validation(req *ExportStatementRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "param.account_id",
+			Message: err.Error(),
+		})
+	}
+	fromErr := validation.ValidStatementDate(req.From)
+	if fromErr != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "query.from",
+			Message: fromErr.Error(),
+		})
+	}
+	toErr := validation.ValidStatementDate(req.To)
+	if toErr != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "query.to",
+			Message: toErr.Error(),
+		})
+	}
+	if fromErr == nil && toErr == nil {
+		if err := validation.ValidateDateRange(req.From, req.To); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "query.from",
+				Message: err.Error(),
+			})
+		}
+	}
+	if err := validation.ValidStatementFormat(req.Format); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "query.format",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,229 @@
+package statements_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/statements"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestExportStatementHandler(t *testing.T) {
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		from      = timer.GetFixedDate()
+		to        = from.AddDate(0, 0, 30)
+		arg       = gomock.Any()
+		path      = "/api/v1/me/accounts/" + accountID.String() + "/statements"
+		query     = "?from=20210101&to=20210131&format=csv"
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+
+	tests := []struct {
+		caseName             string
+		accountID            string
+		query                string
+		setupContext         func() context.Context
+		prepare              func(mockExportStatementUC *appMock.MockIExportStatementUsecase)
+		expectedCode         int
+		expectedBody         string
+		expectedResponseBody interface{}
+		expectedErrors       int
+	}{
+		{
+			caseName:     "Positive: 取引明細をCSVで出力できる",
+			accountID:    accountID.String(),
+			query:        query,
+			setupContext: happyContext,
+			prepare: func(mockExportStatementUC *appMock.MockIExportStatementUsecase) {
+				mockExportStatementUC.EXPECT().Run(arg, transactionApp.ExportStatementCommand{
+					UserID:    userID.String(),
+					AccountID: accountID.String(),
+					From:      from,
+					To:        to,
+				}, arg).DoAndReturn(func(_ context.Context, _ transactionApp.ExportStatementCommand, writer transactionApp.IStatementWriter) error {
+					if err := writer.WriteHeader(transactionApp.StatementHeaderDTO{
+						AccountID:      accountID.String(),
+						Currency:       moneyVO.JPY,
+						PeriodStart:    from,
+						PeriodEnd:      to.AddDate(0, 0, 1),
+						OpeningBalance: "0",
+						ClosingBalance: "0",
+					}); err != nil {
+						return err
+					}
+					return writer.WriteFooter()
+				})
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "record_type,booked_at,transaction_id,operation_type,direction,amount,currency,balance,counterparty_account_id\n" +
+				"OPENING_BALANCE,2021-01-01T00:00:00Z,,,,,JPY,0,\n" +
+				"CLOSING_BALANCE,2021-01-31T23:59:59Z,,,,,JPY,0,\n",
+		},
+		{
+			caseName:     "Negative: 口座ID、期間、出力形式が不正な場合、Validation Failed を返す",
+			accountID:    "invalid",
+			query:        "?from=2021-01-01&format=pdf",
+			setupContext: happyContext,
+			prepare:      func(mockExportStatementUC *appMock.MockIExportStatementUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: "/api/v1/me/accounts/invalid/statements",
+				},
+			},
+			expectedErrors: 4,
+		},
+		{
+			caseName:     "Negative: 終了日が開始日より前の場合、Validation Failed を返す",
+			accountID:    accountID.String(),
+			query:        "?from=20210201&to=20210101&format=csv",
+			setupContext: happyContext,
+			prepare:      func(mockExportStatementUC *appMock.MockIExportStatementUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: path,
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			caseName:     "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			accountID:    accountID.String(),
+			query:        query,
+			setupContext: func() context.Context { return context.Background() },
+			prepare:      func(mockExportStatementUC *appMock.MockIExportStatementUsecase) {},
+			expectedCode: http.StatusUnauthorized,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLUnauthorized,
+				Title:    response.TitleUnauthorized,
+				Status:   http.StatusUnauthorized,
+				Detail:   config.ErrUserIDMissing.Error(),
+				Instance: path,
+			},
+		},
+		{
+			caseName:     "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			accountID:    accountID.String(),
+			query:        query,
+			setupContext: happyContext,
+			prepare: func(mockExportStatementUC *appMock.MockIExportStatementUsecase) {
+				mockExportStatementUC.EXPECT().Run(arg, arg, arg).Return(accountDomain.ErrUnauthorized)
+			},
+			expectedCode: http.StatusForbidden,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLForbidden,
+				Title:    response.TitleForbidden,
+				Status:   http.StatusForbidden,
+				Detail:   accountDomain.ErrUnauthorized.Error(),
+				Instance: path,
+			},
+		},
+		{
+			caseName:     "Negative: 口座が見つからない場合、Not Found を返す",
+			accountID:    accountID.String(),
+			query:        query,
+			setupContext: happyContext,
+			prepare: func(mockExportStatementUC *appMock.MockIExportStatementUsecase) {
+				mockExportStatementUC.EXPECT().Run(arg, arg, arg).Return(accountDomain.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLNotFound,
+				Title:    response.TitleNotFound,
+				Status:   http.StatusNotFound,
+				Detail:   accountDomain.ErrNotFound.Error(),
+				Instance: path,
+			},
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			accountID:    accountID.String(),
+			query:        query,
+			setupContext: happyContext,
+			prepare: func(mockExportStatementUC *appMock.MockIExportStatementUsecase) {
+				mockExportStatementUC.EXPECT().Run(arg, arg, arg).Return(assert.AnError)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLInternalServerError,
+				Title:    response.TitleInternalServerError,
+				Status:   http.StatusInternalServerError,
+				Detail:   assert.AnError.Error(),
+				Instance: path,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/me/accounts/"+tt.accountID+"/statements"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id")
+			ctx.SetParamValues(tt.accountID)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockExportStatementUC := appMock.NewMockIExportStatementUsecase(ctrl)
+			tt.prepare(mockExportStatementUC)
+
+			h := statements.NewExportStatementHandler(mockExportStatementUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "text/csv; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t,
+					`attachment; filename="statement_`+accountID.String()+`_20210101_20210131.csv"`,
+					rec.Header().Get(echo.HeaderContentDisposition))
+				assert.Equal(t, tt.expectedBody, rec.Body.String())
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Len(t, resp.Errors, tt.expectedErrors)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package statements
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+)
+
+// OFX の金融機関IDです。
+const ofxBankID = "POCGO"
+
+type ofxWriter struct {
+	w      io.Writer
+	header transactionApp.StatementHeaderDTO
+}
+
+// OFX 2.2 の銀行取引明細 (STMTRS) を出力します。
+// OFX には期首残高の要素が無い為、期首残高は BALLIST に、期末残高は LEDGERBAL に出力します。
+func NewOFXWriter(w io.Writer) transactionApp.IStatementWriter {
+	return &ofxWriter{w: w}
+}
+
+// OFX の日時形式 (YYYYMMDDHHMMSS.XXX[gmt offset:tz name]) に変換します。
+func formatOFXDateTime(t time.Time) string {
+	return t.UTC().Format("20060102150405.000") + "[0:GMT]"
+}
+
+func ofxTransactionType(operationType string) string {
+	switch operationType {
+	case transactionDomain.Deposit:
+		return "DEP"
+	case transactionDomain.Withdrawal:
+		return "DEBIT"
+	case transactionDomain.Reversal:
+		// 取消は入金と出金のどちらにも当たらない為、増減の向きは金額の符号で表す
+		return "OTHER"
+	default:
+		return "XFER"
+	}
+}
+
+func (o *ofxWriter) WriteHeader(header transactionApp.StatementHeaderDTO) error {
+	o.header = header
+	_, err := fmt.Fprintf(o.w, `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+  <SIGNONMSGSRSV1>
+    <SONRS>
+      <STATUS>
+        <CODE>0</CODE>
+        <SEVERITY>INFO</SEVERITY>
+      </STATUS>
+      <DTSERVER>%s</DTSERVER>
+      <LANGUAGE>JPN</LANGUAGE>
+    </SONRS>
+  </SIGNONMSGSRSV1>
+  <BANKMSGSRSV1>
+    <STMTTRNRS>
+      <TRNUID>0</TRNUID>
+      <STATUS>
+        <CODE>0</CODE>
+        <SEVERITY>INFO</SEVERITY>
+      </STATUS>
+      <STMTRS>
+        <CURDEF>%s</CURDEF>
+        <BANKACCTFROM>
+          <BANKID>%s</BANKID>
+          <ACCTID>%s</ACCTID>
+          <ACCTTYPE>CHECKING</ACCTTYPE>
+        </BANKACCTFROM>
+        <BANKTRANLIST>
+          <DTSTART>%s</DTSTART>
+          <DTEND>%s</DTEND>
+`,
+		formatOFXDateTime(header.GeneratedAt),
+		escapeXML(header.Currency),
+		ofxBankID,
+		escapeXML(header.AccountID),
+		formatOFXDateTime(header.PeriodStart),
+		formatOFXDateTime(periodLastSecond(header)),
+	)
+	return err
+}
+
+func (o *ofxWriter) WriteTransaction(t transactionApp.ListTransactionDTO) error {
+	transactionAt, err := time.Parse(time.RFC3339, t.TransactionAt)
+	if err != nil {
+		return err
+	}
+
+	memo := ""
+	if id := counterpartyAccountID(o.header, t); id != nil {
+		memo = fmt.Sprintf("\n            <MEMO>%s</MEMO>", escapeXML(*id))
+	}
+
+	_, err = fmt.Fprintf(o.w, `          <STMTTRN>
+            <TRNTYPE>%s</TRNTYPE>
+            <DTPOSTED>%s</DTPOSTED>
+            <TRNAMT>%s</TRNAMT>
+            <FITID>%s</FITID>
+            <NAME>%s</NAME>%s
+          </STMTTRN>
+`,
+		ofxTransactionType(t.OperationType),
+		formatOFXDateTime(transactionAt),
+		escapeXML(t.SignedAmount),
+		escapeXML(t.ID),
+		escapeXML(t.OperationType),
+		memo,
+	)
+	return err
+}
+
+func (o *ofxWriter) WriteFooter() error {
+	_, err := fmt.Fprintf(o.w, `        </BANKTRANLIST>
+        <LEDGERBAL>
+          <BALAMT>%s</BALAMT>
+          <DTASOF>%s</DTASOF>
+        </LEDGERBAL>
+        <BALLIST>
+          <BAL>
+            <NAME>OPENING BALANCE</NAME>
+            <DESC>Balance at the start of the statement period</DESC>
+            <BALTYPE>DOLLAR</BALTYPE>
+            <VALUE>%s</VALUE>
+            <DTASOF>%s</DTASOF>
+          </BAL>
+        </BALLIST>
+      </STMTRS>
+    </STMTTRNRS>
+  </BANKMSGSRSV1>
+</OFX>
+`,
+		escapeXML(o.header.ClosingBalance),
+		formatOFXDateTime(periodLastSecond(o.header)),
+		escapeXML(o.header.OpeningBalance),
+		formatOFXDateTime(o.header.PeriodStart),
+	)
+	return err
+}
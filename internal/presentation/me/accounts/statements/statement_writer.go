@@ -0,0 +1,52 @@
+package statements
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+)
+
+// 出力形式に対応する取引明細の書き込み処理と、レスポンスの Content-Type、ファイルの拡張子を返します。
+func NewStatementWriter(format string, w io.Writer) (writer transactionApp.IStatementWriter, contentType, extension string) {
+	switch format {
+	case transactionApp.StatementFormatOFX:
+		return NewOFXWriter(w), "application/x-ofx", "ofx"
+	case transactionApp.StatementFormatCamt053:
+		return NewCamt053Writer(w), "application/xml; charset=UTF-8", "xml"
+	default:
+		return NewCSVWriter(w), "text/csv; charset=UTF-8", "csv"
+	}
+}
+
+// 明細の対象期間の最終日時（秒単位）を返します。PeriodEnd は期間に含まない為、その1秒前になります。
+func periodLastSecond(header transactionApp.StatementHeaderDTO) time.Time {
+	return header.PeriodEnd.Add(-time.Second)
+}
+
+// 振込の相手口座のIDを返します。入金と出金の場合は nil です。
+func counterpartyAccountID(header transactionApp.StatementHeaderDTO, t transactionApp.ListTransactionDTO) *string {
+	if t.ReceiverAccountID == nil {
+		return nil
+	}
+	if t.AccountID == header.AccountID {
+		return t.ReceiverAccountID
+	}
+	return &t.AccountID
+}
+
+// 符号付きの10進数表記の金額を、符号を除いた金額と負の値かどうかに分けます。
+func splitSign(decimal string) (amount string, negative bool) {
+	if strings.HasPrefix(decimal, "-") {
+		return decimal[1:], true
+	}
+	return decimal, false
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
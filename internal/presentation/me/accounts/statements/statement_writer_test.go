@@ -0,0 +1,183 @@
+package statements_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/sebdah/goldie/v2"
+	"github.com/stretchr/testify/assert"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/statements"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+type statementFixture struct {
+	header       transactionApp.StatementHeaderDTO
+	transactions []transactionApp.ListTransactionDTO
+}
+
+func newStatementFixtures() map[string]statementFixture {
+	accountID := idVO.NewAccountIDForTest("account").String()
+	otherAccountID := idVO.NewAccountIDForTest("other").String()
+	periodStart := timer.GetFixedDate()
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	at := func(days int) string {
+		return timer.FormatToISO8601(periodStart.AddDate(0, 0, days).Add(9 * time.Hour))
+	}
+
+	return map[string]statementFixture{
+		"jpy": {
+			header: transactionApp.StatementHeaderDTO{
+				AccountID:      accountID,
+				AccountName:    "生活費 & 貯金",
+				Currency:       moneyVO.JPY,
+				PeriodStart:    periodStart,
+				PeriodEnd:      periodEnd,
+				OpeningBalance: "1000",
+				ClosingBalance: "1700",
+				GeneratedAt:    periodEnd,
+			},
+			transactions: []transactionApp.ListTransactionDTO{
+				{
+					ID: idVO.NewTransactionIDForTest("deposit").String(), AccountID: accountID,
+					OperationType: transactionDomain.Deposit, Amount: "1000", Currency: moneyVO.JPY,
+					Direction: transactionDomain.Credit, SignedAmount: "1000", BalanceAfter: strutil.StrPointer("2000"),
+					TransactionAt: at(1),
+				},
+				{
+					ID: idVO.NewTransactionIDForTest("withdrawal").String(), AccountID: accountID,
+					OperationType: transactionDomain.Withdrawal, Amount: "300", Currency: moneyVO.JPY,
+					Direction: transactionDomain.Debit, SignedAmount: "-300", BalanceAfter: strutil.StrPointer("1700"),
+					TransactionAt: at(2),
+				},
+				{
+					ID: idVO.NewTransactionIDForTest("transfer_out").String(), AccountID: accountID,
+					ReceiverAccountID: &otherAccountID,
+					OperationType:     transactionDomain.Transfer, Amount: "500", Currency: moneyVO.JPY,
+					Direction: transactionDomain.Debit, SignedAmount: "-500", BalanceAfter: strutil.StrPointer("1200"),
+					TransactionAt: at(3),
+				},
+				{
+					// 残高の記録を始める前の取引は取引後の残高がありません。
+					ID: idVO.NewTransactionIDForTest("transfer_in").String(), AccountID: otherAccountID,
+					ReceiverAccountID: &accountID,
+					OperationType:     transactionDomain.Transfer, Amount: "3.34", Currency: moneyVO.USD,
+					ReceiverAmount: strutil.StrPointer("500"), ReceiverCurrency: strutil.StrPointer(moneyVO.JPY),
+					Direction: transactionDomain.Credit, SignedAmount: "500",
+					TransactionAt: at(4),
+				},
+			},
+		},
+		"usd": {
+			header: transactionApp.StatementHeaderDTO{
+				AccountID:      accountID,
+				AccountName:    "USD",
+				Currency:       moneyVO.USD,
+				PeriodStart:    periodStart,
+				PeriodEnd:      periodEnd,
+				OpeningBalance: "0.00",
+				ClosingBalance: "10.50",
+				GeneratedAt:    periodEnd,
+			},
+			transactions: []transactionApp.ListTransactionDTO{
+				{
+					ID: idVO.NewTransactionIDForTest("deposit").String(), AccountID: accountID,
+					OperationType: transactionDomain.Deposit, Amount: "10.50", Currency: moneyVO.USD,
+					Direction: transactionDomain.Credit, SignedAmount: "10.50", BalanceAfter: strutil.StrPointer("10.50"),
+					TransactionAt: at(1),
+				},
+				{
+					ID: idVO.NewTransactionIDForTest("mistaken").String(), AccountID: accountID,
+					OperationType: transactionDomain.Deposit, Amount: "1.10", Currency: moneyVO.USD,
+					Direction: transactionDomain.Credit, SignedAmount: "1.10", BalanceAfter: strutil.StrPointer("11.60"),
+					TransactionAt: at(2),
+				},
+				{
+					ID: idVO.NewTransactionIDForTest("reversal").String(), AccountID: accountID,
+					OperationType:         transactionDomain.Reversal,
+					ReversedTransactionID: strutil.StrPointer(idVO.NewTransactionIDForTest("mistaken").String()),
+					Amount:                "1.10", Currency: moneyVO.USD,
+					Direction: transactionDomain.Debit, SignedAmount: "-1.10", BalanceAfter: strutil.StrPointer("10.50"),
+					TransactionAt: at(3),
+				},
+			},
+		},
+	}
+}
+
+func TestStatementWriters(t *testing.T) {
+	fixtures := newStatementFixtures()
+	gol := goldie.New(t, goldie.WithFixtureDir("testdata"), goldie.WithDiffEngine(goldie.ColoredDiff))
+
+	tests := []struct {
+		caseName string
+		fixture  string
+		format   string
+	}{
+		{caseName: "Positive: JPY口座の取引明細をCSVで出力できる", fixture: "jpy", format: transactionApp.StatementFormatCSV},
+		{caseName: "Positive: JPY口座の取引明細をOFXで出力できる", fixture: "jpy", format: transactionApp.StatementFormatOFX},
+		{caseName: "Positive: JPY口座の取引明細をcamt.053で出力できる", fixture: "jpy", format: transactionApp.StatementFormatCamt053},
+		{caseName: "Positive: USD口座の取引明細をCSVで出力できる", fixture: "usd", format: transactionApp.StatementFormatCSV},
+		{caseName: "Positive: USD口座の取引明細をOFXで出力できる", fixture: "usd", format: transactionApp.StatementFormatOFX},
+		{caseName: "Positive: USD口座の取引明細をcamt.053で出力できる", fixture: "usd", format: transactionApp.StatementFormatCamt053},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			fixture := fixtures[tt.fixture]
+			var buf bytes.Buffer
+			writer, _, _ := statements.NewStatementWriter(tt.format, &buf)
+
+			assert.NoError(t, writer.WriteHeader(fixture.header))
+			for _, transaction := range fixture.transactions {
+				assert.NoError(t, writer.WriteTransaction(transaction))
+			}
+			assert.NoError(t, writer.WriteFooter())
+
+			gol.Assert(t, "statement_"+tt.fixture+"_"+tt.format, buf.Bytes())
+		})
+	}
+}
+
+func TestNewStatementWriter(t *testing.T) {
+	tests := []struct {
+		caseName        string
+		format          string
+		wantContentType string
+		wantExtension   string
+	}{
+		{
+			caseName:        "Positive: csv の場合、CSVの Content-Type と拡張子を返す",
+			format:          transactionApp.StatementFormatCSV,
+			wantContentType: "text/csv; charset=UTF-8",
+			wantExtension:   "csv",
+		},
+		{
+			caseName:        "Positive: ofx の場合、OFXの Content-Type と拡張子を返す",
+			format:          transactionApp.StatementFormatOFX,
+			wantContentType: "application/x-ofx",
+			wantExtension:   "ofx",
+		},
+		{
+			caseName:        "Positive: camt053 の場合、XMLの Content-Type と拡張子を返す",
+			format:          transactionApp.StatementFormatCamt053,
+			wantContentType: "application/xml; charset=UTF-8",
+			wantExtension:   "xml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			writer, contentType, extension := statements.NewStatementWriter(tt.format, &bytes.Buffer{})
+			assert.NotNil(t, writer)
+			assert.Equal(t, tt.wantContentType, contentType)
+			assert.Equal(t, tt.wantExtension, extension)
+		})
+	}
+}
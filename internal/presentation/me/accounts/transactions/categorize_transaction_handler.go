@@ -0,0 +1,120 @@
+package transactions
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type CategorizeTransactionHandler struct {
+	categorizeTransactionUC transactionApp.ICategorizeTransactionUsecase
+}
+
+func NewCategorizeTransactionHandler(categorizeTransactionUC transactionApp.ICategorizeTransactionUsecase) *CategorizeTransactionHandler {
+	return &CategorizeTransactionHandler{
+		categorizeTransactionUC: categorizeTransactionUC,
+	}
+}
+
+type CategorizeTransactionParams struct {
+	AccountID     string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+	TransactionID string `param:"transaction_id" example:"01J9R8AJ1Q2YDH1X9836GS9E89"`
+}
+
+type CategorizeTransactionRequestBody struct {
+	// 分類 (最大10個、1つあたり最大30文字、カンマは使用不可 空の配列の場合は分類を全て外します)
+	Categories []string `json:"categories" example:"food,travel"`
+}
+
+type CategorizeTransactionRequest struct {
+	CategorizeTransactionParams
+	CategorizeTransactionRequestBody
+}
+
+// @Summary 取引の分類の設定
+// @Description 指定された口座として取引に分類を付けます。既に付けている分類は全て置き換えます。
+// @Description 振込の場合、送金元と受取口座はそれぞれ別の分類を付けられます。
+// @Tags Transaction API
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param transaction_id path string true "取引ID"
+// @Param request body CategorizeTransactionRequestBody true "Request Body"
+// @Success 200 {object} ReadTransactionResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/transactions/{transaction_id}/categories [put]
+func (h *CategorizeTransactionHandler) Run(ctx echo.Context) error {
+	req := new(CategorizeTransactionRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.categorizeTransactionUC.Run(ctx.Request().Context(), transactionApp.CategorizeTransactionCommand{
+		UserID:        userID,
+		AccountID:     req.AccountID,
+		TransactionID: req.TransactionID,
+		Categories:    req.Categories,
+	})
+	if err != nil {
+		switch err {
+		case transactionDomain.ErrInvalidCategory,
+			transactionDomain.ErrTooManyCategories:
+			return response.BadRequest(ctx, err)
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound, transactionDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, newReadTransactionResponse(dto))
+}
+
+func (h *CategorizeTransactionHandler) validation(req *CategorizeTransactionRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "param.account_id",
+			Message: err.Error(),
+		})
+	}
+	if err := validation.ValidULID(req.TransactionID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "param.transaction_id",
+			Message: err.Error(),
+		})
+	}
+	if req.Categories == nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "categories",
+			Message: "is required",
+		})
+	} else if err := validation.ValidTransactionCategories(req.Categories); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "categories",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
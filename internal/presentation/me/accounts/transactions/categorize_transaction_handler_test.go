@@ -0,0 +1,262 @@
+package transactions_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/transactions"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestCategorizeTransactionHandler(t *testing.T) {
+	var (
+		userID        = idVO.NewUserIDForTest("user")
+		accountID     = idVO.NewAccountIDForTest("account")
+		transactionID = idVO.NewTransactionIDForTest("transaction")
+		transactionAt = timer.GetFixedDateString()
+		arg           = gomock.Any()
+		uri           = "/api/v1/me/accounts/" + accountID.String() + "/transactions/" + transactionID.String() + "/categories"
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+
+	happyRequestBody := map[string]interface{}{
+		"categories": []string{"travel", "food"},
+	}
+
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		requestBody          interface{}
+		setupContext         func() context.Context
+		prepare              func(mockCategorizeTransactionUC *appMock.MockICategorizeTransactionUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 取引の分類の設定に成功する",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockCategorizeTransactionUC *appMock.MockICategorizeTransactionUsecase) {
+				mockCategorizeTransactionUC.EXPECT().Run(arg, transactionApp.CategorizeTransactionCommand{
+					UserID:        userID.String(),
+					AccountID:     accountID.String(),
+					TransactionID: transactionID.String(),
+					Categories:    []string{"travel", "food"},
+				}).Return(&transactionApp.ReadTransactionDTO{
+					ID:            transactionID.String(),
+					AccountID:     accountID.String(),
+					OperationType: transactionDomain.Withdrawal,
+					Amount:        "1000",
+					Currency:      money.JPY,
+					Direction:     transactionDomain.Debit,
+					SignedAmount:  "-1000",
+					Categories:    []string{"food", "travel"},
+					TransactionAt: transactionAt,
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: transactions.ReadTransactionResponse{
+				ID:            transactionID.String(),
+				AccountID:     accountID.String(),
+				OperationType: transactionDomain.Withdrawal,
+				Amount:        "1000",
+				Currency:      money.JPY,
+				Direction:     transactionDomain.Debit,
+				SignedAmount:  "-1000",
+				Categories:    []string{"food", "travel"},
+				TransactionAt: transactionAt,
+			},
+		},
+		{
+			caseName:     "Positive: 空の配列を指定すると分類を全て外せる",
+			requestBody:  map[string]interface{}{"categories": []string{}},
+			setupContext: happyContext,
+			prepare: func(mockCategorizeTransactionUC *appMock.MockICategorizeTransactionUsecase) {
+				mockCategorizeTransactionUC.EXPECT().Run(arg, transactionApp.CategorizeTransactionCommand{
+					UserID:        userID.String(),
+					AccountID:     accountID.String(),
+					TransactionID: transactionID.String(),
+					Categories:    []string{},
+				}).Return(&transactionApp.ReadTransactionDTO{
+					ID:            transactionID.String(),
+					AccountID:     accountID.String(),
+					OperationType: transactionDomain.Deposit,
+					Amount:        "1000",
+					Currency:      money.JPY,
+					Direction:     transactionDomain.Credit,
+					SignedAmount:  "1000",
+					Categories:    []string{},
+					TransactionAt: transactionAt,
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: transactions.ReadTransactionResponse{
+				ID:            transactionID.String(),
+				AccountID:     accountID.String(),
+				OperationType: transactionDomain.Deposit,
+				Amount:        "1000",
+				Currency:      money.JPY,
+				Direction:     transactionDomain.Credit,
+				SignedAmount:  "1000",
+				Categories:    []string{},
+				TransactionAt: transactionAt,
+			},
+		},
+		{
+			caseName:     "Negative: 分類が指定されていない場合、Validation Failed を返す",
+			requestBody:  map[string]interface{}{},
+			setupContext: happyContext,
+			prepare:      func(mockCategorizeTransactionUC *appMock.MockICategorizeTransactionUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:     "Negative: カンマを含む分類の場合、Validation Failed を返す",
+			requestBody:  map[string]interface{}{"categories": []string{"food,travel"}},
+			setupContext: happyContext,
+			prepare:      func(mockCategorizeTransactionUC *appMock.MockICategorizeTransactionUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			requestBody:          happyRequestBody,
+			setupContext:         context.Background,
+			prepare:              func(mockCategorizeTransactionUC *appMock.MockICategorizeTransactionUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 重複を除いた後も分類が多すぎる場合、Bad Request を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockCategorizeTransactionUC *appMock.MockICategorizeTransactionUsecase) {
+				mockCategorizeTransactionUC.EXPECT().Run(arg, arg).Return(nil, transactionDomain.ErrTooManyCategories)
+			},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, transactionDomain.ErrTooManyCategories),
+		},
+		{
+			caseName:     "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockCategorizeTransactionUC *appMock.MockICategorizeTransactionUsecase) {
+				mockCategorizeTransactionUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnauthorized),
+		},
+		{
+			caseName:     "Negative: 取引が見つからない場合、Not Found を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockCategorizeTransactionUC *appMock.MockICategorizeTransactionUsecase) {
+				mockCategorizeTransactionUC.EXPECT().Run(arg, arg).Return(nil, transactionDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, transactionDomain.ErrNotFound),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockCategorizeTransactionUC *appMock.MockICategorizeTransactionUsecase) {
+				mockCategorizeTransactionUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPut, uri, bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id", "transaction_id")
+			ctx.SetParamValues(accountID.String(), transactionID.String())
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockCategorizeTransactionUC := appMock.NewMockICategorizeTransactionUsecase(ctrl)
+			tt.prepare(mockCategorizeTransactionUC)
+
+			h := transactions.NewCategorizeTransactionHandler(mockCategorizeTransactionUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp transactions.ReadTransactionResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Len(t, resp.Errors, 1)
+					assert.Equal(t, "categories", resp.Errors[0].Field)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
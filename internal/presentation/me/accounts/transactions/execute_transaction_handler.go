@@ -1,12 +1,18 @@
 package transactions
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/u104rak1/pocgo/internal/application/idempotency"
 	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
 	"github.com/u104rak1/pocgo/internal/config"
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
 	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
 	"github.com/u104rak1/pocgo/internal/presentation/validation"
@@ -23,6 +29,9 @@ func NewExecuteTransactionHandler(executeTransactionUsecase transactionApp.IExec
 	}
 }
 
+// 同じキーで再送されたリクエストは取引を再実行せず、最初のレスポンスを返す為のヘッダーです。
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 type ExecuteTransactionParams struct {
 	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
 }
@@ -34,19 +43,29 @@ type ExecuteTransactionRequestBody struct {
 	// 取引種別 （DEPOSIT, WITHDRAWAL, TRANSFER)
 	OperationType string `json:"operationType" example:"DEPOSIT"`
 
-	// 取引金額
-	Amount float64 `json:"amount" example:"1000"`
+	// 取引金額 (通貨の小数点以下の桁数まで指定可能)
+	Amount json.Number `json:"amount" swaggertype:"number" example:"1000"`
 
-	// 通貨 （JPY, USD)
+	// 通貨 （通貨マスタに登録されている ISO 4217 通貨コード)
 	Currency string `json:"currency" example:"JPY"`
 
-	// 受取口座ID (TRANSFERの場合必須)
+	// 受取口座ID (TRANSFERの場合、受取口座IDと支払先IDのいずれか一方が必須)
 	ReceiverAccountID *string `json:"receiverAccountId" example:"01J9R8AJ1Q2YDH1X9836GS9D87"`
+
+	// 支払先ID (TRANSFERの場合、確認済みの支払先が取引の通貨で受け取る既定の口座に振り込みます)
+	PayeeID *string `json:"payeeId" example:"01J9R8AJ1Q2YDH1X9836GS9P12"`
+
+	// メモ (最大200文字、取引を行った口座のみ参照可能)
+	Memo *string `json:"memo" example:"Lunch with team"`
+
+	// 参照情報 (最大140文字、TRANSFERの場合は受取口座からも参照可能)
+	Reference *string `json:"reference" example:"INV-2024-0001"`
 }
 
 type ExecuteTransactionRequest struct {
 	ExecuteTransactionParams
 	ExecuteTransactionRequestBody
+	IdempotencyKey *string
 }
 
 type ExecuteTransactionResponse struct {
@@ -63,28 +82,50 @@ type ExecuteTransactionResponse struct {
 	OperationType string `json:"operationType" example:"DEPOSIT"`
 
 	// 取引金額
-	Amount float64 `json:"amount" example:"1000"`
+	Amount json.Number `json:"amount" swaggertype:"number" example:"1000"`
 
 	// 通貨
 	Currency string `json:"currency" example:"JPY"`
 
+	// 受取金額 (TRANSFERの場合、受取口座の通貨での入金額)
+	ReceiverAmount *json.Number `json:"receiverAmount" swaggertype:"number" example:"6.67"`
+
+	// 受取通貨 (TRANSFERの場合)
+	ReceiverCurrency *string `json:"receiverCurrency" example:"USD"`
+
+	// 為替レート (通貨が異なるTRANSFERの場合、送金通貨1単位あたりの受取通貨の価格)
+	ExchangeRate *json.Number `json:"exchangeRate" swaggertype:"number" example:"0.006667"`
+
+	// メモ
+	Memo *string `json:"memo" example:"Lunch with team"`
+
+	// 参照情報
+	Reference *string `json:"reference" example:"INV-2024-0001"`
+
 	// 取引日時
 	TransactionAt string `json:"transactionAt" example:"2024-03-20T15:00:00Z"`
 }
 
 // @Summary 取引実行
 // @Description 指定された口座に対して取引を実行します。
+// @Description 振込は受取口座IDの代わりに支払先IDを指定できます。支払先は事前に確認済みにし、支払先の利用者が取引の通貨の受取口座を設定している必要があります。
+// @Description 出金と振込は口座の取引金額の上限を超える場合は実行できず、残りの金額を含むエラーを返します。
+// @Description Idempotency-Key ヘッダーを指定した場合、同じキーで再送されたリクエストは取引を再実行せずに最初のレスポンスを返します。
 // @Tags Transaction API
 // @Security BearerAuth
 // @Accept json
 // @Produce json
 // @Param account_id path string true "操作する口座ID"
+// @Param Idempotency-Key header string false "リクエストを一意に識別するキー (最大255文字)"
 // @Param request body ExecuteTransactionRequestBody true "Request Body"
 // @Success 200 {object} ExecuteTransactionResponse
 // @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
 // @Failure 401 {object} response.ProblemDetail "Unauthorized"
 // @Failure 403 {object} response.ProblemDetail "Forbidden"
 // @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 409 {object} response.ProblemDetail "Conflict"
+// @Failure 422 {object} response.ProblemDetail "Unprocessable Entity"
+// @Failure 429 {object} response.ProblemDetail "Too Many Requests"
 // @Failure 500 {object} response.ProblemDetail "Internal Server Error"
 // @Router /api/v1/me/accounts/{account_id}/transactions [post]
 func (h *ExecuteTransactionHandler) Run(ctx echo.Context) error {
@@ -92,6 +133,9 @@ func (h *ExecuteTransactionHandler) Run(ctx echo.Context) error {
 	if err := ctx.Bind(req); err != nil {
 		return response.BadRequest(ctx, response.ErrInvalidJSON)
 	}
+	if key, ok := ctx.Request().Header[IdempotencyKeyHeader]; ok && len(key) > 0 {
+		req.IdempotencyKey = &key[0]
+	}
 
 	if validationErrors := h.validation(req); len(validationErrors) > 0 {
 		return response.ValidationFailed(ctx, validationErrors)
@@ -107,22 +151,42 @@ func (h *ExecuteTransactionHandler) Run(ctx echo.Context) error {
 		AccountID:         req.AccountID,
 		Password:          req.Password,
 		OperationType:     req.OperationType,
-		Amount:            req.Amount,
+		Amount:            req.Amount.String(),
 		Currency:          req.Currency,
 		ReceiverAccountID: req.ReceiverAccountID,
+		PayeeID:           req.PayeeID,
+		Memo:              req.Memo,
+		Reference:         req.Reference,
+		IdempotencyKey:    req.IdempotencyKey,
 	})
 	if err != nil {
 		switch err {
-		case moneyVO.ErrDifferentCurrencyOperation:
+		case moneyVO.ErrDifferentCurrencyOperation,
+			transactionDomain.ErrInvalidMemo,
+			transactionDomain.ErrInvalidReference:
 			return response.BadRequest(ctx, err)
 		case accountDomain.ErrUnmatchedPassword:
 			return response.Forbidden(ctx, err)
 		case accountDomain.ErrNotFound,
-			accountDomain.ErrReceiverNotFound:
+			accountDomain.ErrReceiverNotFound,
+			payeeDomain.ErrNotFound:
 			return response.NotFound(ctx, err)
-		case moneyVO.ErrInsufficientBalance:
+		case accountDomain.ErrConcurrentModification,
+			idempotency.ErrRequestInProgress:
+			return response.Conflict(ctx, err)
+		case moneyVO.ErrInsufficientBalance,
+			moneyVO.ErrExchangeRateNotFound,
+			idempotency.ErrKeyReused,
+			payeeDomain.ErrNotConfirmed,
+			payeeDomain.ErrNoReceivingAccount:
 			return response.UnprocessableEntity(ctx, err)
+		case lockoutDomain.ErrLocked:
+			return response.TooManyRequests(ctx, err)
 		default:
+			// 上限を超えた場合のエラーは残りの金額を含む為、ErrLimitExceeded をラップした型で返されます。
+			if errors.Is(err, limitDomain.ErrLimitExceeded) {
+				return response.UnprocessableEntity(ctx, err)
+			}
 			return response.InternalServerError(ctx, err)
 		}
 	}
@@ -132,8 +196,13 @@ func (h *ExecuteTransactionHandler) Run(ctx echo.Context) error {
 		AccountID:         dto.AccountID,
 		ReceiverAccountID: dto.ReceiverAccountID,
 		OperationType:     dto.OperationType,
-		Amount:            dto.Amount,
+		Amount:            json.Number(dto.Amount),
 		Currency:          dto.Currency,
+		ReceiverAmount:    decimalPointer(dto.ReceiverAmount),
+		ReceiverCurrency:  dto.ReceiverCurrency,
+		ExchangeRate:      decimalPointer(dto.ExchangeRate),
+		Memo:              dto.Memo,
+		Reference:         dto.Reference,
 		TransactionAt:     dto.TransactionAt,
 	})
 }
@@ -166,7 +235,7 @@ func (h *ExecuteTransactionHandler) validation(req *ExecuteTransactionRequest) (
 			Message: err.Error(),
 		})
 	} else {
-		if err := validation.ValidAmount(req.Currency, req.Amount); err != nil {
+		if err := validation.ValidAmount(req.Currency, req.Amount.String()); err != nil {
 			validationErrors = append(validationErrors, response.ValidationError{
 				Field:   "amount",
 				Message: err.Error(),
@@ -174,6 +243,15 @@ func (h *ExecuteTransactionHandler) validation(req *ExecuteTransactionRequest) (
 		}
 	}
 
+	if req.IdempotencyKey != nil {
+		if err := validation.ValidIdempotencyKey(*req.IdempotencyKey); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   IdempotencyKeyHeader,
+				Message: err.Error(),
+			})
+		}
+	}
+
 	if req.ReceiverAccountID != nil {
 		if err := validation.ValidULID(*req.ReceiverAccountID); err != nil {
 			validationErrors = append(validationErrors, response.ValidationError{
@@ -183,20 +261,61 @@ func (h *ExecuteTransactionHandler) validation(req *ExecuteTransactionRequest) (
 		}
 	}
 
+	if req.PayeeID != nil {
+		if err := validation.ValidULID(*req.PayeeID); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "payeeId",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if req.Memo != nil {
+		if err := validation.ValidTransactionMemo(*req.Memo); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "memo",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if req.Reference != nil {
+		if err := validation.ValidTransactionReference(*req.Reference); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "reference",
+				Message: err.Error(),
+			})
+		}
+	}
+
 	if req.OperationType == transactionDomain.Transfer {
-		if req.AccountID == *req.ReceiverAccountID {
+		switch {
+		case req.ReceiverAccountID == nil && req.PayeeID == nil:
 			validationErrors = append(validationErrors, response.ValidationError{
 				Field:   "receiverAccountId",
-				Message: "receiverAccountId must be different from account_id",
+				Message: "receiverAccountId or payeeId is required for transfer operation",
 			})
-		}
-		if req.ReceiverAccountID == nil {
+		case req.ReceiverAccountID != nil && req.PayeeID != nil:
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "payeeId",
+				Message: "payeeId cannot be specified together with receiverAccountId",
+			})
+		case req.ReceiverAccountID != nil && req.AccountID == *req.ReceiverAccountID:
 			validationErrors = append(validationErrors, response.ValidationError{
 				Field:   "receiverAccountId",
-				Message: "receiverAccountId is required for transfer operation",
+				Message: "receiverAccountId must be different from account_id",
 			})
 		}
 	}
 
 	return validationErrors
 }
+
+// 10進数表記の文字列のポインタを json.Number のポインタに変換します。
+func decimalPointer(s *string) *json.Number {
+	if s == nil {
+		return nil
+	}
+	n := json.Number(*s)
+	return &n
+}
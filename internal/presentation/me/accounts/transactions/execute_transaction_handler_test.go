@@ -6,15 +6,20 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/u104rak1/pocgo/internal/application/idempotency"
 	appMock "github.com/u104rak1/pocgo/internal/application/mock"
 	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
 	"github.com/u104rak1/pocgo/internal/config"
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
@@ -29,24 +34,50 @@ func TestExecuteTransactionHandler(t *testing.T) {
 		userID        = idVO.NewUserIDForTest("user")
 		password      = "1234"
 		operationType = transactionDomain.Deposit
-		amount        = 1000.0
+		amount        = "1000"
 		currency      = moneyVO.JPY
 		transactionID = idVO.NewTransactionIDForTest("transaction")
 		transactionAt = timer.GetFixedDateString()
 		uri           = "/api/v1/me/accounts/" + accountID.String() + "/transactions"
 		arg           = gomock.Any()
+
+		receiverAccountID    = idVO.NewAccountIDForTest("receiver").String()
+		payeeID              = idVO.NewPayeeIDForTest("payee").String()
+		receiverAmount       = "6.67"
+		receiverCurrency     = moneyVO.USD
+		exchangeRate         = "0.006667"
+		receiverAmountNumber = json.Number(receiverAmount)
+		exchangeRateNumber   = json.Number(exchangeRate)
+
+		idempotencyKey        = "5f0c7a0e-3c3b-4f0e-9d5a-1c2b3d4e5f60"
+		invalidIdempotencyKey = "invalid key"
+
+		memo             = "Lunch with team"
+		reference        = "INV-2024-0001"
+		tooLongMemo      = strings.Repeat("a", transactionDomain.MemoMaxLength+1)
+		tooLongReference = strings.Repeat("a", transactionDomain.ReferenceMaxLength+1)
 	)
 
 	var happyRequestBody = transactions.ExecuteTransactionRequestBody{
 		Password:      password,
 		OperationType: operationType,
-		Amount:        amount,
+		Amount:        json.Number(amount),
 		Currency:      currency,
 	}
 
+	dailyLimit, _ := moneyVO.New(3000, moneyVO.JPY)
+	remaining, _ := moneyVO.New(500, moneyVO.JPY)
+	limitExceededErr := &limitDomain.ExceededError{
+		OperationType: limitDomain.OperationWithdrawal,
+		Window:        limitDomain.WindowDaily,
+		Limit:         *dailyLimit,
+		Remaining:     *remaining,
+	}
+
 	tests := []struct {
 		caseName             string
 		requestBody          interface{}
+		idempotencyKey       *string
 		setupContext         func() context.Context
 		prepare              func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase)
 		expectedCode         int
@@ -74,11 +105,167 @@ func TestExecuteTransactionHandler(t *testing.T) {
 				ID:            transactionID.String(),
 				AccountID:     accountID.String(),
 				OperationType: operationType,
-				Amount:        amount,
+				Amount:        json.Number(amount),
 				Currency:      currency,
 				TransactionAt: transactionAt,
 			},
 		},
+		{
+			caseName: "Positive: 通貨が異なる口座への振込に成功し、受取金額と為替レートを返す",
+			requestBody: transactions.ExecuteTransactionRequestBody{
+				Password:          password,
+				OperationType:     transactionDomain.Transfer,
+				Amount:            json.Number(amount),
+				Currency:          currency,
+				ReceiverAccountID: &receiverAccountID,
+			},
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).Return(&transactionApp.ExecuteTransactionDTO{
+					ID:                transactionID.String(),
+					AccountID:         accountID.String(),
+					ReceiverAccountID: &receiverAccountID,
+					OperationType:     transactionDomain.Transfer,
+					Amount:            amount,
+					Currency:          currency,
+					ReceiverAmount:    &receiverAmount,
+					ReceiverCurrency:  &receiverCurrency,
+					ExchangeRate:      &exchangeRate,
+					TransactionAt:     transactionAt,
+				}, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedResponseBody: transactions.ExecuteTransactionResponse{
+				ID:                transactionID.String(),
+				AccountID:         accountID.String(),
+				ReceiverAccountID: &receiverAccountID,
+				OperationType:     transactionDomain.Transfer,
+				Amount:            json.Number(amount),
+				Currency:          currency,
+				ReceiverAmount:    &receiverAmountNumber,
+				ReceiverCurrency:  &receiverCurrency,
+				ExchangeRate:      &exchangeRateNumber,
+				TransactionAt:     transactionAt,
+			},
+		},
+		{
+			caseName:       "Positive: Idempotency-Key ヘッダーの値がユースケースに渡される",
+			requestBody:    happyRequestBody,
+			idempotencyKey: &idempotencyKey,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).DoAndReturn(
+					func(_ context.Context, cmd transactionApp.ExecuteTransactionCommand) (*transactionApp.ExecuteTransactionDTO, error) {
+						assert.Equal(t, &idempotencyKey, cmd.IdempotencyKey)
+						return &transactionApp.ExecuteTransactionDTO{
+							ID:            transactionID.String(),
+							AccountID:     accountID.String(),
+							OperationType: operationType,
+							Amount:        amount,
+							Currency:      currency,
+							TransactionAt: transactionAt,
+						}, nil
+					})
+			},
+			expectedCode: http.StatusCreated,
+			expectedResponseBody: transactions.ExecuteTransactionResponse{
+				ID:            transactionID.String(),
+				AccountID:     accountID.String(),
+				OperationType: operationType,
+				Amount:        json.Number(amount),
+				Currency:      currency,
+				TransactionAt: transactionAt,
+			},
+		},
+		{
+			caseName: "Positive: 支払先IDを指定した振込では、支払先IDがユースケースに渡される",
+			requestBody: transactions.ExecuteTransactionRequestBody{
+				Password:      password,
+				OperationType: transactionDomain.Transfer,
+				Amount:        json.Number(amount),
+				Currency:      currency,
+				PayeeID:       &payeeID,
+			},
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).DoAndReturn(
+					func(_ context.Context, cmd transactionApp.ExecuteTransactionCommand) (*transactionApp.ExecuteTransactionDTO, error) {
+						assert.Equal(t, &payeeID, cmd.PayeeID)
+						assert.Nil(t, cmd.ReceiverAccountID)
+						return &transactionApp.ExecuteTransactionDTO{
+							ID:                transactionID.String(),
+							AccountID:         accountID.String(),
+							ReceiverAccountID: &receiverAccountID,
+							OperationType:     transactionDomain.Transfer,
+							Amount:            amount,
+							Currency:          currency,
+							TransactionAt:     transactionAt,
+						}, nil
+					})
+			},
+			expectedCode: http.StatusCreated,
+			expectedResponseBody: transactions.ExecuteTransactionResponse{
+				ID:                transactionID.String(),
+				AccountID:         accountID.String(),
+				ReceiverAccountID: &receiverAccountID,
+				OperationType:     transactionDomain.Transfer,
+				Amount:            json.Number(amount),
+				Currency:          currency,
+				TransactionAt:     transactionAt,
+			},
+		},
+		{
+			caseName: "Positive: メモと参照情報がユースケースに渡され、レスポンスに含まれる",
+			requestBody: transactions.ExecuteTransactionRequestBody{
+				Password:      password,
+				OperationType: operationType,
+				Amount:        json.Number(amount),
+				Currency:      currency,
+				Memo:          &memo,
+				Reference:     &reference,
+			},
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).DoAndReturn(
+					func(_ context.Context, cmd transactionApp.ExecuteTransactionCommand) (*transactionApp.ExecuteTransactionDTO, error) {
+						assert.Equal(t, &memo, cmd.Memo)
+						assert.Equal(t, &reference, cmd.Reference)
+						return &transactionApp.ExecuteTransactionDTO{
+							ID:            transactionID.String(),
+							AccountID:     accountID.String(),
+							OperationType: operationType,
+							Amount:        amount,
+							Currency:      currency,
+							Memo:          &memo,
+							Reference:     &reference,
+							TransactionAt: transactionAt,
+						}, nil
+					})
+			},
+			expectedCode: http.StatusCreated,
+			expectedResponseBody: transactions.ExecuteTransactionResponse{
+				ID:            transactionID.String(),
+				AccountID:     accountID.String(),
+				OperationType: operationType,
+				Amount:        json.Number(amount),
+				Currency:      currency,
+				Memo:          &memo,
+				Reference:     &reference,
+				TransactionAt: transactionAt,
+			},
+		},
 		{
 			caseName:    "Negative: リクエストボディが無効なJSONの場合、Bad Request を返す",
 			requestBody: "invalid json",
@@ -115,6 +302,126 @@ func TestExecuteTransactionHandler(t *testing.T) {
 				},
 			},
 		},
+		{
+			caseName: "Negative: 金額が0の場合、ユースケースを実行せずに Bad Request を返す",
+			requestBody: transactions.ExecuteTransactionRequestBody{
+				Password:      password,
+				OperationType: transactionDomain.Deposit,
+				Amount:        json.Number("0"),
+				Currency:      currency,
+			},
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare:      func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName: "Negative: 振込で受取口座IDと支払先IDのいずれも指定されない場合、Bad Request を返す",
+			requestBody: transactions.ExecuteTransactionRequestBody{
+				Password:      password,
+				OperationType: transactionDomain.Transfer,
+				Amount:        json.Number(amount),
+				Currency:      currency,
+			},
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare:      func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName: "Negative: 振込で受取口座IDと支払先IDの両方が指定された場合、Bad Request を返す",
+			requestBody: transactions.ExecuteTransactionRequestBody{
+				Password:          password,
+				OperationType:     transactionDomain.Transfer,
+				Amount:            json.Number(amount),
+				Currency:          currency,
+				ReceiverAccountID: &receiverAccountID,
+				PayeeID:           &payeeID,
+			},
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare:      func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName: "Negative: メモと参照情報が長すぎる場合、Bad Request を返す",
+			requestBody: transactions.ExecuteTransactionRequestBody{
+				Password:      password,
+				OperationType: operationType,
+				Amount:        json.Number(amount),
+				Currency:      currency,
+				Memo:          &tooLongMemo,
+				Reference:     &tooLongReference,
+			},
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare:      func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:       "Negative: Idempotency-Key ヘッダーの値が不正な場合、Bad Request を返す",
+			requestBody:    happyRequestBody,
+			idempotencyKey: &invalidIdempotencyKey,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare:      func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
 		{
 			caseName:    "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
 			requestBody: happyRequestBody,
@@ -207,6 +514,25 @@ func TestExecuteTransactionHandler(t *testing.T) {
 				Instance: uri,
 			},
 		},
+		{
+			caseName:    "Negative: 支払先が見つからない場合、Not Found を返す",
+			requestBody: happyRequestBody,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).Return(nil, payeeDomain.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLNotFound,
+				Title:    response.TitleNotFound,
+				Status:   http.StatusNotFound,
+				Detail:   payeeDomain.ErrNotFound.Error(),
+				Instance: uri,
+			},
+		},
 		{
 			caseName:    "Negative: 残高が不足している場合、Unprocessable Entity を返す",
 			requestBody: happyRequestBody,
@@ -226,6 +552,160 @@ func TestExecuteTransactionHandler(t *testing.T) {
 				Instance: uri,
 			},
 		},
+		{
+			caseName:    "Negative: 為替レートが見つからない場合、Unprocessable Entity を返す",
+			requestBody: happyRequestBody,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).Return(nil, moneyVO.ErrExchangeRateNotFound)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLUnprocessableEntity,
+				Title:    response.TitleUnprocessableEntity,
+				Status:   http.StatusUnprocessableEntity,
+				Detail:   moneyVO.ErrExchangeRateNotFound.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:    "Negative: 支払先が未確認の場合、Unprocessable Entity を返す",
+			requestBody: happyRequestBody,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).Return(nil, payeeDomain.ErrNotConfirmed)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLUnprocessableEntity,
+				Title:    response.TitleUnprocessableEntity,
+				Status:   http.StatusUnprocessableEntity,
+				Detail:   payeeDomain.ErrNotConfirmed.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:    "Negative: 支払先が取引の通貨の受取口座を設定していない場合、Unprocessable Entity を返す",
+			requestBody: happyRequestBody,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).Return(nil, payeeDomain.ErrNoReceivingAccount)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLUnprocessableEntity,
+				Title:    response.TitleUnprocessableEntity,
+				Status:   http.StatusUnprocessableEntity,
+				Detail:   payeeDomain.ErrNoReceivingAccount.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:    "Negative: 取引金額の上限を超える場合、Unprocessable Entity を返す",
+			requestBody: happyRequestBody,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).Return(nil, limitExceededErr)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLUnprocessableEntity,
+				Title:    response.TitleUnprocessableEntity,
+				Status:   http.StatusUnprocessableEntity,
+				Detail:   limitExceededErr.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:    "Negative: 他のリクエストが先に口座を更新していた場合、Conflict を返す",
+			requestBody: happyRequestBody,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrConcurrentModification)
+			},
+			expectedCode: http.StatusConflict,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLConflict,
+				Title:    response.TitleConflict,
+				Status:   http.StatusConflict,
+				Detail:   accountDomain.ErrConcurrentModification.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:       "Negative: 同じ Idempotency-Key のリクエストが処理中の場合、Conflict を返す",
+			requestBody:    happyRequestBody,
+			idempotencyKey: &idempotencyKey,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).Return(nil, idempotency.ErrRequestInProgress)
+			},
+			expectedCode: http.StatusConflict,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLConflict,
+				Title:    response.TitleConflict,
+				Status:   http.StatusConflict,
+				Detail:   idempotency.ErrRequestInProgress.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:       "Negative: Idempotency-Key が異なるリクエストで使用済みの場合、Unprocessable Entity を返す",
+			requestBody:    happyRequestBody,
+			idempotencyKey: &idempotencyKey,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).Return(nil, idempotency.ErrKeyReused)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLUnprocessableEntity,
+				Title:    response.TitleUnprocessableEntity,
+				Status:   http.StatusUnprocessableEntity,
+				Detail:   idempotency.ErrKeyReused.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:    "Negative: 連続してパスワードの照合に失敗し口座がロックされている場合、Too Many Requests を返す",
+			requestBody: happyRequestBody,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockExecuteTransactionUC *appMock.MockIExecuteTransactionUsecase) {
+				mockExecuteTransactionUC.EXPECT().Run(arg, arg).Return(nil, lockoutDomain.ErrLocked)
+			},
+			expectedCode: http.StatusTooManyRequests,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLTooManyRequests,
+				Title:    response.TitleTooManyRequests,
+				Status:   http.StatusTooManyRequests,
+				Detail:   lockoutDomain.ErrLocked.Error(),
+				Instance: uri,
+			},
+		},
 		{
 			caseName:    "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
 			requestBody: happyRequestBody,
@@ -258,6 +738,9 @@ func TestExecuteTransactionHandler(t *testing.T) {
 			assert.NoError(t, err)
 			req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(body))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.idempotencyKey != nil {
+				req.Header.Set(transactions.IdempotencyKeyHeader, *tt.idempotencyKey)
+			}
 			rec := httptest.NewRecorder()
 			ctx := e.NewContext(req, rec)
 			ctx.SetParamNames("account_id")
@@ -1,6 +1,9 @@
 package transactions
 
 import (
+	"encoding/json"
+	"errors"
+	"net/url"
 	"strings"
 	"time"
 
@@ -8,6 +11,8 @@ import (
 	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
 	"github.com/u104rak1/pocgo/internal/config"
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
 	"github.com/u104rak1/pocgo/internal/presentation/validation"
 	"github.com/u104rak1/pocgo/internal/server/response"
 	"github.com/u104rak1/pocgo/pkg/timer"
@@ -30,10 +35,15 @@ type ListTransactionsParams struct {
 type ListTransactionsQuery struct {
 	From           *string `query:"from" example:"20240101"`
 	To             *string `query:"to" example:"20241231"`
-	OperationTypes *string `query:"operation_types" example:"DEPOSIT,WITHDRAWAL,TRANSFER"`
+	OperationTypes *string `query:"operation_types" example:"DEPOSIT,WITHDRAWAL,TRANSFER_IN"`
+	Q              *string `query:"q" example:"lunch"`
+	MinAmount      *string `query:"min_amount" example:"1000"`
+	MaxAmount      *string `query:"max_amount" example:"5000"`
+	Category       *string `query:"category" example:"food,travel"`
 	Sort           *string `query:"sort" example:"DESC"`
 	Limit          *int    `query:"limit" example:"10"`
 	Page           *int    `query:"page" example:"1"`
+	Cursor         *string `query:"cursor" example:"eyJhdCI6IjIwMjQtMDMtMjBUMTU6MDA6MDBaIiwiaWQiOiIwMUo5UjhBSjFRMllESDFYOTgzNkdTOUU4OSIsImRpciI6Im5leHQifQ"`
 }
 
 type ListTransactionsRequest struct {
@@ -42,11 +52,22 @@ type ListTransactionsRequest struct {
 }
 
 type ListTransactionsResponse struct {
-	// 取引件数
-	Total int `json:"total" example:"1"`
+	// 取引件数 (cursor を指定した場合は件数を数えない為、含まれません)
+	Total *int `json:"total,omitempty" example:"1"`
 
 	// 取引一覧
 	Transactions []ListTransactionsTransaction `json:"transactions"`
+
+	// 前後のページへのリンク
+	Links ListTransactionsLinks `json:"links"`
+}
+
+type ListTransactionsLinks struct {
+	// 次のページのURL (次のページがない場合は null)
+	Next *string `json:"next" example:"/api/v1/me/accounts/01J9R7YPV1FH1V0PPKVSB5C8FW/transactions?cursor=eyJhdCI6IjIwMjQtMDMtMjBUMTU6MDA6MDBaIiwiaWQiOiIwMUo5UjhBSjFRMllESDFYOTgzNkdTOUU4OSIsImRpciI6Im5leHQifQ&limit=10"`
+
+	// 前のページのURL (前のページがない場合は null)
+	Prev *string `json:"prev" example:"/api/v1/me/accounts/01J9R7YPV1FH1V0PPKVSB5C8FW/transactions?cursor=eyJhdCI6IjIwMjQtMDMtMjBUMTU6MDA6MDBaIiwiaWQiOiIwMUo5UjhBSjFRMllESDFYOTgzNkdTOUU4OSIsImRpciI6InByZXYifQ&limit=10"`
 }
 
 type ListTransactionsTransaction struct {
@@ -62,18 +83,48 @@ type ListTransactionsTransaction struct {
 	// 取引種別
 	OperationType string `json:"operationType" example:"DEPOSIT"`
 
+	// 取り消した取引ID (REVERSALの場合)
+	ReversedTransactionID *string `json:"reversedTransactionId" example:"01J9R8AJ1Q2YDH1X9836GS9E88"`
+
 	// 取引金額
-	Amount float64 `json:"amount" example:"1000"`
+	Amount json.Number `json:"amount" swaggertype:"number" example:"1000"`
 
 	// 通貨
 	Currency string `json:"currency" example:"JPY"`
 
+	// 受取金額 (TRANSFERの場合、受取口座の通貨での入金額)
+	ReceiverAmount *json.Number `json:"receiverAmount" swaggertype:"number" example:"6.67"`
+
+	// 受取通貨 (TRANSFERの場合)
+	ReceiverCurrency *string `json:"receiverCurrency" example:"USD"`
+
+	// 為替レート (通貨が異なるTRANSFERの場合、送金通貨1単位あたりの受取通貨の価格)
+	ExchangeRate *json.Number `json:"exchangeRate" swaggertype:"number" example:"0.006667"`
+
+	// 口座から見た取引の向き (入金と受け取った振込は CREDIT、出金と送金した振込は DEBIT、REVERSALは取り消した取引と逆の向き)
+	Direction string `json:"direction" example:"CREDIT"`
+
+	// 口座の通貨での符号付きの増減額 (DEBIT の場合は負の値)
+	SignedAmount json.Number `json:"signedAmount" swaggertype:"number" example:"1000"`
+
+	// 取引後の口座残高 (残高の記録を始める前の取引の場合は null)
+	BalanceAfter *json.Number `json:"balanceAfter" swaggertype:"number" example:"1000"`
+
+	// メモ (取引を行った口座の場合のみ)
+	Memo *string `json:"memo" example:"Lunch with team"`
+
+	// 参照情報
+	Reference *string `json:"reference" example:"INV-2024-0001"`
+
+	// 口座が取引に付けた分類
+	Categories []string `json:"categories" example:"food,travel"`
+
 	// 取引日時
 	TransactionAt string `json:"transactionAt" example:"2024-03-20T15:00:00Z"`
 }
 
 // @Summary 取引一覧取得
-// @Description 指定された口座の取引履歴を取得します。
+// @Description 指定された口座の取引履歴を取得します。他の口座から受け取った振込も含みます。
 // @Tags Transaction API
 // @Security BearerAuth
 // @Accept json
@@ -81,10 +132,15 @@ type ListTransactionsTransaction struct {
 // @Param account_id path string true "操作する口座ID"
 // @Param from query string false "取引日の開始日（YYYYMMDD）"
 // @Param to query string false "取引日の終了日（YYYYMMDD）"
-// @Param operation_types query string false "取引種別（DEPOSIT, WITHDRAWAL, TRANSFER カンマ区切りで複数指定可 未指定の場合は全ての取引種別を取得）"
+// @Param operation_types query string false "取引種別（DEPOSIT, WITHDRAWAL, TRANSFER, TRANSFER_IN, TRANSFER_OUT, REVERSAL カンマ区切りで複数指定可 TRANSFERは送金と受取の両方 未指定の場合は全ての取引種別を取得）"
+// @Param q query string false "検索語（メモと参照情報を単語で検索 空白区切りで複数指定した場合は全てを含む取引を取得 受け取った振込はメモを検索しません）"
+// @Param min_amount query string false "口座の通貨での取引金額の下限"
+// @Param max_amount query string false "口座の通貨での取引金額の上限"
+// @Param category query string false "分類（カンマ区切りで複数指定可 いずれかの分類が付いた取引を取得）"
 // @Param sort query string false "ソート順（ASC, DESC）"
 // @Param limit query int false "ページサイズ（1~100）"
-// @Param page query int false "ページ番号（1~）"
+// @Param page query int false "ページ番号（1~）cursor と同時には指定できません"
+// @Param cursor query string false "ページングのカーソル（links.next, links.prev に含まれる値）"
 // @Success 200 {object} ListTransactionsResponse
 // @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
 // @Failure 401 {object} response.ProblemDetail "Unauthorized"
@@ -129,15 +185,25 @@ func (h *ListTransactionsHandler) Run(ctx echo.Context) error {
 		to = &parsedTo
 	}
 
+	var categories []string
+	if req.Category != nil {
+		categories = strings.Split(*req.Category, ",")
+	}
+
 	dto, err := h.listTransactionsUC.Run(ctx.Request().Context(), transactionApp.ListTransactionsCommand{
 		UserID:         userID,
 		AccountID:      req.AccountID,
 		From:           from,
 		To:             to,
 		OperationTypes: operationTypes,
+		Query:          req.Q,
+		MinAmount:      req.MinAmount,
+		MaxAmount:      req.MaxAmount,
+		Categories:     categories,
 		Sort:           req.Sort,
 		Limit:          req.Limit,
 		Page:           req.Page,
+		Cursor:         req.Cursor,
 	})
 	if err != nil {
 		switch err {
@@ -145,7 +211,16 @@ func (h *ListTransactionsHandler) Run(ctx echo.Context) error {
 			return response.Forbidden(ctx, err)
 		case accountDomain.ErrNotFound:
 			return response.NotFound(ctx, err)
+		case transactionDomain.ErrInvalidCursor,
+			transactionDomain.ErrInvalidCategory,
+			transactionDomain.ErrTooManyCategories,
+			transactionDomain.ErrInvalidAmountRange:
+			return response.BadRequest(ctx, err)
 		default:
+			// 金額の小数点以下の桁数が口座の通貨と合わない場合のエラーは、通貨を含めてラップされています。
+			if errors.Is(err, moneyVO.ErrInvalidPrecision) {
+				return response.BadRequest(ctx, err)
+			}
 			return response.InternalServerError(ctx, err)
 		}
 	}
@@ -153,22 +228,51 @@ func (h *ListTransactionsHandler) Run(ctx echo.Context) error {
 	transactions := make([]ListTransactionsTransaction, len(dto.Transactions))
 	for i, t := range dto.Transactions {
 		transactions[i] = ListTransactionsTransaction{
-			ID:                t.ID,
-			AccountID:         t.AccountID,
-			ReceiverAccountID: t.ReceiverAccountID,
-			OperationType:     t.OperationType,
-			Amount:            t.Amount,
-			Currency:          t.Currency,
-			TransactionAt:     t.TransactionAt,
+			ID:                    t.ID,
+			AccountID:             t.AccountID,
+			ReceiverAccountID:     t.ReceiverAccountID,
+			OperationType:         t.OperationType,
+			ReversedTransactionID: t.ReversedTransactionID,
+			Amount:                json.Number(t.Amount),
+			Currency:              t.Currency,
+			ReceiverAmount:        decimalPointer(t.ReceiverAmount),
+			ReceiverCurrency:      t.ReceiverCurrency,
+			ExchangeRate:          decimalPointer(t.ExchangeRate),
+			Direction:             t.Direction,
+			SignedAmount:          json.Number(t.SignedAmount),
+			BalanceAfter:          decimalPointer(t.BalanceAfter),
+			Memo:                  t.Memo,
+			Reference:             t.Reference,
+			Categories:            t.Categories,
+			TransactionAt:         t.TransactionAt,
 		}
 	}
 
 	return ctx.JSON(200, ListTransactionsResponse{
 		Total:        dto.Total,
 		Transactions: transactions,
+		Links: ListTransactionsLinks{
+			Next: pageLink(ctx, dto.NextCursor),
+			Prev: pageLink(ctx, dto.PrevCursor),
+		},
 	})
 }
 
+// リクエストの絞り込み条件を引き継ぎ、page の代わりに cursor を指定したURLを返します。
+func pageLink(ctx echo.Context, cursor *string) *string {
+	if cursor == nil {
+		return nil
+	}
+	query := url.Values{}
+	for key, values := range ctx.QueryParams() {
+		query[key] = values
+	}
+	query.Del("page")
+	query.Set("cursor", *cursor)
+	link := ctx.Request().URL.Path + "?" + query.Encode()
+	return &link
+}
+
 func (h *ListTransactionsHandler) validation(req *ListTransactionsRequest) (validationErrors []response.ValidationError) {
 	if err := validation.ValidULID(req.AccountID); err != nil {
 		validationErrors = append(validationErrors, response.ValidationError{
@@ -208,6 +312,38 @@ func (h *ListTransactionsHandler) validation(req *ListTransactionsRequest) (vali
 			})
 		}
 	}
+	if req.Q != nil {
+		if err := validation.ValidTransactionSearchQuery(*req.Q); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "query.q",
+				Message: err.Error(),
+			})
+		}
+	}
+	if req.MinAmount != nil {
+		if err := validation.ValidTransactionAmountFilter(*req.MinAmount); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "query.min_amount",
+				Message: err.Error(),
+			})
+		}
+	}
+	if req.MaxAmount != nil {
+		if err := validation.ValidTransactionAmountFilter(*req.MaxAmount); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "query.max_amount",
+				Message: err.Error(),
+			})
+		}
+	}
+	if req.Category != nil {
+		if err := validation.ValidTransactionCategoryFilter(*req.Category); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "query.category",
+				Message: err.Error(),
+			})
+		}
+	}
 	if req.Sort != nil {
 		if err := validation.ValidSort(*req.Sort); err != nil {
 			validationErrors = append(validationErrors, response.ValidationError{
@@ -232,5 +368,19 @@ func (h *ListTransactionsHandler) validation(req *ListTransactionsRequest) (vali
 			})
 		}
 	}
+	if req.Cursor != nil {
+		if err := validation.ValidTransactionCursor(*req.Cursor); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "query.cursor",
+				Message: err.Error(),
+			})
+		}
+		if req.Page != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "query.cursor",
+				Message: "cannot be specified together with page",
+			})
+		}
+	}
 	return validationErrors
 }
@@ -3,8 +3,10 @@ package transactions_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -19,6 +21,8 @@ import (
 	"github.com/u104rak1/pocgo/internal/domain/value_object/money"
 	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/transactions"
 	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/numutil"
+	"github.com/u104rak1/pocgo/pkg/strutil"
 	"github.com/u104rak1/pocgo/pkg/timer"
 )
 
@@ -36,8 +40,19 @@ func TestListTransactionsHandler(t *testing.T) {
 		transactionID  = idVO.NewTransactionIDForTest("transaction")
 		transactionAt  = timer.GetFixedDateString()
 		uri            = "/api/v1/me/accounts/" + accountID.String() + "/transactions"
+		nextCursor     = "next-cursor"
+		prevCursor     = "prev-cursor"
 	)
 
+	// page を除いた絞り込み条件と cursor を持つリンクを返す
+	link := func(cursor string, query url.Values) *string {
+		query.Set("cursor", cursor)
+		l := uri + "?" + query.Encode()
+		return &l
+	}
+	validCursor := transactionDomain.Cursor{ID: transactionID}.Encode()
+	precisionErr := fmt.Errorf("%w: amount in JPY must not have decimal places", money.ErrInvalidPrecision)
+
 	tests := []struct {
 		caseName             string
 		requestQuery         string
@@ -55,15 +70,18 @@ func TestListTransactionsHandler(t *testing.T) {
 			},
 			prepare: func(mockListTransactionsUC *appMock.MockIListTransactionsUsecase) {
 				mockListTransactionsUC.EXPECT().Run(mockAny, mockAny).Return(&transactionApp.ListTransactionsDTO{
-					Total: 1,
+					Total:      numutil.IntPointer(1),
+					NextCursor: &nextCursor,
 					Transactions: []transactionApp.ListTransactionDTO{
 						{
 							ID:                transactionID.String(),
 							AccountID:         accountID.String(),
 							ReceiverAccountID: nil,
 							OperationType:     transactionDomain.Deposit,
-							Amount:            1000,
+							Amount:            "1000",
 							Currency:          money.JPY,
+							Direction:         transactionDomain.Credit,
+							SignedAmount:      "1000",
 							TransactionAt:     transactionAt,
 						},
 					},
@@ -71,22 +89,149 @@ func TestListTransactionsHandler(t *testing.T) {
 			},
 			expectedCode: http.StatusOK,
 			expectedResponseBody: transactions.ListTransactionsResponse{
-				Total: 1,
+				Total: numutil.IntPointer(1),
 				Transactions: []transactions.ListTransactionsTransaction{
 					{
 						ID:            transactionID.String(),
 						AccountID:     accountID.String(),
 						OperationType: transactionDomain.Deposit,
-						Amount:        1000,
+						Amount:        "1000",
 						Currency:      money.JPY,
+						Direction:     transactionDomain.Credit,
+						SignedAmount:  "1000",
 						TransactionAt: transactionAt,
 					},
 				},
+				Links: transactions.ListTransactionsLinks{
+					Next: link(nextCursor, url.Values{
+						"from": {from}, "to": {to}, "operation_types": {operationTypes}, "sort": {sort}, "limit": {limit},
+					}),
+				},
+			},
+		},
+		{
+			caseName:     "Positive: カーソルを指定して取引一覧取得に成功する",
+			requestQuery: "?cursor=" + validCursor + "&limit=" + limit,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockListTransactionsUC *appMock.MockIListTransactionsUsecase) {
+				mockListTransactionsUC.EXPECT().Run(mockAny, transactionApp.ListTransactionsCommand{
+					UserID:         userID.String(),
+					AccountID:      accountID.String(),
+					OperationTypes: []string{},
+					Limit:          numutil.IntPointer(10),
+					Cursor:         &validCursor,
+				}).Return(&transactionApp.ListTransactionsDTO{
+					Transactions: []transactionApp.ListTransactionDTO{},
+					NextCursor:   &nextCursor,
+					PrevCursor:   &prevCursor,
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: transactions.ListTransactionsResponse{
+				Transactions: []transactions.ListTransactionsTransaction{},
+				Links: transactions.ListTransactionsLinks{
+					Next: link(nextCursor, url.Values{"limit": {limit}}),
+					Prev: link(prevCursor, url.Values{"limit": {limit}}),
+				},
+			},
+		},
+		{
+			caseName:     "Negative: カーソルとページ番号を同時に指定した場合、Validation Failed を返す",
+			requestQuery: "?cursor=" + validCursor + "&page=" + page,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare:      func(mockListTransactionsUC *appMock.MockIListTransactionsUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
 			},
 		},
 		{
 			caseName:     "Negative: クエリパラメータが無効な場合、Validation Failed を返す",
-			requestQuery: "?from=invalid&to=invalid&operation_types=invalid&sort=invalid&limit=-1&page=-1",
+			requestQuery: "?from=invalid&to=invalid&operation_types=invalid&sort=invalid&limit=-1&page=-1&cursor=invalid",
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare:      func(mockListTransactionsUC *appMock.MockIListTransactionsUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:     "Positive: 検索語、金額、分類で絞り込んで取引一覧取得に成功する",
+			requestQuery: "?q=lunch&min_amount=1000&max_amount=5000&category=food,travel",
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockListTransactionsUC *appMock.MockIListTransactionsUsecase) {
+				mockListTransactionsUC.EXPECT().Run(mockAny, transactionApp.ListTransactionsCommand{
+					UserID:         userID.String(),
+					AccountID:      accountID.String(),
+					OperationTypes: []string{},
+					Query:          strutil.StrPointer("lunch"),
+					MinAmount:      strutil.StrPointer("1000"),
+					MaxAmount:      strutil.StrPointer("5000"),
+					Categories:     []string{"food", "travel"},
+				}).Return(&transactionApp.ListTransactionsDTO{
+					Total: numutil.IntPointer(1),
+					Transactions: []transactionApp.ListTransactionDTO{
+						{
+							ID:            transactionID.String(),
+							AccountID:     accountID.String(),
+							OperationType: transactionDomain.Withdrawal,
+							Amount:        "1200",
+							Currency:      money.JPY,
+							Direction:     transactionDomain.Debit,
+							SignedAmount:  "-1200",
+							Memo:          strutil.StrPointer("Lunch with team"),
+							Categories:    []string{"food"},
+							TransactionAt: transactionAt,
+						},
+					},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: transactions.ListTransactionsResponse{
+				Total: numutil.IntPointer(1),
+				Transactions: []transactions.ListTransactionsTransaction{
+					{
+						ID:            transactionID.String(),
+						AccountID:     accountID.String(),
+						OperationType: transactionDomain.Withdrawal,
+						Amount:        "1200",
+						Currency:      money.JPY,
+						Direction:     transactionDomain.Debit,
+						SignedAmount:  "-1200",
+						Memo:          strutil.StrPointer("Lunch with team"),
+						Categories:    []string{"food"},
+						TransactionAt: transactionAt,
+					},
+				},
+			},
+		},
+		{
+			caseName:     "Negative: 検索語、金額、分類が無効な場合、Validation Failed を返す",
+			requestQuery: "?q=&min_amount=-1&max_amount=abc&category=food,,travel",
 			setupContext: func() context.Context {
 				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
 				return ctx
@@ -103,6 +248,44 @@ func TestListTransactionsHandler(t *testing.T) {
 				},
 			},
 		},
+		{
+			caseName:     "Negative: 金額の下限が上限より大きい場合、Bad Request を返す",
+			requestQuery: "?min_amount=5000&max_amount=1000",
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockListTransactionsUC *appMock.MockIListTransactionsUsecase) {
+				mockListTransactionsUC.EXPECT().Run(mockAny, mockAny).Return(nil, transactionDomain.ErrInvalidAmountRange)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLBadRequest,
+				Title:    response.TitleBadRequest,
+				Status:   http.StatusBadRequest,
+				Detail:   transactionDomain.ErrInvalidAmountRange.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:     "Negative: 金額の小数点以下の桁数が口座の通貨と合わない場合、Bad Request を返す",
+			requestQuery: "?min_amount=10.5",
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+				return ctx
+			},
+			prepare: func(mockListTransactionsUC *appMock.MockIListTransactionsUsecase) {
+				mockListTransactionsUC.EXPECT().Run(mockAny, mockAny).Return(nil, precisionErr)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLBadRequest,
+				Title:    response.TitleBadRequest,
+				Status:   http.StatusBadRequest,
+				Detail:   precisionErr.Error(),
+				Instance: uri,
+			},
+		},
 		{
 			caseName: "Negative: ユーザーIDがコンテキストに存在しない場合、Unauthorized を返す",
 			setupContext: func() context.Context {
@@ -0,0 +1,172 @@
+package transactions
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ReadTransactionHandler struct {
+	readTransactionUC transactionApp.IReadTransactionUsecase
+}
+
+func NewReadTransactionHandler(readTransactionUC transactionApp.IReadTransactionUsecase) *ReadTransactionHandler {
+	return &ReadTransactionHandler{
+		readTransactionUC: readTransactionUC,
+	}
+}
+
+type ReadTransactionParams struct {
+	AccountID     string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+	TransactionID string `param:"transaction_id" example:"01J9R8AJ1Q2YDH1X9836GS9E89"`
+}
+
+type ReadTransactionRequest struct {
+	ReadTransactionParams
+}
+
+type ReadTransactionResponse struct {
+	// 取引ID
+	ID string `json:"id" example:"01J9R8AJ1Q2YDH1X9836GS9E89"`
+
+	// 口座ID
+	AccountID string `json:"accountId" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+
+	// 受取口座ID
+	ReceiverAccountID *string `json:"receiverAccountId" example:"01J9R8AJ1Q2YDH1X9836GS9D87"`
+
+	// 取引種別
+	OperationType string `json:"operationType" example:"DEPOSIT"`
+
+	// 取り消した取引ID (REVERSALの場合)
+	ReversedTransactionID *string `json:"reversedTransactionId" example:"01J9R8AJ1Q2YDH1X9836GS9E88"`
+
+	// 取引金額
+	Amount json.Number `json:"amount" swaggertype:"number" example:"1000"`
+
+	// 通貨
+	Currency string `json:"currency" example:"JPY"`
+
+	// 受取金額 (TRANSFERの場合、受取口座の通貨での入金額)
+	ReceiverAmount *json.Number `json:"receiverAmount" swaggertype:"number" example:"6.67"`
+
+	// 受取通貨 (TRANSFERの場合)
+	ReceiverCurrency *string `json:"receiverCurrency" example:"USD"`
+
+	// 為替レート (通貨が異なるTRANSFERの場合、送金通貨1単位あたりの受取通貨の価格)
+	ExchangeRate *json.Number `json:"exchangeRate" swaggertype:"number" example:"0.006667"`
+
+	// 口座から見た取引の向き (入金と受け取った振込は CREDIT、出金と送金した振込は DEBIT、REVERSALは取り消した取引と逆の向き)
+	Direction string `json:"direction" example:"CREDIT"`
+
+	// 口座の通貨での符号付きの増減額 (DEBIT の場合は負の値)
+	SignedAmount json.Number `json:"signedAmount" swaggertype:"number" example:"1000"`
+
+	// 取引後の口座残高 (残高の記録を始める前の取引の場合は null)
+	BalanceAfter *json.Number `json:"balanceAfter" swaggertype:"number" example:"1000"`
+
+	// メモ (取引を行った口座の場合のみ)
+	Memo *string `json:"memo" example:"Lunch with team"`
+
+	// 参照情報
+	Reference *string `json:"reference" example:"INV-2024-0001"`
+
+	// 口座が取引に付けた分類
+	Categories []string `json:"categories" example:"food,travel"`
+
+	// 取引日時
+	TransactionAt string `json:"transactionAt" example:"2024-03-20T15:00:00Z"`
+}
+
+// @Summary 取引の取得
+// @Description 指定された口座の取引を取得します。他の口座から受け取った振込も取得できます。
+// @Tags Transaction API
+// @Security BearerAuth
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param transaction_id path string true "取引ID"
+// @Success 200 {object} ReadTransactionResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/transactions/{transaction_id} [get]
+func (h *ReadTransactionHandler) Run(ctx echo.Context) error {
+	req := new(ReadTransactionRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.readTransactionUC.Run(ctx.Request().Context(), transactionApp.ReadTransactionCommand{
+		UserID:        userID,
+		AccountID:     req.AccountID,
+		TransactionID: req.TransactionID,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound, transactionDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, newReadTransactionResponse(dto))
+}
+
+func newReadTransactionResponse(dto *transactionApp.ReadTransactionDTO) ReadTransactionResponse {
+	return ReadTransactionResponse{
+		ID:                    dto.ID,
+		AccountID:             dto.AccountID,
+		ReceiverAccountID:     dto.ReceiverAccountID,
+		OperationType:         dto.OperationType,
+		ReversedTransactionID: dto.ReversedTransactionID,
+		Amount:                json.Number(dto.Amount),
+		Currency:              dto.Currency,
+		ReceiverAmount:        decimalPointer(dto.ReceiverAmount),
+		ReceiverCurrency:      dto.ReceiverCurrency,
+		ExchangeRate:          decimalPointer(dto.ExchangeRate),
+		Direction:             dto.Direction,
+		SignedAmount:          json.Number(dto.SignedAmount),
+		BalanceAfter:          decimalPointer(dto.BalanceAfter),
+		Memo:                  dto.Memo,
+		Reference:             dto.Reference,
+		Categories:            dto.Categories,
+		TransactionAt:         dto.TransactionAt,
+	}
+}
+
+func (h *ReadTransactionHandler) validation(req *ReadTransactionRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "param.account_id",
+			Message: err.Error(),
+		})
+	}
+	if err := validation.ValidULID(req.TransactionID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "param.transaction_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
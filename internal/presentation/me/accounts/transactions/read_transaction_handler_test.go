@@ -0,0 +1,233 @@
+package transactions_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/transactions"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestReadTransactionHandler(t *testing.T) {
+	var (
+		userID        = idVO.NewUserIDForTest("user")
+		accountID     = idVO.NewAccountIDForTest("account")
+		transactionID = idVO.NewTransactionIDForTest("transaction")
+		transactionAt = timer.GetFixedDateString()
+		balanceAfter  = json.Number("2000")
+		arg           = gomock.Any()
+		uri           = "/api/v1/me/accounts/" + accountID.String() + "/transactions/" + transactionID.String()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+
+	tests := []struct {
+		caseName             string
+		accountID            string
+		transactionID        string
+		setupContext         func() context.Context
+		prepare              func(mockReadTransactionUC *appMock.MockIReadTransactionUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:      "Positive: 取引の取得に成功する",
+			accountID:     accountID.String(),
+			transactionID: transactionID.String(),
+			setupContext:  happyContext,
+			prepare: func(mockReadTransactionUC *appMock.MockIReadTransactionUsecase) {
+				mockReadTransactionUC.EXPECT().Run(arg, transactionApp.ReadTransactionCommand{
+					UserID:        userID.String(),
+					AccountID:     accountID.String(),
+					TransactionID: transactionID.String(),
+				}).Return(&transactionApp.ReadTransactionDTO{
+					ID:            transactionID.String(),
+					AccountID:     accountID.String(),
+					OperationType: transactionDomain.Deposit,
+					Amount:        "1000",
+					Currency:      money.JPY,
+					Direction:     transactionDomain.Credit,
+					SignedAmount:  "1000",
+					BalanceAfter:  strutil.StrPointer("2000"),
+					TransactionAt: transactionAt,
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: transactions.ReadTransactionResponse{
+				ID:            transactionID.String(),
+				AccountID:     accountID.String(),
+				OperationType: transactionDomain.Deposit,
+				Amount:        "1000",
+				Currency:      money.JPY,
+				Direction:     transactionDomain.Credit,
+				SignedAmount:  "1000",
+				BalanceAfter:  &balanceAfter,
+				TransactionAt: transactionAt,
+			},
+		},
+		{
+			caseName:      "Negative: 口座IDと取引IDが不正な場合、Validation Failed を返す",
+			accountID:     "invalid",
+			transactionID: "invalid",
+			setupContext:  happyContext,
+			prepare:       func(mockReadTransactionUC *appMock.MockIReadTransactionUsecase) {},
+			expectedCode:  http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: "/api/v1/me/accounts/invalid/transactions/invalid",
+				},
+			},
+		},
+		{
+			caseName:      "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			accountID:     accountID.String(),
+			transactionID: transactionID.String(),
+			setupContext: func() context.Context {
+				return context.Background()
+			},
+			prepare:      func(mockReadTransactionUC *appMock.MockIReadTransactionUsecase) {},
+			expectedCode: http.StatusUnauthorized,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLUnauthorized,
+				Title:    response.TitleUnauthorized,
+				Status:   http.StatusUnauthorized,
+				Detail:   config.ErrUserIDMissing.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:      "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			accountID:     accountID.String(),
+			transactionID: transactionID.String(),
+			setupContext:  happyContext,
+			prepare: func(mockReadTransactionUC *appMock.MockIReadTransactionUsecase) {
+				mockReadTransactionUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			expectedCode: http.StatusForbidden,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLForbidden,
+				Title:    response.TitleForbidden,
+				Status:   http.StatusForbidden,
+				Detail:   accountDomain.ErrUnauthorized.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:      "Negative: 口座が見つからない場合、Not Found を返す",
+			accountID:     accountID.String(),
+			transactionID: transactionID.String(),
+			setupContext:  happyContext,
+			prepare: func(mockReadTransactionUC *appMock.MockIReadTransactionUsecase) {
+				mockReadTransactionUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLNotFound,
+				Title:    response.TitleNotFound,
+				Status:   http.StatusNotFound,
+				Detail:   accountDomain.ErrNotFound.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:      "Negative: 取引が見つからない場合、Not Found を返す",
+			accountID:     accountID.String(),
+			transactionID: transactionID.String(),
+			setupContext:  happyContext,
+			prepare: func(mockReadTransactionUC *appMock.MockIReadTransactionUsecase) {
+				mockReadTransactionUC.EXPECT().Run(arg, arg).Return(nil, transactionDomain.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLNotFound,
+				Title:    response.TitleNotFound,
+				Status:   http.StatusNotFound,
+				Detail:   transactionDomain.ErrNotFound.Error(),
+				Instance: uri,
+			},
+		},
+		{
+			caseName:      "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			accountID:     accountID.String(),
+			transactionID: transactionID.String(),
+			setupContext:  happyContext,
+			prepare: func(mockReadTransactionUC *appMock.MockIReadTransactionUsecase) {
+				mockReadTransactionUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLInternalServerError,
+				Title:    response.TitleInternalServerError,
+				Status:   http.StatusInternalServerError,
+				Detail:   assert.AnError.Error(),
+				Instance: uri,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/me/accounts/"+tt.accountID+"/transactions/"+tt.transactionID, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id", "transaction_id")
+			ctx.SetParamValues(tt.accountID, tt.transactionID)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockReadTransactionUC := appMock.NewMockIReadTransactionUsecase(ctrl)
+			tt.prepare(mockReadTransactionUC)
+
+			h := transactions.NewReadTransactionHandler(mockReadTransactionUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp transactions.ReadTransactionResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Len(t, resp.Errors, 2)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package transactions
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ReverseTransactionHandler struct {
+	reverseTransactionUC transactionApp.IReverseTransactionUsecase
+}
+
+func NewReverseTransactionHandler(reverseTransactionUC transactionApp.IReverseTransactionUsecase) *ReverseTransactionHandler {
+	return &ReverseTransactionHandler{
+		reverseTransactionUC: reverseTransactionUC,
+	}
+}
+
+type ReverseTransactionParams struct {
+	AccountID     string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+	TransactionID string `param:"transaction_id" example:"01J9R8AJ1Q2YDH1X9836GS9E89"`
+}
+
+type ReverseTransactionRequestBody struct {
+	// 口座パスワード
+	Password string `json:"password" example:"1234"`
+}
+
+type ReverseTransactionRequest struct {
+	ReverseTransactionParams
+	ReverseTransactionRequestBody
+}
+
+type ReverseTransactionResponse struct {
+	// 取消の取引ID
+	ID string `json:"id" example:"01J9R8AJ1Q2YDH1X9836GS9E90"`
+
+	// 口座ID
+	AccountID string `json:"accountId" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+
+	// 受取口座ID (振込を取り消した場合、資金を戻した口座)
+	ReceiverAccountID *string `json:"receiverAccountId" example:"01J9R8AJ1Q2YDH1X9836GS9D87"`
+
+	// 取引種別 (REVERSAL)
+	OperationType string `json:"operationType" example:"REVERSAL"`
+
+	// 取り消した取引ID
+	ReversedTransactionID string `json:"reversedTransactionId" example:"01J9R8AJ1Q2YDH1X9836GS9E89"`
+
+	// 取引金額
+	Amount json.Number `json:"amount" swaggertype:"number" example:"1000"`
+
+	// 通貨
+	Currency string `json:"currency" example:"JPY"`
+
+	// 受取金額 (振込を取り消した場合、受取口座から戻した金額)
+	ReceiverAmount *json.Number `json:"receiverAmount" swaggertype:"number" example:"6.67"`
+
+	// 受取通貨 (振込を取り消した場合)
+	ReceiverCurrency *string `json:"receiverCurrency" example:"USD"`
+
+	// 為替レート (取り消した振込に適用した為替レート)
+	ExchangeRate *json.Number `json:"exchangeRate" swaggertype:"number" example:"0.006667"`
+
+	// 口座から見た取引の向き (取り消した取引と逆の向き)
+	Direction string `json:"direction" example:"CREDIT"`
+
+	// 口座の通貨での符号付きの増減額 (DEBIT の場合は負の値)
+	SignedAmount json.Number `json:"signedAmount" swaggertype:"number" example:"1000"`
+
+	// 取消後の口座残高
+	BalanceAfter *json.Number `json:"balanceAfter" swaggertype:"number" example:"1000"`
+
+	// 参照情報 (取り消した取引から引き継がれます)
+	Reference *string `json:"reference" example:"INV-2024-0001"`
+
+	// 取引日時
+	TransactionAt string `json:"transactionAt" example:"2024-03-20T15:00:00Z"`
+}
+
+// @Summary 取引の取消
+// @Description 完了した取引を取り消し、取引と逆向きに残高を戻す取消の取引 (REVERSAL) を作成します。
+// @Description 取り消せるのは取引を行った口座の入金と振込のみで、1つの取引は1回しか取り消せません。出金と取消の取引は取り消せません。
+// @Description 振込の場合は受取口座の残高も戻します。受取口座が受け取った資金を既に使っている場合は取り消せません。
+// @Tags Transaction API
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "操作する口座ID"
+// @Param transaction_id path string true "取り消す取引ID"
+// @Param request body ReverseTransactionRequestBody true "Request Body"
+// @Success 201 {object} ReverseTransactionResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 409 {object} response.ProblemDetail "Conflict"
+// @Failure 422 {object} response.ProblemDetail "Unprocessable Entity"
+// @Failure 429 {object} response.ProblemDetail "Too Many Requests"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id}/transactions/{transaction_id}/reversal [post]
+func (h *ReverseTransactionHandler) Run(ctx echo.Context) error {
+	req := new(ReverseTransactionRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.reverseTransactionUC.Run(ctx.Request().Context(), transactionApp.ReverseTransactionCommand{
+		UserID:        userID,
+		AccountID:     req.AccountID,
+		TransactionID: req.TransactionID,
+		Password:      req.Password,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized,
+			accountDomain.ErrUnmatchedPassword,
+			transactionDomain.ErrReversalNotAllowed:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound,
+			accountDomain.ErrReceiverNotFound,
+			transactionDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		case accountDomain.ErrConcurrentModification,
+			transactionDomain.ErrAlreadyReversed:
+			return response.Conflict(ctx, err)
+		case transactionDomain.ErrNotReversible,
+			transactionDomain.ErrReceiverInsufficientBalance,
+			moneyVO.ErrInsufficientBalance:
+			return response.UnprocessableEntity(ctx, err)
+		case lockoutDomain.ErrLocked:
+			return response.TooManyRequests(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, ReverseTransactionResponse{
+		ID:                    dto.ID,
+		AccountID:             dto.AccountID,
+		ReceiverAccountID:     dto.ReceiverAccountID,
+		OperationType:         dto.OperationType,
+		ReversedTransactionID: dto.ReversedTransactionID,
+		Amount:                json.Number(dto.Amount),
+		Currency:              dto.Currency,
+		ReceiverAmount:        decimalPointer(dto.ReceiverAmount),
+		ReceiverCurrency:      dto.ReceiverCurrency,
+		ExchangeRate:          decimalPointer(dto.ExchangeRate),
+		Direction:             dto.Direction,
+		SignedAmount:          json.Number(dto.SignedAmount),
+		BalanceAfter:          decimalPointer(dto.BalanceAfter),
+		Reference:             dto.Reference,
+		TransactionAt:         dto.TransactionAt,
+	})
+}
+
+func (h *ReverseTransactionHandler) validation(req *ReverseTransactionRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "account_id",
+			Message: err.Error(),
+		})
+	}
+	if err := validation.ValidULID(req.TransactionID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "transaction_id",
+			Message: err.Error(),
+		})
+	}
+	if err := validation.ValidAccountPassword(req.Password); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "password",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,267 @@
+package transactions_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts/transactions"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestReverseTransactionHandler(t *testing.T) {
+	var (
+		userID            = idVO.NewUserIDForTest("user")
+		accountID         = idVO.NewAccountIDForTest("account")
+		receiverAccountID = idVO.NewAccountIDForTest("receiver").String()
+		transactionID     = idVO.NewTransactionIDForTest("transaction")
+		reversalID        = idVO.NewTransactionIDForTest("reversal")
+		transactionAt     = timer.GetFixedDateString()
+		password          = "1234"
+		receiverAmount    = json.Number("6.67")
+		balanceAfter      = json.Number("2000")
+		arg               = gomock.Any()
+	)
+
+	uri := func(transactionID string) string {
+		return "/api/v1/me/accounts/" + accountID.String() + "/transactions/" + transactionID + "/reversal"
+	}
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri(transactionID.String()),
+		}
+	}
+	happyRequestBody := transactions.ReverseTransactionRequestBody{Password: password}
+
+	tests := []struct {
+		caseName             string
+		transactionID        string
+		requestBody          interface{}
+		setupContext         func() context.Context
+		prepare              func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:      "Positive: 振込の取消に成功する",
+			transactionID: transactionID.String(),
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase) {
+				mockReverseTransactionUC.EXPECT().Run(arg, transactionApp.ReverseTransactionCommand{
+					UserID:        userID.String(),
+					AccountID:     accountID.String(),
+					TransactionID: transactionID.String(),
+					Password:      password,
+				}).Return(&transactionApp.ReverseTransactionDTO{
+					ID:                    reversalID.String(),
+					AccountID:             accountID.String(),
+					ReceiverAccountID:     &receiverAccountID,
+					OperationType:         transactionDomain.Reversal,
+					ReversedTransactionID: transactionID.String(),
+					Amount:                "1000",
+					Currency:              money.JPY,
+					ReceiverAmount:        strutil.StrPointer("6.67"),
+					ReceiverCurrency:      strutil.StrPointer(money.USD),
+					ExchangeRate:          strutil.StrPointer("0.00667"),
+					Direction:             transactionDomain.Credit,
+					SignedAmount:          "1000",
+					BalanceAfter:          strutil.StrPointer("2000"),
+					TransactionAt:         transactionAt,
+				}, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedResponseBody: transactions.ReverseTransactionResponse{
+				ID:                    reversalID.String(),
+				AccountID:             accountID.String(),
+				ReceiverAccountID:     &receiverAccountID,
+				OperationType:         transactionDomain.Reversal,
+				ReversedTransactionID: transactionID.String(),
+				Amount:                "1000",
+				Currency:              money.JPY,
+				ReceiverAmount:        &receiverAmount,
+				ReceiverCurrency:      strutil.StrPointer(money.USD),
+				ExchangeRate:          func() *json.Number { n := json.Number("0.00667"); return &n }(),
+				Direction:             transactionDomain.Credit,
+				SignedAmount:          "1000",
+				BalanceAfter:          &balanceAfter,
+				TransactionAt:         transactionAt,
+			},
+		},
+		{
+			caseName:      "Negative: 取引IDとパスワードが不正な場合、Validation Failed を返す",
+			transactionID: "invalid",
+			requestBody:   transactions.ReverseTransactionRequestBody{Password: "12"},
+			setupContext:  happyContext,
+			prepare:       func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase) {},
+			expectedCode:  http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri("invalid"),
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			transactionID:        transactionID.String(),
+			requestBody:          happyRequestBody,
+			setupContext:         context.Background,
+			prepare:              func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:      "Negative: 受け取った振込を取り消そうとした場合、Forbidden を返す",
+			transactionID: transactionID.String(),
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase) {
+				mockReverseTransactionUC.EXPECT().Run(arg, arg).Return(nil, transactionDomain.ErrReversalNotAllowed)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, transactionDomain.ErrReversalNotAllowed),
+		},
+		{
+			caseName:      "Negative: 取引が見つからない場合、Not Found を返す",
+			transactionID: transactionID.String(),
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase) {
+				mockReverseTransactionUC.EXPECT().Run(arg, arg).Return(nil, transactionDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, transactionDomain.ErrNotFound),
+		},
+		{
+			caseName:      "Negative: 既に取り消された取引の場合、Conflict を返す",
+			transactionID: transactionID.String(),
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase) {
+				mockReverseTransactionUC.EXPECT().Run(arg, arg).Return(nil, transactionDomain.ErrAlreadyReversed)
+			},
+			expectedCode:         http.StatusConflict,
+			expectedResponseBody: problem(http.StatusConflict, response.TypeURLConflict, response.TitleConflict, transactionDomain.ErrAlreadyReversed),
+		},
+		{
+			caseName:      "Negative: 受取口座が資金を既に使っている場合、Unprocessable Entity を返す",
+			transactionID: transactionID.String(),
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase) {
+				mockReverseTransactionUC.EXPECT().Run(arg, arg).Return(nil, transactionDomain.ErrReceiverInsufficientBalance)
+			},
+			expectedCode:         http.StatusUnprocessableEntity,
+			expectedResponseBody: problem(http.StatusUnprocessableEntity, response.TypeURLUnprocessableEntity, response.TitleUnprocessableEntity, transactionDomain.ErrReceiverInsufficientBalance),
+		},
+		{
+			caseName:      "Negative: 口座がロックされている場合、Too Many Requests を返す",
+			transactionID: transactionID.String(),
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase) {
+				mockReverseTransactionUC.EXPECT().Run(arg, arg).Return(nil, lockoutDomain.ErrLocked)
+			},
+			expectedCode:         http.StatusTooManyRequests,
+			expectedResponseBody: problem(http.StatusTooManyRequests, response.TypeURLTooManyRequests, response.TitleTooManyRequests, lockoutDomain.ErrLocked),
+		},
+		{
+			caseName:      "Negative: パスワードが一致しない場合、Forbidden を返す",
+			transactionID: transactionID.String(),
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase) {
+				mockReverseTransactionUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnmatchedPassword)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnmatchedPassword),
+		},
+		{
+			caseName:      "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			transactionID: transactionID.String(),
+			requestBody:   happyRequestBody,
+			setupContext:  happyContext,
+			prepare: func(mockReverseTransactionUC *appMock.MockIReverseTransactionUsecase) {
+				mockReverseTransactionUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, uri(tt.transactionID), bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("account_id", "transaction_id")
+			ctx.SetParamValues(accountID.String(), tt.transactionID)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockReverseTransactionUC := appMock.NewMockIReverseTransactionUsecase(ctrl)
+			tt.prepare(mockReverseTransactionUC)
+
+			h := transactions.NewReverseTransactionHandler(mockReverseTransactionUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusCreated {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusCreated, rec.Code)
+				var resp transactions.ReverseTransactionResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Len(t, resp.Errors, 2)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
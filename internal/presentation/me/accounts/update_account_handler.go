@@ -0,0 +1,128 @@
+package accounts
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	accountApp "github.com/u104rak1/pocgo/internal/application/account"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type UpdateAccountHandler struct {
+	updateAccountUC accountApp.IUpdateAccountUsecase
+}
+
+func NewUpdateAccountHandler(updateAccountUsecase accountApp.IUpdateAccountUsecase) *UpdateAccountHandler {
+	return &UpdateAccountHandler{
+		updateAccountUC: updateAccountUsecase,
+	}
+}
+
+type UpdateAccountParams struct {
+	AccountID string `param:"account_id" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type UpdateAccountRequestBody struct {
+	// 3 ～ 20 文字の新しいアカウント名
+	Name string `json:"name" example:"For private"`
+}
+
+type UpdateAccountRequest struct {
+	UpdateAccountParams
+	UpdateAccountRequestBody
+}
+
+type UpdateAccountResponse struct {
+	// 口座ID
+	ID string `json:"id" example:"01J9R7YPV1FH1V0PPKVSB5C7LE"`
+
+	// 口座名
+	Name string `json:"name" example:"For private"`
+
+	// 口座残高
+	Balance json.Number `json:"balance" swaggertype:"number" example:"1000"`
+
+	// 通貨
+	Currency string `json:"currency" example:"JPY"`
+
+	// 口座の更新日時
+	UpdatedAt string `json:"updatedAt" example:"2021-08-01T00:00:00Z"`
+}
+
+// @Summary 口座名の変更
+// @Description 指定された口座の名前を変更します。
+// @Tags Account API
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "口座ID"
+// @Param request body UpdateAccountRequestBody true "Request Body"
+// @Success 200 {object} UpdateAccountResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 409 {object} response.ProblemDetail "Conflict"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/accounts/{account_id} [patch]
+func (h *UpdateAccountHandler) Run(ctx echo.Context) error {
+	req := new(UpdateAccountRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.updateAccountUC.Run(ctx.Request().Context(), accountApp.UpdateAccountCommand{
+		UserID:    userID,
+		AccountID: req.AccountID,
+		Name:      req.Name,
+	})
+	if err != nil {
+		switch err {
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		case accountDomain.ErrConcurrentModification:
+			return response.Conflict(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, UpdateAccountResponse{
+		ID:        dto.ID,
+		Name:      dto.Name,
+		Balance:   json.Number(dto.Balance),
+		Currency:  dto.Currency,
+		UpdatedAt: dto.UpdatedAt,
+	})
+}
+
+func (h *UpdateAccountHandler) validation(req *UpdateAccountRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "param.account_id",
+			Message: err.Error(),
+		})
+	}
+	if err := validation.ValidAccountName(req.Name); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "body.name",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,84 @@
+package payees
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ConfirmPayeeHandler struct {
+	confirmPayeeUC payeeApp.IConfirmPayeeUsecase
+}
+
+func NewConfirmPayeeHandler(confirmPayeeUsecase payeeApp.IConfirmPayeeUsecase) *ConfirmPayeeHandler {
+	return &ConfirmPayeeHandler{
+		confirmPayeeUC: confirmPayeeUsecase,
+	}
+}
+
+type ConfirmPayeeParams struct {
+	PayeeID string `param:"payee_id" example:"01J9R8AJ1Q2YDH1X9836GS9P12"`
+}
+
+type ConfirmPayeeRequest struct {
+	ConfirmPayeeParams
+}
+
+// @Summary 支払先の確認
+// @Description 伏せた名前を確認した支払先を確認済みにします。確認済みの支払先にのみ支払先IDで振込ができます。
+// @Description 既に確認済みの場合は何もせずに支払先を返します。
+// @Tags Payee API
+// @Security BearerAuth
+// @Produce json
+// @Param payee_id path string true "支払先ID"
+// @Success 200 {object} PayeeResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/payees/{payee_id}/confirmation [post]
+func (h *ConfirmPayeeHandler) Run(ctx echo.Context) error {
+	req := new(ConfirmPayeeRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.confirmPayeeUC.Run(ctx.Request().Context(), payeeApp.ConfirmPayeeCommand{
+		UserID:  userID,
+		PayeeID: req.PayeeID,
+	})
+	if err != nil {
+		switch err {
+		case payeeDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, newPayeeResponse(*dto))
+}
+
+func (h *ConfirmPayeeHandler) validation(req *ConfirmPayeeRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.PayeeID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "payee_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
@@ -0,0 +1,82 @@
+package payees
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type DeletePayeeHandler struct {
+	deletePayeeUC payeeApp.IDeletePayeeUsecase
+}
+
+func NewDeletePayeeHandler(deletePayeeUsecase payeeApp.IDeletePayeeUsecase) *DeletePayeeHandler {
+	return &DeletePayeeHandler{
+		deletePayeeUC: deletePayeeUsecase,
+	}
+}
+
+type DeletePayeeParams struct {
+	PayeeID string `param:"payee_id" example:"01J9R8AJ1Q2YDH1X9836GS9P12"`
+}
+
+type DeletePayeeRequest struct {
+	DeletePayeeParams
+}
+
+// @Summary 支払先の削除
+// @Description 指定された支払先を削除します。支払先IDで行った振込の履歴は影響を受けません。
+// @Tags Payee API
+// @Security BearerAuth
+// @Param payee_id path string true "支払先ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/payees/{payee_id} [delete]
+func (h *DeletePayeeHandler) Run(ctx echo.Context) error {
+	req := new(DeletePayeeRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	err := h.deletePayeeUC.Run(ctx.Request().Context(), payeeApp.DeletePayeeCommand{
+		UserID:  userID,
+		PayeeID: req.PayeeID,
+	})
+	if err != nil {
+		switch err {
+		case payeeDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (h *DeletePayeeHandler) validation(req *DeletePayeeRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.PayeeID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "payee_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
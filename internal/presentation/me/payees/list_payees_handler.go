@@ -0,0 +1,56 @@
+package payees
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ListPayeesHandler struct {
+	listPayeesUC payeeApp.IListPayeesUsecase
+}
+
+func NewListPayeesHandler(listPayeesUsecase payeeApp.IListPayeesUsecase) *ListPayeesHandler {
+	return &ListPayeesHandler{
+		listPayeesUC: listPayeesUsecase,
+	}
+}
+
+type ListPayeesResponse struct {
+	// 支払先の一覧 (登録日時の昇順)
+	Payees []PayeeResponse `json:"payees"`
+}
+
+// @Summary 支払先の一覧
+// @Description 登録している支払先の一覧を取得します。
+// @Tags Payee API
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} ListPayeesResponse
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/payees [get]
+func (h *ListPayeesHandler) Run(ctx echo.Context) error {
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.listPayeesUC.Run(ctx.Request().Context(), payeeApp.ListPayeesCommand{
+		UserID: userID,
+	})
+	if err != nil {
+		return response.InternalServerError(ctx, err)
+	}
+
+	payees := make([]PayeeResponse, 0, len(dto.Payees))
+	for _, payee := range dto.Payees {
+		payees = append(payees, newPayeeResponse(payee))
+	}
+	return ctx.JSON(http.StatusOK, ListPayeesResponse{
+		Payees: payees,
+	})
+}
@@ -0,0 +1,144 @@
+package payees_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/presentation/me/payees"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestListPayeesHandler(t *testing.T) {
+	var (
+		userID      = idVO.NewUserIDForTest("user")
+		payeeID     = idVO.NewPayeeIDForTest("payee")
+		confirmedAt = timer.GetFixedDateString()
+		uri         = "/api/v1/me/payees"
+		arg         = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		setupContext         func() context.Context
+		prepare              func(mockListPayeesUC *appMock.MockIListPayeesUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 支払先一覧の取得に成功する",
+			setupContext: happyContext,
+			prepare: func(mockListPayeesUC *appMock.MockIListPayeesUsecase) {
+				mockListPayeesUC.EXPECT().Run(arg, payeeApp.ListPayeesCommand{
+					UserID: userID.String(),
+				}).Return(&payeeApp.ListPayeesDTO{
+					Payees: []payeeApp.PayeeDTO{
+						{
+							ID:          payeeID.String(),
+							MaskedName:  "S*** T***",
+							Confirmed:   true,
+							ConfirmedAt: &confirmedAt,
+							CreatedAt:   timer.GetFixedDateString(),
+						},
+					},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: payees.ListPayeesResponse{
+				Payees: []payees.PayeeResponse{
+					{
+						ID:          payeeID.String(),
+						MaskedName:  "S*** T***",
+						Confirmed:   true,
+						ConfirmedAt: &confirmedAt,
+						CreatedAt:   timer.GetFixedDateString(),
+					},
+				},
+			},
+		},
+		{
+			caseName:     "Positive: 支払先がない場合、空の一覧を返す",
+			setupContext: happyContext,
+			prepare: func(mockListPayeesUC *appMock.MockIListPayeesUsecase) {
+				mockListPayeesUC.EXPECT().Run(arg, arg).Return(&payeeApp.ListPayeesDTO{Payees: []payeeApp.PayeeDTO{}}, nil)
+			},
+			expectedCode:         http.StatusOK,
+			expectedResponseBody: payees.ListPayeesResponse{Payees: []payees.PayeeResponse{}},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			setupContext:         context.Background,
+			prepare:              func(mockListPayeesUC *appMock.MockIListPayeesUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			setupContext: happyContext,
+			prepare: func(mockListPayeesUC *appMock.MockIListPayeesUsecase) {
+				mockListPayeesUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, uri, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockListPayeesUC := appMock.NewMockIListPayeesUsecase(ctrl)
+			tt.prepare(mockListPayeesUC)
+
+			h := payees.NewListPayeesHandler(mockListPayeesUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp payees.ListPayeesResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				resp, ok := he.Message.(response.ProblemDetail)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			}
+		})
+	}
+}
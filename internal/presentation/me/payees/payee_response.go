@@ -0,0 +1,36 @@
+package payees
+
+import (
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+)
+
+type PayeeResponse struct {
+	// 支払先ID
+	ID string `json:"id" example:"01J9R8AJ1Q2YDH1X9836GS9P12"`
+
+	// 支払先の利用者の名前 (各単語の先頭の1文字以外を伏せた名前)
+	MaskedName string `json:"maskedName" example:"S*** T***"`
+
+	// ニックネーム
+	Nickname *string `json:"nickname" example:"Landlord"`
+
+	// 確認済みかどうか (確認済みの支払先にのみ支払先IDで振込ができます)
+	Confirmed bool `json:"confirmed" example:"true"`
+
+	// 確認日時 (確認済みの場合)
+	ConfirmedAt *string `json:"confirmedAt" example:"2026-10-17T09:05:00Z"`
+
+	// 登録日時
+	CreatedAt string `json:"createdAt" example:"2026-10-17T09:00:00Z"`
+}
+
+func newPayeeResponse(dto payeeApp.PayeeDTO) PayeeResponse {
+	return PayeeResponse{
+		ID:          dto.ID,
+		MaskedName:  dto.MaskedName,
+		Nickname:    dto.Nickname,
+		Confirmed:   dto.Confirmed,
+		ConfirmedAt: dto.ConfirmedAt,
+		CreatedAt:   dto.CreatedAt,
+	}
+}
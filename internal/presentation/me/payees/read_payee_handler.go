@@ -0,0 +1,83 @@
+package payees
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ReadPayeeHandler struct {
+	readPayeeUC payeeApp.IReadPayeeUsecase
+}
+
+func NewReadPayeeHandler(readPayeeUsecase payeeApp.IReadPayeeUsecase) *ReadPayeeHandler {
+	return &ReadPayeeHandler{
+		readPayeeUC: readPayeeUsecase,
+	}
+}
+
+type ReadPayeeParams struct {
+	PayeeID string `param:"payee_id" example:"01J9R8AJ1Q2YDH1X9836GS9P12"`
+}
+
+type ReadPayeeRequest struct {
+	ReadPayeeParams
+}
+
+// @Summary 支払先の取得
+// @Description 指定された支払先を取得します。
+// @Tags Payee API
+// @Security BearerAuth
+// @Produce json
+// @Param payee_id path string true "支払先ID"
+// @Success 200 {object} PayeeResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/payees/{payee_id} [get]
+func (h *ReadPayeeHandler) Run(ctx echo.Context) error {
+	req := new(ReadPayeeRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.readPayeeUC.Run(ctx.Request().Context(), payeeApp.ReadPayeeCommand{
+		UserID:  userID,
+		PayeeID: req.PayeeID,
+	})
+	if err != nil {
+		switch err {
+		case payeeDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, newPayeeResponse(*dto))
+}
+
+func (h *ReadPayeeHandler) validation(req *ReadPayeeRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidULID(req.PayeeID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "payee_id",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
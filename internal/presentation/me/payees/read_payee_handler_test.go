@@ -0,0 +1,165 @@
+package payees_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/presentation/me/payees"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestReadPayeeHandler(t *testing.T) {
+	var (
+		userID  = idVO.NewUserIDForTest("user")
+		payeeID = idVO.NewPayeeIDForTest("payee")
+		arg     = gomock.Any()
+	)
+
+	uri := func(payeeID string) string {
+		return "/api/v1/me/payees/" + payeeID
+	}
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri(payeeID.String()),
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		payeeID              string
+		setupContext         func() context.Context
+		prepare              func(mockReadPayeeUC *appMock.MockIReadPayeeUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 支払先の取得に成功する",
+			payeeID:      payeeID.String(),
+			setupContext: happyContext,
+			prepare: func(mockReadPayeeUC *appMock.MockIReadPayeeUsecase) {
+				mockReadPayeeUC.EXPECT().Run(arg, payeeApp.ReadPayeeCommand{
+					UserID:  userID.String(),
+					PayeeID: payeeID.String(),
+				}).Return(&payeeApp.PayeeDTO{
+					ID:         payeeID.String(),
+					MaskedName: "S*** T***",
+					CreatedAt:  timer.GetFixedDateString(),
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: payees.PayeeResponse{
+				ID:         payeeID.String(),
+				MaskedName: "S*** T***",
+				CreatedAt:  timer.GetFixedDateString(),
+			},
+		},
+		{
+			caseName:     "Negative: 支払先IDが不正な場合、Validation Failed を返す",
+			payeeID:      "invalid",
+			setupContext: happyContext,
+			prepare:      func(mockReadPayeeUC *appMock.MockIReadPayeeUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri("invalid"),
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			payeeID:              payeeID.String(),
+			setupContext:         context.Background,
+			prepare:              func(mockReadPayeeUC *appMock.MockIReadPayeeUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 支払先が見つからない場合、Not Found を返す",
+			payeeID:      payeeID.String(),
+			setupContext: happyContext,
+			prepare: func(mockReadPayeeUC *appMock.MockIReadPayeeUsecase) {
+				mockReadPayeeUC.EXPECT().Run(arg, arg).Return(nil, payeeDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, payeeDomain.ErrNotFound),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			payeeID:      payeeID.String(),
+			setupContext: happyContext,
+			prepare: func(mockReadPayeeUC *appMock.MockIReadPayeeUsecase) {
+				mockReadPayeeUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, uri(tt.payeeID), nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("payee_id")
+			ctx.SetParamValues(tt.payeeID)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockReadPayeeUC := appMock.NewMockIReadPayeeUsecase(ctrl)
+			tt.prepare(mockReadPayeeUC)
+
+			h := payees.NewReadPayeeHandler(mockReadPayeeUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp payees.PayeeResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package payees
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type RegisterPayeeHandler struct {
+	registerPayeeUC payeeApp.IRegisterPayeeUsecase
+}
+
+func NewRegisterPayeeHandler(registerPayeeUsecase payeeApp.IRegisterPayeeUsecase) *RegisterPayeeHandler {
+	return &RegisterPayeeHandler{
+		registerPayeeUC: registerPayeeUsecase,
+	}
+}
+
+type RegisterPayeeRequestBody struct {
+	// 支払先の利用者のメールアドレス
+	Email string `json:"email" example:"sato@example.com"`
+
+	// ニックネーム (最大30文字)
+	Nickname *string `json:"nickname" example:"Landlord"`
+}
+
+type RegisterPayeeRequest struct {
+	RegisterPayeeRequestBody
+}
+
+// @Summary 支払先の登録
+// @Description メールアドレスで利用者を探し、未確認の支払先として登録します。
+// @Description レスポンスの伏せた名前を確認して支払先を確認済みにすると、支払先IDで振込ができるようになります。
+// @Tags Payee API
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body RegisterPayeeRequestBody true "Request Body"
+// @Success 201 {object} PayeeResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 409 {object} response.ProblemDetail "Conflict"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/payees [post]
+func (h *RegisterPayeeHandler) Run(ctx echo.Context) error {
+	req := new(RegisterPayeeRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.registerPayeeUC.Run(ctx.Request().Context(), payeeApp.RegisterPayeeCommand{
+		UserID:   userID,
+		Email:    req.Email,
+		Nickname: req.Nickname,
+	})
+	if err != nil {
+		switch err {
+		case payeeDomain.ErrSelfPayee,
+			payeeDomain.ErrInvalidNickname:
+			return response.BadRequest(ctx, err)
+		case payeeDomain.ErrRecipientNotFound:
+			return response.NotFound(ctx, err)
+		case payeeDomain.ErrAlreadyExists,
+			payeeDomain.ErrLimitReached:
+			return response.Conflict(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, newPayeeResponse(*dto))
+}
+
+func (h *RegisterPayeeHandler) validation(req *RegisterPayeeRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidUserEmail(req.Email); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "email",
+			Message: err.Error(),
+		})
+	}
+
+	if req.Nickname != nil {
+		if err := validation.ValidPayeeNickname(*req.Nickname); err != nil {
+			validationErrors = append(validationErrors, response.ValidationError{
+				Field:   "nickname",
+				Message: err.Error(),
+			})
+		}
+	}
+	return validationErrors
+}
@@ -0,0 +1,216 @@
+package payees_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/presentation/me/payees"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestRegisterPayeeHandler(t *testing.T) {
+	var (
+		userID          = idVO.NewUserIDForTest("user")
+		payeeID         = idVO.NewPayeeIDForTest("payee")
+		email           = "sato@example.com"
+		nickname        = "Landlord"
+		tooLongNickname = strings.Repeat("a", payeeDomain.NicknameMaxLength+1)
+		uri             = "/api/v1/me/payees"
+		arg             = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+	validationFailed := response.ValidationProblemDetail{
+		ProblemDetail: response.ProblemDetail{
+			Type:     response.TypeURLValidationFailed,
+			Title:    response.TitleValidationFailed,
+			Status:   http.StatusBadRequest,
+			Detail:   response.DetailValidationFailed,
+			Instance: uri,
+		},
+	}
+
+	happyRequestBody := payees.RegisterPayeeRequestBody{
+		Email:    email,
+		Nickname: &nickname,
+	}
+
+	tests := []struct {
+		caseName             string
+		requestBody          interface{}
+		setupContext         func() context.Context
+		prepare              func(mockRegisterPayeeUC *appMock.MockIRegisterPayeeUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 支払先の登録に成功し、伏せた名前を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockRegisterPayeeUC *appMock.MockIRegisterPayeeUsecase) {
+				mockRegisterPayeeUC.EXPECT().Run(arg, payeeApp.RegisterPayeeCommand{
+					UserID:   userID.String(),
+					Email:    email,
+					Nickname: &nickname,
+				}).Return(&payeeApp.PayeeDTO{
+					ID:         payeeID.String(),
+					MaskedName: "S*** T***",
+					Nickname:   &nickname,
+					CreatedAt:  timer.GetFixedDateString(),
+				}, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedResponseBody: payees.PayeeResponse{
+				ID:         payeeID.String(),
+				MaskedName: "S*** T***",
+				Nickname:   &nickname,
+				CreatedAt:  timer.GetFixedDateString(),
+			},
+		},
+		{
+			caseName:             "Negative: メールアドレスが不正な場合、Validation Failed を返す",
+			requestBody:          payees.RegisterPayeeRequestBody{Email: "invalid"},
+			setupContext:         happyContext,
+			prepare:              func(mockRegisterPayeeUC *appMock.MockIRegisterPayeeUsecase) {},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: validationFailed,
+		},
+		{
+			caseName:             "Negative: ニックネームが長すぎる場合、Validation Failed を返す",
+			requestBody:          payees.RegisterPayeeRequestBody{Email: email, Nickname: &tooLongNickname},
+			setupContext:         happyContext,
+			prepare:              func(mockRegisterPayeeUC *appMock.MockIRegisterPayeeUsecase) {},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: validationFailed,
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			requestBody:          happyRequestBody,
+			setupContext:         context.Background,
+			prepare:              func(mockRegisterPayeeUC *appMock.MockIRegisterPayeeUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 自分自身を支払先に登録しようとした場合、Bad Request を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockRegisterPayeeUC *appMock.MockIRegisterPayeeUsecase) {
+				mockRegisterPayeeUC.EXPECT().Run(arg, arg).Return(nil, payeeDomain.ErrSelfPayee)
+			},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, payeeDomain.ErrSelfPayee),
+		},
+		{
+			caseName:     "Negative: メールアドレスの利用者が見つからない場合、Not Found を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockRegisterPayeeUC *appMock.MockIRegisterPayeeUsecase) {
+				mockRegisterPayeeUC.EXPECT().Run(arg, arg).Return(nil, payeeDomain.ErrRecipientNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, payeeDomain.ErrRecipientNotFound),
+		},
+		{
+			caseName:     "Negative: 既に登録されている場合、Conflict を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockRegisterPayeeUC *appMock.MockIRegisterPayeeUsecase) {
+				mockRegisterPayeeUC.EXPECT().Run(arg, arg).Return(nil, payeeDomain.ErrAlreadyExists)
+			},
+			expectedCode:         http.StatusConflict,
+			expectedResponseBody: problem(http.StatusConflict, response.TypeURLConflict, response.TitleConflict, payeeDomain.ErrAlreadyExists),
+		},
+		{
+			caseName:     "Negative: 支払先の上限数に達している場合、Conflict を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockRegisterPayeeUC *appMock.MockIRegisterPayeeUsecase) {
+				mockRegisterPayeeUC.EXPECT().Run(arg, arg).Return(nil, payeeDomain.ErrLimitReached)
+			},
+			expectedCode:         http.StatusConflict,
+			expectedResponseBody: problem(http.StatusConflict, response.TypeURLConflict, response.TitleConflict, payeeDomain.ErrLimitReached),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockRegisterPayeeUC *appMock.MockIRegisterPayeeUsecase) {
+				mockRegisterPayeeUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockRegisterPayeeUC := appMock.NewMockIRegisterPayeeUsecase(ctrl)
+			tt.prepare(mockRegisterPayeeUC)
+
+			h := payees.NewRegisterPayeeHandler(mockRegisterPayeeUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusCreated {
+				assert.NoError(t, err)
+				var resp payees.PayeeResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
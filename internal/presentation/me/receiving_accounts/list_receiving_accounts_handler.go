@@ -0,0 +1,56 @@
+package receivingaccounts
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type ListReceivingAccountsHandler struct {
+	listReceivingAccountsUC payeeApp.IListReceivingAccountsUsecase
+}
+
+func NewListReceivingAccountsHandler(listReceivingAccountsUsecase payeeApp.IListReceivingAccountsUsecase) *ListReceivingAccountsHandler {
+	return &ListReceivingAccountsHandler{
+		listReceivingAccountsUC: listReceivingAccountsUsecase,
+	}
+}
+
+type ListReceivingAccountsResponse struct {
+	// 通貨ごとの受取口座 (通貨コードの昇順)
+	ReceivingAccounts []ReceivingAccountResponse `json:"receivingAccounts"`
+}
+
+// @Summary 受取口座の一覧
+// @Description 他の利用者から支払先IDで振込を受け取る際の、通貨ごとの既定の口座の一覧を取得します。
+// @Tags Receiving Account API
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} ListReceivingAccountsResponse
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/receiving-accounts [get]
+func (h *ListReceivingAccountsHandler) Run(ctx echo.Context) error {
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.listReceivingAccountsUC.Run(ctx.Request().Context(), payeeApp.ListReceivingAccountsCommand{
+		UserID: userID,
+	})
+	if err != nil {
+		return response.InternalServerError(ctx, err)
+	}
+
+	receivingAccounts := make([]ReceivingAccountResponse, 0, len(dto.ReceivingAccounts))
+	for _, receivingAccount := range dto.ReceivingAccounts {
+		receivingAccounts = append(receivingAccounts, newReceivingAccountResponse(receivingAccount))
+	}
+	return ctx.JSON(http.StatusOK, ListReceivingAccountsResponse{
+		ReceivingAccounts: receivingAccounts,
+	})
+}
@@ -0,0 +1,131 @@
+package receivingaccounts_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	receivingaccounts "github.com/u104rak1/pocgo/internal/presentation/me/receiving_accounts"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestListReceivingAccountsHandler(t *testing.T) {
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		uri       = "/api/v1/me/receiving-accounts"
+		arg       = gomock.Any()
+	)
+
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		setupContext         func() context.Context
+		prepare              func(mockListReceivingAccountsUC *appMock.MockIListReceivingAccountsUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 受取口座一覧の取得に成功する",
+			setupContext: happyContext,
+			prepare: func(mockListReceivingAccountsUC *appMock.MockIListReceivingAccountsUsecase) {
+				mockListReceivingAccountsUC.EXPECT().Run(arg, payeeApp.ListReceivingAccountsCommand{
+					UserID: userID.String(),
+				}).Return(&payeeApp.ListReceivingAccountsDTO{
+					ReceivingAccounts: []payeeApp.ReceivingAccountDTO{
+						{
+							Currency:  moneyVO.JPY,
+							AccountID: accountID.String(),
+							UpdatedAt: timer.GetFixedDateString(),
+						},
+					},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: receivingaccounts.ListReceivingAccountsResponse{
+				ReceivingAccounts: []receivingaccounts.ReceivingAccountResponse{
+					{
+						Currency:  moneyVO.JPY,
+						AccountID: accountID.String(),
+						UpdatedAt: timer.GetFixedDateString(),
+					},
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			setupContext:         context.Background,
+			prepare:              func(mockListReceivingAccountsUC *appMock.MockIListReceivingAccountsUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			setupContext: happyContext,
+			prepare: func(mockListReceivingAccountsUC *appMock.MockIListReceivingAccountsUsecase) {
+				mockListReceivingAccountsUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, uri, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockListReceivingAccountsUC := appMock.NewMockIListReceivingAccountsUsecase(ctrl)
+			tt.prepare(mockListReceivingAccountsUC)
+
+			h := receivingaccounts.NewListReceivingAccountsHandler(mockListReceivingAccountsUC)
+			err := h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp receivingaccounts.ListReceivingAccountsResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				resp, ok := he.Message.(response.ProblemDetail)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			}
+		})
+	}
+}
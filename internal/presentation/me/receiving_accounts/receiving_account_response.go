@@ -0,0 +1,24 @@
+package receivingaccounts
+
+import (
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+)
+
+type ReceivingAccountResponse struct {
+	// 通貨
+	Currency string `json:"currency" example:"JPY"`
+
+	// 受取口座ID
+	AccountID string `json:"accountId" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+
+	// 更新日時
+	UpdatedAt string `json:"updatedAt" example:"2026-10-17T09:00:00Z"`
+}
+
+func newReceivingAccountResponse(dto payeeApp.ReceivingAccountDTO) ReceivingAccountResponse {
+	return ReceivingAccountResponse{
+		Currency:  dto.Currency,
+		AccountID: dto.AccountID,
+		UpdatedAt: dto.UpdatedAt,
+	}
+}
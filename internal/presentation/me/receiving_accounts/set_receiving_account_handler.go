@@ -0,0 +1,106 @@
+package receivingaccounts
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type SetReceivingAccountHandler struct {
+	setReceivingAccountUC payeeApp.ISetReceivingAccountUsecase
+}
+
+func NewSetReceivingAccountHandler(setReceivingAccountUsecase payeeApp.ISetReceivingAccountUsecase) *SetReceivingAccountHandler {
+	return &SetReceivingAccountHandler{
+		setReceivingAccountUC: setReceivingAccountUsecase,
+	}
+}
+
+type SetReceivingAccountParams struct {
+	Currency string `param:"currency" example:"JPY"`
+}
+
+type SetReceivingAccountRequestBody struct {
+	// 受取口座ID (指定した通貨の自分の口座)
+	AccountID string `json:"accountId" example:"01J9R7YPV1FH1V0PPKVSB5C8FW"`
+}
+
+type SetReceivingAccountRequest struct {
+	SetReceivingAccountParams
+	SetReceivingAccountRequestBody
+}
+
+// @Summary 受取口座の設定
+// @Description 他の利用者から支払先IDで振込を受け取る際の、指定された通貨の既定の口座を設定します。
+// @Description 口座の通貨は指定された通貨と一致する必要があります。既に設定している場合は置き換えます。
+// @Tags Receiving Account API
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param currency path string true "通貨"
+// @Param request body SetReceivingAccountRequestBody true "Request Body"
+// @Success 200 {object} ReceivingAccountResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 403 {object} response.ProblemDetail "Forbidden"
+// @Failure 404 {object} response.ProblemDetail "Not Found"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/me/receiving-accounts/{currency} [put]
+func (h *SetReceivingAccountHandler) Run(ctx echo.Context) error {
+	req := new(SetReceivingAccountRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	userID, ok := ctx.Request().Context().Value(config.CtxUserIDKey()).(string)
+	if !ok {
+		return response.Unauthorized(ctx, config.ErrUserIDMissing)
+	}
+
+	dto, err := h.setReceivingAccountUC.Run(ctx.Request().Context(), payeeApp.SetReceivingAccountCommand{
+		UserID:    userID,
+		Currency:  req.Currency,
+		AccountID: req.AccountID,
+	})
+	if err != nil {
+		switch err {
+		case payeeDomain.ErrDifferentCurrency:
+			return response.BadRequest(ctx, err)
+		case accountDomain.ErrUnauthorized:
+			return response.Forbidden(ctx, err)
+		case accountDomain.ErrNotFound:
+			return response.NotFound(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, newReceivingAccountResponse(*dto))
+}
+
+func (h *SetReceivingAccountHandler) validation(req *SetReceivingAccountRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidCurrency(req.Currency); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "currency",
+			Message: err.Error(),
+		})
+	}
+
+	if err := validation.ValidULID(req.AccountID); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "accountId",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
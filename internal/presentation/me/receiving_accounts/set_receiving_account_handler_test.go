@@ -0,0 +1,222 @@
+package receivingaccounts_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	"github.com/u104rak1/pocgo/internal/config"
+	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	receivingaccounts "github.com/u104rak1/pocgo/internal/presentation/me/receiving_accounts"
+	"github.com/u104rak1/pocgo/internal/server/response"
+	"github.com/u104rak1/pocgo/pkg/timer"
+)
+
+func TestSetReceivingAccountHandler(t *testing.T) {
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		currency  = moneyVO.JPY
+		arg       = gomock.Any()
+	)
+
+	uri := func(currency string) string {
+		return "/api/v1/me/receiving-accounts/" + currency
+	}
+	happyContext := func() context.Context {
+		return context.WithValue(context.Background(), config.CtxUserIDKey(), userID.String())
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri(currency),
+		}
+	}
+
+	happyRequestBody := receivingaccounts.SetReceivingAccountRequestBody{
+		AccountID: accountID.String(),
+	}
+
+	tests := []struct {
+		caseName             string
+		currency             string
+		requestBody          interface{}
+		setupContext         func() context.Context
+		prepare              func(mockSetReceivingAccountUC *appMock.MockISetReceivingAccountUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:     "Positive: 受取口座の設定に成功する",
+			currency:     currency,
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockSetReceivingAccountUC *appMock.MockISetReceivingAccountUsecase) {
+				mockSetReceivingAccountUC.EXPECT().Run(arg, payeeApp.SetReceivingAccountCommand{
+					UserID:    userID.String(),
+					Currency:  currency,
+					AccountID: accountID.String(),
+				}).Return(&payeeApp.ReceivingAccountDTO{
+					Currency:  currency,
+					AccountID: accountID.String(),
+					UpdatedAt: timer.GetFixedDateString(),
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedResponseBody: receivingaccounts.ReceivingAccountResponse{
+				Currency:  currency,
+				AccountID: accountID.String(),
+				UpdatedAt: timer.GetFixedDateString(),
+			},
+		},
+		{
+			caseName:     "Negative: サポートされていない通貨の場合、Validation Failed を返す",
+			currency:     "XXX",
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare:      func(mockSetReceivingAccountUC *appMock.MockISetReceivingAccountUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri("XXX"),
+				},
+			},
+		},
+		{
+			caseName:     "Negative: 口座IDが不正な場合、Validation Failed を返す",
+			currency:     currency,
+			requestBody:  receivingaccounts.SetReceivingAccountRequestBody{AccountID: "invalid"},
+			setupContext: happyContext,
+			prepare:      func(mockSetReceivingAccountUC *appMock.MockISetReceivingAccountUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri(currency),
+				},
+			},
+		},
+		{
+			caseName:             "Negative: コンテキストにユーザーIDがない場合、Unauthorized を返す",
+			currency:             currency,
+			requestBody:          happyRequestBody,
+			setupContext:         context.Background,
+			prepare:              func(mockSetReceivingAccountUC *appMock.MockISetReceivingAccountUsecase) {},
+			expectedCode:         http.StatusUnauthorized,
+			expectedResponseBody: problem(http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, config.ErrUserIDMissing),
+		},
+		{
+			caseName:     "Negative: 口座の通貨が指定された通貨と異なる場合、Bad Request を返す",
+			currency:     currency,
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockSetReceivingAccountUC *appMock.MockISetReceivingAccountUsecase) {
+				mockSetReceivingAccountUC.EXPECT().Run(arg, arg).Return(nil, payeeDomain.ErrDifferentCurrency)
+			},
+			expectedCode:         http.StatusBadRequest,
+			expectedResponseBody: problem(http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, payeeDomain.ErrDifferentCurrency),
+		},
+		{
+			caseName:     "Negative: 他のユーザーの口座の場合、Forbidden を返す",
+			currency:     currency,
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockSetReceivingAccountUC *appMock.MockISetReceivingAccountUsecase) {
+				mockSetReceivingAccountUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrUnauthorized)
+			},
+			expectedCode:         http.StatusForbidden,
+			expectedResponseBody: problem(http.StatusForbidden, response.TypeURLForbidden, response.TitleForbidden, accountDomain.ErrUnauthorized),
+		},
+		{
+			caseName:     "Negative: 口座が見つからない場合、Not Found を返す",
+			currency:     currency,
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockSetReceivingAccountUC *appMock.MockISetReceivingAccountUsecase) {
+				mockSetReceivingAccountUC.EXPECT().Run(arg, arg).Return(nil, accountDomain.ErrNotFound)
+			},
+			expectedCode:         http.StatusNotFound,
+			expectedResponseBody: problem(http.StatusNotFound, response.TypeURLNotFound, response.TitleNotFound, accountDomain.ErrNotFound),
+		},
+		{
+			caseName:     "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			currency:     currency,
+			requestBody:  happyRequestBody,
+			setupContext: happyContext,
+			prepare: func(mockSetReceivingAccountUC *appMock.MockISetReceivingAccountUsecase) {
+				mockSetReceivingAccountUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode:         http.StatusInternalServerError,
+			expectedResponseBody: problem(http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPut, uri(tt.currency), bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("currency")
+			ctx.SetParamValues(tt.currency)
+			ctx.SetRequest(req.WithContext(tt.setupContext()))
+
+			mockSetReceivingAccountUC := appMock.NewMockISetReceivingAccountUsecase(ctrl)
+			tt.prepare(mockSetReceivingAccountUC)
+
+			h := receivingaccounts.NewSetReceivingAccountHandler(mockSetReceivingAccountUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				var resp receivingaccounts.ReceivingAccountResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
 	authDomain "github.com/u104rak1/pocgo/internal/domain/authentication"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
 	"github.com/u104rak1/pocgo/internal/presentation/validation"
 	"github.com/u104rak1/pocgo/internal/server/response"
 )
@@ -31,10 +32,13 @@ type SigninRequest struct {
 type SigninResponse struct {
 	// アクセストークン
 	AccessToken string `json:"accessToken" example:"eyJhb..."`
+
+	// アクセストークンの再発行に使用するリフレッシュトークン
+	RefreshToken string `json:"refreshToken" example:"Jq3vT0hS..."`
 }
 
 // @Summary サインイン
-// @Description ユーザーのメールアドレスとパスワードを使用してユーザーを認証し、アクセストークンを発行します。
+// @Description ユーザーのメールアドレスとパスワードを使用してユーザーを認証し、アクセストークンとリフレッシュトークンを発行します。
 // @Tags Authentication API
 // @Accept json
 // @Produce json
@@ -42,6 +46,7 @@ type SigninResponse struct {
 // @Success 201 {object} SigninResponse
 // @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
 // @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 429 {object} response.ProblemDetail "Too Many Requests"
 // @Failure 500 {object} response.ProblemDetail "Internal Server Error"
 // @Router /api/v1/signin [post]
 func (h *SigninHandler) Run(ctx echo.Context) error {
@@ -62,13 +67,16 @@ func (h *SigninHandler) Run(ctx echo.Context) error {
 		switch err {
 		case authDomain.ErrAuthenticationFailed:
 			return response.Unauthorized(ctx, err)
+		case lockoutDomain.ErrLocked:
+			return response.TooManyRequests(ctx, err)
 		default:
 			return response.InternalServerError(ctx, err)
 		}
 	}
 
 	return ctx.JSON(http.StatusCreated, SigninResponse{
-		AccessToken: dto.AccessToken,
+		AccessToken:  dto.AccessToken,
+		RefreshToken: dto.RefreshToken,
 	})
 }
 
@@ -13,6 +13,7 @@ import (
 	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
 	appMock "github.com/u104rak1/pocgo/internal/application/mock"
 	authDomain "github.com/u104rak1/pocgo/internal/domain/authentication"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
 	"github.com/u104rak1/pocgo/internal/presentation/signin"
 	"github.com/u104rak1/pocgo/internal/server/response"
 )
@@ -20,6 +21,7 @@ import (
 func TestSigninHandler(t *testing.T) {
 	var (
 		accessToken     = "token"
+		refreshToken    = "refreshToken"
 		invalidJSONBody = "invalid json"
 		uri             = "/api/v1/signin"
 		arg             = gomock.Any()
@@ -42,12 +44,14 @@ func TestSigninHandler(t *testing.T) {
 			requestBody: happyRequestBody,
 			prepare: func(mockSigninUC *appMock.MockISigninUsecase) {
 				mockSigninUC.EXPECT().Run(arg, arg).Return(&authApp.SigninDTO{
-					AccessToken: accessToken,
+					AccessToken:  accessToken,
+					RefreshToken: refreshToken,
 				}, nil)
 			},
 			expectedCode: http.StatusCreated,
 			expectedResponseBody: signin.SigninResponse{
-				AccessToken: accessToken,
+				AccessToken:  accessToken,
+				RefreshToken: refreshToken,
 			},
 		},
 		{
@@ -93,6 +97,21 @@ func TestSigninHandler(t *testing.T) {
 				Instance: uri,
 			},
 		},
+		{
+			caseName:    "Negative: 連続して認証に失敗しロックされている場合、Too Many Requests を返す",
+			requestBody: happyRequestBody,
+			prepare: func(mockSigninUC *appMock.MockISigninUsecase) {
+				mockSigninUC.EXPECT().Run(arg, arg).Return(nil, lockoutDomain.ErrLocked)
+			},
+			expectedCode: http.StatusTooManyRequests,
+			expectedResponseBody: response.ProblemDetail{
+				Type:     response.TypeURLTooManyRequests,
+				Title:    response.TitleTooManyRequests,
+				Status:   http.StatusTooManyRequests,
+				Detail:   lockoutDomain.ErrLocked.Error(),
+				Instance: uri,
+			},
+		},
 		{
 			caseName:    "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
 			requestBody: happyRequestBody,
@@ -38,6 +38,9 @@ type SignupResponse struct {
 
 	// アクセストークン
 	AccessToken string `json:"accessToken" example:"eyJhb..."`
+
+	// アクセストークンの再発行に使用するリフレッシュトークン
+	RefreshToken string `json:"refreshToken" example:"Jq3vT0hS..."`
 }
 
 type SignupResponseBodyUser struct {
@@ -52,7 +55,7 @@ type SignupResponseBodyUser struct {
 }
 
 // @Summary サインアップ
-// @Description 新しいユーザーを作成し、アクセストークンを発行します。
+// @Description 新しいユーザーを作成し、アクセストークンとリフレッシュトークンを発行します。
 // @Tags Authentication API
 // @Accept json
 // @Produce json
@@ -93,7 +96,8 @@ func (h *SignupHandler) Run(ctx echo.Context) error {
 			Name:  dto.User.Name,
 			Email: dto.User.Email,
 		},
-		AccessToken: dto.AccessToken,
+		AccessToken:  dto.AccessToken,
+		RefreshToken: dto.RefreshToken,
 	})
 }
 
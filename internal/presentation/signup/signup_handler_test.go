@@ -26,6 +26,7 @@ func TestSignupHandler(t *testing.T) {
 		userEmail       = "sato@example.com"
 		userPassword    = "password"
 		accessToken     = "token"
+		refreshToken    = "refreshToken"
 		invalidJSONBody = "invalid json"
 		uri             = "/api/v1/signup"
 		arg             = gomock.Any()
@@ -54,7 +55,8 @@ func TestSignupHandler(t *testing.T) {
 						Name:  userName,
 						Email: userEmail,
 					},
-					AccessToken: accessToken,
+					AccessToken:  accessToken,
+					RefreshToken: refreshToken,
 				}, nil)
 			},
 			expectedCode: http.StatusCreated,
@@ -64,7 +66,8 @@ func TestSignupHandler(t *testing.T) {
 					Name:  userName,
 					Email: userEmail,
 				},
-				AccessToken: accessToken,
+				AccessToken:  accessToken,
+				RefreshToken: refreshToken,
 			},
 		},
 		{
@@ -0,0 +1,86 @@
+package token
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+type RefreshTokenHandler struct {
+	refreshTokenUC authApp.IRefreshTokenUsecase
+}
+
+func NewRefreshTokenHandler(refreshTokenUsecase authApp.IRefreshTokenUsecase) *RefreshTokenHandler {
+	return &RefreshTokenHandler{
+		refreshTokenUC: refreshTokenUsecase,
+	}
+}
+
+type RefreshTokenRequest struct {
+	// サインイン時または前回の再発行時に発行されたリフレッシュトークン
+	RefreshToken string `json:"refreshToken" example:"Jq3vT0hS..."`
+}
+
+type RefreshTokenResponse struct {
+	// アクセストークン
+	AccessToken string `json:"accessToken" example:"eyJhb..."`
+
+	// 新しいリフレッシュトークン。リクエストで使用したリフレッシュトークンは使用済みになります。
+	RefreshToken string `json:"refreshToken" example:"Jq3vT0hS..."`
+}
+
+// @Summary トークンの再発行
+// @Description リフレッシュトークンを使用してアクセストークンとリフレッシュトークンを再発行します。使用済みのリフレッシュトークンが再利用された場合は、同じセッションのトークンを全て失効させます。
+// @Tags Authentication API
+// @Accept json
+// @Produce json
+// @Param request body RefreshTokenRequest true "Request Body"
+// @Success 201 {object} RefreshTokenResponse
+// @Failure 400 {object} response.ValidationProblemDetail "Validation Failed or Bad Request"
+// @Failure 401 {object} response.ProblemDetail "Unauthorized"
+// @Failure 500 {object} response.ProblemDetail "Internal Server Error"
+// @Router /api/v1/token/refresh [post]
+func (h *RefreshTokenHandler) Run(ctx echo.Context) error {
+	req := new(RefreshTokenRequest)
+	if err := ctx.Bind(req); err != nil {
+		return response.BadRequest(ctx, response.ErrInvalidJSON)
+	}
+
+	if validationErrors := h.validation(req); len(validationErrors) > 0 {
+		return response.ValidationFailed(ctx, validationErrors)
+	}
+
+	dto, err := h.refreshTokenUC.Run(ctx.Request().Context(), authApp.RefreshTokenCommand{
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		switch err {
+		case sessionDomain.ErrInvalidRefreshToken,
+			sessionDomain.ErrRefreshTokenExpired,
+			sessionDomain.ErrRefreshTokenReused,
+			sessionDomain.ErrSessionRevoked:
+			return response.Unauthorized(ctx, err)
+		default:
+			return response.InternalServerError(ctx, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, RefreshTokenResponse{
+		AccessToken:  dto.AccessToken,
+		RefreshToken: dto.RefreshToken,
+	})
+}
+
+func (h *RefreshTokenHandler) validation(req *RefreshTokenRequest) (validationErrors []response.ValidationError) {
+	if err := validation.ValidRefreshToken(req.RefreshToken); err != nil {
+		validationErrors = append(validationErrors, response.ValidationError{
+			Field:   "body.refreshToken",
+			Message: err.Error(),
+		})
+	}
+	return validationErrors
+}
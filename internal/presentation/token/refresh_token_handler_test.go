@@ -0,0 +1,193 @@
+package token_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+	appMock "github.com/u104rak1/pocgo/internal/application/mock"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	"github.com/u104rak1/pocgo/internal/presentation/token"
+	"github.com/u104rak1/pocgo/internal/server/response"
+)
+
+func TestRefreshTokenHandler(t *testing.T) {
+	var (
+		accessToken     = "token"
+		refreshToken    = strings.Repeat("a", sessionDomain.RefreshTokenLength)
+		newRefreshToken = strings.Repeat("b", sessionDomain.RefreshTokenLength)
+		invalidJSONBody = "invalid json"
+		uri             = "/api/v1/token/refresh"
+		arg             = gomock.Any()
+	)
+
+	var happyRequestBody = token.RefreshTokenRequest{
+		RefreshToken: refreshToken,
+	}
+	problem := func(status int, typeURL, title string, err error) response.ProblemDetail {
+		return response.ProblemDetail{
+			Type:     typeURL,
+			Title:    title,
+			Status:   status,
+			Detail:   err.Error(),
+			Instance: uri,
+		}
+	}
+
+	tests := []struct {
+		caseName             string
+		requestBody          interface{}
+		prepare              func(mockRefreshTokenUC *appMock.MockIRefreshTokenUsecase)
+		expectedCode         int
+		expectedResponseBody interface{}
+	}{
+		{
+			caseName:    "Positive: トークンの再発行に成功する",
+			requestBody: happyRequestBody,
+			prepare: func(mockRefreshTokenUC *appMock.MockIRefreshTokenUsecase) {
+				mockRefreshTokenUC.EXPECT().Run(arg, authApp.RefreshTokenCommand{
+					RefreshToken: refreshToken,
+				}).Return(&authApp.RefreshTokenDTO{
+					AccessToken:  accessToken,
+					RefreshToken: newRefreshToken,
+				}, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedResponseBody: token.RefreshTokenResponse{
+				AccessToken:  accessToken,
+				RefreshToken: newRefreshToken,
+			},
+		},
+		{
+			caseName:     "Negative: リクエストボディが無効なJSONの場合、Bad Request を返す",
+			requestBody:  invalidJSONBody,
+			prepare:      func(mockRefreshTokenUC *appMock.MockIRefreshTokenUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: problem(
+				http.StatusBadRequest, response.TypeURLBadRequest, response.TitleBadRequest, response.ErrInvalidJSON,
+			),
+		},
+		{
+			caseName:     "Negative: バリデーションが無効な場合、Validation Failed を返す",
+			requestBody:  token.RefreshTokenRequest{},
+			prepare:      func(mockRefreshTokenUC *appMock.MockIRefreshTokenUsecase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedResponseBody: response.ValidationProblemDetail{
+				ProblemDetail: response.ProblemDetail{
+					Type:     response.TypeURLValidationFailed,
+					Title:    response.TitleValidationFailed,
+					Status:   http.StatusBadRequest,
+					Detail:   response.DetailValidationFailed,
+					Instance: uri,
+				},
+			},
+		},
+		{
+			caseName:    "Negative: リフレッシュトークンが存在しない場合、Unauthorized を返す",
+			requestBody: happyRequestBody,
+			prepare: func(mockRefreshTokenUC *appMock.MockIRefreshTokenUsecase) {
+				mockRefreshTokenUC.EXPECT().Run(arg, arg).Return(nil, sessionDomain.ErrInvalidRefreshToken)
+			},
+			expectedCode: http.StatusUnauthorized,
+			expectedResponseBody: problem(
+				http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, sessionDomain.ErrInvalidRefreshToken,
+			),
+		},
+		{
+			caseName:    "Negative: リフレッシュトークンの有効期限が切れている場合、Unauthorized を返す",
+			requestBody: happyRequestBody,
+			prepare: func(mockRefreshTokenUC *appMock.MockIRefreshTokenUsecase) {
+				mockRefreshTokenUC.EXPECT().Run(arg, arg).Return(nil, sessionDomain.ErrRefreshTokenExpired)
+			},
+			expectedCode: http.StatusUnauthorized,
+			expectedResponseBody: problem(
+				http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, sessionDomain.ErrRefreshTokenExpired,
+			),
+		},
+		{
+			caseName:    "Negative: 使用済みのリフレッシュトークンが再利用された場合、Unauthorized を返す",
+			requestBody: happyRequestBody,
+			prepare: func(mockRefreshTokenUC *appMock.MockIRefreshTokenUsecase) {
+				mockRefreshTokenUC.EXPECT().Run(arg, arg).Return(nil, sessionDomain.ErrRefreshTokenReused)
+			},
+			expectedCode: http.StatusUnauthorized,
+			expectedResponseBody: problem(
+				http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, sessionDomain.ErrRefreshTokenReused,
+			),
+		},
+		{
+			caseName:    "Negative: セッションが失効している場合、Unauthorized を返す",
+			requestBody: happyRequestBody,
+			prepare: func(mockRefreshTokenUC *appMock.MockIRefreshTokenUsecase) {
+				mockRefreshTokenUC.EXPECT().Run(arg, arg).Return(nil, sessionDomain.ErrSessionRevoked)
+			},
+			expectedCode: http.StatusUnauthorized,
+			expectedResponseBody: problem(
+				http.StatusUnauthorized, response.TypeURLUnauthorized, response.TitleUnauthorized, sessionDomain.ErrSessionRevoked,
+			),
+		},
+		{
+			caseName:    "Negative: 未知のエラーが発生した場合、Internal Server Error を返す",
+			requestBody: happyRequestBody,
+			prepare: func(mockRefreshTokenUC *appMock.MockIRefreshTokenUsecase) {
+				mockRefreshTokenUC.EXPECT().Run(arg, arg).Return(nil, assert.AnError)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedResponseBody: problem(
+				http.StatusInternalServerError, response.TypeURLInternalServerError, response.TitleInternalServerError, assert.AnError,
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := echo.New()
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			mockRefreshTokenUC := appMock.NewMockIRefreshTokenUsecase(ctrl)
+			tt.prepare(mockRefreshTokenUC)
+
+			h := token.NewRefreshTokenHandler(mockRefreshTokenUC)
+			err = h.Run(ctx)
+
+			if tt.expectedCode == http.StatusCreated {
+				assert.NoError(t, err)
+				var resp token.RefreshTokenResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponseBody, resp)
+			} else {
+				assert.Error(t, err)
+				he, ok := err.(*echo.HTTPError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, he.Code)
+				switch resp := he.Message.(type) {
+				case response.ProblemDetail:
+					assert.Equal(t, tt.expectedResponseBody, resp)
+				case response.ValidationProblemDetail:
+					expected := tt.expectedResponseBody.(response.ValidationProblemDetail)
+					assert.Equal(t, expected.ProblemDetail, resp.ProblemDetail)
+					assert.Greater(t, len(resp.Errors), 0)
+				default:
+					t.Errorf("unexpected response: %v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package validation
+
+import (
+	"errors"
+	"time"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+)
+
+func ValidHoldStatus(status string) error {
+	return v.Validate(status, v.Required, v.In(
+		holdDomain.StatusAuthorized, holdDomain.StatusCaptured, holdDomain.StatusVoided, holdDomain.StatusExpired))
+}
+
+// 仮押さえの有効期限を検証します。タイムゾーンを含む ISO8601 形式で指定します。
+func ValidHoldExpiresAt(expiresAt string) error {
+	if _, err := time.Parse(time.RFC3339, expiresAt); err != nil {
+		return errors.New("must be a valid ISO8601 date-time")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+)
+
+func TestValidHoldStatus(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: AUTHORIZEDは有効",
+			input:    holdDomain.StatusAuthorized,
+		},
+		{
+			caseName: "Positive: EXPIREDは有効",
+			input:    holdDomain.StatusExpired,
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: サポートされていない状態は無効",
+			input:    "PENDING",
+			errMsg:   "must be a valid value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidHoldStatus(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidHoldExpiresAt(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: UTCの日時は有効",
+			input:    "2026-10-24T09:00:00Z",
+		},
+		{
+			caseName: "Positive: タイムゾーン付きの日時は有効",
+			input:    "2026-10-24T18:00:00+09:00",
+		},
+		{
+			caseName: "Negative: タイムゾーンのない日時は無効",
+			input:    "2026-10-24T09:00:00",
+			errMsg:   "must be a valid ISO8601 date-time",
+		},
+		{
+			caseName: "Negative: 日付のみは無効",
+			input:    "20261024",
+			errMsg:   "must be a valid ISO8601 date-time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidHoldExpiresAt(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
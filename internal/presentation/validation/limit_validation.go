@@ -0,0 +1,20 @@
+package validation
+
+import (
+	"regexp"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+)
+
+var limitAmountRegex = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// 上限を設定できる取引種別 (WITHDRAWAL, TRANSFER) かを検証します。
+func ValidLimitOperationType(operationType string) error {
+	return v.Validate(operationType, v.Required, v.In(limitDomain.OperationWithdrawal, limitDomain.OperationTransfer))
+}
+
+// 上限の金額が10進数表記かを検証します。小数点以下の桁数は口座の通貨によって異なる為、ユースケースで検証します。
+func ValidLimitAmount(amount string) error {
+	return v.Validate(amount, v.Required, v.Match(limitAmountRegex).Error("must be a decimal number"))
+}
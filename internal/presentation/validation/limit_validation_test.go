@@ -0,0 +1,92 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+)
+
+func TestValidLimitOperationType(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: WITHDRAWALは有効",
+			input:    limitDomain.OperationWithdrawal,
+		},
+		{
+			caseName: "Positive: TRANSFERは有効",
+			input:    limitDomain.OperationTransfer,
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: 入金は上限を設定できない為無効",
+			input:    "DEPOSIT",
+			errMsg:   "must be a valid value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidLimitOperationType(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidLimitAmount(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 整数は有効",
+			input:    "500000",
+		},
+		{
+			caseName: "Positive: 小数は有効",
+			input:    "5000.50",
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: 負の数は無効",
+			input:    "-100",
+			errMsg:   "must be a decimal number",
+		},
+		{
+			caseName: "Negative: 指数表記は無効",
+			input:    "1e5",
+			errMsg:   "must be a decimal number",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidLimitAmount(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package validation
 
 import (
-	"math"
+	"errors"
 
 	v "github.com/go-ozzo/ozzo-validation/v4"
 	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
@@ -12,37 +12,28 @@ type MoneyValidation struct {
 	Message string
 }
 
+// 通貨が通貨レジストリに登録されているかを検証します。
 func ValidCurrency(currency string) error {
-	return v.Validate(currency, v.Required, v.In(moneyVO.JPY, moneyVO.USD))
-}
-
-func ValidAmount(currency string, amount float64) error {
-	switch currency {
-	case moneyVO.JPY:
-		return validJPY(amount)
-	case moneyVO.USD:
-		return validUSD(amount)
-	default:
-		return moneyVO.ErrUnsupportedCurrency
+	codes := moneyVO.SupportedCurrencyCodes()
+	elements := make([]interface{}, len(codes))
+	for i, code := range codes {
+		elements[i] = code
 	}
+	return v.Validate(currency, v.Required, v.In(elements...))
 }
 
-func validJPY(amount float64) error {
-	if err := v.Validate(amount, v.Min(0.0)); err != nil {
+// 10進数表記の金額が 0 より大きく、通貨の精度（小数点以下の桁数）を満たしているかを検証します。
+// 金額が 0 の取引は記帳できない為、何も保存する前に拒否します。
+func ValidAmount(currency string, amount string) error {
+	if err := v.Validate(amount, v.Required); err != nil {
 		return err
 	}
-	if math.Round(amount) != amount {
-		return moneyVO.ErrInvalidJPYPrecision
-	}
-	return nil
-}
-
-func validUSD(amount float64) error {
-	if err := v.Validate(amount, v.Min(0.0)); err != nil {
+	money, err := moneyVO.NewFromDecimal(amount, currency)
+	if err != nil {
 		return err
 	}
-	if math.Round(amount*100) != amount*100 {
-		return moneyVO.ErrInvalidUSDPrecision
+	if money.Amount() == 0 {
+		return errors.New("must be greater than 0")
 	}
 	return nil
 }
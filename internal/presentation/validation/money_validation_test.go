@@ -12,62 +12,86 @@ func TestValidAmount(t *testing.T) {
 	tests := []struct {
 		caseName string
 		currency string
-		amount   float64
+		amount   string
 		errMsg   string
 	}{
+		{
+			caseName: "Negative: 空文字列は無効",
+			currency: moneyVO.JPY,
+			amount:   "",
+			errMsg:   "cannot be blank",
+		},
 		{
 			caseName: "Negative: 負のJPYは無効",
 			currency: moneyVO.JPY,
-			amount:   -1.0,
-			errMsg:   "must be no less than 0",
+			amount:   "-1",
+			errMsg:   moneyVO.ErrNegativeAmount.Error(),
 		},
 		{
-			caseName: "Positive: 0 JPYは有効",
+			caseName: "Negative: 0 JPYは無効",
 			currency: moneyVO.JPY,
-			amount:   0.0,
-			errMsg:   "",
+			amount:   "0",
+			errMsg:   "must be greater than 0",
 		},
 		{
 			caseName: "Positive: 正のJPYは有効",
 			currency: moneyVO.JPY,
-			amount:   1.0,
+			amount:   "1",
 			errMsg:   "",
 		},
 		{
 			caseName: "Negative: JPYの精度が無効",
 			currency: moneyVO.JPY,
-			amount:   100.5,
-			errMsg:   moneyVO.ErrInvalidJPYPrecision.Error(),
+			amount:   "100.5",
+			errMsg:   "invalid precision: amount in JPY must not have decimal places",
 		},
 		{
 			caseName: "Negative: 負のUSDは無効",
 			currency: moneyVO.USD,
-			amount:   -1.0,
-			errMsg:   "must be no less than 0",
+			amount:   "-1",
+			errMsg:   moneyVO.ErrNegativeAmount.Error(),
 		},
 		{
-			caseName: "Positive: 0 USDは有効",
+			caseName: "Negative: 0 USDは無効",
 			currency: moneyVO.USD,
-			amount:   0.0,
-			errMsg:   "",
+			amount:   "0",
+			errMsg:   "must be greater than 0",
 		},
 		{
 			caseName: "Positive: 正のUSDは有効",
 			currency: moneyVO.USD,
-			amount:   1.0,
+			amount:   "1",
 			errMsg:   "",
 		},
 		{
 			caseName: "Positive: USDは2桁まで有効",
 			currency: moneyVO.USD,
-			amount:   100.12,
+			amount:   "100.12",
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 最小単位に満たない0のUSDは無効",
+			currency: moneyVO.USD,
+			amount:   "0.00",
+			errMsg:   "must be greater than 0",
+		},
+		{
+			caseName: "Positive: 浮動小数点数で誤差が出るUSDも有効",
+			currency: moneyVO.USD,
+			amount:   "0.29",
 			errMsg:   "",
 		},
 		{
 			caseName: "Negative: USDは3桁以上は無効",
 			currency: moneyVO.USD,
-			amount:   100.123,
-			errMsg:   moneyVO.ErrInvalidUSDPrecision.Error(),
+			amount:   "100.123",
+			errMsg:   "invalid precision: amount in USD cannot have more than 2 decimal places",
+		},
+		{
+			caseName: "Negative: サポートされていない通貨は無効",
+			currency: "XXX",
+			amount:   "1",
+			errMsg:   moneyVO.ErrUnsupportedCurrency.Error(),
 		},
 	}
 
@@ -108,7 +132,7 @@ func TestValidCurrency(t *testing.T) {
 		},
 		{
 			caseName: "Negative: サポートされていない通貨は無効",
-			input:    "EUR",
+			input:    "XXX",
 			errMsg:   "must be a valid value",
 		},
 	}
@@ -0,0 +1,11 @@
+package validation
+
+import (
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+)
+
+// 支払先のニックネームを検証します。前後の空白は取り除かれる為、空白のみの場合は指定されなかったものとして扱われます。
+func ValidPayeeNickname(nickname string) error {
+	return v.Validate(nickname, v.RuneLength(0, payeeDomain.NicknameMaxLength))
+}
@@ -0,0 +1,44 @@
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+)
+
+func TestValidPayeeNickname(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 空文字列は有効",
+			input:    "",
+		},
+		{
+			caseName: "Positive: 30文字以下は有効",
+			input:    strings.Repeat("あ", payeeDomain.NicknameMaxLength),
+		},
+		{
+			caseName: "Negative: 31文字以上は無効",
+			input:    strings.Repeat("あ", payeeDomain.NicknameMaxLength+1),
+			errMsg:   "the length must be no more than 30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidPayeeNickname(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
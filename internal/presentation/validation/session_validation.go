@@ -0,0 +1,10 @@
+package validation
+
+import (
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+)
+
+func ValidRefreshToken(refreshToken string) error {
+	return v.Validate(refreshToken, v.Required, v.Length(sessionDomain.RefreshTokenLength, sessionDomain.RefreshTokenLength))
+}
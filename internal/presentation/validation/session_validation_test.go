@@ -0,0 +1,52 @@
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+)
+
+func TestValidRefreshToken(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 有効なリフレッシュトークン",
+			input:    strings.Repeat("a", sessionDomain.RefreshTokenLength),
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: 42文字は無効",
+			input:    strings.Repeat("a", sessionDomain.RefreshTokenLength-1),
+			errMsg:   "the length must be exactly 43",
+		},
+		{
+			caseName: "Negative: 44文字は無効",
+			input:    strings.Repeat("a", sessionDomain.RefreshTokenLength+1),
+			errMsg:   "the length must be exactly 43",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidRefreshToken(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"errors"
+	"strconv"
+
+	v "github.com/go-ozzo/ozzo-validation/v4"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+)
+
+func ValidStandingOrderFrequency(frequency string) error {
+	return v.Validate(frequency, v.Required, v.In(
+		standingOrderDomain.FrequencyWeekly, standingOrderDomain.FrequencyMonthly))
+}
+
+// 実行日を検証します。毎月実行する場合は必須で、毎週実行する場合は指定できません。
+func ValidStandingOrderDayOfMonth(frequency string, dayOfMonth *int) error {
+	if frequency != standingOrderDomain.FrequencyMonthly {
+		if dayOfMonth != nil {
+			return errors.New("can only be specified for a monthly standing order")
+		}
+		return nil
+	}
+	if dayOfMonth == nil {
+		return errors.New("cannot be blank")
+	}
+	if *dayOfMonth < standingOrderDomain.DayOfMonthMin || *dayOfMonth > standingOrderDomain.DayOfMonthMax {
+		return errors.New("must be between " + strconv.Itoa(standingOrderDomain.DayOfMonthMin) + " and " + strconv.Itoa(standingOrderDomain.DayOfMonthMax))
+	}
+	return nil
+}
+
+// 自動振込の開始日を検証します。開始日の指定は必須です。
+func ValidStandingOrderStartDate(yyyymmdd string) error {
+	if err := v.Validate(yyyymmdd, v.Required); err != nil {
+		return err
+	}
+	return ValidYYYYMMDD(yyyymmdd)
+}
+
+func ValidStandingOrderMaxExecutions(maxExecutions int) error {
+	if maxExecutions <= 0 {
+		return errors.New("must be greater than 0")
+	}
+	return nil
+}
+
+func ValidStandingOrderMaxRetries(maxRetries int) error {
+	if maxRetries < 0 || maxRetries > standingOrderDomain.MaxRetriesLimit {
+		return errors.New("must be between 0 and " + strconv.Itoa(standingOrderDomain.MaxRetriesLimit))
+	}
+	return nil
+}
+
+func ValidStandingOrderFailurePolicy(policy string) error {
+	return v.Validate(policy, v.Required, v.In(
+		standingOrderDomain.FailurePolicySkip, standingOrderDomain.FailurePolicySuspend))
+}
+
+// 利用者が変更できる自動振込の状態を検証します。ACTIVE で再開、PAUSED で一時停止します。
+func ValidStandingOrderStatus(status string) error {
+	return v.Validate(status, v.Required, v.In(
+		standingOrderDomain.StatusActive, standingOrderDomain.StatusPaused))
+}
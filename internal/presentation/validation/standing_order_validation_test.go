@@ -0,0 +1,281 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
+	"github.com/u104rak1/pocgo/internal/presentation/validation"
+)
+
+func TestValidStandingOrderFrequency(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: WEEKLYは有効",
+			input:    standingOrderDomain.FrequencyWeekly,
+		},
+		{
+			caseName: "Positive: MONTHLYは有効",
+			input:    standingOrderDomain.FrequencyMonthly,
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: サポートされていない間隔は無効",
+			input:    "DAILY",
+			errMsg:   "must be a valid value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidStandingOrderFrequency(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidStandingOrderDayOfMonth(t *testing.T) {
+	day := func(d int) *int { return &d }
+
+	tests := []struct {
+		caseName   string
+		frequency  string
+		dayOfMonth *int
+		errMsg     string
+	}{
+		{
+			caseName:   "Positive: 毎月実行する場合、1日は有効",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: day(1),
+		},
+		{
+			caseName:   "Positive: 毎月実行する場合、31日は有効",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: day(31),
+		},
+		{
+			caseName:  "Positive: 毎週実行する場合、指定しないのは有効",
+			frequency: standingOrderDomain.FrequencyWeekly,
+		},
+		{
+			caseName:  "Negative: 毎月実行する場合、指定しないのは無効",
+			frequency: standingOrderDomain.FrequencyMonthly,
+			errMsg:    "cannot be blank",
+		},
+		{
+			caseName:   "Negative: 毎月実行する場合、0日は無効",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: day(0),
+			errMsg:     "must be between 1 and 31",
+		},
+		{
+			caseName:   "Negative: 毎月実行する場合、32日は無効",
+			frequency:  standingOrderDomain.FrequencyMonthly,
+			dayOfMonth: day(32),
+			errMsg:     "must be between 1 and 31",
+		},
+		{
+			caseName:   "Negative: 毎週実行する場合、指定するのは無効",
+			frequency:  standingOrderDomain.FrequencyWeekly,
+			dayOfMonth: day(1),
+			errMsg:     "can only be specified for a monthly standing order",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidStandingOrderDayOfMonth(tt.frequency, tt.dayOfMonth)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidStandingOrderStartDate(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: YYYYMMDD形式の日付は有効",
+			input:    "20261101",
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: 形式が異なる日付は無効",
+			input:    "2026-11-01",
+			errMsg:   "must be in a valid format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidStandingOrderStartDate(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidStandingOrderMaxExecutions(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    int
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 1は有効",
+			input:    1,
+		},
+		{
+			caseName: "Negative: 0は無効",
+			input:    0,
+			errMsg:   "must be greater than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidStandingOrderMaxExecutions(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidStandingOrderMaxRetries(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    int
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 0は有効",
+			input:    0,
+		},
+		{
+			caseName: "Positive: 上限の回数は有効",
+			input:    standingOrderDomain.MaxRetriesLimit,
+		},
+		{
+			caseName: "Negative: 負の数は無効",
+			input:    -1,
+			errMsg:   "must be between 0 and 5",
+		},
+		{
+			caseName: "Negative: 上限を超える回数は無効",
+			input:    standingOrderDomain.MaxRetriesLimit + 1,
+			errMsg:   "must be between 0 and 5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidStandingOrderMaxRetries(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidStandingOrderFailurePolicy(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: SKIPは有効",
+			input:    standingOrderDomain.FailurePolicySkip,
+		},
+		{
+			caseName: "Positive: SUSPENDは有効",
+			input:    standingOrderDomain.FailurePolicySuspend,
+		},
+		{
+			caseName: "Negative: サポートされていない方針は無効",
+			input:    "RETRY",
+			errMsg:   "must be a valid value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidStandingOrderFailurePolicy(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestValidStandingOrderStatus(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: ACTIVEは有効",
+			input:    standingOrderDomain.StatusActive,
+		},
+		{
+			caseName: "Positive: PAUSEDは有効",
+			input:    standingOrderDomain.StatusPaused,
+		},
+		{
+			caseName: "Negative: 利用者が変更できない状態は無効",
+			input:    standingOrderDomain.StatusCancelled,
+			errMsg:   "must be a valid value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidStandingOrderStatus(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
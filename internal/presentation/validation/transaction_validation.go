@@ -2,10 +2,13 @@ package validation
 
 import (
 	"errors"
+	"regexp"
 	"strconv"
 	"strings"
 
 	v "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/u104rak1/pocgo/internal/application/idempotency"
+	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
 )
 
@@ -14,6 +17,13 @@ func ValidTransactionOperationType(operationType string) error {
 		transactionDomain.Deposit, transactionDomain.Withdrawal, transactionDomain.Transfer))
 }
 
+// 取引一覧の絞り込みに使用する取引種別を検証します。振込の向きを表す TRANSFER_IN, TRANSFER_OUT と取消の REVERSAL も指定できます。
+func ValidListTransactionsOperationType(operationType string) error {
+	return v.Validate(operationType, v.Required, v.In(
+		transactionDomain.Deposit, transactionDomain.Withdrawal, transactionDomain.Transfer,
+		transactionDomain.TransferIn, transactionDomain.TransferOut, transactionDomain.Reversal))
+}
+
 // 取引操作タイプのカンマ区切り文字列を検証します。
 func ValidTransactionOperationTypes(operationTypes string) error {
 	if operationTypes == "" {
@@ -25,7 +35,7 @@ func ValidTransactionOperationTypes(operationTypes string) error {
 
 	for _, t := range types {
 		t = strings.TrimSpace(t)
-		if err := ValidTransactionOperationType(t); err != nil {
+		if err := ValidListTransactionsOperationType(t); err != nil {
 			errorMsgs = append(errorMsgs, err.Error())
 		}
 	}
@@ -46,3 +56,87 @@ func ValidListTransactionsLimit(limit int) error {
 	}
 	return nil
 }
+
+// 取引一覧のカーソルを検証します。
+func ValidTransactionCursor(cursor string) error {
+	if err := v.Validate(cursor, v.Required); err != nil {
+		return err
+	}
+	_, err := transactionDomain.DecodeCursor(cursor)
+	return err
+}
+
+// 取引明細の対象期間の日付を検証します。取引明細では期間の指定が必須です。
+func ValidStatementDate(yyyymmdd string) error {
+	if err := v.Validate(yyyymmdd, v.Required); err != nil {
+		return err
+	}
+	return ValidYYYYMMDD(yyyymmdd)
+}
+
+// 取引明細の出力形式を検証します。
+func ValidStatementFormat(format string) error {
+	return v.Validate(format, v.Required, v.In(
+		transactionApp.StatementFormatCSV, transactionApp.StatementFormatOFX, transactionApp.StatementFormatCamt053))
+}
+
+var idempotencyKeyRegex = regexp.MustCompile(`^[\x21-\x7E]+$`)
+
+// Idempotency-Key ヘッダーの値を検証します。空白を含まない ASCII の表示可能文字のみ使用できます。
+func ValidIdempotencyKey(key string) error {
+	return v.Validate(key, v.Required, v.Length(1, idempotency.KeyMaxLength),
+		v.Match(idempotencyKeyRegex).Error("must contain only printable ASCII characters without spaces"))
+}
+
+// 取引のメモを検証します。前後の空白は取り除かれる為、空白のみの場合は指定されなかったものとして扱われます。
+func ValidTransactionMemo(memo string) error {
+	return v.Validate(memo, v.RuneLength(0, transactionDomain.MemoMaxLength))
+}
+
+// 取引の参照情報を検証します。
+func ValidTransactionReference(reference string) error {
+	return v.Validate(reference, v.RuneLength(0, transactionDomain.ReferenceMaxLength))
+}
+
+// 取引の分類を1つ検証します。一覧の絞り込みでカンマ区切りで指定する為、カンマは使用できません。
+func ValidTransactionCategory(category string) error {
+	category = strings.TrimSpace(category)
+	if err := v.Validate(category, v.Required, v.RuneLength(1, transactionDomain.CategoryMaxLength)); err != nil {
+		return err
+	}
+	if strings.Contains(category, ",") {
+		return errors.New("must not contain commas")
+	}
+	return nil
+}
+
+// 取引に付ける分類を検証します。空の場合は分類を全て外します。
+func ValidTransactionCategories(categories []string) error {
+	if len(categories) > transactionDomain.MaxCategories {
+		return errors.New("must contain at most " + strconv.Itoa(transactionDomain.MaxCategories) + " categories")
+	}
+	for _, category := range categories {
+		if err := ValidTransactionCategory(category); err != nil {
+			return errors.New("contains an invalid category")
+		}
+	}
+	return nil
+}
+
+// 取引一覧の絞り込みに使用する分類のカンマ区切り文字列を検証します。
+func ValidTransactionCategoryFilter(categories string) error {
+	if categories == "" {
+		return errors.New("categories cannot be blank")
+	}
+	return ValidTransactionCategories(strings.Split(categories, ","))
+}
+
+// 取引一覧の検索語を検証します。
+func ValidTransactionSearchQuery(query string) error {
+	return v.Validate(strings.TrimSpace(query), v.Required, v.RuneLength(1, transactionDomain.SearchQueryMaxLength))
+}
+
+// 取引一覧の絞り込みに使用する金額を検証します。通貨の小数点以下の桁数は口座の通貨で検証されます。
+func ValidTransactionAmountFilter(amount string) error {
+	return v.Validate(amount, v.Required, v.Match(limitAmountRegex).Error("must be a decimal number"))
+}
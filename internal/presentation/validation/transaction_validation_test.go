@@ -2,10 +2,12 @@ package validation_test
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/u104rak1/pocgo/internal/domain/transaction"
+	"github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	"github.com/u104rak1/pocgo/internal/presentation/validation"
 )
 
@@ -82,6 +84,11 @@ func TestValidTransactionOperationTypes(t *testing.T) {
 			input:    transaction.Deposit + "," + transaction.Withdrawal,
 			errMsg:   "",
 		},
+		{
+			caseName: "Positive: 振込の向きを指定した操作タイプ",
+			input:    transaction.TransferIn + "," + transaction.TransferOut,
+			errMsg:   "",
+		},
 		{
 			caseName: "Negative: 無効な操作タイプを含む",
 			input:    transaction.Deposit + ",invalid-type",
@@ -144,3 +151,418 @@ func TestValidListTransactionsLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestValidTransactionCursor(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 有効なカーソル",
+			input:    transaction.Cursor{ID: id.NewTransactionIDForTest("transaction")}.Encode(),
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 空文字列",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: 不正なカーソル",
+			input:    "invalid",
+			errMsg:   transaction.ErrInvalidCursor.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidTransactionCursor(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: UUID形式のキーは有効",
+			input:    "5f0c7a0e-3c3b-4f0e-9d5a-1c2b3d4e5f60",
+			errMsg:   "",
+		},
+		{
+			caseName: "Positive: 255文字のキーは有効",
+			input:    strings.Repeat("a", 255),
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: 256文字のキーは無効",
+			input:    strings.Repeat("a", 256),
+			errMsg:   "the length must be between 1 and 255",
+		},
+		{
+			caseName: "Negative: 空白を含むキーは無効",
+			input:    "idempotency key",
+			errMsg:   "must contain only printable ASCII characters without spaces",
+		},
+		{
+			caseName: "Negative: ASCII以外の文字を含むキーは無効",
+			input:    "キー",
+			errMsg:   "must contain only printable ASCII characters without spaces",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidIdempotencyKey(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidStatementFormat(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: csv は有効",
+			input:    "csv",
+			errMsg:   "",
+		},
+		{
+			caseName: "Positive: ofx は有効",
+			input:    "ofx",
+			errMsg:   "",
+		},
+		{
+			caseName: "Positive: camt053 は有効",
+			input:    "camt053",
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: 対応していない形式は無効",
+			input:    "pdf",
+			errMsg:   "must be a valid value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidStatementFormat(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidStatementDate(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: YYYYMMDD形式の日付は有効",
+			input:    "20240101",
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: YYYYMMDD形式でない日付は無効",
+			input:    "2024-01-01",
+			errMsg:   "must be in a valid format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidStatementDate(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidTransactionMemo(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 空文字列は有効",
+			input:    "",
+			errMsg:   "",
+		},
+		{
+			caseName: "Positive: 200文字のメモは有効",
+			input:    strings.Repeat("あ", 200),
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 201文字のメモは無効",
+			input:    strings.Repeat("あ", 201),
+			errMsg:   "the length must be no more than 200",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidTransactionMemo(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidTransactionReference(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 140文字の参照情報は有効",
+			input:    strings.Repeat("a", 140),
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 141文字の参照情報は無効",
+			input:    strings.Repeat("a", 141),
+			errMsg:   "the length must be no more than 140",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidTransactionReference(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidTransactionCategories(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    []string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 空の場合は有効",
+			input:    []string{},
+			errMsg:   "",
+		},
+		{
+			caseName: "Positive: 10個の分類は有効",
+			input:    []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"},
+			errMsg:   "",
+		},
+		{
+			caseName: "Positive: 30文字の分類は有効",
+			input:    []string{strings.Repeat("食", 30)},
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 11個の分類は無効",
+			input:    []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"},
+			errMsg:   "must contain at most 10 categories",
+		},
+		{
+			caseName: "Negative: 31文字の分類を含む場合は無効",
+			input:    []string{"food", strings.Repeat("食", 31)},
+			errMsg:   "contains an invalid category",
+		},
+		{
+			caseName: "Negative: 空白のみの分類を含む場合は無効",
+			input:    []string{" "},
+			errMsg:   "contains an invalid category",
+		},
+		{
+			caseName: "Negative: カンマを含む分類は無効",
+			input:    []string{"food,travel"},
+			errMsg:   "contains an invalid category",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidTransactionCategories(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidTransactionCategoryFilter(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: カンマ区切りの分類は有効",
+			input:    "food, travel",
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "categories cannot be blank",
+		},
+		{
+			caseName: "Negative: 空の分類を含む場合は無効",
+			input:    "food,,travel",
+			errMsg:   "contains an invalid category",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidTransactionCategoryFilter(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidTransactionSearchQuery(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 100文字の検索語は有効",
+			input:    strings.Repeat("a", 100),
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 空白のみの検索語は無効",
+			input:    "  ",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: 101文字の検索語は無効",
+			input:    strings.Repeat("a", 101),
+			errMsg:   "the length must be between 1 and 100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidTransactionSearchQuery(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestValidTransactionAmountFilter(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    string
+		errMsg   string
+	}{
+		{
+			caseName: "Positive: 整数は有効",
+			input:    "1000",
+			errMsg:   "",
+		},
+		{
+			caseName: "Positive: 小数は有効",
+			input:    "10.50",
+			errMsg:   "",
+		},
+		{
+			caseName: "Negative: 空文字列は無効",
+			input:    "",
+			errMsg:   "cannot be blank",
+		},
+		{
+			caseName: "Negative: 負の数は無効",
+			input:    "-1",
+			errMsg:   "must be a decimal number",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			t.Parallel()
+			err := validation.ValidTransactionAmountFilter(tt.input)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
@@ -7,11 +7,15 @@ import (
 	"github.com/labstack/echo/v4"
 	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
 	"github.com/u104rak1/pocgo/internal/config"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	jwtServ "github.com/u104rak1/pocgo/internal/infrastructure/jwt"
 	"github.com/u104rak1/pocgo/internal/server/response"
 )
 
-func AuthorizationMiddleware(authService authApp.IJWTService) echo.MiddlewareFunc {
+// アクセストークンを検証し、ユーザーIDとセッションIDをコンテキストにセットします。
+// ログアウトやリフレッシュトークンの再利用によって失効したセッションのアクセストークンは拒否します。
+func AuthorizationMiddleware(authService authApp.IJWTService, sessionService sessionDomain.ISessionService) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			authHeader := c.Request().Header.Get("Authorization")
@@ -21,12 +25,24 @@ func AuthorizationMiddleware(authService authApp.IJWTService) echo.MiddlewareFun
 
 			accessToken := strings.TrimPrefix(authHeader, "Bearer ")
 
-			userID, err := authService.GetUserIDFromAccessToken(accessToken)
+			claims, err := authService.ParseAccessToken(accessToken)
 			if err != nil {
 				return response.Unauthorized(c, err)
 			}
 
-			ctx := context.WithValue(c.Request().Context(), config.CtxUserIDKey(), userID)
+			sessionID, err := idVO.SessionIDFromString(claims.SessionID)
+			if err != nil {
+				return response.Unauthorized(c, jwtServ.ErrInvalidAccessToken)
+			}
+			if err := sessionService.EnsureActive(c.Request().Context(), sessionID); err != nil {
+				if err == sessionDomain.ErrSessionRevoked {
+					return response.Unauthorized(c, err)
+				}
+				return response.InternalServerError(c, err)
+			}
+
+			ctx := context.WithValue(c.Request().Context(), config.CtxUserIDKey(), claims.UserID)
+			ctx = context.WithValue(ctx, config.CtxSessionIDKey(), claims.SessionID)
 			c.SetRequest(c.Request().WithContext(ctx))
 
 			return next(c)
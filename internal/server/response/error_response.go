@@ -64,6 +64,9 @@ const (
 	TitleUnprocessableEntity   = "Unprocessable Entity"
 	TypeURLUnprocessableEntity = problemURL + "unprocessable-entity"
 
+	TitleTooManyRequests   = "Too Many Requests"
+	TypeURLTooManyRequests = problemURL + "too-many-requests"
+
 	TitleInternalServerError   = "Internal Server Error"
 	TypeURLInternalServerError = problemURL + "internal-server-error"
 )
@@ -148,6 +151,17 @@ func UnprocessableEntity(ctx echo.Context, err error) error {
 	return echo.NewHTTPError(http.StatusUnprocessableEntity, problem)
 }
 
+func TooManyRequests(ctx echo.Context, err error) error {
+	problem := NewProblemDetail(
+		http.StatusTooManyRequests,
+		TitleTooManyRequests,
+		err.Error(),
+		ctx.Request().URL.Path,
+		TypeURLTooManyRequests,
+	)
+	return echo.NewHTTPError(http.StatusTooManyRequests, problem)
+}
+
 func InternalServerError(ctx echo.Context, err error) error {
 	problem := NewProblemDetail(
 		http.StatusInternalServerError,
@@ -117,6 +117,17 @@ func TestErrorResponses(t *testing.T) {
 				Instance: path,
 			},
 		},
+		{
+			caseName: "Positive: Too Many Requests が正常に動作する",
+			function: response.TooManyRequests,
+			expectedResponse: response.ProblemDetail{
+				Type:     response.TypeURLTooManyRequests,
+				Title:    response.TitleTooManyRequests,
+				Status:   http.StatusTooManyRequests,
+				Detail:   assert.AnError.Error(),
+				Instance: path,
+			},
+		},
 		{
 			caseName: "Positive: Internal Server Error が正常に動作する",
 			function: response.InternalServerError,
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+	"time"
+)
+
+// Scheduler はサーバーと同じプロセスで、一定の間隔でジョブを繰り返し実行します。
+// 前回の実行が終わるまで次の実行は行わない為、同じジョブが並行して実行されることはありません。
+type Scheduler struct {
+	interval time.Duration
+	job      func(ctx context.Context) error
+	onError  func(err error)
+}
+
+// onError にはジョブが返したエラーを受け取る関数を指定します。エラーが発生しても次の実行は行います。
+func NewScheduler(interval time.Duration, job func(ctx context.Context) error, onError func(err error)) *Scheduler {
+	return &Scheduler{
+		interval: interval,
+		job:      job,
+		onError:  onError,
+	}
+}
+
+// 起動直後に1回ジョブを実行し、その後は interval ごとに実行します。ctx がキャンセルされるまで戻りません。
+// キャンセルされた時点で実行中のジョブには同じ ctx が渡されている為、ジョブの終了を待ってから戻ります。
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.job(ctx); err != nil && ctx.Err() == nil {
+			s.onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
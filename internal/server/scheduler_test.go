@@ -0,0 +1,69 @@
+package server_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/u104rak1/pocgo/internal/server"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Run("Positive: キャンセルされるまでジョブを繰り返し実行し、エラーを通知する", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var (
+			mu   sync.Mutex
+			runs int
+			errs []error
+		)
+		job := func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			runs++
+			if runs == 3 {
+				cancel()
+			}
+			return assert.AnError
+		}
+		onError := func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			errs = append(errs, err)
+		}
+
+		done := make(chan struct{})
+		go func() {
+			server.NewScheduler(time.Millisecond, job, onError).Run(ctx)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("scheduler did not stop after the context was cancelled")
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, 3, runs)
+		// キャンセル後に返されたエラーは通知しません。
+		assert.Equal(t, []error{assert.AnError, assert.AnError}, errs)
+	})
+
+	t.Run("Positive: 起動直後に1回実行する", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		runs := 0
+		job := func(ctx context.Context) error {
+			runs++
+			cancel()
+			return nil
+		}
+
+		server.NewScheduler(time.Hour, job, func(err error) { t.Errorf("unexpected error: %v", err) }).Run(ctx)
+
+		assert.Equal(t, 1, runs)
+	})
+}
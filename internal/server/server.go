@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"log"
 	"net/http"
 	"os"
 	"os/signal"
@@ -12,31 +13,66 @@ import (
 	echoSwagger "github.com/swaggo/echo-swagger"
 	accountApp "github.com/u104rak1/pocgo/internal/application/account"
 	authApp "github.com/u104rak1/pocgo/internal/application/authentication"
+	eventApp "github.com/u104rak1/pocgo/internal/application/event"
+	holdApp "github.com/u104rak1/pocgo/internal/application/hold"
+	"github.com/u104rak1/pocgo/internal/application/idempotency"
+	limitApp "github.com/u104rak1/pocgo/internal/application/limit"
+	payeeApp "github.com/u104rak1/pocgo/internal/application/payee"
+	standingOrderApp "github.com/u104rak1/pocgo/internal/application/standing_order"
 	transactionApp "github.com/u104rak1/pocgo/internal/application/transaction"
 	unitofwork "github.com/u104rak1/pocgo/internal/application/unit_of_work"
 	userApp "github.com/u104rak1/pocgo/internal/application/user"
 	"github.com/u104rak1/pocgo/internal/config"
 	accountDomain "github.com/u104rak1/pocgo/internal/domain/account"
 	authDomain "github.com/u104rak1/pocgo/internal/domain/authentication"
+	eventDomain "github.com/u104rak1/pocgo/internal/domain/event"
+	holdDomain "github.com/u104rak1/pocgo/internal/domain/hold"
+	ledgerDomain "github.com/u104rak1/pocgo/internal/domain/ledger"
+	limitDomain "github.com/u104rak1/pocgo/internal/domain/limit"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
+	payeeDomain "github.com/u104rak1/pocgo/internal/domain/payee"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	standingOrderDomain "github.com/u104rak1/pocgo/internal/domain/standing_order"
 	transactionDomain "github.com/u104rak1/pocgo/internal/domain/transaction"
 	userDomain "github.com/u104rak1/pocgo/internal/domain/user"
+	moneyVO "github.com/u104rak1/pocgo/internal/domain/value_object/money"
+	eventpublisher "github.com/u104rak1/pocgo/internal/infrastructure/event_publisher"
+	exchangerate "github.com/u104rak1/pocgo/internal/infrastructure/exchange_rate"
 	"github.com/u104rak1/pocgo/internal/infrastructure/inmemory"
 	"github.com/u104rak1/pocgo/internal/infrastructure/jwt"
 	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/repository"
+	sqliteRepository "github.com/u104rak1/pocgo/internal/infrastructure/sqlite/repository"
+	transactionlimit "github.com/u104rak1/pocgo/internal/infrastructure/transaction_limit"
 	healthPre "github.com/u104rak1/pocgo/internal/presentation/health"
+	jwksPre "github.com/u104rak1/pocgo/internal/presentation/jwks"
+	logoutPre "github.com/u104rak1/pocgo/internal/presentation/logout"
 	mePre "github.com/u104rak1/pocgo/internal/presentation/me"
 	accountsPre "github.com/u104rak1/pocgo/internal/presentation/me/accounts"
+	holdsPre "github.com/u104rak1/pocgo/internal/presentation/me/accounts/holds"
+	limitsPre "github.com/u104rak1/pocgo/internal/presentation/me/accounts/limits"
+	standingOrdersPre "github.com/u104rak1/pocgo/internal/presentation/me/accounts/standing_orders"
+	statementsPre "github.com/u104rak1/pocgo/internal/presentation/me/accounts/statements"
 	transactionsPre "github.com/u104rak1/pocgo/internal/presentation/me/accounts/transactions"
+	payeesPre "github.com/u104rak1/pocgo/internal/presentation/me/payees"
+	receivingAccountsPre "github.com/u104rak1/pocgo/internal/presentation/me/receiving_accounts"
 	signinPre "github.com/u104rak1/pocgo/internal/presentation/signin"
 	signupPre "github.com/u104rak1/pocgo/internal/presentation/signup"
+	tokenPre "github.com/u104rak1/pocgo/internal/presentation/token"
 	myMiddleware "github.com/u104rak1/pocgo/internal/server/middleware"
+	"github.com/u104rak1/pocgo/pkg/timer"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+	"golang.org/x/exp/slog"
 )
 
 func Start() {
 	env := config.NewEnv()
+	if err := env.Validate(); err != nil {
+		panic(err)
+	}
 
 	var db *bun.DB
+	var store *inmemory.Store
 	var err error
 
 	if !env.USE_INMEMORY {
@@ -45,11 +81,117 @@ func Start() {
 			panic(err)
 		}
 		defer config.CloseDB(db)
+	} else {
+		store = inmemory.NewStore()
+	}
+
+	e, usecases := setupServer(db, store)
+
+	// 永続化したデータはテーブルを登録した後に読み込む為、全てのリポジトリを作成してから開きます。
+	if store != nil && env.INMEMORY_DATA_DIR != "" {
+		openInMemoryStore(store, env)
+		defer closeInMemoryStore(store)
 	}
 
-	e := SetupEcho(db)
+	// サーバーの停止後、実行中の自動振込や仮押さえの期限切れ、イベントの発行の処理が終わるのを待ってからデータベースとの接続を閉じます。
+	ctx, cancel := context.WithCancel(context.Background())
+	standingOrderSchedulerDone := startStandingOrderScheduler(ctx, env, usecases.executeDueStandingOrdersUC)
+	holdExpirySchedulerDone := startHoldExpiryScheduler(ctx, env, usecases.expireHoldsUC)
+	eventRelaySchedulerDone := startEventRelayScheduler(ctx, env, usecases.relayEventsUC)
 
 	startServer(e)
+
+	cancel()
+	<-standingOrderSchedulerDone
+	<-holdExpirySchedulerDone
+	<-eventRelaySchedulerDone
+}
+
+// INMEMORY_DATA_DIR のスナップショットと先行書き込みログを読み込み、以降の書き込みを永続化します。
+func openInMemoryStore(store *inmemory.Store, env *config.Env) {
+	logger := slog.New(slog.NewJSONHandler(log.Writer(), nil))
+	err := store.Open(inmemory.PersistenceConfig{
+		Dir:              env.INMEMORY_DATA_DIR,
+		FsyncPolicy:      inmemory.FsyncPolicy(env.INMEMORY_FSYNC),
+		FsyncInterval:    env.INMEMORY_FSYNC_INTERVAL,
+		SnapshotInterval: env.INMEMORY_SNAPSHOT_INTERVAL,
+		OnError: func(err error) {
+			logger.Error(err.Error())
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	log.Println("Successfully opened in-memory store")
+}
+
+func closeInMemoryStore(store *inmemory.Store) {
+	if err := store.Close(); err != nil {
+		log.Fatalf("Failed to close in-memory store: %v", err)
+	}
+	log.Println("Successfully closed in-memory store")
+}
+
+// 実行日を迎えた自動振込を STANDING_ORDER_INTERVAL ごとに実行します。返すチャネルはスケジューラーが停止すると閉じられます。
+func startStandingOrderScheduler(ctx context.Context, env *config.Env, executeDueStandingOrdersUC standingOrderApp.IExecuteDueStandingOrdersUsecase) <-chan struct{} {
+	return startScheduler(ctx, "standing_order", env.STANDING_ORDER_INTERVAL, func(ctx context.Context) ([]slog.Attr, error) {
+		dto, err := executeDueStandingOrdersUC.Run(ctx)
+		if dto == nil || dto.Succeeded+dto.Failed == 0 {
+			return nil, err
+		}
+		return []slog.Attr{slog.Int("succeeded", dto.Succeeded), slog.Int("failed", dto.Failed)}, err
+	})
+}
+
+// job を interval ごとに実行するスケジューラーを起動します。interval が 0 以下の場合は起動しません。
+// job が返したログの項目は、スケジューラーの名前とともに出力します。処理した対象がない場合は nil を返してください。
+// 返すチャネルはスケジューラーが停止すると閉じられます。
+func startScheduler(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) ([]slog.Attr, error)) <-chan struct{} {
+	done := make(chan struct{})
+	if interval <= 0 {
+		close(done)
+		return done
+	}
+
+	logger := slog.New(slog.NewJSONHandler(log.Writer(), nil)).With(slog.String("scheduler", name))
+	run := func(ctx context.Context) error {
+		attrs, err := job(ctx)
+		if len(attrs) > 0 {
+			logger.LogAttrs(ctx, slog.LevelInfo, "scheduled job completed", attrs...)
+		}
+		return err
+	}
+	onError := func(err error) {
+		logger.Error(err.Error())
+	}
+
+	go func() {
+		defer close(done)
+		NewScheduler(interval, run, onError).Run(ctx)
+	}()
+	return done
+}
+
+// 有効期限を過ぎた仮押さえを HOLD_EXPIRY_INTERVAL ごとに期限切れにします。返すチャネルはスケジューラーが停止すると閉じられます。
+func startHoldExpiryScheduler(ctx context.Context, env *config.Env, expireHoldsUC holdApp.IExpireHoldsUsecase) <-chan struct{} {
+	return startScheduler(ctx, "hold_expiry", env.HOLD_EXPIRY_INTERVAL, func(ctx context.Context) ([]slog.Attr, error) {
+		dto, err := expireHoldsUC.Run(ctx)
+		if dto == nil || dto.Expired == 0 {
+			return nil, err
+		}
+		return []slog.Attr{slog.Int("expired", dto.Expired)}, err
+	})
+}
+
+// アウトボックスに記録されたイベントを EVENT_RELAY_INTERVAL ごとに発行します。返すチャネルはスケジューラーが停止すると閉じられます。
+func startEventRelayScheduler(ctx context.Context, env *config.Env, relayEventsUC eventApp.IRelayEventsUsecase) <-chan struct{} {
+	return startScheduler(ctx, "event_relay", env.EVENT_RELAY_INTERVAL, func(ctx context.Context) ([]slog.Attr, error) {
+		dto, err := relayEventsUC.Run(ctx)
+		if dto == nil || dto.Published+dto.Failed == 0 {
+			return nil, err
+		}
+		return []slog.Attr{slog.Int("published", dto.Published), slog.Int("failed", dto.Failed)}, err
+	})
 }
 
 func startServer(e *echo.Echo) {
@@ -71,9 +213,18 @@ func startServer(e *echo.Echo) {
 }
 
 func SetupEcho(db *bun.DB) *echo.Echo {
+	e, _ := setupServer(db, inmemory.NewStore())
+	return e
+}
+
+// スケジューラーがハンドラーと同じリポジトリを使用できるように、ユースケースも返します。
+func setupServer(db *bun.DB, store *inmemory.Store) (*echo.Echo, Usecases) {
 	e := echo.New()
 
-	repositories := setupRepository(db)
+	repositories := setupRepository(db, store)
+	if err := moneyVO.LoadCurrencies(context.Background(), repositories.currency); err != nil {
+		panic(err)
+	}
 	domainServices := setupDomainServices(repositories)
 	usecases := setupUsecases(db, repositories, domainServices)
 	handlers := setupHandlers(usecases)
@@ -87,117 +238,414 @@ func SetupEcho(db *bun.DB) *echo.Echo {
 	}))
 	e.Use(echoMiddleware.RequestID())
 	myMiddleware.SetLoggerMiddleware(e)
-	authMiddleware := myMiddleware.AuthorizationMiddleware(repositories.jwt)
+	authMiddleware := myMiddleware.AuthorizationMiddleware(repositories.jwt, domainServices.session)
 
 	/** Health Endpoint */
 	healthHandler := healthPre.NewHealthHandler(db)
 	e.GET("/", healthHandler.Run)
 
+	/** JWKS Endpoint */
+	e.GET("/.well-known/jwks.json", handlers.jwksHandler.Run)
+
 	v1 := e.Group("/api/v1")
 	setupRoutes(v1, handlers, authMiddleware)
 
 	/** Swagger */
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
-	return e
+	return e, usecases
 }
 
 type Repositories struct {
-	user        userDomain.IUserRepository
-	auth        authDomain.IAuthenticationRepository
-	account     accountDomain.IAccountRepository
-	transaction transactionDomain.ITransactionRepository
-	jwt         authApp.IJWTService
+	user           userDomain.IUserRepository
+	auth           authDomain.IAuthenticationRepository
+	session        sessionDomain.ISessionRepository
+	lockout        lockoutDomain.ILockoutRepository
+	account        accountDomain.IAccountRepository
+	transaction    transactionDomain.ITransactionRepository
+	ledger         ledgerDomain.ILedgerRepository
+	currency       moneyVO.ICurrencyRepository
+	exchangeRate   moneyVO.IExchangeRateProvider
+	idempotencyKey idempotency.IIdempotencyKeyRepository
+	standingOrder  standingOrderDomain.IStandingOrderRepository
+	hold           holdDomain.IHoldRepository
+	accountLimit   limitDomain.IAccountLimitRepository
+	defaultLimit   limitDomain.IDefaultLimitProvider
+	payee          payeeDomain.IPayeeRepository
+	event          eventDomain.IEventRepository
+	jwt            authApp.IJWTService
+	eventPublisher eventApp.IEventPublisher
+	// インメモリモードで、リポジトリとUOWが共有するストア
+	inMemoryStore *inmemory.Store
 }
 
-func setupRepository(db *bun.DB) (repositories Repositories) {
+func setupRepository(db *bun.DB, store *inmemory.Store) (repositories Repositories) {
 	env := config.NewEnv()
+	exchangeRateProvider := setupExchangeRateProvider(env)
+	defaultLimitProvider := setupDefaultLimitProvider(env)
+	eventPublisher := setupEventPublisher(env)
 
-	if env.USE_INMEMORY {
+	switch {
+	case env.USE_INMEMORY:
+		accountRepository := inmemory.NewAccountInMemoryRepository(store)
 		return Repositories{
-			user:        inmemory.NewUserInMemoryRepository(),
-			auth:        inmemory.NewAuthenticationInMemoryRepository(),
-			account:     inmemory.NewAccountInMemoryRepository(),
-			transaction: inmemory.NewTransactionInMemoryRepository(),
-			jwt:         jwt.NewService([]byte(env.JWT_SECRET_KEY)),
+			user:           inmemory.NewUserInMemoryRepository(store),
+			auth:           inmemory.NewAuthenticationInMemoryRepository(store),
+			session:        inmemory.NewSessionInMemoryRepository(store),
+			lockout:        inmemory.NewLockoutInMemoryRepository(store),
+			account:        accountRepository,
+			transaction:    inmemory.NewTransactionInMemoryRepository(store),
+			ledger:         inmemory.NewLedgerInMemoryRepository(store, accountRepository),
+			currency:       inmemory.NewCurrencyInMemoryRepository(),
+			idempotencyKey: inmemory.NewIdempotencyKeyInMemoryRepository(store),
+			standingOrder:  inmemory.NewStandingOrderInMemoryRepository(store),
+			hold:           inmemory.NewHoldInMemoryRepository(store),
+			accountLimit:   inmemory.NewAccountLimitInMemoryRepository(store),
+			defaultLimit:   defaultLimitProvider,
+			payee:          inmemory.NewPayeeInMemoryRepository(store),
+			event:          inmemory.NewEventInMemoryRepository(store),
+			exchangeRate:   exchangeRateProvider,
+			jwt:            NewJWTService(env),
+			eventPublisher: eventPublisher,
+			inMemoryStore:  store,
 		}
-	} else {
+	case isSQLite(db):
+		// SQL が PostgreSQL に依存しないリポジトリは、PostgreSQL のものをそのまま使用します。
 		return Repositories{
-			user:        repository.NewUserRepository(db),
-			auth:        repository.NewAuthenticationRepository(db),
-			account:     repository.NewAccountRepository(db),
-			transaction: repository.NewTransactionRepository(db),
-			jwt:         jwt.NewService([]byte(env.JWT_SECRET_KEY)),
+			user:           sqliteRepository.NewUserRepository(db),
+			auth:           repository.NewAuthenticationRepository(db),
+			session:        repository.NewSessionRepository(db),
+			lockout:        sqliteRepository.NewLockoutRepository(db),
+			account:        repository.NewAccountRepository(db),
+			transaction:    sqliteRepository.NewTransactionRepository(db),
+			ledger:         repository.NewLedgerRepository(db),
+			currency:       repository.NewCurrencyRepository(db),
+			idempotencyKey: repository.NewIdempotencyKeyRepository(db),
+			standingOrder:  repository.NewStandingOrderRepository(db),
+			hold:           repository.NewHoldRepository(db),
+			accountLimit:   repository.NewAccountLimitRepository(db),
+			defaultLimit:   defaultLimitProvider,
+			payee:          repository.NewPayeeRepository(db),
+			event:          repository.NewEventRepository(db),
+			exchangeRate:   exchangeRateProvider,
+			jwt:            NewJWTService(env),
+			eventPublisher: eventPublisher,
+		}
+	default:
+		return Repositories{
+			user:           repository.NewUserRepository(db),
+			auth:           repository.NewAuthenticationRepository(db),
+			session:        repository.NewSessionRepository(db),
+			lockout:        repository.NewLockoutRepository(db),
+			account:        repository.NewAccountRepository(db),
+			transaction:    repository.NewTransactionRepository(db),
+			ledger:         repository.NewLedgerRepository(db),
+			currency:       repository.NewCurrencyRepository(db),
+			idempotencyKey: repository.NewIdempotencyKeyRepository(db),
+			standingOrder:  repository.NewStandingOrderRepository(db),
+			hold:           repository.NewHoldRepository(db),
+			accountLimit:   repository.NewAccountLimitRepository(db),
+			defaultLimit:   defaultLimitProvider,
+			payee:          repository.NewPayeeRepository(db),
+			event:          repository.NewEventRepository(db),
+			exchangeRate:   exchangeRateProvider,
+			jwt:            NewJWTService(env),
+			eventPublisher: eventPublisher,
 		}
 	}
 }
 
+// DB_DRIVER が sqlite の場合は、SQLite の方言で接続しています。
+func isSQLite(db *bun.DB) bool {
+	return db != nil && db.Dialect().Name() == dialect.SQLite
+}
+
+func setupExchangeRateProvider(env *config.Env) moneyVO.IExchangeRateProvider {
+	if env.EXCHANGE_RATE_FILE == "" {
+		return exchangerate.NewFixedRateProvider()
+	}
+	provider, err := exchangerate.NewFileRateProvider(env.EXCHANGE_RATE_FILE)
+	if err != nil {
+		panic(err)
+	}
+	return provider
+}
+
+func setupDefaultLimitProvider(env *config.Env) limitDomain.IDefaultLimitProvider {
+	if env.TRANSACTION_LIMIT_FILE == "" {
+		return transactionlimit.NewFixedLimitProvider()
+	}
+	provider, err := transactionlimit.NewFileLimitProvider(env.TRANSACTION_LIMIT_FILE)
+	if err != nil {
+		panic(err)
+	}
+	return provider
+}
+
+// 発行先は起動時に Env.Validate で検証しています。
+func setupEventPublisher(env *config.Env) eventApp.IEventPublisher {
+	switch env.EVENT_PUBLISHER {
+	case config.EventPublisherLog:
+		return eventpublisher.NewLogPublisher(slog.New(slog.NewJSONHandler(log.Writer(), nil)))
+	case config.EventPublisherFile:
+		publisher, err := eventpublisher.NewFilePublisher(env.EVENT_PUBLISHER_FILE)
+		if err != nil {
+			panic(err)
+		}
+		return publisher
+	case config.EventPublisherHTTP:
+		return eventpublisher.NewHTTPPublisher(env.EVENT_PUBLISHER_URL)
+	default:
+		panic(config.ErrUnsupportedEventPublisher)
+	}
+}
+
+// 署名鍵が指定されている場合は公開鍵暗号方式 (RS256/EdDSA)、指定されていない場合は共通鍵 (HS256) でアクセストークンを署名します。
+func NewJWTService(env *config.Env) authApp.IJWTService {
+	if env.JWT_SIGNING_KEY_FILE == "" {
+		return jwt.NewService([]byte(env.JWT_SECRET_KEY))
+	}
+	service, err := jwt.NewKeyPairServiceFromFiles(env.JWT_SIGNING_KEY_FILE, env.JWT_VERIFICATION_KEY_FILES...)
+	if err != nil {
+		panic(err)
+	}
+	return service
+}
+
 type DomainServices struct {
-	user        userDomain.IUserService
-	auth        authDomain.IAuthenticationService
-	account     accountDomain.IAccountService
-	transaction transactionDomain.ITransactionService
+	user          userDomain.IUserService
+	auth          authDomain.IAuthenticationService
+	session       sessionDomain.ISessionService
+	account       accountDomain.IAccountService
+	transaction   transactionDomain.ITransactionService
+	standingOrder standingOrderDomain.IStandingOrderService
+	hold          holdDomain.IHoldService
+	limit         limitDomain.ILimitService
+	payee         payeeDomain.IPayeeService
 }
 
 func setupDomainServices(r Repositories) DomainServices {
+	lockoutService := lockoutDomain.NewService(r.lockout, timer.Now)
+	limitService := limitDomain.NewService(r.accountLimit, r.defaultLimit)
+	transactionService := transactionDomain.NewService(r.account, r.transaction, r.ledger, r.exchangeRate, limitService, r.event)
 	return DomainServices{
-		user:        userDomain.NewService(r.user),
-		auth:        authDomain.NewService(r.auth, r.user),
-		account:     accountDomain.NewService(r.account),
-		transaction: transactionDomain.NewService(r.account, r.transaction),
+		user:          userDomain.NewService(r.user, r.event),
+		auth:          authDomain.NewService(r.auth, r.user, lockoutService),
+		session:       sessionDomain.NewService(r.session),
+		account:       accountDomain.NewService(r.account, lockoutService, r.event),
+		transaction:   transactionService,
+		standingOrder: standingOrderDomain.NewService(r.standingOrder),
+		hold:          holdDomain.NewService(r.account, r.hold, transactionService),
+		limit:         limitService,
+		payee:         payeeDomain.NewService(r.payee, r.user),
 	}
 }
 
 type Usecases struct {
-	signupUC           authApp.ISignupUsecase
-	signinUC           authApp.ISigninUsecase
-	readUserUC         userApp.IReadUserUsecase
-	createAccountUC    accountApp.ICreateAccountUsecase
-	execTransactionUC  transactionApp.IExecuteTransactionUsecase
-	listTransactionsUC transactionApp.IListTransactionsUsecase
+	signupUC             authApp.ISignupUsecase
+	signinUC             authApp.ISigninUsecase
+	refreshTokenUC       authApp.IRefreshTokenUsecase
+	logoutUC             authApp.ILogoutUsecase
+	listPublicKeysUC     authApp.IListPublicKeysUsecase
+	readUserUC           userApp.IReadUserUsecase
+	createAccountUC      accountApp.ICreateAccountUsecase
+	listAccountsUC       accountApp.IListAccountsUsecase
+	readAccountUC        accountApp.IReadAccountUsecase
+	updateAccountUC      accountApp.IUpdateAccountUsecase
+	changeAccountPwUC    accountApp.IChangeAccountPasswordUsecase
+	closeAccountUC       accountApp.ICloseAccountUsecase
+	execTransactionUC    transactionApp.IExecuteTransactionUsecase
+	listTransactionsUC   transactionApp.IListTransactionsUsecase
+	readTransactionUC    transactionApp.IReadTransactionUsecase
+	reverseTransactionUC transactionApp.IReverseTransactionUsecase
+	categorizeTxUC       transactionApp.ICategorizeTransactionUsecase
+	exportStatementUC    transactionApp.IExportStatementUsecase
+
+	createStandingOrderUC      standingOrderApp.ICreateStandingOrderUsecase
+	listStandingOrdersUC       standingOrderApp.IListStandingOrdersUsecase
+	readStandingOrderUC        standingOrderApp.IReadStandingOrderUsecase
+	updateStandingOrderUC      standingOrderApp.IUpdateStandingOrderUsecase
+	cancelStandingOrderUC      standingOrderApp.ICancelStandingOrderUsecase
+	executeDueStandingOrdersUC standingOrderApp.IExecuteDueStandingOrdersUsecase
+
+	authorizeHoldUC holdApp.IAuthorizeHoldUsecase
+	listHoldsUC     holdApp.IListHoldsUsecase
+	readHoldUC      holdApp.IReadHoldUsecase
+	captureHoldUC   holdApp.ICaptureHoldUsecase
+	voidHoldUC      holdApp.IVoidHoldUsecase
+	expireHoldsUC   holdApp.IExpireHoldsUsecase
+
+	relayEventsUC eventApp.IRelayEventsUsecase
+
+	listAccountLimitsUC limitApp.IListAccountLimitsUsecase
+	setAccountLimitUC   limitApp.ISetAccountLimitUsecase
+
+	registerPayeeUC         payeeApp.IRegisterPayeeUsecase
+	listPayeesUC            payeeApp.IListPayeesUsecase
+	readPayeeUC             payeeApp.IReadPayeeUsecase
+	confirmPayeeUC          payeeApp.IConfirmPayeeUsecase
+	deletePayeeUC           payeeApp.IDeletePayeeUsecase
+	listReceivingAccountsUC payeeApp.IListReceivingAccountsUsecase
+	setReceivingAccountUC   payeeApp.ISetReceivingAccountUsecase
 }
 
 func setupUsecases(db *bun.DB, r Repositories, ds DomainServices) Usecases {
 	var uow unitofwork.IUnitOfWork
 	var transactionUOW unitofwork.IUnitOfWorkWithResult[transactionDomain.Transaction]
 
-	if db == nil {
+	switch {
+	case db == nil:
 		// インメモリ用のUOWを設定
-		uow = inmemory.NewUnitOfWorkInMemory()
-		transactionUOW = inmemory.NewUnitOfWorkInMemoryWithResult[transactionDomain.Transaction]()
-	} else {
+		uow = inmemory.NewUnitOfWorkInMemory(r.inMemoryStore)
+		transactionUOW = inmemory.NewUnitOfWorkInMemoryWithResult[transactionDomain.Transaction](r.inMemoryStore)
+	case isSQLite(db):
+		// SQLite用のUOWを設定
+		uow = sqliteRepository.NewUnitOfWork(db)
+		transactionUOW = sqliteRepository.NewUnitOfWorkWithResult[transactionDomain.Transaction](db)
+	default:
 		// データベース用のUOWを設定
 		uow = repository.NewUnitOfWork(db)
 		transactionUOW = repository.NewUnitOfWorkWithResult[transactionDomain.Transaction](db)
 	}
 
+	listTransactionsUC := transactionApp.NewListTransactionsUsecase(ds.account, ds.transaction)
+
 	return Usecases{
-		signupUC:           authApp.NewSignupUsecase(r.user, r.auth, ds.user, ds.auth, r.jwt),
-		signinUC:           authApp.NewSigninUsecase(ds.auth, r.jwt),
-		readUserUC:         userApp.NewReadUserUsecase(ds.user),
-		createAccountUC:    accountApp.NewCreateAccountUsecase(r.account, ds.account, ds.user, uow),
-		execTransactionUC:  transactionApp.NewExecuteTransactionUsecase(ds.account, ds.transaction, transactionUOW),
-		listTransactionsUC: transactionApp.NewListTransactionsUsecase(ds.account, ds.transaction),
+		signupUC:             authApp.NewSignupUsecase(r.auth, ds.user, ds.auth, ds.session, r.jwt, uow),
+		signinUC:             authApp.NewSigninUsecase(ds.auth, ds.session, r.jwt),
+		refreshTokenUC:       authApp.NewRefreshTokenUsecase(ds.session, r.jwt, uow),
+		logoutUC:             authApp.NewLogoutUsecase(ds.session),
+		listPublicKeysUC:     authApp.NewListPublicKeysUsecase(r.jwt),
+		readUserUC:           userApp.NewReadUserUsecase(ds.user),
+		createAccountUC:      accountApp.NewCreateAccountUsecase(ds.account, ds.user, uow),
+		listAccountsUC:       accountApp.NewListAccountsUsecase(r.account),
+		readAccountUC:        accountApp.NewReadAccountUsecase(ds.account),
+		updateAccountUC:      accountApp.NewUpdateAccountUsecase(r.account, ds.account, uow),
+		changeAccountPwUC:    accountApp.NewChangeAccountPasswordUsecase(r.account, ds.account, uow),
+		closeAccountUC:       accountApp.NewCloseAccountUsecase(r.account, ds.account, uow),
+		execTransactionUC:    transactionApp.NewExecuteTransactionUsecase(ds.account, ds.transaction, ds.payee, r.idempotencyKey, transactionUOW),
+		listTransactionsUC:   listTransactionsUC,
+		readTransactionUC:    transactionApp.NewReadTransactionUsecase(ds.account, ds.transaction),
+		reverseTransactionUC: transactionApp.NewReverseTransactionUsecase(ds.account, ds.transaction, transactionUOW),
+		categorizeTxUC:       transactionApp.NewCategorizeTransactionUsecase(ds.account, ds.transaction, uow),
+		exportStatementUC:    transactionApp.NewExportStatementUsecase(ds.account, r.ledger, listTransactionsUC),
+
+		createStandingOrderUC:      standingOrderApp.NewCreateStandingOrderUsecase(ds.account, r.standingOrder),
+		listStandingOrdersUC:       standingOrderApp.NewListStandingOrdersUsecase(ds.account, r.standingOrder),
+		readStandingOrderUC:        standingOrderApp.NewReadStandingOrderUsecase(ds.account, ds.standingOrder, r.standingOrder),
+		updateStandingOrderUC:      standingOrderApp.NewUpdateStandingOrderUsecase(ds.account, ds.standingOrder, r.standingOrder, uow),
+		cancelStandingOrderUC:      standingOrderApp.NewCancelStandingOrderUsecase(ds.account, ds.standingOrder, r.standingOrder, uow),
+		executeDueStandingOrdersUC: standingOrderApp.NewExecuteDueStandingOrdersUsecase(ds.account, ds.transaction, r.standingOrder, uow, timer.Now),
+
+		authorizeHoldUC: holdApp.NewAuthorizeHoldUsecase(ds.account, ds.hold, uow),
+		listHoldsUC:     holdApp.NewListHoldsUsecase(ds.account, r.hold),
+		readHoldUC:      holdApp.NewReadHoldUsecase(ds.account, ds.hold),
+		captureHoldUC:   holdApp.NewCaptureHoldUsecase(ds.account, ds.hold, uow),
+		voidHoldUC:      holdApp.NewVoidHoldUsecase(ds.account, ds.hold, uow),
+		expireHoldsUC:   holdApp.NewExpireHoldsUsecase(ds.hold, r.hold, uow, timer.Now),
+
+		relayEventsUC: eventApp.NewRelayEventsUsecase(r.event, r.eventPublisher, timer.Now),
+
+		listAccountLimitsUC: limitApp.NewListAccountLimitsUsecase(ds.account, ds.limit),
+		setAccountLimitUC:   limitApp.NewSetAccountLimitUsecase(ds.account, ds.limit, uow),
+
+		registerPayeeUC:         payeeApp.NewRegisterPayeeUsecase(ds.payee),
+		listPayeesUC:            payeeApp.NewListPayeesUsecase(r.payee),
+		readPayeeUC:             payeeApp.NewReadPayeeUsecase(ds.payee),
+		confirmPayeeUC:          payeeApp.NewConfirmPayeeUsecase(ds.payee, r.payee, uow),
+		deletePayeeUC:           payeeApp.NewDeletePayeeUsecase(ds.payee, r.payee, uow),
+		listReceivingAccountsUC: payeeApp.NewListReceivingAccountsUsecase(r.payee),
+		setReceivingAccountUC:   payeeApp.NewSetReceivingAccountUsecase(ds.account, ds.payee),
 	}
 }
 
 type Handlers struct {
-	signupHandler           *signupPre.SignupHandler
-	signinHandler           *signinPre.SigninHandler
-	readMyProfHandler       *mePre.ReadMyProfileHandler
-	createAccountHandler    *accountsPre.CreateAccountHandler
-	execTransactionHandler  *transactionsPre.ExecuteTransactionHandler
-	listTransactionsHandler *transactionsPre.ListTransactionsHandler
+	signupHandler             *signupPre.SignupHandler
+	signinHandler             *signinPre.SigninHandler
+	refreshTokenHandler       *tokenPre.RefreshTokenHandler
+	logoutHandler             *logoutPre.LogoutHandler
+	jwksHandler               *jwksPre.JWKSHandler
+	readMyProfHandler         *mePre.ReadMyProfileHandler
+	createAccountHandler      *accountsPre.CreateAccountHandler
+	listAccountsHandler       *accountsPre.ListAccountsHandler
+	readAccountHandler        *accountsPre.ReadAccountHandler
+	updateAccountHandler      *accountsPre.UpdateAccountHandler
+	changeAccountPwHandler    *accountsPre.ChangeAccountPasswordHandler
+	closeAccountHandler       *accountsPre.CloseAccountHandler
+	execTransactionHandler    *transactionsPre.ExecuteTransactionHandler
+	listTransactionsHandler   *transactionsPre.ListTransactionsHandler
+	readTransactionHandler    *transactionsPre.ReadTransactionHandler
+	reverseTransactionHandler *transactionsPre.ReverseTransactionHandler
+	categorizeTxHandler       *transactionsPre.CategorizeTransactionHandler
+	exportStatementHandler    *statementsPre.ExportStatementHandler
+
+	createStandingOrderHandler *standingOrdersPre.CreateStandingOrderHandler
+	listStandingOrdersHandler  *standingOrdersPre.ListStandingOrdersHandler
+	readStandingOrderHandler   *standingOrdersPre.ReadStandingOrderHandler
+	updateStandingOrderHandler *standingOrdersPre.UpdateStandingOrderHandler
+	cancelStandingOrderHandler *standingOrdersPre.CancelStandingOrderHandler
+
+	authorizeHoldHandler *holdsPre.AuthorizeHoldHandler
+	listHoldsHandler     *holdsPre.ListHoldsHandler
+	readHoldHandler      *holdsPre.ReadHoldHandler
+	captureHoldHandler   *holdsPre.CaptureHoldHandler
+	voidHoldHandler      *holdsPre.VoidHoldHandler
+
+	listAccountLimitsHandler *limitsPre.ListAccountLimitsHandler
+	setAccountLimitHandler   *limitsPre.SetAccountLimitHandler
+
+	registerPayeeHandler         *payeesPre.RegisterPayeeHandler
+	listPayeesHandler            *payeesPre.ListPayeesHandler
+	readPayeeHandler             *payeesPre.ReadPayeeHandler
+	confirmPayeeHandler          *payeesPre.ConfirmPayeeHandler
+	deletePayeeHandler           *payeesPre.DeletePayeeHandler
+	listReceivingAccountsHandler *receivingAccountsPre.ListReceivingAccountsHandler
+	setReceivingAccountHandler   *receivingAccountsPre.SetReceivingAccountHandler
 }
 
 func setupHandlers(u Usecases) Handlers {
 	return Handlers{
-		signupHandler:           signupPre.NewSignupHandler(u.signupUC),
-		signinHandler:           signinPre.NewSigninHandler(u.signinUC),
-		readMyProfHandler:       mePre.NewReadMyProfileHandler(u.readUserUC),
-		createAccountHandler:    accountsPre.NewCreateAccountHandler(u.createAccountUC),
-		execTransactionHandler:  transactionsPre.NewExecuteTransactionHandler(u.execTransactionUC),
-		listTransactionsHandler: transactionsPre.NewListTransactionsHandler(u.listTransactionsUC),
+		signupHandler:             signupPre.NewSignupHandler(u.signupUC),
+		signinHandler:             signinPre.NewSigninHandler(u.signinUC),
+		refreshTokenHandler:       tokenPre.NewRefreshTokenHandler(u.refreshTokenUC),
+		logoutHandler:             logoutPre.NewLogoutHandler(u.logoutUC),
+		jwksHandler:               jwksPre.NewJWKSHandler(u.listPublicKeysUC),
+		readMyProfHandler:         mePre.NewReadMyProfileHandler(u.readUserUC),
+		createAccountHandler:      accountsPre.NewCreateAccountHandler(u.createAccountUC),
+		listAccountsHandler:       accountsPre.NewListAccountsHandler(u.listAccountsUC),
+		readAccountHandler:        accountsPre.NewReadAccountHandler(u.readAccountUC),
+		updateAccountHandler:      accountsPre.NewUpdateAccountHandler(u.updateAccountUC),
+		changeAccountPwHandler:    accountsPre.NewChangeAccountPasswordHandler(u.changeAccountPwUC),
+		closeAccountHandler:       accountsPre.NewCloseAccountHandler(u.closeAccountUC),
+		execTransactionHandler:    transactionsPre.NewExecuteTransactionHandler(u.execTransactionUC),
+		listTransactionsHandler:   transactionsPre.NewListTransactionsHandler(u.listTransactionsUC),
+		readTransactionHandler:    transactionsPre.NewReadTransactionHandler(u.readTransactionUC),
+		reverseTransactionHandler: transactionsPre.NewReverseTransactionHandler(u.reverseTransactionUC),
+		categorizeTxHandler:       transactionsPre.NewCategorizeTransactionHandler(u.categorizeTxUC),
+		exportStatementHandler:    statementsPre.NewExportStatementHandler(u.exportStatementUC),
+
+		createStandingOrderHandler: standingOrdersPre.NewCreateStandingOrderHandler(u.createStandingOrderUC),
+		listStandingOrdersHandler:  standingOrdersPre.NewListStandingOrdersHandler(u.listStandingOrdersUC),
+		readStandingOrderHandler:   standingOrdersPre.NewReadStandingOrderHandler(u.readStandingOrderUC),
+		updateStandingOrderHandler: standingOrdersPre.NewUpdateStandingOrderHandler(u.updateStandingOrderUC),
+		cancelStandingOrderHandler: standingOrdersPre.NewCancelStandingOrderHandler(u.cancelStandingOrderUC),
+
+		authorizeHoldHandler: holdsPre.NewAuthorizeHoldHandler(u.authorizeHoldUC),
+		listHoldsHandler:     holdsPre.NewListHoldsHandler(u.listHoldsUC),
+		readHoldHandler:      holdsPre.NewReadHoldHandler(u.readHoldUC),
+		captureHoldHandler:   holdsPre.NewCaptureHoldHandler(u.captureHoldUC),
+		voidHoldHandler:      holdsPre.NewVoidHoldHandler(u.voidHoldUC),
+
+		listAccountLimitsHandler: limitsPre.NewListAccountLimitsHandler(u.listAccountLimitsUC),
+		setAccountLimitHandler:   limitsPre.NewSetAccountLimitHandler(u.setAccountLimitUC),
+
+		registerPayeeHandler:         payeesPre.NewRegisterPayeeHandler(u.registerPayeeUC),
+		listPayeesHandler:            payeesPre.NewListPayeesHandler(u.listPayeesUC),
+		readPayeeHandler:             payeesPre.NewReadPayeeHandler(u.readPayeeUC),
+		confirmPayeeHandler:          payeesPre.NewConfirmPayeeHandler(u.confirmPayeeUC),
+		deletePayeeHandler:           payeesPre.NewDeletePayeeHandler(u.deletePayeeUC),
+		listReceivingAccountsHandler: receivingAccountsPre.NewListReceivingAccountsHandler(u.listReceivingAccountsUC),
+		setReceivingAccountHandler:   receivingAccountsPre.NewSetReceivingAccountHandler(u.setReceivingAccountUC),
 	}
 }
 
@@ -205,14 +653,54 @@ func setupRoutes(e *echo.Group, h Handlers, authMiddleware echo.MiddlewareFunc)
 	/** Authentication Endpoint */
 	e.POST("/signup", h.signupHandler.Run)
 	e.POST("/signin", h.signinHandler.Run)
+	e.POST("/token/refresh", h.refreshTokenHandler.Run)
+	e.POST("/logout", h.logoutHandler.Run, authMiddleware)
 
 	/** User Endpoint */
 	e.GET("/me", h.readMyProfHandler.Run, authMiddleware)
 
 	/** Account Endpoint */
 	e.POST("/me/accounts", h.createAccountHandler.Run, authMiddleware)
+	e.GET("/me/accounts", h.listAccountsHandler.Run, authMiddleware)
+	e.GET("/me/accounts/:account_id", h.readAccountHandler.Run, authMiddleware)
+	e.PATCH("/me/accounts/:account_id", h.updateAccountHandler.Run, authMiddleware)
+	e.PUT("/me/accounts/:account_id/password", h.changeAccountPwHandler.Run, authMiddleware)
+	e.DELETE("/me/accounts/:account_id", h.closeAccountHandler.Run, authMiddleware)
 
 	/** Transaction Endpoint */
 	e.POST("/me/accounts/:account_id/transactions", h.execTransactionHandler.Run, authMiddleware)
 	e.GET("/me/accounts/:account_id/transactions", h.listTransactionsHandler.Run, authMiddleware)
+	e.GET("/me/accounts/:account_id/transactions/:transaction_id", h.readTransactionHandler.Run, authMiddleware)
+	e.POST("/me/accounts/:account_id/transactions/:transaction_id/reversal", h.reverseTransactionHandler.Run, authMiddleware)
+	e.PUT("/me/accounts/:account_id/transactions/:transaction_id/categories", h.categorizeTxHandler.Run, authMiddleware)
+	e.GET("/me/accounts/:account_id/statements", h.exportStatementHandler.Run, authMiddleware)
+
+	/** Standing Order Endpoint */
+	e.POST("/me/accounts/:account_id/standing-orders", h.createStandingOrderHandler.Run, authMiddleware)
+	e.GET("/me/accounts/:account_id/standing-orders", h.listStandingOrdersHandler.Run, authMiddleware)
+	e.GET("/me/accounts/:account_id/standing-orders/:standing_order_id", h.readStandingOrderHandler.Run, authMiddleware)
+	e.PATCH("/me/accounts/:account_id/standing-orders/:standing_order_id", h.updateStandingOrderHandler.Run, authMiddleware)
+	e.DELETE("/me/accounts/:account_id/standing-orders/:standing_order_id", h.cancelStandingOrderHandler.Run, authMiddleware)
+
+	/** Hold Endpoint */
+	e.POST("/me/accounts/:account_id/holds", h.authorizeHoldHandler.Run, authMiddleware)
+	e.GET("/me/accounts/:account_id/holds", h.listHoldsHandler.Run, authMiddleware)
+	e.GET("/me/accounts/:account_id/holds/:hold_id", h.readHoldHandler.Run, authMiddleware)
+	e.POST("/me/accounts/:account_id/holds/:hold_id/capture", h.captureHoldHandler.Run, authMiddleware)
+	e.POST("/me/accounts/:account_id/holds/:hold_id/void", h.voidHoldHandler.Run, authMiddleware)
+
+	/** Limit Endpoint */
+	e.GET("/me/accounts/:account_id/limits", h.listAccountLimitsHandler.Run, authMiddleware)
+	e.PUT("/me/accounts/:account_id/limits/:operation_type", h.setAccountLimitHandler.Run, authMiddleware)
+
+	/** Payee Endpoint */
+	e.POST("/me/payees", h.registerPayeeHandler.Run, authMiddleware)
+	e.GET("/me/payees", h.listPayeesHandler.Run, authMiddleware)
+	e.GET("/me/payees/:payee_id", h.readPayeeHandler.Run, authMiddleware)
+	e.POST("/me/payees/:payee_id/confirmation", h.confirmPayeeHandler.Run, authMiddleware)
+	e.DELETE("/me/payees/:payee_id", h.deletePayeeHandler.Run, authMiddleware)
+
+	/** Receiving Account Endpoint */
+	e.GET("/me/receiving-accounts", h.listReceivingAccountsHandler.Run, authMiddleware)
+	e.PUT("/me/receiving-accounts/:currency", h.setReceivingAccountHandler.Run, authMiddleware)
 }
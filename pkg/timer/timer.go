@@ -39,6 +39,11 @@ func ParseYYYYMMDD(dateStr string) (time.Time, error) {
 	return parsedTime.UTC().Truncate(time.Second), nil
 }
 
+// FormatToYYYYMMDD は time.Time 値をUTCの "YYYYMMDD" 形式の日付文字列に変換します
+func FormatToYYYYMMDD(t time.Time) string {
+	return t.UTC().Format("20060102")
+}
+
 func TimePointer(t time.Time) *time.Time {
 	return &t
 }
@@ -84,3 +84,28 @@ func TestParseYYYYMMDD(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatToYYYYMMDD(t *testing.T) {
+	tests := []struct {
+		caseName string
+		input    time.Time
+		want     string
+	}{
+		{
+			caseName: "UTCの日付に変換される",
+			input:    time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC),
+			want:     "20240131",
+		},
+		{
+			caseName: "UTC以外のタイムゾーンはUTCの日付に変換される",
+			input:    time.Date(2024, 2, 1, 8, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+			want:     "20240131",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			assert.Equal(t, tt.want, timer.FormatToYYYYMMDD(tt.input))
+		})
+	}
+}
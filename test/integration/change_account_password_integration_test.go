@@ -0,0 +1,103 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/seed"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts"
+	passwordUtil "github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/timer"
+	"github.com/uptrace/bun"
+)
+
+func TestChangeAccountPassword(t *testing.T) {
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		userName  = "sato taro"
+		userEmail = "sato@example.com"
+		password  = "1234"
+	)
+
+	insertAccount := func(t *testing.T, db *bun.DB) {
+		user := &model.User{
+			ID:    userID.String(),
+			Name:  userName,
+			Email: userEmail,
+		}
+		passwordHash, err := passwordUtil.Encode(password)
+		assert.NoError(t, err)
+		account := &model.Account{
+			ID:           accountID.String(),
+			UserID:       userID.String(),
+			Name:         "For work",
+			PasswordHash: passwordHash,
+			Balance:      1000,
+			CurrencyID:   seed.JPYID,
+			UpdatedAt:    timer.Now(),
+		}
+		InsertTestData(t, db, user, account)
+	}
+
+	tests := []struct {
+		caseName     string
+		requestBody  interface{}
+		prepare      func(t *testing.T, db *bun.DB)
+		wantCode     int
+		wantPassword string
+	}{
+		{
+			caseName: "Happy path (204): パスワードの変更に成功する",
+			requestBody: accounts.ChangeAccountPasswordRequestBody{
+				CurrentPassword: password,
+				NewPassword:     "5678",
+			},
+			prepare:      insertAccount,
+			wantCode:     http.StatusNoContent,
+			wantPassword: "5678",
+		},
+		{
+			caseName: "Sad path (403): 現在のパスワードが異なる為、失敗する",
+			requestBody: accounts.ChangeAccountPasswordRequestBody{
+				CurrentPassword: "9999",
+				NewPassword:     "5678",
+			},
+			prepare:      insertAccount,
+			wantCode:     http.StatusForbidden,
+			wantPassword: password,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			e, gol, db := BeforeAll(t)
+			defer AfterAll(t, db)
+
+			tt.prepare(t, db)
+			usedTables := []string{"accounts"}
+			beforeDBData := GetDBData(t, db, usedTables)
+
+			req, rec := NewJSONRequest(t, http.MethodPut, "/api/v1/me/accounts/"+accountID.String()+"/password", tt.requestBody)
+			SetAccessToken(t, db, userID.String(), req)
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			// パスワードはハッシュ化されて保存される為、ハッシュと照合して確認する
+			var stored model.Account
+			err := db.NewSelect().Model(&stored).Where("id = ?", accountID.String()).Scan(req.Context())
+			assert.NoError(t, err)
+			assert.NoError(t, passwordUtil.Compare(stored.PasswordHash, tt.wantPassword))
+
+			afterDBData := GetDBData(t, db, usedTables)
+			result := GenerateResultJSON(t, beforeDBData, afterDBData, req, rec, tt.requestBody)
+			replaceKeys := []string{"passwordHash", "updatedAt"}
+			result = ReplaceDynamicValue(result, replaceKeys)
+
+			gol.Assert(t, t.Name(), result)
+		})
+	}
+}
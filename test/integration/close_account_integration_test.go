@@ -0,0 +1,87 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/seed"
+	passwordUtil "github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/timer"
+	"github.com/uptrace/bun"
+)
+
+func TestCloseAccount(t *testing.T) {
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		userName  = "sato taro"
+		userEmail = "sato@example.com"
+	)
+
+	insertAccount := func(t *testing.T, db *bun.DB, balance int64) {
+		user := &model.User{
+			ID:    userID.String(),
+			Name:  userName,
+			Email: userEmail,
+		}
+		passwordHash, err := passwordUtil.Encode("1234")
+		assert.NoError(t, err)
+		account := &model.Account{
+			ID:           accountID.String(),
+			UserID:       userID.String(),
+			Name:         "For work",
+			PasswordHash: passwordHash,
+			Balance:      balance,
+			CurrencyID:   seed.JPYID,
+			UpdatedAt:    timer.Now(),
+		}
+		InsertTestData(t, db, user, account)
+	}
+
+	tests := []struct {
+		caseName string
+		prepare  func(t *testing.T, db *bun.DB)
+		wantCode int
+	}{
+		{
+			caseName: "Happy path (204): 口座の解約に成功する",
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertAccount(t, db, 0)
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			caseName: "Sad path (409): 残高が残っている為、失敗する",
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertAccount(t, db, 1000)
+			},
+			wantCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			e, gol, db := BeforeAll(t)
+			defer AfterAll(t, db)
+
+			tt.prepare(t, db)
+			usedTables := []string{"accounts"}
+			beforeDBData := GetDBData(t, db, usedTables)
+
+			req, rec := NewJSONRequest(t, http.MethodDelete, "/api/v1/me/accounts/"+accountID.String(), nil)
+			SetAccessToken(t, db, userID.String(), req)
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			afterDBData := GetDBData(t, db, usedTables)
+			result := GenerateResultJSON(t, beforeDBData, afterDBData, req, rec, nil)
+			replaceKeys := []string{"passwordHash", "updatedAt", "deletedAt"}
+			result = ReplaceDynamicValue(result, replaceKeys)
+
+			gol.Assert(t, t.Name(), result)
+		})
+	}
+}
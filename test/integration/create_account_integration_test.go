@@ -28,10 +28,11 @@ func TestCreateAccount(t *testing.T) {
 	)
 
 	tests := []struct {
-		caseName    string
-		requestBody interface{}
-		prepare     func(t *testing.T, db *bun.DB)
-		wantCode    int
+		caseName     string
+		requestBody  interface{}
+		prepare      func(t *testing.T, db *bun.DB)
+		wantCode     int
+		skipIfSQLite bool
 	}{
 		{
 			caseName: "Happy path (201): 口座作成に成功する",
@@ -60,6 +61,25 @@ func TestCreateAccount(t *testing.T) {
 			prepare: func(t *testing.T, db *bun.DB) {
 				InsertTestData(t, db)
 			},
+			wantCode:     http.StatusNotFound,
+			skipIfSQLite: true,
+		},
+		{
+			caseName: "Sad path (404): ユーザーが退会済みの為、失敗する",
+			requestBody: accounts.CreateAccountRequestBody{
+				Name:     name,
+				Password: password,
+				Currency: currency,
+			},
+			prepare: func(t *testing.T, db *bun.DB) {
+				user := &model.User{
+					ID:        userID.String(),
+					Name:      userName,
+					Email:     userEmail,
+					DeletedAt: timer.GetFixedDate(),
+				}
+				InsertTestData(t, db, user)
+			},
 			wantCode: http.StatusNotFound,
 		},
 		{
@@ -97,6 +117,9 @@ func TestCreateAccount(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.caseName, func(t *testing.T) {
+			if tt.skipIfSQLite {
+				SkipIfSQLite(t)
+			}
 			e, gol, db := BeforeAll(t)
 			defer AfterAll(t, db)
 
@@ -105,13 +128,13 @@ func TestCreateAccount(t *testing.T) {
 			beforeDBData := GetDBData(t, db, usedTables)
 
 			req, rec := NewJSONRequest(t, http.MethodPost, "/api/v1/me/accounts", tt.requestBody)
-			SetAccessToken(t, userID.String(), req)
+			SetAccessToken(t, db, userID.String(), req)
 			e.ServeHTTP(rec, req)
 			assert.Equal(t, tt.wantCode, rec.Code)
 
 			afterDBData := GetDBData(t, db, usedTables)
 			result := GenerateResultJSON(t, beforeDBData, afterDBData, req, rec, tt.requestBody)
-			replaceKeys := []string{"id", "passwordHash", "accessToken", "updatedAt"}
+			replaceKeys := []string{"id", "passwordHash", "accessToken", "updatedAt", "deletedAt"}
 			result = ReplaceDynamicValue(result, replaceKeys)
 
 			gol.Assert(t, t.Name(), result)
@@ -13,16 +13,16 @@ import (
 	"runtime"
 	"testing"
 
-	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
 	"github.com/sebdah/goldie/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/u104rak1/pocgo/internal/config"
-	"github.com/u104rak1/pocgo/internal/infrastructure/jwt"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
 	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/seed"
 	"github.com/u104rak1/pocgo/internal/server"
 	"github.com/u104rak1/pocgo/pkg/strutil"
+	"github.com/u104rak1/pocgo/pkg/timer"
 	"github.com/uptrace/bun"
 )
 
@@ -75,6 +75,15 @@ func BeforeAll(t *testing.T) (*echo.Echo, *goldie.Goldie, *bun.DB) {
 	return e, gol, db
 }
 
+// SQLite は外部キー制約を有効にしており、存在しないユーザーのセッションを作成できない為、
+// 存在しないユーザーでアクセスするケースは SQLite では実行しません。
+func SkipIfSQLite(t *testing.T) {
+	t.Helper()
+	if config.NewEnv().DB_DRIVER == config.DBDriverSQLite {
+		t.Skip("SQLite cannot create a session for a user that does not exist")
+	}
+}
+
 // データベースとの接続を閉じます。deferで呼び出してください。
 func AfterAll(t *testing.T, db *bun.DB) {
 	config.CloseDB(db)
@@ -154,13 +163,17 @@ func GetDBData(t *testing.T, db *bun.DB, usedTables []string) map[string]interfa
 }
 
 // 指定したユーザーIDに対応するアクセストークンをリクエストヘッダーにセットします
-func SetAccessToken(t *testing.T, userID string, req *http.Request) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+// アクセストークンは有効なセッションに紐づいている必要がある為、ユーザーごとに固定のIDでセッションを作成します
+func SetAccessToken(t *testing.T, db *bun.DB, userID string, req *http.Request) {
+	sessionID := idVO.NewSessionIDForTest(userID).String()
+	_, err := db.NewInsert().Model(&model.Session{
+		ID:        sessionID,
+		UserID:    userID,
+		CreatedAt: timer.Now(),
+	}).On("CONFLICT (id) DO NOTHING").Exec(context.Background())
+	assert.NoError(t, err)
 
-	env := config.NewEnv()
-	jwtSecretKey := []byte(env.JWT_SECRET_KEY)
-	token, err := jwt.NewService(jwtSecretKey).GenerateAccessToken(userID)
+	token, err := server.NewJWTService(config.NewEnv()).GenerateAccessToken(userID, sessionID)
 	assert.NoError(t, err)
 	req.Header.Set("Authorization", "Bearer "+token)
 }
@@ -189,9 +202,12 @@ func GenerateResultJSON(
 	rec *httptest.ResponseRecorder,
 	requestBody interface{},
 ) []byte {
+	// 204 No Content などレスポンスボディが空の場合は null として出力します
 	var responseBody interface{}
-	if err := json.Unmarshal(rec.Body.Bytes(), &responseBody); err != nil {
-		t.Fatalf("Failed to unmarshal response body: %v", err)
+	if rec.Body.Len() > 0 {
+		if err := json.Unmarshal(rec.Body.Bytes(), &responseBody); err != nil {
+			t.Fatalf("Failed to unmarshal response body: %v", err)
+		}
 	}
 
 	result := TestResult{
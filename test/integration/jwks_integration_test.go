@@ -0,0 +1,38 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWKS(t *testing.T) {
+	tests := []struct {
+		caseName string
+		wantCode int
+	}{
+		{
+			caseName: "Happy path (200): 共通鍵で署名している為、空の公開鍵一覧を取得する",
+			wantCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			e, gol, db := BeforeAll(t)
+			defer AfterAll(t, db)
+
+			req, rec := NewJSONRequest(t, http.MethodGet, "/.well-known/jwks.json", nil)
+			beforeDBData := GetDBData(t, db, nil)
+
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			afterDBData := GetDBData(t, db, nil)
+			result := GenerateResultJSON(t, beforeDBData, afterDBData, req, rec, nil)
+
+			gol.Assert(t, t.Name(), result)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/seed"
+	passwordUtil "github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/timer"
+	"github.com/uptrace/bun"
+)
+
+func TestListAccounts(t *testing.T) {
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		userName  = "sato taro"
+		userEmail = "sato@example.com"
+	)
+
+	tests := []struct {
+		caseName string
+		prepare  func(t *testing.T, db *bun.DB)
+		wantCode int
+	}{
+		{
+			caseName: "Happy path (200): 解約済みの口座を除いた口座一覧の取得に成功する",
+			prepare: func(t *testing.T, db *bun.DB) {
+				user := &model.User{
+					ID:    userID.String(),
+					Name:  userName,
+					Email: userEmail,
+				}
+				passwordHash, err := passwordUtil.Encode("1234")
+				assert.NoError(t, err)
+				accounts := []*model.Account{
+					{
+						ID:           idVO.NewAccountIDForTest("account1").String(),
+						UserID:       userID.String(),
+						Name:         "For work",
+						PasswordHash: passwordHash,
+						Balance:      1000,
+						CurrencyID:   seed.JPYID,
+						UpdatedAt:    timer.Now(),
+					},
+					{
+						ID:           idVO.NewAccountIDForTest("account2").String(),
+						UserID:       userID.String(),
+						Name:         "For travel",
+						PasswordHash: passwordHash,
+						Balance:      1050,
+						CurrencyID:   seed.USDID,
+						UpdatedAt:    timer.Now(),
+					},
+					{
+						ID:           idVO.NewAccountIDForTest("account3").String(),
+						UserID:       userID.String(),
+						Name:         "Closed",
+						PasswordHash: passwordHash,
+						Balance:      0,
+						CurrencyID:   seed.JPYID,
+						UpdatedAt:    timer.Now(),
+						DeletedAt:    timer.Now(),
+					},
+				}
+				InsertTestData(t, db, user, accounts)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			caseName: "Happy path (200): 口座を所有していない場合は空の一覧を返す",
+			prepare: func(t *testing.T, db *bun.DB) {
+				user := &model.User{
+					ID:    userID.String(),
+					Name:  userName,
+					Email: userEmail,
+				}
+				InsertTestData(t, db, user)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			e, gol, db := BeforeAll(t)
+			defer AfterAll(t, db)
+
+			tt.prepare(t, db)
+			usedTables := []string{"users", "accounts"}
+			beforeDBData := GetDBData(t, db, usedTables)
+
+			req, rec := NewJSONRequest(t, http.MethodGet, "/api/v1/me/accounts", nil)
+			SetAccessToken(t, db, userID.String(), req)
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			result := GenerateResultJSON(t, beforeDBData, nil, req, rec, nil)
+			replaceKeys := []string{"passwordHash", "updatedAt", "deletedAt"}
+			result = ReplaceDynamicValue(result, replaceKeys)
+
+			gol.Assert(t, t.Name(), result)
+		})
+	}
+}
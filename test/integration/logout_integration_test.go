@@ -0,0 +1,90 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/u104rak1/pocgo/pkg/timer"
+	"github.com/uptrace/bun"
+)
+
+func TestLogout(t *testing.T) {
+	var (
+		userID = idVO.NewUserIDForTest("user")
+		// SetAccessToken で作成されるセッションのID
+		sessionID = idVO.NewSessionIDForTest(userID.String())
+	)
+
+	insertUser := func(t *testing.T, db *bun.DB) {
+		user := &model.User{
+			ID:    userID.String(),
+			Name:  "sato taro",
+			Email: "sato@example.com",
+		}
+		InsertTestData(t, db, user)
+	}
+
+	tests := []struct {
+		caseName  string
+		prepare   func(t *testing.T, db *bun.DB)
+		withToken bool
+		wantCode  int
+	}{
+		{
+			caseName:  "Happy path (204): ログアウトに成功する",
+			prepare:   insertUser,
+			withToken: true,
+			wantCode:  http.StatusNoContent,
+		},
+		{
+			caseName:  "Sad path (401): アクセストークンがない為、失敗する",
+			prepare:   insertUser,
+			withToken: false,
+			wantCode:  http.StatusUnauthorized,
+		},
+		{
+			caseName: "Sad path (401): 既にログアウト済みのセッションの為、失敗する",
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertUser(t, db)
+				revokedAt := timer.Now()
+				session := &model.Session{
+					ID:        sessionID.String(),
+					UserID:    userID.String(),
+					CreatedAt: timer.Now(),
+					RevokedAt: &revokedAt,
+				}
+				InsertTestData(t, db, session)
+			},
+			withToken: true,
+			wantCode:  http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			e, gol, db := BeforeAll(t)
+			defer AfterAll(t, db)
+
+			tt.prepare(t, db)
+			req, rec := NewJSONRequest(t, http.MethodPost, "/api/v1/logout", nil)
+			if tt.withToken {
+				SetAccessToken(t, db, userID.String(), req)
+			}
+			usedTables := []string{"sessions"}
+			beforeDBData := GetDBData(t, db, usedTables)
+
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			afterDBData := GetDBData(t, db, usedTables)
+			result := GenerateResultJSON(t, beforeDBData, afterDBData, req, rec, nil)
+			replaceKeys := []string{"createdAt", "revokedAt"}
+			result = ReplaceDynamicValue(result, replaceKeys)
+
+			gol.Assert(t, t.Name(), result)
+		})
+	}
+}
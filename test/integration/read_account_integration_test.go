@@ -0,0 +1,100 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/seed"
+	passwordUtil "github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/timer"
+	"github.com/uptrace/bun"
+)
+
+func TestReadAccount(t *testing.T) {
+	var (
+		userID       = idVO.NewUserIDForTest("user")
+		otherUserID  = idVO.NewUserIDForTest("other")
+		accountID    = idVO.NewAccountIDForTest("account")
+		accountName  = "For work"
+		userName     = "sato taro"
+		userEmail    = "sato@example.com"
+		otherName    = "suzuki jiro"
+		otherEmail   = "suzuki@example.com"
+		accountOwner = func(t *testing.T, db *bun.DB, ownerID idVO.UserID) {
+			users := []*model.User{
+				{ID: userID.String(), Name: userName, Email: userEmail},
+				{ID: otherUserID.String(), Name: otherName, Email: otherEmail},
+			}
+			passwordHash, err := passwordUtil.Encode("1234")
+			assert.NoError(t, err)
+			account := &model.Account{
+				ID:           accountID.String(),
+				UserID:       ownerID.String(),
+				Name:         accountName,
+				PasswordHash: passwordHash,
+				Balance:      1000,
+				CurrencyID:   seed.JPYID,
+				UpdatedAt:    timer.Now(),
+			}
+			InsertTestData(t, db, users, account)
+		}
+	)
+
+	tests := []struct {
+		caseName string
+		prepare  func(t *testing.T, db *bun.DB)
+		wantCode int
+	}{
+		{
+			caseName: "Happy path (200): 口座の取得に成功する",
+			prepare: func(t *testing.T, db *bun.DB) {
+				accountOwner(t, db, userID)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			caseName: "Sad path (403): 他のユーザーの口座の為、失敗する",
+			prepare: func(t *testing.T, db *bun.DB) {
+				accountOwner(t, db, otherUserID)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			caseName: "Sad path (404): 口座が見つからない為、失敗する",
+			prepare: func(t *testing.T, db *bun.DB) {
+				user := &model.User{
+					ID:    userID.String(),
+					Name:  userName,
+					Email: userEmail,
+				}
+				InsertTestData(t, db, user)
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			e, gol, db := BeforeAll(t)
+			defer AfterAll(t, db)
+
+			tt.prepare(t, db)
+			usedTables := []string{"users", "accounts"}
+			beforeDBData := GetDBData(t, db, usedTables)
+
+			req, rec := NewJSONRequest(t, http.MethodGet, "/api/v1/me/accounts/"+accountID.String(), nil)
+			SetAccessToken(t, db, userID.String(), req)
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			result := GenerateResultJSON(t, beforeDBData, nil, req, rec, nil)
+			replaceKeys := []string{"passwordHash", "updatedAt"}
+			result = ReplaceDynamicValue(result, replaceKeys)
+
+			gol.Assert(t, t.Name(), result)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/u104rak1/pocgo/pkg/timer"
 	"github.com/uptrace/bun"
 )
 
@@ -18,9 +19,10 @@ func TestReadMyProfile(t *testing.T) {
 	)
 
 	tests := []struct {
-		caseName string
-		prepare  func(t *testing.T, db *bun.DB)
-		wantCode int
+		caseName     string
+		prepare      func(t *testing.T, db *bun.DB)
+		wantCode     int
+		skipIfSQLite bool
 	}{
 		{
 			caseName: "Happy path (200): ユーザー情報取得に成功する",
@@ -39,12 +41,29 @@ func TestReadMyProfile(t *testing.T) {
 			prepare: func(t *testing.T, db *bun.DB) {
 				InsertTestData(t, db)
 			},
+			wantCode:     http.StatusNotFound,
+			skipIfSQLite: true,
+		},
+		{
+			caseName: "Sad path (404): ユーザーが退会済みの為、失敗する",
+			prepare: func(t *testing.T, db *bun.DB) {
+				user := &model.User{
+					ID:        userID.String(),
+					Name:      maxLenUserName,
+					Email:     email,
+					DeletedAt: timer.GetFixedDate(),
+				}
+				InsertTestData(t, db, user)
+			},
 			wantCode: http.StatusNotFound,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.caseName, func(t *testing.T) {
+			if tt.skipIfSQLite {
+				SkipIfSQLite(t)
+			}
 			e, gol, db := BeforeAll(t)
 			defer AfterAll(t, db)
 
@@ -53,12 +72,12 @@ func TestReadMyProfile(t *testing.T) {
 			beforeDBData := GetDBData(t, db, usedTables)
 
 			req, rec := NewJSONRequest(t, http.MethodGet, "/api/v1/me", nil)
-			SetAccessToken(t, userID.String(), req)
+			SetAccessToken(t, db, userID.String(), req)
 			e.ServeHTTP(rec, req)
 			assert.Equal(t, tt.wantCode, rec.Code)
 
 			result := GenerateResultJSON(t, beforeDBData, nil, req, rec, nil)
-			result = ReplaceDynamicValue(result, []string{})
+			result = ReplaceDynamicValue(result, []string{"deletedAt"})
 
 			gol.Assert(t, t.Name(), result)
 		})
@@ -0,0 +1,127 @@
+package integration_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sessionDomain "github.com/u104rak1/pocgo/internal/domain/session"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/u104rak1/pocgo/internal/presentation/token"
+	"github.com/u104rak1/pocgo/pkg/timer"
+	"github.com/uptrace/bun"
+)
+
+func TestRefreshToken(t *testing.T) {
+	var (
+		userID       = idVO.NewUserIDForTest("user")
+		sessionID    = idVO.NewSessionIDForTest("session")
+		refreshToken = strings.Repeat("r", sessionDomain.RefreshTokenLength)
+	)
+
+	insertRefreshToken := func(t *testing.T, db *bun.DB, revokedAt, expiresAt, usedAt *time.Time) {
+		user := &model.User{
+			ID:    userID.String(),
+			Name:  "sato taro",
+			Email: "sato@example.com",
+		}
+		session := &model.Session{
+			ID:        sessionID.String(),
+			UserID:    userID.String(),
+			CreatedAt: timer.Now(),
+			RevokedAt: revokedAt,
+		}
+		refreshTokenModel := &model.RefreshToken{
+			TokenHash: sessionDomain.HashRefreshToken(refreshToken),
+			SessionID: sessionID.String(),
+			ExpiresAt: *expiresAt,
+			UsedAt:    usedAt,
+			CreatedAt: timer.Now(),
+		}
+		InsertTestData(t, db, user, session, refreshTokenModel)
+	}
+	now := timer.Now()
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	tests := []struct {
+		caseName    string
+		requestBody interface{}
+		prepare     func(t *testing.T, db *bun.DB)
+		wantCode    int
+	}{
+		{
+			caseName:    "Happy path (201): トークンの再発行に成功する",
+			requestBody: token.RefreshTokenRequest{RefreshToken: refreshToken},
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertRefreshToken(t, db, nil, &future, nil)
+			},
+			wantCode: http.StatusCreated,
+		},
+		{
+			caseName:    "Sad path (400): リフレッシュトークンが空の為、失敗する",
+			requestBody: token.RefreshTokenRequest{},
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertRefreshToken(t, db, nil, &future, nil)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			caseName:    "Sad path (401): リフレッシュトークンが存在しない為、失敗する",
+			requestBody: token.RefreshTokenRequest{RefreshToken: strings.Repeat("x", sessionDomain.RefreshTokenLength)},
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertRefreshToken(t, db, nil, &future, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			caseName:    "Sad path (401): リフレッシュトークンの有効期限が切れている為、失敗する",
+			requestBody: token.RefreshTokenRequest{RefreshToken: refreshToken},
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertRefreshToken(t, db, nil, &past, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			caseName:    "Sad path (401): 使用済みのリフレッシュトークンが再利用された為、セッションを失効させて失敗する",
+			requestBody: token.RefreshTokenRequest{RefreshToken: refreshToken},
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertRefreshToken(t, db, nil, &future, &now)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			caseName:    "Sad path (401): セッションが失効している為、失敗する",
+			requestBody: token.RefreshTokenRequest{RefreshToken: refreshToken},
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertRefreshToken(t, db, &now, &future, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			e, gol, db := BeforeAll(t)
+			defer AfterAll(t, db)
+
+			tt.prepare(t, db)
+			usedTables := []string{"sessions", "refresh_tokens"}
+			beforeDBData := GetDBData(t, db, usedTables)
+
+			req, rec := NewJSONRequest(t, http.MethodPost, "/api/v1/token/refresh", tt.requestBody)
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			afterDBData := GetDBData(t, db, usedTables)
+			result := GenerateResultJSON(t, beforeDBData, afterDBData, req, rec, tt.requestBody)
+			replaceKeys := []string{"tokenHash", "createdAt", "expiresAt", "usedAt", "revokedAt", "accessToken", "refreshToken"}
+			result = ReplaceDynamicValue(result, replaceKeys)
+
+			gol.Assert(t, t.Name(), result)
+		})
+	}
+}
@@ -3,12 +3,15 @@ package integration_test
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	lockoutDomain "github.com/u104rak1/pocgo/internal/domain/lockout"
 	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
 	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
 	"github.com/u104rak1/pocgo/internal/presentation/signin"
 	"github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/timer"
 	"github.com/uptrace/bun"
 )
 
@@ -84,6 +87,26 @@ func TestSignin(t *testing.T) {
 			prepare:  prepareNormal,
 			wantCode: http.StatusUnauthorized,
 		},
+		{
+			caseName: "Sad path (429): 連続して認証に失敗しロックされている為、正しいパスワードでも失敗する",
+			requestBody: signin.SigninRequest{
+				Email:    email,
+				Password: maxLenPassword,
+			},
+			prepare: func(t *testing.T, db *bun.DB) {
+				prepareNormal(t, db)
+				lockedUntil := timer.Now().Add(time.Hour)
+				lockout := &model.Lockout{
+					Subject:     string(lockoutDomain.SubjectUser),
+					SubjectID:   userID.String(),
+					FailedCount: lockoutDomain.MaxFailedAttempts,
+					LockedUntil: &lockedUntil,
+					UpdatedAt:   timer.Now(),
+				}
+				InsertTestData(t, db, lockout)
+			},
+			wantCode: http.StatusTooManyRequests,
+		},
 	}
 
 	for _, tt := range tests {
@@ -100,7 +123,7 @@ func TestSignin(t *testing.T) {
 			assert.Equal(t, tt.wantCode, rec.Code)
 
 			result := GenerateResultJSON(t, beforeDBData, nil, req, rec, tt.requestBody)
-			replaceKeys := []string{"passwordHash", "accessToken"}
+			replaceKeys := []string{"passwordHash", "accessToken", "refreshToken"}
 			result = ReplaceDynamicValue(result, replaceKeys)
 
 			gol.Assert(t, t.Name(), result)
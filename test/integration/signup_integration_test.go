@@ -71,7 +71,7 @@ func TestSignup(t *testing.T) {
 
 			afterDBData := GetDBData(t, db, usedTables)
 			result := GenerateResultJSON(t, beforeDBData, afterDBData, req, rec, tt.requestBody)
-			replaceKeys := []string{"id", "userId", "passwordHash", "updatedAt", "accessToken"}
+			replaceKeys := []string{"id", "userId", "passwordHash", "updatedAt", "accessToken", "refreshToken"}
 			result = ReplaceDynamicValue(result, replaceKeys)
 
 			gol.Assert(t, t.Name(), result)
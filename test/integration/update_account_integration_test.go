@@ -0,0 +1,97 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	idVO "github.com/u104rak1/pocgo/internal/domain/value_object/id"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/model"
+	"github.com/u104rak1/pocgo/internal/infrastructure/postgres/seed"
+	"github.com/u104rak1/pocgo/internal/presentation/me/accounts"
+	passwordUtil "github.com/u104rak1/pocgo/pkg/password"
+	"github.com/u104rak1/pocgo/pkg/timer"
+	"github.com/uptrace/bun"
+)
+
+func TestUpdateAccount(t *testing.T) {
+	var (
+		userID    = idVO.NewUserIDForTest("user")
+		accountID = idVO.NewAccountIDForTest("account")
+		userName  = "sato taro"
+		userEmail = "sato@example.com"
+	)
+
+	insertAccount := func(t *testing.T, db *bun.DB, deletedAt time.Time) {
+		user := &model.User{
+			ID:    userID.String(),
+			Name:  userName,
+			Email: userEmail,
+		}
+		passwordHash, err := passwordUtil.Encode("1234")
+		assert.NoError(t, err)
+		account := &model.Account{
+			ID:           accountID.String(),
+			UserID:       userID.String(),
+			Name:         "For work",
+			PasswordHash: passwordHash,
+			Balance:      1000,
+			CurrencyID:   seed.JPYID,
+			UpdatedAt:    timer.Now(),
+			DeletedAt:    deletedAt,
+		}
+		InsertTestData(t, db, user, account)
+	}
+
+	tests := []struct {
+		caseName    string
+		requestBody interface{}
+		prepare     func(t *testing.T, db *bun.DB)
+		wantCode    int
+	}{
+		{
+			caseName: "Happy path (200): 口座名の変更に成功する",
+			requestBody: accounts.UpdateAccountRequestBody{
+				Name: "For private",
+			},
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertAccount(t, db, time.Time{})
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			caseName: "Sad path (404): 解約済みの口座の為、失敗する",
+			requestBody: accounts.UpdateAccountRequestBody{
+				Name: "For private",
+			},
+			prepare: func(t *testing.T, db *bun.DB) {
+				insertAccount(t, db, timer.Now())
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.caseName, func(t *testing.T) {
+			e, gol, db := BeforeAll(t)
+			defer AfterAll(t, db)
+
+			tt.prepare(t, db)
+			usedTables := []string{"users", "accounts"}
+			beforeDBData := GetDBData(t, db, usedTables)
+
+			req, rec := NewJSONRequest(t, http.MethodPatch, "/api/v1/me/accounts/"+accountID.String(), tt.requestBody)
+			SetAccessToken(t, db, userID.String(), req)
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			afterDBData := GetDBData(t, db, usedTables)
+			result := GenerateResultJSON(t, beforeDBData, afterDBData, req, rec, tt.requestBody)
+			replaceKeys := []string{"passwordHash", "updatedAt", "deletedAt"}
+			result = ReplaceDynamicValue(result, replaceKeys)
+
+			gol.Assert(t, t.Name(), result)
+		})
+	}
+}